@@ -32,6 +32,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/x/genutil"
@@ -247,7 +248,7 @@ func New(
 		ibc.NewAppModule(app.IBCKeeper),
 		params.NewAppModule(app.ParamsKeeper),
 		transferModule,
-		mayachain.NewAppModule(app.thorchainKeeper, appCodec, app.BankKeeper, app.AccountKeeper, app.TransferKeeper, keys[thorchaintypes.StoreKey], telemetryEnabled),
+		mayachain.NewAppModule(app.thorchainKeeper, appCodec, app.BankKeeper, app.AccountKeeper, app.TransferKeeper, keys[thorchaintypes.StoreKey], telemetryEnabled).WithQueryContext(app.queryContext),
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -311,6 +312,19 @@ func (app *BASEChainApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain)
 	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
 }
 
+// queryContext returns a read only context of the committed state at the given
+// height, or at the latest height when the height is zero
+func (app *BASEChainApp) queryContext(height int64) (sdk.Context, error) {
+	if height == 0 {
+		height = app.LastBlockHeight()
+	}
+	cacheMS, err := app.CommitMultiStore().CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return sdk.Context{}, err
+	}
+	return sdk.NewContext(cacheMS, tmproto.Header{Height: height}, true, app.Logger()), nil
+}
+
 // LoadHeight loads a particular height
 func (app *BASEChainApp) LoadHeight(height int64) error {
 	return app.LoadVersion(height)
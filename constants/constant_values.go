@@ -48,6 +48,7 @@ const (
 	PauseOnSlashThreshold
 	FailKeygenSlashPoints
 	FailKeysignSlashPoints
+	SlashLedgerRetention
 	LiquidityLockUpBlocks
 	ObserveSlashPoints
 	DoubleBlockSignSlashPoints
@@ -71,6 +72,7 @@ const (
 	FullImpLossProtectionBlocks
 	BondLockupPeriod
 	MaxBondProviders
+	NodeOperatorFeeChangeDelay
 	NumberOfNewNodesPerChurn
 	MinTxOutVolumeThreshold
 	TxOutDelayRate
@@ -80,6 +82,8 @@ const (
 	TNSFeeOnSale
 	TNSFeePerBlock
 	PermittedSolvencyGap
+	SolvencyWarnGap
+	SolvencyHistoryRetention
 	NodeOperatorFee
 	ValidatorMaxRewardRatio
 	PoolDepthForYggFundingMin
@@ -109,6 +113,10 @@ const (
 	POLMaxPoolMovement
 	POLSynthUtilization
 	POLBuffer
+	POLMaxPoolDeposit
+	POLCooldown
+	POLSnapshotInterval
+	POLSnapshotRetention
 	SynthYieldBasisPoints
 	SynthYieldCycle
 	MinimumL1OutboundFeeUSD
@@ -142,10 +150,20 @@ const (
 	CACAOPoolEnabled
 	CACAOPoolRewardsEnabled
 	CACAOPoolDepositMaturityBlocks
+	CACAOPoolAutoCompoundInterval
 	ChurnMigrateRounds
 	TradeAccountsEnabled
 	TradeAccountsDepositEnabled
 	TradeAccountsWithdrawEnabled
+	EnableOrderBooks
+	LimitOrderMinFillBasisPoints
+	DCAOrdersEnabled
+	DCAMaxQuantity
+	DCAMaxLength
+	LendingEnabled
+	LendingMinCollateralRatio
+	LendingLiquidationCollateralRatio
+	LendingLiquidationInterval
 
 	// These are new implicitly-0 Constants undisplayed in the API endpoint (no explicit value set).
 	BurnSynths
@@ -181,6 +199,7 @@ var nameToString = map[ConstantName]string{
 	PauseUnbond:                         "PauseUnbond",
 	MinimumBondInCacao:                  "MinimumBondInRune", // Can't change the string value, because we would have to account for the version change when mimir is used
 	MaxBondProviders:                    "MaxBondProviders",
+	NodeOperatorFeeChangeDelay:          "NodeOperatorFeeChangeDelay",
 	FundMigrationInterval:               "FundMigrationInterval",
 	ArtificialRagnarokBlockHeight:       "ArtificialRagnarokBlockHeight",
 	MaximumLiquidityCacao:               "MaximumLiquidityRune", // Can't change the string value, because we would have to account for the version change when mimir is used
@@ -191,6 +210,7 @@ var nameToString = map[ConstantName]string{
 	PauseOnSlashThreshold:               "PauseOnSlashThreshold",
 	FailKeygenSlashPoints:               "FailKeygenSlashPoints",
 	FailKeysignSlashPoints:              "FailKeysignSlashPoints",
+	SlashLedgerRetention:                "SlashLedgerRetention",
 	LiquidityLockUpBlocks:               "LiquidityLockUpBlocks",
 	ObserveSlashPoints:                  "ObserveSlashPoints",
 	MissBlockSignSlashPoints:            "MissBlockSignSlashPoints",
@@ -222,6 +242,8 @@ var nameToString = map[ConstantName]string{
 	TNSFeeOnSale:                        "TNSFeeOnSale",
 	TNSFeePerBlock:                      "TNSFeePerBlock",
 	PermittedSolvencyGap:                "PermittedSolvencyGap",
+	SolvencyWarnGap:                     "SolvencyWarnGap",
+	SolvencyHistoryRetention:            "SolvencyHistoryRetention",
 	ValidatorMaxRewardRatio:             "ValidatorMaxRewardRatio",
 	NodeOperatorFee:                     "NodeOperatorFee",
 	PoolDepthForYggFundingMin:           "PoolDepthForYggFundingMin",
@@ -250,6 +272,10 @@ var nameToString = map[ConstantName]string{
 	POLMaxPoolMovement:                  "POLMaxPoolMovement",
 	POLSynthUtilization:                 "POLSynthUtilization",
 	POLBuffer:                           "POLBuffer",
+	POLMaxPoolDeposit:                   "POLMaxPoolDeposit",
+	POLCooldown:                         "POLCooldown",
+	POLSnapshotInterval:                 "POLSnapshotInterval",
+	POLSnapshotRetention:                "POLSnapshotRetention",
 	RagnarokProcessNumOfLPPerIteration:  "RagnarokProcessNumOfLPPerIteration",
 	SynthYieldBasisPoints:               "SynthYieldBasisPoints",
 	SynthYieldCycle:                     "SynthYieldCycle",
@@ -284,10 +310,20 @@ var nameToString = map[ConstantName]string{
 	CACAOPoolEnabled:                    "CACAOPoolEnabled",
 	CACAOPoolRewardsEnabled:             "CACAOPoolRewardsEnabled",
 	CACAOPoolDepositMaturityBlocks:      "CACAOPoolDepositMaturityBlocks",
+	CACAOPoolAutoCompoundInterval:       "CACAOPoolAutoCompoundInterval",
 	ChurnMigrateRounds:                  "ChurnMigrateRounds",
 	TradeAccountsEnabled:                "TradeAccountsEnabled",
 	TradeAccountsDepositEnabled:         "TradeAccountsDepositEnabled",
 	TradeAccountsWithdrawEnabled:        "TradeAccountsWithdrawEnabled",
+	EnableOrderBooks:                    "EnableOrderBooks",
+	LimitOrderMinFillBasisPoints:        "LimitOrderMinFillBasisPoints",
+	DCAOrdersEnabled:                    "DCAOrdersEnabled",
+	DCAMaxQuantity:                      "DCAMaxQuantity",
+	DCAMaxLength:                        "DCAMaxLength",
+	LendingEnabled:                      "LendingEnabled",
+	LendingMinCollateralRatio:           "LendingMinCollateralRatio",
+	LendingLiquidationCollateralRatio:   "LendingLiquidationCollateralRatio",
+	LendingLiquidationInterval:          "LendingLiquidationInterval",
 }
 
 // String implement fmt.stringer
@@ -32,11 +32,13 @@ func NewConstantValueVCUR() *ConstantVals {
 			PauseUnbond:                         0,                   // pauses the ability to unbond
 			MinimumBondInCacao:                  1_000_000_00000000,  // 1M cacao
 			MaxBondProviders:                    2,                   // maximum number of bond providers
+			NodeOperatorFeeChangeDelay:          43200,               // blocks before an increase of the node operator fee takes effect - one churn interval
 			MaxOutboundAttempts:                 0,                   // maximum retries to reschedule a transaction
 			SlashPenalty:                        15000,               // penalty paid (in basis points) for theft of assets
 			PauseOnSlashThreshold:               10_000_00000000,     // number of cacao to pause the network on the event a vault is slash for theft
 			FailKeygenSlashPoints:               720,                 // slash for 720 blocks , which equals 1 hour
 			FailKeysignSlashPoints:              2,                   // slash for 2 blocks
+			SlashLedgerRetention:                86400,               // blocks the slash points of a node are kept per reason - two churn intervals
 			LiquidityLockUpBlocks:               0,                   // the number of blocks LP can withdraw after their liquidity
 			ObserveSlashPoints:                  1,                   // the number of slashpoints for making an observation (redeems later if observation reaches consensus
 			ObservationDelayFlexibility:         10,                  // number of blocks of flexibility for a validator to get their slash points taken off for making an observation
@@ -66,6 +68,8 @@ func NewConstantValueVCUR() *ConstantVals {
 			TNSFeeOnSale:                        1000,                // fee for TNS sale in basis points
 			TNSFeePerBlock:                      2000,                // per block cost for TNS, in cacao
 			PermittedSolvencyGap:                100,                 // the setting is in basis points
+			SolvencyWarnGap:                     50,                  // solvency gap in basis points a warning is emitted above, must be below PermittedSolvencyGap
+			SolvencyHistoryRetention:            201600,              // blocks the reported solvency of a vault is kept - two weeks
 			ValidatorMaxRewardRatio:             1,                   // the ratio to MinimumBondInCacao at which validators stop receiving rewards proportional to their bond
 			PoolDepthForYggFundingMin:           50_000_000_00000000, // the minimum pool depth in CACAO required for ygg funding
 			MaxNodeToChurnOutForLowVersion:      1,                   // the maximum number of nodes to churn out for low version per churn
@@ -88,6 +92,10 @@ func NewConstantValueVCUR() *ConstantVals {
 			POLMaxPoolMovement:                  0,                   // Maximum amount of cacao to enter/exit a pool per iteration. This is in basis points of the pool cacao depth
 			POLSynthUtilization:                 0,                   // target synth utilization for POL (basis points)
 			POLBuffer:                           0,                   // buffer around the POL synth utilization (basis points added to/subtracted from POLSynthUtilization basis points)
+			POLMaxPoolDeposit:                   0,                   // Maximum value in cacao of the POL liquidity in a single pool, 0 means no per pool limit
+			POLCooldown:                         0,                   // Minimum number of blocks between POL movements in the same pool
+			POLSnapshotInterval:                 14400,               // blocks between snapshots of the per pool POL ledger - one day, 0 disables the snapshots
+			POLSnapshotRetention:                1296000,             // blocks a snapshot of the per pool POL ledger is kept for - 90 days
 			RagnarokProcessNumOfLPPerIteration:  200,                 // the number of LP to be processed per iteration during ragnarok pool
 			SynthYieldBasisPoints:               6000,                // amount of the yield the capital earns the synth holder receives
 			SynthYieldCycle:                     0,                   // number of blocks when the network pays out rewards to yield bearing synths
@@ -117,10 +125,20 @@ func NewConstantValueVCUR() *ConstantVals {
 			CACAOPoolEnabled:                    0,                   // enable/disable CACAO Pool
 			CACAOPoolRewardsEnabled:             1,                   // enable/disable CACAO Pool rewards
 			CACAOPoolDepositMaturityBlocks:      14400,               // blocks from last deposit to allow withdraw - one day
+			CACAOPoolAutoCompoundInterval:       14400,               // blocks between re-deposits of the yield of auto-compounding CACAO providers - one day
 			ChurnMigrateRounds:                  5,                   // Number of rounds to migrate vaults during churn
 			TradeAccountsEnabled:                0,                   // enable/disable trade account
 			TradeAccountsDepositEnabled:         0,                   // enable/disable trade account deposits
 			TradeAccountsWithdrawEnabled:        0,                   // enable/disable trade account withdrawals
+			EnableOrderBooks:                    0,                   // enable/disable order books (limit orders)
+			LimitOrderMinFillBasisPoints:        1000,                // smallest slice of a limit order deposit that is filled at once, in basis points
+			DCAOrdersEnabled:                    0,                   // enable/disable dca (dollar-cost-averaging) orders
+			DCAMaxQuantity:                      100,                 // max number of slices a dca order can be split into
+			DCAMaxLength:                        14400 * 90,          // max number of blocks a dca order can trade over
+			LendingEnabled:                      0,                   // enable/disable loans backed by trade account collateral
+			LendingMinCollateralRatio:           20_000,              // default minimum collateralization ratio of loans in basis points, overridden per pool by LendingMinCR-<pool>
+			LendingLiquidationCollateralRatio:   12_000,              // collateralization ratio in basis points below which a loan is liquidated
+			LendingLiquidationInterval:          10,                  // number of blocks between checks for loans to liquidate
 		},
 		boolValues: map[ConstantName]bool{
 			StrictBondLiquidityRatio: false,
@@ -19,6 +19,9 @@ func init() {
 		OldValidatorRate:                    60,          // 5 min
 		MinimumBondInCacao:                  100_000_000, // 1 cacao
 		MaxBondProviders:                    6,           // maximum number of bond providers
+		NodeOperatorFeeChangeDelay:          60,          // 5 min
+		SlashLedgerRetention:                120,         // 10 min
+		SolvencyHistoryRetention:            120,         // 10 min
 		ValidatorMaxRewardRatio:             3,
 		FundMigrationInterval:               40,
 		LiquidityLockUpBlocks:               0,
@@ -45,6 +48,7 @@ func init() {
 		TradeAccountsEnabled:                1,
 		TradeAccountsDepositEnabled:         1,
 		TradeAccountsWithdrawEnabled:        1,
+		LendingEnabled:                      1,
 	}
 	boolOverrides = map[ConstantName]bool{
 		StrictBondLiquidityRatio: false,
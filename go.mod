@@ -277,6 +277,7 @@ require (
 	github.com/radixdlt/maya v1.3.1
 	github.com/radixdlt/radix-engine-toolkit-go/v2 v2.1.1
 	github.com/tyler-smith/go-bip39 v1.0.2
+	google.golang.org/genproto/googleapis/api v0.0.0-20231106174013-bbf56f31fb17
 )
 
 require (
@@ -315,7 +316,6 @@ require (
 	go.opentelemetry.io/otel/metric v1.24.0 // indirect
 	go.opentelemetry.io/otel/trace v1.24.0 // indirect
 	gonum.org/v1/gonum v0.12.0 // indirect
-	google.golang.org/genproto/googleapis/api v0.0.0-20231106174013-bbf56f31fb17 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20231120223509-83a465c0220f // indirect
 	rsc.io/tmplfunc v0.0.3 // indirect
 )
@@ -240,6 +240,195 @@ func (a *QueueApiService) QueueOutboundExecute(r ApiQueueOutboundRequest) ([]TxO
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiQueueOutboundPageRequest struct {
+	ctx context.Context
+	ApiService *QueueApiService
+	height *int64
+	offset *int64
+	limit *int64
+	chain *string
+	asset *string
+	destination *string
+	vault *string
+	fromHeight *int64
+	toHeight *int64
+}
+
+// optional block height, defaults to current tip
+func (r ApiQueueOutboundPageRequest) Height(height int64) ApiQueueOutboundPageRequest {
+	r.height = &height
+	return r
+}
+
+// number of matching items to skip
+func (r ApiQueueOutboundPageRequest) Offset(offset int64) ApiQueueOutboundPageRequest {
+	r.offset = &offset
+	return r
+}
+
+// maximum number of items to return, defaults to 100
+func (r ApiQueueOutboundPageRequest) Limit(limit int64) ApiQueueOutboundPageRequest {
+	r.limit = &limit
+	return r
+}
+
+// only return items for the chain
+func (r ApiQueueOutboundPageRequest) Chain(chain string) ApiQueueOutboundPageRequest {
+	r.chain = &chain
+	return r
+}
+
+// only return items moving the asset
+func (r ApiQueueOutboundPageRequest) Asset(asset string) ApiQueueOutboundPageRequest {
+	r.asset = &asset
+	return r
+}
+
+// only return items sent to the address
+func (r ApiQueueOutboundPageRequest) Destination(destination string) ApiQueueOutboundPageRequest {
+	r.destination = &destination
+	return r
+}
+
+// only return items of the vault pubkey
+func (r ApiQueueOutboundPageRequest) Vault(vault string) ApiQueueOutboundPageRequest {
+	r.vault = &vault
+	return r
+}
+
+// only return items from the height onwards
+func (r ApiQueueOutboundPageRequest) FromHeight(fromHeight int64) ApiQueueOutboundPageRequest {
+	r.fromHeight = &fromHeight
+	return r
+}
+
+// only return items up to the height
+func (r ApiQueueOutboundPageRequest) ToHeight(toHeight int64) ApiQueueOutboundPageRequest {
+	r.toHeight = &toHeight
+	return r
+}
+
+func (r ApiQueueOutboundPageRequest) Execute() (*OutboundQueuePageResponse, *http.Response, error) {
+	return r.ApiService.QueueOutboundPageExecute(r)
+}
+
+/*
+QueueOutboundPage Method for QueueOutboundPage
+
+Returns a page of the outbound queue matching the filters, with the number of matching outbounds per chain.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiQueueOutboundPageRequest
+*/
+func (a *QueueApiService) QueueOutboundPage(ctx context.Context) ApiQueueOutboundPageRequest {
+	return ApiQueueOutboundPageRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return OutboundQueuePageResponse
+func (a *QueueApiService) QueueOutboundPageExecute(r ApiQueueOutboundPageRequest) (*OutboundQueuePageResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *OutboundQueuePageResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "QueueApiService.QueueOutboundPage")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/mayachain/queue/outbound/page"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.height != nil {
+		localVarQueryParams.Add("height", parameterToString(*r.height, ""))
+	}
+	if r.offset != nil {
+		localVarQueryParams.Add("offset", parameterToString(*r.offset, ""))
+	}
+	if r.limit != nil {
+		localVarQueryParams.Add("limit", parameterToString(*r.limit, ""))
+	}
+	if r.chain != nil {
+		localVarQueryParams.Add("chain", parameterToString(*r.chain, ""))
+	}
+	if r.asset != nil {
+		localVarQueryParams.Add("asset", parameterToString(*r.asset, ""))
+	}
+	if r.destination != nil {
+		localVarQueryParams.Add("destination", parameterToString(*r.destination, ""))
+	}
+	if r.vault != nil {
+		localVarQueryParams.Add("vault", parameterToString(*r.vault, ""))
+	}
+	if r.fromHeight != nil {
+		localVarQueryParams.Add("from_height", parameterToString(*r.fromHeight, ""))
+	}
+	if r.toHeight != nil {
+		localVarQueryParams.Add("to_height", parameterToString(*r.toHeight, ""))
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiQueueScheduledRequest struct {
 	ctx context.Context
 	ApiService *QueueApiService
@@ -349,6 +538,195 @@ func (a *QueueApiService) QueueScheduledExecute(r ApiQueueScheduledRequest) ([]T
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiQueueScheduledPageRequest struct {
+	ctx context.Context
+	ApiService *QueueApiService
+	height *int64
+	offset *int64
+	limit *int64
+	chain *string
+	asset *string
+	destination *string
+	vault *string
+	fromHeight *int64
+	toHeight *int64
+}
+
+// optional block height, defaults to current tip
+func (r ApiQueueScheduledPageRequest) Height(height int64) ApiQueueScheduledPageRequest {
+	r.height = &height
+	return r
+}
+
+// number of matching items to skip
+func (r ApiQueueScheduledPageRequest) Offset(offset int64) ApiQueueScheduledPageRequest {
+	r.offset = &offset
+	return r
+}
+
+// maximum number of items to return, defaults to 100
+func (r ApiQueueScheduledPageRequest) Limit(limit int64) ApiQueueScheduledPageRequest {
+	r.limit = &limit
+	return r
+}
+
+// only return items for the chain
+func (r ApiQueueScheduledPageRequest) Chain(chain string) ApiQueueScheduledPageRequest {
+	r.chain = &chain
+	return r
+}
+
+// only return items moving the asset
+func (r ApiQueueScheduledPageRequest) Asset(asset string) ApiQueueScheduledPageRequest {
+	r.asset = &asset
+	return r
+}
+
+// only return items sent to the address
+func (r ApiQueueScheduledPageRequest) Destination(destination string) ApiQueueScheduledPageRequest {
+	r.destination = &destination
+	return r
+}
+
+// only return items of the vault pubkey
+func (r ApiQueueScheduledPageRequest) Vault(vault string) ApiQueueScheduledPageRequest {
+	r.vault = &vault
+	return r
+}
+
+// only return items from the height onwards
+func (r ApiQueueScheduledPageRequest) FromHeight(fromHeight int64) ApiQueueScheduledPageRequest {
+	r.fromHeight = &fromHeight
+	return r
+}
+
+// only return items up to the height
+func (r ApiQueueScheduledPageRequest) ToHeight(toHeight int64) ApiQueueScheduledPageRequest {
+	r.toHeight = &toHeight
+	return r
+}
+
+func (r ApiQueueScheduledPageRequest) Execute() (*OutboundQueuePageResponse, *http.Response, error) {
+	return r.ApiService.QueueScheduledPageExecute(r)
+}
+
+/*
+QueueScheduledPage Method for QueueScheduledPage
+
+Returns a page of the scheduled queue matching the filters, with the number of matching outbounds per chain.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiQueueScheduledPageRequest
+*/
+func (a *QueueApiService) QueueScheduledPage(ctx context.Context) ApiQueueScheduledPageRequest {
+	return ApiQueueScheduledPageRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return OutboundQueuePageResponse
+func (a *QueueApiService) QueueScheduledPageExecute(r ApiQueueScheduledPageRequest) (*OutboundQueuePageResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *OutboundQueuePageResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "QueueApiService.QueueScheduledPage")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/mayachain/queue/scheduled/page"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.height != nil {
+		localVarQueryParams.Add("height", parameterToString(*r.height, ""))
+	}
+	if r.offset != nil {
+		localVarQueryParams.Add("offset", parameterToString(*r.offset, ""))
+	}
+	if r.limit != nil {
+		localVarQueryParams.Add("limit", parameterToString(*r.limit, ""))
+	}
+	if r.chain != nil {
+		localVarQueryParams.Add("chain", parameterToString(*r.chain, ""))
+	}
+	if r.asset != nil {
+		localVarQueryParams.Add("asset", parameterToString(*r.asset, ""))
+	}
+	if r.destination != nil {
+		localVarQueryParams.Add("destination", parameterToString(*r.destination, ""))
+	}
+	if r.vault != nil {
+		localVarQueryParams.Add("vault", parameterToString(*r.vault, ""))
+	}
+	if r.fromHeight != nil {
+		localVarQueryParams.Add("from_height", parameterToString(*r.fromHeight, ""))
+	}
+	if r.toHeight != nil {
+		localVarQueryParams.Add("to_height", parameterToString(*r.toHeight, ""))
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiQueueSwapRequest struct {
 	ctx context.Context
 	ApiService *QueueApiService
@@ -457,3 +835,192 @@ func (a *QueueApiService) QueueSwapExecute(r ApiQueueSwapRequest) ([]MsgSwap, *h
 
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
+
+type ApiQueueSwapPageRequest struct {
+	ctx context.Context
+	ApiService *QueueApiService
+	height *int64
+	offset *int64
+	limit *int64
+	chain *string
+	asset *string
+	destination *string
+	vault *string
+	fromHeight *int64
+	toHeight *int64
+}
+
+// optional block height, defaults to current tip
+func (r ApiQueueSwapPageRequest) Height(height int64) ApiQueueSwapPageRequest {
+	r.height = &height
+	return r
+}
+
+// number of matching items to skip
+func (r ApiQueueSwapPageRequest) Offset(offset int64) ApiQueueSwapPageRequest {
+	r.offset = &offset
+	return r
+}
+
+// maximum number of items to return, defaults to 100
+func (r ApiQueueSwapPageRequest) Limit(limit int64) ApiQueueSwapPageRequest {
+	r.limit = &limit
+	return r
+}
+
+// only return items for the chain
+func (r ApiQueueSwapPageRequest) Chain(chain string) ApiQueueSwapPageRequest {
+	r.chain = &chain
+	return r
+}
+
+// only return items moving the asset
+func (r ApiQueueSwapPageRequest) Asset(asset string) ApiQueueSwapPageRequest {
+	r.asset = &asset
+	return r
+}
+
+// only return items sent to the address
+func (r ApiQueueSwapPageRequest) Destination(destination string) ApiQueueSwapPageRequest {
+	r.destination = &destination
+	return r
+}
+
+// only return items of the vault pubkey
+func (r ApiQueueSwapPageRequest) Vault(vault string) ApiQueueSwapPageRequest {
+	r.vault = &vault
+	return r
+}
+
+// only return items from the height onwards
+func (r ApiQueueSwapPageRequest) FromHeight(fromHeight int64) ApiQueueSwapPageRequest {
+	r.fromHeight = &fromHeight
+	return r
+}
+
+// only return items up to the height
+func (r ApiQueueSwapPageRequest) ToHeight(toHeight int64) ApiQueueSwapPageRequest {
+	r.toHeight = &toHeight
+	return r
+}
+
+func (r ApiQueueSwapPageRequest) Execute() (*SwapQueuePageResponse, *http.Response, error) {
+	return r.ApiService.QueueSwapPageExecute(r)
+}
+
+/*
+QueueSwapPage Method for QueueSwapPage
+
+Returns a page of the swap queue matching the filters, with the number of matching swaps per target chain.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiQueueSwapPageRequest
+*/
+func (a *QueueApiService) QueueSwapPage(ctx context.Context) ApiQueueSwapPageRequest {
+	return ApiQueueSwapPageRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return SwapQueuePageResponse
+func (a *QueueApiService) QueueSwapPageExecute(r ApiQueueSwapPageRequest) (*SwapQueuePageResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *SwapQueuePageResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "QueueApiService.QueueSwapPage")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/mayachain/queue/swap/page"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.height != nil {
+		localVarQueryParams.Add("height", parameterToString(*r.height, ""))
+	}
+	if r.offset != nil {
+		localVarQueryParams.Add("offset", parameterToString(*r.offset, ""))
+	}
+	if r.limit != nil {
+		localVarQueryParams.Add("limit", parameterToString(*r.limit, ""))
+	}
+	if r.chain != nil {
+		localVarQueryParams.Add("chain", parameterToString(*r.chain, ""))
+	}
+	if r.asset != nil {
+		localVarQueryParams.Add("asset", parameterToString(*r.asset, ""))
+	}
+	if r.destination != nil {
+		localVarQueryParams.Add("destination", parameterToString(*r.destination, ""))
+	}
+	if r.vault != nil {
+		localVarQueryParams.Add("vault", parameterToString(*r.vault, ""))
+	}
+	if r.fromHeight != nil {
+		localVarQueryParams.Add("from_height", parameterToString(*r.fromHeight, ""))
+	}
+	if r.toHeight != nil {
+		localVarQueryParams.Add("to_height", parameterToString(*r.toHeight, ""))
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
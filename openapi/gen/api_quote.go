@@ -22,67 +22,60 @@ import (
 // QuoteApiService QuoteApi service
 type QuoteApiService service
 
-type ApiQuotesaverdepositRequest struct {
+type ApiQuotecacaopooldepositRequest struct {
 	ctx context.Context
 	ApiService *QuoteApiService
 	height *int64
-	asset *string
 	amount *int64
 }
 
 // optional block height, defaults to current tip
-func (r ApiQuotesaverdepositRequest) Height(height int64) ApiQuotesaverdepositRequest {
+func (r ApiQuotecacaopooldepositRequest) Height(height int64) ApiQuotecacaopooldepositRequest {
 	r.height = &height
 	return r
 }
 
-// the asset to deposit
-func (r ApiQuotesaverdepositRequest) Asset(asset string) ApiQuotesaverdepositRequest {
-	r.asset = &asset
-	return r
-}
-
-// the source asset amount in 1e8 decimals
-func (r ApiQuotesaverdepositRequest) Amount(amount int64) ApiQuotesaverdepositRequest {
+// the cacao amount in 1e10 decimals
+func (r ApiQuotecacaopooldepositRequest) Amount(amount int64) ApiQuotecacaopooldepositRequest {
 	r.amount = &amount
 	return r
 }
 
-func (r ApiQuotesaverdepositRequest) Execute() (*QuoteSaverDepositResponse, *http.Response, error) {
-	return r.ApiService.QuotesaverdepositExecute(r)
+func (r ApiQuotecacaopooldepositRequest) Execute() (*QuoteCacaoPoolDepositResponse, *http.Response, error) {
+	return r.ApiService.QuotecacaopooldepositExecute(r)
 }
 
 /*
-Quotesaverdeposit Method for Quotesaverdeposit
+Quotecacaopooldeposit Method for Quotecacaopooldeposit
 
-Provide a quote estimate for the provided saver deposit.
+Provide a quote estimate for the provided CACAO pool deposit.
 
  @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
- @return ApiQuotesaverdepositRequest
+ @return ApiQuotecacaopooldepositRequest
 */
-func (a *QuoteApiService) Quotesaverdeposit(ctx context.Context) ApiQuotesaverdepositRequest {
-	return ApiQuotesaverdepositRequest{
+func (a *QuoteApiService) Quotecacaopooldeposit(ctx context.Context) ApiQuotecacaopooldepositRequest {
+	return ApiQuotecacaopooldepositRequest{
 		ApiService: a,
 		ctx: ctx,
 	}
 }
 
 // Execute executes the request
-//  @return QuoteSaverDepositResponse
-func (a *QuoteApiService) QuotesaverdepositExecute(r ApiQuotesaverdepositRequest) (*QuoteSaverDepositResponse, *http.Response, error) {
+//  @return QuoteCacaoPoolDepositResponse
+func (a *QuoteApiService) QuotecacaopooldepositExecute(r ApiQuotecacaopooldepositRequest) (*QuoteCacaoPoolDepositResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod   = http.MethodGet
 		localVarPostBody     interface{}
 		formFiles            []formFile
-		localVarReturnValue  *QuoteSaverDepositResponse
+		localVarReturnValue  *QuoteCacaoPoolDepositResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "QuoteApiService.Quotesaverdeposit")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "QuoteApiService.Quotecacaopooldeposit")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/mayachain/quote/saver/deposit"
+	localVarPath := localBasePath + "/mayachain/quote/cacaopool/deposit"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
@@ -91,9 +84,6 @@ func (a *QuoteApiService) QuotesaverdepositExecute(r ApiQuotesaverdepositRequest
 	if r.height != nil {
 		localVarQueryParams.Add("height", parameterToString(*r.height, ""))
 	}
-	if r.asset != nil {
-		localVarQueryParams.Add("asset", parameterToString(*r.asset, ""))
-	}
 	if r.amount != nil {
 		localVarQueryParams.Add("amount", parameterToString(*r.amount, ""))
 	}
@@ -151,74 +141,67 @@ func (a *QuoteApiService) QuotesaverdepositExecute(r ApiQuotesaverdepositRequest
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiQuotesaverwithdrawRequest struct {
+type ApiQuotecacaopoolwithdrawRequest struct {
 	ctx context.Context
 	ApiService *QuoteApiService
 	height *int64
-	asset *string
 	address *string
 	withdrawBps *int64
 }
 
 // optional block height, defaults to current tip
-func (r ApiQuotesaverwithdrawRequest) Height(height int64) ApiQuotesaverwithdrawRequest {
+func (r ApiQuotecacaopoolwithdrawRequest) Height(height int64) ApiQuotecacaopoolwithdrawRequest {
 	r.height = &height
 	return r
 }
 
-// the asset to withdraw
-func (r ApiQuotesaverwithdrawRequest) Asset(asset string) ApiQuotesaverwithdrawRequest {
-	r.asset = &asset
-	return r
-}
-
-// the address for the position
-func (r ApiQuotesaverwithdrawRequest) Address(address string) ApiQuotesaverwithdrawRequest {
+// the mayachain address of the CACAO pool provider
+func (r ApiQuotecacaopoolwithdrawRequest) Address(address string) ApiQuotecacaopoolwithdrawRequest {
 	r.address = &address
 	return r
 }
 
 // the basis points of the existing position to withdraw
-func (r ApiQuotesaverwithdrawRequest) WithdrawBps(withdrawBps int64) ApiQuotesaverwithdrawRequest {
+func (r ApiQuotecacaopoolwithdrawRequest) WithdrawBps(withdrawBps int64) ApiQuotecacaopoolwithdrawRequest {
 	r.withdrawBps = &withdrawBps
 	return r
 }
 
-func (r ApiQuotesaverwithdrawRequest) Execute() (*QuoteSaverWithdrawResponse, *http.Response, error) {
-	return r.ApiService.QuotesaverwithdrawExecute(r)
+func (r ApiQuotecacaopoolwithdrawRequest) Execute() (*QuoteCacaoPoolWithdrawResponse, *http.Response, error) {
+	return r.ApiService.QuotecacaopoolwithdrawExecute(r)
 }
 
 /*
-Quotesaverwithdraw Method for Quotesaverwithdraw
+Quotecacaopoolwithdraw Method for Quotecacaopoolwithdraw
 
-Provide a quote estimate for the provided saver withdraw.
+Provide a quote estimate for the provided CACAO pool withdraw.
 
  @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
- @return ApiQuotesaverwithdrawRequest
+ @return ApiQuotecacaopoolwithdrawRequest
 */
-func (a *QuoteApiService) Quotesaverwithdraw(ctx context.Context) ApiQuotesaverwithdrawRequest {
-	return ApiQuotesaverwithdrawRequest{
+func (a *QuoteApiService) Quotecacaopoolwithdraw(ctx context.Context) ApiQuotecacaopoolwithdrawRequest {
+	return ApiQuotecacaopoolwithdrawRequest{
 		ApiService: a,
 		ctx: ctx,
 	}
 }
 
 // Execute executes the request
-//  @return QuoteSaverWithdrawResponse
-func (a *QuoteApiService) QuotesaverwithdrawExecute(r ApiQuotesaverwithdrawRequest) (*QuoteSaverWithdrawResponse, *http.Response, error) {
+//  @return QuoteCacaoPoolWithdrawResponse
+func (a *QuoteApiService) QuotecacaopoolwithdrawExecute(r ApiQuotecacaopoolwithdrawRequest) (*QuoteCacaoPoolWithdrawResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod   = http.MethodGet
 		localVarPostBody     interface{}
 		formFiles            []formFile
-		localVarReturnValue  *QuoteSaverWithdrawResponse
+		localVarReturnValue  *QuoteCacaoPoolWithdrawResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "QuoteApiService.Quotesaverwithdraw")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "QuoteApiService.Quotecacaopoolwithdraw")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/mayachain/quote/saver/withdraw"
+	localVarPath := localBasePath + "/mayachain/quote/cacaopool/withdraw"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
@@ -227,9 +210,6 @@ func (a *QuoteApiService) QuotesaverwithdrawExecute(r ApiQuotesaverwithdrawReque
 	if r.height != nil {
 		localVarQueryParams.Add("height", parameterToString(*r.height, ""))
 	}
-	if r.asset != nil {
-		localVarQueryParams.Add("asset", parameterToString(*r.asset, ""))
-	}
 	if r.address != nil {
 		localVarQueryParams.Add("address", parameterToString(*r.address, ""))
 	}
@@ -290,130 +270,240 @@ func (a *QuoteApiService) QuotesaverwithdrawExecute(r ApiQuotesaverwithdrawReque
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiQuoteswapRequest struct {
+type ApiQuoteliquidityaddRequest struct {
 	ctx context.Context
 	ApiService *QuoteApiService
 	height *int64
-	fromAsset *string
-	toAsset *string
-	amount *int64
-	destination *string
-	refundAddress *string
-	streamingInterval *int64
-	streamingQuantity *int64
-	toleranceBps *int64
-	liquidityToleranceBps *int64
-	affiliateBps *string
-	affiliate *string
+	asset *string
+	amountCacao *int64
+	amountAsset *int64
+	cacaoAddress *string
+	assetAddress *string
 }
 
 // optional block height, defaults to current tip
-func (r ApiQuoteswapRequest) Height(height int64) ApiQuoteswapRequest {
+func (r ApiQuoteliquidityaddRequest) Height(height int64) ApiQuoteliquidityaddRequest {
 	r.height = &height
 	return r
 }
 
-// the source asset
-func (r ApiQuoteswapRequest) FromAsset(fromAsset string) ApiQuoteswapRequest {
-	r.fromAsset = &fromAsset
+// the pool asset to add liquidity to
+func (r ApiQuoteliquidityaddRequest) Asset(asset string) ApiQuoteliquidityaddRequest {
+	r.asset = &asset
 	return r
 }
 
-// the target asset
-func (r ApiQuoteswapRequest) ToAsset(toAsset string) ApiQuoteswapRequest {
-	r.toAsset = &toAsset
+// the cacao amount to add in 1e10 decimals
+func (r ApiQuoteliquidityaddRequest) AmountCacao(amountCacao int64) ApiQuoteliquidityaddRequest {
+	r.amountCacao = &amountCacao
 	return r
 }
 
-// the source asset amount in 1e8 decimals
-func (r ApiQuoteswapRequest) Amount(amount int64) ApiQuoteswapRequest {
-	r.amount = &amount
+// the asset amount to add in 1e8 decimals
+func (r ApiQuoteliquidityaddRequest) AmountAsset(amountAsset int64) ApiQuoteliquidityaddRequest {
+	r.amountAsset = &amountAsset
 	return r
 }
 
-// the destination address, required to generate memo
-func (r ApiQuoteswapRequest) Destination(destination string) ApiQuoteswapRequest {
-	r.destination = &destination
+// the cacao address for the position, paired into the asset memo
+func (r ApiQuoteliquidityaddRequest) CacaoAddress(cacaoAddress string) ApiQuoteliquidityaddRequest {
+	r.cacaoAddress = &cacaoAddress
 	return r
 }
 
-// the refund address, refunds will be sent here if the swap fails
-func (r ApiQuoteswapRequest) RefundAddress(refundAddress string) ApiQuoteswapRequest {
-	r.refundAddress = &refundAddress
+// the asset address for the position, paired into the cacao memo
+func (r ApiQuoteliquidityaddRequest) AssetAddress(assetAddress string) ApiQuoteliquidityaddRequest {
+	r.assetAddress = &assetAddress
 	return r
 }
 
-// the interval in which streaming swaps are swapped
-func (r ApiQuoteswapRequest) StreamingInterval(streamingInterval int64) ApiQuoteswapRequest {
-	r.streamingInterval = &streamingInterval
-	return r
+func (r ApiQuoteliquidityaddRequest) Execute() (*QuoteLiquidityAddResponse, *http.Response, error) {
+	return r.ApiService.QuoteliquidityaddExecute(r)
 }
 
-// the quantity of swaps within a streaming swap
-func (r ApiQuoteswapRequest) StreamingQuantity(streamingQuantity int64) ApiQuoteswapRequest {
-	r.streamingQuantity = &streamingQuantity
+/*
+Quoteliquidityadd Method for Quoteliquidityadd
+
+Provide a quote estimate for the provided liquidity add.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiQuoteliquidityaddRequest
+*/
+func (a *QuoteApiService) Quoteliquidityadd(ctx context.Context) ApiQuoteliquidityaddRequest {
+	return ApiQuoteliquidityaddRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return QuoteLiquidityAddResponse
+func (a *QuoteApiService) QuoteliquidityaddExecute(r ApiQuoteliquidityaddRequest) (*QuoteLiquidityAddResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *QuoteLiquidityAddResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "QuoteApiService.Quoteliquidityadd")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/mayachain/quote/liquidity/add"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.height != nil {
+		localVarQueryParams.Add("height", parameterToString(*r.height, ""))
+	}
+	if r.asset != nil {
+		localVarQueryParams.Add("asset", parameterToString(*r.asset, ""))
+	}
+	if r.amountCacao != nil {
+		localVarQueryParams.Add("amount_cacao", parameterToString(*r.amountCacao, ""))
+	}
+	if r.amountAsset != nil {
+		localVarQueryParams.Add("amount_asset", parameterToString(*r.amountAsset, ""))
+	}
+	if r.cacaoAddress != nil {
+		localVarQueryParams.Add("cacao_address", parameterToString(*r.cacaoAddress, ""))
+	}
+	if r.assetAddress != nil {
+		localVarQueryParams.Add("asset_address", parameterToString(*r.assetAddress, ""))
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiQuoteliquiditywithdrawRequest struct {
+	ctx context.Context
+	ApiService *QuoteApiService
+	height *int64
+	asset *string
+	address *string
+	withdrawBps *int64
+	withdrawalAsset *string
+}
+
+// optional block height, defaults to current tip
+func (r ApiQuoteliquiditywithdrawRequest) Height(height int64) ApiQuoteliquiditywithdrawRequest {
+	r.height = &height
 	return r
 }
 
-// the maximum basis points from the current feeless swap price to set the limit in the generated memo
-func (r ApiQuoteswapRequest) ToleranceBps(toleranceBps int64) ApiQuoteswapRequest {
-	r.toleranceBps = &toleranceBps
+// the pool asset to withdraw liquidity from
+func (r ApiQuoteliquiditywithdrawRequest) Asset(asset string) ApiQuoteliquiditywithdrawRequest {
+	r.asset = &asset
 	return r
 }
 
-// the maximum basis points of tolerance for pool price movements to set the limit in the generated memo
-func (r ApiQuoteswapRequest) LiquidityToleranceBps(liquidityToleranceBps int64) ApiQuoteswapRequest {
-	r.liquidityToleranceBps = &liquidityToleranceBps
+// the address for the position
+func (r ApiQuoteliquiditywithdrawRequest) Address(address string) ApiQuoteliquiditywithdrawRequest {
+	r.address = &address
 	return r
 }
 
-// the affiliate fee in basis points; use \&quot;/\&quot; to separate multiple values
-func (r ApiQuoteswapRequest) AffiliateBps(affiliateBps string) ApiQuoteswapRequest {
-	r.affiliateBps = &affiliateBps
+// the basis points of the existing position to withdraw
+func (r ApiQuoteliquiditywithdrawRequest) WithdrawBps(withdrawBps int64) ApiQuoteliquiditywithdrawRequest {
+	r.withdrawBps = &withdrawBps
 	return r
 }
 
-// the affiliate (address or mayaname); use \&quot;/\&quot; to separate multiple values
-func (r ApiQuoteswapRequest) Affiliate(affiliate string) ApiQuoteswapRequest {
-	r.affiliate = &affiliate
+// the asset to withdraw for an asymmetric withdraw (cacao or the pool asset)
+func (r ApiQuoteliquiditywithdrawRequest) WithdrawalAsset(withdrawalAsset string) ApiQuoteliquiditywithdrawRequest {
+	r.withdrawalAsset = &withdrawalAsset
 	return r
 }
 
-func (r ApiQuoteswapRequest) Execute() (*QuoteSwapResponse, *http.Response, error) {
-	return r.ApiService.QuoteswapExecute(r)
+func (r ApiQuoteliquiditywithdrawRequest) Execute() (*QuoteLiquidityWithdrawResponse, *http.Response, error) {
+	return r.ApiService.QuoteliquiditywithdrawExecute(r)
 }
 
 /*
-Quoteswap Method for Quoteswap
+Quoteliquiditywithdraw Method for Quoteliquiditywithdraw
 
-Provide a quote estimate for the provided swap.
+Provide a quote estimate for the provided liquidity withdraw.
 
  @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
- @return ApiQuoteswapRequest
+ @return ApiQuoteliquiditywithdrawRequest
 */
-func (a *QuoteApiService) Quoteswap(ctx context.Context) ApiQuoteswapRequest {
-	return ApiQuoteswapRequest{
+func (a *QuoteApiService) Quoteliquiditywithdraw(ctx context.Context) ApiQuoteliquiditywithdrawRequest {
+	return ApiQuoteliquiditywithdrawRequest{
 		ApiService: a,
 		ctx: ctx,
 	}
 }
 
 // Execute executes the request
-//  @return QuoteSwapResponse
-func (a *QuoteApiService) QuoteswapExecute(r ApiQuoteswapRequest) (*QuoteSwapResponse, *http.Response, error) {
+//  @return QuoteLiquidityWithdrawResponse
+func (a *QuoteApiService) QuoteliquiditywithdrawExecute(r ApiQuoteliquiditywithdrawRequest) (*QuoteLiquidityWithdrawResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod   = http.MethodGet
 		localVarPostBody     interface{}
 		formFiles            []formFile
-		localVarReturnValue  *QuoteSwapResponse
+		localVarReturnValue  *QuoteLiquidityWithdrawResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "QuoteApiService.Quoteswap")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "QuoteApiService.Quoteliquiditywithdraw")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/mayachain/quote/swap"
+	localVarPath := localBasePath + "/mayachain/quote/liquidity/withdraw"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
@@ -422,32 +512,697 @@ func (a *QuoteApiService) QuoteswapExecute(r ApiQuoteswapRequest) (*QuoteSwapRes
 	if r.height != nil {
 		localVarQueryParams.Add("height", parameterToString(*r.height, ""))
 	}
-	if r.fromAsset != nil {
-		localVarQueryParams.Add("from_asset", parameterToString(*r.fromAsset, ""))
-	}
-	if r.toAsset != nil {
-		localVarQueryParams.Add("to_asset", parameterToString(*r.toAsset, ""))
+	if r.asset != nil {
+		localVarQueryParams.Add("asset", parameterToString(*r.asset, ""))
 	}
-	if r.amount != nil {
-		localVarQueryParams.Add("amount", parameterToString(*r.amount, ""))
+	if r.address != nil {
+		localVarQueryParams.Add("address", parameterToString(*r.address, ""))
 	}
-	if r.destination != nil {
-		localVarQueryParams.Add("destination", parameterToString(*r.destination, ""))
+	if r.withdrawBps != nil {
+		localVarQueryParams.Add("withdraw_bps", parameterToString(*r.withdrawBps, ""))
 	}
-	if r.refundAddress != nil {
-		localVarQueryParams.Add("refund_address", parameterToString(*r.refundAddress, ""))
+	if r.withdrawalAsset != nil {
+		localVarQueryParams.Add("withdrawal_asset", parameterToString(*r.withdrawalAsset, ""))
 	}
-	if r.streamingInterval != nil {
-		localVarQueryParams.Add("streaming_interval", parameterToString(*r.streamingInterval, ""))
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
 	}
-	if r.streamingQuantity != nil {
-		localVarQueryParams.Add("streaming_quantity", parameterToString(*r.streamingQuantity, ""))
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	if r.toleranceBps != nil {
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiQuotesaverdepositRequest struct {
+	ctx context.Context
+	ApiService *QuoteApiService
+	height *int64
+	asset *string
+	amount *int64
+}
+
+// optional block height, defaults to current tip
+func (r ApiQuotesaverdepositRequest) Height(height int64) ApiQuotesaverdepositRequest {
+	r.height = &height
+	return r
+}
+
+// the asset to deposit
+func (r ApiQuotesaverdepositRequest) Asset(asset string) ApiQuotesaverdepositRequest {
+	r.asset = &asset
+	return r
+}
+
+// the source asset amount in 1e8 decimals
+func (r ApiQuotesaverdepositRequest) Amount(amount int64) ApiQuotesaverdepositRequest {
+	r.amount = &amount
+	return r
+}
+
+func (r ApiQuotesaverdepositRequest) Execute() (*QuoteSaverDepositResponse, *http.Response, error) {
+	return r.ApiService.QuotesaverdepositExecute(r)
+}
+
+/*
+Quotesaverdeposit Method for Quotesaverdeposit
+
+Provide a quote estimate for the provided saver deposit.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiQuotesaverdepositRequest
+*/
+func (a *QuoteApiService) Quotesaverdeposit(ctx context.Context) ApiQuotesaverdepositRequest {
+	return ApiQuotesaverdepositRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return QuoteSaverDepositResponse
+func (a *QuoteApiService) QuotesaverdepositExecute(r ApiQuotesaverdepositRequest) (*QuoteSaverDepositResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *QuoteSaverDepositResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "QuoteApiService.Quotesaverdeposit")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/mayachain/quote/saver/deposit"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.height != nil {
+		localVarQueryParams.Add("height", parameterToString(*r.height, ""))
+	}
+	if r.asset != nil {
+		localVarQueryParams.Add("asset", parameterToString(*r.asset, ""))
+	}
+	if r.amount != nil {
+		localVarQueryParams.Add("amount", parameterToString(*r.amount, ""))
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiQuotesaverwithdrawRequest struct {
+	ctx context.Context
+	ApiService *QuoteApiService
+	height *int64
+	asset *string
+	address *string
+	withdrawBps *int64
+}
+
+// optional block height, defaults to current tip
+func (r ApiQuotesaverwithdrawRequest) Height(height int64) ApiQuotesaverwithdrawRequest {
+	r.height = &height
+	return r
+}
+
+// the asset to withdraw
+func (r ApiQuotesaverwithdrawRequest) Asset(asset string) ApiQuotesaverwithdrawRequest {
+	r.asset = &asset
+	return r
+}
+
+// the address for the position
+func (r ApiQuotesaverwithdrawRequest) Address(address string) ApiQuotesaverwithdrawRequest {
+	r.address = &address
+	return r
+}
+
+// the basis points of the existing position to withdraw
+func (r ApiQuotesaverwithdrawRequest) WithdrawBps(withdrawBps int64) ApiQuotesaverwithdrawRequest {
+	r.withdrawBps = &withdrawBps
+	return r
+}
+
+func (r ApiQuotesaverwithdrawRequest) Execute() (*QuoteSaverWithdrawResponse, *http.Response, error) {
+	return r.ApiService.QuotesaverwithdrawExecute(r)
+}
+
+/*
+Quotesaverwithdraw Method for Quotesaverwithdraw
+
+Provide a quote estimate for the provided saver withdraw.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiQuotesaverwithdrawRequest
+*/
+func (a *QuoteApiService) Quotesaverwithdraw(ctx context.Context) ApiQuotesaverwithdrawRequest {
+	return ApiQuotesaverwithdrawRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return QuoteSaverWithdrawResponse
+func (a *QuoteApiService) QuotesaverwithdrawExecute(r ApiQuotesaverwithdrawRequest) (*QuoteSaverWithdrawResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *QuoteSaverWithdrawResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "QuoteApiService.Quotesaverwithdraw")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/mayachain/quote/saver/withdraw"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.height != nil {
+		localVarQueryParams.Add("height", parameterToString(*r.height, ""))
+	}
+	if r.asset != nil {
+		localVarQueryParams.Add("asset", parameterToString(*r.asset, ""))
+	}
+	if r.address != nil {
+		localVarQueryParams.Add("address", parameterToString(*r.address, ""))
+	}
+	if r.withdrawBps != nil {
+		localVarQueryParams.Add("withdraw_bps", parameterToString(*r.withdrawBps, ""))
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiQuoteswapRequest struct {
+	ctx context.Context
+	ApiService *QuoteApiService
+	height *int64
+	fromAsset *string
+	toAsset *string
+	amount *int64
+	destination *string
+	refundAddress *string
+	streamingInterval *int64
+	streamingQuantity *int64
+	toleranceBps *int64
+	liquidityToleranceBps *int64
+	affiliateBps *string
+	affiliate *string
+}
+
+// optional block height, defaults to current tip
+func (r ApiQuoteswapRequest) Height(height int64) ApiQuoteswapRequest {
+	r.height = &height
+	return r
+}
+
+// the source asset
+func (r ApiQuoteswapRequest) FromAsset(fromAsset string) ApiQuoteswapRequest {
+	r.fromAsset = &fromAsset
+	return r
+}
+
+// the target asset
+func (r ApiQuoteswapRequest) ToAsset(toAsset string) ApiQuoteswapRequest {
+	r.toAsset = &toAsset
+	return r
+}
+
+// the source asset amount in 1e8 decimals
+func (r ApiQuoteswapRequest) Amount(amount int64) ApiQuoteswapRequest {
+	r.amount = &amount
+	return r
+}
+
+// the destination address, required to generate memo
+func (r ApiQuoteswapRequest) Destination(destination string) ApiQuoteswapRequest {
+	r.destination = &destination
+	return r
+}
+
+// the refund address, refunds will be sent here if the swap fails
+func (r ApiQuoteswapRequest) RefundAddress(refundAddress string) ApiQuoteswapRequest {
+	r.refundAddress = &refundAddress
+	return r
+}
+
+// the interval in which streaming swaps are swapped
+func (r ApiQuoteswapRequest) StreamingInterval(streamingInterval int64) ApiQuoteswapRequest {
+	r.streamingInterval = &streamingInterval
+	return r
+}
+
+// the quantity of swaps within a streaming swap
+func (r ApiQuoteswapRequest) StreamingQuantity(streamingQuantity int64) ApiQuoteswapRequest {
+	r.streamingQuantity = &streamingQuantity
+	return r
+}
+
+// the maximum basis points from the current feeless swap price to set the limit in the generated memo
+func (r ApiQuoteswapRequest) ToleranceBps(toleranceBps int64) ApiQuoteswapRequest {
+	r.toleranceBps = &toleranceBps
+	return r
+}
+
+// the maximum basis points of tolerance for pool price movements to set the limit in the generated memo
+func (r ApiQuoteswapRequest) LiquidityToleranceBps(liquidityToleranceBps int64) ApiQuoteswapRequest {
+	r.liquidityToleranceBps = &liquidityToleranceBps
+	return r
+}
+
+// the affiliate fee in basis points; use \&quot;/\&quot; to separate multiple values
+func (r ApiQuoteswapRequest) AffiliateBps(affiliateBps string) ApiQuoteswapRequest {
+	r.affiliateBps = &affiliateBps
+	return r
+}
+
+// the affiliate (address or mayaname); use \&quot;/\&quot; to separate multiple values
+func (r ApiQuoteswapRequest) Affiliate(affiliate string) ApiQuoteswapRequest {
+	r.affiliate = &affiliate
+	return r
+}
+
+func (r ApiQuoteswapRequest) Execute() (*QuoteSwapResponse, *http.Response, error) {
+	return r.ApiService.QuoteswapExecute(r)
+}
+
+/*
+Quoteswap Method for Quoteswap
+
+Provide a quote estimate for the provided swap.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiQuoteswapRequest
+*/
+func (a *QuoteApiService) Quoteswap(ctx context.Context) ApiQuoteswapRequest {
+	return ApiQuoteswapRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return QuoteSwapResponse
+func (a *QuoteApiService) QuoteswapExecute(r ApiQuoteswapRequest) (*QuoteSwapResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *QuoteSwapResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "QuoteApiService.Quoteswap")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/mayachain/quote/swap"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.height != nil {
+		localVarQueryParams.Add("height", parameterToString(*r.height, ""))
+	}
+	if r.fromAsset != nil {
+		localVarQueryParams.Add("from_asset", parameterToString(*r.fromAsset, ""))
+	}
+	if r.toAsset != nil {
+		localVarQueryParams.Add("to_asset", parameterToString(*r.toAsset, ""))
+	}
+	if r.amount != nil {
+		localVarQueryParams.Add("amount", parameterToString(*r.amount, ""))
+	}
+	if r.destination != nil {
+		localVarQueryParams.Add("destination", parameterToString(*r.destination, ""))
+	}
+	if r.refundAddress != nil {
+		localVarQueryParams.Add("refund_address", parameterToString(*r.refundAddress, ""))
+	}
+	if r.streamingInterval != nil {
+		localVarQueryParams.Add("streaming_interval", parameterToString(*r.streamingInterval, ""))
+	}
+	if r.streamingQuantity != nil {
+		localVarQueryParams.Add("streaming_quantity", parameterToString(*r.streamingQuantity, ""))
+	}
+	if r.toleranceBps != nil {
 		localVarQueryParams.Add("tolerance_bps", parameterToString(*r.toleranceBps, ""))
 	}
-	if r.liquidityToleranceBps != nil {
-		localVarQueryParams.Add("liquidity_tolerance_bps", parameterToString(*r.liquidityToleranceBps, ""))
+	if r.liquidityToleranceBps != nil {
+		localVarQueryParams.Add("liquidity_tolerance_bps", parameterToString(*r.liquidityToleranceBps, ""))
+	}
+	if r.affiliateBps != nil {
+		localVarQueryParams.Add("affiliate_bps", parameterToString(*r.affiliateBps, ""))
+	}
+	if r.affiliate != nil {
+		localVarQueryParams.Add("affiliate", parameterToString(*r.affiliate, ""))
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiQuoteswaproutesRequest struct {
+	ctx context.Context
+	ApiService *QuoteApiService
+	height *int64
+	fromAsset *string
+	toAsset *string
+	amount *int64
+	destination *string
+	refundAddress *string
+	streamingInterval *int64
+	toleranceBps *int64
+	affiliateBps *string
+	affiliate *string
+}
+
+// optional block height, defaults to current tip
+func (r ApiQuoteswaproutesRequest) Height(height int64) ApiQuoteswaproutesRequest {
+	r.height = &height
+	return r
+}
+
+// the source asset
+func (r ApiQuoteswaproutesRequest) FromAsset(fromAsset string) ApiQuoteswaproutesRequest {
+	r.fromAsset = &fromAsset
+	return r
+}
+
+// the target asset, layer1, trade and synth variants are compared
+func (r ApiQuoteswaproutesRequest) ToAsset(toAsset string) ApiQuoteswaproutesRequest {
+	r.toAsset = &toAsset
+	return r
+}
+
+// the source asset amount in 1e8 decimals
+func (r ApiQuoteswaproutesRequest) Amount(amount int64) ApiQuoteswaproutesRequest {
+	r.amount = &amount
+	return r
+}
+
+// the destination address, memos are only generated for routes the address can receive
+func (r ApiQuoteswaproutesRequest) Destination(destination string) ApiQuoteswaproutesRequest {
+	r.destination = &destination
+	return r
+}
+
+// the refund address, refunds will be sent here if the swap fails
+func (r ApiQuoteswaproutesRequest) RefundAddress(refundAddress string) ApiQuoteswaproutesRequest {
+	r.refundAddress = &refundAddress
+	return r
+}
+
+// the interval in which streaming swaps are swapped, defaults to 1
+func (r ApiQuoteswaproutesRequest) StreamingInterval(streamingInterval int64) ApiQuoteswaproutesRequest {
+	r.streamingInterval = &streamingInterval
+	return r
+}
+
+// the maximum basis points from the current feeless swap price to set the limit in the generated memo
+func (r ApiQuoteswaproutesRequest) ToleranceBps(toleranceBps int64) ApiQuoteswaproutesRequest {
+	r.toleranceBps = &toleranceBps
+	return r
+}
+
+// the affiliate fee in basis points; use \&quot;/\&quot; to separate multiple values
+func (r ApiQuoteswaproutesRequest) AffiliateBps(affiliateBps string) ApiQuoteswaproutesRequest {
+	r.affiliateBps = &affiliateBps
+	return r
+}
+
+// the affiliate (address or mayaname); use \&quot;/\&quot; to separate multiple values
+func (r ApiQuoteswaproutesRequest) Affiliate(affiliate string) ApiQuoteswaproutesRequest {
+	r.affiliate = &affiliate
+	return r
+}
+
+func (r ApiQuoteswaproutesRequest) Execute() (*QuoteSwapRoutesResponse, *http.Response, error) {
+	return r.ApiService.QuoteswaproutesExecute(r)
+}
+
+/*
+Quoteswaproutes Method for Quoteswaproutes
+
+Provide ranked swap quotes across the layer1, trade and synth variants of the target asset, each with the streaming quantity that best fits the swap size.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiQuoteswaproutesRequest
+*/
+func (a *QuoteApiService) Quoteswaproutes(ctx context.Context) ApiQuoteswaproutesRequest {
+	return ApiQuoteswaproutesRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return QuoteSwapRoutesResponse
+func (a *QuoteApiService) QuoteswaproutesExecute(r ApiQuoteswaproutesRequest) (*QuoteSwapRoutesResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *QuoteSwapRoutesResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "QuoteApiService.Quoteswaproutes")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/mayachain/quote/swap/routes"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.height != nil {
+		localVarQueryParams.Add("height", parameterToString(*r.height, ""))
+	}
+	if r.fromAsset != nil {
+		localVarQueryParams.Add("from_asset", parameterToString(*r.fromAsset, ""))
+	}
+	if r.toAsset != nil {
+		localVarQueryParams.Add("to_asset", parameterToString(*r.toAsset, ""))
+	}
+	if r.amount != nil {
+		localVarQueryParams.Add("amount", parameterToString(*r.amount, ""))
+	}
+	if r.destination != nil {
+		localVarQueryParams.Add("destination", parameterToString(*r.destination, ""))
+	}
+	if r.refundAddress != nil {
+		localVarQueryParams.Add("refund_address", parameterToString(*r.refundAddress, ""))
+	}
+	if r.streamingInterval != nil {
+		localVarQueryParams.Add("streaming_interval", parameterToString(*r.streamingInterval, ""))
+	}
+	if r.toleranceBps != nil {
+		localVarQueryParams.Add("tolerance_bps", parameterToString(*r.toleranceBps, ""))
 	}
 	if r.affiliateBps != nil {
 		localVarQueryParams.Add("affiliate_bps", parameterToString(*r.affiliateBps, ""))
@@ -508,3 +1263,281 @@ func (a *QuoteApiService) QuoteswapExecute(r ApiQuoteswapRequest) (*QuoteSwapRes
 
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
+
+type ApiQuotetradedepositRequest struct {
+	ctx context.Context
+	ApiService *QuoteApiService
+	height *int64
+	asset *string
+	amount *int64
+	address *string
+}
+
+// optional block height, defaults to current tip
+func (r ApiQuotetradedepositRequest) Height(height int64) ApiQuotetradedepositRequest {
+	r.height = &height
+	return r
+}
+
+// the layer 1 asset to deposit into the trade account
+func (r ApiQuotetradedepositRequest) Asset(asset string) ApiQuotetradedepositRequest {
+	r.asset = &asset
+	return r
+}
+
+// the asset amount in 1e8 decimals
+func (r ApiQuotetradedepositRequest) Amount(amount int64) ApiQuotetradedepositRequest {
+	r.amount = &amount
+	return r
+}
+
+// the mayachain address owning the trade account
+func (r ApiQuotetradedepositRequest) Address(address string) ApiQuotetradedepositRequest {
+	r.address = &address
+	return r
+}
+
+func (r ApiQuotetradedepositRequest) Execute() (*QuoteTradeDepositResponse, *http.Response, error) {
+	return r.ApiService.QuotetradedepositExecute(r)
+}
+
+/*
+Quotetradedeposit Method for Quotetradedeposit
+
+Provide a quote estimate for the provided trade account deposit.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiQuotetradedepositRequest
+*/
+func (a *QuoteApiService) Quotetradedeposit(ctx context.Context) ApiQuotetradedepositRequest {
+	return ApiQuotetradedepositRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return QuoteTradeDepositResponse
+func (a *QuoteApiService) QuotetradedepositExecute(r ApiQuotetradedepositRequest) (*QuoteTradeDepositResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *QuoteTradeDepositResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "QuoteApiService.Quotetradedeposit")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/mayachain/quote/trade/deposit"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.height != nil {
+		localVarQueryParams.Add("height", parameterToString(*r.height, ""))
+	}
+	if r.asset != nil {
+		localVarQueryParams.Add("asset", parameterToString(*r.asset, ""))
+	}
+	if r.amount != nil {
+		localVarQueryParams.Add("amount", parameterToString(*r.amount, ""))
+	}
+	if r.address != nil {
+		localVarQueryParams.Add("address", parameterToString(*r.address, ""))
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiQuotetradewithdrawRequest struct {
+	ctx context.Context
+	ApiService *QuoteApiService
+	height *int64
+	asset *string
+	amount *int64
+	address *string
+}
+
+// optional block height, defaults to current tip
+func (r ApiQuotetradewithdrawRequest) Height(height int64) ApiQuotetradewithdrawRequest {
+	r.height = &height
+	return r
+}
+
+// the trade asset to withdraw
+func (r ApiQuotetradewithdrawRequest) Asset(asset string) ApiQuotetradewithdrawRequest {
+	r.asset = &asset
+	return r
+}
+
+// the trade asset amount in 1e8 decimals
+func (r ApiQuotetradewithdrawRequest) Amount(amount int64) ApiQuotetradewithdrawRequest {
+	r.amount = &amount
+	return r
+}
+
+// the layer 1 address to receive the withdraw
+func (r ApiQuotetradewithdrawRequest) Address(address string) ApiQuotetradewithdrawRequest {
+	r.address = &address
+	return r
+}
+
+func (r ApiQuotetradewithdrawRequest) Execute() (*QuoteTradeWithdrawResponse, *http.Response, error) {
+	return r.ApiService.QuotetradewithdrawExecute(r)
+}
+
+/*
+Quotetradewithdraw Method for Quotetradewithdraw
+
+Provide a quote estimate for the provided trade account withdraw.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiQuotetradewithdrawRequest
+*/
+func (a *QuoteApiService) Quotetradewithdraw(ctx context.Context) ApiQuotetradewithdrawRequest {
+	return ApiQuotetradewithdrawRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return QuoteTradeWithdrawResponse
+func (a *QuoteApiService) QuotetradewithdrawExecute(r ApiQuotetradewithdrawRequest) (*QuoteTradeWithdrawResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *QuoteTradeWithdrawResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "QuoteApiService.Quotetradewithdraw")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/mayachain/quote/trade/withdraw"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.height != nil {
+		localVarQueryParams.Add("height", parameterToString(*r.height, ""))
+	}
+	if r.asset != nil {
+		localVarQueryParams.Add("asset", parameterToString(*r.asset, ""))
+	}
+	if r.amount != nil {
+		localVarQueryParams.Add("amount", parameterToString(*r.amount, ""))
+	}
+	if r.address != nil {
+		localVarQueryParams.Add("address", parameterToString(*r.address, ""))
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
@@ -245,6 +245,119 @@ func (a *VaultsApiService) VaultExecute(r ApiVaultRequest) (*Vault, *http.Respon
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiVaultMigrationRequest struct {
+	ctx context.Context
+	ApiService *VaultsApiService
+	pubkey string
+	height *int64
+}
+
+// optional block height, defaults to current tip
+func (r ApiVaultMigrationRequest) Height(height int64) ApiVaultMigrationRequest {
+	r.height = &height
+	return r
+}
+
+func (r ApiVaultMigrationRequest) Execute() (*VaultMigration, *http.Response, error) {
+	return r.ApiService.VaultMigrationExecute(r)
+}
+
+/*
+VaultMigration Method for VaultMigration
+
+Returns the migration progress of the retiring vault for the provided pubkey.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @param pubkey
+ @return ApiVaultMigrationRequest
+*/
+func (a *VaultsApiService) VaultMigration(ctx context.Context, pubkey string) ApiVaultMigrationRequest {
+	return ApiVaultMigrationRequest{
+		ApiService: a,
+		ctx: ctx,
+		pubkey: pubkey,
+	}
+}
+
+// Execute executes the request
+//  @return VaultMigration
+func (a *VaultsApiService) VaultMigrationExecute(r ApiVaultMigrationRequest) (*VaultMigration, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *VaultMigration
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "VaultsApiService.VaultMigration")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/mayachain/vault/{pubkey}/migration"
+	localVarPath = strings.Replace(localVarPath, "{"+"pubkey"+"}", url.PathEscape(parameterToString(r.pubkey, "")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.height != nil {
+		localVarQueryParams.Add("height", parameterToString(*r.height, ""))
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiVaultPubkeysRequest struct {
 	ctx context.Context
 	ApiService *VaultsApiService
@@ -354,6 +467,123 @@ func (a *VaultsApiService) VaultPubkeysExecute(r ApiVaultPubkeysRequest) (*Vault
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiVaultSolvencyRequest struct {
+	ctx context.Context
+	ApiService *VaultsApiService
+	pubkey string
+	chain string
+	height *int64
+}
+
+// optional block height, defaults to current tip
+func (r ApiVaultSolvencyRequest) Height(height int64) ApiVaultSolvencyRequest {
+	r.height = &height
+	return r
+}
+
+func (r ApiVaultSolvencyRequest) Execute() (*SolvencyHistory, *http.Response, error) {
+	return r.ApiService.VaultSolvencyExecute(r)
+}
+
+/*
+VaultSolvency Method for VaultSolvency
+
+Returns the solvency reported for the vault with the provided pubkey on the provided chain.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @param pubkey
+ @param chain
+ @return ApiVaultSolvencyRequest
+*/
+func (a *VaultsApiService) VaultSolvency(ctx context.Context, pubkey string, chain string) ApiVaultSolvencyRequest {
+	return ApiVaultSolvencyRequest{
+		ApiService: a,
+		ctx: ctx,
+		pubkey: pubkey,
+		chain: chain,
+	}
+}
+
+// Execute executes the request
+//  @return SolvencyHistory
+func (a *VaultsApiService) VaultSolvencyExecute(r ApiVaultSolvencyRequest) (*SolvencyHistory, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *SolvencyHistory
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "VaultsApiService.VaultSolvency")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/mayachain/vault/{pubkey}/solvency/{chain}"
+	localVarPath = strings.Replace(localVarPath, "{"+"pubkey"+"}", url.PathEscape(parameterToString(r.pubkey, "")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"chain"+"}", url.PathEscape(parameterToString(r.chain, "")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.height != nil {
+		localVarQueryParams.Add("height", parameterToString(*r.height, ""))
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiYggdrasilRequest struct {
 	ctx context.Context
 	ApiService *VaultsApiService
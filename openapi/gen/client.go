@@ -48,16 +48,22 @@ type APIClient struct {
 
 	// API Services
 
+	AffiliatesApi *AffiliatesApiService
+
 	BlockApi *BlockApiService
 
 	CACAOPoolApi *CACAOPoolApiService
 
+	DCAApi *DCAApiService
+
 	HealthApi *HealthApiService
 
 	InvariantsApi *InvariantsApiService
 
 	LiquidityProvidersApi *LiquidityProvidersApiService
 
+	LoansApi *LoansApiService
+
 	MayanamesApi *MayanamesApiService
 
 	MimirApi *MimirApiService
@@ -66,10 +72,14 @@ type APIClient struct {
 
 	NodesApi *NodesApiService
 
+	OrderBookApi *OrderBookApiService
+
 	POLApi *POLApiService
 
 	PoolsApi *PoolsApiService
 
+	PortfolioApi *PortfolioApiService
+
 	QueueApi *QueueApiService
 
 	QuoteApi *QuoteApiService
@@ -109,17 +119,22 @@ func NewAPIClient(cfg *Configuration) *APIClient {
 	c.common.client = c
 
 	// API Services
+	c.AffiliatesApi = (*AffiliatesApiService)(&c.common)
 	c.BlockApi = (*BlockApiService)(&c.common)
 	c.CACAOPoolApi = (*CACAOPoolApiService)(&c.common)
+	c.DCAApi = (*DCAApiService)(&c.common)
 	c.HealthApi = (*HealthApiService)(&c.common)
 	c.InvariantsApi = (*InvariantsApiService)(&c.common)
 	c.LiquidityProvidersApi = (*LiquidityProvidersApiService)(&c.common)
+	c.LoansApi = (*LoansApiService)(&c.common)
 	c.MayanamesApi = (*MayanamesApiService)(&c.common)
 	c.MimirApi = (*MimirApiService)(&c.common)
 	c.NetworkApi = (*NetworkApiService)(&c.common)
 	c.NodesApi = (*NodesApiService)(&c.common)
+	c.OrderBookApi = (*OrderBookApiService)(&c.common)
 	c.POLApi = (*POLApiService)(&c.common)
 	c.PoolsApi = (*PoolsApiService)(&c.common)
+	c.PortfolioApi = (*PortfolioApiService)(&c.common)
 	c.QueueApi = (*QueueApiService)(&c.common)
 	c.QuoteApi = (*QuoteApiService)(&c.common)
 	c.SaversApi = (*SaversApiService)(&c.common)
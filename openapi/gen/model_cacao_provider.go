@@ -24,6 +24,14 @@ type CACAOProvider struct {
 	WithdrawAmount string `json:"withdraw_amount"`
 	LastDepositHeight int64 `json:"last_deposit_height"`
 	LastWithdrawHeight int64 `json:"last_withdraw_height"`
+	// the basis points of the withdraw queued until the last deposit reaches maturity
+	QueuedWithdrawBasisPoints *int64 `json:"queued_withdraw_basis_points,omitempty"`
+	// the height the queued withdraw is executed at
+	QueuedWithdrawHeight *int64 `json:"queued_withdraw_height,omitempty"`
+	// whether the yield of the provider is re-deposited every auto-compound interval
+	AutoCompound *bool `json:"auto_compound,omitempty"`
+	// the total yield re-deposited by auto-compounding
+	CompoundedAmount *string `json:"compounded_amount,omitempty"`
 }
 
 // NewCACAOProvider instantiates a new CACAOProvider object
@@ -243,6 +251,134 @@ func (o *CACAOProvider) SetLastWithdrawHeight(v int64) {
 	o.LastWithdrawHeight = v
 }
 
+// GetQueuedWithdrawBasisPoints returns the QueuedWithdrawBasisPoints field value if set, zero value otherwise.
+func (o *CACAOProvider) GetQueuedWithdrawBasisPoints() int64 {
+	if o == nil || o.QueuedWithdrawBasisPoints == nil {
+		var ret int64
+		return ret
+	}
+	return *o.QueuedWithdrawBasisPoints
+}
+
+// GetQueuedWithdrawBasisPointsOk returns a tuple with the QueuedWithdrawBasisPoints field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CACAOProvider) GetQueuedWithdrawBasisPointsOk() (*int64, bool) {
+	if o == nil || o.QueuedWithdrawBasisPoints == nil {
+		return nil, false
+	}
+	return o.QueuedWithdrawBasisPoints, true
+}
+
+// HasQueuedWithdrawBasisPoints returns a boolean if a field has been set.
+func (o *CACAOProvider) HasQueuedWithdrawBasisPoints() bool {
+	if o != nil && o.QueuedWithdrawBasisPoints != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetQueuedWithdrawBasisPoints gets a reference to the given int64 and assigns it to the QueuedWithdrawBasisPoints field.
+func (o *CACAOProvider) SetQueuedWithdrawBasisPoints(v int64) {
+	o.QueuedWithdrawBasisPoints = &v
+}
+
+// GetQueuedWithdrawHeight returns the QueuedWithdrawHeight field value if set, zero value otherwise.
+func (o *CACAOProvider) GetQueuedWithdrawHeight() int64 {
+	if o == nil || o.QueuedWithdrawHeight == nil {
+		var ret int64
+		return ret
+	}
+	return *o.QueuedWithdrawHeight
+}
+
+// GetQueuedWithdrawHeightOk returns a tuple with the QueuedWithdrawHeight field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CACAOProvider) GetQueuedWithdrawHeightOk() (*int64, bool) {
+	if o == nil || o.QueuedWithdrawHeight == nil {
+		return nil, false
+	}
+	return o.QueuedWithdrawHeight, true
+}
+
+// HasQueuedWithdrawHeight returns a boolean if a field has been set.
+func (o *CACAOProvider) HasQueuedWithdrawHeight() bool {
+	if o != nil && o.QueuedWithdrawHeight != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetQueuedWithdrawHeight gets a reference to the given int64 and assigns it to the QueuedWithdrawHeight field.
+func (o *CACAOProvider) SetQueuedWithdrawHeight(v int64) {
+	o.QueuedWithdrawHeight = &v
+}
+
+// GetAutoCompound returns the AutoCompound field value if set, zero value otherwise.
+func (o *CACAOProvider) GetAutoCompound() bool {
+	if o == nil || o.AutoCompound == nil {
+		var ret bool
+		return ret
+	}
+	return *o.AutoCompound
+}
+
+// GetAutoCompoundOk returns a tuple with the AutoCompound field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CACAOProvider) GetAutoCompoundOk() (*bool, bool) {
+	if o == nil || o.AutoCompound == nil {
+		return nil, false
+	}
+	return o.AutoCompound, true
+}
+
+// HasAutoCompound returns a boolean if a field has been set.
+func (o *CACAOProvider) HasAutoCompound() bool {
+	if o != nil && o.AutoCompound != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetAutoCompound gets a reference to the given bool and assigns it to the AutoCompound field.
+func (o *CACAOProvider) SetAutoCompound(v bool) {
+	o.AutoCompound = &v
+}
+
+// GetCompoundedAmount returns the CompoundedAmount field value if set, zero value otherwise.
+func (o *CACAOProvider) GetCompoundedAmount() string {
+	if o == nil || o.CompoundedAmount == nil {
+		var ret string
+		return ret
+	}
+	return *o.CompoundedAmount
+}
+
+// GetCompoundedAmountOk returns a tuple with the CompoundedAmount field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CACAOProvider) GetCompoundedAmountOk() (*string, bool) {
+	if o == nil || o.CompoundedAmount == nil {
+		return nil, false
+	}
+	return o.CompoundedAmount, true
+}
+
+// HasCompoundedAmount returns a boolean if a field has been set.
+func (o *CACAOProvider) HasCompoundedAmount() bool {
+	if o != nil && o.CompoundedAmount != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetCompoundedAmount gets a reference to the given string and assigns it to the CompoundedAmount field.
+func (o *CACAOProvider) SetCompoundedAmount(v string) {
+	o.CompoundedAmount = &v
+}
+
 func (o CACAOProvider) MarshalJSON_deprecated() ([]byte, error) {
 	toSerialize := map[string]interface{}{}
 	if true {
@@ -269,6 +405,18 @@ func (o CACAOProvider) MarshalJSON_deprecated() ([]byte, error) {
 	if true {
 		toSerialize["last_withdraw_height"] = o.LastWithdrawHeight
 	}
+	if o.QueuedWithdrawBasisPoints != nil {
+		toSerialize["queued_withdraw_basis_points"] = o.QueuedWithdrawBasisPoints
+	}
+	if o.QueuedWithdrawHeight != nil {
+		toSerialize["queued_withdraw_height"] = o.QueuedWithdrawHeight
+	}
+	if o.AutoCompound != nil {
+		toSerialize["auto_compound"] = o.AutoCompound
+	}
+	if o.CompoundedAmount != nil {
+		toSerialize["compounded_amount"] = o.CompoundedAmount
+	}
 	return json.Marshal(toSerialize)
 }
 
@@ -35,12 +35,16 @@ type MsgSwap struct {
 	AggregatorTargetAddress *string `json:"aggregator_target_address,omitempty"`
 	// the minimum amount of SwapOut asset to receive (else cancelling the SwapOut and receiving mayachain's output)
 	AggregatorTargetLimit *string `json:"aggregator_target_limit,omitempty"`
-	// market if immediately completed or refunded, limit if held until fulfillable
+	// market if immediately completed or refunded, limit if held until fulfillable, cancel if cancelling a held limit order
 	OrderType *string `json:"order_type,omitempty"`
 	// number of swaps to execute in a streaming swap
 	StreamQuantity *int64 `json:"stream_quantity,omitempty"`
 	// the interval (in blocks) to execute the streaming swap
 	StreamInterval *int64 `json:"stream_interval,omitempty"`
+	// the block height after which a limit order expires
+	ExpiryHeight *int64 `json:"expiry_height,omitempty"`
+	// the inbound hash of the limit order a cancel order cancels
+	CancelTxId *string `json:"cancel_tx_id,omitempty"`
 }
 
 // NewMsgSwap instantiates a new MsgSwap object
@@ -448,6 +452,70 @@ func (o *MsgSwap) SetStreamInterval(v int64) {
 	o.StreamInterval = &v
 }
 
+// GetExpiryHeight returns the ExpiryHeight field value if set, zero value otherwise.
+func (o *MsgSwap) GetExpiryHeight() int64 {
+	if o == nil || o.ExpiryHeight == nil {
+		var ret int64
+		return ret
+	}
+	return *o.ExpiryHeight
+}
+
+// GetExpiryHeightOk returns a tuple with the ExpiryHeight field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *MsgSwap) GetExpiryHeightOk() (*int64, bool) {
+	if o == nil || o.ExpiryHeight == nil {
+		return nil, false
+	}
+	return o.ExpiryHeight, true
+}
+
+// HasExpiryHeight returns a boolean if a field has been set.
+func (o *MsgSwap) HasExpiryHeight() bool {
+	if o != nil && o.ExpiryHeight != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetExpiryHeight gets a reference to the given int64 and assigns it to the ExpiryHeight field.
+func (o *MsgSwap) SetExpiryHeight(v int64) {
+	o.ExpiryHeight = &v
+}
+
+// GetCancelTxId returns the CancelTxId field value if set, zero value otherwise.
+func (o *MsgSwap) GetCancelTxId() string {
+	if o == nil || o.CancelTxId == nil {
+		var ret string
+		return ret
+	}
+	return *o.CancelTxId
+}
+
+// GetCancelTxIdOk returns a tuple with the CancelTxId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *MsgSwap) GetCancelTxIdOk() (*string, bool) {
+	if o == nil || o.CancelTxId == nil {
+		return nil, false
+	}
+	return o.CancelTxId, true
+}
+
+// HasCancelTxId returns a boolean if a field has been set.
+func (o *MsgSwap) HasCancelTxId() bool {
+	if o != nil && o.CancelTxId != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetCancelTxId gets a reference to the given string and assigns it to the CancelTxId field.
+func (o *MsgSwap) SetCancelTxId(v string) {
+	o.CancelTxId = &v
+}
+
 func (o MsgSwap) MarshalJSON_deprecated() ([]byte, error) {
 	toSerialize := map[string]interface{}{}
 	if true {
@@ -489,6 +557,12 @@ func (o MsgSwap) MarshalJSON_deprecated() ([]byte, error) {
 	if o.StreamInterval != nil {
 		toSerialize["stream_interval"] = o.StreamInterval
 	}
+	if o.ExpiryHeight != nil {
+		toSerialize["expiry_height"] = o.ExpiryHeight
+	}
+	if o.CancelTxId != nil {
+		toSerialize["cancel_tx_id"] = o.CancelTxId
+	}
 	return json.Marshal(toSerialize)
 }
 
@@ -18,6 +18,10 @@ import (
 type NodeBondProviders struct {
 	NodeAddress *string `json:"node_address,omitempty"`
 	NodeOperatorFee string `json:"node_operator_fee"`
+	// operator fee that takes effect at pending_node_operator_fee_height
+	PendingNodeOperatorFee *string `json:"pending_node_operator_fee,omitempty"`
+	// height from which the pending operator fee is applied to bond rewards
+	PendingNodeOperatorFeeHeight *int64 `json:"pending_node_operator_fee_height,omitempty"`
 	Providers []NodeBondProvider `json:"providers"`
 }
 
@@ -96,6 +100,70 @@ func (o *NodeBondProviders) SetNodeOperatorFee(v string) {
 	o.NodeOperatorFee = v
 }
 
+// GetPendingNodeOperatorFee returns the PendingNodeOperatorFee field value if set, zero value otherwise.
+func (o *NodeBondProviders) GetPendingNodeOperatorFee() string {
+	if o == nil || o.PendingNodeOperatorFee == nil {
+		var ret string
+		return ret
+	}
+	return *o.PendingNodeOperatorFee
+}
+
+// GetPendingNodeOperatorFeeOk returns a tuple with the PendingNodeOperatorFee field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *NodeBondProviders) GetPendingNodeOperatorFeeOk() (*string, bool) {
+	if o == nil || o.PendingNodeOperatorFee == nil {
+		return nil, false
+	}
+	return o.PendingNodeOperatorFee, true
+}
+
+// HasPendingNodeOperatorFee returns a boolean if a field has been set.
+func (o *NodeBondProviders) HasPendingNodeOperatorFee() bool {
+	if o != nil && o.PendingNodeOperatorFee != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetPendingNodeOperatorFee gets a reference to the given string and assigns it to the PendingNodeOperatorFee field.
+func (o *NodeBondProviders) SetPendingNodeOperatorFee(v string) {
+	o.PendingNodeOperatorFee = &v
+}
+
+// GetPendingNodeOperatorFeeHeight returns the PendingNodeOperatorFeeHeight field value if set, zero value otherwise.
+func (o *NodeBondProviders) GetPendingNodeOperatorFeeHeight() int64 {
+	if o == nil || o.PendingNodeOperatorFeeHeight == nil {
+		var ret int64
+		return ret
+	}
+	return *o.PendingNodeOperatorFeeHeight
+}
+
+// GetPendingNodeOperatorFeeHeightOk returns a tuple with the PendingNodeOperatorFeeHeight field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *NodeBondProviders) GetPendingNodeOperatorFeeHeightOk() (*int64, bool) {
+	if o == nil || o.PendingNodeOperatorFeeHeight == nil {
+		return nil, false
+	}
+	return o.PendingNodeOperatorFeeHeight, true
+}
+
+// HasPendingNodeOperatorFeeHeight returns a boolean if a field has been set.
+func (o *NodeBondProviders) HasPendingNodeOperatorFeeHeight() bool {
+	if o != nil && o.PendingNodeOperatorFeeHeight != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetPendingNodeOperatorFeeHeight gets a reference to the given int64 and assigns it to the PendingNodeOperatorFeeHeight field.
+func (o *NodeBondProviders) SetPendingNodeOperatorFeeHeight(v int64) {
+	o.PendingNodeOperatorFeeHeight = &v
+}
+
 // GetProviders returns the Providers field value
 func (o *NodeBondProviders) GetProviders() []NodeBondProvider {
 	if o == nil {
@@ -128,6 +196,12 @@ func (o NodeBondProviders) MarshalJSON_deprecated() ([]byte, error) {
 	if true {
 		toSerialize["node_operator_fee"] = o.NodeOperatorFee
 	}
+	if o.PendingNodeOperatorFee != nil {
+		toSerialize["pending_node_operator_fee"] = o.PendingNodeOperatorFee
+	}
+	if o.PendingNodeOperatorFeeHeight != nil {
+		toSerialize["pending_node_operator_fee_height"] = o.PendingNodeOperatorFeeHeight
+	}
 	if true {
 		toSerialize["providers"] = o.Providers
 	}
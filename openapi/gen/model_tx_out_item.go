@@ -33,6 +33,8 @@ type TxOutItem struct {
 	// the minimum amount of SwapOut asset to receive (else cancelling the SwapOut and receiving mayachain's output)
 	AggregatorTargetLimit *string `json:"aggregator_target_limit,omitempty"`
 	Height *int64 `json:"height,omitempty"`
+	// the outbound lane the item is scheduled in (swap, refund, affiliate, migrate or yggdrasil)
+	Lane *string `json:"lane,omitempty"`
 }
 
 // NewTxOutItem instantiates a new TxOutItem object
@@ -472,6 +474,38 @@ func (o *TxOutItem) SetHeight(v int64) {
 	o.Height = &v
 }
 
+// GetLane returns the Lane field value if set, zero value otherwise.
+func (o *TxOutItem) GetLane() string {
+	if o == nil || o.Lane == nil {
+		var ret string
+		return ret
+	}
+	return *o.Lane
+}
+
+// GetLaneOk returns a tuple with the Lane field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *TxOutItem) GetLaneOk() (*string, bool) {
+	if o == nil || o.Lane == nil {
+		return nil, false
+	}
+	return o.Lane, true
+}
+
+// HasLane returns a boolean if a field has been set.
+func (o *TxOutItem) HasLane() bool {
+	if o != nil && o.Lane != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetLane gets a reference to the given string and assigns it to the Lane field.
+func (o *TxOutItem) SetLane(v string) {
+	o.Lane = &v
+}
+
 func (o TxOutItem) MarshalJSON_deprecated() ([]byte, error) {
 	toSerialize := map[string]interface{}{}
 	if true {
@@ -516,6 +550,9 @@ func (o TxOutItem) MarshalJSON_deprecated() ([]byte, error) {
 	if o.Height != nil {
 		toSerialize["height"] = o.Height
 	}
+	if o.Lane != nil {
+		toSerialize["lane"] = o.Lane
+	}
 	return json.Marshal(toSerialize)
 }
 
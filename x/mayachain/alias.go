@@ -65,10 +65,18 @@ const (
 	// Order Type
 	MarketOrder = types.OrderType_market
 	LimitOrder  = types.OrderType_limit
+	CancelOrder = types.OrderType_cancel
+	DCAOrder    = types.OrderType_dca
+
+	// Limit order close reasons
+	LimitOrderCloseReasonExpired   = types.LimitOrderCloseReasonExpired
+	LimitOrderCloseReasonCancelled = types.LimitOrderCloseReasonCancelled
 
 	// Memos
 	TxSwap            = mem.TxSwap
 	TxLimitOrder      = mem.TxLimitOrder
+	TxCancelOrder     = mem.TxCancelOrder
+	TxDCA             = mem.TxDCA
 	TxAdd             = mem.TxAdd
 	TxBond            = mem.TxBond
 	TxYggdrasilFund   = mem.TxYggdrasilFund
@@ -88,6 +96,7 @@ var (
 	NewPool                        = types.NewPool
 	NewNetwork                     = types.NewNetwork
 	NewProtocolOwnedLiquidity      = types.NewProtocolOwnedLiquidity
+	NewPOLPool                     = types.NewPOLPool
 	NewCACAOPool                   = types.NewCACAOPool
 	NewObservedTx                  = types.NewObservedTx
 	NewTssVoter                    = types.NewTssVoter
@@ -98,6 +107,28 @@ var (
 	NewMsgCacaoPoolWithdraw        = types.NewMsgCacaoPoolWithdraw
 	NewMsgTradeAccountDeposit      = types.NewMsgTradeAccountDeposit
 	NewMsgTradeAccountWithdrawal   = types.NewMsgTradeAccountWithdrawal
+	NewMsgTradeAccountTransfer     = types.NewMsgTradeAccountTransfer
+	NewMsgLoanOpen                 = types.NewMsgLoanOpen
+	NewMsgLoanRepayment            = types.NewMsgLoanRepayment
+	NewMsgAffiliateClaim           = types.NewMsgAffiliateClaim
+	NewMsgMAYANameList             = types.NewMsgMAYANameList
+	NewMsgMAYANameDelist           = types.NewMsgMAYANameDelist
+	NewMsgMAYANameBuy              = types.NewMsgMAYANameBuy
+	NewMsgBondProviderWhitelist    = types.NewMsgBondProviderWhitelist
+	NewMsgBondProviderRemove       = types.NewMsgBondProviderRemove
+	NewMsgNodeOperatorFee          = types.NewMsgNodeOperatorFee
+	NewMsgBondProviderTransfer     = types.NewMsgBondProviderTransfer
+	NewEventBondProvider           = types.NewEventBondProvider
+	NewEventNodeOperatorFee        = types.NewEventNodeOperatorFee
+	NewEventBondProviderTransfer   = types.NewEventBondProviderTransfer
+	NewEventVaultMigrated          = types.NewEventVaultMigrated
+	NewEventSolvencyWarning        = types.NewEventSolvencyWarning
+	NewVaultMigration              = types.NewVaultMigration
+	NewMAYANameListing             = types.NewMAYANameListing
+	NewEventMAYANameList           = types.NewEventMAYANameList
+	NewEventMAYANameDelist         = types.NewEventMAYANameDelist
+	NewEventMAYANameSale           = types.NewEventMAYANameSale
+	NewLoan                        = types.NewLoan
 	NewMsgForgiveSlash             = types.NewMsgForgiveSlash
 	NewMsgMimir                    = types.NewMsgMimir
 	NewMsgNodePauseChain           = types.NewMsgNodePauseChain
@@ -113,6 +144,7 @@ var (
 	NewMsgAddLiquidity             = types.NewMsgAddLiquidity
 	NewMsgWithdrawLiquidity        = types.NewMsgWithdrawLiquidity
 	NewMsgSwap                     = types.NewMsgSwap
+	NewMsgSwapCancel               = types.NewMsgSwapCancel
 	NewKeygen                      = types.NewKeygen
 	NewKeygenBlock                 = types.NewKeygenBlock
 	NewMsgSetNodeKeys              = types.NewMsgSetNodeKeys
@@ -153,10 +185,22 @@ var (
 	NewEventMAYAName               = types.NewEventMAYAName
 	NewEventMAYANameV111           = types.NewEventMAYANameV111
 	NewEventCACAOPoolDeposit       = types.NewEventCACAOPoolDeposit
+	NewEventCACAOPoolCompound      = types.NewEventCACAOPoolCompound
 	NewEventCACAOPoolWithdrawV118  = types.NewEventCACAOPoolWithdrawV118
 	NewEventCACAOPoolWithdraw      = types.NewEventCACAOPoolWithdraw
 	NewEventTradeAccountDeposit    = types.NewEventTradeAccountDeposit
 	NewEventTradeAccountWithdraw   = types.NewEventTradeAccountWithdraw
+	NewEventTradeAccountTransfer   = types.NewEventTradeAccountTransfer
+	NewEventLoanOpen               = types.NewEventLoanOpen
+	NewEventLoanRepayment          = types.NewEventLoanRepayment
+	NewEventLoanClose              = types.NewEventLoanClose
+	NewEventLoanLiquidation        = types.NewEventLoanLiquidation
+	NewEventPOL                    = types.NewEventPOL
+	NewEventLimitOrderClose        = types.NewEventLimitOrderClose
+	NewEventLimitOrderFill         = types.NewEventLimitOrderFill
+	NewEventStreamingSwapCancel    = types.NewEventStreamingSwapCancel
+	NewEventDCAOrder               = types.NewEventDCAOrder
+	NewEventDCASlice               = types.NewEventDCASlice
 	NewPoolMod                     = types.NewPoolMod
 	NewMsgRefundTx                 = types.NewMsgRefundTx
 	NewMsgOutboundTx               = types.NewMsgOutboundTx
@@ -184,7 +228,9 @@ var (
 	NewNetworkFee                  = types.NewNetworkFee
 	NewMAYAName                    = types.NewMAYAName
 	NewAffiliateFeeCollector       = types.NewAffiliateFeeCollector
+	NewAffiliatePayout             = types.NewAffiliatePayout
 	NewStreamingSwap               = types.NewStreamingSwap
+	NewLimitOrderFill              = types.NewLimitOrderFill
 	GetPoolStatus                  = types.GetPoolStatus
 	GetRandomVault                 = types.GetRandomVault
 	GetRandomYggVault              = types.GetRandomYggVault
@@ -215,6 +261,8 @@ var (
 	HasMinority                    = types.HasMinority
 	DefaultGenesis                 = types.DefaultGenesis
 	NewSolvencyVoter               = types.NewSolvencyVoter
+	NewSolvencyRecord              = types.NewSolvencyRecord
+	NewSolvencyDelta               = types.NewSolvencyDelta
 	NewMsgSolvency                 = types.NewMsgSolvency
 	GetLiquidityPools              = types.GetLiquidityPools
 	EmptyBps                       = types.EmptyBps
@@ -224,6 +272,7 @@ var (
 	ParseMemoWithMAYANames     = mem.ParseMemoWithMAYANames
 	FetchAddress               = mem.FetchAddress
 	NewRefundMemo              = mem.NewRefundMemo
+	NewCancelOrderMemo         = mem.NewCancelOrderMemo
 	NewOutboundMemo            = mem.NewOutboundMemo
 	NewRagnarokMemo            = mem.NewRagnarokMemo
 	NewYggdrasilReturn         = mem.NewYggdrasilReturn
@@ -272,6 +321,24 @@ type (
 	MsgCacaoPoolWithdraw      = types.MsgCacaoPoolWithdraw
 	MsgTradeAccountDeposit    = types.MsgTradeAccountDeposit
 	MsgTradeAccountWithdrawal = types.MsgTradeAccountWithdrawal
+	MsgTradeAccountTransfer   = types.MsgTradeAccountTransfer
+	MsgLoanOpen               = types.MsgLoanOpen
+	MsgLoanRepayment          = types.MsgLoanRepayment
+	MsgAffiliateClaim         = types.MsgAffiliateClaim
+	MsgMAYANameList           = types.MsgMAYANameList
+	MsgMAYANameDelist         = types.MsgMAYANameDelist
+	MsgMAYANameBuy            = types.MsgMAYANameBuy
+	MsgBondProviderWhitelist  = types.MsgBondProviderWhitelist
+	MsgBondProviderRemove     = types.MsgBondProviderRemove
+	MsgNodeOperatorFee        = types.MsgNodeOperatorFee
+	MsgBondProviderTransfer   = types.MsgBondProviderTransfer
+	MAYANameListing           = types.MAYANameListing
+	EventMAYANameSale         = types.EventMAYANameSale
+	Loan                      = types.Loan
+	EventLoanOpen             = types.EventLoanOpen
+	EventLoanRepayment        = types.EventLoanRepayment
+	EventLoanClose            = types.EventLoanClose
+	EventLoanLiquidation      = types.EventLoanLiquidation
 	PoolStatus                = types.PoolStatus
 	Pool                      = types.Pool
 	Pools                     = types.Pools
@@ -280,12 +347,16 @@ type (
 	LiquidityProviders        = types.LiquidityProviders
 	StreamingSwap             = types.StreamingSwap
 	StreamingSwaps            = types.StreamingSwaps
+	LimitOrderFill            = types.LimitOrderFill
 	ObservedTxs               = types.ObservedTxs
 	ObservedTx                = types.ObservedTx
 	ObservedTxVoter           = types.ObservedTxVoter
 	ObservedTxVoters          = types.ObservedTxVoters
 	BanVoter                  = types.BanVoter
 	ErrataTxVoter             = types.ErrataTxVoter
+	SolvencyVoter             = types.SolvencyVoter
+	SolvencyRecord            = types.SolvencyRecord
+	SolvencyDelta             = types.SolvencyDelta
 	TssVoter                  = types.TssVoter
 	TssKeysignFailVoter       = types.TssKeysignFailVoter
 	TxOutItem                 = types.TxOutItem
@@ -308,13 +379,17 @@ type (
 	ReserveContributors       = types.ReserveContributors
 	Vault                     = types.Vault
 	Vaults                    = types.Vaults
+	VaultMigration            = types.VaultMigration
 	NodeAccount               = types.NodeAccount
 	NodeAccounts              = types.NodeAccounts
+	NodeSlashEntry            = types.NodeSlashEntry
 	NodeStatus                = types.NodeStatus
 	BondProviders             = types.BondProviders
 	BondProvider              = types.BondProvider
 	Network                   = types.Network
 	ProtocolOwnedLiquidity    = types.ProtocolOwnedLiquidity
+	POLPool                   = types.POLPool
+	POLPoolSnapshot           = types.POLPoolSnapshot
 	VaultStatus               = types.VaultStatus
 	GasPool                   = types.GasPool
 	EventGas                  = types.EventGas
@@ -324,6 +399,7 @@ type (
 	EventBondV105             = types.EventBondV105
 	EventFee                  = types.EventFee
 	EventSlash                = types.EventSlash
+	EventNodeOperatorFee      = types.EventNodeOperatorFee
 	EventOutbound             = types.EventOutbound
 	NetworkFee                = types.NetworkFee
 	ObservedNetworkFeeVoter   = types.ObservedNetworkFeeVoter
@@ -336,6 +412,7 @@ type (
 	MAYANameAlias             = types.MAYANameAlias
 	MAYANameSubaffiliate      = types.MAYANameSubaffiliate
 	AffiliateFeeCollector     = types.AffiliateFeeCollector
+	AffiliatePayout           = types.AffiliatePayout
 	NodeMimir                 = types.NodeMimir
 	NodeMimirs                = types.NodeMimirs
 	CACAOProvider             = types.CACAOProvider
@@ -349,6 +426,7 @@ type (
 	WithdrawLiquidityMemo      = mem.WithdrawLiquidityMemo
 	DonateMemo                 = mem.DonateMemo
 	RefundMemo                 = mem.RefundMemo
+	CancelOrderMemo            = mem.CancelOrderMemo
 	MigrateMemo                = mem.MigrateMemo
 	RagnarokMemo               = mem.RagnarokMemo
 	BondMemo                   = mem.BondMemo
@@ -366,6 +444,17 @@ type (
 	CacaoPoolWithdrawMemo      = mem.CacaoPoolWithdrawMemo
 	TradeAccountDepositMemo    = mem.TradeAccountDepositMemo
 	TradeAccountWithdrawalMemo = mem.TradeAccountWithdrawalMemo
+	TradeAccountTransferMemo   = mem.TradeAccountTransferMemo
+	LoanOpenMemo               = mem.LoanOpenMemo
+	LoanRepaymentMemo          = mem.LoanRepaymentMemo
+	AffiliateClaimMemo         = mem.AffiliateClaimMemo
+	MAYANameListMemo           = mem.MAYANameListMemo
+	MAYANameDelistMemo         = mem.MAYANameDelistMemo
+	MAYANameBuyMemo            = mem.MAYANameBuyMemo
+	BondProviderWhitelistMemo  = mem.BondProviderWhitelistMemo
+	BondProviderRemoveMemo     = mem.BondProviderRemoveMemo
+	NodeOperatorFeeMemo        = mem.NodeOperatorFeeMemo
+	BondProviderTransferMemo   = mem.BondProviderTransferMemo
 
 	// Proto
 	ProtoStrings = types.ProtoStrings
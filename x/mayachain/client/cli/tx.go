@@ -40,6 +40,7 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(GetCmdNodeResumeChain())
 	cmd.AddCommand(GetCmdDeposit())
 	cmd.AddCommand(GetCmdSend())
+	cmd.AddCommand(GetCmdTradeAccountTransfer())
 	cmd.AddCommand(GetCmdObserveTxIns())
 	cmd.AddCommand(GetCmdObserveTxOuts())
 	for _, subCmd := range cmd.Commands() {
@@ -112,6 +113,41 @@ func GetCmdSend() *cobra.Command {
 	}
 }
 
+// GetCmdTradeAccountTransfer command to move trade assets to another trade account
+func GetCmdTradeAccountTransfer() *cobra.Command {
+	return &cobra.Command{
+		Use:   "trade-transfer [to_address] [amount] [asset]",
+		Short: "transfers trade assets to another trade account",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			toAddr, err := cosmos.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid address: %w", err)
+			}
+
+			amt, err := cosmos.ParseUint(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid amount (must be an integer): %w", err)
+			}
+
+			asset, err := common.NewAsset(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid asset: %w", err)
+			}
+
+			msg := types.NewMsgTradeAccountTransfer(asset, amt, toAddr, clientCtx.GetFromAddress(), common.Tx{})
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
 // GetCmdMimir command to change a mimir attribute
 func GetCmdMimir() *cobra.Command {
 	return &cobra.Command{
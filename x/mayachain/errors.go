@@ -23,6 +23,9 @@ const (
 	CodeSwapFailInvalidAmount    uint32 = 113
 	CodeSwapFailInvalidBalance   uint32 = 114
 	CodeSwapFailNotEnoughBalance uint32 = 115
+	CodeLimitOrderExpired        uint32 = 116
+	CodeLimitOrderCancelled      uint32 = 117
+	CodeStreamingSwapCancelled   uint32 = 118
 
 	CodeAddLiquidityFailValidation    uint32 = 120
 	CodeFailGetLiquidityProvider      uint32 = 122
@@ -67,6 +70,7 @@ var (
 	errSwapFailInvalidAmount          = se.Register(DefaultCodespace, CodeSwapFailInvalidAmount, "fail swap, invalid amount")
 	errSwapFailInvalidBalance         = se.Register(DefaultCodespace, CodeSwapFailInvalidBalance, "fail swap, invalid balance")
 	errSwapFailNotEnoughBalance       = se.Register(DefaultCodespace, CodeSwapFailNotEnoughBalance, "fail swap, not enough balance")
+	errStreamingSwapCancelled         = se.Register(DefaultCodespace, CodeStreamingSwapCancelled, "streaming swap cancelled")
 	errNoLiquidityUnitLeft            = se.Register(DefaultCodespace, CodeNoLiquidityUnitLeft, "nothing to withdraw")
 	errWithdrawWithin24Hours          = se.Register(DefaultCodespace, CodeWithdrawWithin24Hours, "you cannot withdraw for 24 hours after providing liquidity for this blockchain")
 	errWithdrawFail                   = se.Register(DefaultCodespace, CodeWithdrawFail, "fail to withdraw")
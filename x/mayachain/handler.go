@@ -50,7 +50,13 @@ func NewExternalHandler(mgr Manager) cosmos.Handler {
 }
 
 func getHandlerMapping(mgr Manager) map[string]MsgHandler {
-	return getHandlerMappingV65(mgr)
+	version := mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("1.124.0")):
+		return getHandlerMappingV124(mgr)
+	default:
+		return getHandlerMappingV65(mgr)
+	}
 }
 
 func getHandlerMappingV65(mgr Manager) map[string]MsgHandler {
@@ -82,6 +88,36 @@ func getHandlerMappingV65(mgr Manager) map[string]MsgHandler {
 	return m
 }
 
+func getHandlerMappingV124(mgr Manager) map[string]MsgHandler {
+	// New arch handlers
+	m := make(map[string]MsgHandler)
+
+	// consensus handlers
+	m[MsgTssPool{}.Type()] = NewTssHandler(mgr)
+	m[MsgObservedTxIn{}.Type()] = NewObservedTxInHandler(mgr)
+	m[MsgObservedTxOut{}.Type()] = NewObservedTxOutHandler(mgr)
+	m[MsgTssKeysignFail{}.Type()] = NewTssKeysignHandler(mgr)
+	m[MsgErrataTx{}.Type()] = NewErrataTxHandler(mgr)
+	m[MsgBan{}.Type()] = NewBanHandler(mgr)
+	m[MsgNetworkFee{}.Type()] = NewNetworkFeeHandler(mgr)
+	m[MsgSolvency{}.Type()] = NewSolvencyHandler(mgr)
+	m[MsgForgiveSlash{}.Type()] = NewForgiveSlashHandler(mgr)
+
+	// cli handlers (non-consensus)
+	m[MsgMimir{}.Type()] = NewMimirHandler(mgr)
+	m[MsgSetNodeKeys{}.Type()] = NewSetNodeKeysHandler(mgr)
+	m[MsgSetAztecAddress{}.Type()] = NewSetAztecAddressHandler(mgr)
+	m[MsgSetVersion{}.Type()] = NewVersionHandler(mgr)
+	m[MsgSetIPAddress{}.Type()] = NewIPAddressHandler(mgr)
+	m[MsgNodePauseChain{}.Type()] = NewNodePauseChainHandler(mgr)
+
+	// native handlers (non-consensus)
+	m[MsgSend{}.Type()] = NewSendHandler(mgr)
+	m[MsgDeposit{}.Type()] = NewDepositHandler(mgr)
+	m[MsgTradeAccountTransfer{}.Type()] = NewNativeTradeAccountTransferHandler(mgr)
+	return m
+}
+
 // NewInternalHandler returns a handler for "thorchain" internal type messages.
 func NewInternalHandler(mgr Manager) cosmos.Handler {
 	return func(ctx cosmos.Context, msg cosmos.Msg) (*cosmos.Result, error) {
@@ -120,6 +156,8 @@ func NewInternalHandler(mgr Manager) cosmos.Handler {
 func getInternalHandlerMapping(mgr Manager) map[string]MsgHandler {
 	version := mgr.GetVersion()
 	switch {
+	case version.GTE(semver.MustParse("1.124.0")):
+		return getInternalHandlerMappingV124(mgr)
 	case version.GTE(semver.MustParse("1.123.0")): // trade-accounts
 		return getInternalHandlerMappingV123(mgr)
 	case version.GTE(semver.MustParse("1.118.0")):
@@ -129,7 +167,7 @@ func getInternalHandlerMapping(mgr Manager) map[string]MsgHandler {
 	}
 }
 
-func getInternalHandlerMappingV123(mgr Manager) map[string]MsgHandler {
+func getInternalHandlerMappingV124(mgr Manager) map[string]MsgHandler {
 	// New arch handlers
 	m := make(map[string]MsgHandler)
 	m[MsgOutboundTx{}.Type()] = NewOutboundTxHandler(mgr)
@@ -153,6 +191,17 @@ func getInternalHandlerMappingV123(mgr Manager) map[string]MsgHandler {
 	m[MsgCacaoPoolWithdraw{}.Type()] = NewCacaoPoolWithdrawHandler(mgr)
 	m[MsgTradeAccountDeposit{}.Type()] = NewTradeAccountDepositHandler(mgr)
 	m[MsgTradeAccountWithdrawal{}.Type()] = NewTradeAccountWithdrawalHandler(mgr)
+	m[MsgTradeAccountTransfer{}.Type()] = NewTradeAccountTransferHandler(mgr)
+	m[MsgLoanOpen{}.Type()] = NewLoanOpenHandler(mgr)
+	m[MsgLoanRepayment{}.Type()] = NewLoanRepaymentHandler(mgr)
+	m[MsgAffiliateClaim{}.Type()] = NewAffiliateClaimHandler(mgr)
+	m[MsgMAYANameList{}.Type()] = NewMAYANameListHandler(mgr)
+	m[MsgMAYANameDelist{}.Type()] = NewMAYANameDelistHandler(mgr)
+	m[MsgMAYANameBuy{}.Type()] = NewMAYANameBuyHandler(mgr)
+	m[MsgBondProviderWhitelist{}.Type()] = NewBondProviderWhitelistHandler(mgr)
+	m[MsgBondProviderRemove{}.Type()] = NewBondProviderRemoveHandler(mgr)
+	m[MsgNodeOperatorFee{}.Type()] = NewNodeOperatorFeeHandler(mgr)
+	m[MsgBondProviderTransfer{}.Type()] = NewBondProviderTransferHandler(mgr)
 	return m
 }
 
@@ -160,7 +209,9 @@ func getMsgSwapFromMemo(memo SwapMemo, tx ObservedTx, signer cosmos.AccAddress)
 	if memo.Destination.IsEmpty() {
 		memo.Destination = tx.Tx.FromAddress
 	}
-	return NewMsgSwap(tx.Tx, memo.GetAsset(), memo.Destination, memo.SlipLimit, memo.AffiliateAddress, memo.AffiliateBasisPoints, memo.GetDexAggregator(), memo.GetDexTargetAddress(), memo.GetDexTargetLimit(), memo.GetOrderType(), memo.GetStreamQuantity(), memo.GetStreamInterval(), signer), nil
+	msg := NewMsgSwap(tx.Tx, memo.GetAsset(), memo.Destination, memo.SlipLimit, memo.AffiliateAddress, memo.AffiliateBasisPoints, memo.GetDexAggregator(), memo.GetDexTargetAddress(), memo.GetDexTargetLimit(), memo.GetOrderType(), memo.GetStreamQuantity(), memo.GetStreamInterval(), signer)
+	msg.ExpiryHeight = memo.GetExpiryHeight()
+	return msg, nil
 }
 
 func getMsgWithdrawFromMemo(memo WithdrawLiquidityMemo, tx ObservedTx, signer cosmos.AccAddress, version semver.Version) (cosmos.Msg, error) {
@@ -247,6 +298,8 @@ func getMsgForgiveSlashFromMemo(memo ForgiveSlashMemo, tx ObservedTx, signer cos
 
 func processOneTxIn(ctx cosmos.Context, version semver.Version, keeper keeper.Keeper, tx ObservedTx, signer cosmos.AccAddress) (cosmos.Msg, error) {
 	switch {
+	case version.GTE(semver.MustParse("1.124.0")):
+		return processOneTxInV124(ctx, keeper, tx, signer)
 	case version.GTE(semver.MustParse("1.123.0")): // trade-accounts
 		return processOneTxInV123(ctx, keeper, tx, signer)
 	case version.GTE(semver.MustParse("1.118.0")):
@@ -257,7 +310,7 @@ func processOneTxIn(ctx cosmos.Context, version semver.Version, keeper keeper.Ke
 	return nil, errBadVersion
 }
 
-func processOneTxInV123(ctx cosmos.Context, keeper keeper.Keeper, tx ObservedTx, signer cosmos.AccAddress) (cosmos.Msg, error) {
+func processOneTxInV124(ctx cosmos.Context, keeper keeper.Keeper, tx ObservedTx, signer cosmos.AccAddress) (cosmos.Msg, error) {
 	if len(tx.Tx.Coins) != 1 {
 		return nil, cosmos.ErrInvalidCoins("only send 1 coins per message")
 	}
@@ -284,6 +337,8 @@ func processOneTxInV123(ctx cosmos.Context, keeper keeper.Keeper, tx ObservedTx,
 	case DonateMemo:
 		m.Asset = fuzzyAssetMatch(ctx, keeper, m.Asset)
 		newMsg, err = getMsgDonateFromMemo(m, tx, signer)
+	case CancelOrderMemo:
+		newMsg = NewMsgSwapCancel(tx.Tx, m.GetTxID(), signer)
 	case RefundMemo:
 		newMsg, err = getMsgRefundFromMemo(m, tx, signer)
 	case OutboundMemo:
@@ -314,7 +369,9 @@ func processOneTxInV123(ctx cosmos.Context, keeper keeper.Keeper, tx ObservedTx,
 	case ForgiveSlashMemo:
 		newMsg, err = getMsgForgiveSlashFromMemo(m, tx, signer)
 	case CacaoPoolDepositMemo:
-		newMsg = NewMsgCacaoPoolDeposit(signer, tx.Tx)
+		msg := NewMsgCacaoPoolDeposit(signer, tx.Tx)
+		msg.AutoCompound = m.AutoCompound
+		newMsg = msg
 	case CacaoPoolWithdrawMemo:
 		newMsg = NewMsgCacaoPoolWithdraw(signer, tx.Tx, m.GetBasisPts(), m.GetAffiliateBasisPoints())
 	case TradeAccountDepositMemo:
@@ -323,6 +380,30 @@ func processOneTxInV123(ctx cosmos.Context, keeper keeper.Keeper, tx ObservedTx,
 	case TradeAccountWithdrawalMemo:
 		coin := tx.Tx.Coins[0]
 		newMsg = NewMsgTradeAccountWithdrawal(coin.Asset, coin.Amount, m.GetAddress(), signer, tx.Tx)
+	case TradeAccountTransferMemo:
+		coin := tx.Tx.Coins[0]
+		newMsg = NewMsgTradeAccountTransfer(coin.Asset, coin.Amount, m.GetAccAddress(), signer, tx.Tx)
+	case LoanOpenMemo:
+		coin := tx.Tx.Coins[0]
+		newMsg = NewMsgLoanOpen(coin.Asset, coin.Amount, m.GetAsset(), m.GetDestination(), m.GetAmount(), signer, tx.Tx)
+	case LoanRepaymentMemo:
+		newMsg = NewMsgLoanRepayment(m.GetAccAddress(), m.GetAsset(), tx.Tx.Coins[0], signer, tx.Tx)
+	case AffiliateClaimMemo:
+		newMsg = NewMsgAffiliateClaim(m.Name, m.GetAsset(), signer, tx.Tx)
+	case MAYANameListMemo:
+		newMsg = NewMsgMAYANameList(m.Name, m.Price, m.Expire, m.KeepAliases, m.KeepSubaffiliates, signer, tx.Tx)
+	case MAYANameDelistMemo:
+		newMsg = NewMsgMAYANameDelist(m.Name, signer, tx.Tx)
+	case MAYANameBuyMemo:
+		newMsg = NewMsgMAYANameBuy(m.Name, tx.Tx.Coins[0], signer, tx.Tx)
+	case BondProviderWhitelistMemo:
+		newMsg = NewMsgBondProviderWhitelist(m.GetAccAddress(), m.ProviderAddress, signer, tx.Tx)
+	case BondProviderRemoveMemo:
+		newMsg = NewMsgBondProviderRemove(m.GetAccAddress(), m.ProviderAddress, signer, tx.Tx)
+	case NodeOperatorFeeMemo:
+		newMsg = NewMsgNodeOperatorFee(m.GetAccAddress(), m.OperatorFee, signer, tx.Tx)
+	case BondProviderTransferMemo:
+		newMsg = NewMsgBondProviderTransfer(m.GetAccAddress(), m.ToAddress, m.GetAsset(), m.GetAmount(), signer, tx.Tx)
 	default:
 		return nil, errInvalidMemo
 	}
@@ -344,6 +425,8 @@ func processOneTxInV123(ctx cosmos.Context, keeper keeper.Keeper, tx ObservedTx,
 		}
 	case *MsgSwap:
 		switch {
+		case keeper.GetVersion().GTE(semver.MustParse("1.124.0")):
+			return newMsg, m.ValidateBasicV124(keeper.GetVersion())
 		case keeper.GetVersion().GTE(semver.MustParse("1.112.0")):
 			return newMsg, m.ValidateBasicV112(keeper.GetVersion())
 		case keeper.GetVersion().GTE(semver.MustParse("0.63.0")):
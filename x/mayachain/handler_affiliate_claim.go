@@ -0,0 +1,127 @@
+package mayachain
+
+import (
+	"fmt"
+
+	"github.com/blang/semver"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+)
+
+// AffiliateClaimHandler is handler to process MsgAffiliateClaim, which lets
+// the owner of a MAYAName pay out its affiliate collector without waiting for
+// the preferred asset swap threshold
+type AffiliateClaimHandler struct {
+	mgr Manager
+}
+
+// NewAffiliateClaimHandler create a new instance of AffiliateClaimHandler
+func NewAffiliateClaimHandler(mgr Manager) AffiliateClaimHandler {
+	return AffiliateClaimHandler{
+		mgr: mgr,
+	}
+}
+
+// Run is the main entry point for AffiliateClaimHandler
+func (h AffiliateClaimHandler) Run(ctx cosmos.Context, m cosmos.Msg) (*cosmos.Result, error) {
+	msg, ok := m.(*MsgAffiliateClaim)
+	if !ok {
+		return nil, errInvalidMessage
+	}
+	if err := h.validate(ctx, *msg); err != nil {
+		ctx.Logger().Error("MsgAffiliateClaim failed validation", "error", err)
+		return nil, err
+	}
+	err := h.handle(ctx, *msg)
+	if err != nil {
+		ctx.Logger().Error("fail to process MsgAffiliateClaim", "error", err)
+	}
+	return &cosmos.Result{}, err
+}
+
+func (h AffiliateClaimHandler) validate(ctx cosmos.Context, msg MsgAffiliateClaim) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("1.124.0")):
+		return h.validateV124(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+func (h AffiliateClaimHandler) validateV124(ctx cosmos.Context, msg MsgAffiliateClaim) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	mn, err := h.getMAYAName(ctx, msg)
+	if err != nil {
+		return err
+	}
+	asset := h.claimAsset(mn, msg)
+	if !asset.IsNativeBase() && !asset.Equals(mn.PreferredAsset) {
+		return fmt.Errorf("can only claim %s or the preferred asset %s", common.BaseNative, mn.PreferredAsset)
+	}
+	affCol, err := h.mgr.Keeper().GetAffiliateCollector(ctx, mn.Owner)
+	if err != nil {
+		return fmt.Errorf("fail to get affiliate collector: %w", err)
+	}
+	if affCol.CacaoAmount.IsZero() {
+		return fmt.Errorf("nothing to claim for %s", mn.Name)
+	}
+	return nil
+}
+
+func (h AffiliateClaimHandler) handle(ctx cosmos.Context, msg MsgAffiliateClaim) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("1.124.0")):
+		return h.handleV124(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+// handle process MsgAffiliateClaim
+func (h AffiliateClaimHandler) handleV124(ctx cosmos.Context, msg MsgAffiliateClaim) error {
+	mn, err := h.getMAYAName(ctx, msg)
+	if err != nil {
+		return err
+	}
+	if h.claimAsset(mn, msg).IsNativeBase() {
+		return releaseAffiliateCollectorFunds(ctx, h.mgr, mn, true)
+	}
+	affCol, err := h.mgr.Keeper().GetAffiliateCollector(ctx, mn.Owner)
+	if err != nil {
+		return fmt.Errorf("fail to get affiliate collector: %w", err)
+	}
+	return swapAffiliateCollector(ctx, h.mgr, mn, affCol, 0, true)
+}
+
+// getMAYAName returns the MAYAName of the claim, which must be owned by the signer
+func (h AffiliateClaimHandler) getMAYAName(ctx cosmos.Context, msg MsgAffiliateClaim) (MAYAName, error) {
+	if !h.mgr.Keeper().MAYANameExists(ctx, msg.Name) {
+		return MAYAName{}, fmt.Errorf("mayaname %s doesn't exist", msg.Name)
+	}
+	mn, err := h.mgr.Keeper().GetMAYAName(ctx, msg.Name)
+	if err != nil {
+		return MAYAName{}, fmt.Errorf("fail to get mayaname: %w", err)
+	}
+	if !mn.Owner.Equals(msg.Signer) {
+		return MAYAName{}, fmt.Errorf("only the owner of %s can claim its affiliate fees", mn.Name)
+	}
+	return mn, nil
+}
+
+// claimAsset returns the asset the claim pays out in, which defaults to the
+// preferred asset of the MAYAName, or CACAO when it has none
+func (h AffiliateClaimHandler) claimAsset(mn MAYAName, msg MsgAffiliateClaim) common.Asset {
+	switch {
+	case !msg.Asset.IsEmpty():
+		return msg.Asset
+	case !mn.PreferredAsset.IsEmpty():
+		return mn.PreferredAsset
+	default:
+		return common.BaseNative
+	}
+}
@@ -0,0 +1,121 @@
+package mayachain
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+)
+
+type HandlerAffiliateClaimSuite struct{}
+
+var _ = Suite(&HandlerAffiliateClaimSuite{})
+
+func (s *HandlerAffiliateClaimSuite) SetUpSuite(c *C) {
+	SetupConfigForTest()
+}
+
+// setupAffiliateClaimTest sets up an available BTC pool and a MAYAName
+// preferring BTC whose owner has accrued 100 CACAO of affiliate fees
+func (s *HandlerAffiliateClaimSuite) setupAffiliateClaimTest(c *C) (cosmos.Context, *Mgrs, MAYAName) {
+	ctx, mgr := setupManagerForTest(c)
+
+	pool := NewPool()
+	pool.Asset = common.BTCAsset
+	pool.BalanceCacao = cosmos.NewUint(100_000 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	pool.LPUnits = cosmos.NewUint(100 * common.One)
+	pool.Status = PoolAvailable
+	c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+
+	owner := GetRandomBech32Addr()
+	aliases := []MAYANameAlias{{Chain: common.BTCChain, Address: GetRandomBTCAddress()}}
+	mn := NewMAYAName("alice", ctx.BlockHeight()+100, aliases, common.BTCAsset, owner, cosmos.ZeroUint(), nil)
+	mgr.Keeper().SetMAYAName(ctx, mn)
+
+	FundModule(c, ctx, mgr.Keeper(), AffiliateCollectorName, 100)
+	mgr.Keeper().SetAffiliateCollector(ctx, NewAffiliateFeeCollector(owner, cosmos.NewUint(100*common.One)))
+
+	return ctx, mgr, mn
+}
+
+func (s *HandlerAffiliateClaimSuite) TestValidate(c *C) {
+	ctx, mgr, mn := s.setupAffiliateClaimTest(c)
+	h := NewAffiliateClaimHandler(mgr)
+	tx := common.Tx{ID: GetRandomTxHash()}
+
+	msg := NewMsgAffiliateClaim(mn.Name, common.EmptyAsset, mn.Owner, tx)
+	c.Check(h.validate(ctx, *msg), IsNil)
+	msg = NewMsgAffiliateClaim(mn.Name, common.BaseNative, mn.Owner, tx)
+	c.Check(h.validate(ctx, *msg), IsNil)
+
+	// only the owner can claim
+	msg = NewMsgAffiliateClaim(mn.Name, common.EmptyAsset, GetRandomBech32Addr(), tx)
+	c.Check(h.validate(ctx, *msg), NotNil)
+
+	// the mayaname must exist
+	msg = NewMsgAffiliateClaim("bob", common.EmptyAsset, mn.Owner, tx)
+	c.Check(h.validate(ctx, *msg), NotNil)
+
+	// only cacao or the preferred asset
+	msg = NewMsgAffiliateClaim(mn.Name, common.ETHAsset, mn.Owner, tx)
+	c.Check(h.validate(ctx, *msg), NotNil)
+
+	// nothing to claim
+	mgr.Keeper().SetAffiliateCollector(ctx, NewAffiliateFeeCollector(mn.Owner, cosmos.ZeroUint()))
+	msg = NewMsgAffiliateClaim(mn.Name, common.EmptyAsset, mn.Owner, tx)
+	c.Check(h.validate(ctx, *msg), NotNil)
+}
+
+func (s *HandlerAffiliateClaimSuite) TestClaimCacao(c *C) {
+	ctx, mgr, mn := s.setupAffiliateClaimTest(c)
+
+	msg := NewMsgAffiliateClaim(mn.Name, common.BaseNative, mn.Owner, common.Tx{ID: GetRandomTxHash()})
+	_, err := NewAffiliateClaimHandler(mgr).Run(ctx, msg)
+	c.Assert(err, IsNil)
+
+	balance := mgr.Keeper().GetBalance(ctx, mn.Owner).AmountOf(common.BaseNative.Native())
+	c.Check(balance.Uint64(), Equals, uint64(100*common.One))
+	affCol, err := mgr.Keeper().GetAffiliateCollector(ctx, mn.Owner)
+	c.Assert(err, IsNil)
+	c.Check(affCol.CacaoAmount.IsZero(), Equals, true)
+
+	payouts, err := mgr.Keeper().GetAffiliatePayouts(ctx, mn.Owner)
+	c.Assert(err, IsNil)
+	c.Assert(payouts, HasLen, 1)
+	c.Check(payouts[0].Mayaname, Equals, mn.Name)
+	c.Check(payouts[0].Asset.Equals(common.BaseNative), Equals, true)
+	c.Check(payouts[0].CacaoAmount.Uint64(), Equals, uint64(100*common.One))
+	c.Check(payouts[0].Claimed, Equals, true)
+
+	// nothing left to claim
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = NewAffiliateClaimHandler(mgr).Run(cacheCtx, msg)
+	c.Assert(err, NotNil)
+}
+
+func (s *HandlerAffiliateClaimSuite) TestClaimPreferredAsset(c *C) {
+	ctx, mgr, mn := s.setupAffiliateClaimTest(c)
+
+	// the claim doesn't wait for the swap threshold
+	msg := NewMsgAffiliateClaim(mn.Name, common.EmptyAsset, mn.Owner, common.Tx{ID: GetRandomTxHash()})
+	_, err := NewAffiliateClaimHandler(mgr).Run(ctx, msg)
+	c.Assert(err, IsNil)
+
+	affCol, err := mgr.Keeper().GetAffiliateCollector(ctx, mn.Owner)
+	c.Assert(err, IsNil)
+	c.Check(affCol.CacaoAmount.IsZero(), Equals, true)
+	c.Check(mgr.Keeper().GetRuneBalanceOfModule(ctx, AffiliateCollectorName).IsZero(), Equals, true)
+
+	payouts, err := mgr.Keeper().GetAffiliatePayouts(ctx, mn.Owner)
+	c.Assert(err, IsNil)
+	c.Assert(payouts, HasLen, 1)
+	c.Check(payouts[0].Asset.Equals(common.BTCAsset), Equals, true)
+	c.Check(payouts[0].Claimed, Equals, true)
+
+	swap, err := mgr.Keeper().GetSwapQueueItem(ctx, payouts[0].TxID, 0)
+	c.Assert(err, IsNil)
+	c.Check(swap.TargetAsset.Equals(common.BTCAsset), Equals, true)
+	c.Check(swap.Destination.Equals(mn.GetAlias(common.BTCChain)), Equals, true)
+	c.Check(swap.Tx.Coins[0].Amount.Uint64(), Equals, uint64(100*common.One))
+}
@@ -436,3 +436,146 @@ func fuzzyAssetMatchV83(ctx cosmos.Context, keeper keeper.Keeper, origAsset comm
 
 	return winner.Asset
 }
+
+func getInternalHandlerMappingV123(mgr Manager) map[string]MsgHandler {
+	// New arch handlers
+	m := make(map[string]MsgHandler)
+	m[MsgOutboundTx{}.Type()] = NewOutboundTxHandler(mgr)
+	m[MsgYggdrasil{}.Type()] = NewYggdrasilHandler(mgr)
+	m[MsgSwap{}.Type()] = NewSwapHandler(mgr)
+	m[MsgReserveContributor{}.Type()] = NewReserveContributorHandler(mgr)
+	m[MsgBond{}.Type()] = NewBondHandler(mgr)
+	m[MsgUnBond{}.Type()] = NewUnBondHandler(mgr)
+	m[MsgLeave{}.Type()] = NewLeaveHandler(mgr)
+	m[MsgDonate{}.Type()] = NewDonateHandler(mgr)
+	m[MsgWithdrawLiquidity{}.Type()] = NewWithdrawLiquidityHandler(mgr)
+	m[MsgAddLiquidity{}.Type()] = NewAddLiquidityHandler(mgr)
+	m[MsgRefundTx{}.Type()] = NewRefundHandler(mgr)
+	m[MsgMigrate{}.Type()] = NewMigrateHandler(mgr)
+	m[MsgRagnarok{}.Type()] = NewRagnarokHandler(mgr)
+	m[MsgNoOp{}.Type()] = NewNoOpHandler(mgr)
+	m[MsgConsolidate{}.Type()] = NewConsolidateHandler(mgr)
+	m[MsgManageMAYAName{}.Type()] = NewManageMAYANameHandler(mgr)
+	m[MsgForgiveSlash{}.Type()] = NewForgiveSlashHandler(mgr)
+	m[MsgCacaoPoolDeposit{}.Type()] = NewCacaoPoolDepositHandler(mgr)
+	m[MsgCacaoPoolWithdraw{}.Type()] = NewCacaoPoolWithdrawHandler(mgr)
+	m[MsgTradeAccountDeposit{}.Type()] = NewTradeAccountDepositHandler(mgr)
+	m[MsgTradeAccountWithdrawal{}.Type()] = NewTradeAccountWithdrawalHandler(mgr)
+	return m
+}
+
+func processOneTxInV123(ctx cosmos.Context, keeper keeper.Keeper, tx ObservedTx, signer cosmos.AccAddress) (cosmos.Msg, error) {
+	if len(tx.Tx.Coins) != 1 {
+		return nil, cosmos.ErrInvalidCoins("only send 1 coins per message")
+	}
+
+	memo, err := ParseMemoWithMAYANames(ctx, keeper, tx.Tx.Memo)
+	if err != nil {
+		ctx.Logger().Error("fail to parse memo", "error", err)
+		return nil, err
+	}
+	// THORNode should not have one tx across chain, if it is cross chain it should be separate tx
+	var newMsg cosmos.Msg
+	// interpret the memo and initialize a corresponding msg event
+	switch m := memo.(type) {
+	case AddLiquidityMemo:
+		m.Asset = fuzzyAssetMatch(ctx, keeper, m.Asset)
+		newMsg, err = getMsgAddLiquidityFromMemo(ctx, m, tx, signer, m.Tier)
+	case WithdrawLiquidityMemo:
+		m.Asset = fuzzyAssetMatch(ctx, keeper, m.Asset)
+		newMsg, err = getMsgWithdrawFromMemo(m, tx, signer, keeper.GetVersion())
+	case SwapMemo:
+		m.Asset = fuzzyAssetMatch(ctx, keeper, m.Asset)
+		m.DexTargetAddress = externalAssetMatch(keeper.GetVersion(), m.Asset.GetChain(), m.DexTargetAddress)
+		newMsg, err = getMsgSwapFromMemo(m, tx, signer)
+	case DonateMemo:
+		m.Asset = fuzzyAssetMatch(ctx, keeper, m.Asset)
+		newMsg, err = getMsgDonateFromMemo(m, tx, signer)
+	case RefundMemo:
+		newMsg, err = getMsgRefundFromMemo(m, tx, signer)
+	case OutboundMemo:
+		newMsg, err = getMsgOutboundFromMemo(m, tx, signer)
+	case MigrateMemo:
+		newMsg, err = getMsgMigrateFromMemo(m, tx, signer)
+	case BondMemo:
+		newMsg, err = getMsgBondFromMemo(m, tx, signer)
+	case UnbondMemo:
+		newMsg, err = getMsgUnbondFromMemo(m, tx, signer)
+	case RagnarokMemo:
+		newMsg, err = getMsgRagnarokFromMemo(m, tx, signer)
+	case LeaveMemo:
+		newMsg, err = getMsgLeaveFromMemo(m, tx, signer)
+	case YggdrasilFundMemo:
+		newMsg = NewMsgYggdrasil(tx.Tx, tx.ObservedPubKey, m.GetBlockHeight(), true, tx.Tx.Coins, signer)
+	case YggdrasilReturnMemo:
+		newMsg = NewMsgYggdrasil(tx.Tx, tx.ObservedPubKey, m.GetBlockHeight(), false, tx.Tx.Coins, signer)
+	case ReserveMemo:
+		res := NewReserveContributor(tx.Tx.FromAddress, tx.Tx.Coins.GetCoin(common.BaseAsset()).Amount)
+		newMsg = NewMsgReserveContributor(tx.Tx, res, signer)
+	case NoOpMemo:
+		newMsg = NewMsgNoOp(tx, signer, m.Action)
+	case ConsolidateMemo:
+		newMsg = NewMsgConsolidate(tx, signer)
+	case ManageMAYANameMemo:
+		newMsg, err = getMsgManageMAYANameFromMemo(m, tx, signer)
+	case ForgiveSlashMemo:
+		newMsg, err = getMsgForgiveSlashFromMemo(m, tx, signer)
+	case CacaoPoolDepositMemo:
+		newMsg = NewMsgCacaoPoolDeposit(signer, tx.Tx)
+	case CacaoPoolWithdrawMemo:
+		newMsg = NewMsgCacaoPoolWithdraw(signer, tx.Tx, m.GetBasisPts(), m.GetAffiliateBasisPoints())
+	case TradeAccountDepositMemo:
+		coin := tx.Tx.Coins[0]
+		newMsg = NewMsgTradeAccountDeposit(coin.Asset, coin.Amount, m.GetAccAddress(), signer, tx.Tx)
+	case TradeAccountWithdrawalMemo:
+		coin := tx.Tx.Coins[0]
+		newMsg = NewMsgTradeAccountWithdrawal(coin.Asset, coin.Amount, m.GetAddress(), signer, tx.Tx)
+	default:
+		return nil, errInvalidMemo
+	}
+
+	if err != nil {
+		return newMsg, err
+	}
+	// MsgAddLiquidity, MsgSwap, MsgSetAztecAddress & MsgManageMAYAName has a new version of validateBasic
+	version := keeper.GetVersion()
+	switch m := newMsg.(type) {
+	case *MsgAddLiquidity:
+		switch {
+		case keeper.GetVersion().GTE(semver.MustParse("1.108.0")):
+			return newMsg, m.ValidateBasicV108()
+		case keeper.GetVersion().GTE(semver.MustParse("0.63.0")):
+			return newMsg, m.ValidateBasicV63()
+		default:
+			return newMsg, m.ValidateBasic()
+		}
+	case *MsgSwap:
+		switch {
+		case keeper.GetVersion().GTE(semver.MustParse("1.112.0")):
+			return newMsg, m.ValidateBasicV112(keeper.GetVersion())
+		case keeper.GetVersion().GTE(semver.MustParse("0.63.0")):
+			return newMsg, m.ValidateBasicV63(keeper.GetVersion())
+		default:
+			return newMsg, m.ValidateBasic()
+		}
+	case *MsgSetAztecAddress:
+		switch {
+		case version.GTE(semver.MustParse("1.108.0")):
+			return newMsg, m.ValidateBasicV108(version)
+		default:
+			return newMsg, m.ValidateBasic()
+		}
+	case *MsgManageMAYAName:
+		switch {
+		case version.GTE(semver.MustParse("1.112.0")):
+			return newMsg, m.ValidateBasicV112(version)
+		case version.GTE(semver.MustParse("1.108.0")):
+			return newMsg, m.ValidateBasicV108(version)
+		default:
+			return newMsg, m.ValidateBasic()
+		}
+	case *MsgTradeAccountWithdrawal:
+		return newMsg, m.ValidateBasicVersioned(version)
+	}
+	return newMsg, newMsg.ValidateBasic()
+}
@@ -174,6 +174,8 @@ func (h BondHandler) validateV107(ctx cosmos.Context, msg MsgBond) error {
 func (h BondHandler) handle(ctx cosmos.Context, msg MsgBond) error {
 	version := h.mgr.GetVersion()
 	switch {
+	case version.GTE(semver.MustParse("1.124.0")):
+		return h.handleV124(ctx, msg)
 	case version.GTE(semver.MustParse("1.107.0")):
 		return h.handleV107(ctx, msg)
 	case version.GTE(semver.MustParse("1.105.0")):
@@ -185,7 +187,7 @@ func (h BondHandler) handle(ctx cosmos.Context, msg MsgBond) error {
 	}
 }
 
-func (h BondHandler) handleV107(ctx cosmos.Context, msg MsgBond) error {
+func (h BondHandler) handleV124(ctx cosmos.Context, msg MsgBond) error {
 	nodeAccount, err := h.mgr.Keeper().GetNodeAccount(ctx, msg.NodeAddress)
 	if err != nil {
 		return ErrInternal(err, fmt.Sprintf("fail to get node account(%s)", msg.NodeAddress))
@@ -262,9 +264,18 @@ func (h BondHandler) handleV107(ctx cosmos.Context, msg MsgBond) error {
 		}
 	}
 
-	// Update operator fee (-1 means operator fee is not being set)
-	if msg.OperatorFee > -1 && msg.OperatorFee <= 10000 {
-		bp.NodeOperatorFee = cosmos.NewUint(uint64(msg.OperatorFee))
+	// Update operator fee (-1 means operator fee is not being set), an increase
+	// is delayed the same as with MsgNodeOperatorFee
+	var feeEvent *EventNodeOperatorFee
+	oldFee := int64(bp.NodeOperatorFee.Uint64())
+	if msg.OperatorFee > -1 && msg.OperatorFee <= 10000 && (msg.OperatorFee != oldFee || bp.HasPendingOperatorFee()) {
+		var operator cosmos.AccAddress
+		operator, err = nodeAccount.BondAddress.AccAddress()
+		if err != nil {
+			return ErrInternal(err, fmt.Sprintf("fail to parse bond address(%s)", nodeAccount.BondAddress))
+		}
+		applyHeight := setNodeOperatorFee(ctx, h.mgr, &bp, operator, msg.OperatorFee)
+		feeEvent = NewEventNodeOperatorFee(msg.NodeAddress, oldFee, msg.OperatorFee, applyHeight, msg.TxIn.ID)
 	}
 
 	units := msg.Units
@@ -310,6 +321,11 @@ func (h BondHandler) handleV107(ctx cosmos.Context, msg MsgBond) error {
 	if err := h.mgr.EventMgr().EmitEvent(ctx, bondEvent); err != nil {
 		ctx.Logger().Error("fail to emit bond event", "error", err)
 	}
+	if feeEvent != nil {
+		if err := h.mgr.EventMgr().EmitEvent(ctx, feeEvent); err != nil {
+			ctx.Logger().Error("fail to emit node operator fee event", "error", err)
+		}
+	}
 
 	return nil
 }
@@ -137,6 +137,135 @@ func (h BondHandler) validateV105(ctx cosmos.Context, msg MsgBond) error {
 	return nil
 }
 
+func (h BondHandler) handleV107(ctx cosmos.Context, msg MsgBond) error {
+	nodeAccount, err := h.mgr.Keeper().GetNodeAccount(ctx, msg.NodeAddress)
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to get node account(%s)", msg.NodeAddress))
+	}
+
+	acct := h.mgr.Keeper().GetAccount(ctx, msg.NodeAddress)
+
+	if nodeAccount.Status == NodeUnknown {
+		// THORNode will not have pub keys at the moment, so have to leave it empty
+		emptyPubKeySet := common.PubKeySet{
+			Secp256k1: common.EmptyPubKey,
+			Ed25519:   common.EmptyPubKey,
+		}
+		// white list the given bep address
+		nodeAccount = NewNodeAccount(msg.NodeAddress, NodeWhiteListed, emptyPubKeySet, "", "", cosmos.ZeroUint(), msg.BondAddress, ctx.BlockHeight())
+		ctx.EventManager().EmitEvent(
+			cosmos.NewEvent("new_node",
+				cosmos.NewAttribute("address", msg.NodeAddress.String()),
+			))
+	}
+
+	// when node bond for the first time , send 1 RUNE to node address
+	// so as the node address will be created on BASEChain otherwise node account won't be able to send tx
+	if acct == nil && msg.Amount.GTE(cosmos.NewUint(common.One)) {
+		// Send the same amount sent in the msg to the Node Account
+		// TODO: Refund any extra amount sent?
+		coins := common.NewCoins(common.NewCoin(common.BaseAsset(), msg.Amount))
+		if err = h.mgr.Keeper().SendFromModuleToAccount(ctx, BondName, msg.NodeAddress, coins); err != nil {
+			ctx.Logger().Error("fail to msg RUNE to node address", "error", err)
+			nodeAccount.Status = NodeUnknown
+		}
+
+		tx := common.Tx{}
+		tx.ID = common.BlankTxID
+		tx.ToAddress = common.Address(nodeAccount.String())
+		bondEvent := NewEventBondV105(common.BaseNative, coins[0].Amount, BondCost, tx)
+		if err = h.mgr.EventMgr().EmitEvent(ctx, bondEvent); err != nil {
+			ctx.Logger().Error("fail to emit bond event", "error", err)
+		}
+	}
+
+	var bp BondProviders
+	bp, err = h.mgr.Keeper().GetBondProviders(ctx, nodeAccount.NodeAddress)
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to get bond providers(%s)", msg.NodeAddress))
+	}
+
+	// if no providers yet, add node operator bond address to the bond provider list
+	if len(bp.Providers) == 0 {
+		// no providers yet, add node operator bond address to the bond provider list
+		var nodeOpBondAddr cosmos.AccAddress
+		nodeOpBondAddr, err = nodeAccount.BondAddress.AccAddress()
+		if err != nil {
+			return ErrInternal(err, fmt.Sprintf("fail to parse bond address(%s)", msg.BondAddress))
+		}
+		p := NewBondProvider(nodeOpBondAddr)
+		bp.Providers = append(bp.Providers, p)
+		defaultNodeOperationFee := h.mgr.Keeper().GetConfigInt64(ctx, constants.NodeOperatorFee)
+		bp.NodeOperatorFee = cosmos.NewUint(uint64(defaultNodeOperationFee))
+	}
+
+	// if bonder is node operator, add additional bonding address
+	if msg.BondAddress.Equals(nodeAccount.BondAddress) && !msg.BondProviderAddress.Empty() {
+		var max int64
+		max, err = h.mgr.Keeper().GetMimir(ctx, constants.MaxBondProviders.String())
+		if err != nil || max < 0 {
+			max = h.mgr.GetConstants().GetInt64Value(constants.MaxBondProviders)
+		}
+		if int64(len(bp.Providers)) >= max {
+			return fmt.Errorf("additional bond providers are not allowed, maximum reached")
+		}
+		if !bp.Has(msg.BondProviderAddress) {
+			bp.Providers = append(bp.Providers, NewBondProvider(msg.BondProviderAddress))
+		}
+	}
+
+	// Update operator fee (-1 means operator fee is not being set)
+	if msg.OperatorFee > -1 && msg.OperatorFee <= 10000 {
+		bp.NodeOperatorFee = cosmos.NewUint(uint64(msg.OperatorFee))
+	}
+
+	units := msg.Units
+	if !msg.Asset.IsEmpty() {
+		var lp LiquidityProvider
+		lp, err = h.mgr.Keeper().GetLiquidityProvider(ctx, msg.Asset, msg.BondAddress)
+		if err != nil {
+			return ErrInternal(err, fmt.Sprintf("fail to get liquidity provider: %s, %s", msg.BondAddress, msg.Asset))
+		}
+		if units.IsZero() {
+			units = lp.GetRemainingUnits()
+		}
+
+		lp.Bond(msg.NodeAddress, units)
+		h.mgr.Keeper().SetLiquidityProvider(ctx, lp)
+
+		from, err := msg.BondAddress.AccAddress()
+		if err != nil {
+			return ErrInternal(err, fmt.Sprintf("fail to get msg bond address(%s)", msg.BondAddress))
+		}
+		bp.BondLiquidity(from)
+	}
+
+	// we want to pay the rewards of the user that bonded not the specified one
+	if bp.HasRewards(msg.Signer) {
+		provider := bp.Get(msg.Signer)
+		err := payBondProviderReward(ctx, h.mgr, provider, bp)
+		if err != nil {
+			// we don't want to disrupt the bond process if we fail to pay the bond provider
+			ctx.Logger().Error("fail to pay bond provider reward", "error", err)
+		}
+	}
+
+	if err := h.mgr.Keeper().SetNodeAccount(ctx, nodeAccount); err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to save node account(%s)", nodeAccount.String()))
+	}
+
+	if err := h.mgr.Keeper().SetBondProviders(ctx, bp); err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to save bond providers(%s)", bp.NodeAddress.String()))
+	}
+
+	bondEvent := NewEventBondV105(msg.Asset, units, BondPaid, msg.TxIn)
+	if err := h.mgr.EventMgr().EmitEvent(ctx, bondEvent); err != nil {
+		ctx.Logger().Error("fail to emit bond event", "error", err)
+	}
+
+	return nil
+}
+
 func (h BondHandler) handleV105(ctx cosmos.Context, msg MsgBond) error {
 	nodeAccount, err := h.mgr.Keeper().GetNodeAccount(ctx, msg.NodeAddress)
 	if err != nil {
@@ -0,0 +1,130 @@
+package mayachain
+
+import (
+	"fmt"
+
+	"github.com/blang/semver"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+)
+
+// BondProviderRemoveHandler is handler to process MsgBondProviderRemove, which
+// lets a node operator remove a bond provider that has no liquidity bonded to
+// its node. Rewards not yet paid to the provider are paid out on removal.
+type BondProviderRemoveHandler struct {
+	mgr Manager
+}
+
+// NewBondProviderRemoveHandler create a new instance of BondProviderRemoveHandler
+func NewBondProviderRemoveHandler(mgr Manager) BondProviderRemoveHandler {
+	return BondProviderRemoveHandler{
+		mgr: mgr,
+	}
+}
+
+// Run is the main entry point for BondProviderRemoveHandler
+func (h BondProviderRemoveHandler) Run(ctx cosmos.Context, m cosmos.Msg) (*cosmos.Result, error) {
+	msg, ok := m.(*MsgBondProviderRemove)
+	if !ok {
+		return nil, errInvalidMessage
+	}
+	if err := h.validate(ctx, *msg); err != nil {
+		ctx.Logger().Error("MsgBondProviderRemove failed validation", "error", err)
+		return nil, err
+	}
+	err := h.handle(ctx, *msg)
+	if err != nil {
+		ctx.Logger().Error("fail to process MsgBondProviderRemove", "error", err)
+	}
+	return &cosmos.Result{}, err
+}
+
+func (h BondProviderRemoveHandler) validate(ctx cosmos.Context, msg MsgBondProviderRemove) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("1.124.0")):
+		return h.validateV124(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+func (h BondProviderRemoveHandler) validateV124(ctx cosmos.Context, msg MsgBondProviderRemove) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	nodeAccount, err := h.mgr.Keeper().GetNodeAccount(ctx, msg.NodeAddress)
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to get node account(%s)", msg.NodeAddress))
+	}
+	if nodeAccount.IsEmpty() || nodeAccount.BondAddress.IsEmpty() {
+		return cosmos.ErrUnknownRequest(fmt.Sprintf("node account(%s) has not been bonded yet", msg.NodeAddress))
+	}
+	operator, err := nodeAccount.BondAddress.AccAddress()
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to parse bond address(%s)", nodeAccount.BondAddress))
+	}
+	if !operator.Equals(msg.Signer) {
+		return cosmos.ErrUnauthorized("only the node operator can remove bond providers")
+	}
+	if operator.Equals(msg.ProviderAddress) {
+		return cosmos.ErrUnknownRequest("cannot remove the node operator")
+	}
+
+	bp, err := h.mgr.Keeper().GetBondProviders(ctx, msg.NodeAddress)
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to get bond providers(%s)", msg.NodeAddress))
+	}
+	if !bp.Has(msg.ProviderAddress) {
+		return cosmos.ErrUnknownRequest(fmt.Sprintf("%s is not a bond provider of this node", msg.ProviderAddress))
+	}
+	bond, err := h.mgr.Keeper().CalcLPLiquidityBond(ctx, common.Address(msg.ProviderAddress.String()), msg.NodeAddress)
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to calculate liquidity bond of %s", msg.ProviderAddress))
+	}
+	if !bond.IsZero() {
+		return cosmos.ErrUnknownRequest("cannot remove a bond provider that still has liquidity bonded to the node")
+	}
+	return nil
+}
+
+func (h BondProviderRemoveHandler) handle(ctx cosmos.Context, msg MsgBondProviderRemove) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("1.124.0")):
+		return h.handleV124(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+// handle process MsgBondProviderRemove
+func (h BondProviderRemoveHandler) handleV124(ctx cosmos.Context, msg MsgBondProviderRemove) error {
+	bp, err := h.mgr.Keeper().GetBondProviders(ctx, msg.NodeAddress)
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to get bond providers(%s)", msg.NodeAddress))
+	}
+
+	if bp.HasRewards(msg.ProviderAddress) {
+		provider := bp.Get(msg.ProviderAddress)
+		if err = payBondProviderReward(ctx, h.mgr, provider, bp); err != nil {
+			return ErrInternal(err, fmt.Sprintf("fail to pay bond provider reward(%s)", msg.ProviderAddress))
+		}
+	}
+
+	// the provider has no liquidity bonded, clear a stale bonded flag before removal
+	bp.Unbond(msg.ProviderAddress)
+	if !bp.Remove(msg.ProviderAddress) {
+		return fmt.Errorf("fail to remove bond provider(%s)", msg.ProviderAddress)
+	}
+	if err = h.mgr.Keeper().SetBondProviders(ctx, bp); err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to save bond providers(%s)", bp.NodeAddress.String()))
+	}
+
+	evt := NewEventBondProvider(msg.NodeAddress, msg.ProviderAddress, "remove", msg.Tx.ID)
+	if err := h.mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+		ctx.Logger().Error("fail to emit bond provider event", "error", err)
+	}
+	return nil
+}
@@ -0,0 +1,225 @@
+package mayachain
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/constants"
+)
+
+type HandlerBondProviderSuite struct{}
+
+var _ = Suite(&HandlerBondProviderSuite{})
+
+func (s *HandlerBondProviderSuite) SetUpSuite(c *C) {
+	SetupConfigForTest()
+}
+
+// setupBondProviderTest sets up a standby node and returns the address of its
+// operator
+func (s *HandlerBondProviderSuite) setupBondProviderTest(c *C) (cosmos.Context, *Mgrs, NodeAccount, cosmos.AccAddress) {
+	ctx, mgr := setupManagerForTest(c)
+	na := GetRandomValidatorNode(NodeStandby)
+	c.Assert(mgr.Keeper().SetNodeAccount(ctx, na), IsNil)
+	operator, err := na.BondAddress.AccAddress()
+	c.Assert(err, IsNil)
+	return ctx, mgr, na, operator
+}
+
+func hasBondProviderEvent(ctx cosmos.Context, evtType string) bool {
+	for _, evt := range ctx.EventManager().Events() {
+		if evt.Type == evtType {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *HandlerBondProviderSuite) TestWhitelistAndRemove(c *C) {
+	ctx, mgr, na, operator := s.setupBondProviderTest(c)
+	provider := GetRandomBaseAddress()
+	providerAcc, _ := provider.AccAddress()
+	whitelist := NewBondProviderWhitelistHandler(mgr)
+	remove := NewBondProviderRemoveHandler(mgr)
+
+	// only the operator can whitelist, and not itself
+	msg := NewMsgBondProviderWhitelist(na.NodeAddress, providerAcc, providerAcc, common.Tx{ID: GetRandomTxHash()})
+	c.Check(whitelist.validate(ctx, *msg), NotNil)
+	msg = NewMsgBondProviderWhitelist(na.NodeAddress, operator, operator, common.Tx{ID: GetRandomTxHash()})
+	c.Check(whitelist.validate(ctx, *msg), NotNil)
+	msg = NewMsgBondProviderWhitelist(GetRandomBech32Addr(), providerAcc, operator, common.Tx{ID: GetRandomTxHash()})
+	c.Check(whitelist.validate(ctx, *msg), NotNil)
+
+	msg = NewMsgBondProviderWhitelist(na.NodeAddress, providerAcc, operator, common.Tx{ID: GetRandomTxHash()})
+	_, err := whitelist.Run(ctx, msg)
+	c.Assert(err, IsNil)
+	bp, err := mgr.Keeper().GetBondProviders(ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Assert(bp.Providers, HasLen, 2)
+	c.Check(bp.Providers[0].BondAddress.Equals(operator), Equals, true)
+	c.Check(bp.Providers[1].BondAddress.Equals(providerAcc), Equals, true)
+	c.Check(bp.NodeOperatorFee.Uint64(), Equals, uint64(mgr.Keeper().GetConfigInt64(ctx, constants.NodeOperatorFee)))
+	c.Check(hasBondProviderEvent(ctx, "bond_provider"), Equals, true)
+
+	// no duplicates, and no more than the maximum
+	c.Check(whitelist.validate(ctx, *msg), NotNil)
+	mgr.Keeper().SetMimir(ctx, constants.MaxBondProviders.String(), 2)
+	msg = NewMsgBondProviderWhitelist(na.NodeAddress, GetRandomBech32Addr(), operator, common.Tx{ID: GetRandomTxHash()})
+	c.Check(whitelist.validate(ctx, *msg), NotNil)
+
+	// a provider with liquidity bonded can't be removed
+	SetupLiquidityBondForTestV105(c, ctx, mgr.Keeper(), common.BTCAsset, provider, na, cosmos.NewUint(100*common.One))
+	rm := NewMsgBondProviderRemove(na.NodeAddress, providerAcc, operator, common.Tx{ID: GetRandomTxHash()})
+	c.Check(remove.validate(ctx, *rm), NotNil)
+	lp, err := mgr.Keeper().GetLiquidityProvider(ctx, common.BTCAsset, provider)
+	c.Assert(err, IsNil)
+	mgr.Keeper().RemoveLiquidityProvider(ctx, lp)
+
+	// only the operator can remove, and not itself
+	rm = NewMsgBondProviderRemove(na.NodeAddress, providerAcc, providerAcc, common.Tx{ID: GetRandomTxHash()})
+	c.Check(remove.validate(ctx, *rm), NotNil)
+	rm = NewMsgBondProviderRemove(na.NodeAddress, operator, operator, common.Tx{ID: GetRandomTxHash()})
+	c.Check(remove.validate(ctx, *rm), NotNil)
+	rm = NewMsgBondProviderRemove(na.NodeAddress, GetRandomBech32Addr(), operator, common.Tx{ID: GetRandomTxHash()})
+	c.Check(remove.validate(ctx, *rm), NotNil)
+
+	// unpaid rewards are paid out on removal
+	FundModule(c, ctx, mgr.Keeper(), BondName, 10)
+	reward := cosmos.NewUint(5 * common.One)
+	bp, err = mgr.Keeper().GetBondProviders(ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	bp.Providers[1].Bonded = true
+	bp.Providers[1].Reward = &reward
+	c.Assert(mgr.Keeper().SetBondProviders(ctx, bp), IsNil)
+
+	rm = NewMsgBondProviderRemove(na.NodeAddress, providerAcc, operator, common.Tx{ID: GetRandomTxHash()})
+	_, err = remove.Run(ctx, rm)
+	c.Assert(err, IsNil)
+	bp, err = mgr.Keeper().GetBondProviders(ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Assert(bp.Providers, HasLen, 1)
+	c.Check(bp.Has(providerAcc), Equals, false)
+	balance := mgr.Keeper().GetBalance(ctx, providerAcc).AmountOf(common.BaseAsset().Native())
+	c.Check(balance.Uint64(), Equals, reward.Uint64())
+}
+
+func (s *HandlerBondProviderSuite) TestNodeOperatorFee(c *C) {
+	ctx, mgr, na, operator := s.setupBondProviderTest(c)
+	provider := GetRandomBech32Addr()
+	h := NewNodeOperatorFeeHandler(mgr)
+
+	// only the operator can set the fee
+	msg := NewMsgNodeOperatorFee(na.NodeAddress, 2000, provider, common.Tx{ID: GetRandomTxHash()})
+	c.Check(h.validate(ctx, *msg), NotNil)
+	msg = NewMsgNodeOperatorFee(na.NodeAddress, 10001, operator, common.Tx{ID: GetRandomTxHash()})
+	c.Check(h.validate(ctx, *msg), NotNil)
+
+	// while no other provider has bonded, a change is immediate
+	msg = NewMsgNodeOperatorFee(na.NodeAddress, 2000, operator, common.Tx{ID: GetRandomTxHash()})
+	_, err := h.Run(ctx, msg)
+	c.Assert(err, IsNil)
+	bp, err := mgr.Keeper().GetBondProviders(ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(bp.NodeOperatorFee.Uint64(), Equals, uint64(2000))
+	c.Check(bp.HasPendingOperatorFee(), Equals, false)
+	c.Check(hasBondProviderEvent(ctx, "node_operator_fee"), Equals, true)
+
+	bp.Providers = append(bp.Providers, NewBondProvider(provider))
+	bp.BondLiquidity(provider)
+	c.Assert(mgr.Keeper().SetBondProviders(ctx, bp), IsNil)
+
+	// an increase is delayed
+	delay := mgr.Keeper().GetConfigInt64(ctx, constants.NodeOperatorFeeChangeDelay)
+	msg = NewMsgNodeOperatorFee(na.NodeAddress, 3000, operator, common.Tx{ID: GetRandomTxHash()})
+	_, err = h.Run(ctx, msg)
+	c.Assert(err, IsNil)
+	bp, err = mgr.Keeper().GetBondProviders(ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(bp.NodeOperatorFee.Uint64(), Equals, uint64(2000))
+	c.Check(bp.PendingNodeOperatorFee, Equals, int64(3000))
+	c.Check(bp.PendingNodeOperatorFeeHeight, Equals, ctx.BlockHeight()+delay)
+
+	// a decrease is immediate and replaces the pending increase
+	msg = NewMsgNodeOperatorFee(na.NodeAddress, 1000, operator, common.Tx{ID: GetRandomTxHash()})
+	_, err = h.Run(ctx, msg)
+	c.Assert(err, IsNil)
+	bp, err = mgr.Keeper().GetBondProviders(ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(bp.NodeOperatorFee.Uint64(), Equals, uint64(1000))
+	c.Check(bp.HasPendingOperatorFee(), Equals, false)
+}
+
+func (s *HandlerBondProviderSuite) TestTransfer(c *C) {
+	ctx, mgr, na, operator := s.setupBondProviderTest(c)
+	k := mgr.Keeper()
+	h := NewBondProviderTransferHandler(mgr)
+	provider := GetRandomBaseAddress()
+	providerAcc, _ := provider.AccAddress()
+
+	SetupLiquidityBondForTestV105(c, ctx, k, common.BTCAsset, na.BondAddress, na, cosmos.NewUint(100*common.One))
+	// the receiver holds liquidity in the pool, but hasn't bonded any of it
+	lp, _ := SetupLiquidityBondForTestV105(c, ctx, k, common.BTCAsset, provider, na, cosmos.NewUint(10*common.One))
+	lp.BondedNodes = nil
+	lp.LastAddHeight = 0
+	k.SetLiquidityProvider(ctx, lp)
+
+	bp := NewBondProviders(na.NodeAddress)
+	bp.Providers = append(bp.Providers, NewBondProvider(operator))
+	bp.BondLiquidity(operator)
+	c.Assert(k.SetBondProviders(ctx, bp), IsNil)
+	nodeBond, err := k.CalcNodeLiquidityBond(ctx, na)
+	c.Assert(err, IsNil)
+
+	// the receiver has to be a provider of the node
+	tx := common.Tx{ID: GetRandomTxHash()}
+	msg := NewMsgBondProviderTransfer(na.NodeAddress, providerAcc, common.BTCAsset, cosmos.NewUint(40*common.One), operator, tx)
+	c.Check(h.validate(ctx, *msg), NotNil)
+	bp.Providers = append(bp.Providers, NewBondProvider(providerAcc))
+	c.Assert(k.SetBondProviders(ctx, bp), IsNil)
+	c.Check(h.validate(ctx, *msg), IsNil)
+
+	// can't move more than is bonded, nor from a pool without a bond
+	msg = NewMsgBondProviderTransfer(na.NodeAddress, providerAcc, common.BTCAsset, cosmos.NewUint(101*common.One), operator, tx)
+	c.Check(h.validate(ctx, *msg), NotNil)
+	msg = NewMsgBondProviderTransfer(na.NodeAddress, providerAcc, common.ETHAsset, cosmos.ZeroUint(), operator, tx)
+	c.Check(h.validate(ctx, *msg), NotNil)
+	msg = NewMsgBondProviderTransfer(na.NodeAddress, operator, common.BTCAsset, cosmos.ZeroUint(), providerAcc, tx)
+	c.Check(h.validate(ctx, *msg), NotNil)
+
+	msg = NewMsgBondProviderTransfer(na.NodeAddress, providerAcc, common.BTCAsset, cosmos.NewUint(40*common.One), operator, tx)
+	_, err = h.Run(ctx, msg)
+	c.Assert(err, IsNil)
+	c.Check(hasBondProviderEvent(ctx, "bond_provider_transfer"), Equals, true)
+
+	from, err := k.GetLiquidityProvider(ctx, common.BTCAsset, na.BondAddress)
+	c.Assert(err, IsNil)
+	c.Check(from.Units.Uint64(), Equals, uint64(60*common.One))
+	c.Check(from.GetUnitsBondedToNode(na.NodeAddress).Uint64(), Equals, uint64(60*common.One))
+	to, err := k.GetLiquidityProvider(ctx, common.BTCAsset, provider)
+	c.Assert(err, IsNil)
+	c.Check(to.Units.Uint64(), Equals, uint64(50*common.One))
+	c.Check(to.GetUnitsBondedToNode(na.NodeAddress).Uint64(), Equals, uint64(40*common.One))
+	c.Check(to.LastAddHeight, Equals, from.LastAddHeight)
+
+	bp, err = k.GetBondProviders(ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(bp.Get(providerAcc).Bonded, Equals, true)
+	c.Check(bp.Get(operator).Bonded, Equals, true)
+
+	// the bond of the node doesn't change
+	bond, err := k.CalcNodeLiquidityBond(ctx, na)
+	c.Assert(err, IsNil)
+	c.Check(bond.Uint64(), Equals, nodeBond.Uint64())
+
+	// zero units moves everything that is left, the sender is no longer bonded
+	msg = NewMsgBondProviderTransfer(na.NodeAddress, providerAcc, common.BTCAsset, cosmos.ZeroUint(), operator, common.Tx{ID: GetRandomTxHash()})
+	_, err = h.Run(ctx, msg)
+	c.Assert(err, IsNil)
+	bp, err = k.GetBondProviders(ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(bp.Get(operator).Bonded, Equals, false)
+	to, err = k.GetLiquidityProvider(ctx, common.BTCAsset, provider)
+	c.Assert(err, IsNil)
+	c.Check(to.GetUnitsBondedToNode(na.NodeAddress).Uint64(), Equals, uint64(100*common.One))
+}
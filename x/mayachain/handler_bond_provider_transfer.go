@@ -0,0 +1,185 @@
+package mayachain
+
+import (
+	"fmt"
+
+	"github.com/blang/semver"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+)
+
+// BondProviderTransferHandler is handler to process MsgBondProviderTransfer,
+// which moves LP units a bond provider has bonded to a node over to another
+// bond provider of the same node. The units stay bonded, so the bond of the
+// node doesn't change.
+type BondProviderTransferHandler struct {
+	mgr Manager
+}
+
+// NewBondProviderTransferHandler create a new instance of BondProviderTransferHandler
+func NewBondProviderTransferHandler(mgr Manager) BondProviderTransferHandler {
+	return BondProviderTransferHandler{
+		mgr: mgr,
+	}
+}
+
+// Run is the main entry point for BondProviderTransferHandler
+func (h BondProviderTransferHandler) Run(ctx cosmos.Context, m cosmos.Msg) (*cosmos.Result, error) {
+	msg, ok := m.(*MsgBondProviderTransfer)
+	if !ok {
+		return nil, errInvalidMessage
+	}
+	if err := h.validate(ctx, *msg); err != nil {
+		ctx.Logger().Error("MsgBondProviderTransfer failed validation", "error", err)
+		return nil, err
+	}
+	err := h.handle(ctx, *msg)
+	if err != nil {
+		ctx.Logger().Error("fail to process MsgBondProviderTransfer", "error", err)
+	}
+	return &cosmos.Result{}, err
+}
+
+func (h BondProviderTransferHandler) validate(ctx cosmos.Context, msg MsgBondProviderTransfer) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("1.124.0")):
+		return h.validateV124(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+func (h BondProviderTransferHandler) validateV124(ctx cosmos.Context, msg MsgBondProviderTransfer) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	nodeAccount, err := h.mgr.Keeper().GetNodeAccount(ctx, msg.NodeAddress)
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to get node account(%s)", msg.NodeAddress))
+	}
+	if nodeAccount.IsEmpty() {
+		return cosmos.ErrUnknownRequest(fmt.Sprintf("node account(%s) doesn't exist", msg.NodeAddress))
+	}
+
+	bp, err := h.mgr.Keeper().GetBondProviders(ctx, msg.NodeAddress)
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to get bond providers(%s)", msg.NodeAddress))
+	}
+	if !bp.Has(msg.Signer) {
+		return cosmos.ErrUnauthorized("address is not a valid bond provider for this node")
+	}
+	if !bp.Has(msg.ToAddress) {
+		return cosmos.ErrUnknownRequest(fmt.Sprintf("%s is not a bond provider of this node", msg.ToAddress))
+	}
+
+	liquidityPools := GetLiquidityPools(h.mgr.GetVersion())
+	if found := common.ContainsAsset(msg.Asset, liquidityPools); !found {
+		return cosmos.ErrUnknownRequest("asset is not in valid liquidity pools list")
+	}
+
+	from, err := h.mgr.Keeper().GetLiquidityProvider(ctx, msg.Asset, common.Address(msg.Signer.String()))
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to get liquidity provider: %s, %s", msg.Signer, msg.Asset))
+	}
+	bonded := from.GetUnitsBondedToNode(msg.NodeAddress)
+	if bonded.IsZero() {
+		return cosmos.ErrUnknownRequest(fmt.Sprintf("no liquidity units bonded to the node: %s, %s", msg.Signer, msg.Asset))
+	}
+	if bonded.LT(msg.Units) {
+		return cosmos.ErrUnknownRequest(fmt.Sprintf("insufficient liquidity units bonded to the node: %s, %s only has %s bonded units", msg.Signer, msg.Asset, bonded))
+	}
+
+	// the units are moved into an existing position, so the asset side of it
+	// keeps being paid out to an address the receiver controls
+	to, err := h.mgr.Keeper().GetLiquidityProvider(ctx, msg.Asset, common.Address(msg.ToAddress.String()))
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to get liquidity provider: %s, %s", msg.ToAddress, msg.Asset))
+	}
+	if to.Units.IsZero() {
+		return cosmos.ErrUnknownRequest(fmt.Sprintf("%s has no liquidity in pool %s", msg.ToAddress, msg.Asset))
+	}
+	maxLPBondedNodes, err := h.mgr.Keeper().GetMimir(ctx, "MaximumLPBondedNodes")
+	if maxLPBondedNodes > 0 && err == nil {
+		if len(to.BondedNodes) >= int(maxLPBondedNodes) && to.GetUnitsBondedToNode(msg.NodeAddress).IsZero() {
+			return cosmos.ErrUnknownRequest("lp has reached maximum bonded nodes")
+		}
+	}
+	return nil
+}
+
+func (h BondProviderTransferHandler) handle(ctx cosmos.Context, msg MsgBondProviderTransfer) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("1.124.0")):
+		return h.handleV124(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+// handle process MsgBondProviderTransfer
+func (h BondProviderTransferHandler) handleV124(ctx cosmos.Context, msg MsgBondProviderTransfer) error {
+	fromAddr := common.Address(msg.Signer.String())
+	from, err := h.mgr.Keeper().GetLiquidityProvider(ctx, msg.Asset, fromAddr)
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to get liquidity provider: %s, %s", msg.Signer, msg.Asset))
+	}
+	to, err := h.mgr.Keeper().GetLiquidityProvider(ctx, msg.Asset, common.Address(msg.ToAddress.String()))
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to get liquidity provider: %s, %s", msg.ToAddress, msg.Asset))
+	}
+
+	units := msg.Units
+	if units.IsZero() {
+		units = from.GetUnitsBondedToNode(msg.NodeAddress)
+	}
+
+	// deposit values move along with the units
+	cacaoDepositValue := common.GetSafeShare(units, from.Units, from.CacaoDepositValue)
+	assetDepositValue := common.GetSafeShare(units, from.Units, from.AssetDepositValue)
+
+	from.Unbond(msg.NodeAddress, units)
+	from.Units = common.SafeSub(from.Units, units)
+	from.CacaoDepositValue = common.SafeSub(from.CacaoDepositValue, cacaoDepositValue)
+	from.AssetDepositValue = common.SafeSub(from.AssetDepositValue, assetDepositValue)
+
+	to.Bond(msg.NodeAddress, units)
+	to.Units = to.Units.Add(units)
+	to.CacaoDepositValue = to.CacaoDepositValue.Add(cacaoDepositValue)
+	to.AssetDepositValue = to.AssetDepositValue.Add(assetDepositValue)
+	// don't let the transfer be used to skip the liquidity lockup
+	if from.LastAddHeight > to.LastAddHeight {
+		to.LastAddHeight = from.LastAddHeight
+	}
+
+	if from.Units.IsZero() && from.PendingAsset.IsZero() && from.PendingCacao.IsZero() {
+		h.mgr.Keeper().RemoveLiquidityProvider(ctx, from)
+	} else {
+		h.mgr.Keeper().SetLiquidityProvider(ctx, from)
+	}
+	h.mgr.Keeper().SetLiquidityProvider(ctx, to)
+
+	bp, err := h.mgr.Keeper().GetBondProviders(ctx, msg.NodeAddress)
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to get bond providers(%s)", msg.NodeAddress))
+	}
+	bp.BondLiquidity(msg.ToAddress)
+	bond, err := h.mgr.Keeper().CalcLPLiquidityBond(ctx, fromAddr, msg.NodeAddress)
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to calculate liquidity bond of %s", msg.Signer))
+	}
+	if bond.IsZero() {
+		bp.Unbond(msg.Signer)
+	}
+	if err := h.mgr.Keeper().SetBondProviders(ctx, bp); err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to save bond providers(%s)", bp.NodeAddress.String()))
+	}
+
+	evt := NewEventBondProviderTransfer(msg.NodeAddress, msg.Signer, msg.ToAddress, msg.Asset, units, msg.Tx.ID)
+	if err := h.mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+		ctx.Logger().Error("fail to emit bond provider transfer event", "error", err)
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package mayachain
+
+import (
+	"fmt"
+
+	"github.com/blang/semver"
+
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/constants"
+)
+
+// BondProviderWhitelistHandler is handler to process MsgBondProviderWhitelist,
+// which lets a node operator add a bond provider to its node
+type BondProviderWhitelistHandler struct {
+	mgr Manager
+}
+
+// NewBondProviderWhitelistHandler create a new instance of BondProviderWhitelistHandler
+func NewBondProviderWhitelistHandler(mgr Manager) BondProviderWhitelistHandler {
+	return BondProviderWhitelistHandler{
+		mgr: mgr,
+	}
+}
+
+// Run is the main entry point for BondProviderWhitelistHandler
+func (h BondProviderWhitelistHandler) Run(ctx cosmos.Context, m cosmos.Msg) (*cosmos.Result, error) {
+	msg, ok := m.(*MsgBondProviderWhitelist)
+	if !ok {
+		return nil, errInvalidMessage
+	}
+	if err := h.validate(ctx, *msg); err != nil {
+		ctx.Logger().Error("MsgBondProviderWhitelist failed validation", "error", err)
+		return nil, err
+	}
+	err := h.handle(ctx, *msg)
+	if err != nil {
+		ctx.Logger().Error("fail to process MsgBondProviderWhitelist", "error", err)
+	}
+	return &cosmos.Result{}, err
+}
+
+func (h BondProviderWhitelistHandler) validate(ctx cosmos.Context, msg MsgBondProviderWhitelist) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("1.124.0")):
+		return h.validateV124(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+func (h BondProviderWhitelistHandler) validateV124(ctx cosmos.Context, msg MsgBondProviderWhitelist) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	nodeAccount, err := h.mgr.Keeper().GetNodeAccount(ctx, msg.NodeAddress)
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to get node account(%s)", msg.NodeAddress))
+	}
+	if nodeAccount.IsEmpty() || nodeAccount.BondAddress.IsEmpty() {
+		return cosmos.ErrUnknownRequest(fmt.Sprintf("node account(%s) has not been bonded yet", msg.NodeAddress))
+	}
+	operator, err := nodeAccount.BondAddress.AccAddress()
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to parse bond address(%s)", nodeAccount.BondAddress))
+	}
+	if !operator.Equals(msg.Signer) {
+		return cosmos.ErrUnauthorized("only the node operator can whitelist bond providers")
+	}
+	if operator.Equals(msg.ProviderAddress) {
+		return cosmos.ErrUnknownRequest("the node operator is always a bond provider")
+	}
+
+	bp, err := h.mgr.Keeper().GetBondProviders(ctx, msg.NodeAddress)
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to get bond providers(%s)", msg.NodeAddress))
+	}
+	if bp.Has(msg.ProviderAddress) {
+		return cosmos.ErrUnknownRequest(fmt.Sprintf("%s is already a bond provider of this node", msg.ProviderAddress))
+	}
+
+	// the node operator is added to the list on the first whitelist
+	providers := int64(len(bp.Providers))
+	if providers == 0 {
+		providers = 1
+	}
+	max, err := h.mgr.Keeper().GetMimir(ctx, constants.MaxBondProviders.String())
+	if err != nil || max < 0 {
+		max = h.mgr.GetConstants().GetInt64Value(constants.MaxBondProviders)
+	}
+	if providers >= max {
+		return cosmos.ErrUnknownRequest("additional bond providers are not allowed, maximum reached")
+	}
+	return nil
+}
+
+func (h BondProviderWhitelistHandler) handle(ctx cosmos.Context, msg MsgBondProviderWhitelist) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("1.124.0")):
+		return h.handleV124(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+// handle process MsgBondProviderWhitelist
+func (h BondProviderWhitelistHandler) handleV124(ctx cosmos.Context, msg MsgBondProviderWhitelist) error {
+	bp, err := h.mgr.Keeper().GetBondProviders(ctx, msg.NodeAddress)
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to get bond providers(%s)", msg.NodeAddress))
+	}
+
+	// no providers yet, add node operator bond address to the bond provider list
+	if len(bp.Providers) == 0 {
+		bp.Providers = append(bp.Providers, NewBondProvider(msg.Signer))
+		defaultNodeOperationFee := h.mgr.Keeper().GetConfigInt64(ctx, constants.NodeOperatorFee)
+		bp.NodeOperatorFee = cosmos.NewUint(uint64(defaultNodeOperationFee))
+	}
+	bp.Providers = append(bp.Providers, NewBondProvider(msg.ProviderAddress))
+
+	if err := h.mgr.Keeper().SetBondProviders(ctx, bp); err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to save bond providers(%s)", bp.NodeAddress.String()))
+	}
+
+	evt := NewEventBondProvider(msg.NodeAddress, msg.ProviderAddress, "whitelist", msg.Tx.ID)
+	if err := h.mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+		ctx.Logger().Error("fail to emit bond provider event", "error", err)
+	}
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 
 	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/constants"
 	"gitlab.com/mayachain/mayanode/x/mayachain/keeper"
 )
 
@@ -353,7 +354,8 @@ func (HandlerBondSuite) TestBondProvider_OperatorFee(c *C) {
 	// Check that a bond provider for the operator + new provider was added
 	c.Assert(len(bp.Providers), Equals, 2)
 
-	// try to increase operator fee after provider has bonded, should success , because bond providers should trust each other
+	// an increase after a provider has bonded is delayed, the same as with
+	// MsgNodeOperatorFee
 	bp.Providers[1].Bonded = true
 	bp.NodeOperatorFee = cosmos.NewUint(5000)
 	c.Assert(k.SetBondProviders(ctx, bp), IsNil)
@@ -363,9 +365,13 @@ func (HandlerBondSuite) TestBondProvider_OperatorFee(c *C) {
 	err = handler.handle(ctx, *msg)
 	c.Assert(err, IsNil)
 	bp, _ = k.GetBondProviders(ctx, standbyNodeAccount.NodeAddress)
-	c.Assert(bp.NodeOperatorFee.Uint64(), Equals, uint64(6000))
+	c.Assert(bp.NodeOperatorFee.Uint64(), Equals, uint64(5000))
+	c.Assert(bp.HasPendingOperatorFee(), Equals, true)
+	c.Assert(bp.PendingNodeOperatorFee, Equals, int64(6000))
+	c.Assert(bp.PendingNodeOperatorFeeHeight, Equals, ctx.BlockHeight()+k.GetConfigInt64(ctx, constants.NodeOperatorFeeChangeDelay))
 
-	// Should be able to decrease operator fee after provider has bonded
+	// Should be able to decrease operator fee after provider has bonded, which
+	// replaces the pending increase
 	msg = NewMsgBond(txIn, standbyNodeAddr, cosmos.NewUint(common.One), operatorBondAddress, providerAccAddr, operatorAccAddress, 4000, common.EmptyAsset, cosmos.ZeroUint())
 	err = handler.validate(ctx, *msg)
 	c.Assert(err, IsNil)
@@ -373,6 +379,7 @@ func (HandlerBondSuite) TestBondProvider_OperatorFee(c *C) {
 	c.Assert(err, IsNil)
 	bp, _ = k.GetBondProviders(ctx, standbyNodeAccount.NodeAddress)
 	c.Assert(bp.NodeOperatorFee.Uint64(), Equals, uint64(4000))
+	c.Assert(bp.HasPendingOperatorFee(), Equals, false)
 
 	// Only operator can set operator fee
 	msg = NewMsgBond(txIn, standbyNodeAddr, amt, providerBondAddress, providerAccAddr, providerAccAddr, 0, common.EmptyAsset, cosmos.ZeroUint())
@@ -75,6 +75,8 @@ func (h CacaoPoolDepositHandler) validateV118(ctx cosmos.Context, msg MsgCacaoPo
 func (h CacaoPoolDepositHandler) handle(ctx cosmos.Context, msg MsgCacaoPoolDeposit) error {
 	version := h.mgr.GetVersion()
 	switch {
+	case version.GTE(semver.MustParse("1.124.0")):
+		return h.handleV124(ctx, msg)
 	case version.GTE(semver.MustParse("1.118.0")):
 		return h.handleV118(ctx, msg)
 	default:
@@ -82,7 +84,7 @@ func (h CacaoPoolDepositHandler) handle(ctx cosmos.Context, msg MsgCacaoPoolDepo
 	}
 }
 
-func (h CacaoPoolDepositHandler) handleV118(ctx cosmos.Context, msg MsgCacaoPoolDeposit) error {
+func (h CacaoPoolDepositHandler) handleV124(ctx cosmos.Context, msg MsgCacaoPoolDeposit) error {
 	// get cacao pool value before deposit
 	cacaoPoolValue, err := cacaoPoolValue(ctx, h.mgr)
 	if err != nil {
@@ -107,6 +109,13 @@ func (h CacaoPoolDepositHandler) handleV118(ctx cosmos.Context, msg MsgCacaoPool
 
 	cacaoProvider.LastDepositHeight = ctx.BlockHeight()
 	cacaoProvider.DepositAmount = cacaoProvider.DepositAmount.Add(msg.Tx.Coins[0].Amount)
+	// the auto-compound flag only changes when the deposit sets it
+	switch msg.AutoCompound {
+	case 0:
+		cacaoProvider.AutoCompound = false
+	case 1:
+		cacaoProvider.AutoCompound = true
+	}
 
 	// cacao pool tracks the reserve and pooler unit shares of pol
 	cacaoPool, err := h.mgr.Keeper().GetCACAOPool(ctx)
@@ -135,6 +144,7 @@ func (h CacaoPoolDepositHandler) handleV118(ctx cosmos.Context, msg MsgCacaoPool
 		"address", msg.Signer,
 		"units", depositUnits,
 		"amount", msg.Tx.Coins[0].Amount,
+		"auto_compound", cacaoProvider.AutoCompound,
 	)
 
 	depositEvent := NewEventCACAOPoolDeposit(
@@ -0,0 +1,90 @@
+package mayachain
+
+import (
+	"fmt"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+)
+
+func (h CacaoPoolDepositHandler) handleV118(ctx cosmos.Context, msg MsgCacaoPoolDeposit) error {
+	// get cacao pool value before deposit
+	cacaoPoolValue, err := cacaoPoolValue(ctx, h.mgr)
+	if err != nil {
+		return fmt.Errorf("fail to get cacao pool value: %s", err)
+	}
+
+	// send deposit to cacaopool module
+	err = h.mgr.Keeper().SendFromModuleToModule(
+		ctx,
+		AsgardName,
+		CACAOPoolName,
+		common.Coins{msg.Tx.Coins[0]},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to SendFromModuleToModule: %s", err)
+	}
+
+	cacaoProvider, err := h.mgr.Keeper().GetCACAOProvider(ctx, msg.Signer)
+	if err != nil {
+		return fmt.Errorf("unable to GetCACAOProvider: %s", err)
+	}
+
+	cacaoProvider.LastDepositHeight = ctx.BlockHeight()
+	cacaoProvider.DepositAmount = cacaoProvider.DepositAmount.Add(msg.Tx.Coins[0].Amount)
+
+	// cacao pool tracks the reserve and pooler unit shares of pol
+	cacaoPool, err := h.mgr.Keeper().GetCACAOPool(ctx)
+	if err != nil {
+		return fmt.Errorf("fail to get cacao pool: %s", err)
+	}
+
+	// if there are no units, this is the initial deposit
+	depositUnits := msg.Tx.Coins[0].Amount
+
+	// compute deposit units
+	if !cacaoPool.TotalUnits().IsZero() {
+		depositCacao := msg.Tx.Coins[0].Amount
+		depositUnits = common.GetSafeShare(depositCacao, cacaoPoolValue, cacaoPool.TotalUnits())
+	}
+
+	// update the provider and cacao pool records
+	cacaoProvider.Units = cacaoProvider.Units.Add(depositUnits)
+	h.mgr.Keeper().SetCACAOProvider(ctx, cacaoProvider)
+	cacaoPool.PoolUnits = cacaoPool.PoolUnits.Add(depositUnits)
+	cacaoPool.CacaoDeposited = cacaoPool.CacaoDeposited.Add(msg.Tx.Coins[0].Amount)
+	h.mgr.Keeper().SetCACAOPool(ctx, cacaoPool)
+
+	ctx.Logger().Info(
+		"cacaopool deposit",
+		"address", msg.Signer,
+		"units", depositUnits,
+		"amount", msg.Tx.Coins[0].Amount,
+	)
+
+	depositEvent := NewEventCACAOPoolDeposit(
+		cacaoProvider.CacaoAddress,
+		msg.Tx.Coins[0].Amount,
+		depositUnits,
+		msg.Tx.ID,
+	)
+	if err := h.mgr.EventMgr().EmitEvent(ctx, depositEvent); err != nil {
+		ctx.Logger().Error("fail to emit cacao pool deposit event", "error", err)
+	}
+
+	telemetry.IncrCounterWithLabels(
+		[]string{"mayanode", "cacao_pool", "deposit_count"},
+		float32(1),
+		[]metrics.Label{},
+	)
+	telemetry.IncrCounterWithLabels(
+		[]string{"mayanode", "cacao_pool", "deposit_amount"},
+		telem(depositEvent.CacaoAmount),
+		[]metrics.Label{},
+	)
+
+	return nil
+}
@@ -492,6 +492,62 @@ func (s *CacaoPoolTestSuite) TestCACAOPoolOneProvider(c *C) {
 	c.Assert(newBal, Equals, prevBal-cacaoFee+496000)
 }
 
+func (s *CacaoPoolTestSuite) TestCACAOPoolQueuedWithdrawAndAutoCompound(c *C) {
+	s.mgr.Keeper().SetMimir(s.ctx, constants.CACAOPoolDepositMaturityBlocks.String(), 3)
+	s.mgr.Keeper().SetMimir(s.ctx, constants.CACAOPoolAutoCompoundInterval.String(), 1)
+
+	// fox opts in to auto-compounding, cat doesn't
+	s.txDeposit("1000", "pool+", s.accMayaCat, 0, c)
+	depositHeight := s.ctx.BlockHeight()
+	s.txDeposit("1000", "pool+:1", s.accMayaFox, 0, c)
+	pFox := s.getCACAOProvider(s.addrMayaFox, c)
+	c.Assert(pFox.Units, Equals, "1000")
+	c.Assert(pFox.GetAutoCompound(), Equals, true)
+	c.Assert(pFox.HasCompoundedAmount(), Equals, false)
+	pCat := s.getCACAOProvider(s.addrMayaCat, c)
+	c.Assert(pCat.Units, Equals, "1000")
+	c.Assert(pCat.HasAutoCompound(), Equals, false)
+
+	// withdraw before maturity is queued
+	s.txDeposit("0", "pool-:10000", s.accMayaFox, 0, c)
+	pFox = s.getCACAOProvider(s.addrMayaFox, c)
+	c.Assert(pFox.Units, Equals, "1000")
+	c.Assert(pFox.WithdrawAmount, Equals, "0")
+	c.Assert(pFox.GetQueuedWithdrawBasisPoints(), Equals, int64(10000))
+	c.Assert(pFox.GetQueuedWithdrawHeight(), Equals, depositHeight+3)
+
+	// make swap to generate swap fee, at the end of the block the yield of fox
+	// is re-deposited and the one of cat is left as it is
+	catBal := s.mgr.Keeper().GetBalance(s.ctx, s.accMayaCat).AmountOf(common.BaseNative.Native()).BigInt().Uint64()
+	s.txDeposit("100000000000", fmt.Sprintf("=:BTC.BTC:%s", s.addrBtcFox), s.accMayaFox, 1, c)
+	pFox = s.getCACAOProvider(s.addrMayaFox, c)
+	c.Assert(pFox.Units, Equals, "1000")
+	c.Assert(pFox.Value, Equals, "248500")
+	c.Assert(pFox.GetCompoundedAmount(), Equals, "247500")
+	pCat = s.getCACAOProvider(s.addrMayaCat, c)
+	c.Assert(pCat.Units, Equals, "1000")
+	c.Assert(pCat.Value, Equals, "248500")
+	c.Assert(pCat.HasCompoundedAmount(), Equals, false)
+	newCatBal := s.mgr.Keeper().GetBalance(s.ctx, s.accMayaCat).AmountOf(common.BaseNative.Native()).BigInt().Uint64()
+	c.Assert(newCatBal, Equals, catBal)
+
+	// queued withdraw is executed once the deposit has matured
+	s.mockTxOutStore.tois = nil
+	s.endBlock(0, c)
+	pFox = s.getCACAOProvider(s.addrMayaFox, c)
+	c.Assert(pFox.Units, Equals, "0")
+	c.Assert(pFox.WithdrawAmount, Equals, "248500")
+	c.Assert(pFox.HasQueuedWithdrawBasisPoints(), Equals, false)
+
+	// a deposit without the flag leaves it as it is, it's only cleared explicitly
+	s.txDeposit("1000", "pool+", s.accMayaFox, 0, c)
+	pFox = s.getCACAOProvider(s.addrMayaFox, c)
+	c.Assert(pFox.GetAutoCompound(), Equals, true)
+	s.txDeposit("1000", "pool+:0", s.accMayaFox, 0, c)
+	pFox = s.getCACAOProvider(s.addrMayaFox, c)
+	c.Assert(pFox.HasAutoCompound(), Equals, false)
+}
+
 func (s *CacaoPoolTestSuite) TestCACAOPoolTwoProviders(c *C) {
 	// CACAOPool is empty
 	cp := s.getCACAOPool(c)
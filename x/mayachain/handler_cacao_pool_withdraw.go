@@ -10,6 +10,7 @@ import (
 	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
 	"gitlab.com/mayachain/mayanode/constants"
+	"gitlab.com/mayachain/mayanode/x/mayachain/types"
 )
 
 // CacaoPoolWithdrawHandler a handler to process withdrawals from CacaoPool
@@ -76,6 +77,8 @@ func (h CacaoPoolWithdrawHandler) validateV118(ctx cosmos.Context, msg MsgCacaoP
 func (h CacaoPoolWithdrawHandler) handle(ctx cosmos.Context, msg MsgCacaoPoolWithdraw) error {
 	version := h.mgr.GetVersion()
 	switch {
+	case version.GTE(semver.MustParse("1.124.0")):
+		return h.handleV124(ctx, msg)
 	case version.GTE(semver.MustParse("1.121.0")): // cacaopool-aff
 		return h.handleV121(ctx, msg)
 	case version.GTE(semver.MustParse("1.118.0")):
@@ -85,6 +88,43 @@ func (h CacaoPoolWithdrawHandler) handle(ctx cosmos.Context, msg MsgCacaoPoolWit
 	}
 }
 
+// handleV124 queues a withdraw requested before the last deposit of the provider
+// reached maturity, the network manager executes it once the deposit matures
+func (h CacaoPoolWithdrawHandler) handleV124(ctx cosmos.Context, msg MsgCacaoPoolWithdraw) error {
+	cacaoProvider, err := h.mgr.Keeper().GetCACAOProvider(ctx, msg.Signer)
+	if err != nil {
+		return fmt.Errorf("unable to GetCACAOProvider: %s", err)
+	}
+	if cacaoProvider.Units.IsZero() {
+		return fmt.Errorf("no CACAO pool position for %s", msg.Signer)
+	}
+
+	depositMaturity := h.mgr.GetConfigInt64(ctx, constants.CACAOPoolDepositMaturityBlocks)
+	matureHeight := cacaoProvider.LastDepositHeight + depositMaturity
+	if ctx.BlockHeight() >= matureHeight {
+		return h.handleV121(ctx, msg)
+	}
+
+	h.mgr.Keeper().SetCACAOPoolWithdrawRequest(ctx, msg)
+	ctx.Logger().Info(
+		"cacaopool withdraw queued",
+		"address", msg.Signer,
+		"basis_points", msg.BasisPoints,
+		"mature_height", matureHeight,
+	)
+
+	queuedEvent := types.NewEventCACAOPoolWithdrawQueued(
+		msg.Signer,
+		int64(msg.BasisPoints.Uint64()),
+		matureHeight,
+		msg.Tx.ID,
+	)
+	if err := h.mgr.EventMgr().EmitEvent(ctx, queuedEvent); err != nil {
+		ctx.Logger().Error("fail to emit cacao pool withdraw queued event", "error", err)
+	}
+	return nil
+}
+
 func (h CacaoPoolWithdrawHandler) handleV121(ctx cosmos.Context, msg MsgCacaoPoolWithdraw) error {
 	cacaoProvider, err := h.mgr.Keeper().GetCACAOProvider(ctx, msg.Signer)
 	if err != nil {
@@ -247,7 +247,11 @@ func (h DepositHandler) handleV123(ctx cosmos.Context, msg MsgDeposit) (*cosmos.
 	if isSwap {
 		msg, ok := m.(*MsgSwap)
 		if ok {
-			h.addSwap(ctx, *msg)
+			if err := h.addSwap(ctx, *msg); err != nil {
+				if newErr := refundTx(ctx, txIn, h.mgr, CodeSwapFail, err.Error(), targetModule); nil != newErr {
+					return nil, newErr
+				}
+			}
 		}
 		return &cosmos.Result{}, nil
 	}
@@ -275,14 +279,21 @@ func (h DepositHandler) handleV123(ctx cosmos.Context, msg MsgDeposit) (*cosmos.
 	return result, nil
 }
 
-func (h DepositHandler) addSwap(ctx cosmos.Context, msg MsgSwap) {
+func (h DepositHandler) addSwap(ctx cosmos.Context, msg MsgSwap) error {
 	version := h.mgr.GetVersion()
 	switch {
+	case version.GTE(semver.MustParse("1.124.0")):
+		return h.addSwapV124(ctx, msg)
 	case version.GTE(semver.MustParse("1.112.0")):
 		h.addSwapV112(ctx, msg)
 	case version.GTE(semver.MustParse("0.65.0")):
 		h.addSwapV65(ctx, msg)
 	}
+	return nil
+}
+
+func (h DepositHandler) addSwapV124(ctx cosmos.Context, msg MsgSwap) error {
+	return addSwapV124(ctx, h.mgr, msg)
 }
 
 func (h DepositHandler) addSwapV112(ctx cosmos.Context, msg MsgSwap) {
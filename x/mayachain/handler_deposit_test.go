@@ -11,6 +11,7 @@ import (
 
 	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/constants"
 	"gitlab.com/mayachain/mayanode/x/mayachain/keeper"
 	"gitlab.com/mayachain/mayanode/x/mayachain/types"
 )
@@ -248,6 +249,62 @@ func (s *HandlerDepositSuite) TestAddSwap(c *C) {
 	c.Assert(acct3.AmountOf(synthAsset.Native()).String(), Equals, strconv.FormatInt(0, 10))
 }
 
+func (s *HandlerDepositSuite) TestAddLimitOrder(c *C) {
+	SetupConfigForTest()
+	ctx, mgr := setupManagerForTest(c)
+	handler := NewDepositHandler(mgr)
+	from := GetRandomBaseAddress()
+	coin := common.NewCoin(common.BaseNative, cosmos.NewUint(common.One))
+	c.Assert(mgr.Keeper().MintToModule(ctx, ModuleName, coin), IsNil)
+	c.Assert(mgr.Keeper().SendFromModuleToModule(ctx, ModuleName, AsgardName, common.NewCoins(coin)), IsNil)
+
+	tx := common.NewTx(
+		GetRandomTxHash(),
+		from,
+		GetRandomBaseAddress(),
+		common.Coins{coin},
+		common.Gas{
+			{Asset: common.BaseNative, Amount: cosmos.NewUint(200000)},
+		},
+		fmt.Sprintf("limito:BTC.BTC:%s:1000/%d", GetRandomBTCAddress().String(), ctx.BlockHeight()+10),
+	)
+	msg := NewMsgSwap(tx, common.BTCAsset, GetRandomBTCAddress(), cosmos.NewUint(1000), common.NoAddress, cosmos.ZeroUint(), "", "", nil, LimitOrder, 0, 0, GetRandomBech32Addr())
+	msg.ExpiryHeight = ctx.BlockHeight() + 10
+
+	// order books are disabled
+	c.Check(handler.addSwap(ctx, *msg), NotNil)
+	c.Check(mgr.Keeper().HasOrderBookItem(ctx, tx.ID), Equals, false)
+
+	mgr.Keeper().SetMimir(ctx, constants.EnableOrderBooks.String(), 1)
+	c.Assert(handler.addSwap(ctx, *msg), IsNil)
+	c.Check(mgr.Keeper().HasOrderBookItem(ctx, tx.ID), Equals, true)
+	hashes, err := mgr.Keeper().GetOrderBookExpiredItems(ctx, msg.ExpiryHeight)
+	c.Assert(err, IsNil)
+	c.Check(hashes, HasLen, 1)
+
+	// only the sender of the order is able to cancel it
+	cancelTx := common.NewTx(
+		GetRandomTxHash(),
+		GetRandomBaseAddress(),
+		GetRandomBaseAddress(),
+		common.Coins{common.NewCoin(common.BaseNative, cosmos.NewUint(common.One))},
+		common.Gas{
+			{Asset: common.BaseNative, Amount: cosmos.NewUint(200000)},
+		},
+		"cancel:"+tx.ID.String(),
+	)
+	cancel := NewMsgSwapCancel(cancelTx, tx.ID, GetRandomBech32Addr())
+	c.Check(handler.addSwap(ctx, *cancel), NotNil)
+	c.Check(mgr.Keeper().HasOrderBookItem(ctx, tx.ID), Equals, true)
+
+	cancel.Tx.FromAddress = from
+	c.Assert(handler.addSwap(ctx, *cancel), IsNil)
+	c.Check(mgr.Keeper().HasOrderBookItem(ctx, tx.ID), Equals, false)
+	hashes, err = mgr.Keeper().GetOrderBookExpiredItems(ctx, msg.ExpiryHeight)
+	c.Assert(err, IsNil)
+	c.Check(hashes, HasLen, 0)
+}
+
 func (s *HandlerDepositSuite) TestTargetModule(c *C) {
 	fee := common.NewCoin(common.BaseAsset(), cosmos.NewUint(20_00000000))
 	gasFee := common.NewCoin(common.BaseAsset(), cosmos.NewUint(18_00000000))
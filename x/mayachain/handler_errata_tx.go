@@ -178,7 +178,7 @@ func (h ErrataTxHandler) handleV58(ctx cosmos.Context, msg MsgErrataTx) (*cosmos
 		return h.processErrataOutboundTx(ctx, msg)
 	}
 
-	if !memo.IsType(TxSwap) && !memo.IsType(TxAdd) {
+	if !memo.IsType(TxSwap) && !memo.IsType(TxLimitOrder) && !memo.IsType(TxDCA) && !memo.IsType(TxAdd) {
 		// must be a swap or add transaction
 		return &cosmos.Result{}, nil
 	}
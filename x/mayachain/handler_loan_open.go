@@ -0,0 +1,200 @@
+package mayachain
+
+import (
+	"fmt"
+
+	"github.com/blang/semver"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/constants"
+)
+
+// LoanOpenHandler is handler to process MsgLoanOpen. The collateral is locked
+// from the trade account of the signer, and the reserve lends the debt, which
+// is swapped into the target asset and paid out through the outbound queue.
+type LoanOpenHandler struct {
+	mgr Manager
+}
+
+// NewLoanOpenHandler create a new instance of LoanOpenHandler
+func NewLoanOpenHandler(mgr Manager) LoanOpenHandler {
+	return LoanOpenHandler{
+		mgr: mgr,
+	}
+}
+
+// Run is the main entry point for LoanOpenHandler
+func (h LoanOpenHandler) Run(ctx cosmos.Context, m cosmos.Msg) (*cosmos.Result, error) {
+	msg, ok := m.(*MsgLoanOpen)
+	if !ok {
+		return nil, errInvalidMessage
+	}
+	if err := h.validate(ctx, *msg); err != nil {
+		ctx.Logger().Error("MsgLoanOpen failed validation", "error", err)
+		return nil, err
+	}
+	err := h.handle(ctx, *msg)
+	if err != nil {
+		ctx.Logger().Error("fail to process MsgLoanOpen", "error", err)
+	}
+	return &cosmos.Result{}, err
+}
+
+func (h LoanOpenHandler) validate(ctx cosmos.Context, msg MsgLoanOpen) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("1.124.0")):
+		return h.validateV124(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+func (h LoanOpenHandler) validateV124(ctx cosmos.Context, msg MsgLoanOpen) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	if h.mgr.Keeper().GetConfigInt64(ctx, constants.LendingEnabled) <= 0 {
+		return fmt.Errorf("lending is disabled")
+	}
+	if !msg.TargetAddress.IsChain(msg.TargetAsset.GetChain(), h.mgr.GetVersion()) {
+		return fmt.Errorf("target address is not a valid %s address", msg.TargetAsset.GetChain())
+	}
+	if _, err := getLoanPool(ctx, h.mgr, msg.CollateralAsset); err != nil {
+		return err
+	}
+	if !msg.TargetAsset.IsNativeBase() {
+		if _, err := getLoanPool(ctx, h.mgr, msg.TargetAsset); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h LoanOpenHandler) handle(ctx cosmos.Context, msg MsgLoanOpen) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("1.124.0")):
+		return h.handleV124(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+// handleV124 opens the loan in a cached context, the collateral is locked and
+// the reserve pays out before all checks are done, so nothing is written
+// unless the whole loan opens
+func (h LoanOpenHandler) handleV124(ctx cosmos.Context, msg MsgLoanOpen) error {
+	cacheCtx, commit := ctx.CacheContext()
+	if err := h.openLoan(cacheCtx, msg); err != nil {
+		return err
+	}
+	commit()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
+
+func (h LoanOpenHandler) openLoan(ctx cosmos.Context, msg MsgLoanOpen) error {
+	loan, err := h.mgr.Keeper().GetLoan(ctx, msg.Signer, msg.CollateralAsset)
+	if err != nil {
+		return ErrInternal(err, "fail to get loan")
+	}
+	if !loan.IsOpen() {
+		loan = NewLoan(msg.Signer, msg.CollateralAsset, msg.TargetAsset)
+	}
+	if !loan.DebtAsset.Equals(msg.TargetAsset) {
+		return fmt.Errorf("loan is already open with debt asset %s", loan.DebtAsset)
+	}
+
+	units, locked, err := lockLoanCollateral(ctx, h.mgr, msg.Signer, msg.CollateralAsset, msg.CollateralAmount)
+	if err != nil {
+		return err
+	}
+	loan.CollateralUnits = loan.CollateralUnits.Add(units)
+	loan.CollateralDeposited = loan.CollateralDeposited.Add(locked)
+
+	// value the whole position, including what was borrowed before
+	collateral, err := loanCollateralAmount(ctx, h.mgr, loan.CollateralAsset, loan.CollateralUnits)
+	if err != nil {
+		return err
+	}
+	collateralValue, err := loanAssetValueInCacao(ctx, h.mgr, loan.CollateralAsset, collateral)
+	if err != nil {
+		return err
+	}
+	debtValue, err := loanAssetValueInCacao(ctx, h.mgr, loan.DebtAsset, loan.DebtOutstanding())
+	if err != nil {
+		return err
+	}
+	minCR := getLoanMinCollateralRatio(ctx, h.mgr, loan.CollateralAsset)
+	maxDebtValue := common.GetUncappedShare(cosmos.NewUint(constants.MaxBasisPts), cosmos.NewUint(uint64(minCR)), collateralValue)
+	availableValue := common.SafeSub(maxDebtValue, debtValue)
+
+	debt := msg.DebtAmount
+	debtCacao := cosmos.ZeroUint()
+	if debt.IsZero() {
+		// borrow as much as the collateral allows
+		debtCacao = availableValue
+		debt, err = loanCacaoValueInAsset(ctx, h.mgr, loan.DebtAsset, debtCacao)
+		if err != nil {
+			return err
+		}
+	} else {
+		debtCacao, err = loanAssetValueInCacao(ctx, h.mgr, loan.DebtAsset, debt)
+		if err != nil {
+			return err
+		}
+		if debtCacao.GT(availableValue) {
+			return fmt.Errorf("insufficient collateral, collateralization ratio would fall below %d basis points", minCR)
+		}
+	}
+	if debt.IsZero() || debtCacao.IsZero() {
+		return fmt.Errorf("nothing to borrow")
+	}
+
+	reserve := h.mgr.Keeper().GetRuneBalanceOfModule(ctx, ReserveName)
+	if reserve.LT(debtCacao) {
+		return fmt.Errorf("insufficient reserve to lend %s", debtCacao)
+	}
+	if err = h.payDebt(ctx, msg, debtCacao); err != nil {
+		return err
+	}
+
+	loan.DebtIssued = loan.DebtIssued.Add(debt)
+	loan.LastOpenHeight = ctx.BlockHeight()
+	h.mgr.Keeper().SetLoan(ctx, loan)
+
+	cr := loanCollateralizationRatio(collateralValue, debtValue.Add(debtCacao))
+	evt := NewEventLoanOpen(loan, locked, units, debt, msg.TargetAddress, cr, msg.Tx.ID)
+	if err := h.mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+		ctx.Logger().Error("fail to emit loan open event", "error", err)
+	}
+	return nil
+}
+
+// payDebt lends the cacao value of the debt from the reserve. Cacao is sent out
+// directly, any other target asset is swapped into first, so the borrower pays
+// the swap fee and slip.
+func (h LoanOpenHandler) payDebt(ctx cosmos.Context, msg MsgLoanOpen, debtCacao cosmos.Uint) error {
+	coin := common.NewCoin(common.BaseNative, debtCacao)
+	if msg.TargetAsset.IsNativeBase() {
+		toi := TxOutItem{
+			Chain:      common.BASEChain,
+			InHash:     msg.Tx.ID,
+			ToAddress:  msg.TargetAddress,
+			Coin:       coin,
+			ModuleName: ReserveName,
+		}
+		ok, err := h.mgr.TxOutStore().TryAddTxOutItem(ctx, h.mgr, toi, cosmos.ZeroUint())
+		if err != nil {
+			return ErrInternal(err, "fail to add outbound tx")
+		}
+		if !ok {
+			return errFailAddOutboundTx
+		}
+		return nil
+	}
+
+	return swapLoanCacao(ctx, h.mgr, msg.Tx, debtCacao, msg.TargetAsset, msg.TargetAddress)
+}
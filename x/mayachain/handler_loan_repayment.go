@@ -0,0 +1,217 @@
+package mayachain
+
+import (
+	"fmt"
+
+	"github.com/blang/semver"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/constants"
+)
+
+// LoanRepaymentHandler is handler to process MsgLoanRepayment. The repayment is
+// swapped into cacao for the reserve, and releases the share of collateral the
+// repaid debt was backed by. Once the whole debt is repaid the loan is closed.
+type LoanRepaymentHandler struct {
+	mgr Manager
+}
+
+// NewLoanRepaymentHandler create a new instance of LoanRepaymentHandler
+func NewLoanRepaymentHandler(mgr Manager) LoanRepaymentHandler {
+	return LoanRepaymentHandler{
+		mgr: mgr,
+	}
+}
+
+// Run is the main entry point for LoanRepaymentHandler
+func (h LoanRepaymentHandler) Run(ctx cosmos.Context, m cosmos.Msg) (*cosmos.Result, error) {
+	msg, ok := m.(*MsgLoanRepayment)
+	if !ok {
+		return nil, errInvalidMessage
+	}
+	if err := h.validate(ctx, *msg); err != nil {
+		ctx.Logger().Error("MsgLoanRepayment failed validation", "error", err)
+		return nil, err
+	}
+	err := h.handle(ctx, *msg)
+	if err != nil {
+		ctx.Logger().Error("fail to process MsgLoanRepayment", "error", err)
+	}
+	return &cosmos.Result{}, err
+}
+
+func (h LoanRepaymentHandler) validate(ctx cosmos.Context, msg MsgLoanRepayment) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("1.124.0")):
+		return h.validateV124(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+// validateV124 doesn't check whether lending is enabled, so open loans can
+// always be repaid
+func (h LoanRepaymentHandler) validateV124(ctx cosmos.Context, msg MsgLoanRepayment) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	loan, err := h.mgr.Keeper().GetLoan(ctx, msg.Owner, msg.CollateralAsset)
+	if err != nil {
+		return ErrInternal(err, "fail to get loan")
+	}
+	if loan.DebtOutstanding().IsZero() {
+		return fmt.Errorf("no outstanding debt against %s", msg.CollateralAsset)
+	}
+	return nil
+}
+
+func (h LoanRepaymentHandler) handle(ctx cosmos.Context, msg MsgLoanRepayment) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("1.124.0")):
+		return h.handleV124(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+// handleV124 repays the loan in a cached context, so the repayment isn't kept
+// by the reserve unless the collateral is released as well
+func (h LoanRepaymentHandler) handleV124(ctx cosmos.Context, msg MsgLoanRepayment) error {
+	cacheCtx, commit := ctx.CacheContext()
+	if err := h.repayLoan(cacheCtx, msg); err != nil {
+		return err
+	}
+	commit()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
+
+func (h LoanRepaymentHandler) repayLoan(ctx cosmos.Context, msg MsgLoanRepayment) error {
+	loan, err := h.mgr.Keeper().GetLoan(ctx, msg.Owner, msg.CollateralAsset)
+	if err != nil {
+		return ErrInternal(err, "fail to get loan")
+	}
+	outstanding := loan.DebtOutstanding()
+
+	repaidCacao, err := h.collectRepayment(ctx, msg)
+	if err != nil {
+		return err
+	}
+
+	// a repayment counts for the debt the cacao the reserve received is worth,
+	// so the payer bears the slip of swapping it, even in the debt asset
+	repaid, err := loanCacaoValueInAsset(ctx, h.mgr, loan.DebtAsset, repaidCacao)
+	if err != nil {
+		return err
+	}
+	if repaid.IsZero() {
+		return fmt.Errorf("repayment is worth nothing")
+	}
+
+	if repaid.GT(outstanding) {
+		// pay the cacao value of what exceeds the debt back to the payer
+		excessCacao := common.GetSafeShare(repaid.Sub(outstanding), repaid, repaidCacao)
+		if err = h.refundExcess(ctx, msg, excessCacao); err != nil {
+			return err
+		}
+		repaid = outstanding
+	}
+
+	units := common.GetSafeShare(repaid, outstanding, loan.CollateralUnits)
+	released, err := releaseLoanCollateral(ctx, h.mgr, loan.Owner, loan.CollateralAsset, units)
+	if err != nil {
+		return err
+	}
+
+	loan.DebtRepaid = loan.DebtRepaid.Add(repaid)
+	loan.CollateralUnits = common.SafeSub(loan.CollateralUnits, units)
+	loan.CollateralWithdrawn = loan.CollateralWithdrawn.Add(released)
+	loan.LastRepayHeight = ctx.BlockHeight()
+
+	evt := NewEventLoanRepayment(loan, released, repaid, msg.Coin, msg.Tx.ID)
+	if err := h.mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+		ctx.Logger().Error("fail to emit loan repayment event", "error", err)
+	}
+
+	if loan.IsOpen() {
+		h.mgr.Keeper().SetLoan(ctx, loan)
+		return nil
+	}
+
+	h.mgr.Keeper().RemoveLoan(ctx, loan)
+	if err := h.mgr.EventMgr().EmitEvent(ctx, NewEventLoanClose(loan, msg.Tx.ID)); err != nil {
+		ctx.Logger().Error("fail to emit loan close event", "error", err)
+	}
+	return nil
+}
+
+// collectRepayment swaps the repayment into cacao and sends it to the reserve,
+// returning the amount of cacao the reserve received
+func (h LoanRepaymentHandler) collectRepayment(ctx cosmos.Context, msg MsgLoanRepayment) (cosmos.Uint, error) {
+	repaidCacao := msg.Coin.Amount
+	if !msg.Coin.Asset.IsNativeBase() {
+		swapper, err := GetSwapper(h.mgr.GetVersion())
+		if err != nil {
+			return cosmos.ZeroUint(), err
+		}
+		synthVirtualDepthMult, err := h.mgr.Keeper().GetMimir(ctx, constants.VirtualMultSynthsBasisPoints.String())
+		if synthVirtualDepthMult < 1 || err != nil {
+			synthVirtualDepthMult = h.mgr.GetConstants().GetInt64Value(constants.VirtualMultSynthsBasisPoints)
+		}
+		tx := msg.Tx
+		tx.Coins = common.NewCoins(msg.Coin)
+		repaidCacao, _, err = swapper.Swap(
+			ctx,
+			h.mgr.Keeper(),
+			tx,
+			common.BaseNative,
+			common.NoopAddress,
+			cosmos.ZeroUint(),
+			"",
+			"",
+			nil,
+			StreamingSwap{},
+			cosmos.ZeroUint(),
+			synthVirtualDepthMult,
+			h.mgr)
+		if err != nil {
+			return cosmos.ZeroUint(), err
+		}
+	}
+
+	coins := common.NewCoins(common.NewCoin(common.BaseNative, repaidCacao))
+	if err := h.mgr.Keeper().SendFromModuleToModule(ctx, AsgardName, ReserveName, coins); err != nil {
+		return cosmos.ZeroUint(), ErrInternal(err, "fail to move repayment from asgard to reserve")
+	}
+	return repaidCacao, nil
+}
+
+// refundExcess pays back the cacao of a repayment exceeding the outstanding
+// debt to whoever paid it, swapped back into the asset it was paid in when the
+// payment came from another chain
+func (h LoanRepaymentHandler) refundExcess(ctx cosmos.Context, msg MsgLoanRepayment, excessCacao cosmos.Uint) error {
+	if excessCacao.IsZero() {
+		return nil
+	}
+	if !msg.Tx.FromAddress.IsChain(common.BASEChain, h.mgr.GetVersion()) {
+		return swapLoanCacao(ctx, h.mgr, msg.Tx, excessCacao, msg.Coin.Asset, msg.Tx.FromAddress)
+	}
+	toi := TxOutItem{
+		Chain:      common.BASEChain,
+		InHash:     msg.Tx.ID,
+		ToAddress:  msg.Tx.FromAddress,
+		Coin:       common.NewCoin(common.BaseNative, excessCacao),
+		ModuleName: ReserveName,
+	}
+	ok, err := h.mgr.TxOutStore().TryAddTxOutItem(ctx, h.mgr, toi, cosmos.ZeroUint())
+	if err != nil {
+		return ErrInternal(err, "fail to add outbound tx")
+	}
+	if !ok {
+		return errFailAddOutboundTx
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+package mayachain
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+)
+
+type HandlerLoanSuite struct{}
+
+var _ = Suite(&HandlerLoanSuite{})
+
+func (s *HandlerLoanSuite) SetUpSuite(c *C) {
+	SetupConfigForTest()
+}
+
+// setupLoanTest sets up an available BTC pool priced at 1 BTC = 1000 CACAO, a
+// funded reserve, and an owner with 10 BTC in their trade account
+func (s *HandlerLoanSuite) setupLoanTest(c *C) (cosmos.Context, *Mgrs, cosmos.AccAddress) {
+	ctx, mgr := setupManagerForTest(c)
+
+	pool := NewPool()
+	pool.Asset = common.BTCAsset
+	pool.BalanceCacao = cosmos.NewUint(100_000 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	pool.LPUnits = cosmos.NewUint(100 * common.One)
+	pool.Status = PoolAvailable
+	c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+	FundModule(c, ctx, mgr.Keeper(), ReserveName, 100_000)
+	FundModule(c, ctx, mgr.Keeper(), AsgardName, 100_000)
+
+	vault := GetRandomVault()
+	vault.Status = ActiveVault
+	c.Assert(mgr.Keeper().SetVault(ctx, vault), IsNil)
+
+	owner := GetRandomBech32Addr()
+	deposit := NewMsgTradeAccountDeposit(common.BTCAsset, cosmos.NewUint(10*common.One), owner, owner, common.Tx{ID: GetRandomTxHash()})
+	_, err := NewTradeAccountDepositHandler(mgr).Run(ctx, deposit)
+	c.Assert(err, IsNil)
+
+	return ctx, mgr, owner
+}
+
+func (s *HandlerLoanSuite) TestLoanOpenValidate(c *C) {
+	ctx, mgr, owner := s.setupLoanTest(c)
+	h := NewLoanOpenHandler(mgr)
+	tradeAsset := common.BTCAsset.GetTradeAsset()
+	tx := common.Tx{ID: GetRandomTxHash()}
+
+	// collateral must be a trade asset
+	msg := NewMsgLoanOpen(common.BTCAsset, cosmos.NewUint(common.One), common.BaseNative, GetRandomBaseAddress(), cosmos.ZeroUint(), owner, tx)
+	c.Check(h.validate(ctx, *msg), NotNil)
+
+	// target address must match the target chain
+	msg = NewMsgLoanOpen(tradeAsset, cosmos.NewUint(common.One), common.BaseNative, GetRandomBTCAddress(), cosmos.ZeroUint(), owner, tx)
+	c.Check(h.validate(ctx, *msg), NotNil)
+
+	// target pool must be available
+	msg = NewMsgLoanOpen(tradeAsset, cosmos.NewUint(common.One), common.ETHAsset, GetRandomETHAddress(), cosmos.ZeroUint(), owner, tx)
+	c.Check(h.validate(ctx, *msg), NotNil)
+
+	msg = NewMsgLoanOpen(tradeAsset, cosmos.NewUint(common.One), common.BaseNative, GetRandomBaseAddress(), cosmos.ZeroUint(), owner, tx)
+	c.Check(h.validate(ctx, *msg), IsNil)
+
+	// lending can be disabled
+	mgr.Keeper().SetMimir(ctx, "LendingEnabled", 0)
+	c.Check(h.validate(ctx, *msg), NotNil)
+}
+
+func (s *HandlerLoanSuite) TestLoanOpenAndRepay(c *C) {
+	ctx, mgr, owner := s.setupLoanTest(c)
+	asset := common.BTCAsset
+	tradeAsset := asset.GetTradeAsset()
+	target := GetRandomBaseAddress()
+
+	// 2 BTC is worth 2000 CACAO, which can't back 1001 CACAO at 200%, the
+	// failed loan leaves the collateral in the trade account
+	reserve := mgr.Keeper().GetRuneBalanceOfModule(ctx, ReserveName)
+	msg := NewMsgLoanOpen(tradeAsset, cosmos.NewUint(2*common.One), common.BaseNative, target, cosmos.NewUint(1001*common.One), owner, common.Tx{ID: GetRandomTxHash()})
+	_, err := NewLoanOpenHandler(mgr).Run(ctx, msg)
+	c.Assert(err, NotNil)
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, asset, owner).String(), Equals, "1000000000")
+	c.Check(mgr.Keeper().LoanExists(ctx, owner, tradeAsset), Equals, false)
+	c.Check(mgr.Keeper().GetRuneBalanceOfModule(ctx, ReserveName).Equal(reserve), Equals, true)
+
+	msg = NewMsgLoanOpen(tradeAsset, cosmos.NewUint(2*common.One), common.BaseNative, target, cosmos.NewUint(1000*common.One), owner, common.Tx{ID: GetRandomTxHash()})
+	_, err = NewLoanOpenHandler(mgr).Run(ctx, msg)
+	c.Assert(err, IsNil)
+
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, asset, owner).String(), Equals, "800000000")
+	loan, err := mgr.Keeper().GetLoan(ctx, owner, tradeAsset)
+	c.Assert(err, IsNil)
+	c.Check(loan.CollateralUnits.String(), Equals, "200000000")
+	c.Check(loan.CollateralDeposited.String(), Equals, "200000000")
+	c.Check(loan.DebtAsset.Equals(common.BaseNative), Equals, true)
+	c.Check(loan.DebtIssued.String(), Equals, "100000000000")
+
+	// the debt is paid out of the reserve
+	targetAcc, err := target.AccAddress()
+	c.Assert(err, IsNil)
+	received := mgr.Keeper().GetBalance(ctx, targetAcc).AmountOf(common.BaseNative.Native())
+	c.Check(received.IsPositive(), Equals, true)
+	c.Check(mgr.Keeper().GetRuneBalanceOfModule(ctx, ReserveName).LT(reserve), Equals, true)
+
+	// a loan can only carry one debt asset
+	cacheCtx, _ := ctx.CacheContext()
+	msg = NewMsgLoanOpen(tradeAsset, cosmos.NewUint(common.One), common.BTCAsset, GetRandomBTCAddress(), cosmos.ZeroUint(), owner, common.Tx{ID: GetRandomTxHash()})
+	_, err = NewLoanOpenHandler(mgr).Run(cacheCtx, msg)
+	c.Assert(err, NotNil)
+
+	// repaying half the debt releases half the collateral
+	FundModule(c, ctx, mgr.Keeper(), AsgardName, 1000)
+	coin := common.NewCoin(common.BaseNative, cosmos.NewUint(500*common.One))
+	repay := NewMsgLoanRepayment(owner, tradeAsset, coin, owner, common.Tx{ID: GetRandomTxHash()})
+	_, err = NewLoanRepaymentHandler(mgr).Run(ctx, repay)
+	c.Assert(err, IsNil)
+
+	loan, err = mgr.Keeper().GetLoan(ctx, owner, tradeAsset)
+	c.Assert(err, IsNil)
+	c.Check(loan.DebtRepaid.String(), Equals, "50000000000")
+	c.Check(loan.CollateralUnits.String(), Equals, "100000000")
+	c.Check(loan.CollateralWithdrawn.String(), Equals, "100000000")
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, asset, owner).String(), Equals, "900000000")
+
+	// overpaying closes the loan and refunds the excess to the payer, who
+	// doesn't have to be the owner
+	payer := GetRandomBech32Addr()
+	before := mgr.Keeper().GetBalance(ctx, owner).AmountOf(common.BaseNative.Native())
+	coin = common.NewCoin(common.BaseNative, cosmos.NewUint(600*common.One))
+	repay = NewMsgLoanRepayment(owner, tradeAsset, coin, payer, common.Tx{ID: GetRandomTxHash(), FromAddress: common.Address(payer.String())})
+	_, err = NewLoanRepaymentHandler(mgr).Run(ctx, repay)
+	c.Assert(err, IsNil)
+
+	c.Check(mgr.Keeper().LoanExists(ctx, owner, tradeAsset), Equals, false)
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, asset, owner).String(), Equals, "1000000000")
+	c.Check(mgr.Keeper().GetBalance(ctx, owner).AmountOf(common.BaseNative.Native()).Equal(before), Equals, true)
+	c.Check(mgr.Keeper().GetBalance(ctx, payer).AmountOf(common.BaseNative.Native()).IsPositive(), Equals, true)
+
+	// nothing left to repay
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = NewLoanRepaymentHandler(mgr).Run(cacheCtx, repay)
+	c.Assert(err, NotNil)
+}
+
+func (s *HandlerLoanSuite) TestLoanOpenMaxDebt(c *C) {
+	ctx, mgr, owner := s.setupLoanTest(c)
+	tradeAsset := common.BTCAsset.GetTradeAsset()
+
+	// a per pool mimir overrides the minimum collateralization ratio
+	mgr.Keeper().SetMimir(ctx, "LendingMinCR-BTC-BTC", 40_000)
+
+	msg := NewMsgLoanOpen(tradeAsset, cosmos.NewUint(4*common.One), common.BaseNative, GetRandomBaseAddress(), cosmos.ZeroUint(), owner, common.Tx{ID: GetRandomTxHash()})
+	_, err := NewLoanOpenHandler(mgr).Run(ctx, msg)
+	c.Assert(err, IsNil)
+
+	loan, err := mgr.Keeper().GetLoan(ctx, owner, tradeAsset)
+	c.Assert(err, IsNil)
+	c.Check(loan.DebtIssued.String(), Equals, "100000000000")
+
+	// the collateral is fully used
+	cacheCtx, _ := ctx.CacheContext()
+	msg = NewMsgLoanOpen(tradeAsset, cosmos.NewUint(1), common.BaseNative, GetRandomBaseAddress(), cosmos.NewUint(common.One), owner, common.Tx{ID: GetRandomTxHash()})
+	_, err = NewLoanOpenHandler(mgr).Run(cacheCtx, msg)
+	c.Assert(err, NotNil)
+}
+
+func (s *HandlerLoanSuite) TestLoanRepayInDebtAsset(c *C) {
+	ctx, mgr, owner := s.setupLoanTest(c)
+	tradeAsset := common.BTCAsset.GetTradeAsset()
+	mgr.txOutStore = NewTxStoreDummy()
+
+	tx := GetRandomTx()
+	tx.FromAddress = common.Address(owner.String())
+	msg := NewMsgLoanOpen(tradeAsset, cosmos.NewUint(4*common.One), common.BTCAsset, GetRandomBTCAddress(), cosmos.NewUint(common.One), owner, tx)
+	_, err := NewLoanOpenHandler(mgr).Run(ctx, msg)
+	c.Assert(err, IsNil)
+
+	// the repayment counts for what it's worth after the swap into cacao,
+	// not at face value
+	coin := common.NewCoin(common.BTCAsset, cosmos.NewUint(common.One/2))
+	tx = GetRandomTx()
+	tx.FromAddress = GetRandomBTCAddress()
+	repay := NewMsgLoanRepayment(owner, tradeAsset, coin, owner, tx)
+	_, err = NewLoanRepaymentHandler(mgr).Run(ctx, repay)
+	c.Assert(err, IsNil)
+
+	loan, err := mgr.Keeper().GetLoan(ctx, owner, tradeAsset)
+	c.Assert(err, IsNil)
+	c.Check(loan.DebtRepaid.IsZero(), Equals, false)
+	c.Check(loan.DebtRepaid.LT(coin.Amount), Equals, true)
+}
+
+func (s *HandlerLoanSuite) TestLiquidateLoans(c *C) {
+	ctx, mgr, owner := s.setupLoanTest(c)
+	ctx = ctx.WithBlockHeight(10)
+	asset := common.BTCAsset
+	tradeAsset := asset.GetTradeAsset()
+
+	msg := NewMsgLoanOpen(tradeAsset, cosmos.NewUint(2*common.One), common.BaseNative, GetRandomBaseAddress(), cosmos.NewUint(1000*common.One), owner, common.Tx{ID: GetRandomTxHash()})
+	_, err := NewLoanOpenHandler(mgr).Run(ctx, msg)
+	c.Assert(err, IsNil)
+
+	// a loan at 200% is left alone
+	liquidateLoans(ctx, mgr)
+	c.Check(mgr.Keeper().LoanExists(ctx, owner, tradeAsset), Equals, true)
+
+	// halving the price of BTC takes the loan to 100%, below the 120%
+	// liquidation ratio, but it's only checked every LendingLiquidationInterval
+	pool, err := mgr.Keeper().GetPool(ctx, asset)
+	c.Assert(err, IsNil)
+	pool.BalanceCacao = cosmos.NewUint(50_000 * common.One)
+	c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+	liquidateLoans(ctx.WithBlockHeight(11), mgr)
+	c.Check(mgr.Keeper().LoanExists(ctx, owner, tradeAsset), Equals, true)
+
+	// the collateral is swapped to cacao for the reserve, which takes the
+	// loss of the debt it doesn't cover
+	reserve := mgr.Keeper().GetRuneBalanceOfModule(ctx, ReserveName)
+	liquidateLoans(ctx, mgr)
+	c.Check(mgr.Keeper().LoanExists(ctx, owner, tradeAsset), Equals, false)
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, asset, owner).String(), Equals, "800000000")
+	c.Check(mgr.Keeper().GetRuneBalanceOfModule(ctx, ReserveName).GT(reserve), Equals, true)
+}
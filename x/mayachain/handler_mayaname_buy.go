@@ -0,0 +1,138 @@
+package mayachain
+
+import (
+	"fmt"
+
+	"github.com/blang/semver"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+)
+
+// MAYANameBuyHandler is handler to process MsgMAYANameBuy. The CACAO of the
+// buyer's deposit pays the seller and any excess is returned to the buyer, the
+// MAYAName changes hands in the same transaction.
+type MAYANameBuyHandler struct {
+	mgr Manager
+}
+
+// NewMAYANameBuyHandler create a new instance of MAYANameBuyHandler
+func NewMAYANameBuyHandler(mgr Manager) MAYANameBuyHandler {
+	return MAYANameBuyHandler{
+		mgr: mgr,
+	}
+}
+
+// Run is the main entry point for MAYANameBuyHandler
+func (h MAYANameBuyHandler) Run(ctx cosmos.Context, m cosmos.Msg) (*cosmos.Result, error) {
+	msg, ok := m.(*MsgMAYANameBuy)
+	if !ok {
+		return nil, errInvalidMessage
+	}
+	if err := h.validate(ctx, *msg); err != nil {
+		ctx.Logger().Error("MsgMAYANameBuy failed validation", "error", err)
+		return nil, err
+	}
+	err := h.handle(ctx, *msg)
+	if err != nil {
+		ctx.Logger().Error("fail to process MsgMAYANameBuy", "error", err)
+	}
+	return &cosmos.Result{}, err
+}
+
+func (h MAYANameBuyHandler) validate(ctx cosmos.Context, msg MsgMAYANameBuy) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("1.124.0")):
+		return h.validateV124(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+func (h MAYANameBuyHandler) validateV124(ctx cosmos.Context, msg MsgMAYANameBuy) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	listing, err := h.mgr.Keeper().GetMAYANameListing(ctx, msg.Name)
+	if err != nil {
+		return err
+	}
+	if listing.IsExpired(ctx.BlockHeight()) {
+		return fmt.Errorf("listing of %s expired at %d", listing.Name, listing.ExpireHeight)
+	}
+	if !h.mgr.Keeper().MAYANameExists(ctx, msg.Name) {
+		return fmt.Errorf("mayaname %s doesn't exist", msg.Name)
+	}
+	mn, err := h.mgr.Keeper().GetMAYAName(ctx, msg.Name)
+	if err != nil {
+		return fmt.Errorf("fail to get mayaname: %w", err)
+	}
+	// the name changed hands since it was listed
+	if !mn.Owner.Equals(listing.Seller) {
+		return fmt.Errorf("listing of %s is no longer valid", listing.Name)
+	}
+	if listing.Seller.Equals(msg.Signer) {
+		return fmt.Errorf("cannot buy your own mayaname")
+	}
+	if msg.Coin.Amount.LT(listing.Price) {
+		return fmt.Errorf("not enough to buy %s: %s < %s", listing.Name, msg.Coin.Amount, listing.Price)
+	}
+	return nil
+}
+
+func (h MAYANameBuyHandler) handle(ctx cosmos.Context, msg MsgMAYANameBuy) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("1.124.0")):
+		return h.handleV124(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+// handle process MsgMAYANameBuy
+func (h MAYANameBuyHandler) handleV124(ctx cosmos.Context, msg MsgMAYANameBuy) error {
+	listing, err := h.mgr.Keeper().GetMAYANameListing(ctx, msg.Name)
+	if err != nil {
+		return err
+	}
+	mn, err := h.mgr.Keeper().GetMAYAName(ctx, msg.Name)
+	if err != nil {
+		return fmt.Errorf("fail to get mayaname: %w", err)
+	}
+
+	// the deposit has been sent to asgard, pay the seller and return the excess
+	payment := common.NewCoins(common.NewCoin(common.BaseNative, listing.Price))
+	if err = h.mgr.Keeper().SendFromModuleToAccount(ctx, AsgardName, listing.Seller, payment); err != nil {
+		return fmt.Errorf("fail to pay seller: %w", err)
+	}
+	if excess := common.SafeSub(msg.Coin.Amount, listing.Price); !excess.IsZero() {
+		change := common.NewCoins(common.NewCoin(common.BaseNative, excess))
+		if err = h.mgr.Keeper().SendFromModuleToAccount(ctx, AsgardName, msg.Signer, change); err != nil {
+			return fmt.Errorf("fail to return excess to buyer: %w", err)
+		}
+	}
+
+	mn.Owner = msg.Signer
+	if !listing.KeepAliases {
+		// a mayaname needs an alias, point it at the buyer
+		buyerAddr, err := common.NewAddress(msg.Signer.String(), h.mgr.GetVersion())
+		if err != nil {
+			return fmt.Errorf("fail to get buyer address: %w", err)
+		}
+		mn.Aliases = []MAYANameAlias{{Chain: common.BASEChain, Address: buyerAddr}}
+		mn.PreferredAsset = common.EmptyAsset
+	}
+	if !listing.KeepSubaffiliates {
+		mn.Subaffiliates = nil
+	}
+	h.mgr.Keeper().SetMAYAName(ctx, mn)
+	h.mgr.Keeper().RemoveMAYANameListing(ctx, listing)
+
+	evt := NewEventMAYANameSale(listing, msg.Signer, msg.Tx.ID)
+	if err := h.mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+		ctx.Logger().Error("fail to emit mayaname sale event", "error", err)
+	}
+	return nil
+}
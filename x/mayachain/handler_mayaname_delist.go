@@ -0,0 +1,121 @@
+package mayachain
+
+import (
+	"fmt"
+
+	"github.com/blang/semver"
+
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+)
+
+// MAYANameDelistHandler is handler to process MsgMAYANameDelist, which cancels
+// the listing of a MAYAName. The current owner can also remove a listing left
+// behind by a previous owner.
+type MAYANameDelistHandler struct {
+	mgr Manager
+}
+
+// NewMAYANameDelistHandler create a new instance of MAYANameDelistHandler
+func NewMAYANameDelistHandler(mgr Manager) MAYANameDelistHandler {
+	return MAYANameDelistHandler{
+		mgr: mgr,
+	}
+}
+
+// Run is the main entry point for MAYANameDelistHandler
+func (h MAYANameDelistHandler) Run(ctx cosmos.Context, m cosmos.Msg) (*cosmos.Result, error) {
+	msg, ok := m.(*MsgMAYANameDelist)
+	if !ok {
+		return nil, errInvalidMessage
+	}
+	if err := h.validate(ctx, *msg); err != nil {
+		ctx.Logger().Error("MsgMAYANameDelist failed validation", "error", err)
+		return nil, err
+	}
+	err := h.handle(ctx, *msg)
+	if err != nil {
+		ctx.Logger().Error("fail to process MsgMAYANameDelist", "error", err)
+	}
+	return &cosmos.Result{}, err
+}
+
+func (h MAYANameDelistHandler) validate(ctx cosmos.Context, msg MsgMAYANameDelist) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("1.124.0")):
+		return h.validateV124(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+func (h MAYANameDelistHandler) validateV124(ctx cosmos.Context, msg MsgMAYANameDelist) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	listing, err := h.mgr.Keeper().GetMAYANameListing(ctx, msg.Name)
+	if err != nil {
+		return err
+	}
+	if listing.Seller.Equals(msg.Signer) {
+		return nil
+	}
+	if h.mgr.Keeper().MAYANameExists(ctx, msg.Name) {
+		mn, err := h.mgr.Keeper().GetMAYAName(ctx, msg.Name)
+		if err != nil {
+			return fmt.Errorf("fail to get mayaname: %w", err)
+		}
+		if mn.Owner.Equals(msg.Signer) {
+			return nil
+		}
+	}
+	return fmt.Errorf("only the seller or the owner of %s can delist it", msg.Name)
+}
+
+func (h MAYANameDelistHandler) handle(ctx cosmos.Context, msg MsgMAYANameDelist) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("1.124.0")):
+		return h.handleV124(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+// handle process MsgMAYANameDelist
+func (h MAYANameDelistHandler) handleV124(ctx cosmos.Context, msg MsgMAYANameDelist) error {
+	listing, err := h.mgr.Keeper().GetMAYANameListing(ctx, msg.Name)
+	if err != nil {
+		return err
+	}
+	h.mgr.Keeper().RemoveMAYANameListing(ctx, listing)
+
+	evt := NewEventMAYANameDelist(listing, msg.Tx.ID)
+	if err := h.mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+		ctx.Logger().Error("fail to emit mayaname delist event", "error", err)
+	}
+	return nil
+}
+
+// expireMAYANameListings removes the listings that can no longer be bought
+// after this block, with a delist event without a tx id for each of them
+func expireMAYANameListings(ctx cosmos.Context, mgr Manager) {
+	names, err := mgr.Keeper().GetMAYANameListingsExpired(ctx, ctx.BlockHeight())
+	if err != nil {
+		ctx.Logger().Error("fail to get expired mayaname listings", "error", err)
+		return
+	}
+	for _, name := range names {
+		listing, err := mgr.Keeper().GetMAYANameListing(ctx, name)
+		if err != nil {
+			ctx.Logger().Error("fail to get expired mayaname listing", "name", name, "error", err)
+			continue
+		}
+		mgr.Keeper().RemoveMAYANameListing(ctx, listing)
+
+		evt := NewEventMAYANameDelist(listing, "")
+		if err := mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+			ctx.Logger().Error("fail to emit mayaname delist event", "error", err)
+		}
+	}
+}
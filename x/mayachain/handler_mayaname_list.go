@@ -0,0 +1,108 @@
+package mayachain
+
+import (
+	"fmt"
+
+	"github.com/blang/semver"
+
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+)
+
+// MAYANameListHandler is handler to process MsgMAYANameList, which puts a
+// MAYAName up for sale. Listing a listed MAYAName replaces its listing.
+type MAYANameListHandler struct {
+	mgr Manager
+}
+
+// NewMAYANameListHandler create a new instance of MAYANameListHandler
+func NewMAYANameListHandler(mgr Manager) MAYANameListHandler {
+	return MAYANameListHandler{
+		mgr: mgr,
+	}
+}
+
+// Run is the main entry point for MAYANameListHandler
+func (h MAYANameListHandler) Run(ctx cosmos.Context, m cosmos.Msg) (*cosmos.Result, error) {
+	msg, ok := m.(*MsgMAYANameList)
+	if !ok {
+		return nil, errInvalidMessage
+	}
+	if err := h.validate(ctx, *msg); err != nil {
+		ctx.Logger().Error("MsgMAYANameList failed validation", "error", err)
+		return nil, err
+	}
+	err := h.handle(ctx, *msg)
+	if err != nil {
+		ctx.Logger().Error("fail to process MsgMAYANameList", "error", err)
+	}
+	return &cosmos.Result{}, err
+}
+
+func (h MAYANameListHandler) validate(ctx cosmos.Context, msg MsgMAYANameList) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("1.124.0")):
+		return h.validateV124(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+func (h MAYANameListHandler) validateV124(ctx cosmos.Context, msg MsgMAYANameList) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	if !h.mgr.Keeper().MAYANameExists(ctx, msg.Name) {
+		return fmt.Errorf("mayaname %s doesn't exist", msg.Name)
+	}
+	mn, err := h.mgr.Keeper().GetMAYAName(ctx, msg.Name)
+	if err != nil {
+		return fmt.Errorf("fail to get mayaname: %w", err)
+	}
+	if !mn.Owner.Equals(msg.Signer) {
+		return fmt.Errorf("only the owner of %s can list it", mn.Name)
+	}
+	expire := h.listingExpireHeight(mn, msg)
+	if expire <= ctx.BlockHeight() {
+		return fmt.Errorf("listing expire height (%d) must be in the future", expire)
+	}
+	if expire > mn.ExpireBlockHeight {
+		return fmt.Errorf("listing cannot outlive the mayaname, which expires at %d", mn.ExpireBlockHeight)
+	}
+	return nil
+}
+
+func (h MAYANameListHandler) handle(ctx cosmos.Context, msg MsgMAYANameList) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("1.124.0")):
+		return h.handleV124(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+// handle process MsgMAYANameList
+func (h MAYANameListHandler) handleV124(ctx cosmos.Context, msg MsgMAYANameList) error {
+	mn, err := h.mgr.Keeper().GetMAYAName(ctx, msg.Name)
+	if err != nil {
+		return fmt.Errorf("fail to get mayaname: %w", err)
+	}
+	listing := NewMAYANameListing(mn.Name, msg.Signer, msg.Price, h.listingExpireHeight(mn, msg), msg.KeepAliases, msg.KeepSubaffiliates, ctx.BlockHeight())
+	h.mgr.Keeper().SetMAYANameListing(ctx, listing)
+
+	evt := NewEventMAYANameList(listing, msg.Tx.ID)
+	if err := h.mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+		ctx.Logger().Error("fail to emit mayaname list event", "error", err)
+	}
+	return nil
+}
+
+// listingExpireHeight returns the height the listing expires at, which
+// defaults to the expiry of the MAYAName
+func (h MAYANameListHandler) listingExpireHeight(mn MAYAName, msg MsgMAYANameList) int64 {
+	if msg.ExpireHeight == 0 {
+		return mn.ExpireBlockHeight
+	}
+	return msg.ExpireHeight
+}
@@ -0,0 +1,178 @@
+package mayachain
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+)
+
+type HandlerMAYANameSaleSuite struct{}
+
+var _ = Suite(&HandlerMAYANameSaleSuite{})
+
+func (s *HandlerMAYANameSaleSuite) SetUpSuite(c *C) {
+	SetupConfigForTest()
+}
+
+// setupMAYANameSaleTest sets up a MAYAName with a BTC alias, a BTC preferred
+// asset and a subaffiliate
+func (s *HandlerMAYANameSaleSuite) setupMAYANameSaleTest(c *C) (cosmos.Context, *Mgrs, MAYAName) {
+	ctx, mgr := setupManagerForTest(c)
+
+	owner := GetRandomBech32Addr()
+	aliases := []MAYANameAlias{{Chain: common.BTCChain, Address: GetRandomBTCAddress()}}
+	subaffiliates := []MAYANameSubaffiliate{{Name: "bob", Bps: cosmos.NewUint(5000)}}
+	mn := NewMAYAName("alice", ctx.BlockHeight()+1000, aliases, common.BTCAsset, owner, cosmos.ZeroUint(), subaffiliates)
+	mgr.Keeper().SetMAYAName(ctx, mn)
+	return ctx, mgr, mn
+}
+
+func (s *HandlerMAYANameSaleSuite) TestListValidate(c *C) {
+	ctx, mgr, mn := s.setupMAYANameSaleTest(c)
+	h := NewMAYANameListHandler(mgr)
+	price := cosmos.NewUint(100 * common.One)
+	tx := common.Tx{ID: GetRandomTxHash()}
+
+	msg := NewMsgMAYANameList(mn.Name, price, 0, false, false, mn.Owner, tx)
+	c.Check(h.validate(ctx, *msg), IsNil)
+
+	// only the owner can list
+	msg = NewMsgMAYANameList(mn.Name, price, 0, false, false, GetRandomBech32Addr(), tx)
+	c.Check(h.validate(ctx, *msg), NotNil)
+
+	// the mayaname must exist
+	msg = NewMsgMAYANameList("bob", price, 0, false, false, mn.Owner, tx)
+	c.Check(h.validate(ctx, *msg), NotNil)
+
+	// the listing must expire in the future, but not after the mayaname
+	msg = NewMsgMAYANameList(mn.Name, price, ctx.BlockHeight(), false, false, mn.Owner, tx)
+	c.Check(h.validate(ctx, *msg), NotNil)
+	msg = NewMsgMAYANameList(mn.Name, price, mn.ExpireBlockHeight+1, false, false, mn.Owner, tx)
+	c.Check(h.validate(ctx, *msg), NotNil)
+}
+
+func (s *HandlerMAYANameSaleSuite) TestListAndBuy(c *C) {
+	ctx, mgr, mn := s.setupMAYANameSaleTest(c)
+	price := cosmos.NewUint(100 * common.One)
+
+	msg := NewMsgMAYANameList(mn.Name, price, 0, false, false, mn.Owner, common.Tx{ID: GetRandomTxHash()})
+	_, err := NewMAYANameListHandler(mgr).Run(ctx, msg)
+	c.Assert(err, IsNil)
+	listing, err := mgr.Keeper().GetMAYANameListing(ctx, mn.Name)
+	c.Assert(err, IsNil)
+	c.Check(listing.ExpireHeight, Equals, mn.ExpireBlockHeight)
+
+	// the deposit of the buyer has been sent to asgard
+	buyer := GetRandomBech32Addr()
+	FundModule(c, ctx, mgr.Keeper(), AsgardName, 150)
+
+	// the seller can't buy their own mayaname, and the price has to be paid
+	cacheCtx, _ := ctx.CacheContext()
+	buy := NewMsgMAYANameBuy(mn.Name, common.NewCoin(common.BaseNative, price), mn.Owner, common.Tx{ID: GetRandomTxHash()})
+	_, err = NewMAYANameBuyHandler(mgr).Run(cacheCtx, buy)
+	c.Assert(err, NotNil)
+	buy = NewMsgMAYANameBuy(mn.Name, common.NewCoin(common.BaseNative, cosmos.NewUint(99*common.One)), buyer, common.Tx{ID: GetRandomTxHash()})
+	_, err = NewMAYANameBuyHandler(mgr).Run(cacheCtx, buy)
+	c.Assert(err, NotNil)
+
+	buy = NewMsgMAYANameBuy(mn.Name, common.NewCoin(common.BaseNative, cosmos.NewUint(150*common.One)), buyer, common.Tx{ID: GetRandomTxHash()})
+	_, err = NewMAYANameBuyHandler(mgr).Run(ctx, buy)
+	c.Assert(err, IsNil)
+
+	// the seller is paid and the excess returned to the buyer
+	c.Check(mgr.Keeper().GetBalance(ctx, mn.Owner).AmountOf(common.BaseNative.Native()).Uint64(), Equals, uint64(100*common.One))
+	c.Check(mgr.Keeper().GetBalance(ctx, buyer).AmountOf(common.BaseNative.Native()).Uint64(), Equals, uint64(50*common.One))
+
+	// the mayaname now points at the buyer
+	bought, err := mgr.Keeper().GetMAYAName(ctx, mn.Name)
+	c.Assert(err, IsNil)
+	c.Check(bought.Owner.Equals(buyer), Equals, true)
+	c.Assert(bought.Aliases, HasLen, 1)
+	c.Check(bought.GetAlias(common.BASEChain).String(), Equals, buyer.String())
+	c.Check(bought.PreferredAsset.IsEmpty(), Equals, true)
+	c.Check(bought.Subaffiliates, HasLen, 0)
+	names, err := mgr.Keeper().GetMAYANamesByOwner(ctx, buyer)
+	c.Assert(err, IsNil)
+	c.Check(names, DeepEquals, []string{mn.Name})
+	c.Check(mgr.Keeper().MAYANameListingExists(ctx, mn.Name), Equals, false)
+}
+
+func (s *HandlerMAYANameSaleSuite) TestBuyKeepAliases(c *C) {
+	ctx, mgr, mn := s.setupMAYANameSaleTest(c)
+	price := cosmos.NewUint(100 * common.One)
+
+	msg := NewMsgMAYANameList(mn.Name, price, 0, true, true, mn.Owner, common.Tx{ID: GetRandomTxHash()})
+	_, err := NewMAYANameListHandler(mgr).Run(ctx, msg)
+	c.Assert(err, IsNil)
+
+	FundModule(c, ctx, mgr.Keeper(), AsgardName, 100)
+	buyer := GetRandomBech32Addr()
+	buy := NewMsgMAYANameBuy(mn.Name, common.NewCoin(common.BaseNative, price), buyer, common.Tx{ID: GetRandomTxHash()})
+	_, err = NewMAYANameBuyHandler(mgr).Run(ctx, buy)
+	c.Assert(err, IsNil)
+
+	bought, err := mgr.Keeper().GetMAYAName(ctx, mn.Name)
+	c.Assert(err, IsNil)
+	c.Check(bought.Owner.Equals(buyer), Equals, true)
+	c.Check(bought.Aliases, DeepEquals, mn.Aliases)
+	c.Check(bought.PreferredAsset.Equals(common.BTCAsset), Equals, true)
+	c.Check(bought.Subaffiliates, HasLen, 1)
+}
+
+func (s *HandlerMAYANameSaleSuite) TestListingExpiryAndDelist(c *C) {
+	ctx, mgr, mn := s.setupMAYANameSaleTest(c)
+	price := cosmos.NewUint(100 * common.One)
+	FundModule(c, ctx, mgr.Keeper(), AsgardName, 100)
+	buyer := GetRandomBech32Addr()
+	buy := NewMsgMAYANameBuy(mn.Name, common.NewCoin(common.BaseNative, price), buyer, common.Tx{ID: GetRandomTxHash()})
+
+	msg := NewMsgMAYANameList(mn.Name, price, ctx.BlockHeight()+10, false, false, mn.Owner, common.Tx{ID: GetRandomTxHash()})
+	_, err := NewMAYANameListHandler(mgr).Run(ctx, msg)
+	c.Assert(err, IsNil)
+
+	// an expired listing can't be bought
+	expiredCtx := ctx.WithBlockHeight(ctx.BlockHeight() + 11)
+	cacheCtx, _ := expiredCtx.CacheContext()
+	_, err = NewMAYANameBuyHandler(mgr).Run(cacheCtx, buy)
+	c.Assert(err, NotNil)
+
+	// a listing is no longer valid once the mayaname changed hands
+	cacheCtx, _ = ctx.CacheContext()
+	transferred := mn
+	transferred.Owner = GetRandomBech32Addr()
+	mgr.Keeper().SetMAYAName(cacheCtx, transferred)
+	_, err = NewMAYANameBuyHandler(mgr).Run(cacheCtx, buy)
+	c.Assert(err, NotNil)
+
+	// only the seller can delist
+	delist := NewMsgMAYANameDelist(mn.Name, buyer, common.Tx{ID: GetRandomTxHash()})
+	_, err = NewMAYANameDelistHandler(mgr).Run(ctx, delist)
+	c.Assert(err, NotNil)
+	delist = NewMsgMAYANameDelist(mn.Name, mn.Owner, common.Tx{ID: GetRandomTxHash()})
+	_, err = NewMAYANameDelistHandler(mgr).Run(ctx, delist)
+	c.Assert(err, IsNil)
+	c.Check(mgr.Keeper().MAYANameListingExists(ctx, mn.Name), Equals, false)
+
+	_, err = NewMAYANameBuyHandler(mgr).Run(ctx, buy)
+	c.Assert(err, NotNil)
+}
+
+func (s *HandlerMAYANameSaleSuite) TestExpireListings(c *C) {
+	ctx, mgr, mn := s.setupMAYANameSaleTest(c)
+	expiry := ctx.BlockHeight() + 10
+
+	msg := NewMsgMAYANameList(mn.Name, cosmos.NewUint(100*common.One), expiry, false, false, mn.Owner, common.Tx{ID: GetRandomTxHash()})
+	_, err := NewMAYANameListHandler(mgr).Run(ctx, msg)
+	c.Assert(err, IsNil)
+
+	// the listing can still be bought until the end of its expiry block
+	expireMAYANameListings(ctx.WithBlockHeight(expiry-1), mgr)
+	c.Check(mgr.Keeper().MAYANameListingExists(ctx, mn.Name), Equals, true)
+
+	expireMAYANameListings(ctx.WithBlockHeight(expiry), mgr)
+	c.Check(mgr.Keeper().MAYANameListingExists(ctx, mn.Name), Equals, false)
+	names, err := mgr.Keeper().GetMAYANameListingsExpired(ctx, expiry)
+	c.Assert(err, IsNil)
+	c.Check(names, HasLen, 0)
+}
@@ -0,0 +1,132 @@
+package mayachain
+
+import (
+	"fmt"
+
+	"github.com/blang/semver"
+
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/constants"
+)
+
+// NodeOperatorFeeHandler is handler to process MsgNodeOperatorFee, which
+// changes the share of the bond rewards the node operator takes. Once another
+// bond provider has bonded, an increase only takes effect after
+// NodeOperatorFeeChangeDelay blocks, so providers have time to react to it.
+type NodeOperatorFeeHandler struct {
+	mgr Manager
+}
+
+// NewNodeOperatorFeeHandler create a new instance of NodeOperatorFeeHandler
+func NewNodeOperatorFeeHandler(mgr Manager) NodeOperatorFeeHandler {
+	return NodeOperatorFeeHandler{
+		mgr: mgr,
+	}
+}
+
+// Run is the main entry point for NodeOperatorFeeHandler
+func (h NodeOperatorFeeHandler) Run(ctx cosmos.Context, m cosmos.Msg) (*cosmos.Result, error) {
+	msg, ok := m.(*MsgNodeOperatorFee)
+	if !ok {
+		return nil, errInvalidMessage
+	}
+	if err := h.validate(ctx, *msg); err != nil {
+		ctx.Logger().Error("MsgNodeOperatorFee failed validation", "error", err)
+		return nil, err
+	}
+	err := h.handle(ctx, *msg)
+	if err != nil {
+		ctx.Logger().Error("fail to process MsgNodeOperatorFee", "error", err)
+	}
+	return &cosmos.Result{}, err
+}
+
+func (h NodeOperatorFeeHandler) validate(ctx cosmos.Context, msg MsgNodeOperatorFee) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("1.124.0")):
+		return h.validateV124(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+func (h NodeOperatorFeeHandler) validateV124(ctx cosmos.Context, msg MsgNodeOperatorFee) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	nodeAccount, err := h.mgr.Keeper().GetNodeAccount(ctx, msg.NodeAddress)
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to get node account(%s)", msg.NodeAddress))
+	}
+	if nodeAccount.IsEmpty() || nodeAccount.BondAddress.IsEmpty() {
+		return cosmos.ErrUnknownRequest(fmt.Sprintf("node account(%s) has not been bonded yet", msg.NodeAddress))
+	}
+	operator, err := nodeAccount.BondAddress.AccAddress()
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to parse bond address(%s)", nodeAccount.BondAddress))
+	}
+	if !operator.Equals(msg.Signer) {
+		return cosmos.ErrUnauthorized("only node operator can set fee")
+	}
+	return nil
+}
+
+func (h NodeOperatorFeeHandler) handle(ctx cosmos.Context, msg MsgNodeOperatorFee) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("1.124.0")):
+		return h.handleV124(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+// handle process MsgNodeOperatorFee
+func (h NodeOperatorFeeHandler) handleV124(ctx cosmos.Context, msg MsgNodeOperatorFee) error {
+	bp, err := h.mgr.Keeper().GetBondProviders(ctx, msg.NodeAddress)
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to get bond providers(%s)", msg.NodeAddress))
+	}
+
+	// no providers yet, add node operator bond address to the bond provider list
+	if len(bp.Providers) == 0 {
+		bp.Providers = append(bp.Providers, NewBondProvider(msg.Signer))
+		defaultNodeOperationFee := h.mgr.Keeper().GetConfigInt64(ctx, constants.NodeOperatorFee)
+		bp.NodeOperatorFee = cosmos.NewUint(uint64(defaultNodeOperationFee))
+	}
+
+	oldFee := int64(bp.NodeOperatorFee.Uint64())
+	applyHeight := setNodeOperatorFee(ctx, h.mgr, &bp, msg.Signer, msg.OperatorFee)
+
+	if err := h.mgr.Keeper().SetBondProviders(ctx, bp); err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to save bond providers(%s)", bp.NodeAddress.String()))
+	}
+
+	evt := NewEventNodeOperatorFee(msg.NodeAddress, oldFee, msg.OperatorFee, applyHeight, msg.Tx.ID)
+	if err := h.mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+		ctx.Logger().Error("fail to emit node operator fee event", "error", err)
+	}
+	return nil
+}
+
+// setNodeOperatorFee changes the node operator fee of the given bond providers
+// and returns the height the new fee applies from. An increase is left pending
+// for NodeOperatorFeeChangeDelay blocks once a provider other than the operator
+// has bonded, a new change always replaces the one still pending.
+func setNodeOperatorFee(ctx cosmos.Context, mgr Manager, bp *BondProviders, operator cosmos.AccAddress, fee int64) int64 {
+	applyHeight := ctx.BlockHeight()
+	if fee > int64(bp.NodeOperatorFee.Uint64()) && bp.HasProviderBonded(operator) {
+		applyHeight += mgr.Keeper().GetConfigInt64(ctx, constants.NodeOperatorFeeChangeDelay)
+	}
+
+	bp.PendingNodeOperatorFee = 0
+	bp.PendingNodeOperatorFeeHeight = 0
+	if applyHeight > ctx.BlockHeight() {
+		bp.PendingNodeOperatorFee = fee
+		bp.PendingNodeOperatorFeeHeight = applyHeight
+	} else {
+		bp.NodeOperatorFee = cosmos.NewUint(uint64(fee))
+	}
+	return applyHeight
+}
@@ -289,7 +289,11 @@ func (h ObservedTxInHandler) handleV112(ctx cosmos.Context, msg MsgObservedTxIn)
 
 		// if its a swap, send it to our queue for processing later
 		if isSwap {
-			h.addSwap(ctx, *swapMsg)
+			if err = h.addSwap(ctx, *swapMsg); err != nil {
+				if newErr := refundTx(ctx, tx, h.mgr, CodeSwapFail, err.Error(), ""); nil != newErr {
+					ctx.Logger().Error("fail to refund", "error", newErr)
+				}
+			}
 			continue
 		}
 
@@ -315,14 +319,21 @@ func (h ObservedTxInHandler) handleV112(ctx cosmos.Context, msg MsgObservedTxIn)
 	return &cosmos.Result{}, nil
 }
 
-func (h ObservedTxInHandler) addSwap(ctx cosmos.Context, msg MsgSwap) {
+func (h ObservedTxInHandler) addSwap(ctx cosmos.Context, msg MsgSwap) error {
 	version := h.mgr.GetVersion()
 	switch {
+	case version.GTE(semver.MustParse("1.124.0")):
+		return h.addSwapV124(ctx, msg)
 	case version.GTE(semver.MustParse("1.112.0")):
 		h.addSwapV112(ctx, msg)
 	case version.GTE(semver.MustParse("0.63.0")):
 		h.addSwapV63(ctx, msg)
 	}
+	return nil
+}
+
+func (h ObservedTxInHandler) addSwapV124(ctx cosmos.Context, msg MsgSwap) error {
+	return addSwapV124(ctx, h.mgr, msg)
 }
 
 func (h ObservedTxInHandler) addSwapV112(ctx cosmos.Context, msg MsgSwap) {
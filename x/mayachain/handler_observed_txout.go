@@ -67,6 +67,8 @@ func (h ObservedTxOutHandler) validateV1(ctx cosmos.Context, msg MsgObservedTxOu
 func (h ObservedTxOutHandler) handle(ctx cosmos.Context, msg MsgObservedTxOut) (*cosmos.Result, error) {
 	version := h.mgr.GetVersion()
 	switch {
+	case version.GTE(semver.MustParse("1.124.0")):
+		return h.handleV124(ctx, msg)
 	case version.GTE(semver.MustParse("1.114.0")):
 		return h.handleV114(ctx, msg)
 	case version.GTE(semver.MustParse("1.112.0")):
@@ -134,7 +136,7 @@ func (h ObservedTxOutHandler) preflightV89(ctx cosmos.Context, voter ObservedTxV
 }
 
 // Handle a message to observe outbound tx
-func (h ObservedTxOutHandler) handleV114(ctx cosmos.Context, msg MsgObservedTxOut) (*cosmos.Result, error) {
+func (h ObservedTxOutHandler) handleV124(ctx cosmos.Context, msg MsgObservedTxOut) (*cosmos.Result, error) {
 	activeNodeAccounts, err := h.mgr.Keeper().ListActiveValidators(ctx)
 	if err != nil {
 		return nil, wrapError(ctx, err, "fail to get list of active node accounts")
@@ -269,11 +271,15 @@ func (h ObservedTxOutHandler) handleV114(ctx cosmos.Context, msg MsgObservedTxOu
 		if vault.IsAsgard() && memo.IsType(TxMigrate) {
 			// only remove the block height that had been specified in the memo
 			vault.RemovePendingTxBlockHeights(memo.GetBlockHeight())
+			if vault.Status == RetiringVault {
+				recordVaultMigration(ctx, h.mgr, vault.PubKey, tx.Tx.Coins)
+			}
 		}
 
 		if !vault.HasFunds() && vault.Status == RetiringVault {
 			// we have successfully removed all funds from a retiring vault,
 			// mark it as inactive
+			completeVaultMigration(ctx, h.mgr, vault)
 			vault.Status = InactiveVault
 		}
 		// if the vault is frozen, then unfreeze it. Since we saw that a
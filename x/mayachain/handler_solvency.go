@@ -72,6 +72,8 @@ func (h SolvencyHandler) handle(ctx cosmos.Context, msg MsgSolvency) (*cosmos.Re
 	ctx.Logger().Debug("handle Solvency request", "id", msg.Id.String(), "signer", msg.Signer.String())
 	version := h.mgr.GetVersion()
 	switch {
+	case version.GTE(semver.MustParse("1.124.0")):
+		return h.handleV124(ctx, msg)
 	case version.GTE(semver.MustParse("1.121.0")): // insolvency-check
 		return h.handleV121(ctx, msg)
 	case version.GTE(semver.MustParse("1.87.0")):
@@ -89,7 +91,7 @@ func (h SolvencyHandler) handle(ctx cosmos.Context, msg MsgSolvency) (*cosmos.Re
 //     if wallet has less fund than asgard vault , and the gap is more than 1% , then the chain
 //     that is insolvent will be halt
 //  3. When chain is halt , bifrost will not observe inbound , and will not sign outbound txs until the issue has been investigated , and enabled it again using mimir
-func (h SolvencyHandler) handleV121(ctx cosmos.Context, msg MsgSolvency) (*cosmos.Result, error) {
+func (h SolvencyHandler) handleV124(ctx cosmos.Context, msg MsgSolvency) (*cosmos.Result, error) {
 	voter, err := h.mgr.Keeper().GetSolvencyVoter(ctx, msg.Id, msg.Chain)
 	if err != nil {
 		return &cosmos.Result{}, fmt.Errorf("fail to get solvency voter, err: %w", err)
@@ -135,6 +137,7 @@ func (h SolvencyHandler) handleV121(ctx cosmos.Context, msg MsgSolvency) (*cosmo
 		ctx.Logger().Error("fail to get vault", "error", err)
 		return &cosmos.Result{}, fmt.Errorf("fail to get vault: %w", err)
 	}
+	h.recordSolvency(ctx, vault, voter)
 	const StopSolvencyCheckKey = `StopSolvencyCheck`
 	stopSolvencyCheck, err := h.mgr.Keeper().GetMimir(ctx, StopSolvencyCheckKey)
 	if err != nil {
@@ -189,6 +192,71 @@ func (h SolvencyHandler) handleV121(ctx cosmos.Context, msg MsgSolvency) (*cosmo
 	return &cosmos.Result{}, nil
 }
 
+// recordSolvency saves the balances of the vault on the chain against the
+// balances reported by the solvency message, and emits a solvency warning for
+// each asset whose gap is above the warn gap, but not yet above the permitted
+// gap that halts the chain
+func (h SolvencyHandler) recordSolvency(ctx cosmos.Context, vault Vault, voter SolvencyVoter) {
+	// excluding the pending outbounds changes the coins in place
+	vault.Coins = vault.Coins.Copy()
+	adjustVault, err := h.excludePendingOutboundFromVault(ctx, vault)
+	if err != nil {
+		ctx.Logger().Error("fail to exclude pending outbound from vault", "error", err)
+		return
+	}
+	record := NewSolvencyRecord(vault.PubKey, voter.Chain, ctx.BlockHeight())
+	for _, c := range adjustVault.Coins {
+		if !c.Asset.Chain.Equals(voter.Chain) || c.Asset.IsBase() {
+			continue
+		}
+		record.Deltas = append(record.Deltas, NewSolvencyDelta(c.Asset, c.Amount, voter.Coins.GetCoin(c.Asset).Amount))
+	}
+	for _, c := range voter.Coins {
+		if c.Asset.IsBase() || c.IsEmpty() || !adjustVault.GetCoin(c.Asset).IsEmpty() {
+			continue
+		}
+		record.Deltas = append(record.Deltas, NewSolvencyDelta(c.Asset, cosmos.ZeroUint(), c.Amount))
+	}
+	h.mgr.Keeper().SetSolvencyRecord(ctx, record)
+
+	haltGap, err := h.mgr.Keeper().GetMimir(ctx, constants.PermittedSolvencyGap.String())
+	if err != nil || haltGap <= 0 {
+		haltGap = h.mgr.GetConstants().GetInt64Value(constants.PermittedSolvencyGap)
+	}
+	// the warn gap can be set per chain
+	warnGap, err := h.mgr.Keeper().GetMimir(ctx, constants.SolvencyWarnGap.String()+voter.Chain.String())
+	if err != nil || warnGap < 0 {
+		warnGap = fetchConfigInt64(ctx, h.mgr, constants.SolvencyWarnGap)
+	}
+	if warnGap <= 0 || warnGap >= haltGap {
+		return
+	}
+	for _, delta := range record.Deltas {
+		if delta.VaultAmount.IsZero() {
+			continue
+		}
+		// same as the solvency check, skip gas assets with too little left in
+		// the vault to pay for 10 * max gas
+		if delta.Asset.IsGasAsset() {
+			gas, err := h.mgr.GasMgr().GetMaxGas(ctx, delta.Asset.GetChain())
+			if err != nil {
+				ctx.Logger().Error("fail to get max gas", "error", err)
+			} else if delta.VaultAmount.LTE(gas.Amount.MulUint64(10)) {
+				continue
+			}
+		}
+		gap := delta.Gap()
+		if gap <= warnGap || gap > haltGap {
+			continue
+		}
+		ctx.Logger().Info("vault solvency gap above warn gap", "pubkey", vault.PubKey, "asset", delta.Asset, "gap", gap, "warn gap", warnGap)
+		evt := NewEventSolvencyWarning(vault.PubKey, voter.Chain, delta, warnGap, haltGap)
+		if err := h.mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+			ctx.Logger().Error("fail to emit solvency warning event", "error", err)
+		}
+	}
+}
+
 // insolvencyCheck compare the coins in vault against the coins report by solvency message
 // insolvent usually means vault has more coins than wallet
 // return true means the vault is insolvent , the network should halt , otherwise false
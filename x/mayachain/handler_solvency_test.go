@@ -6,6 +6,8 @@ import (
 	se "github.com/cosmos/cosmos-sdk/types/errors"
 	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/constants"
+	"gitlab.com/mayachain/mayanode/x/mayachain/types"
 	. "gopkg.in/check.v1"
 )
 
@@ -140,3 +142,85 @@ func (s *HandlerSolvencyTestSuite) TestValidate(c *C) {
 	c.Assert(errors.Is(err, se.ErrUnknownRequest), Equals, true)
 	c.Assert(result, IsNil)
 }
+
+func (s *HandlerSolvencyTestSuite) TestSolvencyHistoryAndWarning(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	handler := NewSolvencyHandler(mgr)
+	var activeNodes [4]NodeAccount
+	for i := 0; i < 4; i++ {
+		activeNodes[i] = GetRandomValidatorNode(NodeActive)
+		c.Assert(mgr.Keeper().SetNodeAccount(ctx, activeNodes[i]), IsNil)
+	}
+	asgard := NewVault(1024, ActiveVault, AsgardVault, GetRandomPubKey(), []string{common.ETHChain.String()}, nil)
+	asgard.AddFunds(common.NewCoins(common.NewCoin(common.ETHAsset, cosmos.NewUint(1000*common.One))))
+	c.Assert(mgr.Keeper().SetVault(ctx, asgard), IsNil)
+
+	report := func(ctx cosmos.Context, amount uint64) {
+		msg, err := NewMsgSolvency(common.ETHChain, asgard.PubKey, common.NewCoins(
+			common.NewCoin(common.ETHAsset, cosmos.NewUint(amount)),
+		), ctx.BlockHeight(), activeNodes[0].NodeAddress)
+		c.Assert(err, IsNil)
+		for i := 0; i < 3; i++ {
+			msg.Signer = activeNodes[i].NodeAddress
+			_, err = handler.Run(ctx, msg)
+			c.Assert(err, IsNil)
+		}
+	}
+	warnings := func(ctx cosmos.Context) int {
+		count := 0
+		for _, evt := range ctx.EventManager().Events() {
+			if evt.Type == types.SolvencyWarningEventType {
+				count++
+			}
+		}
+		return count
+	}
+
+	// wallet is short by 0.2%, below the warn gap
+	report(ctx, 998*common.One)
+	c.Check(warnings(ctx), Equals, 0)
+
+	// wallet is short by 0.6%, above the warn gap but below the halt gap
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10).WithEventManager(cosmos.NewEventManager())
+	report(ctx, 994*common.One)
+	c.Check(warnings(ctx), Equals, 1)
+	halt, err := mgr.Keeper().GetMimir(ctx, "SolvencyHaltETHChain")
+	c.Assert(err, IsNil)
+	c.Check(halt, Equals, int64(-1))
+
+	// a warn gap set for the chain overrides the default
+	mgr.Keeper().SetMimir(ctx, "SolvencyWarnGapETH", 80)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10).WithEventManager(cosmos.NewEventManager())
+	report(ctx, 994*common.One)
+	c.Check(warnings(ctx), Equals, 0)
+
+	var records []SolvencyRecord
+	iter := mgr.Keeper().GetSolvencyRecordIterator(ctx, asgard.PubKey, common.ETHChain)
+	for ; iter.Valid(); iter.Next() {
+		var record SolvencyRecord
+		c.Assert(mgr.Keeper().Cdc().Unmarshal(iter.Value(), &record), IsNil)
+		records = append(records, record)
+	}
+	iter.Close()
+	c.Assert(records, HasLen, 3)
+	c.Assert(records[1].Deltas, HasLen, 1)
+	c.Check(records[1].Deltas[0].Asset.Equals(common.ETHAsset), Equals, true)
+	c.Check(records[1].Deltas[0].VaultAmount.Uint64(), Equals, uint64(1000*common.One))
+	c.Check(records[1].Deltas[0].WalletAmount.Uint64(), Equals, uint64(994*common.One))
+	c.Check(records[1].Deltas[0].Gap(), Equals, int64(60))
+
+	// records older than the retention window are pruned
+	retention := mgr.GetConstants().GetInt64Value(constants.SolvencyHistoryRetention)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + retention)
+	report(ctx, 1000*common.One)
+	records = records[:0]
+	iter = mgr.Keeper().GetSolvencyRecordIterator(ctx, asgard.PubKey, common.ETHChain)
+	for ; iter.Valid(); iter.Next() {
+		var record SolvencyRecord
+		c.Assert(mgr.Keeper().Cdc().Unmarshal(iter.Value(), &record), IsNil)
+		records = append(records, record)
+	}
+	iter.Close()
+	c.Assert(records, HasLen, 1)
+	c.Check(records[0].Height, Equals, ctx.BlockHeight())
+}
@@ -45,6 +45,8 @@ func (h SwapHandler) Run(ctx cosmos.Context, m cosmos.Msg) (*cosmos.Result, erro
 func (h SwapHandler) validate(ctx cosmos.Context, msg MsgSwap) error {
 	version := h.mgr.GetVersion()
 	switch {
+	case version.GTE(semver.MustParse("1.124.0")):
+		return h.validateV124(ctx, msg)
 	case version.GTE(semver.MustParse("1.123.0")): // trade-accounts
 		return h.validateV123(ctx, msg)
 	case version.GTE(semver.MustParse("1.112.0")):
@@ -62,8 +64,8 @@ func (h SwapHandler) validate(ctx cosmos.Context, msg MsgSwap) error {
 	}
 }
 
-func (h SwapHandler) validateV123(ctx cosmos.Context, msg MsgSwap) error {
-	if err := msg.ValidateBasicV112(h.mgr.GetVersion()); err != nil {
+func (h SwapHandler) validateV124(ctx cosmos.Context, msg MsgSwap) error {
+	if err := msg.ValidateBasicV124(h.mgr.GetVersion()); err != nil {
 		return err
 	}
 
@@ -274,6 +276,8 @@ func (h SwapHandler) handle(ctx cosmos.Context, msg MsgSwap) (*cosmos.Result, er
 	ctx.Logger().Info("receive MsgSwap", "request tx hash", msg.Tx.ID, "source asset", msg.Tx.Coins[0].Asset, "target asset", msg.TargetAsset, "signer", msg.Signer.String())
 	version := h.mgr.GetVersion()
 	switch {
+	case version.GTE(semver.MustParse("1.124.0")):
+		return h.handleV124(ctx, msg)
 	case version.GTE(semver.MustParse("1.118.0")):
 		return h.handleV118(ctx, msg)
 	case version.GTE(semver.MustParse("1.112.0")):
@@ -289,7 +293,7 @@ func (h SwapHandler) handle(ctx cosmos.Context, msg MsgSwap) (*cosmos.Result, er
 	}
 }
 
-func (h SwapHandler) handleV118(ctx cosmos.Context, msg MsgSwap) (*cosmos.Result, error) {
+func (h SwapHandler) handleV124(ctx cosmos.Context, msg MsgSwap) (*cosmos.Result, error) {
 	// We use TargetAsset instead of Destination since Address.GetChain() iterates over all chains bumping ETH
 	// before ARB
 	destinationChain := msg.TargetAsset.GetChain()
@@ -336,8 +340,10 @@ func (h SwapHandler) handleV118(ctx cosmos.Context, msg MsgSwap) (*cosmos.Result
 			}
 		}
 
-		// for first swap only, override interval and quantity (if needed)
-		if swp.Count == 0 {
+		// for first swap only, override interval and quantity (if needed).
+		// dca orders are checked against their own limits when they are
+		// added to the swap queue, so they are never overridden
+		if swp.Count == 0 && !msg.IsDCA() {
 			// ensure interval is never larger than max length, override if so
 			maxLength := h.mgr.Keeper().GetConfigInt64(ctx, constants.StreamingSwapMaxLength)
 			if uint64(maxLength) < swp.Interval {
@@ -360,7 +366,13 @@ func (h SwapHandler) handleV118(ctx cosmos.Context, msg MsgSwap) (*cosmos.Result
 		// NOTE: its okay if the amount is zero. The swap will fail as it
 		// should, which will cause the swap queue manager later to send out
 		// the In/Out amounts accordingly
+		sliceLimit := msg.TradeTarget
 		msg.Tx.Coins[0].Amount, msg.TradeTarget = swp.NextSize(h.mgr.GetVersion())
+		// every slice of a dca order is guarded by the same price, instead of
+		// what is left of the trade target after the previous slices
+		if msg.IsDCA() {
+			msg.TradeTarget = common.GetSafeShare(msg.Tx.Coins[0].Amount, swp.DefaultSwapSize(), sliceLimit)
+		}
 	}
 
 	emit, _, swapErr := swapper.Swap(
@@ -381,6 +393,21 @@ func (h SwapHandler) handleV118(ctx cosmos.Context, msg MsgSwap) (*cosmos.Result
 		return nil, swapErr
 	}
 
+	// limit orders can be filled over several blocks, keep track of what has
+	// been emitted so far. The order book manager accounts for the inbound
+	// side, as it knows the size of the slice before any affiliate fee is
+	// taken off.
+	if msg.IsLimitOrder() {
+		var fill LimitOrderFill
+		fill, err = h.mgr.Keeper().GetLimitOrderFill(ctx, msg.Tx.ID)
+		if err != nil {
+			ctx.Logger().Error("fail to fetch limit order fill", "error", err)
+			return nil, err
+		}
+		fill.Out = fill.Out.Add(emit)
+		h.mgr.Keeper().SetLimitOrderFill(ctx, fill)
+	}
+
 	// Check if swap is to AffiliateCollector Module, if so, add the accrued RUNE for the affiliate
 	affColAddress, err := h.mgr.Keeper().GetModuleAddress(AffiliateCollectorName)
 	if err != nil {
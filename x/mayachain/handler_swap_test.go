@@ -383,6 +383,57 @@ func (s *HandlerSwapSuite) TestHandleStreamingSwap(c *C) {
 	c.Check(swp.Out.String(), Equals, "1648810932")
 }
 
+func (s *HandlerSwapSuite) TestHandleLimitOrderFill(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	pool.BalanceCacao = cosmos.NewUint(100 * common.One)
+	c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+	mgr.txOutStore = NewTxStoreDummy()
+
+	na := GetRandomValidatorNode(NodeActive)
+	c.Assert(mgr.Keeper().SetNodeAccount(ctx, na), IsNil)
+
+	handler := NewSwapHandler(mgr)
+
+	signerBNBAddr := GetRandomBNBAddress()
+	tx := common.NewTx(
+		GetRandomTxHash(),
+		signerBNBAddr,
+		signerBNBAddr,
+		common.Coins{
+			common.NewCoin(common.BaseAsset(), cosmos.NewUint(common.One)),
+		},
+		BNBGasFeeSingleton,
+		fmt.Sprintf("=<:BNB.BNB:%s", signerBNBAddr),
+	)
+	msg := NewMsgSwap(tx, common.BNBAsset, signerBNBAddr, cosmos.NewUint(common.One/2), common.NoAddress, cosmos.ZeroUint(), "", "", nil, LimitOrder, 0, 0, na.NodeAddress)
+
+	// each executed slice adds its emitted amount to the fill record
+	_, err := handler.handle(ctx, *msg)
+	c.Assert(err, IsNil)
+	fill, err := mgr.Keeper().GetLimitOrderFill(ctx, tx.ID)
+	c.Assert(err, IsNil)
+	c.Check(fill.Out.String(), Equals, "98029604")
+	c.Check(fill.In.IsZero(), Equals, true)
+
+	_, err = handler.handle(ctx, *msg)
+	c.Assert(err, IsNil)
+	fill, err = mgr.Keeper().GetLimitOrderFill(ctx, tx.ID)
+	c.Assert(err, IsNil)
+	c.Check(fill.Out.String(), Equals, "194155998")
+
+	// a slice that doesn't meet its price is not recorded
+	msg.TradeTarget = cosmos.NewUint(common.One)
+	_, err = handler.handle(ctx, *msg)
+	c.Assert(err, NotNil)
+	fill, err = mgr.Keeper().GetLimitOrderFill(ctx, tx.ID)
+	c.Assert(err, IsNil)
+	c.Check(fill.Out.String(), Equals, "194155998")
+}
+
 func (s *HandlerSwapSuite) TestSwapSynthERC20(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 	mgr.txOutStore = NewTxStoreDummy()
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/blang/semver"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/store"
@@ -601,6 +602,24 @@ func (HandlerSuite) TestGetMsgUnBondFromMemo(c *C) {
 	c.Assert(isUnBond, Equals, true)
 }
 
+func (HandlerSuite) TestGetMsgLoanFromMemo(c *C) {
+	w := getHandlerTestWrapper(c, 1, true, false)
+	tx := GetRandomTx()
+	tx.Coins = common.Coins{
+		common.NewCoin(common.BNBAsset.GetTradeAsset(), cosmos.NewUint(100*common.One)),
+	}
+	tx.Memo = fmt.Sprintf("loan+:BNB.BNB:%s", GetRandomBNBAddress())
+	obTx := NewObservedTx(tx, w.ctx.BlockHeight(), GetRandomPubKey(), w.ctx.BlockHeight())
+	msg, err := processOneTxIn(w.ctx, GetCurrentVersion(), w.keeper, obTx, w.activeNodeAccount.NodeAddress)
+	c.Assert(err, IsNil)
+	_, isLoanOpen := msg.(*MsgLoanOpen)
+	c.Assert(isLoanOpen, Equals, true)
+
+	// loans aren't routed before 1.124.0
+	_, err = processOneTxIn(w.ctx, semver.MustParse("1.123.0"), w.keeper, obTx, w.activeNodeAccount.NodeAddress)
+	c.Assert(err, NotNil)
+}
+
 func (HandlerSuite) TestGetMsgLiquidityFromMemo(c *C) {
 	w := getHandlerTestWrapper(c, 1, true, false)
 	// provide BNB, however THORNode send T-CAN as coin , which is incorrect, should result in an error
@@ -771,6 +790,17 @@ func (s *HandlerSuite) TestExternalHandler(c *C) {
 	c.Assert(result, NotNil)
 }
 
+func (s *HandlerSuite) TestGetHandlerMapping(c *C) {
+	_, mgr := setupManagerForTest(c)
+
+	// trade account transfers can only be sent natively from 1.124.0
+	_, ok := getHandlerMapping(mgr)[MsgTradeAccountTransfer{}.Type()]
+	c.Check(ok, Equals, true)
+	mgr.currentVersion = semver.MustParse("1.123.0")
+	_, ok = getHandlerMapping(mgr)[MsgTradeAccountTransfer{}.Type()]
+	c.Check(ok, Equals, false)
+}
+
 func (s *HandlerSuite) TestFuzzyMatching(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 	k := mgr.Keeper()
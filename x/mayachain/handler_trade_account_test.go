@@ -9,6 +9,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/constants"
 	openapi "gitlab.com/mayachain/mayanode/openapi/gen"
 	"gitlab.com/mayachain/mayanode/x/mayachain/types"
 	. "gopkg.in/check.v1"
@@ -75,6 +76,60 @@ func (s *MultipleAffiliatesSuite) TestTradeAccountDeposit(c *C) {
 	c.Check(bal.String(), Equals, "350")
 }
 
+func (s *MultipleAffiliatesSuite) TestTradeAccountTransfer(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	asset := common.BTCAsset
+	tradeAsset := asset.GetTradeAsset()
+	from := GetRandomBech32Addr()
+	to := GetRandomBech32Addr()
+	dummyTx := common.Tx{ID: "test"}
+
+	deposit := NewMsgTradeAccountDeposit(asset, cosmos.NewUint(500), from, from, dummyTx)
+	_, err := NewTradeAccountDepositHandler(mgr).Run(ctx, deposit)
+	c.Assert(err, IsNil)
+
+	h := NewTradeAccountTransferHandler(mgr)
+
+	// cannot transfer more than the balance
+	msg := NewMsgTradeAccountTransfer(tradeAsset, cosmos.NewUint(501), to, from, dummyTx)
+	_, err = h.Run(ctx, msg)
+	c.Assert(err, NotNil)
+
+	// cannot transfer to itself
+	msg = NewMsgTradeAccountTransfer(tradeAsset, cosmos.NewUint(100), from, from, dummyTx)
+	_, err = h.Run(ctx, msg)
+	c.Assert(err, NotNil)
+
+	// cannot transfer to a module
+	msg = NewMsgTradeAccountTransfer(tradeAsset, cosmos.NewUint(100), mgr.Keeper().GetModuleAccAddress(AsgardName), from, dummyTx)
+	_, err = h.Run(ctx, msg)
+	c.Assert(err, NotNil)
+
+	msg = NewMsgTradeAccountTransfer(tradeAsset, cosmos.NewUint(200), to, from, dummyTx)
+	_, err = h.Run(ctx, msg)
+	c.Assert(err, IsNil)
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, asset, from).String(), Equals, "300")
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, asset, to).String(), Equals, "200")
+
+	// a native transfer pays the native fee
+	FundAccount(c, ctx, mgr.Keeper(), from, 100)
+	nativeFee := mgr.GetConstants().GetInt64Value(constants.NativeTransactionFee)
+	before := mgr.Keeper().GetBalance(ctx, from).AmountOf(common.BaseNative.Native())
+	msg = NewMsgTradeAccountTransfer(tradeAsset, cosmos.NewUint(100), to, from, common.Tx{})
+	_, err = NewNativeTradeAccountTransferHandler(mgr).Run(ctx, msg)
+	c.Assert(err, IsNil)
+	after := mgr.Keeper().GetBalance(ctx, from).AmountOf(common.BaseNative.Native())
+	c.Check(before.Sub(after).Int64(), Equals, nativeFee)
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, asset, from).String(), Equals, "200")
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, asset, to).String(), Equals, "300")
+
+	// a native transfer without funds for the fee fails
+	msg = NewMsgTradeAccountTransfer(tradeAsset, cosmos.NewUint(100), from, to, common.Tx{})
+	_, err = NewNativeTradeAccountTransferHandler(mgr).Run(ctx, msg)
+	c.Assert(err, NotNil)
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, asset, to).String(), Equals, "300")
+}
+
 func (s *MultipleAffiliatesSuite) getTradeUnit(asset common.Asset, c *C) (resp openapi.TradeUnitResponse) {
 	jsonData, err := queryTradeUnit(s.ctx, []string{asset.String()}, s.mgr)
 	c.Assert(err, IsNil)
@@ -0,0 +1,150 @@
+package mayachain
+
+import (
+	"fmt"
+
+	"github.com/blang/semver"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/constants"
+)
+
+// TradeAccountTransferHandler is handler to process MsgTradeAccountTransfer.
+// A native handler serves MsgTradeAccountTransfer signed directly by the owner
+// and charges the native transaction fee, while the internal handler serves
+// the "trade=" memo of a MsgDeposit, which has already paid the fee.
+type TradeAccountTransferHandler struct {
+	mgr    Manager
+	native bool
+}
+
+// NewTradeAccountTransferHandler create a new instance of TradeAccountTransferHandler
+func NewTradeAccountTransferHandler(mgr Manager) TradeAccountTransferHandler {
+	return TradeAccountTransferHandler{
+		mgr: mgr,
+	}
+}
+
+// NewNativeTradeAccountTransferHandler create a new instance of TradeAccountTransferHandler
+// for MsgTradeAccountTransfer sent directly to MAYAChain
+func NewNativeTradeAccountTransferHandler(mgr Manager) TradeAccountTransferHandler {
+	return TradeAccountTransferHandler{
+		mgr:    mgr,
+		native: true,
+	}
+}
+
+// Run is the main entry point for TradeAccountTransferHandler
+func (h TradeAccountTransferHandler) Run(ctx cosmos.Context, m cosmos.Msg) (*cosmos.Result, error) {
+	msg, ok := m.(*MsgTradeAccountTransfer)
+	if !ok {
+		return nil, errInvalidMessage
+	}
+	if err := h.validate(ctx, *msg); err != nil {
+		ctx.Logger().Error("MsgTradeAccountTransfer failed validation", "error", err)
+		return nil, err
+	}
+	err := h.handle(ctx, *msg)
+	if err != nil {
+		ctx.Logger().Error("fail to process MsgTradeAccountTransfer", "error", err)
+	}
+	return &cosmos.Result{}, err
+}
+
+func (h TradeAccountTransferHandler) validate(ctx cosmos.Context, msg MsgTradeAccountTransfer) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("1.124.0")):
+		return h.validateV124(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+func (h TradeAccountTransferHandler) validateV124(ctx cosmos.Context, msg MsgTradeAccountTransfer) error {
+	tradeAccountsEnabled := h.mgr.Keeper().GetConfigInt64(ctx, constants.TradeAccountsEnabled)
+	if tradeAccountsEnabled <= 0 {
+		return fmt.Errorf("trade accounts are disabled")
+	}
+	if IsModuleAccAddress(h.mgr.Keeper(), msg.ToAddress) {
+		return fmt.Errorf("cannot transfer trade assets to a module")
+	}
+	return msg.ValidateBasic()
+}
+
+func (h TradeAccountTransferHandler) handle(ctx cosmos.Context, msg MsgTradeAccountTransfer) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("1.124.0")):
+		return h.handleV124(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+// handle process MsgTradeAccountTransfer
+func (h TradeAccountTransferHandler) handleV124(ctx cosmos.Context, msg MsgTradeAccountTransfer) error {
+	if h.native {
+		if err := h.payNativeFee(ctx, &msg); err != nil {
+			return err
+		}
+	}
+
+	balance := h.mgr.TradeAccountManager().BalanceOf(ctx, msg.Asset, msg.Signer)
+	if msg.Amount.GT(balance) {
+		return fmt.Errorf("insufficient trade account balance: %s < %s", balance, msg.Amount)
+	}
+
+	transferred, err := h.mgr.TradeAccountManager().Transfer(ctx, msg.Asset, msg.Amount, msg.Signer, msg.ToAddress, msg.Tx.ID)
+	if err != nil {
+		return err
+	}
+	if transferred.IsZero() {
+		return fmt.Errorf("nothing to transfer")
+	}
+	return nil
+}
+
+// payNativeFee charges the native transaction fee the same way as MsgSend and
+// records the hash of the transaction on the message
+func (h TradeAccountTransferHandler) payNativeFee(ctx cosmos.Context, msg *MsgTradeAccountTransfer) error {
+	haltHeight, err := h.mgr.Keeper().GetMimir(ctx, "HaltMAYAChain")
+	if err != nil {
+		return fmt.Errorf("failed to get mimir setting: %w", err)
+	}
+	if haltHeight > 0 && ctx.BlockHeight() > haltHeight {
+		return fmt.Errorf("mimir has halted MAYAChain transactions")
+	}
+
+	nativeTxFee, err := h.mgr.Keeper().GetMimir(ctx, constants.NativeTransactionFee.String())
+	if err != nil || nativeTxFee < 0 {
+		nativeTxFee = h.mgr.GetConstants().GetInt64Value(constants.NativeTransactionFee)
+	}
+	gas := common.NewCoin(common.BaseNative, cosmos.NewUint(uint64(nativeTxFee)))
+	gasFee, err := gas.Native()
+	if err != nil {
+		return ErrInternal(err, "fail to get gas fee")
+	}
+	if !h.mgr.Keeper().HasCoins(ctx, msg.Signer, cosmos.NewCoins(gasFee)) {
+		return cosmos.ErrInsufficientCoins(err, "insufficient funds")
+	}
+
+	// Calculate Maya Fund -->  gasFee = 90%, Maya Fund = 10%
+	newGas, mayaGas := CalculateMayaFundPercentage(gas, h.mgr)
+	if err := h.mgr.Keeper().SendFromAccountToModule(ctx, msg.Signer, ReserveName, common.NewCoins(newGas)); err != nil {
+		return fmt.Errorf("unable to send gas to reserve: %w", err)
+	}
+	if err := h.mgr.Keeper().SendFromAccountToModule(ctx, msg.Signer, MayaFund, common.NewCoins(mayaGas)); err != nil {
+		return fmt.Errorf("unable to send gas to maya fund: %w", err)
+	}
+
+	hash := tmtypes.Tx(ctx.TxBytes()).Hash()
+	txID, err := common.NewTxID(fmt.Sprintf("%X", hash))
+	if err != nil {
+		return fmt.Errorf("fail to get tx hash: %w", err)
+	}
+	msg.Tx.ID = txID
+	return nil
+}
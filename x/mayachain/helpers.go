@@ -82,7 +82,7 @@ func refundTxV124(ctx cosmos.Context, tx ObservedTx, mgr Manager, refundCode uin
 		if coin.Asset.IsBase() || !pool.BalanceCacao.IsZero() {
 			toAddr := tx.Tx.FromAddress
 			memo, err := ParseMemoWithMAYANames(ctx, mgr.Keeper(), tx.Tx.Memo)
-			if err == nil && memo.IsType(TxSwap) && !memo.GetRefundAddress().IsEmpty() && !coin.Asset.GetChain().IsBASEChain() {
+			if err == nil && (memo.IsType(TxSwap) || memo.IsType(TxLimitOrder) || memo.IsType(TxDCA)) && !memo.GetRefundAddress().IsEmpty() && !coin.Asset.GetChain().IsBASEChain() {
 				// If the memo specifies a refund address, send the refund to that address. If
 				// refund memo can't be parsed or is invalid for the refund chain, it will
 				// default back to the sender address
@@ -769,6 +769,36 @@ func emitPoolBalanceChangedEvent(ctx cosmos.Context, poolMod PoolMod, reason str
 	}
 }
 
+// recordVaultMigration adds the given coins to the amount migrated out of a
+// retiring vault
+func recordVaultMigration(ctx cosmos.Context, mgr Manager, pk common.PubKey, coins common.Coins) {
+	migration, err := mgr.Keeper().GetVaultMigration(ctx, pk)
+	if err != nil {
+		ctx.Logger().Error("fail to get vault migration", "error", err, "pubkey", pk)
+		return
+	}
+	migration.AddMigrated(coins)
+	mgr.Keeper().SetVaultMigration(ctx, migration)
+}
+
+// completeVaultMigration marks the migration of a retiring vault as complete
+// and emits a vault migrated event, it must be called before the vault status
+// is changed to inactive
+func completeVaultMigration(ctx cosmos.Context, mgr Manager, vault Vault) {
+	migration, err := mgr.Keeper().GetVaultMigration(ctx, vault.PubKey)
+	if err != nil {
+		ctx.Logger().Error("fail to get vault migration", "error", err, "pubkey", vault.PubKey)
+		return
+	}
+	migration.CompletedHeight = ctx.BlockHeight()
+	mgr.Keeper().SetVaultMigration(ctx, migration)
+
+	evt := NewEventVaultMigrated(vault.PubKey, vault.StatusSince, migration.Migrated)
+	if err = mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+		ctx.Logger().Error("fail to emit vault migrated event", "error", err)
+	}
+}
+
 // isLiquidityAuction checks for the LiquidityAuction mimir attribute
 func isLiquidityAuction(ctx cosmos.Context, keeper keeper.Keeper) bool {
 	liquidityAuction, err := keeper.GetMimir(ctx, constants.LiquidityAuction.String())
@@ -1949,6 +1979,61 @@ func getPreferredAssetSwapThresholdV118(ctx cosmos.Context, mgr Manager, preferr
 	return threshold
 }
 
+// addSwapV124 adds the swap to the order book when order books are enabled, and
+// to the swap queue otherwise. Limit orders are only accepted while order
+// books are enabled, while cancellations are processed straight away, as they
+// never execute against the pools. A cancellation stops a limit order if one
+// exists with the given tx id, and a streaming swap otherwise. DCA orders are
+// always added to the swap queue, which swaps them slice by slice.
+func addSwapV124(ctx cosmos.Context, mgr Manager, msg MsgSwap) error {
+	if msg.IsCancel() {
+		if err := msg.ValidateBasicV124(mgr.GetVersion()); err != nil {
+			return err
+		}
+		if mgr.Keeper().HasOrderBookItem(ctx, msg.CancelTxID) {
+			return mgr.OrderBookMgr().CancelOrderBookItem(ctx, mgr, msg)
+		}
+		return mgr.SwapQ().CancelStreamingSwap(ctx, mgr, msg)
+	}
+	if msg.IsDCA() {
+		return addDCAOrder(ctx, mgr, msg)
+	}
+	if mgr.Keeper().GetConfigInt64(ctx, constants.EnableOrderBooks) > 0 {
+		return mgr.OrderBookMgr().AddOrderBookItem(ctx, msg)
+	}
+	if msg.OrderType == LimitOrder {
+		return fmt.Errorf("limit orders are disabled")
+	}
+	addSwapDirect(ctx, mgr, msg)
+	return nil
+}
+
+// addDCAOrder parks the deposit of a dca order in the swap queue, where it is
+// swapped in slices at the order's interval. Unlike regular streaming swaps,
+// the interval and quantity are never adjusted, so orders that exceed the
+// network limits are rejected instead.
+func addDCAOrder(ctx cosmos.Context, mgr Manager, msg MsgSwap) error {
+	if mgr.Keeper().GetConfigInt64(ctx, constants.DCAOrdersEnabled) <= 0 {
+		return fmt.Errorf("dca orders are disabled")
+	}
+	if !msg.IsStreaming() || msg.StreamQuantity < 2 {
+		return fmt.Errorf("dca orders need an interval and at least two slices")
+	}
+	maxQuantity := mgr.Keeper().GetConfigInt64(ctx, constants.DCAMaxQuantity)
+	if msg.StreamQuantity > uint64(maxQuantity) {
+		return fmt.Errorf("dca order quantity (%d) exceeds max quantity (%d)", msg.StreamQuantity, maxQuantity)
+	}
+	maxLength := mgr.Keeper().GetConfigInt64(ctx, constants.DCAMaxLength)
+	if msg.StreamInterval > uint64(maxLength)/msg.StreamQuantity {
+		return fmt.Errorf("dca order length (%d blocks) exceeds max length (%d blocks)", msg.StreamInterval*msg.StreamQuantity, maxLength)
+	}
+	addSwapDirect(ctx, mgr, msg)
+	if err := mgr.EventMgr().EmitEvent(ctx, NewEventDCAOrder(msg)); err != nil {
+		ctx.Logger().Error("fail to emit dca order event", "error", err)
+	}
+	return nil
+}
+
 // addSwapDirect adds the swap directly to the swap queue (no order book) - segmented
 // out into its own function to allow easier maintenance of original behavior vs order
 // book behavior.
@@ -403,6 +403,12 @@ func checkAndTriggerPreferredAssetSwap(ctx cosmos.Context, mgr Manager, mayaname
 }
 
 func triggerPreferredAssetSwapV118(ctx cosmos.Context, mgr Manager, mn MAYAName, affCol AffiliateFeeCollector, queueIndex int) error {
+	return swapAffiliateCollector(ctx, mgr, mn, affCol, queueIndex, false)
+}
+
+// swapAffiliateCollector queues the swap of the accrued CACAO to the preferred
+// asset of the MAYAName, claimed is set when the owner requested the payout
+func swapAffiliateCollector(ctx cosmos.Context, mgr Manager, mn MAYAName, affCol AffiliateFeeCollector, queueIndex int, claimed bool) error {
 	// Sanity check: don't swap 0 amount
 	if affCol.CacaoAmount.IsZero() {
 		return fmt.Errorf("can't execute preferred asset swap, accrued RUNE amount is zero")
@@ -415,7 +421,7 @@ func triggerPreferredAssetSwapV118(ctx cosmos.Context, mgr Manager, mn MAYAName,
 
 	// if the preferred asset is empty or cacao, just release the affiliate collector
 	if mn.PreferredAsset.IsEmpty() || mn.PreferredAsset.IsNativeBase() {
-		if err := releaseAffiliateCollector(ctx, mgr, mn); err != nil {
+		if err := releaseAffiliateCollectorFunds(ctx, mgr, mn, claimed); err != nil {
 			return fmt.Errorf("failed to release affiliate collector funds for %s. Error: %w", mn.Name, err)
 		}
 		return nil
@@ -514,11 +520,18 @@ func triggerPreferredAssetSwapV118(ctx cosmos.Context, mgr Manager, mn MAYAName,
 
 	affCol.CacaoAmount = cosmos.ZeroUint()
 	mgr.Keeper().SetAffiliateCollector(ctx, affCol)
+	recordAffiliatePayout(ctx, mgr, mn, mn.PreferredAsset, affCacao, paTxID, claimed)
 
 	return nil
 }
 
 func releaseAffiliateCollector(ctx cosmos.Context, mgr Manager, mayaname MAYAName) error {
+	return releaseAffiliateCollectorFunds(ctx, mgr, mayaname, false)
+}
+
+// releaseAffiliateCollectorFunds sends the accrued CACAO to the MAYAName,
+// claimed is set when the owner requested the payout
+func releaseAffiliateCollectorFunds(ctx cosmos.Context, mgr Manager, mayaname MAYAName, claimed bool) error {
 	var err error
 	var destAcc cosmos.AccAddress
 	affCol, err := mgr.Keeper().GetAffiliateCollector(ctx, mayaname.Owner)
@@ -542,8 +555,22 @@ func releaseAffiliateCollector(ctx cosmos.Context, mgr Manager, mayaname MAYANam
 	if sdkErr != nil {
 		return fmt.Errorf("fail to send native asset to affiliate, address: %s, error: %w", destAcc, sdkErr)
 	}
+	payout := affCol.CacaoAmount
 	affCol.CacaoAmount = cosmos.ZeroUint()
 	mgr.Keeper().SetAffiliateCollector(ctx, affCol)
+	recordAffiliatePayout(ctx, mgr, mayaname, common.BaseNative, payout, common.BlankTxID, claimed)
 
 	return nil
 }
+
+// recordAffiliatePayout adds the payout to the history of the affiliate
+// collector, failing to record it doesn't fail the payout
+func recordAffiliatePayout(ctx cosmos.Context, mgr Manager, mn MAYAName, asset common.Asset, amount cosmos.Uint, txID common.TxID, claimed bool) {
+	if mgr.GetVersion().LT(semver.MustParse("1.124.0")) {
+		return
+	}
+	payout := NewAffiliatePayout(mn.Name, asset, amount, txID, ctx.BlockHeight(), claimed)
+	if err := mgr.Keeper().AddAffiliatePayout(ctx, mn.Owner, payout); err != nil {
+		ctx.Logger().Error("fail to record affiliate payout", "mayaname", mn.Name, "error", err)
+	}
+}
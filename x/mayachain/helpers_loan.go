@@ -0,0 +1,306 @@
+package mayachain
+
+import (
+	"fmt"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/constants"
+)
+
+// getLoanMinCollateralRatio returns the minimum collateralization ratio (in
+// basis points) of loans backed by the given collateral asset. It can be set
+// per pool with the LendingMinCR-<pool> mimir, and falls back to the
+// LendingMinCollateralRatio constant.
+func getLoanMinCollateralRatio(ctx cosmos.Context, mgr Manager, collateralAsset common.Asset) int64 {
+	mimirKey := fmt.Sprintf("LendingMinCR-%s", collateralAsset.GetLayer1Asset().MimirString())
+	minCR, err := mgr.Keeper().GetMimir(ctx, mimirKey)
+	if err == nil && minCR > 0 {
+		return minCR
+	}
+	return mgr.Keeper().GetConfigInt64(ctx, constants.LendingMinCollateralRatio)
+}
+
+// getLoanPool returns the available pool used to value the given asset
+func getLoanPool(ctx cosmos.Context, mgr Manager, asset common.Asset) (Pool, error) {
+	pool, err := mgr.Keeper().GetPool(ctx, asset.GetLayer1Asset())
+	if err != nil {
+		return pool, ErrInternal(err, fmt.Sprintf("fail to get pool(%s)", asset.GetLayer1Asset()))
+	}
+	if pool.IsEmpty() || !pool.IsAvailable() {
+		return pool, fmt.Errorf("pool(%s) is not available", asset.GetLayer1Asset())
+	}
+	return pool, nil
+}
+
+// loanAssetValueInCacao converts an amount of a collateral or debt asset into
+// its cacao value at the current pool price
+func loanAssetValueInCacao(ctx cosmos.Context, mgr Manager, asset common.Asset, amt cosmos.Uint) (cosmos.Uint, error) {
+	if asset.IsNativeBase() {
+		return amt, nil
+	}
+	pool, err := getLoanPool(ctx, mgr, asset)
+	if err != nil {
+		return cosmos.ZeroUint(), err
+	}
+	return pool.AssetValueInRune(amt), nil
+}
+
+// loanCacaoValueInAsset converts an amount of cacao into the given collateral
+// or debt asset at the current pool price
+func loanCacaoValueInAsset(ctx cosmos.Context, mgr Manager, asset common.Asset, amt cosmos.Uint) (cosmos.Uint, error) {
+	if asset.IsNativeBase() {
+		return amt, nil
+	}
+	pool, err := getLoanPool(ctx, mgr, asset)
+	if err != nil {
+		return cosmos.ZeroUint(), err
+	}
+	return pool.RuneValueInAsset(amt), nil
+}
+
+// loanCollateralAmount returns the amount of trade asset the given collateral
+// units are entitled to
+func loanCollateralAmount(ctx cosmos.Context, mgr Manager, asset common.Asset, units cosmos.Uint) (cosmos.Uint, error) {
+	tu, err := mgr.Keeper().GetTradeUnit(ctx, asset)
+	if err != nil {
+		return cosmos.ZeroUint(), err
+	}
+	return common.GetSafeShare(units, tu.Units, tu.Depth), nil
+}
+
+// loanCollateralizationRatio returns the collateral value over the debt value
+// in basis points, zero when there is no debt
+func loanCollateralizationRatio(collateralValue, debtValue cosmos.Uint) int64 {
+	if debtValue.IsZero() {
+		return 0
+	}
+	return int64(common.GetUncappedShare(collateralValue, debtValue, cosmos.NewUint(constants.MaxBasisPts)).Uint64())
+}
+
+// lockLoanCollateral moves the trade units backing amount of the trade asset
+// out of the owner's trade account. The units are then held by the loan, so the
+// trade unit record of the asset is untouched.
+func lockLoanCollateral(ctx cosmos.Context, mgr Manager, owner cosmos.AccAddress, asset common.Asset, amount cosmos.Uint) (cosmos.Uint, cosmos.Uint, error) {
+	tu, err := mgr.Keeper().GetTradeUnit(ctx, asset)
+	if err != nil {
+		return cosmos.ZeroUint(), cosmos.ZeroUint(), err
+	}
+	tr, err := mgr.Keeper().GetTradeAccount(ctx, owner, asset)
+	if err != nil {
+		return cosmos.ZeroUint(), cosmos.ZeroUint(), err
+	}
+
+	// same share calculation as a trade account withdrawal
+	assetAvailable := common.GetSafeShare(tr.Units, tu.Units, tu.Depth)
+	if amount.GT(assetAvailable) {
+		return cosmos.ZeroUint(), cosmos.ZeroUint(), fmt.Errorf("insufficient trade account balance: %s < %s", assetAvailable, amount)
+	}
+	units := common.GetSafeShare(amount, assetAvailable, tr.Units)
+	locked := common.GetSafeShare(units, tr.Units, assetAvailable)
+	if units.IsZero() {
+		return cosmos.ZeroUint(), cosmos.ZeroUint(), fmt.Errorf("collateral is too small")
+	}
+
+	tr.Units = common.SafeSub(tr.Units, units)
+	tr.LastWithdrawHeight = ctx.BlockHeight()
+	mgr.Keeper().SetTradeAccount(ctx, tr)
+	return units, locked, nil
+}
+
+// releaseLoanCollateral returns the given collateral units of a loan to the
+// owner's trade account, and the amount of trade asset they are worth
+func releaseLoanCollateral(ctx cosmos.Context, mgr Manager, owner cosmos.AccAddress, asset common.Asset, units cosmos.Uint) (cosmos.Uint, error) {
+	amount, err := loanCollateralAmount(ctx, mgr, asset, units)
+	if err != nil {
+		return cosmos.ZeroUint(), err
+	}
+	tr, err := mgr.Keeper().GetTradeAccount(ctx, owner, asset)
+	if err != nil {
+		return cosmos.ZeroUint(), err
+	}
+	tr.Units = tr.Units.Add(units)
+	tr.LastAddHeight = ctx.BlockHeight()
+	mgr.Keeper().SetTradeAccount(ctx, tr)
+	return amount, nil
+}
+
+// swapLoanCacao moves the given cacao from the reserve to asgard and swaps it
+// into the target asset, paid out to the destination through the outbound queue
+func swapLoanCacao(ctx cosmos.Context, mgr Manager, tx common.Tx, cacao cosmos.Uint, target common.Asset, destination common.Address) error {
+	coin := common.NewCoin(common.BaseNative, cacao)
+	if err := mgr.Keeper().SendFromModuleToModule(ctx, ReserveName, AsgardName, common.NewCoins(coin)); err != nil {
+		return ErrInternal(err, "fail to move cacao from reserve to asgard")
+	}
+
+	swapper, err := GetSwapper(mgr.GetVersion())
+	if err != nil {
+		return err
+	}
+	synthVirtualDepthMult, err := mgr.Keeper().GetMimir(ctx, constants.VirtualMultSynthsBasisPoints.String())
+	if synthVirtualDepthMult < 1 || err != nil {
+		synthVirtualDepthMult = mgr.GetConstants().GetInt64Value(constants.VirtualMultSynthsBasisPoints)
+	}
+	tx.Coins = common.NewCoins(coin)
+	_, _, err = swapper.Swap(
+		ctx,
+		mgr.Keeper(),
+		tx,
+		target,
+		destination,
+		cosmos.ZeroUint(),
+		"",
+		"",
+		nil,
+		StreamingSwap{},
+		cosmos.ZeroUint(),
+		synthVirtualDepthMult,
+		mgr)
+	return err
+}
+
+// liquidateLoans closes every loan whose collateralization ratio fell below the
+// LendingLiquidationCollateralRatio. The collateral is swapped into cacao for
+// the reserve, which takes the loss when it doesn't cover the debt, and what
+// exceeds the debt is paid back to the owner.
+func liquidateLoans(ctx cosmos.Context, mgr Manager) {
+	interval := mgr.Keeper().GetConfigInt64(ctx, constants.LendingLiquidationInterval)
+	if interval <= 0 || ctx.BlockHeight()%interval != 0 {
+		return
+	}
+	liquidationCR := mgr.Keeper().GetConfigInt64(ctx, constants.LendingLiquidationCollateralRatio)
+
+	var loans []Loan
+	iter := mgr.Keeper().GetLoanIterator(ctx)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var loan Loan
+		if err := mgr.Keeper().Cdc().Unmarshal(iter.Value(), &loan); err != nil {
+			ctx.Logger().Error("fail to unmarshal loan", "error", err)
+			continue
+		}
+		loans = append(loans, loan)
+	}
+
+	for _, loan := range loans {
+		cr, err := getLoanCollateralizationRatio(ctx, mgr, loan)
+		if err != nil {
+			ctx.Logger().Error("fail to get loan collateralization ratio", "owner", loan.Owner, "asset", loan.CollateralAsset, "error", err)
+			continue
+		}
+		if cr == 0 || cr >= liquidationCR {
+			continue
+		}
+
+		cacheCtx, commit := ctx.CacheContext()
+		if err := liquidateLoan(cacheCtx, mgr, loan, cr); err != nil {
+			ctx.Logger().Error("fail to liquidate loan", "owner", loan.Owner, "asset", loan.CollateralAsset, "error", err)
+			continue
+		}
+		commit()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
+
+// getLoanCollateralizationRatio values the collateral and the outstanding debt
+// of a loan at the current pool prices
+func getLoanCollateralizationRatio(ctx cosmos.Context, mgr Manager, loan Loan) (int64, error) {
+	collateral, err := loanCollateralAmount(ctx, mgr, loan.CollateralAsset, loan.CollateralUnits)
+	if err != nil {
+		return 0, err
+	}
+	collateralValue, err := loanAssetValueInCacao(ctx, mgr, loan.CollateralAsset, collateral)
+	if err != nil {
+		return 0, err
+	}
+	debtValue, err := loanAssetValueInCacao(ctx, mgr, loan.DebtAsset, loan.DebtOutstanding())
+	if err != nil {
+		return 0, err
+	}
+	return loanCollateralizationRatio(collateralValue, debtValue), nil
+}
+
+func liquidateLoan(ctx cosmos.Context, mgr Manager, loan Loan, cr int64) error {
+	outstanding := loan.DebtOutstanding()
+	debtCacao, err := loanAssetValueInCacao(ctx, mgr, loan.DebtAsset, outstanding)
+	if err != nil {
+		return err
+	}
+
+	// hand the collateral back to the owner's trade account, and swap it out
+	// of there like any other trade asset
+	collateral, err := releaseLoanCollateral(ctx, mgr, loan.Owner, loan.CollateralAsset, loan.CollateralUnits)
+	if err != nil {
+		return err
+	}
+	if collateral.IsZero() {
+		return fmt.Errorf("collateral is worth nothing")
+	}
+
+	swapper, err := GetSwapper(mgr.GetVersion())
+	if err != nil {
+		return err
+	}
+	synthVirtualDepthMult, err := mgr.Keeper().GetMimir(ctx, constants.VirtualMultSynthsBasisPoints.String())
+	if synthVirtualDepthMult < 1 || err != nil {
+		synthVirtualDepthMult = mgr.GetConstants().GetInt64Value(constants.VirtualMultSynthsBasisPoints)
+	}
+	owner := common.Address(loan.Owner.String())
+	coins := common.NewCoins(common.NewCoin(loan.CollateralAsset, collateral))
+	tx := common.NewTx(common.BlankTxID, owner, common.NoopAddress, coins, nil, "MAYA-LOAN-LIQUIDATION")
+	tx.Chain = common.BASEChain
+	cacao, _, err := swapper.Swap(
+		ctx,
+		mgr.Keeper(),
+		tx,
+		common.BaseNative,
+		common.NoopAddress,
+		cosmos.ZeroUint(),
+		"",
+		"",
+		nil,
+		StreamingSwap{},
+		cosmos.ZeroUint(),
+		synthVirtualDepthMult,
+		mgr)
+	if err != nil {
+		return err
+	}
+	if err := mgr.Keeper().SendFromModuleToModule(ctx, AsgardName, ReserveName, common.NewCoins(common.NewCoin(common.BaseNative, cacao))); err != nil {
+		return ErrInternal(err, "fail to move liquidated collateral from asgard to reserve")
+	}
+
+	repaid := outstanding
+	if cacao.LT(debtCacao) {
+		repaid = common.GetSafeShare(cacao, debtCacao, outstanding)
+	} else if excess := cacao.Sub(debtCacao); !excess.IsZero() {
+		toi := TxOutItem{
+			Chain:      common.BASEChain,
+			InHash:     common.BlankTxID,
+			ToAddress:  owner,
+			Coin:       common.NewCoin(common.BaseNative, excess),
+			ModuleName: ReserveName,
+		}
+		ok, err := mgr.TxOutStore().TryAddTxOutItem(ctx, mgr, toi, cosmos.ZeroUint())
+		if err != nil {
+			return ErrInternal(err, "fail to add outbound tx")
+		}
+		if !ok {
+			return errFailAddOutboundTx
+		}
+	}
+
+	loan.DebtRepaid = loan.DebtRepaid.Add(repaid)
+	loan.CollateralUnits = cosmos.ZeroUint()
+	loan.LastRepayHeight = ctx.BlockHeight()
+	mgr.Keeper().RemoveLoan(ctx, loan)
+
+	evt := NewEventLoanLiquidation(loan, collateral, repaid, cacao, cr)
+	if err := mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+		ctx.Logger().Error("fail to emit loan liquidation event", "error", err)
+	}
+	if err := mgr.EventMgr().EmitEvent(ctx, NewEventLoanClose(loan, common.BlankTxID)); err != nil {
+		ctx.Logger().Error("fail to emit loan close event", "error", err)
+	}
+	return nil
+}
@@ -0,0 +1,286 @@
+package mayachain
+
+import (
+	"fmt"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/constants"
+)
+
+// actions a POL strategy can take in a pool
+const (
+	POLActionAdd    = "add"
+	POLActionRemove = "remove"
+	POLActionNone   = "none"
+)
+
+// polStrategy is the set of targets the POL follows in a single pool. Every
+// target is configured network wide with its constant / mimir, and can be
+// overridden per pool with a <target>-<pool> mimir (ie POLBuffer-BTC-BTC).
+type polStrategy struct {
+	Mode              int64       // POL-<pool> mimir: 1 is on, 2 is forced withdraw
+	TargetUtilization cosmos.Uint // target synth utilization (basis points)
+	Buffer            cosmos.Uint // band around the target in which no movement happens (basis points)
+	MaxMovement       cosmos.Uint // max cacao to move per cycle (basis points of the pool cacao depth)
+	MaxPoolDeposit    cosmos.Uint // max value in cacao of the POL liquidity in the pool, zero is unlimited
+	Cooldown          int64       // min number of blocks between movements in the pool
+}
+
+// polDecision is the outcome of evaluating the POL strategy of a pool
+type polDecision struct {
+	Asset              common.Asset
+	Action             string
+	CacaoAmount        cosmos.Uint
+	Utilization        cosmos.Uint
+	TargetUtilization  cosmos.Uint
+	Buffer             cosmos.Uint
+	MaxPoolDeposit     cosmos.Uint
+	CacaoValue         cosmos.Uint
+	Cooldown           int64
+	LastMovementHeight int64
+	Reason             string
+}
+
+// getPOLPoolMimir returns the per pool override of the given POL target, or -1
+// when it isn't set
+func getPOLPoolMimir(ctx cosmos.Context, mgr Manager, name string, asset common.Asset) int64 {
+	val, err := mgr.Keeper().GetMimir(ctx, fmt.Sprintf("%s-%s", name, asset.MimirString()))
+	if err != nil {
+		ctx.Logger().Error("fail to get POL mimir", "name", name, "pool", asset.String(), "error", err)
+		return -1
+	}
+	return val
+}
+
+// getPOLStrategy returns the POL targets of the given pool, falling back on the
+// network wide values for the targets that aren't overridden
+func getPOLStrategy(ctx cosmos.Context, mgr Manager, asset common.Asset) polStrategy {
+	k := mgr.Keeper()
+	strategy := polStrategy{
+		Mode:              getPOLPoolMimir(ctx, mgr, "POL", asset),
+		TargetUtilization: cosmos.NewUint(uint64(k.GetConfigInt64(ctx, constants.POLSynthUtilization))),
+		Buffer:            cosmos.NewUint(uint64(k.GetConfigInt64(ctx, constants.POLBuffer))),
+		MaxMovement:       cosmos.NewUint(uint64(k.GetConfigInt64(ctx, constants.POLMaxPoolMovement))),
+		MaxPoolDeposit:    cosmos.NewUint(uint64(k.GetConfigInt64(ctx, constants.POLMaxPoolDeposit))),
+		Cooldown:          k.GetConfigInt64(ctx, constants.POLCooldown),
+	}
+	if val := getPOLPoolMimir(ctx, mgr, constants.POLSynthUtilization.String(), asset); val > 0 {
+		strategy.TargetUtilization = cosmos.NewUint(uint64(val))
+	}
+	if val := getPOLPoolMimir(ctx, mgr, constants.POLBuffer.String(), asset); val >= 0 {
+		strategy.Buffer = cosmos.NewUint(uint64(val))
+	}
+	if val := getPOLPoolMimir(ctx, mgr, constants.POLMaxPoolMovement.String(), asset); val > 0 {
+		strategy.MaxMovement = cosmos.NewUint(uint64(val))
+	}
+	if val := getPOLPoolMimir(ctx, mgr, constants.POLMaxPoolDeposit.String(), asset); val >= 0 {
+		strategy.MaxPoolDeposit = cosmos.NewUint(uint64(val))
+	}
+	if val := getPOLPoolMimir(ctx, mgr, constants.POLCooldown.String(), asset); val >= 0 {
+		strategy.Cooldown = val
+	}
+	return strategy
+}
+
+// getPOLMovement returns the amount of cacao to move in or out of the pool to
+// bring the synth utilization back to the target, capped to the max movement
+func getPOLMovement(pool Pool, maxMovement, utilization, targetUtil cosmos.Uint) cosmos.Uint {
+	var move cosmos.Uint
+	if utilization.GT(targetUtil) {
+		move = utilization.Sub(targetUtil)
+	} else {
+		move = targetUtil.Sub(utilization)
+	}
+	if move.GT(maxMovement) {
+		move = maxMovement
+	}
+	return common.GetSafeShare(move, cosmos.NewUint(10_000), pool.BalanceCacao)
+}
+
+// getPOLPools generates a filtered list of pools that the POL is active with
+func getPOLPools(ctx cosmos.Context, mgr Manager) Pools {
+	var pools Pools
+	iterator := mgr.Keeper().GetPoolIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var pool Pool
+		err := mgr.Keeper().Cdc().Unmarshal(iterator.Value(), &pool)
+		if err != nil {
+			ctx.Logger().Error("fail to unmarshal pool", "pool", pool.Asset.String(), "error", err)
+			continue
+		}
+
+		if pool.Asset.IsSyntheticAsset() {
+			continue
+		}
+
+		if pool.BalanceCacao.IsZero() {
+			continue
+		}
+
+		if pool.Status == PoolSuspended {
+			continue
+		}
+
+		if isChainTradingHalted(ctx, mgr, pool.Asset.GetChain()) || isGlobalTradingHalted(ctx, mgr) {
+			continue
+		}
+
+		// The POL key for the ETH.ETH pool would be POL-ETH-ETH .
+		key := "POL-" + pool.Asset.MimirString()
+		val, err := mgr.Keeper().GetMimir(ctx, key)
+		if err != nil {
+			ctx.Logger().Error("fail to manage POL in pool", "pool", pool.Asset.String(), "error", err)
+			continue
+		}
+
+		// -1 is unset default behaviour; 0 is off (paused); 1 is on; 2 (elsewhere) is forced withdraw.
+		switch val {
+		case -1:
+			continue // unset default behaviour:  pause POL movements
+		case 0:
+			continue // off behaviour:  pause POL movements
+		case 1:
+			// on behaviour:  POL is enabled
+		}
+
+		pools = append(pools, pool)
+	}
+
+	return pools
+}
+
+// evaluatePOLStrategy decides, without moving any funds, whether the POL should
+// add or remove liquidity in the given pool, how much cacao and why
+func evaluatePOLStrategy(ctx cosmos.Context, mgr Manager, pool Pool, pol ProtocolOwnedLiquidity, polAddress common.Address) (polDecision, error) {
+	strategy := getPOLStrategy(ctx, mgr, pool.Asset)
+	decision := polDecision{
+		Asset:             pool.Asset,
+		Action:            POLActionNone,
+		CacaoAmount:       cosmos.ZeroUint(),
+		Utilization:       cosmos.ZeroUint(),
+		TargetUtilization: strategy.TargetUtilization,
+		Buffer:            strategy.Buffer,
+		MaxPoolDeposit:    strategy.MaxPoolDeposit,
+		CacaoValue:        cosmos.ZeroUint(),
+		Cooldown:          strategy.Cooldown,
+	}
+
+	lp, err := mgr.Keeper().GetLiquidityProvider(ctx, pool.Asset, polAddress)
+	if err != nil {
+		return decision, fmt.Errorf("fail to get POL liquidity provider: %w", err)
+	}
+	decision.LastMovementHeight = lp.LastAddHeight
+	if lp.LastWithdrawHeight > decision.LastMovementHeight {
+		decision.LastMovementHeight = lp.LastWithdrawHeight
+	}
+
+	synthSupply := mgr.Keeper().GetTotalSupply(ctx, pool.Asset.GetSyntheticAsset())
+	pool.CalcUnits(mgr.GetVersion(), synthSupply)
+	decision.Utilization = common.GetUncappedShare(pool.SynthUnits, pool.GetPoolUnits(), cosmos.NewUint(10_000))
+	decision.CacaoValue = getPOLValue(pool, lp.Units)
+
+	// a zero network wide target synth utilization disables POL in every pool
+	if mgr.Keeper().GetConfigInt64(ctx, constants.POLSynthUtilization) == 0 {
+		decision.Reason = "POL is disabled"
+		return decision, nil
+	}
+
+	// if pool isn't available or mimir has it configured, force withdraw from the pool
+	forced := strategy.Mode == 2 || pool.Status != PoolAvailable
+	if forced {
+		decision.TargetUtilization = cosmos.NewUint(10_000)
+	}
+
+	coolingDown := !forced && strategy.Cooldown > 0 && decision.LastMovementHeight > 0 &&
+		ctx.BlockHeight() < decision.LastMovementHeight+strategy.Cooldown
+
+	// detect if we need to deposit cacao
+	if common.SafeSub(decision.Utilization, decision.Buffer).GT(decision.TargetUtilization) {
+		maxDeposit := mgr.Keeper().GetConfigInt64(ctx, constants.POLMaxNetworkDeposit)
+		if maxDeposit <= pol.CurrentDeposit().Int64() {
+			decision.Reason = "maximum cacao deployed from POL"
+			return decision, nil
+		}
+		if !strategy.MaxPoolDeposit.IsZero() && decision.CacaoValue.GTE(strategy.MaxPoolDeposit) {
+			decision.Reason = "maximum cacao deployed in pool"
+			return decision, nil
+		}
+		if coolingDown {
+			decision.Reason = fmt.Sprintf("cooldown until block %d", decision.LastMovementHeight+strategy.Cooldown)
+			return decision, nil
+		}
+		amt := getPOLMovement(pool, strategy.MaxMovement, decision.Utilization, decision.TargetUtilization)
+		if !strategy.MaxPoolDeposit.IsZero() {
+			amt = cosmos.MinUint(common.SafeSub(strategy.MaxPoolDeposit, decision.CacaoValue), amt)
+		}
+		if amt.IsZero() {
+			decision.Reason = "movement is too small"
+			return decision, nil
+		}
+		if amt.GT(mgr.Keeper().GetRuneBalanceOfModule(ctx, ReserveName)) {
+			decision.Reason = "insufficient reserve balance"
+			return decision, nil
+		}
+		decision.Action = POLActionAdd
+		decision.CacaoAmount = amt
+		decision.Reason = "synth utilization above target band"
+		return decision, nil
+	}
+
+	// detect if we need to withdraw cacao
+	if decision.Utilization.Add(decision.Buffer).LT(decision.TargetUtilization) {
+		if lp.Units.IsZero() {
+			decision.Reason = "no POL liquidity in pool"
+			return decision, nil
+		}
+		if coolingDown {
+			decision.Reason = fmt.Sprintf("cooldown until block %d", decision.LastMovementHeight+strategy.Cooldown)
+			return decision, nil
+		}
+		amt := getPOLMovement(pool, strategy.MaxMovement, decision.Utilization, decision.TargetUtilization)
+		if amt.IsZero() {
+			decision.Reason = "movement is too small"
+			return decision, nil
+		}
+		decision.Action = POLActionRemove
+		decision.CacaoAmount = amt
+		decision.Reason = "synth utilization below target band"
+		if forced {
+			decision.Reason = "forced withdraw"
+		}
+		return decision, nil
+	}
+
+	decision.Reason = "synth utilization within target band"
+	return decision, nil
+}
+
+// getPOLValue returns the value in cacao of the given units of the pool, the
+// pool units must already include the synth units
+func getPOLValue(pool Pool, units cosmos.Uint) cosmos.Uint {
+	return common.GetSafeShare(units, pool.GetPoolUnits(), pool.BalanceCacao).MulUint64(2)
+}
+
+// getPOLPoolLedger returns the POL ledger of the given pool. A POL position
+// opened before the ledger existed has its value at entry taken from the
+// deposit value of the liquidity provider
+func getPOLPoolLedger(ctx cosmos.Context, mgr Manager, asset common.Asset, polAddress common.Address) (POLPool, error) {
+	if mgr.Keeper().POLPoolExists(ctx, asset) {
+		return mgr.Keeper().GetPOLPool(ctx, asset)
+	}
+	ledger := NewPOLPool(asset)
+	lp, err := mgr.Keeper().GetLiquidityProvider(ctx, asset, polAddress)
+	if err != nil {
+		return ledger, fmt.Errorf("fail to get POL liquidity provider: %w", err)
+	}
+	ledger.Units = lp.Units
+	ledger.EntryValue = lp.CacaoDepositValue.MulUint64(2)
+	ledger.CacaoDeposited = ledger.EntryValue
+	ledger.LastMovementHeight = lp.LastAddHeight
+	if lp.LastWithdrawHeight > ledger.LastMovementHeight {
+		ledger.LastMovementHeight = lp.LastWithdrawHeight
+	}
+	return ledger, nil
+}
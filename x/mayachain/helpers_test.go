@@ -1509,6 +1509,117 @@ func (s *HelperSuite) TestIsLiquidityAuction(c *C) {
 	c.Assert(isLiquidityAuction(ctx, mgr.Keeper()), Equals, false)
 }
 
+func (s *HelperSuite) TestVaultMigration(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	vault := GetRandomVault()
+	vault.Status = RetiringVault
+	vault.StatusSince = 100
+	c.Assert(mgr.Keeper().SetVault(ctx, vault), IsNil)
+
+	recordVaultMigration(ctx, mgr, vault.PubKey, common.NewCoins(
+		common.NewCoin(common.BNBAsset, cosmos.NewUint(common.One)),
+		common.NewCoin(common.BTCAsset, cosmos.NewUint(2*common.One)),
+	))
+	recordVaultMigration(ctx, mgr, vault.PubKey, common.NewCoins(
+		common.NewCoin(common.BNBAsset, cosmos.NewUint(3*common.One)),
+	))
+	migration, err := mgr.Keeper().GetVaultMigration(ctx, vault.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(migration.Migrated.GetCoin(common.BNBAsset).Amount.Uint64(), Equals, uint64(4*common.One))
+	c.Check(migration.Migrated.GetCoin(common.BTCAsset).Amount.Uint64(), Equals, uint64(2*common.One))
+	c.Check(migration.CompletedHeight, Equals, int64(0))
+
+	ctx = ctx.WithBlockHeight(500)
+	completeVaultMigration(ctx, mgr, vault)
+	migration, err = mgr.Keeper().GetVaultMigration(ctx, vault.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(migration.CompletedHeight, Equals, int64(500))
+	found := false
+	for _, evt := range ctx.EventManager().Events() {
+		if evt.Type != types.VaultMigratedEventType {
+			continue
+		}
+		found = true
+		for _, attr := range evt.Attributes {
+			if string(attr.Key) == "retiring_height" {
+				c.Check(string(attr.Value), Equals, "100")
+			}
+		}
+	}
+	c.Check(found, Equals, true)
+}
+
+func (s *HelperSuite) TestTxOutLane(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	k := mgr.Keeper()
+	version := mgr.GetVersion()
+
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceCacao = cosmos.NewUint(100 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+
+	setVoter := func(memo string) common.TxID {
+		tx := GetRandomTx()
+		tx.Memo = memo
+		voter := NewObservedTxVoter(tx.ID, ObservedTxs{NewObservedTx(tx, 1, GetRandomPubKey(), 1)})
+		voter.Tx = voter.Txs[0]
+		k.SetObservedTxInVoter(ctx, voter)
+		return tx.ID
+	}
+	swapID := setVoter("=:BTC.BTC:" + GetRandomBTCAddress().String())
+	affID := setVoter(PreferredAssetSwapMemoPrefix + "-test")
+
+	newItem := func(memo string, inHash common.TxID) TxOutItem {
+		return TxOutItem{
+			Chain:     common.BNBChain,
+			ToAddress: GetRandomBNBAddress(),
+			InHash:    inHash,
+			Coin:      common.NewCoin(common.BNBAsset, cosmos.NewUint(common.One)),
+			Memo:      memo,
+		}
+	}
+	migrate := newItem(NewMigrateMemo(1).String(), common.BlankTxID)
+	ygg := newItem(NewYggdrasilFund(1).String(), common.BlankTxID)
+	refund := newItem(NewRefundMemo(swapID).String(), swapID)
+	swap := newItem(NewOutboundMemo(swapID).String(), swapID)
+	aff := newItem(NewOutboundMemo(affID).String(), affID)
+	c.Check(getTxOutLane(ctx, k, version, migrate), Equals, TxOutLaneMigrate)
+	c.Check(getTxOutLane(ctx, k, version, ygg), Equals, TxOutLaneYggdrasil)
+	c.Check(getTxOutLane(ctx, k, version, refund), Equals, TxOutLaneRefund)
+	c.Check(getTxOutLane(ctx, k, version, swap), Equals, TxOutLaneSwap)
+	c.Check(getTxOutLane(ctx, k, version, aff), Equals, TxOutLaneAffiliate)
+	c.Check(isInternalTxOutLane(TxOutLaneMigrate), Equals, true)
+	c.Check(isInternalTxOutLane(TxOutLaneAffiliate), Equals, false)
+
+	// no limit by default
+	height := ctx.BlockHeight()
+	c.Assert(k.AppendTxOut(ctx, height, migrate), IsNil)
+	c.Assert(k.AppendTxOut(ctx, height, aff), IsNil)
+	c.Check(getTxOutLaneLimit(ctx, k, TxOutLaneMigrate, common.BNBChain), Equals, int64(0))
+	c.Check(isTxOutLaneFull(ctx, k, version, height, common.BNBChain, TxOutLaneMigrate), Equals, false)
+
+	value, count, err := getTxOutLaneUsage(ctx, k, version, height, common.BNBChain, TxOutLaneMigrate)
+	c.Assert(err, IsNil)
+	c.Check(count, Equals, int64(1))
+	c.Check(value.IsZero(), Equals, false)
+	_, count, err = getTxOutLaneUsage(ctx, k, version, height, common.BTCChain, TxOutLaneMigrate)
+	c.Assert(err, IsNil)
+	c.Check(count, Equals, int64(0))
+
+	// the network wide limit applies to all chains, unless overridden per chain
+	k.SetMimir(ctx, "TxOutLaneLimit-MIGRATE", 1)
+	c.Check(isTxOutLaneFull(ctx, k, version, height, common.BNBChain, TxOutLaneMigrate), Equals, true)
+	c.Check(isTxOutLaneFull(ctx, k, version, height, common.BTCChain, TxOutLaneMigrate), Equals, false)
+	c.Check(isTxOutLaneFull(ctx, k, version, height, common.BNBChain, TxOutLaneAffiliate), Equals, false)
+	k.SetMimir(ctx, "TxOutLaneLimit-MIGRATE-BNB", 2)
+	c.Check(getTxOutLaneLimit(ctx, k, TxOutLaneMigrate, common.BNBChain), Equals, int64(2))
+	c.Check(isTxOutLaneFull(ctx, k, version, height, common.BNBChain, TxOutLaneMigrate), Equals, false)
+	k.SetMimir(ctx, "TxOutLaneLimit-MIGRATE-BNB", 0)
+	c.Check(getTxOutLaneLimit(ctx, k, TxOutLaneMigrate, common.BNBChain), Equals, int64(0))
+}
+
 func (s *HelperSuite) TestAffiliateShareCalculating(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 
@@ -0,0 +1,123 @@
+package mayachain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/blang/semver"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/x/mayachain/keeper"
+)
+
+// outbound lanes. On top of the delay relative to the value scheduled on all
+// chains, every lane can be rate limited per chain, so the outbounds of one lane
+// of a chain don't take the room of another.
+const (
+	TxOutLaneSwap      = "swap"      // user outbounds (swaps, withdrawals, ...)
+	TxOutLaneRefund    = "refund"    // refunds of failed inbounds
+	TxOutLaneAffiliate = "affiliate" // affiliate payouts in their preferred asset
+	TxOutLaneMigrate   = "migrate"   // funds moved between asgard vaults
+	TxOutLaneYggdrasil = "yggdrasil" // yggdrasil funding and returns
+)
+
+// getTxOutLane returns the lane the given outbound is scheduled in. The lane is
+// stored on the item when it's added to the block out, only the items
+// scheduled before are classified again.
+func getTxOutLane(ctx cosmos.Context, k keeper.Keeper, version semver.Version, toi TxOutItem) string {
+	if toi.Lane != "" {
+		return toi.Lane
+	}
+	memo, _ := ParseMemo(version, toi.Memo) // ignore err
+	switch {
+	case memo.IsType(TxMigrate):
+		return TxOutLaneMigrate
+	case memo.IsType(TxYggdrasilFund), memo.IsType(TxYggdrasilReturn):
+		return TxOutLaneYggdrasil
+	case memo.IsType(TxRefund):
+		return TxOutLaneRefund
+	}
+	if toi.InHash.IsEmpty() || toi.InHash.Equals(common.BlankTxID) {
+		return TxOutLaneSwap
+	}
+	voter, err := k.GetObservedTxInVoter(ctx, toi.InHash)
+	if err != nil {
+		ctx.Logger().Error("fail to get observed tx in voter", "hash", toi.InHash, "error", err)
+		return TxOutLaneSwap
+	}
+	if strings.HasPrefix(voter.Tx.Tx.Memo, PreferredAssetSwapMemoPrefix) {
+		return TxOutLaneAffiliate
+	}
+	return TxOutLaneSwap
+}
+
+// isInternalTxOutLane returns true for the lanes moving funds between the
+// network's own vaults, these outbounds are never delayed
+func isInternalTxOutLane(lane string) bool {
+	return lane == TxOutLaneMigrate || lane == TxOutLaneYggdrasil
+}
+
+// getTxOutLaneLimit returns the max number of outbounds of the lane the given
+// chain sends per block, zero is unlimited. The limit is set with the
+// TxOutLaneLimit-<lane>-<chain> mimir (ie TxOutLaneLimit-REFUND-BTC), falling
+// back on the TxOutLaneLimit-<lane> mimir for all chains.
+func getTxOutLaneLimit(ctx cosmos.Context, k keeper.Keeper, lane string, chain common.Chain) int64 {
+	key := fmt.Sprintf("TxOutLaneLimit-%s", strings.ToUpper(lane))
+	limit, err := k.GetMimir(ctx, fmt.Sprintf("%s-%s", key, chain))
+	if err != nil {
+		ctx.Logger().Error("fail to get outbound lane limit", "lane", lane, "chain", chain, "error", err)
+		return 0
+	}
+	if limit < 0 {
+		limit, err = k.GetMimir(ctx, key)
+		if err != nil || limit < 0 {
+			return 0
+		}
+	}
+	return limit
+}
+
+// getTxOutLaneUsage returns the value in cacao and the number of the outbounds
+// of the lane scheduled on the given chain at the given height
+func getTxOutLaneUsage(ctx cosmos.Context, k keeper.Keeper, version semver.Version, height int64, chain common.Chain, lane string) (cosmos.Uint, int64, error) {
+	txOut, err := k.GetTxOut(ctx, height)
+	if err != nil {
+		return cosmos.ZeroUint(), 0, err
+	}
+
+	value := cosmos.ZeroUint()
+	count := int64(0)
+	for _, item := range txOut.TxArray {
+		if !item.Chain.Equals(chain) || getTxOutLane(ctx, k, version, item) != lane {
+			continue
+		}
+		count++
+		if item.Coin.Asset.IsBase() {
+			value = value.Add(item.Coin.Amount)
+			continue
+		}
+		pool, err := k.GetPool(ctx, item.Coin.Asset)
+		if err != nil {
+			ctx.Logger().Error("fail to get pool", "asset", item.Coin.Asset, "error", err)
+			continue
+		}
+		value = value.Add(pool.AssetValueInRune(item.Coin.Amount))
+	}
+	return value, count, nil
+}
+
+// isTxOutLaneFull returns true when the chain already sends as many outbounds
+// of the lane at the given height as its limit allows
+func isTxOutLaneFull(ctx cosmos.Context, k keeper.Keeper, version semver.Version, height int64, chain common.Chain, lane string) bool {
+	limit := getTxOutLaneLimit(ctx, k, lane, chain)
+	if limit == 0 {
+		return false
+	}
+	_, count, err := getTxOutLaneUsage(ctx, k, version, height, chain, lane)
+	if err != nil {
+		ctx.Logger().Error("fail to get outbound lane usage", "height", height, "error", err)
+		return false
+	}
+	return count >= limit
+}
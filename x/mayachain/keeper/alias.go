@@ -34,12 +34,14 @@ var (
 )
 
 type (
-	MsgSwap = types.MsgSwap
+	MsgSwap              = types.MsgSwap
+	MsgCacaoPoolWithdraw = types.MsgCacaoPoolWithdraw
 
 	PoolStatus               = types.PoolStatus
 	Pool                     = types.Pool
 	Pools                    = types.Pools
 	StreamingSwap            = types.StreamingSwap
+	LimitOrderFill           = types.LimitOrderFill
 	LiquidityProvider        = types.LiquidityProvider
 	LiquidityProviders       = types.LiquidityProviders
 	ObservedTxVoter          = types.ObservedTxVoter
@@ -56,6 +58,7 @@ type (
 	ReserveContributors      = types.ReserveContributors
 	Vault                    = types.Vault
 	Vaults                   = types.Vaults
+	VaultMigration           = types.VaultMigration
 	Jail                     = types.Jail
 	BondProvider             = types.BondProvider
 	BondProviders            = types.BondProviders
@@ -65,17 +68,24 @@ type (
 	NodeStatus               = types.NodeStatus
 	Network                  = types.Network
 	ProtocolOwnedLiquidity   = types.ProtocolOwnedLiquidity
+	POLPool                  = types.POLPool
+	POLPoolSnapshot          = types.POLPoolSnapshot
 	VaultStatus              = types.VaultStatus
 	NetworkFee               = types.NetworkFee
 	ObservedNetworkFeeVoter  = types.ObservedNetworkFeeVoter
 	RagnarokWithdrawPosition = types.RagnarokWithdrawPosition
 	ChainContract            = types.ChainContract
 	SolvencyVoter            = types.SolvencyVoter
+	SolvencyRecord           = types.SolvencyRecord
 	MAYAName                 = types.MAYAName
+	MAYANameListing          = types.MAYANameListing
 	AffiliateFeeCollector    = types.AffiliateFeeCollector
+	AffiliatePayout          = types.AffiliatePayout
+	AffiliatePayouts         = types.AffiliatePayouts
 	LiquidityAuctionTier     = types.LiquidityAuctionTier
 	CACAOProvider            = types.CACAOProvider
 	CACAOPool                = types.CACAOPool
 	TradeAccount             = types.TradeAccount
+	Loan                     = types.Loan
 	TradeUnit                = types.TradeUnit
 )
@@ -80,6 +80,7 @@ type Keeper interface {
 	KeeperStreamingSwap
 	KeeperCACAOPool
 	KeeperTradeAccount
+	KeeperLoan
 }
 
 type KeeperConfig interface {
@@ -151,6 +152,7 @@ type KeeperNodeAccount interface {
 	IncNodeAccountSlashPoints(_ cosmos.Context, _ cosmos.AccAddress, _ int64) error
 	DecNodeAccountSlashPoints(_ cosmos.Context, _ cosmos.AccAddress, _ int64) error
 	ResetNodeAccountSlashPoints(_ cosmos.Context, _ cosmos.AccAddress)
+	GetNodeSlashEntryIterator(_ cosmos.Context, _ cosmos.AccAddress) cosmos.Iterator
 	GetNodeAccountJail(ctx cosmos.Context, addr cosmos.AccAddress) (Jail, error)
 	SetNodeAccountJail(ctx cosmos.Context, addr cosmos.AccAddress, height int64, reason string) error
 	ReleaseNodeAccountFromJail(ctx cosmos.Context, addr cosmos.AccAddress) error
@@ -201,6 +203,10 @@ type KeeperCACAOPool interface {
 	GetCACAOProvider(ctx cosmos.Context, addr cosmos.AccAddress) (CACAOProvider, error)
 	SetCACAOProvider(ctx cosmos.Context, rp CACAOProvider)
 	RemoveCACAOProvider(ctx cosmos.Context, rp CACAOProvider)
+	GetCACAOPoolWithdrawRequestIterator(ctx cosmos.Context) cosmos.Iterator
+	GetCACAOPoolWithdrawRequest(ctx cosmos.Context, addr cosmos.AccAddress) (MsgCacaoPoolWithdraw, error)
+	SetCACAOPoolWithdrawRequest(ctx cosmos.Context, msg MsgCacaoPoolWithdraw)
+	RemoveCACAOPoolWithdrawRequest(ctx cosmos.Context, addr cosmos.AccAddress)
 }
 
 type KeeperVault interface {
@@ -217,6 +223,8 @@ type KeeperVault interface {
 	GetPendingOutbounds(_ cosmos.Context, _ common.Asset) []TxOutItem
 	DeleteVault(ctx cosmos.Context, pk common.PubKey) error
 	RemoveFromAsgardIndex(ctx cosmos.Context, pubkey common.PubKey) error
+	GetVaultMigration(ctx cosmos.Context, pk common.PubKey) (VaultMigration, error)
+	SetVaultMigration(ctx cosmos.Context, record VaultMigration)
 }
 
 type KeeperReserveContributors interface {
@@ -230,6 +238,13 @@ type KeeperNetwork interface {
 	SetNetwork(ctx cosmos.Context, data Network) error
 	GetPOL(ctx cosmos.Context) (ProtocolOwnedLiquidity, error)
 	SetPOL(ctx cosmos.Context, data ProtocolOwnedLiquidity) error
+	GetPOLPoolIterator(ctx cosmos.Context) cosmos.Iterator
+	GetPOLPool(ctx cosmos.Context, asset common.Asset) (POLPool, error)
+	POLPoolExists(ctx cosmos.Context, asset common.Asset) bool
+	SetPOLPool(ctx cosmos.Context, record POLPool)
+	GetPOLPoolSnapshotIterator(ctx cosmos.Context, asset common.Asset) cosmos.Iterator
+	SetPOLPoolSnapshot(ctx cosmos.Context, snapshot POLPoolSnapshot)
+	RemovePOLPoolSnapshot(ctx cosmos.Context, asset common.Asset, height int64)
 }
 
 type KeeperTss interface {
@@ -307,6 +322,11 @@ type KeeperOrderBooks interface {
 	RemoveOrderBookIndex(_ cosmos.Context, _ MsgSwap) error
 	SetOrderBookProcessor(_ cosmos.Context, _ []bool) error
 	GetOrderBookProcessor(_ cosmos.Context) ([]bool, error)
+	GetOrderBookExpiredItems(_ cosmos.Context, height int64) (common.TxIDs, error)
+	SetLimitOrderFill(_ cosmos.Context, _ LimitOrderFill)
+	GetLimitOrderFill(_ cosmos.Context, _ common.TxID) (LimitOrderFill, error)
+	LimitOrderFillExists(_ cosmos.Context, _ common.TxID) bool
+	RemoveLimitOrderFill(_ cosmos.Context, _ common.TxID)
 }
 
 type KeeperMimir interface {
@@ -345,6 +365,8 @@ type KeeperChainContract interface {
 type KeeperSolvencyVoter interface {
 	SetSolvencyVoter(_ cosmos.Context, _ SolvencyVoter)
 	GetSolvencyVoter(_ cosmos.Context, _ common.TxID, _ common.Chain) (SolvencyVoter, error)
+	SetSolvencyRecord(ctx cosmos.Context, record SolvencyRecord)
+	GetSolvencyRecordIterator(ctx cosmos.Context, pk common.PubKey, chain common.Chain) cosmos.Iterator
 }
 
 // NewKeeper creates new instances of the thorchain Keeper
@@ -354,10 +376,20 @@ type KeeperMAYAName interface {
 	SetMAYAName(ctx cosmos.Context, name MAYAName)
 	GetMAYANameIterator(ctx cosmos.Context) cosmos.Iterator
 	DeleteMAYAName(ctx cosmos.Context, name string) error
+	GetMAYANamesByOwner(ctx cosmos.Context, owner cosmos.AccAddress) ([]string, error)
+	GetMAYANamesByAlias(ctx cosmos.Context, chain common.Chain, addr common.Address) ([]string, error)
+	GetMAYANameListing(ctx cosmos.Context, name string) (MAYANameListing, error)
+	MAYANameListingExists(ctx cosmos.Context, name string) bool
+	SetMAYANameListing(ctx cosmos.Context, listing MAYANameListing)
+	RemoveMAYANameListing(ctx cosmos.Context, listing MAYANameListing)
+	GetMAYANameListingsExpired(ctx cosmos.Context, height int64) ([]string, error)
+	GetMAYANameListingIterator(ctx cosmos.Context) cosmos.Iterator
 	SetAffiliateCollector(ctx_ cosmos.Context, affCol AffiliateFeeCollector)
 	GetAffiliateCollector(ctx cosmos.Context, accAddress cosmos.AccAddress) (AffiliateFeeCollector, error)
 	GetAffiliateCollectorIterator(ctx cosmos.Context) cosmos.Iterator
 	GetAffiliateCollectors(ctx cosmos.Context) ([]AffiliateFeeCollector, error)
+	GetAffiliatePayouts(ctx cosmos.Context, owner cosmos.AccAddress) ([]AffiliatePayout, error)
+	AddAffiliatePayout(ctx cosmos.Context, owner cosmos.AccAddress, payout AffiliatePayout) error
 }
 
 type KeeperTradeAccount interface {
@@ -366,11 +398,21 @@ type KeeperTradeAccount interface {
 	RemoveTradeAccount(ctx cosmos.Context, record TradeAccount)
 	GetTradeAccountIterator(ctx cosmos.Context) cosmos.Iterator
 	GetTradeAccountIteratorWithAddress(ctx cosmos.Context, addr cosmos.AccAddress) cosmos.Iterator
+	TransferTradeAccount(ctx cosmos.Context, from, to cosmos.AccAddress, asset common.Asset, units cosmos.Uint) error
 	GetTradeUnit(ctx cosmos.Context, asset common.Asset) (TradeUnit, error)
 	SetTradeUnit(ctx cosmos.Context, unit TradeUnit)
 	GetTradeUnitIterator(ctx cosmos.Context) cosmos.Iterator
 }
 
+type KeeperLoan interface {
+	GetLoanIterator(ctx cosmos.Context) cosmos.Iterator
+	GetLoanIteratorWithOwner(ctx cosmos.Context, owner cosmos.AccAddress) cosmos.Iterator
+	GetLoan(ctx cosmos.Context, owner cosmos.AccAddress, collateralAsset common.Asset) (Loan, error)
+	LoanExists(ctx cosmos.Context, owner cosmos.AccAddress, collateralAsset common.Asset) bool
+	SetLoan(ctx cosmos.Context, loan Loan)
+	RemoveLoan(ctx cosmos.Context, loan Loan)
+}
+
 // NewKVStore creates new instances of the thorchain Keeper
 func NewKeeper(cdc codec.BinaryCodec, coinKeeper bankkeeper.Keeper, accountKeeper authkeeper.AccountKeeper, ibcTransferkeeper ibctransferkeeper.Keeper, storeKey cosmos.StoreKey) Keeper {
 	version := semver.MustParse("0.0.0")
@@ -133,6 +133,16 @@ func (k KVStoreDummy) SetCACAOProvider(ctx cosmos.Context, rp CACAOProvider)
 func (k KVStoreDummy) GetCACAOProviderIterator(ctx cosmos.Context) cosmos.Iterator { return nil }
 func (k KVStoreDummy) RemoveCACAOProvider(ctx cosmos.Context, rp CACAOProvider)    {}
 
+func (k KVStoreDummy) GetCACAOPoolWithdrawRequestIterator(ctx cosmos.Context) cosmos.Iterator {
+	return nil
+}
+
+func (k KVStoreDummy) GetCACAOPoolWithdrawRequest(ctx cosmos.Context, addr cosmos.AccAddress) (MsgCacaoPoolWithdraw, error) {
+	return MsgCacaoPoolWithdraw{}, kaboom
+}
+func (k KVStoreDummy) SetCACAOPoolWithdrawRequest(ctx cosmos.Context, msg MsgCacaoPoolWithdraw)  {}
+func (k KVStoreDummy) RemoveCACAOPoolWithdrawRequest(ctx cosmos.Context, addr cosmos.AccAddress) {}
+
 func (k KVStoreDummy) GetTradeAccount(ctx cosmos.Context, addr cosmos.AccAddress, asset common.Asset) (TradeAccount, error) {
 	return TradeAccount{}, kaboom
 }
@@ -143,12 +153,31 @@ func (k KVStoreDummy) GetTradeAccountIteratorWithAddress(ctx cosmos.Context, add
 	return nil
 }
 
+func (k KVStoreDummy) TransferTradeAccount(ctx cosmos.Context, from, to cosmos.AccAddress, asset common.Asset, units cosmos.Uint) error {
+	return kaboom
+}
+
 func (k KVStoreDummy) GetTradeUnit(ctx cosmos.Context, asset common.Asset) (TradeUnit, error) {
 	return TradeUnit{}, kaboom
 }
 func (k KVStoreDummy) SetTradeUnit(ctx cosmos.Context, unit TradeUnit)         {}
 func (k KVStoreDummy) GetTradeUnitIterator(ctx cosmos.Context) cosmos.Iterator { return nil }
 
+func (k KVStoreDummy) GetLoanIterator(ctx cosmos.Context) cosmos.Iterator { return nil }
+func (k KVStoreDummy) GetLoanIteratorWithOwner(ctx cosmos.Context, owner cosmos.AccAddress) cosmos.Iterator {
+	return nil
+}
+
+func (k KVStoreDummy) GetLoan(ctx cosmos.Context, owner cosmos.AccAddress, collateralAsset common.Asset) (Loan, error) {
+	return Loan{}, kaboom
+}
+
+func (k KVStoreDummy) LoanExists(ctx cosmos.Context, owner cosmos.AccAddress, collateralAsset common.Asset) bool {
+	return false
+}
+func (k KVStoreDummy) SetLoan(ctx cosmos.Context, loan Loan)    {}
+func (k KVStoreDummy) RemoveLoan(ctx cosmos.Context, loan Loan) {}
+
 func (k KVStoreDummy) GetRagnarokBlockHeight(_ cosmos.Context) (int64, error) {
 	return 0, kaboom
 }
@@ -291,6 +320,10 @@ func (k KVStoreDummy) IncNodeAccountSlashPoints(_ cosmos.Context, _ cosmos.AccAd
 	return kaboom
 }
 
+func (k KVStoreDummy) GetNodeSlashEntryIterator(_ cosmos.Context, _ cosmos.AccAddress) cosmos.Iterator {
+	return nil
+}
+
 func (k KVStoreDummy) GetIBCTransferParams(ctx cosmos.Context) ibctransfertypes.Params {
 	return ibctransfertypes.Params{}
 }
@@ -393,7 +426,12 @@ func (k KVStoreDummy) SetVault(_ cosmos.Context, _ Vault) error { return kaboom
 func (k KVStoreDummy) GetVault(_ cosmos.Context, _ common.PubKey) (Vault, error) {
 	return Vault{}, kaboom
 }
-func (k KVStoreDummy) GetAsgardVaults(_ cosmos.Context) (Vaults, error) { return nil, kaboom }
+
+func (k KVStoreDummy) GetVaultMigration(_ cosmos.Context, _ common.PubKey) (VaultMigration, error) {
+	return VaultMigration{}, kaboom
+}
+func (k KVStoreDummy) SetVaultMigration(_ cosmos.Context, _ VaultMigration) {}
+func (k KVStoreDummy) GetAsgardVaults(_ cosmos.Context) (Vaults, error)     { return nil, kaboom }
 func (k KVStoreDummy) GetAsgardVaultsByStatus(_ cosmos.Context, _ VaultStatus) (Vaults, error) {
 	return nil, kaboom
 }
@@ -424,6 +462,18 @@ func (k KVStoreDummy) SetPOL(_ cosmos.Context, _ ProtocolOwnedLiquidity) error {
 	return kaboom
 }
 
+func (k KVStoreDummy) GetPOLPoolIterator(_ cosmos.Context) cosmos.Iterator { return nil }
+func (k KVStoreDummy) GetPOLPool(_ cosmos.Context, _ common.Asset) (POLPool, error) {
+	return POLPool{}, kaboom
+}
+func (k KVStoreDummy) POLPoolExists(_ cosmos.Context, _ common.Asset) bool { return false }
+func (k KVStoreDummy) SetPOLPool(_ cosmos.Context, _ POLPool)              {}
+func (k KVStoreDummy) GetPOLPoolSnapshotIterator(_ cosmos.Context, _ common.Asset) cosmos.Iterator {
+	return nil
+}
+func (k KVStoreDummy) SetPOLPoolSnapshot(_ cosmos.Context, _ POLPoolSnapshot)          {}
+func (k KVStoreDummy) RemovePOLPoolSnapshot(_ cosmos.Context, _ common.Asset, _ int64) {}
+
 func (k KVStoreDummy) SetTssKeysignFailVoter(_ cosmos.Context, tss TssKeysignFailVoter) {
 }
 
@@ -509,6 +559,16 @@ func (k KVStoreDummy) GetOrderBookProcessor(ctx cosmos.Context) ([]bool, error)
 	return nil, kaboom
 }
 
+func (k KVStoreDummy) GetOrderBookExpiredItems(_ cosmos.Context, _ int64) (common.TxIDs, error) {
+	return nil, kaboom
+}
+func (k KVStoreDummy) SetLimitOrderFill(_ cosmos.Context, _ LimitOrderFill) {}
+func (k KVStoreDummy) GetLimitOrderFill(_ cosmos.Context, _ common.TxID) (LimitOrderFill, error) {
+	return LimitOrderFill{}, kaboom
+}
+func (k KVStoreDummy) LimitOrderFillExists(_ cosmos.Context, _ common.TxID) bool { return false }
+func (k KVStoreDummy) RemoveLimitOrderFill(_ cosmos.Context, _ common.TxID)      {}
+
 func (k KVStoreDummy) GetMimir(_ cosmos.Context, key string) (int64, error) { return -1, kaboom }
 func (k KVStoreDummy) SetMimir(_ cosmos.Context, key string, value int64)   {}
 func (k KVStoreDummy) GetNodeMimirs(ctx cosmos.Context, key string) (NodeMimirs, error) {
@@ -598,6 +658,10 @@ func (k KVStoreDummy) SetSolvencyVoter(_ cosmos.Context, _ SolvencyVoter) {}
 func (k KVStoreDummy) GetSolvencyVoter(_ cosmos.Context, _ common.TxID, _ common.Chain) (SolvencyVoter, error) {
 	return SolvencyVoter{}, kaboom
 }
+func (k KVStoreDummy) SetSolvencyRecord(_ cosmos.Context, _ SolvencyRecord) {}
+func (k KVStoreDummy) GetSolvencyRecordIterator(_ cosmos.Context, _ common.PubKey, _ common.Chain) cosmos.Iterator {
+	return nil
+}
 
 func (k KVStoreDummy) MAYANameExists(ctx cosmos.Context, _ string) bool { return false }
 func (k KVStoreDummy) GetMAYAName(ctx cosmos.Context, _ string) (MAYAName, error) {
@@ -607,6 +671,13 @@ func (k KVStoreDummy) SetMAYAName(ctx cosmos.Context, name MAYAName)          {}
 func (k KVStoreDummy) GetMAYANameIterator(ctx cosmos.Context) cosmos.Iterator { return nil }
 
 func (k KVStoreDummy) DeleteMAYAName(ctx cosmos.Context, _ string) error { return kaboom }
+func (k KVStoreDummy) GetMAYANamesByOwner(_ cosmos.Context, _ cosmos.AccAddress) ([]string, error) {
+	return nil, kaboom
+}
+
+func (k KVStoreDummy) GetMAYANamesByAlias(_ cosmos.Context, _ common.Chain, _ common.Address) ([]string, error) {
+	return nil, kaboom
+}
 
 func (k KVStoreDummy) SetAffiliateCollector(_ cosmos.Context, _ AffiliateFeeCollector) {}
 func (k KVStoreDummy) GetAffiliateCollector(_ cosmos.Context, _ cosmos.AccAddress) (AffiliateFeeCollector, error) {
@@ -621,6 +692,26 @@ func (k KVStoreDummy) GetAffiliateCollectors(_ cosmos.Context) ([]AffiliateFeeCo
 	return nil, kaboom
 }
 
+func (k KVStoreDummy) GetMAYANameListing(_ cosmos.Context, _ string) (MAYANameListing, error) {
+	return MAYANameListing{}, kaboom
+}
+
+func (k KVStoreDummy) MAYANameListingExists(_ cosmos.Context, _ string) bool       { return false }
+func (k KVStoreDummy) SetMAYANameListing(_ cosmos.Context, _ MAYANameListing)      {}
+func (k KVStoreDummy) RemoveMAYANameListing(_ cosmos.Context, _ MAYANameListing)   {}
+func (k KVStoreDummy) GetMAYANameListingIterator(_ cosmos.Context) cosmos.Iterator { return nil }
+func (k KVStoreDummy) GetMAYANameListingsExpired(_ cosmos.Context, _ int64) ([]string, error) {
+	return nil, kaboom
+}
+
+func (k KVStoreDummy) GetAffiliatePayouts(_ cosmos.Context, _ cosmos.AccAddress) ([]AffiliatePayout, error) {
+	return nil, kaboom
+}
+
+func (k KVStoreDummy) AddAffiliatePayout(_ cosmos.Context, _ cosmos.AccAddress, _ AffiliatePayout) error {
+	return kaboom
+}
+
 func (k KVStoreDummy) DistributeMayaFund(ctx cosmos.Context, constAccessor constants.ConstantValues) {
 }
 
@@ -49,8 +49,11 @@ var (
 	NewPool                    = types.NewPool
 	NewJail                    = types.NewJail
 	NewStreamingSwap           = types.NewStreamingSwap
+	NewLimitOrderFill          = types.NewLimitOrderFill
 	NewNetwork                 = types.NewNetwork
 	NewProtocolOwnedLiquidity  = types.NewProtocolOwnedLiquidity
+	NewPOLPool                 = types.NewPOLPool
+	NewVaultMigration          = types.NewVaultMigration
 	NewCACAOPool               = types.NewCACAOPool
 	NewObservedTx              = types.NewObservedTx
 	NewTssVoter                = types.NewTssVoter
@@ -65,11 +68,14 @@ var (
 	RegisterCodec              = types.RegisterCodec
 	NewNodeAccount             = types.NewNodeAccount
 	NewBondProviders           = types.NewBondProviders
+	NewNodeSlashEntry          = types.NewNodeSlashEntry
 	NewBondProvider            = types.NewBondProvider
 	NewVault                   = types.NewVault
 	NewReserveContributor      = types.NewReserveContributor
 	NewMAYAName                = types.NewMAYAName
+	NewMAYANameListing         = types.NewMAYANameListing
 	NewAffiliateFeeCollector   = types.NewAffiliateFeeCollector
+	NewAffiliatePayout         = types.NewAffiliatePayout
 	GetRandomTx                = types.GetRandomTx
 	GetRandomValidatorNode     = types.GetRandomValidatorNode
 	GetRandomVaultNode         = types.GetRandomVaultNode
@@ -90,13 +96,16 @@ var (
 	GetLiquidityPools          = types.GetLiquidityPools
 	NewTradeAccount            = types.NewTradeAccount
 	NewTradeUnit               = types.NewTradeUnit
+	NewLoan                    = types.NewLoan
 )
 
 type (
 	MsgSwap                  = types.MsgSwap
+	MsgCacaoPoolWithdraw     = types.MsgCacaoPoolWithdraw
 	Pool                     = types.Pool
 	Pools                    = types.Pools
 	StreamingSwap            = types.StreamingSwap
+	LimitOrderFill           = types.LimitOrderFill
 	LiquidityProvider        = types.LiquidityProvider
 	LiquidityProviders       = types.LiquidityProviders
 	ObservedTxs              = types.ObservedTxs
@@ -112,11 +121,13 @@ type (
 	ReserveContributors      = types.ReserveContributors
 	Vault                    = types.Vault
 	Vaults                   = types.Vaults
+	VaultMigration           = types.VaultMigration
 	Jail                     = types.Jail
 	BondProvider             = types.BondProvider
 	BondProviders            = types.BondProviders
 	NodeAccount              = types.NodeAccount
 	NodeAccounts             = types.NodeAccounts
+	NodeSlashEntry           = types.NodeSlashEntry
 	NodeStatus               = types.NodeStatus
 	NodeType                 = types.NodeType
 	Network                  = types.Network
@@ -128,17 +139,24 @@ type (
 	TssKeysignMetric         = types.TssKeysignMetric
 	ChainContract            = types.ChainContract
 	MAYAName                 = types.MAYAName
+	MAYANameListing          = types.MAYANameListing
 	MAYANameAlias            = types.MAYANameAlias
 	MAYANameSubaffiliate     = types.MAYANameSubaffiliate
 	AffiliateFeeCollector    = types.AffiliateFeeCollector
+	AffiliatePayout          = types.AffiliatePayout
+	AffiliatePayouts         = types.AffiliatePayouts
 	SolvencyVoter            = types.SolvencyVoter
+	SolvencyRecord           = types.SolvencyRecord
 	NodeMimir                = types.NodeMimir
 	NodeMimirs               = types.NodeMimirs
 	LiquidityAuctionTier     = types.LiquidityAuctionTier
 	ProtocolOwnedLiquidity   = types.ProtocolOwnedLiquidity
+	POLPool                  = types.POLPool
+	POLPoolSnapshot          = types.POLPoolSnapshot
 	CACAOProvider            = types.CACAOProvider
 	CACAOPool                = types.CACAOPool
 	TradeAccount             = types.TradeAccount
+	Loan                     = types.Loan
 	TradeUnit                = types.TradeUnit
 
 	ProtoInt64        = types.ProtoInt64
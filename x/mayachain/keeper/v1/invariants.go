@@ -2,6 +2,7 @@ package keeperv1
 
 import (
 	"fmt"
+	"sort"
 
 	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
@@ -16,6 +17,7 @@ func (k KVStore) InvariantRoutes() []common.InvariantRoute {
 		common.NewInvariantRoute("affiliate_collector", AffiliateCollectorInvariant(k)),
 		common.NewInvariantRoute("pools", PoolsInvariant(k)),
 		common.NewInvariantRoute("streaming_swaps", StreamingSwapsInvariant(k)),
+		common.NewInvariantRoute("trade_accounts", TradeAccountsInvariant(k)),
 	}
 }
 
@@ -369,3 +371,69 @@ func CACAOPoolInvariant(k KVStore) common.Invariant {
 		return msg, broken
 	}
 }
+
+// TradeAccountsInvariant the units of each trade asset should match the sum of
+// units held in all trade accounts of that asset and locked as loan collateral
+func TradeAccountsInvariant(k KVStore) common.Invariant {
+	return func(ctx cosmos.Context) (msg []string, broken bool) {
+		accountUnits := make(map[string]cosmos.Uint)
+		accountIter := k.GetTradeAccountIterator(ctx)
+		defer accountIter.Close()
+		for ; accountIter.Valid(); accountIter.Next() {
+			var ta TradeAccount
+			k.Cdc().MustUnmarshal(accountIter.Value(), &ta)
+			units, ok := accountUnits[ta.Asset.String()]
+			if !ok {
+				units = cosmos.ZeroUint()
+			}
+			accountUnits[ta.Asset.String()] = units.Add(ta.Units)
+		}
+
+		loanIter := k.GetLoanIterator(ctx)
+		defer loanIter.Close()
+		for ; loanIter.Valid(); loanIter.Next() {
+			var loan Loan
+			k.Cdc().MustUnmarshal(loanIter.Value(), &loan)
+			units, ok := accountUnits[loan.CollateralAsset.String()]
+			if !ok {
+				units = cosmos.ZeroUint()
+			}
+			accountUnits[loan.CollateralAsset.String()] = units.Add(loan.CollateralUnits)
+		}
+
+		unitIter := k.GetTradeUnitIterator(ctx)
+		defer unitIter.Close()
+		for ; unitIter.Valid(); unitIter.Next() {
+			var tu TradeUnit
+			k.Cdc().MustUnmarshal(unitIter.Value(), &tu)
+			units, ok := accountUnits[tu.Asset.String()]
+			if !ok {
+				units = cosmos.ZeroUint()
+			}
+			delete(accountUnits, tu.Asset.String())
+
+			if tu.Units.GT(units) {
+				msg = append(msg, fmt.Sprintf("%s oversolvent: %s units", tu.Asset, tu.Units.Sub(units)))
+				broken = true
+			} else if tu.Units.LT(units) {
+				msg = append(msg, fmt.Sprintf("%s insolvent: %s units", tu.Asset, units.Sub(tu.Units)))
+				broken = true
+			}
+		}
+
+		// trade accounts without a trade unit record
+		assets := make([]string, 0, len(accountUnits))
+		for asset := range accountUnits {
+			assets = append(assets, asset)
+		}
+		sort.Strings(assets)
+		for _, asset := range assets {
+			if units := accountUnits[asset]; !units.IsZero() {
+				msg = append(msg, fmt.Sprintf("%s insolvent: %s units", asset, units))
+				broken = true
+			}
+		}
+
+		return msg, broken
+	}
+}
@@ -164,3 +164,63 @@ func (s *InvariantsSuite) TestAffiliateCollectorInvariant(c *C) {
 	c.Assert(broken, Equals, false)
 	c.Assert(msg, IsNil)
 }
+
+func (s *InvariantsSuite) TestTradeAccountsInvariant(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	invariant := TradeAccountsInvariant(k)
+
+	asset := common.BTCAsset.GetTradeAsset()
+	addr1 := GetRandomBech32Addr()
+	addr2 := GetRandomBech32Addr()
+
+	tu := NewTradeUnit(asset)
+	tu.Units = cosmos.NewUint(300)
+	tu.Depth = cosmos.NewUint(300)
+	k.SetTradeUnit(ctx, tu)
+
+	ta := NewTradeAccount(addr1, asset)
+	ta.Units = cosmos.NewUint(100)
+	k.SetTradeAccount(ctx, ta)
+
+	msg, broken := invariant(ctx)
+	c.Assert(broken, Equals, true)
+	c.Assert(len(msg), Equals, 1)
+	c.Assert(msg[0], Equals, "BTC~BTC oversolvent: 200 units")
+
+	ta = NewTradeAccount(addr2, asset)
+	ta.Units = cosmos.NewUint(200)
+	k.SetTradeAccount(ctx, ta)
+
+	msg, broken = invariant(ctx)
+	c.Assert(broken, Equals, false)
+	c.Assert(msg, IsNil)
+
+	// moving units between accounts keeps the invariant
+	c.Assert(k.TransferTradeAccount(ctx, addr2, addr1, asset, cosmos.NewUint(150)), IsNil)
+	msg, broken = invariant(ctx)
+	c.Assert(broken, Equals, false)
+	c.Assert(msg, IsNil)
+
+	// units locked as loan collateral are part of the trade units
+	ta, err := k.GetTradeAccount(ctx, addr1, asset)
+	c.Assert(err, IsNil)
+	ta.Units = common.SafeSub(ta.Units, cosmos.NewUint(50))
+	k.SetTradeAccount(ctx, ta)
+	loan := NewLoan(addr1, asset, common.ETHAsset)
+	loan.CollateralUnits = cosmos.NewUint(50)
+	k.SetLoan(ctx, loan)
+	msg, broken = invariant(ctx)
+	c.Assert(broken, Equals, false)
+	c.Assert(msg, IsNil)
+
+	// trade accounts without a trade unit
+	ta = NewTradeAccount(addr1, common.ETHAsset.GetTradeAsset())
+	ta.Units = cosmos.NewUint(5)
+	k.SetTradeAccount(ctx, ta)
+
+	msg, broken = invariant(ctx)
+	c.Assert(broken, Equals, true)
+	c.Assert(len(msg), Equals, 1)
+	c.Assert(msg[0], Equals, "ETH~ETH insolvent: 5 units")
+}
@@ -43,12 +43,17 @@ const (
 	prefixBondProviders           kvTypes.DbPrefix = "bond_providers/"
 	prefixVault                   kvTypes.DbPrefix = "vault/"
 	prefixVaultAsgardIndex        kvTypes.DbPrefix = "vault_asgard_index/"
+	prefixVaultMigration          kvTypes.DbPrefix = "vault_migration/"
 	prefixNetwork                 kvTypes.DbPrefix = "network/"
 	prefixPOL                     kvTypes.DbPrefix = "pol/"
+	prefixPOLPool                 kvTypes.DbPrefix = "pol_pool/"
+	prefixPOLPoolSnapshot         kvTypes.DbPrefix = "pol_snapshot/"
 	prefixCACAOProvider           kvTypes.DbPrefix = "cacao_provider/"
 	prefixCACAOPool               kvTypes.DbPrefix = "cacao_pool/"
+	prefixCACAOPoolWithdraw       kvTypes.DbPrefix = "cacao_pool_withdraw/"
 	prefixTradeAccount            kvTypes.DbPrefix = "tr_acct/"
 	prefixTradeUnit               kvTypes.DbPrefix = "tr_unit/"
+	prefixLoan                    kvTypes.DbPrefix = "loan/"
 	prefixStreamingSwap           kvTypes.DbPrefix = "stream/"
 	prefixObservingAddresses      kvTypes.DbPrefix = "observing_addresses/"
 	prefixTss                     kvTypes.DbPrefix = "tss/"
@@ -63,12 +68,15 @@ const (
 	prefixForgiveSlashVoter       kvTypes.DbPrefix = "forgive_slash/"
 	prefixBanVoter                kvTypes.DbPrefix = "ban/"
 	prefixNodeSlashPoints         kvTypes.DbPrefix = "slash/"
+	prefixNodeSlashLedger         kvTypes.DbPrefix = "node_slash/"
 	prefixNodeJail                kvTypes.DbPrefix = "jail/"
 	prefixSwapQueueItem           kvTypes.DbPrefix = "swapitem/"
 	prefixOrderBookItem           kvTypes.DbPrefix = "o/"
 	prefixOrderBookLimitIndex     kvTypes.DbPrefix = "olim/"
 	prefixOrderBookMarketIndex    kvTypes.DbPrefix = "omark/"
 	prefixOrderBookProcessor      kvTypes.DbPrefix = "oproc/"
+	prefixOrderBookExpiryIndex    kvTypes.DbPrefix = "oexp/"
+	prefixOrderBookFill           kvTypes.DbPrefix = "ofill/"
 	prefixMimir                   kvTypes.DbPrefix = "mimir/"
 	prefixNodeMimir               kvTypes.DbPrefix = "nodemimir/"
 	prefixNodePauseChain          kvTypes.DbPrefix = "node_pause_chain/"
@@ -79,8 +87,14 @@ const (
 	prefixTssKeysignMetricLatest  kvTypes.DbPrefix = "latest_tss_keysign_metric/"
 	prefixChainContract           kvTypes.DbPrefix = "chain_contract/"
 	prefixSolvencyVoter           kvTypes.DbPrefix = "solvency_voter/"
+	prefixSolvencyHistory         kvTypes.DbPrefix = "solvency_history/"
 	prefixMAYAName                kvTypes.DbPrefix = "mayaname/"
+	prefixMAYANameOwnerIndex      kvTypes.DbPrefix = "mayaname_owner/"
+	prefixMAYANameAliasIndex      kvTypes.DbPrefix = "mayaname_alias/"
+	prefixMAYANameListing         kvTypes.DbPrefix = "mayaname_listing/"
+	prefixMAYANameListingExpiry   kvTypes.DbPrefix = "mayaname_listing_exp/"
 	prefixAffiliateCollector      kvTypes.DbPrefix = "aff_col/"
+	prefixAffiliatePayouts        kvTypes.DbPrefix = "aff_payouts/"
 	prefixRollingPoolLiquidityFee kvTypes.DbPrefix = "rolling_pool_liquidity_fee/"
 	prefixLiquidityAuctionTier    kvTypes.DbPrefix = "la_tier/"
 	prefixVersion                 kvTypes.DbPrefix = "version/"
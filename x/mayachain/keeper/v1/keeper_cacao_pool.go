@@ -1,6 +1,7 @@
 package keeperv1
 
 import (
+	"errors"
 	"fmt"
 
 	"gitlab.com/mayachain/mayanode/common/cosmos"
@@ -68,10 +69,11 @@ func (k KVStore) GetCACAOProviderIterator(ctx cosmos.Context) cosmos.Iterator {
 // GetCACAOProvider retrieve CACAO provider from the data store
 func (k KVStore) GetCACAOProvider(ctx cosmos.Context, addr cosmos.AccAddress) (CACAOProvider, error) {
 	record := CACAOProvider{
-		CacaoAddress:   addr,
-		DepositAmount:  cosmos.ZeroUint(),
-		WithdrawAmount: cosmos.ZeroUint(),
-		Units:          cosmos.ZeroUint(),
+		CacaoAddress:     addr,
+		DepositAmount:    cosmos.ZeroUint(),
+		WithdrawAmount:   cosmos.ZeroUint(),
+		Units:            cosmos.ZeroUint(),
+		CompoundedAmount: cosmos.ZeroUint(),
 	}
 
 	_, err := k.getCACAOProvider(ctx, k.GetKey(ctx, prefixCACAOProvider, record.Key()), &record)
@@ -87,3 +89,57 @@ func (k KVStore) SetCACAOProvider(ctx cosmos.Context, rp CACAOProvider) {
 func (k KVStore) RemoveCACAOProvider(ctx cosmos.Context, rp CACAOProvider) {
 	k.del(ctx, k.GetKey(ctx, prefixCACAOProvider, rp.Key()))
 }
+
+////////////////////////////////////////////////////////////////////////////////////////
+// CACAOPool Withdraw Requests
+////////////////////////////////////////////////////////////////////////////////////////
+
+func (k KVStore) setMsgCacaoPoolWithdraw(ctx cosmos.Context, key string, record MsgCacaoPoolWithdraw) {
+	store := ctx.KVStore(k.storeKey)
+	buf := k.cdc.MustMarshal(&record)
+	if buf == nil {
+		store.Delete([]byte(key))
+	} else {
+		store.Set([]byte(key), buf)
+	}
+}
+
+func (k KVStore) getMsgCacaoPoolWithdraw(ctx cosmos.Context, key string, record *MsgCacaoPoolWithdraw) (bool, error) {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has([]byte(key)) {
+		return false, nil
+	}
+
+	bz := store.Get([]byte(key))
+	if err := k.cdc.Unmarshal(bz, record); err != nil {
+		return true, dbError(ctx, fmt.Sprintf("Unmarshal kvstore: (%T) %s", record, key), err)
+	}
+	return true, nil
+}
+
+// GetCACAOPoolWithdrawRequestIterator iterate the withdraw requests waiting for
+// the deposit of their provider to reach maturity
+func (k KVStore) GetCACAOPoolWithdrawRequestIterator(ctx cosmos.Context) cosmos.Iterator {
+	return k.getIterator(ctx, prefixCACAOPoolWithdraw)
+}
+
+// GetCACAOPoolWithdrawRequest retrieve the queued withdraw request of the CACAO provider
+func (k KVStore) GetCACAOPoolWithdrawRequest(ctx cosmos.Context, addr cosmos.AccAddress) (MsgCacaoPoolWithdraw, error) {
+	record := MsgCacaoPoolWithdraw{}
+	ok, err := k.getMsgCacaoPoolWithdraw(ctx, k.GetKey(ctx, prefixCACAOPoolWithdraw, addr.String()), &record)
+	if !ok {
+		return record, errors.New("not found")
+	}
+	return record, err
+}
+
+// SetCACAOPoolWithdrawRequest save the withdraw request of the signer, replacing
+// any request already queued for it
+func (k KVStore) SetCACAOPoolWithdrawRequest(ctx cosmos.Context, msg MsgCacaoPoolWithdraw) {
+	k.setMsgCacaoPoolWithdraw(ctx, k.GetKey(ctx, prefixCACAOPoolWithdraw, msg.Signer.String()), msg)
+}
+
+// RemoveCACAOPoolWithdrawRequest remove the queued withdraw request of the CACAO provider
+func (k KVStore) RemoveCACAOPoolWithdrawRequest(ctx cosmos.Context, addr cosmos.AccAddress) {
+	k.del(ctx, k.GetKey(ctx, prefixCACAOPoolWithdraw, addr.String()))
+}
@@ -0,0 +1,67 @@
+package keeperv1
+
+import (
+	"fmt"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/x/mayachain/keeper/types"
+)
+
+func (k KVStore) setLoan(ctx cosmos.Context, key string, record Loan) {
+	store := ctx.KVStore(k.storeKey)
+	buf := k.cdc.MustMarshal(&record)
+	if buf == nil {
+		store.Delete([]byte(key))
+	} else {
+		store.Set([]byte(key), buf)
+	}
+}
+
+func (k KVStore) getLoan(ctx cosmos.Context, key string, record *Loan) (bool, error) {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has([]byte(key)) {
+		return false, nil
+	}
+
+	bz := store.Get([]byte(key))
+	if err := k.cdc.Unmarshal(bz, record); err != nil {
+		return true, dbError(ctx, fmt.Sprintf("Unmarshal kvstore: (%T) %s", record, key), err)
+	}
+	return true, nil
+}
+
+// GetLoanIterator iterate loans of all owners
+func (k KVStore) GetLoanIterator(ctx cosmos.Context) cosmos.Iterator {
+	return k.getIterator(ctx, prefixLoan)
+}
+
+// GetLoanIteratorWithOwner iterate the loans of the given owner
+func (k KVStore) GetLoanIteratorWithOwner(ctx cosmos.Context, owner cosmos.AccAddress) cosmos.Iterator {
+	key := k.GetKey(ctx, prefixLoan, owner.String())
+	return k.getIterator(ctx, types.DbPrefix(key))
+}
+
+// GetLoan retrieve the loan of the owner backed by the given collateral asset,
+// an empty loan is returned if it doesn't exist
+func (k KVStore) GetLoan(ctx cosmos.Context, owner cosmos.AccAddress, collateralAsset common.Asset) (Loan, error) {
+	record := NewLoan(owner, collateralAsset, common.EmptyAsset)
+	_, err := k.getLoan(ctx, k.GetKey(ctx, prefixLoan, record.Key()), &record)
+	return record, err
+}
+
+// LoanExists check whether the owner has a loan backed by the collateral asset
+func (k KVStore) LoanExists(ctx cosmos.Context, owner cosmos.AccAddress, collateralAsset common.Asset) bool {
+	record := NewLoan(owner, collateralAsset, common.EmptyAsset)
+	return k.has(ctx, k.GetKey(ctx, prefixLoan, record.Key()))
+}
+
+// SetLoan save the loan to kv store
+func (k KVStore) SetLoan(ctx cosmos.Context, loan Loan) {
+	k.setLoan(ctx, k.GetKey(ctx, prefixLoan, loan.Key()), loan)
+}
+
+// RemoveLoan remove the loan from kv store
+func (k KVStore) RemoveLoan(ctx cosmos.Context, loan Loan) {
+	k.del(ctx, k.GetKey(ctx, prefixLoan, loan.Key()))
+}
@@ -0,0 +1,55 @@
+package keeperv1
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/mayachain/mayanode/common"
+	cosmos "gitlab.com/mayachain/mayanode/common/cosmos"
+)
+
+type KeeperLoanSuite struct{}
+
+var _ = Suite(&KeeperLoanSuite{})
+
+func (s *KeeperLoanSuite) SetUpSuite(c *C) {
+	SetupConfigForTest()
+}
+
+func (s *KeeperLoanSuite) TestLoan(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	asset := common.BTCAsset.GetTradeAsset()
+	owner := GetRandomBech32Addr()
+
+	c.Check(k.LoanExists(ctx, owner, asset), Equals, false)
+	loan, err := k.GetLoan(ctx, owner, asset)
+	c.Assert(err, IsNil)
+	c.Check(loan.CollateralUnits.IsZero(), Equals, true)
+	c.Check(loan.DebtAsset.IsEmpty(), Equals, true)
+
+	loan.DebtAsset = common.ETHAsset
+	loan.CollateralUnits = cosmos.NewUint(100)
+	loan.DebtIssued = cosmos.NewUint(50)
+	k.SetLoan(ctx, loan)
+	c.Check(k.LoanExists(ctx, owner, asset), Equals, true)
+
+	loan, err = k.GetLoan(ctx, owner, asset)
+	c.Assert(err, IsNil)
+	c.Check(loan.DebtAsset.Equals(common.ETHAsset), Equals, true)
+	c.Check(loan.CollateralUnits.Equal(cosmos.NewUint(100)), Equals, true)
+	c.Check(loan.DebtOutstanding().Equal(cosmos.NewUint(50)), Equals, true)
+
+	// loans of another owner are not iterated
+	other := NewLoan(GetRandomBech32Addr(), asset, common.ETHAsset)
+	k.SetLoan(ctx, other)
+
+	count := 0
+	iter := k.GetLoanIteratorWithOwner(ctx, owner)
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	iter.Close()
+	c.Check(count, Equals, 1)
+
+	k.RemoveLoan(ctx, loan)
+	c.Check(k.LoanExists(ctx, owner, asset), Equals, false)
+}
@@ -2,9 +2,12 @@ package keeperv1
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/blang/semver"
 
+	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
 	"gitlab.com/mayachain/mayanode/constants"
 )
@@ -38,9 +41,20 @@ func (k KVStore) GetMAYANameIterator(ctx cosmos.Context) cosmos.Iterator {
 	return k.getIterator(ctx, prefixMAYAName)
 }
 
-// SetMAYAName save the MAYAName object to store
+// SetMAYAName save the MAYAName object to store, and update the owner and
+// alias indexes of the name
 func (k KVStore) SetMAYAName(ctx cosmos.Context, name MAYAName) {
-	k.setMAYAName(ctx, k.GetKey(ctx, prefixMAYAName, name.Key()), name)
+	key := k.GetKey(ctx, prefixMAYAName, name.Key())
+	if !k.GetVersion().GTE(semver.MustParse("1.124.0")) {
+		k.setMAYAName(ctx, key, name)
+		return
+	}
+	old := MAYAName{Name: name.Name}
+	if ok, _ := k.getMAYAName(ctx, key, &old); ok {
+		k.removeMAYANameIndex(ctx, old)
+	}
+	k.setMAYAName(ctx, key, name)
+	k.setMAYANameIndex(ctx, name)
 }
 
 // TODO: remove after adding constants access to keeper (https://gitlab.com/mayachain/mayanode/-/issues/58)
@@ -98,10 +112,106 @@ func (k KVStore) GetMAYAName(ctx cosmos.Context, name string) (MAYAName, error)
 // DeleteMAYAName remove the given MAYAName from data store
 func (k KVStore) DeleteMAYAName(ctx cosmos.Context, name string) error {
 	n := MAYAName{Name: name}
-	k.del(ctx, k.GetKey(ctx, prefixMAYAName, n.Key()))
+	key := k.GetKey(ctx, prefixMAYAName, n.Key())
+	if k.GetVersion().GTE(semver.MustParse("1.124.0")) {
+		if ok, _ := k.getMAYAName(ctx, key, &n); ok {
+			k.removeMAYANameIndex(ctx, n)
+		}
+	}
+	k.del(ctx, key)
 	return nil
 }
 
+///------------------------- MAYAName Indexes ---------------------------///
+// The owner and alias indexes map an owner, or an alias address, to the names
+// pointing at it. The indexes are kept in step with the stored record, an
+// expired name is only dropped from them once it is registered again, so
+// lookups need to check the name hasn't expired.
+
+// GetMAYANamesByOwner returns the names indexed under the given owner
+func (k KVStore) GetMAYANamesByOwner(ctx cosmos.Context, owner cosmos.AccAddress) ([]string, error) {
+	record := make([]string, 0)
+	_, err := k.getStrings(ctx, k.getMAYANameOwnerIndexKey(ctx, owner), &record)
+	return record, err
+}
+
+// GetMAYANamesByAlias returns the names indexed under the given alias address
+func (k KVStore) GetMAYANamesByAlias(ctx cosmos.Context, chain common.Chain, addr common.Address) ([]string, error) {
+	record := make([]string, 0)
+	_, err := k.getStrings(ctx, k.getMAYANameAliasIndexKey(ctx, chain, addr), &record)
+	return record, err
+}
+
+func (k KVStore) setMAYANameIndex(ctx cosmos.Context, name MAYAName) {
+	if !name.Owner.Empty() {
+		k.addMAYANameToIndex(ctx, k.getMAYANameOwnerIndexKey(ctx, name.Owner), name.Name)
+	}
+	for _, alias := range name.Aliases {
+		if alias.Address.IsEmpty() {
+			continue
+		}
+		k.addMAYANameToIndex(ctx, k.getMAYANameAliasIndexKey(ctx, alias.Chain, alias.Address), name.Name)
+	}
+}
+
+func (k KVStore) removeMAYANameIndex(ctx cosmos.Context, name MAYAName) {
+	if !name.Owner.Empty() {
+		k.removeMAYANameFromIndex(ctx, k.getMAYANameOwnerIndexKey(ctx, name.Owner), name.Name)
+	}
+	for _, alias := range name.Aliases {
+		if alias.Address.IsEmpty() {
+			continue
+		}
+		k.removeMAYANameFromIndex(ctx, k.getMAYANameAliasIndexKey(ctx, alias.Chain, alias.Address), name.Name)
+	}
+}
+
+func (k KVStore) addMAYANameToIndex(ctx cosmos.Context, key, name string) {
+	record := make([]string, 0)
+	if _, err := k.getStrings(ctx, key, &record); err != nil {
+		ctx.Logger().Error("fail to get mayaname index", "key", key, "error", err)
+		return
+	}
+	for _, rec := range record {
+		if strings.EqualFold(rec, name) {
+			return
+		}
+	}
+	record = append(record, name)
+	k.setStrings(ctx, key, record)
+}
+
+func (k KVStore) removeMAYANameFromIndex(ctx cosmos.Context, key, name string) {
+	record := make([]string, 0)
+	if _, err := k.getStrings(ctx, key, &record); err != nil {
+		ctx.Logger().Error("fail to get mayaname index", "key", key, "error", err)
+		return
+	}
+	for i, rec := range record {
+		if strings.EqualFold(rec, name) {
+			record = removeString(record, i)
+			break
+		}
+	}
+	if len(record) == 0 {
+		k.del(ctx, key)
+		return
+	}
+	k.setStrings(ctx, key, record)
+}
+
+func (k KVStore) getMAYANameOwnerIndexKey(ctx cosmos.Context, owner cosmos.AccAddress) string {
+	return k.GetKey(ctx, prefixMAYANameOwnerIndex, owner.String())
+}
+
+// getMAYANameAliasIndexKey lower cases the address, so lookups of EVM
+// addresses don't depend on their checksum casing
+func (k KVStore) getMAYANameAliasIndexKey(ctx cosmos.Context, chain common.Chain, addr common.Address) string {
+	return k.GetKey(ctx, prefixMAYANameAliasIndex, fmt.Sprintf("%s/%s", chain, strings.ToLower(addr.String())))
+}
+
+///----------------------------------------------------------------------///
+
 // AffiliateFeeCollector
 
 func (k KVStore) setAffiliateCollector(ctx cosmos.Context, key string, record AffiliateFeeCollector) {
@@ -156,3 +266,142 @@ func (k KVStore) GetAffiliateCollectors(ctx cosmos.Context) ([]AffiliateFeeColle
 	}
 	return affCols, nil
 }
+
+// maxAffiliatePayouts is the number of most recent payouts kept per owner
+const maxAffiliatePayouts = 20
+
+// GetAffiliatePayouts returns the most recent payouts of the affiliate
+// collector of the given owner, oldest first
+func (k KVStore) GetAffiliatePayouts(ctx cosmos.Context, owner cosmos.AccAddress) ([]AffiliatePayout, error) {
+	key := k.GetKey(ctx, prefixAffiliatePayouts, owner.String())
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has([]byte(key)) {
+		return nil, nil
+	}
+	var record AffiliatePayouts
+	if err := k.cdc.Unmarshal(store.Get([]byte(key)), &record); err != nil {
+		return nil, dbError(ctx, fmt.Sprintf("Unmarshal kvstore: (%T) %s", record, key), err)
+	}
+	return record.Payouts, nil
+}
+
+// AddAffiliatePayout records a payout of the affiliate collector of the given
+// owner, dropping the oldest payouts beyond maxAffiliatePayouts
+func (k KVStore) AddAffiliatePayout(ctx cosmos.Context, owner cosmos.AccAddress, payout AffiliatePayout) error {
+	payouts, err := k.GetAffiliatePayouts(ctx, owner)
+	if err != nil {
+		return err
+	}
+	payouts = append(payouts, payout)
+	if len(payouts) > maxAffiliatePayouts {
+		payouts = payouts[len(payouts)-maxAffiliatePayouts:]
+	}
+	record := AffiliatePayouts{Payouts: payouts}
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(k.GetKey(ctx, prefixAffiliatePayouts, owner.String())), k.cdc.MustMarshal(&record))
+	return nil
+}
+
+// GetMAYANameListing retrieve the open listing of the given MAYAName
+func (k KVStore) GetMAYANameListing(ctx cosmos.Context, name string) (MAYANameListing, error) {
+	record := MAYANameListing{Name: name, Price: cosmos.ZeroUint()}
+	key := k.GetKey(ctx, prefixMAYANameListing, record.Key())
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has([]byte(key)) {
+		return record, fmt.Errorf("mayaname %s is not listed", name)
+	}
+	if err := k.cdc.Unmarshal(store.Get([]byte(key)), &record); err != nil {
+		return record, dbError(ctx, fmt.Sprintf("Unmarshal kvstore: (%T) %s", record, key), err)
+	}
+	return record, nil
+}
+
+// MAYANameListingExists check whether the given MAYAName is listed
+func (k KVStore) MAYANameListingExists(ctx cosmos.Context, name string) bool {
+	record := MAYANameListing{Name: name}
+	return k.has(ctx, k.GetKey(ctx, prefixMAYANameListing, record.Key()))
+}
+
+// SetMAYANameListing save the listing to kv store, replacing the previous
+// listing of the MAYAName
+func (k KVStore) SetMAYANameListing(ctx cosmos.Context, listing MAYANameListing) {
+	if prev, err := k.GetMAYANameListing(ctx, listing.Name); err == nil {
+		k.removeMAYANameListingExpiryIndex(ctx, prev)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(k.GetKey(ctx, prefixMAYANameListing, listing.Key())), k.cdc.MustMarshal(&listing))
+	k.setMAYANameListingExpiryIndex(ctx, listing)
+}
+
+// RemoveMAYANameListing remove the listing from kv store
+func (k KVStore) RemoveMAYANameListing(ctx cosmos.Context, listing MAYANameListing) {
+	if prev, err := k.GetMAYANameListing(ctx, listing.Name); err == nil {
+		k.removeMAYANameListingExpiryIndex(ctx, prev)
+	}
+	k.del(ctx, k.GetKey(ctx, prefixMAYANameListing, listing.Key()))
+}
+
+// GetMAYANameListingsExpired returns the names of the listings that expire at or
+// before the given height
+func (k KVStore) GetMAYANameListingsExpired(ctx cosmos.Context, height int64) ([]string, error) {
+	last := k.getMAYANameListingExpiryIndexKey(ctx, height)
+	result := make([]string, 0)
+	iter := k.getIterator(ctx, prefixMAYANameListingExpiry)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if string(iter.Key()) > last {
+			break
+		}
+		var value ProtoStrings
+		if err := k.cdc.Unmarshal(iter.Value(), &value); err != nil {
+			return nil, dbError(ctx, fmt.Sprintf("Unmarshal kvstore: (%s)", string(iter.Key())), err)
+		}
+		result = append(result, value.Value...)
+	}
+	return result, nil
+}
+
+func (k KVStore) setMAYANameListingExpiryIndex(ctx cosmos.Context, listing MAYANameListing) {
+	key := k.getMAYANameListingExpiryIndexKey(ctx, listing.ExpireHeight)
+	record := make([]string, 0)
+	if _, err := k.getStrings(ctx, key, &record); err != nil {
+		_ = dbError(ctx, fmt.Sprintf("fail to get mayaname listing expiry index: (%s)", key), err)
+		return
+	}
+	for _, rec := range record {
+		if strings.EqualFold(rec, listing.Name) {
+			return
+		}
+	}
+	record = append(record, listing.Name)
+	k.setStrings(ctx, key, record)
+}
+
+func (k KVStore) removeMAYANameListingExpiryIndex(ctx cosmos.Context, listing MAYANameListing) {
+	key := k.getMAYANameListingExpiryIndexKey(ctx, listing.ExpireHeight)
+	record := make([]string, 0)
+	if _, err := k.getStrings(ctx, key, &record); err != nil {
+		_ = dbError(ctx, fmt.Sprintf("fail to get mayaname listing expiry index: (%s)", key), err)
+		return
+	}
+	for i, rec := range record {
+		if strings.EqualFold(rec, listing.Name) {
+			record = removeString(record, i)
+			break
+		}
+	}
+	if len(record) == 0 {
+		k.del(ctx, key)
+		return
+	}
+	k.setStrings(ctx, key, record)
+}
+
+func (k KVStore) getMAYANameListingExpiryIndexKey(ctx cosmos.Context, height int64) string {
+	return k.GetKey(ctx, prefixMAYANameListingExpiry, rewriteRatio(expiryHeightLength, strconv.FormatInt(height, 10)))
+}
+
+// GetMAYANameListingIterator iterate MAYAName listings
+func (k KVStore) GetMAYANameListingIterator(ctx cosmos.Context) cosmos.Iterator {
+	return k.getIterator(ctx, prefixMAYANameListing)
+}
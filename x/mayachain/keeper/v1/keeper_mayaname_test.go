@@ -1,6 +1,10 @@
 package keeperv1
 
 import (
+	"strings"
+
+	"github.com/blang/semver"
+
 	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
 	. "gopkg.in/check.v1"
@@ -39,3 +43,134 @@ func (s *KeeperMAYANameSuite) TestMAYAName(c *C) {
 	c.Assert(name.GetSubaffiliates()[0].GetName(), Equals, "alfa")
 	c.Assert(name.GetSubaffiliates()[1].GetName(), Equals, "beta")
 }
+
+func (s *KeeperMAYANameSuite) TestMAYANameIndex(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	owner := GetRandomBech32Addr()
+	btcAddr := GetRandomBTCAddress()
+	bnbAddr := GetRandomBNBAddress()
+	name := NewMAYAName("hello", 50, []MAYANameAlias{{Chain: common.BTCChain, Address: btcAddr}}, common.EmptyAsset, owner, cosmos.ZeroUint(), nil)
+	k.SetMAYAName(ctx, name)
+	k.SetMAYAName(ctx, NewMAYAName("world", 50, []MAYANameAlias{{Chain: common.BTCChain, Address: btcAddr}}, common.EmptyAsset, owner, cosmos.ZeroUint(), nil))
+
+	names, err := k.GetMAYANamesByOwner(ctx, owner)
+	c.Assert(err, IsNil)
+	c.Check(names, DeepEquals, []string{"hello", "world"})
+	names, err = k.GetMAYANamesByAlias(ctx, common.BTCChain, common.Address(strings.ToUpper(btcAddr.String())))
+	c.Assert(err, IsNil)
+	c.Check(names, DeepEquals, []string{"hello", "world"})
+
+	// changing the alias and owner moves the name in the indexes
+	newOwner := GetRandomBech32Addr()
+	name.Owner = newOwner
+	name.Aliases = []MAYANameAlias{{Chain: common.BNBChain, Address: bnbAddr}}
+	k.SetMAYAName(ctx, name)
+
+	names, err = k.GetMAYANamesByOwner(ctx, owner)
+	c.Assert(err, IsNil)
+	c.Check(names, DeepEquals, []string{"world"})
+	names, err = k.GetMAYANamesByOwner(ctx, newOwner)
+	c.Assert(err, IsNil)
+	c.Check(names, DeepEquals, []string{"hello"})
+	names, err = k.GetMAYANamesByAlias(ctx, common.BTCChain, btcAddr)
+	c.Assert(err, IsNil)
+	c.Check(names, DeepEquals, []string{"world"})
+	names, err = k.GetMAYANamesByAlias(ctx, common.BNBChain, bnbAddr)
+	c.Assert(err, IsNil)
+	c.Check(names, DeepEquals, []string{"hello"})
+
+	// deleting a name removes it from the indexes
+	c.Assert(k.DeleteMAYAName(ctx, "world"), IsNil)
+	names, err = k.GetMAYANamesByOwner(ctx, owner)
+	c.Assert(err, IsNil)
+	c.Check(names, HasLen, 0)
+	names, err = k.GetMAYANamesByAlias(ctx, common.BTCChain, btcAddr)
+	c.Assert(err, IsNil)
+	c.Check(names, HasLen, 0)
+
+	// names aren't indexed before 1.124.0
+	k.SetVersion(semver.MustParse("1.123.0"))
+	k.SetMAYAName(ctx, NewMAYAName("world", 50, []MAYANameAlias{{Chain: common.BTCChain, Address: btcAddr}}, common.EmptyAsset, owner, cosmos.ZeroUint(), nil))
+	names, err = k.GetMAYANamesByOwner(ctx, owner)
+	c.Assert(err, IsNil)
+	c.Check(names, HasLen, 0)
+	names, err = k.GetMAYANamesByAlias(ctx, common.BTCChain, btcAddr)
+	c.Assert(err, IsNil)
+	c.Check(names, HasLen, 0)
+}
+
+func (s *KeeperMAYANameSuite) TestAffiliatePayouts(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	owner := GetRandomBech32Addr()
+	payouts, err := k.GetAffiliatePayouts(ctx, owner)
+	c.Assert(err, IsNil)
+	c.Check(payouts, HasLen, 0)
+
+	for i := 1; i <= maxAffiliatePayouts+5; i++ {
+		payout := NewAffiliatePayout("hello", common.BTCAsset, cosmos.NewUint(uint64(i)), GetRandomTxHash(), int64(i), false)
+		c.Assert(k.AddAffiliatePayout(ctx, owner, payout), IsNil)
+	}
+
+	// only the most recent payouts are kept, oldest first
+	payouts, err = k.GetAffiliatePayouts(ctx, owner)
+	c.Assert(err, IsNil)
+	c.Assert(payouts, HasLen, maxAffiliatePayouts)
+	c.Check(payouts[0].Height, Equals, int64(6))
+	c.Check(payouts[maxAffiliatePayouts-1].Height, Equals, int64(maxAffiliatePayouts+5))
+	c.Check(payouts[0].Asset.Equals(common.BTCAsset), Equals, true)
+
+	payouts, err = k.GetAffiliatePayouts(ctx, GetRandomBech32Addr())
+	c.Assert(err, IsNil)
+	c.Check(payouts, HasLen, 0)
+}
+
+func (s *KeeperMAYANameSuite) TestMAYANameListing(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	seller := GetRandomBech32Addr()
+	c.Check(k.MAYANameListingExists(ctx, "hello"), Equals, false)
+	_, err := k.GetMAYANameListing(ctx, "hello")
+	c.Check(err, NotNil)
+
+	listing := NewMAYANameListing("hello", seller, cosmos.NewUint(common.One), 100, true, false, 10)
+	k.SetMAYANameListing(ctx, listing)
+	c.Check(k.MAYANameListingExists(ctx, "HELLO"), Equals, true)
+	listing, err = k.GetMAYANameListing(ctx, "hello")
+	c.Assert(err, IsNil)
+	c.Check(listing.Seller.Equals(seller), Equals, true)
+	c.Check(listing.Price.Uint64(), Equals, uint64(common.One))
+	c.Check(listing.ExpireHeight, Equals, int64(100))
+	c.Check(listing.KeepAliases, Equals, true)
+	c.Check(listing.IsExpired(100), Equals, false)
+	c.Check(listing.IsExpired(101), Equals, true)
+
+	k.SetMAYANameListing(ctx, NewMAYANameListing("world", seller, cosmos.NewUint(common.One), 100, false, false, 10))
+	iter := k.GetMAYANameListingIterator(ctx)
+	count := 0
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	iter.Close()
+	c.Check(count, Equals, 2)
+
+	names, err := k.GetMAYANameListingsExpired(ctx, 99)
+	c.Assert(err, IsNil)
+	c.Check(names, HasLen, 0)
+	names, err = k.GetMAYANameListingsExpired(ctx, 100)
+	c.Assert(err, IsNil)
+	c.Check(names, HasLen, 2)
+
+	// relisting moves the listing to its new expiry height
+	k.SetMAYANameListing(ctx, NewMAYANameListing("world", seller, cosmos.NewUint(common.One), 200, false, false, 20))
+	names, err = k.GetMAYANameListingsExpired(ctx, 100)
+	c.Assert(err, IsNil)
+	c.Check(names, DeepEquals, []string{"hello"})
+
+	k.RemoveMAYANameListing(ctx, listing)
+	c.Check(k.MAYANameListingExists(ctx, "hello"), Equals, false)
+	names, err = k.GetMAYANameListingsExpired(ctx, 200)
+	c.Assert(err, IsNil)
+	c.Check(names, DeepEquals, []string{"world"})
+}
@@ -3,7 +3,9 @@ package keeperv1
 import (
 	"fmt"
 
+	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/x/mayachain/keeper/types"
 )
 
 func (k KVStore) setNetwork(ctx cosmos.Context, key string, record Network) {
@@ -77,3 +79,73 @@ func (k KVStore) SetPOL(ctx cosmos.Context, data ProtocolOwnedLiquidity) error {
 	k.setPOL(ctx, k.GetKey(ctx, prefixPOL, ""), data)
 	return nil
 }
+
+func (k KVStore) setPOLPool(ctx cosmos.Context, key string, record POLPool) {
+	store := ctx.KVStore(k.storeKey)
+	buf := k.cdc.MustMarshal(&record)
+	if buf == nil {
+		store.Delete([]byte(key))
+	} else {
+		store.Set([]byte(key), buf)
+	}
+}
+
+func (k KVStore) getPOLPool(ctx cosmos.Context, key string, record *POLPool) (bool, error) {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has([]byte(key)) {
+		return false, nil
+	}
+
+	bz := store.Get([]byte(key))
+	if err := k.cdc.Unmarshal(bz, record); err != nil {
+		return true, dbError(ctx, fmt.Sprintf("Unmarshal kvstore: (%T) %s", record, key), err)
+	}
+	return true, nil
+}
+
+// GetPOLPoolIterator iterate the POL ledgers of all pools
+func (k KVStore) GetPOLPoolIterator(ctx cosmos.Context) cosmos.Iterator {
+	return k.getIterator(ctx, prefixPOLPool)
+}
+
+// GetPOLPool retrieve the POL ledger of the given pool from key value store
+func (k KVStore) GetPOLPool(ctx cosmos.Context, asset common.Asset) (POLPool, error) {
+	record := NewPOLPool(asset)
+	_, err := k.getPOLPool(ctx, k.GetKey(ctx, prefixPOLPool, record.Key()), &record)
+	return record, err
+}
+
+// POLPoolExists check whether the POL has a ledger in the given pool
+func (k KVStore) POLPoolExists(ctx cosmos.Context, asset common.Asset) bool {
+	return k.has(ctx, k.GetKey(ctx, prefixPOLPool, asset.String()))
+}
+
+// SetPOLPool save the POL ledger of a pool to key value store
+func (k KVStore) SetPOLPool(ctx cosmos.Context, record POLPool) {
+	k.setPOLPool(ctx, k.GetKey(ctx, prefixPOLPool, record.Key()), record)
+}
+
+func (k KVStore) getPOLPoolSnapshotKey(ctx cosmos.Context, asset common.Asset, height int64) string {
+	// zero padded height, so the snapshots of a pool iterate in chronological order
+	return k.GetKey(ctx, prefixPOLPoolSnapshot, fmt.Sprintf("%s/%020d", asset.String(), height))
+}
+
+// GetPOLPoolSnapshotIterator iterate the snapshots of the POL ledger of the
+// given pool, oldest first
+func (k KVStore) GetPOLPoolSnapshotIterator(ctx cosmos.Context, asset common.Asset) cosmos.Iterator {
+	key := k.GetKey(ctx, prefixPOLPoolSnapshot, asset.String()+"/")
+	return k.getIterator(ctx, types.DbPrefix(key))
+}
+
+// SetPOLPoolSnapshot save a snapshot of the POL ledger of a pool to key value store
+func (k KVStore) SetPOLPoolSnapshot(ctx cosmos.Context, snapshot POLPoolSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+	buf := k.cdc.MustMarshal(&snapshot)
+	store.Set([]byte(k.getPOLPoolSnapshotKey(ctx, snapshot.Pool.Asset, snapshot.Height)), buf)
+}
+
+// RemovePOLPoolSnapshot remove the snapshot of the POL ledger of a pool taken at
+// the given height
+func (k KVStore) RemovePOLPoolSnapshot(ctx cosmos.Context, asset common.Asset, height int64) {
+	k.del(ctx, k.getPOLPoolSnapshotKey(ctx, asset, height))
+}
@@ -41,3 +41,46 @@ func (KeeperNetworkSuite) TestPOL(c *C) {
 	c.Check(err2, IsNil)
 	c.Check(pol2.CacaoDeposited.Uint64(), Equals, uint64(100*common.One))
 }
+
+func (KeeperNetworkSuite) TestPOLPool(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	c.Check(k.POLPoolExists(ctx, common.BTCAsset), Equals, false)
+	p, err := k.GetPOLPool(ctx, common.BTCAsset)
+	c.Check(err, IsNil)
+	c.Check(p.Asset.Equals(common.BTCAsset), Equals, true)
+	c.Check(p.Units.IsZero(), Equals, true)
+
+	p.Deposit(cosmos.NewUint(100*common.One), cosmos.NewUint(100), 10)
+	k.SetPOLPool(ctx, p)
+	k.SetPOLPool(ctx, NewPOLPool(common.ETHAsset))
+	c.Check(k.POLPoolExists(ctx, common.BTCAsset), Equals, true)
+	p, err = k.GetPOLPool(ctx, common.BTCAsset)
+	c.Check(err, IsNil)
+	c.Check(p.EntryValue.Uint64(), Equals, uint64(100*common.One))
+
+	count := 0
+	iter := k.GetPOLPoolIterator(ctx)
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	iter.Close()
+	c.Check(count, Equals, 2)
+
+	// snapshots iterate per pool, oldest first
+	for _, height := range []int64{200, 9, 1000} {
+		k.SetPOLPoolSnapshot(ctx, POLPoolSnapshot{Pool: p, Height: height, Value: cosmos.ZeroUint()})
+	}
+	eth := NewPOLPool(common.ETHAsset)
+	k.SetPOLPoolSnapshot(ctx, POLPoolSnapshot{Pool: eth, Height: 50, Value: cosmos.ZeroUint()})
+	k.RemovePOLPoolSnapshot(ctx, common.BTCAsset, 200)
+
+	var heights []int64
+	iter = k.GetPOLPoolSnapshotIterator(ctx, common.BTCAsset)
+	for ; iter.Valid(); iter.Next() {
+		var snapshot POLPoolSnapshot
+		c.Assert(k.Cdc().Unmarshal(iter.Value(), &snapshot), IsNil)
+		heights = append(heights, snapshot.Height)
+	}
+	iter.Close()
+	c.Check(heights, DeepEquals, []int64{9, 1000})
+}
@@ -13,8 +13,13 @@ import (
 	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
 	"gitlab.com/mayachain/mayanode/constants"
+	"gitlab.com/mayachain/mayanode/x/mayachain/keeper/types"
 )
 
+// slashReasonUnknown is the reason slash points are recorded under in the
+// slash ledger when the context carries no reason metric label
+const slashReasonUnknown = "unknown"
+
 func (k KVStore) setNodeAccount(ctx cosmos.Context, key string, record NodeAccount) {
 	store := ctx.KVStore(k.storeKey)
 	buf := k.cdc.MustMarshal(&record)
@@ -318,6 +323,9 @@ func (k KVStore) IncNodeAccountSlashPoints(ctx cosmos.Context, addr cosmos.AccAd
 	k.SetNodeAccountSlashPoints(ctx, addr, current+pts)
 
 	metricLabels, _ := ctx.Context().Value(constants.CtxMetricLabels).([]metrics.Label)
+	if k.GetVersion().GTE(semver.MustParse("1.124.0")) {
+		k.addNodeSlashEntry(ctx, addr, pts, metricLabels)
+	}
 	telemetry.IncrCounterWithLabels(
 		[]string{"mayanode", "point_slash"},
 		float32(pts),
@@ -345,6 +353,9 @@ func (k KVStore) DecNodeAccountSlashPoints(ctx cosmos.Context, addr cosmos.AccAd
 	}
 
 	metricLabels, _ := ctx.Context().Value(constants.CtxMetricLabels).([]metrics.Label)
+	if k.GetVersion().GTE(semver.MustParse("1.124.0")) {
+		k.addNodeSlashEntry(ctx, addr, -dec, metricLabels)
+	}
 	telemetry.IncrCounterWithLabels(
 		[]string{"mayanode", "point_slash_refund"},
 		float32(dec),
@@ -357,6 +368,78 @@ func (k KVStore) DecNodeAccountSlashPoints(ctx cosmos.Context, addr cosmos.AccAd
 	return nil
 }
 
+func (k KVStore) getNodeSlashEntryKey(ctx cosmos.Context, addr cosmos.AccAddress, height int64, reason string) string {
+	// zero padded height, so the entries of a node iterate in chronological order
+	return k.GetKey(ctx, prefixNodeSlashLedger, fmt.Sprintf("%s/%020d/%s", addr.String(), height, reason))
+}
+
+// addNodeSlashEntry records the given slash points, negative when refunded, in
+// the slash ledger of the node under the reason found in the metric labels.
+// Entries older than the retention window are pruned.
+func (k KVStore) addNodeSlashEntry(ctx cosmos.Context, addr cosmos.AccAddress, pts int64, labels []metrics.Label) {
+	if pts == 0 {
+		return
+	}
+	reason := slashReasonUnknown
+	for _, label := range labels {
+		if label.Name == "reason" {
+			reason = label.Value
+			break
+		}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := []byte(k.getNodeSlashEntryKey(ctx, addr, ctx.BlockHeight(), reason))
+	entry := NewNodeSlashEntry(addr, ctx.BlockHeight(), reason)
+	if store.Has(key) {
+		if err := k.cdc.Unmarshal(store.Get(key), &entry); err != nil {
+			ctx.Logger().Error("fail to unmarshal node slash entry", "error", err, "key", string(key))
+			return
+		}
+	}
+	entry.Points += pts
+	// slash points refunded in the block they were given don't need an entry
+	if entry.Points == 0 {
+		store.Delete(key)
+	} else {
+		store.Set(key, k.cdc.MustMarshal(&entry))
+	}
+
+	k.pruneNodeSlashEntries(ctx, addr)
+}
+
+// pruneNodeSlashEntries removes the entries of the slash ledger of the given
+// node that are older than the retention window
+func (k KVStore) pruneNodeSlashEntries(ctx cosmos.Context, addr cosmos.AccAddress) {
+	retention := k.GetConfigInt64(ctx, constants.SlashLedgerRetention)
+	expired := make([][]byte, 0)
+	iterator := k.GetNodeSlashEntryIterator(ctx, addr)
+	for ; iterator.Valid(); iterator.Next() {
+		var entry NodeSlashEntry
+		if err := k.cdc.Unmarshal(iterator.Value(), &entry); err != nil {
+			ctx.Logger().Error("fail to unmarshal node slash entry", "error", err, "key", string(iterator.Key()))
+			break
+		}
+		if entry.Height > ctx.BlockHeight()-retention {
+			break
+		}
+		expired = append(expired, iterator.Key())
+	}
+	iterator.Close()
+
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range expired {
+		store.Delete(key)
+	}
+}
+
+// GetNodeSlashEntryIterator iterate the slash ledger of the given node, oldest
+// entries first
+func (k KVStore) GetNodeSlashEntryIterator(ctx cosmos.Context, addr cosmos.AccAddress) cosmos.Iterator {
+	key := k.GetKey(ctx, prefixNodeSlashLedger, addr.String()+"/")
+	return k.getIterator(ctx, types.DbPrefix(key))
+}
+
 func (k KVStore) setJail(ctx cosmos.Context, key string, record Jail) {
 	store := ctx.KVStore(k.storeKey)
 	buf := k.cdc.MustMarshal(&record)
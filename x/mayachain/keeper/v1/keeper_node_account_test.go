@@ -1,11 +1,16 @@
 package keeperv1
 
 import (
+	"context"
+
+	"github.com/armon/go-metrics"
 	"github.com/blang/semver"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	. "gopkg.in/check.v1"
 
 	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/constants"
 )
 
 type KeeperNodeAccountSuite struct{}
@@ -269,6 +274,59 @@ func (s *KeeperNodeAccountSuite) TestNodeAccountSlashPoints(c *C) {
 	k.ResetNodeAccountSlashPoints(ctx, GetRandomBech32Addr())
 }
 
+func (s *KeeperNodeAccountSuite) TestNodeSlashLedger(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	addr := GetRandomBech32Addr()
+	withReason := func(ctx cosmos.Context, reason string) cosmos.Context {
+		return ctx.WithContext(context.WithValue(ctx.Context(), constants.CtxMetricLabels, []metrics.Label{
+			telemetry.NewLabel("reason", reason),
+		}))
+	}
+	entries := func() []NodeSlashEntry {
+		result := make([]NodeSlashEntry, 0)
+		iterator := k.GetNodeSlashEntryIterator(ctx, addr)
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			var entry NodeSlashEntry
+			c.Assert(k.cdc.Unmarshal(iterator.Value(), &entry), IsNil)
+			result = append(result, entry)
+		}
+		return result
+	}
+
+	ctx = ctx.WithBlockHeight(10)
+	c.Assert(k.IncNodeAccountSlashPoints(withReason(ctx, "not_observing"), addr, 2), IsNil)
+	c.Assert(k.IncNodeAccountSlashPoints(withReason(ctx, "not_observing"), addr, 2), IsNil)
+	c.Assert(k.IncNodeAccountSlashPoints(ctx, addr, 3), IsNil)
+	// refunded in the same block, no entry left
+	c.Assert(k.IncNodeAccountSlashPoints(withReason(ctx, "failed_observe_txin"), addr, 2), IsNil)
+	c.Assert(k.DecNodeAccountSlashPoints(withReason(ctx, "failed_observe_txin"), addr, 2), IsNil)
+	result := entries()
+	c.Assert(result, HasLen, 2)
+	c.Check(result[0].Reason, Equals, "not_observing")
+	c.Check(result[0].Points, Equals, int64(4))
+	c.Check(result[0].Height, Equals, int64(10))
+	c.Check(result[1].Reason, Equals, slashReasonUnknown)
+	c.Check(result[1].Points, Equals, int64(3))
+
+	// refunds are capped at the slash points of the node
+	ctx = ctx.WithBlockHeight(20)
+	c.Assert(k.DecNodeAccountSlashPoints(withReason(ctx, "not_signing"), addr, 100), IsNil)
+	result = entries()
+	c.Assert(result, HasLen, 3)
+	c.Check(result[2].Reason, Equals, "not_signing")
+	c.Check(result[2].Points, Equals, int64(-7))
+
+	// entries older than the retention window are pruned
+	retention := k.GetConfigInt64(ctx, constants.SlashLedgerRetention)
+	ctx = ctx.WithBlockHeight(10 + retention)
+	c.Assert(k.IncNodeAccountSlashPoints(withReason(ctx, "miss_block_sign"), addr, 1), IsNil)
+	result = entries()
+	c.Assert(result, HasLen, 2)
+	c.Check(result[0].Height, Equals, int64(20))
+	c.Check(result[1].Reason, Equals, "miss_block_sign")
+}
+
 func (s *KeeperNodeAccountSuite) TestJail(c *C) {
 	ctx, k := setupKeeperForTest(c)
 	addr := GetRandomBech32Addr()
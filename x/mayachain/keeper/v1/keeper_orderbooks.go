@@ -3,6 +3,7 @@ package keeperv1
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"gitlab.com/mayachain/mayanode/common"
@@ -33,9 +34,15 @@ func (k KVStore) SetOrderBookItem(ctx cosmos.Context, msg MsgSwap) error {
 	if msg.Tx.ID.IsEmpty() {
 		return fmt.Errorf("invalid tx hash")
 	}
+	if msg.ExpiryHeight < 0 {
+		return fmt.Errorf("expiry height cannot be negative")
+	}
 	if err := k.SetOrderBookIndex(ctx, msg); err != nil {
 		return err
 	}
+	if err := k.setOrderBookExpiryIndex(ctx, msg); err != nil {
+		return err
+	}
 	k.setMsgSwap(ctx, k.GetKey(ctx, prefixOrderBookItem, msg.Tx.ID.String()), msg)
 	return nil
 }
@@ -69,6 +76,9 @@ func (k KVStore) RemoveOrderBookItem(ctx cosmos.Context, txID common.TxID) error
 		_ = dbError(ctx, "failed to fetch order book item", err)
 	} else {
 		err = k.RemoveOrderBookIndex(ctx, msg)
+		if err == nil {
+			err = k.removeOrderBookExpiryIndex(ctx, msg)
+		}
 	}
 	k.del(ctx, k.GetKey(ctx, prefixOrderBookItem, txID.String()))
 	return err
@@ -212,6 +222,145 @@ func (k KVStore) getOrderBookIndexKey(ctx cosmos.Context, msg MsgSwap) string {
 	}
 }
 
+///----------------------------------------------------------------------///
+
+///---------------------- Order Book Expiry Index -----------------------///
+// The expiry index tracks the limit orders that have an expiry height, keyed
+// by that height, so expired orders can be found without scanning the whole
+// order book.
+
+// expiryHeightLength is the character length of the height stored in the key
+// of the expiry index, so the kvstore iterates over heights numerically
+const expiryHeightLength int = 20
+
+// GetOrderBookExpiredItems - returns the tx ids of all order book items that
+// expire at, or before, the given height
+func (k KVStore) GetOrderBookExpiredItems(ctx cosmos.Context, height int64) (common.TxIDs, error) {
+	last := k.getOrderBookExpiryIndexKey(ctx, height)
+	result := make(common.TxIDs, 0)
+	iter := k.getIterator(ctx, prefixOrderBookExpiryIndex)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if string(iter.Key()) > last {
+			break
+		}
+		var value ProtoStrings
+		if err := k.cdc.Unmarshal(iter.Value(), &value); err != nil {
+			return nil, dbError(ctx, fmt.Sprintf("Unmarshal kvstore: (%s)", string(iter.Key())), err)
+		}
+		for _, rec := range value.Value {
+			hash, err := common.NewTxID(rec)
+			if err != nil {
+				_ = dbError(ctx, fmt.Sprintf("failed to parse tx hash: (%s)", rec), err)
+				continue
+			}
+			result = append(result, hash)
+		}
+	}
+	return result, nil
+}
+
+func (k KVStore) setOrderBookExpiryIndex(ctx cosmos.Context, msg MsgSwap) error {
+	if msg.ExpiryHeight <= 0 {
+		return nil
+	}
+	key := k.getOrderBookExpiryIndexKey(ctx, msg.ExpiryHeight)
+	record := make([]string, 0)
+	if _, err := k.getStrings(ctx, key, &record); err != nil {
+		return err
+	}
+	for _, rec := range record {
+		if strings.EqualFold(rec, msg.Tx.ID.String()) {
+			return nil
+		}
+	}
+	record = append(record, msg.Tx.ID.String())
+	k.setStrings(ctx, key, record)
+	return nil
+}
+
+func (k KVStore) removeOrderBookExpiryIndex(ctx cosmos.Context, msg MsgSwap) error {
+	if msg.ExpiryHeight <= 0 {
+		return nil
+	}
+	key := k.getOrderBookExpiryIndexKey(ctx, msg.ExpiryHeight)
+	record := make([]string, 0)
+	if _, err := k.getStrings(ctx, key, &record); err != nil {
+		return err
+	}
+	for i, rec := range record {
+		if strings.EqualFold(rec, msg.Tx.ID.String()) {
+			record = removeString(record, i)
+			break
+		}
+	}
+	if len(record) == 0 {
+		k.del(ctx, key)
+		return nil
+	}
+	k.setStrings(ctx, key, record)
+	return nil
+}
+
+func (k KVStore) getOrderBookExpiryIndexKey(ctx cosmos.Context, height int64) string {
+	return k.GetKey(ctx, prefixOrderBookExpiryIndex, rewriteRatio(expiryHeightLength, strconv.FormatInt(height, 10)))
+}
+
+///----------------------------------------------------------------------///
+
+///-------------------------- Order Book Fills --------------------------///
+// A limit order can be filled over several blocks. The fill record tracks how
+// much of the deposit has been swapped so far, and how much was emitted for
+// it. The order book item itself is left untouched until the order is closed.
+
+func (k KVStore) setLimitOrderFill(ctx cosmos.Context, key string, record LimitOrderFill) {
+	store := ctx.KVStore(k.storeKey)
+	buf := k.cdc.MustMarshal(&record)
+	if buf == nil {
+		store.Delete([]byte(key))
+	} else {
+		store.Set([]byte(key), buf)
+	}
+}
+
+func (k KVStore) getLimitOrderFill(ctx cosmos.Context, key string, record *LimitOrderFill) (bool, error) {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has([]byte(key)) {
+		return false, nil
+	}
+
+	bz := store.Get([]byte(key))
+	if err := k.cdc.Unmarshal(bz, record); err != nil {
+		return true, dbError(ctx, fmt.Sprintf("Unmarshal kvstore: (%T) %s", record, key), err)
+	}
+	return true, nil
+}
+
+// SetLimitOrderFill - writes the fill progress of a limit order to the kv store
+func (k KVStore) SetLimitOrderFill(ctx cosmos.Context, fill LimitOrderFill) {
+	k.setLimitOrderFill(ctx, k.GetKey(ctx, prefixOrderBookFill, fill.TxID.String()), fill)
+}
+
+// GetLimitOrderFill - read the fill progress of a limit order, an order that
+// hasn't been filled at all returns an empty record
+func (k KVStore) GetLimitOrderFill(ctx cosmos.Context, hash common.TxID) (LimitOrderFill, error) {
+	record := NewLimitOrderFill(hash)
+	_, err := k.getLimitOrderFill(ctx, k.GetKey(ctx, prefixOrderBookFill, hash.String()), &record)
+	return record, err
+}
+
+// LimitOrderFillExists - checks whether the given limit order has been partially filled
+func (k KVStore) LimitOrderFillExists(ctx cosmos.Context, hash common.TxID) bool {
+	return k.has(ctx, k.GetKey(ctx, prefixOrderBookFill, hash.String()))
+}
+
+// RemoveLimitOrderFill - removes the fill progress of a limit order from the kv store
+func (k KVStore) RemoveLimitOrderFill(ctx cosmos.Context, hash common.TxID) {
+	k.del(ctx, k.GetKey(ctx, prefixOrderBookFill, hash.String()))
+}
+
+///----------------------------------------------------------------------///
+
 func getRatio(input, output cosmos.Uint) string {
 	if output.IsZero() {
 		return "0"
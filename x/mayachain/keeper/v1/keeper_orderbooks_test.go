@@ -73,6 +73,87 @@ func (s *KeeperOrderBookSuite) TestKeeperOrderBook(c *C) {
 	c.Check(ok, Equals, false)
 }
 
+func (s *KeeperOrderBookSuite) TestOrderBookExpiryIndex(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	msg1 := MsgSwap{
+		Tx:           GetRandomTx(),
+		TradeTarget:  cosmos.NewUint(10 * common.One),
+		OrderType:    types.OrderType_limit,
+		ExpiryHeight: 10,
+	}
+	msg2 := MsgSwap{
+		Tx:           GetRandomTx(),
+		TradeTarget:  cosmos.NewUint(10 * common.One),
+		OrderType:    types.OrderType_limit,
+		ExpiryHeight: 1000,
+	}
+	msg3 := MsgSwap{
+		Tx:          GetRandomTx(),
+		TradeTarget: cosmos.NewUint(10 * common.One),
+		OrderType:   types.OrderType_limit,
+	}
+	c.Assert(k.SetOrderBookItem(ctx, msg1), IsNil)
+	c.Assert(k.SetOrderBookItem(ctx, msg1), IsNil) // no duplicates
+	c.Assert(k.SetOrderBookItem(ctx, msg2), IsNil)
+	c.Assert(k.SetOrderBookItem(ctx, msg3), IsNil)
+
+	hashes, err := k.GetOrderBookExpiredItems(ctx, 9)
+	c.Assert(err, IsNil)
+	c.Check(hashes, HasLen, 0)
+
+	hashes, err = k.GetOrderBookExpiredItems(ctx, 10)
+	c.Assert(err, IsNil)
+	c.Assert(hashes, HasLen, 1)
+	c.Check(hashes[0].Equals(msg1.Tx.ID), Equals, true)
+
+	// heights are compared numerically, not alphabetically
+	hashes, err = k.GetOrderBookExpiredItems(ctx, 999)
+	c.Assert(err, IsNil)
+	c.Check(hashes, HasLen, 1)
+
+	hashes, err = k.GetOrderBookExpiredItems(ctx, 1000)
+	c.Assert(err, IsNil)
+	c.Check(hashes, HasLen, 2)
+
+	c.Assert(k.RemoveOrderBookItem(ctx, msg1.Tx.ID), IsNil)
+	hashes, err = k.GetOrderBookExpiredItems(ctx, 1000)
+	c.Assert(err, IsNil)
+	c.Assert(hashes, HasLen, 1)
+	c.Check(hashes[0].Equals(msg2.Tx.ID), Equals, true)
+
+	msg3.ExpiryHeight = -1
+	c.Check(k.SetOrderBookItem(ctx, msg3), NotNil)
+}
+
+func (s *KeeperOrderBookSuite) TestLimitOrderFill(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	txID := GetRandomTxHash()
+	c.Check(k.LimitOrderFillExists(ctx, txID), Equals, false)
+	fill, err := k.GetLimitOrderFill(ctx, txID)
+	c.Assert(err, IsNil)
+	c.Check(fill.TxID.Equals(txID), Equals, true)
+	c.Check(fill.In.IsZero(), Equals, true)
+	c.Check(fill.Out.IsZero(), Equals, true)
+
+	fill.In = cosmos.NewUint(100)
+	fill.Out = cosmos.NewUint(50)
+	fill.Count = 1
+	fill.LastHeight = 12
+	k.SetLimitOrderFill(ctx, fill)
+	c.Check(k.LimitOrderFillExists(ctx, txID), Equals, true)
+	fill, err = k.GetLimitOrderFill(ctx, txID)
+	c.Assert(err, IsNil)
+	c.Check(fill.In.Uint64(), Equals, uint64(100))
+	c.Check(fill.Out.Uint64(), Equals, uint64(50))
+	c.Check(fill.Count, Equals, uint64(1))
+	c.Check(fill.LastHeight, Equals, int64(12))
+
+	k.RemoveLimitOrderFill(ctx, txID)
+	c.Check(k.LimitOrderFillExists(ctx, txID), Equals, false)
+}
+
 func (s *KeeperOrderBookSuite) TestGetOrderBookIndexKey(c *C) {
 	ctx, k := setupKeeperForTest(c)
 	msg := MsgSwap{
@@ -5,6 +5,8 @@ import (
 
 	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/constants"
+	"gitlab.com/mayachain/mayanode/x/mayachain/keeper/types"
 )
 
 func (k KVStore) setSolvencyVoter(ctx cosmos.Context, key string, record SolvencyVoter) {
@@ -43,3 +45,42 @@ func (k KVStore) GetSolvencyVoter(ctx cosmos.Context, txID common.TxID, chain co
 	_, err := k.getSolvencyVoter(ctx, k.GetKey(ctx, prefixSolvencyVoter, key), &solvencyVoter)
 	return solvencyVoter, err
 }
+
+func (k KVStore) getSolvencyRecordKey(ctx cosmos.Context, pk common.PubKey, chain common.Chain, height int64) string {
+	// zero padded height, so the records of a vault iterate in chronological order
+	return k.GetKey(ctx, prefixSolvencyHistory, fmt.Sprintf("%s/%s/%020d", pk, chain, height))
+}
+
+// SetSolvencyRecord save the solvency of a vault on a chain, records older
+// than the retention window are pruned
+func (k KVStore) SetSolvencyRecord(ctx cosmos.Context, record SolvencyRecord) {
+	store := ctx.KVStore(k.storeKey)
+	key := k.getSolvencyRecordKey(ctx, record.PubKey, record.Chain, record.Height)
+	store.Set([]byte(key), k.cdc.MustMarshal(&record))
+
+	retention := k.GetConfigInt64(ctx, constants.SolvencyHistoryRetention)
+	expired := make([][]byte, 0)
+	iterator := k.GetSolvencyRecordIterator(ctx, record.PubKey, record.Chain)
+	for ; iterator.Valid(); iterator.Next() {
+		var r SolvencyRecord
+		if err := k.cdc.Unmarshal(iterator.Value(), &r); err != nil {
+			ctx.Logger().Error("fail to unmarshal solvency record", "error", err, "key", string(iterator.Key()))
+			break
+		}
+		if r.Height > ctx.BlockHeight()-retention {
+			break
+		}
+		expired = append(expired, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range expired {
+		store.Delete(key)
+	}
+}
+
+// GetSolvencyRecordIterator iterate the solvency history of the given vault on
+// the given chain, oldest records first
+func (k KVStore) GetSolvencyRecordIterator(ctx cosmos.Context, pk common.PubKey, chain common.Chain) cosmos.Iterator {
+	key := k.GetKey(ctx, prefixSolvencyHistory, fmt.Sprintf("%s/%s/", pk, chain))
+	return k.getIterator(ctx, types.DbPrefix(key))
+}
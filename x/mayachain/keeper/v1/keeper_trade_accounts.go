@@ -55,6 +55,32 @@ func (k KVStore) RemoveTradeAccount(ctx cosmos.Context, tr TradeAccount) {
 	k.del(ctx, k.GetKey(ctx, prefixTradeAccount, tr.Key()))
 }
 
+// TransferTradeAccount moves units of a trade asset from one owner's trade
+// account to another's. The trade unit record is untouched, as the total units
+// and depth of the asset do not change.
+func (k KVStore) TransferTradeAccount(ctx cosmos.Context, from, to cosmos.AccAddress, asset common.Asset, units cosmos.Uint) error {
+	fromAcct, err := k.GetTradeAccount(ctx, from, asset)
+	if err != nil {
+		return err
+	}
+	if fromAcct.Units.LT(units) {
+		return fmt.Errorf("insufficient trade units: %s < %s", fromAcct.Units, units)
+	}
+	toAcct, err := k.GetTradeAccount(ctx, to, asset)
+	if err != nil {
+		return err
+	}
+
+	fromAcct.Units = fromAcct.Units.Sub(units)
+	fromAcct.LastWithdrawHeight = ctx.BlockHeight()
+	toAcct.Units = toAcct.Units.Add(units)
+	toAcct.LastAddHeight = ctx.BlockHeight()
+
+	k.SetTradeAccount(ctx, fromAcct)
+	k.SetTradeAccount(ctx, toAcct)
+	return nil
+}
+
 func (k KVStore) setTradeUnit(ctx cosmos.Context, key string, record TradeUnit) {
 	store := ctx.KVStore(k.storeKey)
 	buf := k.cdc.MustMarshal(&record)
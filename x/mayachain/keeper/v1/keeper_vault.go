@@ -375,3 +375,39 @@ func (k KVStore) DeleteVault(ctx cosmos.Context, pubkey common.PubKey) error {
 	k.del(ctx, k.GetKey(ctx, prefixVault, vault.PubKey.String()))
 	return nil
 }
+
+func (k KVStore) setVaultMigration(ctx cosmos.Context, key string, record VaultMigration) {
+	store := ctx.KVStore(k.storeKey)
+	buf := k.cdc.MustMarshal(&record)
+	if buf == nil {
+		store.Delete([]byte(key))
+	} else {
+		store.Set([]byte(key), buf)
+	}
+}
+
+func (k KVStore) getVaultMigration(ctx cosmos.Context, key string, record *VaultMigration) (bool, error) {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has([]byte(key)) {
+		return false, nil
+	}
+
+	bz := store.Get([]byte(key))
+	if err := k.cdc.Unmarshal(bz, record); err != nil {
+		return true, dbError(ctx, fmt.Sprintf("Unmarshal kvstore: (%T) %s", record, key), err)
+	}
+	return true, nil
+}
+
+// GetVaultMigration get the record of the funds migrated out of the vault with
+// the given pubkey
+func (k KVStore) GetVaultMigration(ctx cosmos.Context, pk common.PubKey) (VaultMigration, error) {
+	record := NewVaultMigration(pk)
+	_, err := k.getVaultMigration(ctx, k.GetKey(ctx, prefixVaultMigration, pk.String()), &record)
+	return record, err
+}
+
+// SetVaultMigration save the record of the funds migrated out of a vault
+func (k KVStore) SetVaultMigration(ctx cosmos.Context, record VaultMigration) {
+	k.setVaultMigration(ctx, k.GetKey(ctx, prefixVaultMigration, record.PubKey.String()), record)
+}
@@ -80,6 +80,14 @@ func (vm *NetworkMgrVCUR) EndBlock(ctx cosmos.Context, mgr Manager) error {
 		ctx.Logger().Error("fail to process POL liquidity", "error", err)
 	}
 
+	vm.snapshotPOLPools(ctx, mgr)
+
+	vm.processCACAOPoolWithdrawRequests(ctx, mgr)
+
+	if err := vm.compoundCACAOPoolProviders(ctx, mgr); err != nil {
+		ctx.Logger().Error("fail to compound cacao pool providers", "error", err)
+	}
+
 	migrateInterval, err := vm.k.GetMimir(ctx, constants.FundMigrationInterval.String())
 	if migrateInterval < 0 || err != nil {
 		migrateInterval = mgr.GetConstants().GetInt64Value(constants.FundMigrationInterval)
@@ -119,6 +127,7 @@ func (vm *NetworkMgrVCUR) EndBlock(ctx cosmos.Context, mgr Manager) error {
 
 	for _, vault := range retiring {
 		if !vault.HasFunds() {
+			completeVaultMigration(ctx, mgr, vault)
 			vault.Status = InactiveVault
 			if err := vm.k.SetVault(ctx, vault); err != nil {
 				ctx.Logger().Error("fail to set vault to inactive", "error", err)
@@ -375,16 +384,8 @@ func (vm *NetworkMgrVCUR) paySaverYield(ctx cosmos.Context, asset common.Asset,
 }
 
 func (vm *NetworkMgrVCUR) POLCycle(ctx cosmos.Context, mgr Manager) error {
-	maxDeposit := vm.k.GetConfigInt64(ctx, constants.POLMaxNetworkDeposit)
-	movement := vm.k.GetConfigInt64(ctx, constants.POLMaxPoolMovement)
-	target := vm.k.GetConfigInt64(ctx, constants.POLSynthUtilization)
-	buf := vm.k.GetConfigInt64(ctx, constants.POLBuffer)
-	targetUtil := cosmos.NewUint(uint64(target))
-	maxMovement := cosmos.NewUint(uint64(movement))
-	buffer := cosmos.NewUint(uint64(buf))
-
 	// if target synth utilization is zero, disable POL
-	if target == 0 {
+	if vm.k.GetConfigInt64(ctx, constants.POLSynthUtilization) == 0 {
 		return nil
 	}
 
@@ -411,104 +412,211 @@ func (vm *NetworkMgrVCUR) POLCycle(ctx cosmos.Context, mgr Manager) error {
 		return err
 	}
 
-	pools := vm.fetchPOLPools(ctx, mgr)
+	pools := getPOLPools(ctx, mgr)
 
 	if len(pools) == 0 {
 		return fmt.Errorf("no POL pools")
 	}
 
 	pool := pools[int(ctx.BlockHeight()%int64(len(pools)))]
+	synthSupply := mgr.Keeper().GetTotalSupply(ctx, pool.Asset.GetSyntheticAsset())
+	pool.CalcUnits(mgr.GetVersion(), synthSupply)
 
-	// The POL key for the ETH.ETH pool would be POL-ETH-ETH .
-	key := "POL-" + pool.Asset.MimirString()
-	val, err := mgr.Keeper().GetMimir(ctx, key)
+	decision, err := evaluatePOLStrategy(ctx, mgr, pool, pol, polAddress)
 	if err != nil {
 		ctx.Logger().Error("fail to manage POL in pool", "pool", pool.Asset.String(), "error", err)
 		return nil
 	}
 
-	// if pool isn't available or mimir has it configured, force withdraw from the pool
-	if val == 2 || pool.Status != PoolAvailable {
-		targetUtil = cosmos.NewUint(10_000)
+	switch decision.Action {
+	case POLActionAdd:
+		err = vm.addPOLLiquidity(ctx, pool, polAddress, asgardAddress, signer, decision.CacaoAmount, mgr)
+	case POLActionRemove:
+		err = vm.removePOLLiquidity(ctx, pool, polAddress, asgardAddress, signer, decision.CacaoAmount, mgr)
+	default:
+		ctx.Logger().Debug("no POL movement", "pool", pool.Asset.String(), "reason", decision.Reason)
+		return nil
 	}
-
-	synthSupply := mgr.Keeper().GetTotalSupply(ctx, pool.Asset.GetSyntheticAsset())
-	pool.CalcUnits(mgr.GetVersion(), synthSupply)
-	utilization := common.GetUncappedShare(pool.SynthUnits, pool.GetPoolUnits(), cosmos.NewUint(10_000))
-
-	// detect if we need to deposit rune
-	if common.SafeSub(utilization, buffer).GT(targetUtil) {
-		if maxDeposit <= pol.CurrentDeposit().Int64() {
-			ctx.Logger().Info("maximum rune deployed from POL")
-			return nil
-		}
-		if err := vm.addPOLLiquidity(ctx, pool, polAddress, asgardAddress, signer, maxMovement, utilization, targetUtil, mgr); err != nil {
-			ctx.Logger().Error("fail to manage POL in pool", "pool", pool.Asset.String(), "error", err)
-		}
+	if err != nil {
+		ctx.Logger().Error("fail to manage POL in pool", "pool", pool.Asset.String(), "error", err)
 		return nil
 	}
 
-	// detect if we need to withdraw rune
-	if utilization.Add(buffer).LT(targetUtil) {
-		if err := vm.removePOLLiquidity(ctx, pool, polAddress, asgardAddress, signer, maxMovement, utilization, targetUtil, mgr); err != nil {
-			ctx.Logger().Error("fail to manage POL in pool", "pool", pool.Asset.String(), "error", err)
-		}
+	polEvent := NewEventPOL(pool.Asset, decision.Action, decision.CacaoAmount, decision.Utilization, decision.TargetUtilization, decision.Buffer, decision.Reason)
+	if err := vm.eventMgr.EmitEvent(ctx, polEvent); err != nil {
+		ctx.Logger().Error("fail to emit POL event", "error", err)
 	}
 
 	return nil
 }
 
-// generated a filtered list of pools that the POL is active with
-func (mv *NetworkMgrVCUR) fetchPOLPools(ctx cosmos.Context, mgr Manager) Pools {
-	var pools Pools
-	iterator := mgr.Keeper().GetPoolIterator(ctx)
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var pool Pool
-		err := mgr.Keeper().Cdc().Unmarshal(iterator.Value(), &pool)
+// snapshotPOLPools records, every POLSnapshotInterval blocks, the POL ledger of
+// each pool along with its current value, and drops the snapshots older than
+// POLSnapshotRetention blocks
+func (vm *NetworkMgrVCUR) snapshotPOLPools(ctx cosmos.Context, mgr Manager) {
+	interval := vm.k.GetConfigInt64(ctx, constants.POLSnapshotInterval)
+	if interval <= 0 || ctx.BlockHeight()%interval != 0 {
+		return
+	}
+	retention := vm.k.GetConfigInt64(ctx, constants.POLSnapshotRetention)
+
+	polAddress, err := vm.k.GetModuleAddress(ReserveName)
+	if err != nil {
+		ctx.Logger().Error("fail to get POL address", "error", err)
+		return
+	}
+	pools, err := vm.k.GetPools(ctx)
+	if err != nil {
+		ctx.Logger().Error("fail to get pools", "error", err)
+		return
+	}
+	for _, pool := range pools {
+		if pool.Asset.IsNative() || pool.BalanceCacao.IsZero() {
+			continue
+		}
+		ledger, err := getPOLPoolLedger(ctx, mgr, pool.Asset, polAddress)
 		if err != nil {
-			ctx.Logger().Error("fail to unmarshal pool", "pool", pool.Asset.String(), "error", err)
+			ctx.Logger().Error("fail to get POL ledger", "pool", pool.Asset.String(), "error", err)
 			continue
 		}
+		if ledger.Units.IsZero() && ledger.CacaoDeposited.IsZero() {
+			continue
+		}
+		synthSupply := vm.k.GetTotalSupply(ctx, pool.Asset.GetSyntheticAsset())
+		pool.CalcUnits(mgr.GetVersion(), synthSupply)
+		vm.k.SetPOLPoolSnapshot(ctx, POLPoolSnapshot{
+			Pool:   ledger,
+			Height: ctx.BlockHeight(),
+			Value:  getPOLValue(pool, ledger.Units),
+		})
 
-		if pool.Asset.IsSyntheticAsset() {
+		if retention <= 0 {
 			continue
 		}
+		var expired []int64
+		iterator := vm.k.GetPOLPoolSnapshotIterator(ctx, pool.Asset)
+		for ; iterator.Valid(); iterator.Next() {
+			var snapshot POLPoolSnapshot
+			if err := vm.k.Cdc().Unmarshal(iterator.Value(), &snapshot); err != nil {
+				ctx.Logger().Error("fail to unmarshal POL snapshot", "error", err)
+				continue
+			}
+			if snapshot.Height > ctx.BlockHeight()-retention {
+				break
+			}
+			expired = append(expired, snapshot.Height)
+		}
+		iterator.Close()
+		for _, height := range expired {
+			vm.k.RemovePOLPoolSnapshot(ctx, pool.Asset, height)
+		}
+	}
+}
+
+// processCACAOPoolWithdrawRequests executes the queued CACAO pool withdraws whose
+// provider deposit has reached maturity. A request that fails is dropped so it
+// can't be retried every block, the provider has to request the withdraw again.
+func (vm *NetworkMgrVCUR) processCACAOPoolWithdrawRequests(ctx cosmos.Context, mgr Manager) {
+	if vm.k.GetConfigInt64(ctx, constants.CACAOPoolEnabled) <= 0 {
+		return
+	}
+	depositMaturity := vm.k.GetConfigInt64(ctx, constants.CACAOPoolDepositMaturityBlocks)
 
-		if pool.BalanceCacao.IsZero() {
+	var requests []MsgCacaoPoolWithdraw
+	iterator := vm.k.GetCACAOPoolWithdrawRequestIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var msg MsgCacaoPoolWithdraw
+		if err := vm.k.Cdc().Unmarshal(iterator.Value(), &msg); err != nil {
+			ctx.Logger().Error("fail to unmarshal cacao pool withdraw request", "error", err)
+			continue
+		}
+		cacaoProvider, err := vm.k.GetCACAOProvider(ctx, msg.Signer)
+		if err != nil {
+			ctx.Logger().Error("fail to get cacao provider", "address", msg.Signer, "error", err)
+			continue
+		}
+		if ctx.BlockHeight() < cacaoProvider.LastDepositHeight+depositMaturity {
 			continue
 		}
+		requests = append(requests, msg)
+	}
 
-		if pool.Status == PoolSuspended {
+	handler := NewCacaoPoolWithdrawHandler(mgr)
+	for _, msg := range requests {
+		vm.k.RemoveCACAOPoolWithdrawRequest(ctx, msg.Signer)
+		cacheCtx, commit := ctx.CacheContext()
+		if err := handler.handle(cacheCtx, msg); err != nil {
+			ctx.Logger().Error("fail to execute queued cacao pool withdraw", "address", msg.Signer, "error", err)
 			continue
 		}
+		commit()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
 
-		if isChainTradingHalted(ctx, mgr, pool.Asset.GetChain()) || isGlobalTradingHalted(ctx, mgr) {
+// compoundCACAOPoolProviders re-deposits the yield accrued by the CACAO providers
+// that opted in to auto-compounding. The yield is already part of the value of
+// the provider units, so no units are minted; the re-deposited yield is added to
+// the compounded amount of the provider and the next cycle only considers the
+// yield accrued since then.
+func (vm *NetworkMgrVCUR) compoundCACAOPoolProviders(ctx cosmos.Context, mgr Manager) error {
+	if vm.k.GetConfigInt64(ctx, constants.CACAOPoolEnabled) <= 0 {
+		return nil
+	}
+	interval := vm.k.GetConfigInt64(ctx, constants.CACAOPoolAutoCompoundInterval)
+	if interval <= 0 || ctx.BlockHeight()%interval != 0 {
+		return nil
+	}
+
+	cacaoPool, err := vm.k.GetCACAOPool(ctx)
+	if err != nil {
+		return fmt.Errorf("fail to get cacao pool: %w", err)
+	}
+	totalUnits := cacaoPool.TotalUnits()
+	if totalUnits.IsZero() {
+		return nil
+	}
+	totalValue, err := cacaoPoolValue(ctx, mgr)
+	if err != nil {
+		return fmt.Errorf("fail to get cacao pool value: %w", err)
+	}
+
+	var addresses []cosmos.AccAddress
+	iterator := vm.k.GetCACAOProviderIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var rp CACAOProvider
+		if err := vm.k.Cdc().Unmarshal(iterator.Value(), &rp); err != nil {
+			ctx.Logger().Error("fail to unmarshal cacao provider", "error", err)
 			continue
 		}
+		if rp.AutoCompound {
+			addresses = append(addresses, rp.CacaoAddress)
+		}
+	}
 
-		// The POL key for the ETH.ETH pool would be POL-ETH-ETH .
-		key := "POL-" + pool.Asset.MimirString()
-		val, err := mgr.Keeper().GetMimir(ctx, key)
+	for _, addr := range addresses {
+		rp, err := vm.k.GetCACAOProvider(ctx, addr)
 		if err != nil {
-			ctx.Logger().Error("fail to manage POL in pool", "pool", pool.Asset.String(), "error", err)
+			ctx.Logger().Error("fail to get cacao provider", "address", addr, "error", err)
 			continue
 		}
-
-		// -1 is unset default behaviour; 0 is off (paused); 1 is on; 2 (elsewhere) is forced withdraw.
-		switch val {
-		case -1:
-			continue // unset default behaviour:  pause POL movements
-		case 0:
-			continue // off behaviour:  pause POL movements
-		case 1:
-			// on behaviour:  POL is enabled
+		value := common.GetSafeShare(rp.Units, totalUnits, totalValue)
+		principal := common.SafeSub(rp.DepositAmount, rp.WithdrawAmount).Add(rp.CompoundedAmount)
+		yield := common.SafeSub(value, principal)
+		if yield.IsZero() {
+			continue
 		}
+		rp.CompoundedAmount = rp.CompoundedAmount.Add(yield)
+		vm.k.SetCACAOProvider(ctx, rp)
 
-		pools = append(pools, pool)
+		compoundEvent := NewEventCACAOPoolCompound(rp.CacaoAddress, yield)
+		if err := vm.eventMgr.EmitEvent(ctx, compoundEvent); err != nil {
+			ctx.Logger().Error("fail to emit cacao pool compound event", "error", err)
+		}
 	}
-
-	return pools
+	return nil
 }
 
 func (vm *NetworkMgrVCUR) addPOLLiquidity(
@@ -516,16 +624,11 @@ func (vm *NetworkMgrVCUR) addPOLLiquidity(
 	pool Pool,
 	polAddress, asgardAddress common.Address,
 	signer cosmos.AccAddress,
-	maxMovement, utilization, targetUtil cosmos.Uint,
+	runeAmt cosmos.Uint,
 	mgr Manager,
 ) error {
 	handler := NewInternalHandler(mgr)
 
-	move := utilization.Sub(targetUtil)
-	if move.GT(maxMovement) {
-		move = maxMovement
-	}
-	runeAmt := common.GetSafeShare(move, cosmos.NewUint(10_000), pool.BalanceCacao)
 	if runeAmt.IsZero() {
 		return nil
 	}
@@ -536,20 +639,37 @@ func (vm *NetworkMgrVCUR) addPOLLiquidity(
 	if runeAmt.GT(bal) {
 		return nil
 	}
-	if err := mgr.Keeper().SendFromModuleToModule(ctx, ReserveName, AsgardName, coins); err != nil {
+	ledger, err := getPOLPoolLedger(ctx, mgr, pool.Asset, polAddress)
+	if err != nil {
+		return err
+	}
+	before, err := mgr.Keeper().GetLiquidityProvider(ctx, pool.Asset, polAddress)
+	if err != nil {
+		return err
+	}
+	if err = mgr.Keeper().SendFromModuleToModule(ctx, ReserveName, AsgardName, coins); err != nil {
 		return err
 	}
 
 	tx := common.NewTx(common.BlankTxID, polAddress, asgardAddress, coins, nil, "MAYA-ADD-POL")
 	msg := NewMsgAddLiquidity(tx, pool.Asset, runeAmt, cosmos.ZeroUint(), polAddress, common.NoAddress, common.NoAddress, cosmos.ZeroUint(), signer, 1)
-	_, err := handler(ctx, msg)
+	_, err = handler(ctx, msg)
 	if err != nil {
 		// revert the rune back to the reserve
 		if err = mgr.Keeper().SendFromModuleToModule(ctx, AsgardName, ReserveName, coins); err != nil {
 			return err
 		}
+		return err
 	}
-	return err
+
+	// record the deposit in the POL ledger of the pool
+	after, err := mgr.Keeper().GetLiquidityProvider(ctx, pool.Asset, polAddress)
+	if err != nil {
+		return err
+	}
+	ledger.Deposit(runeAmt, common.SafeSub(after.Units, before.Units), ctx.BlockHeight())
+	mgr.Keeper().SetPOLPool(ctx, ledger)
+	return nil
 }
 
 func (vm *NetworkMgrVCUR) removePOLLiquidity(
@@ -557,7 +677,7 @@ func (vm *NetworkMgrVCUR) removePOLLiquidity(
 	pool Pool,
 	polAddress, asgardAddress common.Address,
 	signer cosmos.AccAddress,
-	maxMovement, utilization, targetUtil cosmos.Uint,
+	runeAmt cosmos.Uint,
 	mgr Manager,
 ) error {
 	handler := NewInternalHandler(mgr)
@@ -571,11 +691,6 @@ func (vm *NetworkMgrVCUR) removePOLLiquidity(
 		return nil
 	}
 
-	move := targetUtil.Sub(utilization)
-	if move.GT(maxMovement) {
-		move = maxMovement
-	}
-	runeAmt := common.GetSafeShare(move, cosmos.NewUint(10_000), pool.BalanceCacao)
 	if runeAmt.IsZero() {
 		return nil
 	}
@@ -593,8 +708,33 @@ func (vm *NetworkMgrVCUR) removePOLLiquidity(
 		signer,
 	)
 
+	ledger, err := getPOLPoolLedger(ctx, mgr, pool.Asset, polAddress)
+	if err != nil {
+		return err
+	}
+	pol, err := mgr.Keeper().GetPOL(ctx)
+	if err != nil {
+		return err
+	}
+
 	_, err = handler(ctx, msg)
-	return err
+	if err != nil {
+		return err
+	}
+
+	// record the withdraw in the POL ledger of the pool
+	after, err := mgr.Keeper().GetLiquidityProvider(ctx, pool.Asset, polAddress)
+	if err != nil {
+		return err
+	}
+	polAfter, err := mgr.Keeper().GetPOL(ctx)
+	if err != nil {
+		return err
+	}
+	cacaoAmt := common.SafeSub(polAfter.CacaoWithdrawn, pol.CacaoWithdrawn)
+	ledger.Withdraw(cacaoAmt, common.SafeSub(lp.Units, after.Units), ctx.BlockHeight())
+	mgr.Keeper().SetPOLPool(ctx, ledger)
+	return nil
 }
 
 // TriggerKeygen generate a record to instruct signer kick off keygen process
@@ -933,21 +933,21 @@ func (*NetworkManagerVCURTestSuite) TestPOLLiquidityAdd(c *C) {
 	// hit max
 	util := cosmos.NewUint(1500)
 	target := cosmos.NewUint(1000)
-	c.Assert(net.addPOLLiquidity(ctx, btcPool, polAddress, asgardAddress, signer, max, util, target, mgr), IsNil)
+	c.Assert(net.addPOLLiquidity(ctx, btcPool, polAddress, asgardAddress, signer, getPOLMovement(btcPool, max, util, target), mgr), IsNil)
 	lp, err := mgr.Keeper().GetLiquidityProvider(ctx, btcPool.Asset, polAddress)
 	c.Assert(err, IsNil)
 	c.Check(lp.Units.Uint64(), Equals, uint64(7), Commentf("%d", lp.Units.Uint64()))
 
 	// doesn't hit max
 	util = cosmos.NewUint(1050)
-	c.Assert(net.addPOLLiquidity(ctx, btcPool, polAddress, asgardAddress, signer, max, util, target, mgr), IsNil)
+	c.Assert(net.addPOLLiquidity(ctx, btcPool, polAddress, asgardAddress, signer, getPOLMovement(btcPool, max, util, target), mgr), IsNil)
 	lp, err = mgr.Keeper().GetLiquidityProvider(ctx, btcPool.Asset, polAddress)
 	c.Assert(err, IsNil)
 	c.Check(lp.Units.Uint64(), Equals, uint64(10), Commentf("%d", lp.Units.Uint64()))
 
 	// no change needed
 	util = cosmos.NewUint(1000)
-	c.Assert(net.addPOLLiquidity(ctx, btcPool, polAddress, asgardAddress, signer, max, util, target, mgr), IsNil)
+	c.Assert(net.addPOLLiquidity(ctx, btcPool, polAddress, asgardAddress, signer, getPOLMovement(btcPool, max, util, target), mgr), IsNil)
 	lp, err = mgr.Keeper().GetLiquidityProvider(ctx, btcPool.Asset, polAddress)
 	c.Assert(err, IsNil)
 	c.Check(lp.Units.Uint64(), Equals, uint64(10), Commentf("%d", lp.Units.Uint64()))
@@ -956,7 +956,7 @@ func (*NetworkManagerVCURTestSuite) TestPOLLiquidityAdd(c *C) {
 	max = cosmos.NewUint(10000)
 	util = cosmos.NewUint(50_000)
 	btcPool.BalanceCacao = cosmos.NewUint(90000000000 * common.One)
-	c.Assert(net.addPOLLiquidity(ctx, btcPool, polAddress, asgardAddress, signer, max, util, target, mgr), IsNil)
+	c.Assert(net.addPOLLiquidity(ctx, btcPool, polAddress, asgardAddress, signer, getPOLMovement(btcPool, max, util, target), mgr), IsNil)
 	lp, err = mgr.Keeper().GetLiquidityProvider(ctx, btcPool.Asset, polAddress)
 	c.Assert(err, IsNil)
 	c.Check(lp.Units.Uint64(), Equals, uint64(10), Commentf("%d", lp.Units.Uint64()))
@@ -1017,7 +1017,7 @@ func (*NetworkManagerVCURTestSuite) TestPOLLiquidityWithdraw(c *C) {
 	// hit max
 	util := cosmos.NewUint(500)
 	target := cosmos.NewUint(1000)
-	c.Assert(net.removePOLLiquidity(ctx, btcPool, polAddress, asgardAddress, signer, max, util, target, mgr), IsNil)
+	c.Assert(net.removePOLLiquidity(ctx, btcPool, polAddress, asgardAddress, signer, getPOLMovement(btcPool, max, util, target), mgr), IsNil)
 	lp, err := mgr.Keeper().GetLiquidityProvider(ctx, btcPool.Asset, polAddress)
 	c.Assert(err, IsNil)
 	c.Check(lp.Units.Uint64(), Equals, uint64(792), Commentf("%d", lp.Units.Uint64()))
@@ -1026,7 +1026,7 @@ func (*NetworkManagerVCURTestSuite) TestPOLLiquidityWithdraw(c *C) {
 
 	// doesn't hit max
 	util = cosmos.NewUint(950)
-	c.Assert(net.removePOLLiquidity(ctx, btcPool, polAddress, asgardAddress, signer, max, util, target, mgr), IsNil)
+	c.Assert(net.removePOLLiquidity(ctx, btcPool, polAddress, asgardAddress, signer, getPOLMovement(btcPool, max, util, target), mgr), IsNil)
 	lp, err = mgr.Keeper().GetLiquidityProvider(ctx, btcPool.Asset, polAddress)
 	c.Assert(err, IsNil)
 	c.Check(lp.Units.Uint64(), Equals, uint64(788), Commentf("%d", lp.Units.Uint64()))
@@ -1035,12 +1035,192 @@ func (*NetworkManagerVCURTestSuite) TestPOLLiquidityWithdraw(c *C) {
 
 	// no change needed
 	util = cosmos.NewUint(1000)
-	c.Assert(net.removePOLLiquidity(ctx, btcPool, polAddress, asgardAddress, signer, max, util, target, mgr), IsNil)
+	c.Assert(net.removePOLLiquidity(ctx, btcPool, polAddress, asgardAddress, signer, getPOLMovement(btcPool, max, util, target), mgr), IsNil)
 	lp, err = mgr.Keeper().GetLiquidityProvider(ctx, btcPool.Asset, polAddress)
 	c.Assert(err, IsNil)
 	c.Check(lp.Units.Uint64(), Equals, uint64(788), Commentf("%d", lp.Units.Uint64()))
 }
 
+func (*NetworkManagerVCURTestSuite) TestPOLStrategy(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	k := mgr.Keeper()
+
+	net := newNetworkMgrVCUR(k, mgr.TxOutStore(), mgr.EventMgr())
+	polAddress, err := k.GetModuleAddress(ReserveName)
+	c.Assert(err, IsNil)
+	na := GetRandomValidatorNode(NodeActive)
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+	c.Assert(k.SetVault(ctx, GetRandomVault()), IsNil)
+
+	reserve := common.NewCoin(common.BaseNative, cosmos.NewUint(1000*common.One))
+	c.Assert(k.MintToModule(ctx, ModuleName, reserve), IsNil)
+	c.Assert(k.SendFromModuleToModule(ctx, ModuleName, ReserveName, common.NewCoins(reserve)), IsNil)
+
+	// minting half of the pool asset depth as synths results in a synth
+	// utilization of 25%
+	synths := common.NewCoin(common.BTCAsset.GetSyntheticAsset(), cosmos.NewUint(10*common.One))
+	c.Assert(k.MintToModule(ctx, ModuleName, synths), IsNil)
+	c.Assert(k.SendFromModuleToModule(ctx, ModuleName, AsgardName, common.NewCoins(synths)), IsNil)
+
+	btcPool := NewPool()
+	btcPool.Asset = common.BTCAsset
+	btcPool.Status = PoolAvailable
+	btcPool.BalanceCacao = cosmos.NewUint(2000 * common.One)
+	btcPool.BalanceAsset = cosmos.NewUint(20 * common.One)
+	btcPool.LPUnits = cosmos.NewUint(3000 * common.One)
+	c.Assert(k.SetPool(ctx, btcPool), IsNil)
+
+	k.SetMimir(ctx, "POL-BTC-BTC", 1)
+	k.SetMimir(ctx, constants.POLSynthUtilization.String(), 1000)
+	k.SetMimir(ctx, constants.POLMaxPoolMovement.String(), 100)
+	k.SetMimir(ctx, constants.POLMaxNetworkDeposit.String(), 10000*common.One)
+
+	evaluate := func() polDecision {
+		pol, err := k.GetPOL(ctx)
+		c.Assert(err, IsNil)
+		decision, err := evaluatePOLStrategy(ctx, mgr, btcPool, pol, polAddress)
+		c.Assert(err, IsNil)
+		return decision
+	}
+
+	// network wide target, the movement is capped to 1% of the pool depth
+	decision := evaluate()
+	c.Check(decision.Action, Equals, POLActionAdd)
+	c.Check(decision.Utilization.Uint64(), Equals, uint64(2500))
+	c.Check(decision.TargetUtilization.Uint64(), Equals, uint64(1000))
+	c.Check(decision.CacaoAmount.Uint64(), Equals, uint64(20*common.One))
+
+	// pool target above the utilization, but no liquidity to withdraw
+	k.SetMimir(ctx, "POLSynthUtilization-BTC-BTC", 3000)
+	decision = evaluate()
+	c.Check(decision.Action, Equals, POLActionNone)
+	c.Check(decision.TargetUtilization.Uint64(), Equals, uint64(3000))
+	c.Check(decision.Reason, Equals, "no POL liquidity in pool")
+
+	// utilization within the pool band
+	k.SetMimir(ctx, "POLSynthUtilization-BTC-BTC", 2000)
+	k.SetMimir(ctx, "POLBuffer-BTC-BTC", 600)
+	decision = evaluate()
+	c.Check(decision.Action, Equals, POLActionNone)
+	c.Check(decision.Reason, Equals, "synth utilization within target band")
+
+	// the deposit is capped to the max cacao deployed in the pool
+	k.SetMimir(ctx, "POLBuffer-BTC-BTC", 0)
+	k.SetMimir(ctx, "POLMaxPoolDeposit-BTC-BTC", 5*common.One)
+	decision = evaluate()
+	c.Check(decision.Action, Equals, POLActionAdd)
+	c.Check(decision.CacaoAmount.Uint64(), Equals, uint64(5*common.One))
+
+	// the POL cycle moves the cacao and emits the POL event
+	ctx = ctx.WithBlockHeight(100)
+	c.Assert(net.POLCycle(ctx, mgr), IsNil)
+	lp, err := k.GetLiquidityProvider(ctx, btcPool.Asset, polAddress)
+	c.Assert(err, IsNil)
+	c.Check(lp.Units.IsZero(), Equals, false)
+	found := false
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type == "pol" {
+			found = true
+		}
+	}
+	c.Check(found, Equals, true)
+
+	btcPool, err = k.GetPool(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	decision = evaluate()
+	c.Check(decision.CacaoValue.IsZero(), Equals, false)
+	c.Check(decision.CacaoValue.LTE(cosmos.NewUint(5*common.One)), Equals, true)
+	k.SetMimir(ctx, "POLMaxPoolDeposit-BTC-BTC", common.One)
+	decision = evaluate()
+	c.Check(decision.Action, Equals, POLActionNone)
+	c.Check(decision.Reason, Equals, "maximum cacao deployed in pool")
+
+	// no movement during the cooldown of the pool
+	k.SetMimir(ctx, "POLMaxPoolDeposit-BTC-BTC", 0)
+	k.SetMimir(ctx, "POLCooldown-BTC-BTC", 10)
+	decision = evaluate()
+	c.Check(decision.Action, Equals, POLActionNone)
+	c.Check(decision.Reason, Equals, "cooldown until block 110")
+	ctx = ctx.WithBlockHeight(110)
+	decision = evaluate()
+	c.Check(decision.Action, Equals, POLActionAdd)
+}
+
+func (*NetworkManagerVCURTestSuite) TestPOLLedger(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	k := mgr.Keeper()
+
+	net := newNetworkMgrVCUR(k, mgr.TxOutStore(), mgr.EventMgr())
+	polAddress, err := k.GetModuleAddress(ReserveName)
+	c.Assert(err, IsNil)
+	asgardAddress, err := k.GetModuleAddress(AsgardName)
+	c.Assert(err, IsNil)
+	na := GetRandomValidatorNode(NodeActive)
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+	c.Assert(k.SetVault(ctx, GetRandomVault()), IsNil)
+
+	reserve := common.NewCoin(common.BaseNative, cosmos.NewUint(1000*common.One))
+	c.Assert(k.MintToModule(ctx, ModuleName, reserve), IsNil)
+	c.Assert(k.SendFromModuleToModule(ctx, ModuleName, ReserveName, common.NewCoins(reserve)), IsNil)
+
+	btcPool := NewPool()
+	btcPool.Asset = common.BTCAsset
+	btcPool.Status = PoolAvailable
+	btcPool.BalanceCacao = cosmos.NewUint(2000 * common.One)
+	btcPool.BalanceAsset = cosmos.NewUint(20 * common.One)
+	btcPool.LPUnits = cosmos.NewUint(2000 * common.One)
+	c.Assert(k.SetPool(ctx, btcPool), IsNil)
+
+	// deposit
+	ctx = ctx.WithBlockHeight(10)
+	c.Assert(net.addPOLLiquidity(ctx, btcPool, polAddress, asgardAddress, na.NodeAddress, cosmos.NewUint(20*common.One), mgr), IsNil)
+	lp, err := k.GetLiquidityProvider(ctx, common.BTCAsset, polAddress)
+	c.Assert(err, IsNil)
+	c.Assert(lp.Units.IsZero(), Equals, false)
+	ledger, err := k.GetPOLPool(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Check(ledger.Units.Equal(lp.Units), Equals, true)
+	c.Check(ledger.CacaoDeposited.Uint64(), Equals, uint64(20*common.One))
+	c.Check(ledger.EntryValue.Uint64(), Equals, uint64(20*common.One))
+	c.Check(ledger.LastMovementHeight, Equals, int64(10))
+
+	// withdraw
+	ctx = ctx.WithBlockHeight(20)
+	btcPool, err = k.GetPool(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Assert(net.removePOLLiquidity(ctx, btcPool, polAddress, asgardAddress, na.NodeAddress, cosmos.NewUint(5*common.One), mgr), IsNil)
+	pol, err := k.GetPOL(ctx)
+	c.Assert(err, IsNil)
+	lp, err = k.GetLiquidityProvider(ctx, common.BTCAsset, polAddress)
+	c.Assert(err, IsNil)
+	ledger, err = k.GetPOLPool(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Check(ledger.Units.Equal(lp.Units), Equals, true)
+	c.Check(ledger.CacaoWithdrawn.Equal(pol.CacaoWithdrawn), Equals, true)
+	c.Check(ledger.CacaoWithdrawn.IsZero(), Equals, false)
+	c.Check(ledger.WithdrawnEntryValue.IsZero(), Equals, false)
+	c.Check(ledger.EntryValue.Add(ledger.WithdrawnEntryValue).Uint64(), Equals, uint64(20*common.One))
+	c.Check(ledger.LastMovementHeight, Equals, int64(20))
+
+	// snapshots are taken every interval and dropped after the retention
+	k.SetMimir(ctx, constants.POLSnapshotInterval.String(), 10)
+	k.SetMimir(ctx, constants.POLSnapshotRetention.String(), 25)
+	for height := int64(25); height <= 60; height += 5 {
+		net.snapshotPOLPools(ctx.WithBlockHeight(height), mgr)
+	}
+	var heights []int64
+	iter := k.GetPOLPoolSnapshotIterator(ctx, common.BTCAsset)
+	for ; iter.Valid(); iter.Next() {
+		var snapshot POLPoolSnapshot
+		c.Assert(k.Cdc().Unmarshal(iter.Value(), &snapshot), IsNil)
+		c.Check(snapshot.Pool.Units.Equal(ledger.Units), Equals, true)
+		c.Check(snapshot.Value.IsZero(), Equals, false)
+		heights = append(heights, snapshot.Height)
+	}
+	iter.Close()
+	c.Check(heights, DeepEquals, []int64{40, 50, 60})
+}
+
 func (s *NetworkManagerVCURTestSuite) TestSaverYieldFunc(c *C) {
 	var err error
 	ctx, mgr := setupManagerForTest(c)
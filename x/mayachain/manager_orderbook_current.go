@@ -270,22 +270,80 @@ func (ob *OrderBookVCUR) discoverLimitOrders(ctx cosmos.Context, pair tradePair,
 				continue
 			}
 
-			// do a swap, including swap fees and outbound fees. If this passes attempt the swap.
-			if ok := ob.checkWithFeeSwap(ctx, pools, msg); !ok {
+			item, ok := ob.getLimitOrderItem(ctx, pools, msg, i)
+			if !ok {
 				continue
 			}
-
-			items = append(items, orderItem{
-				msg:   msg,
-				index: i,
-				fee:   cosmos.ZeroUint(),
-				slip:  cosmos.ZeroUint(),
-			})
+			items = append(items, item)
 		}
 	}
 	return items, done
 }
 
+// getLimitOrderItem returns the slice of a limit order that is able to fill at
+// its price this block. The slice carries its share of the trade target, so
+// the swap limit is still enforced by the swap handler. Whatever isn't filled
+// stays in the order book.
+func (ob *OrderBookVCUR) getLimitOrderItem(ctx cosmos.Context, pools Pools, msg MsgSwap, index int) (orderItem, bool) {
+	fill, err := ob.k.GetLimitOrderFill(ctx, msg.Tx.ID)
+	if err != nil {
+		ctx.Logger().Error("fail to fetch limit order fill", "error", err)
+		return orderItem{}, false
+	}
+	deposit := msg.Tx.Coins[0]
+	remaining := fill.Remaining(deposit.Amount)
+	if remaining.IsZero() {
+		return orderItem{}, false
+	}
+
+	amount := ob.getLimitOrderFillAmount(ctx, pools, msg, remaining)
+	if amount.IsZero() {
+		return orderItem{}, false
+	}
+	msg.TradeTarget = common.GetSafeShare(amount, deposit.Amount, msg.TradeTarget)
+	deposit.Amount = amount
+	msg.Tx.Coins = common.Coins{deposit}
+
+	return orderItem{
+		msg:   msg,
+		index: index,
+		fee:   cosmos.ZeroUint(),
+		slip:  cosmos.ZeroUint(),
+	}, true
+}
+
+// getLimitOrderFillAmount finds the largest part of the remaining deposit of a
+// limit order that can be swapped at the price of the order, including swap
+// fees. Slices smaller than LimitOrderMinFillBasisPoints of the deposit are not
+// filled, unless that is all that remains of the order.
+func (ob *OrderBookVCUR) getLimitOrderFillAmount(ctx cosmos.Context, pools Pools, msg MsgSwap, remaining cosmos.Uint) cosmos.Uint {
+	if ob.checkWithFeeSwap(ctx, pools, msg, remaining) {
+		return remaining
+	}
+
+	minFillBps := ob.k.GetConfigInt64(ctx, constants.LimitOrderMinFillBasisPoints)
+	minFill := common.GetSafeShare(cosmos.NewUint(uint64(minFillBps)), cosmos.NewUint(10_000), msg.Tx.Coins[0].Amount)
+	if minFill.IsZero() {
+		minFill = cosmos.OneUint()
+	}
+	if remaining.LTE(minFill) || !ob.checkWithFeeSwap(ctx, pools, msg, minFill) {
+		return cosmos.ZeroUint()
+	}
+
+	// the emission of a swap grows slower than its input, so the largest
+	// slice that meets the price can be found with a binary search
+	lo, hi := minFill, remaining
+	for i := 0; i < 64 && hi.Sub(lo).GT(cosmos.OneUint()); i++ {
+		mid := lo.Add(hi).QuoUint64(2)
+		if ob.checkWithFeeSwap(ctx, pools, msg, mid) {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return lo
+}
+
 func (ob *OrderBookVCUR) checkFeelessSwap(pools Pools, pair tradePair, indexRatio uint64) bool {
 	var ratio cosmos.Uint
 	switch {
@@ -318,7 +376,9 @@ func (ob *OrderBookVCUR) checkFeelessSwap(pools Pools, pair tradePair, indexRati
 	return cosmos.NewUint(indexRatio).GT(ratio)
 }
 
-func (ob *OrderBookVCUR) checkWithFeeSwap(ctx cosmos.Context, pools Pools, msg MsgSwap) bool {
+// checkWithFeeSwap checks whether swapping the given amount of the deposit of
+// a limit order emits more than its share of the trade target
+func (ob *OrderBookVCUR) checkWithFeeSwap(ctx cosmos.Context, pools Pools, msg MsgSwap, amount cosmos.Uint) bool {
 	swapper, err := GetSwapper(ob.k.GetVersion())
 	if err != nil {
 		ctx.Logger().Error("fail to load swapper", "error", err)
@@ -326,13 +386,13 @@ func (ob *OrderBookVCUR) checkWithFeeSwap(ctx cosmos.Context, pools Pools, msg M
 	}
 
 	// account for affiliate fee
-	source := msg.Tx.Coins[0]
+	source := common.NewCoin(msg.Tx.Coins[0].Asset, amount)
 	if !msg.AffiliateBasisPoints.IsZero() {
 		maxBasisPoints := cosmos.NewUint(10_000)
 		source.Amount = common.GetSafeShare(common.SafeSub(maxBasisPoints, msg.AffiliateBasisPoints), maxBasisPoints, source.Amount)
 	}
 
-	target := common.NewCoin(msg.TargetAsset, msg.TradeTarget)
+	target := common.NewCoin(msg.TargetAsset, common.GetSafeShare(amount, msg.Tx.Coins[0].Amount, msg.TradeTarget))
 	var emit cosmos.Uint
 	switch {
 	case !source.Asset.IsNativeBase() && !target.Asset.IsNativeBase():
@@ -445,6 +505,9 @@ func (ob *OrderBookVCUR) getAssetPairs(ctx cosmos.Context) (tradePairs, Pools) {
 }
 
 func (ob *OrderBookVCUR) AddOrderBookItem(ctx cosmos.Context, msg MsgSwap) error {
+	if msg.ExpiryHeight > 0 && msg.ExpiryHeight < ctx.BlockHeight() {
+		return fmt.Errorf("limit order expiry height (%d) has already passed", msg.ExpiryHeight)
+	}
 	if err := ob.k.SetOrderBookItem(ctx, msg); err != nil {
 		ctx.Logger().Error("fail to add order book item", "error", err)
 		return err
@@ -460,6 +523,144 @@ func (ob *OrderBookVCUR) AddOrderBookItem(ctx cosmos.Context, msg MsgSwap) error
 	return nil
 }
 
+// CancelOrderBookItem removes the limit order the given message cancels from
+// the order book, and refunds it. Only the address that placed the order is
+// able to cancel it. Whatever was sent along with the cancellation is
+// refunded as well.
+func (ob *OrderBookVCUR) CancelOrderBookItem(ctx cosmos.Context, mgr Manager, msg MsgSwap) error {
+	if !msg.IsCancel() {
+		return fmt.Errorf("message does not cancel an order")
+	}
+	order, err := ob.k.GetOrderBookItem(ctx, msg.CancelTxID)
+	if err != nil {
+		return fmt.Errorf("fail to get limit order (%s): %w", msg.CancelTxID, err)
+	}
+	if order.OrderType != LimitOrder {
+		return fmt.Errorf("only limit orders can be cancelled")
+	}
+	if !order.Tx.FromAddress.Equals(msg.Tx.FromAddress) {
+		return fmt.Errorf("only the sender of the limit order can cancel it")
+	}
+	if err := ob.closeLimitOrder(ctx, mgr, order, CodeLimitOrderCancelled, LimitOrderCloseReasonCancelled, msg.Tx.ID); err != nil {
+		return err
+	}
+	if msg.Tx.Coins.IsEmpty() {
+		return nil
+	}
+	return refundTx(ctx, ObservedTx{Tx: msg.Tx}, mgr, CodeLimitOrderCancelled, "limit order cancelled", limitOrderRefundModule(msg.Tx))
+}
+
+// limitOrderRefundModule returns the module a native deposit was sent to, so
+// the refund comes out of the module that holds the funds
+func limitOrderRefundModule(tx common.Tx) string {
+	if !tx.Coins.IsEmpty() && tx.Coins[0].Asset.IsNative() {
+		return AsgardName
+	}
+	return ""
+}
+
+// closeLimitOrder removes a limit order from the order book without executing
+// the rest of it, refunds whatever hasn't been filled yet through the regular
+// refund path and emits an event
+func (ob *OrderBookVCUR) closeLimitOrder(ctx cosmos.Context, mgr Manager, msg MsgSwap, code uint32, reason string, cancelTxID common.TxID) error {
+	msg = ob.unfilledLimitOrder(ctx, msg)
+	if err := ob.k.RemoveOrderBookItem(ctx, msg.Tx.ID); err != nil {
+		return fmt.Errorf("fail to remove order book item: %w", err)
+	}
+	ob.k.RemoveLimitOrderFill(ctx, msg.Tx.ID)
+	if !msg.Tx.Coins.IsEmpty() {
+		if err := refundTx(ctx, ObservedTx{Tx: msg.Tx}, mgr, code, fmt.Sprintf("limit order %s", reason), limitOrderRefundModule(msg.Tx)); err != nil {
+			return fmt.Errorf("fail to refund limit order: %w", err)
+		}
+	}
+	evt := NewEventLimitOrderClose(msg, reason, cancelTxID)
+	if err := mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+		ctx.Logger().Error("fail to emit limit order close event", "error", err)
+	}
+	return nil
+}
+
+// unfilledLimitOrder returns the limit order as it is stored in the order book,
+// reduced to the part of its deposit and trade target that hasn't been filled
+// yet. The given message may be a slice of the order.
+func (ob *OrderBookVCUR) unfilledLimitOrder(ctx cosmos.Context, msg MsgSwap) MsgSwap {
+	order, err := ob.k.GetOrderBookItem(ctx, msg.Tx.ID)
+	if err != nil {
+		ctx.Logger().Error("fail to fetch limit order", "hash", msg.Tx.ID, "error", err)
+		return msg
+	}
+	fill, err := ob.k.GetLimitOrderFill(ctx, msg.Tx.ID)
+	if err != nil {
+		ctx.Logger().Error("fail to fetch limit order fill", "hash", msg.Tx.ID, "error", err)
+		return order
+	}
+	deposit := order.Tx.Coins[0]
+	remaining := fill.Remaining(deposit.Amount)
+	order.TradeTarget = common.GetSafeShare(remaining, deposit.Amount, order.TradeTarget)
+	order.Tx.Coins = common.Coins{}
+	if !remaining.IsZero() {
+		deposit.Amount = remaining
+		order.Tx.Coins = common.Coins{deposit}
+	}
+	return order
+}
+
+// recordLimitOrderFill adds an executed slice of a limit order to the fill
+// record of the order and emits a fill event. The swap handler has already
+// added the emitted amount, prev is the fill record from before the slice was
+// swapped. Returns true once the whole deposit of the order has been filled.
+func (ob *OrderBookVCUR) recordLimitOrderFill(ctx cosmos.Context, mgr Manager, msg MsgSwap, prev LimitOrderFill) bool {
+	order, err := ob.k.GetOrderBookItem(ctx, msg.Tx.ID)
+	if err != nil {
+		ctx.Logger().Error("fail to fetch limit order", "hash", msg.Tx.ID, "error", err)
+		return true
+	}
+	fill, err := ob.k.GetLimitOrderFill(ctx, msg.Tx.ID)
+	if err != nil {
+		ctx.Logger().Error("fail to fetch limit order fill", "hash", msg.Tx.ID, "error", err)
+		return true
+	}
+	in := msg.Tx.Coins[0]
+	out := common.NewCoin(msg.TargetAsset, common.SafeSub(fill.Out, prev.Out))
+	fill.In = fill.In.Add(in.Amount)
+	fill.Count++
+	fill.LastHeight = ctx.BlockHeight()
+
+	deposit := order.Tx.Coins[0].Amount
+	done := fill.IsDone(deposit)
+	if done {
+		ob.k.RemoveLimitOrderFill(ctx, msg.Tx.ID)
+	} else {
+		ob.k.SetLimitOrderFill(ctx, fill)
+	}
+
+	evt := NewEventLimitOrderFill(msg.Tx.ID, in, out, fill, fill.Remaining(deposit))
+	if err := mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+		ctx.Logger().Error("fail to emit limit order fill event", "error", err)
+	}
+	return done
+}
+
+// expireLimitOrders refunds every limit order that reached its expiry height
+// without being executed
+func (ob *OrderBookVCUR) expireLimitOrders(ctx cosmos.Context, mgr Manager) {
+	hashes, err := ob.k.GetOrderBookExpiredItems(ctx, ctx.BlockHeight())
+	if err != nil {
+		ctx.Logger().Error("fail to get expired limit orders", "error", err)
+		return
+	}
+	for _, hash := range hashes {
+		msg, err := ob.k.GetOrderBookItem(ctx, hash)
+		if err != nil {
+			ctx.Logger().Error("fail to fetch expired limit order", "hash", hash, "error", err)
+			continue
+		}
+		if err := ob.closeLimitOrder(ctx, mgr, msg, CodeLimitOrderExpired, LimitOrderCloseReasonExpired, ""); err != nil {
+			ctx.Logger().Error("fail to expire limit order", "hash", hash, "error", err)
+		}
+	}
+}
+
 // EndBlock trigger the real swap to be processed
 func (ob *OrderBookVCUR) EndBlock(ctx cosmos.Context, mgr Manager) error {
 	handler := NewInternalHandler(mgr)
@@ -486,10 +687,13 @@ func (ob *OrderBookVCUR) EndBlock(ctx cosmos.Context, mgr Manager) error {
 		return err
 	}
 
-	// pull new limit orders added this block (if not already added)
+	// pull new limit orders added this block (if not already added, or
+	// cancelled since)
 	for _, item := range ob.limitOrders {
-		if !swaps.HasItem(item.msg.Tx.ID) {
-			swaps = append(swaps, item)
+		if !swaps.HasItem(item.msg.Tx.ID) && ob.k.HasOrderBookItem(ctx, item.msg.Tx.ID) {
+			if item, ok := ob.getLimitOrderItem(ctx, pools, item.msg, item.index); ok {
+				swaps = append(swaps, item)
+			}
 		}
 	}
 	ob.limitOrders = make(orderItems, 0)
@@ -540,7 +744,19 @@ func (ob *OrderBookVCUR) EndBlock(ctx cosmos.Context, mgr Manager) error {
 			}
 		}
 
+		// the swap handler adds the emitted amount of a limit order slice to
+		// its fill record
+		var prevFill LimitOrderFill
+		if pick.msg.IsLimitOrder() {
+			prevFill, err = ob.k.GetLimitOrderFill(ctx, pick.msg.Tx.ID)
+			if err != nil {
+				ctx.Logger().Error("fail to fetch limit order fill", "msg", pick.msg.Tx.String(), "error", err)
+				continue
+			}
+		}
+
 		// make the primary swap
+		keep := false
 		_, err := handler(ctx, &msg)
 		if err != nil {
 			switch pick.msg.OrderType {
@@ -552,13 +768,19 @@ func (ob *OrderBookVCUR) EndBlock(ctx cosmos.Context, mgr Manager) error {
 				if strings.Contains(err.Error(), "less than price limit") || strings.Contains(err.Error(), "outbound amount does not meet requirements") {
 					continue
 				}
-				refund(pick.msg, err)
+				// refund the part of the order that hasn't been filled yet
+				refund(ob.unfilledLimitOrder(ctx, pick.msg), err)
+				ob.k.RemoveLimitOrderFill(ctx, pick.msg.Tx.ID)
 			default:
 				// non-supported order book item, refund
 				refund(pick.msg, err)
 			}
 		} else {
 			todo = todo.findMatchingTrades(genTradePair(msg.Tx.Coins[0].Asset, msg.TargetAsset), pairs)
+			// a partially filled limit order stays in the order book
+			if pick.msg.IsLimitOrder() {
+				keep = !ob.recordLimitOrderFill(ctx, mgr, pick.msg, prevFill)
+			}
 			if !affiliateSwap.Tx.IsEmpty() {
 				// if asset sent in is native rune, no need
 				if affiliateSwap.Tx.Coins[0].Asset.IsNativeBase() {
@@ -582,11 +804,17 @@ func (ob *OrderBookVCUR) EndBlock(ctx cosmos.Context, mgr Manager) error {
 				}
 			}
 		}
+		if keep {
+			continue
+		}
 		if err := ob.k.RemoveOrderBookItem(ctx, pick.msg.Tx.ID); err != nil {
 			ctx.Logger().Error("fail to remove order book item", "msg", pick.msg.Tx.String(), "error", err)
 		}
 	}
 
+	// orders get one last chance to execute at their expiry height
+	ob.expireLimitOrders(ctx, mgr)
+
 	if err := ob.k.SetOrderBookProcessor(ctx, ob.convertAssetArraysToProc(todo, pairs)); err != nil {
 		ctx.Logger().Error("fail to set book processor", "error", err)
 	}
@@ -1,8 +1,10 @@
 package mayachain
 
 import (
+	"errors"
 	"fmt"
 
+	"github.com/blang/semver"
 	. "gopkg.in/check.v1"
 
 	"gitlab.com/mayachain/mayanode/common"
@@ -494,3 +496,189 @@ func (s OrderBookVCURSuite) TestEndBlock(c *C) {
 	c.Assert(err, IsNil)
 	c.Check(proc, DeepEquals, []bool{false, true, true, true, false, false}, Commentf("%+v", proc))
 }
+
+func (s OrderBookVCURSuite) TestCancelAndExpireLimitOrders(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	ctx = ctx.WithBlockHeight(100)
+	mgr.txOutStore = NewTxStoreDummy()
+	book := newOrderBookVCUR(mgr.Keeper())
+
+	pool := NewPool()
+	pool.Asset = common.BTCAsset
+	pool.BalanceAsset = cosmos.NewUint(97645470445)
+	pool.BalanceCacao = cosmos.NewUint(798072095218642)
+	pool.Status = PoolAvailable
+	c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+
+	btcAddr := GetRandomBTCAddress()
+	newLimitOrder := func(expiry int64) MsgSwap {
+		tx := GetRandomTx()
+		tx.Chain = common.BTCChain
+		tx.FromAddress = btcAddr
+		tx.Coins = common.NewCoins(common.NewCoin(common.BTCAsset, cosmos.NewUint(1*common.One)))
+		msg := NewMsgSwap(
+			tx, common.BaseAsset(), GetRandomBaseAddress(), cosmos.NewUint(1_000_000*common.One),
+			common.NoAddress, cosmos.ZeroUint(),
+			"", "", nil,
+			LimitOrder,
+			0, 0,
+			GetRandomBech32Addr())
+		msg.ExpiryHeight = expiry
+		return *msg
+	}
+
+	// expiry height already passed
+	c.Check(book.AddOrderBookItem(ctx, newLimitOrder(99)), NotNil)
+
+	// cancel a limit order
+	order := newLimitOrder(0)
+	c.Assert(book.AddOrderBookItem(ctx, order), IsNil)
+	c.Check(mgr.Keeper().HasOrderBookItem(ctx, order.Tx.ID), Equals, true)
+
+	cancelTx := GetRandomTx()
+	cancelTx.Chain = common.BTCChain
+	cancelTx.FromAddress = GetRandomBTCAddress()
+	cancelTx.Coins = common.NewCoins(common.NewCoin(common.BTCAsset, cosmos.NewUint(10000)))
+	cancel := NewMsgSwapCancel(cancelTx, order.Tx.ID, GetRandomBech32Addr())
+
+	// only the sender can cancel
+	c.Check(book.CancelOrderBookItem(ctx, mgr, *cancel), NotNil)
+	c.Check(mgr.Keeper().HasOrderBookItem(ctx, order.Tx.ID), Equals, true)
+
+	// unknown order
+	cancel.Tx.FromAddress = btcAddr
+	cancel.CancelTxID = GetRandomTxHash()
+	c.Check(book.CancelOrderBookItem(ctx, mgr, *cancel), NotNil)
+
+	cancel.CancelTxID = order.Tx.ID
+	c.Assert(book.CancelOrderBookItem(ctx, mgr, *cancel), IsNil)
+	c.Check(mgr.Keeper().HasOrderBookItem(ctx, order.Tx.ID), Equals, false)
+	items, err := mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 2) // the order and the cancel tx are both refunded
+	c.Check(items[0].InHash.Equals(order.Tx.ID), Equals, true)
+	c.Check(items[0].ToAddress.Equals(btcAddr), Equals, true)
+	c.Check(items[1].InHash.Equals(cancelTx.ID), Equals, true)
+
+	// cancelled orders can't be cancelled again
+	c.Check(book.CancelOrderBookItem(ctx, mgr, *cancel), NotNil)
+
+	// expire a limit order
+	mgr.txOutStore = NewTxStoreDummy()
+	order = newLimitOrder(105)
+	c.Assert(book.AddOrderBookItem(ctx, order), IsNil)
+
+	ctx = ctx.WithBlockHeight(104)
+	book.expireLimitOrders(ctx, mgr)
+	c.Check(mgr.Keeper().HasOrderBookItem(ctx, order.Tx.ID), Equals, true)
+
+	ctx = ctx.WithBlockHeight(105)
+	c.Assert(book.EndBlock(ctx, mgr), IsNil)
+	c.Check(mgr.Keeper().HasOrderBookItem(ctx, order.Tx.ID), Equals, false)
+	items, err = mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].InHash.Equals(order.Tx.ID), Equals, true)
+	hashes, err := mgr.Keeper().GetOrderBookExpiredItems(ctx, ctx.BlockHeight())
+	c.Assert(err, IsNil)
+	c.Check(hashes, HasLen, 0)
+}
+
+func (s OrderBookVCURSuite) TestPartialFillLimitOrder(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	mgr.txOutStore = NewTxStoreDummy()
+	book := newOrderBookVCUR(mgr.Keeper())
+
+	pool := NewPool()
+	pool.Asset = common.BTCAsset
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	pool.BalanceCacao = cosmos.NewUint(100_000 * common.One)
+	pool.Status = PoolAvailable
+	c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+
+	// the whole order would slip far past its price, while the first ~2.6 BTC
+	// are able to fill
+	btcAddr := GetRandomBTCAddress()
+	tx := GetRandomTx()
+	tx.Chain = common.BTCChain
+	tx.FromAddress = btcAddr
+	tx.Coins = common.NewCoins(common.NewCoin(common.BTCAsset, cosmos.NewUint(20*common.One)))
+	msg := NewMsgSwap(
+		tx, common.BaseAsset(), GetRandomBaseAddress(), cosmos.NewUint(19_000*common.One),
+		common.NoAddress, cosmos.ZeroUint(),
+		"", "", nil,
+		LimitOrder,
+		0, 0,
+		GetRandomBech32Addr())
+	c.Assert(mgr.Keeper().SetOrderBookItem(ctx, *msg), IsNil)
+
+	// slices below the minimum fill are skipped
+	_, pools := book.getAssetPairs(ctx)
+	c.Check(book.getLimitOrderFillAmount(ctx, pools, *msg, cosmos.NewUint(20*common.One)).Uint64(), Equals, uint64(259_783_520))
+	mgr.Keeper().SetMimir(ctx, constants.LimitOrderMinFillBasisPoints.String(), 2000)
+	c.Check(book.getLimitOrderFillAmount(ctx, pools, *msg, cosmos.NewUint(20*common.One)).IsZero(), Equals, true)
+	mgr.Keeper().SetMimir(ctx, constants.LimitOrderMinFillBasisPoints.String(), 1000)
+
+	c.Assert(mgr.Keeper().SetOrderBookProcessor(ctx, []bool{true, true}), IsNil)
+	c.Assert(book.EndBlock(ctx, mgr), IsNil)
+
+	// the order stays in the book with the unfilled remainder
+	c.Check(mgr.Keeper().HasOrderBookItem(ctx, msg.Tx.ID), Equals, true)
+	fill, err := mgr.Keeper().GetLimitOrderFill(ctx, msg.Tx.ID)
+	c.Assert(err, IsNil)
+	c.Check(fill.In.Uint64(), Equals, uint64(259_783_520))
+	c.Check(fill.Out.GT(common.GetSafeShare(fill.In, cosmos.NewUint(20*common.One), cosmos.NewUint(19_000*common.One))), Equals, true)
+	c.Check(fill.Count, Equals, uint64(1))
+	c.Check(fill.LastHeight, Equals, ctx.BlockHeight())
+
+	found := false
+	for _, evt := range ctx.EventManager().Events() {
+		if evt.Type == "limit_order_fill" {
+			found = true
+		}
+	}
+	c.Check(found, Equals, true)
+
+	// cancelling refunds the unfilled remainder only
+	cancelTx := GetRandomTx()
+	cancelTx.Chain = common.BTCChain
+	cancelTx.FromAddress = btcAddr
+	cancel := NewMsgSwapCancel(cancelTx, msg.Tx.ID, GetRandomBech32Addr())
+	mgr.txOutStore = NewTxStoreDummy()
+	c.Assert(book.CancelOrderBookItem(ctx, mgr, *cancel), IsNil)
+	c.Check(mgr.Keeper().HasOrderBookItem(ctx, msg.Tx.ID), Equals, false)
+	c.Check(mgr.Keeper().LimitOrderFillExists(ctx, msg.Tx.ID), Equals, false)
+	items, err := mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].InHash.Equals(msg.Tx.ID), Equals, true)
+	c.Check(items[0].Coin.Amount.Uint64(), Equals, uint64(20*common.One-259_783_520))
+}
+
+func (s OrderBookVCURSuite) TestLimitOrderRefundModule(c *C) {
+	tx := GetRandomTx()
+	tx.Coins = common.NewCoins(common.NewCoin(common.BTCAsset, cosmos.NewUint(common.One)))
+	c.Check(limitOrderRefundModule(tx), Equals, "")
+
+	tx.Chain = common.BASEChain
+	tx.Coins = common.NewCoins(common.NewCoin(common.BaseNative, cosmos.NewUint(common.One)))
+	c.Check(limitOrderRefundModule(tx), Equals, AsgardName)
+
+	tx.Coins = common.Coins{}
+	c.Check(limitOrderRefundModule(tx), Equals, "")
+}
+
+func (s OrderBookVCURSuite) TestGetOrderBook(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+
+	book, err := GetOrderBook(semver.MustParse("1.123.0"), mgr.Keeper())
+	c.Assert(err, IsNil)
+	_, ok := book.(*OrderBookV123)
+	c.Check(ok, Equals, true)
+	c.Check(errors.Is(book.CancelOrderBookItem(ctx, mgr, MsgSwap{}), errBadVersion), Equals, true)
+
+	book, err = GetOrderBook(semver.MustParse("1.124.0"), mgr.Keeper())
+	c.Assert(err, IsNil)
+	_, ok = book.(*OrderBookVCUR)
+	c.Check(ok, Equals, true)
+}
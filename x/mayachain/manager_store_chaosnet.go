@@ -4496,3 +4496,13 @@ func migrateStoreV123(ctx cosmos.Context, mgr *Mgrs) {
 	migrateStoreV123RequeueDanglingActions(ctx, mgr)
 	migrateStoreV123FixInsolvency(ctx, mgr)
 }
+
+func migrateStoreV124(ctx cosmos.Context, mgr *Mgrs) {
+	defer func() {
+		if err := recover(); err != nil {
+			ctx.Logger().Error("fail to migrate store to v124", "error", err)
+		}
+	}()
+
+	indexMAYANames(ctx, mgr)
+}
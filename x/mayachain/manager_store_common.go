@@ -668,3 +668,23 @@ func fixInsolvency(ctx cosmos.Context, mgr *Mgrs, vault *Vault, asset common.Ass
 		return
 	}
 }
+
+// indexMAYANames saves every mayaname again, so the keeper builds the owner
+// and alias indexes of the names registered before they existed
+func indexMAYANames(ctx cosmos.Context, mgr Manager) {
+	var names []MAYAName
+	iter := mgr.Keeper().GetMAYANameIterator(ctx)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var name MAYAName
+		if err := mgr.Keeper().Cdc().Unmarshal(iter.Value(), &name); err != nil {
+			ctx.Logger().Error("fail to unmarshal mayaname", "error", err)
+			continue
+		}
+		names = append(names, name)
+	}
+	for _, name := range names {
+		mgr.Keeper().SetMAYAName(ctx, name)
+	}
+	ctx.Logger().Info("indexed mayanames", "count", len(names))
+}
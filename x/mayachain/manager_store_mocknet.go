@@ -53,3 +53,13 @@ func migrateStoreV120(ctx cosmos.Context, mgr Manager) {}
 func migrateStoreV121(ctx cosmos.Context, mgr Manager) {}
 func migrateStoreV122(ctx cosmos.Context, mgr Manager) {}
 func migrateStoreV123(ctx cosmos.Context, mgr Manager) {}
+
+func migrateStoreV124(ctx cosmos.Context, mgr Manager) {
+	defer func() {
+		if err := recover(); err != nil {
+			ctx.Logger().Error("fail to migrate store to v124", "error", err)
+		}
+	}()
+
+	indexMAYANames(ctx, mgr)
+}
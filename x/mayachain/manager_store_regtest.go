@@ -1145,3 +1145,13 @@ func migrateStoreV123(ctx cosmos.Context, mgr *Mgrs) {
 
 	migrateStoreV123FixInsolvency(ctx, mgr)
 }
+
+func migrateStoreV124(ctx cosmos.Context, mgr *Mgrs) {
+	defer func() {
+		if err := recover(); err != nil {
+			ctx.Logger().Error("fail to migrate store to v124", "error", err)
+		}
+	}()
+
+	indexMAYANames(ctx, mgr)
+}
@@ -254,3 +254,13 @@ func migrateStoreV121(ctx cosmos.Context, mgr *Mgrs) {
 // migrateStoreV122 is an empty migration for stagenet
 func migrateStoreV122(ctx cosmos.Context, mgr *Mgrs) {}
 func migrateStoreV123(ctx cosmos.Context, mgr *Mgrs) {}
+
+func migrateStoreV124(ctx cosmos.Context, mgr *Mgrs) {
+	defer func() {
+		if err := recover(); err != nil {
+			ctx.Logger().Error("fail to migrate store to v124", "error", err)
+		}
+	}()
+
+	indexMAYANames(ctx, mgr)
+}
@@ -1,6 +1,7 @@
 package mayachain
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -118,6 +119,16 @@ func (vm *SwapQueueVCUR) EndBlock(ctx cosmos.Context, mgr Manager) error {
 		// grab swp BEFORE a streaming swap modified the msg.Tx.Coins[0].Amount
 		// value. This is used later to refund the correct amount
 		swp := pick.msg.GetStreamingSwap()
+		// a dca slice event reports what the slice added to the order
+		prev := swp
+		if pick.msg.IsDCA() && vm.k.StreamingSwapExists(ctx, pick.msg.Tx.ID) {
+			var getErr error
+			prev, getErr = vm.k.GetStreamingSwap(ctx, pick.msg.Tx.ID)
+			if getErr != nil {
+				ctx.Logger().Error("fail to fetch streaming swap", "error", getErr)
+				return getErr
+			}
+		}
 
 		triggerRefund := false
 		_, handleErr := handler(ctx, &pick.msg)
@@ -140,6 +151,11 @@ func (vm *SwapQueueVCUR) EndBlock(ctx cosmos.Context, mgr Manager) error {
 				// if we haven't made any swaps yet, its safe to do a regular
 				// refund. Otherwise allow later code to do partial refunds
 				triggerRefund = swp.In.IsZero() && swp.Out.IsZero()
+				// a dca slice that misses its price limit is skipped, the
+				// deposit stays parked for the following slices
+				if pick.msg.IsDCA() && strings.Contains(handleErr.Error(), "less than price limit") {
+					triggerRefund = false
+				}
 				if triggerRefund {
 					// revert the tx amount to the be original deposit amount
 					pick.msg.Tx.Coins[0].Amount = swp.Deposit
@@ -187,6 +203,11 @@ func (vm *SwapQueueVCUR) EndBlock(ctx cosmos.Context, mgr Manager) error {
 				ctx.Logger().Error("fail to fetch streaming swap", "error", err)
 				return err
 			}
+			// the failed first slice of a dca order has not stored its
+			// streaming swap yet
+			if pick.msg.IsDCA() && !triggerRefund && !vm.k.StreamingSwapExists(ctx, pick.msg.Tx.ID) {
+				swp = prev
+			}
 			swp.Count += 1
 			if handleErr != nil {
 				swp.FailedSwaps = append(swp.FailedSwaps, swp.Count)
@@ -196,63 +217,23 @@ func (vm *SwapQueueVCUR) EndBlock(ctx cosmos.Context, mgr Manager) error {
 			if !triggerRefund {
 				mgr.Keeper().SetStreamingSwap(ctx, swp)
 			}
+			if pick.msg.IsDCA() && !triggerRefund {
+				reason := ""
+				if handleErr != nil {
+					reason = handleErr.Error()
+				}
+				in := common.SafeSub(swp.In, prev.In)
+				out := common.SafeSub(swp.Out, prev.Out)
+				if err := mgr.EventMgr().EmitEvent(ctx, NewEventDCASlice(pick.msg, swp, in, out, reason)); err != nil {
+					ctx.Logger().Error("fail to emit dca slice event", "error", err)
+				}
+			}
 			if swp.Valid() == nil && swp.IsDone() {
 				vm.k.RemoveSwapQueueItem(ctx, pick.msg.Tx.ID, pick.index)
 				vm.k.RemoveStreamingSwap(ctx, pick.msg.Tx.ID)
-
-				memo, err := ParseMemoWithMAYANames(ctx, vm.k, pick.msg.Tx.Memo)
-				if err != nil {
+				if err := vm.settleStreamingSwap(ctx, mgr, pick.msg, swp, handleErr); err != nil {
 					return err
 				}
-				isSaversAdd := memo.IsType(TxAdd)
-
-				// If this is a savers add skip scheduling outbound
-				if !swp.Out.IsZero() && !isSaversAdd {
-					dexAgg := ""
-					if len(pick.msg.Aggregator) > 0 {
-						dexAgg, err = FetchDexAggregator(
-							mgr.GetVersion(),
-							pick.msg.TargetAsset.GetChain(),
-							pick.msg.Aggregator,
-						)
-						if err != nil {
-							return err
-						}
-					}
-					dexAggTargetAsset := pick.msg.AggregatorTargetAddress
-
-					toi := TxOutItem{
-						Chain:                 pick.msg.TargetAsset.GetChain(),
-						InHash:                pick.msg.Tx.ID,
-						ToAddress:             pick.msg.Destination,
-						Coin:                  common.NewCoin(pick.msg.TargetAsset, swp.Out),
-						Aggregator:            dexAgg,
-						AggregatorTargetAsset: dexAggTargetAsset,
-						AggregatorTargetLimit: pick.msg.AggregatorTargetLimit,
-					}
-
-					if _, err := mgr.TxOutStore().TryAddTxOutItem(ctx, mgr, toi, cosmos.ZeroUint()); err != nil {
-						ctx.Logger().Error("fail streaming swap outbound", "error", err)
-						unrefundableCoinCleanup(ctx, mgr, toi, "failed_outbound")
-					}
-				}
-
-				if swp.Deposit.GT(swp.In) {
-					remainder := common.SafeSub(swp.Deposit, swp.In)
-					source := pick.msg.Tx.Coins[0].Asset
-					refundCoin := common.NewCoin(source, remainder)
-					refundCoinTx := pick.msg.Tx
-					refundCoinTx.Coins = common.NewCoins(refundCoin)
-					// As this is a streaming swap's partial refund, the vault context may have changed, so do vault selection.
-					if refundErr := refundTx(ctx, ObservedTx{Tx: refundCoinTx}, mgr, CodeSwapFail, handleErr.Error(), ""); refundErr != nil {
-						ctx.Logger().Error("fail to partial-refund swap", "error", refundErr)
-					}
-				}
-
-				evt := NewEventStreamingSwap(pick.msg.Tx.Coins[0].Asset, pick.msg.TargetAsset, swp)
-				if err := mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
-					ctx.Logger().Error("fail to emit streaming swap event", "error", err)
-				}
 			}
 		} else {
 			vm.k.RemoveSwapQueueItem(ctx, pick.msg.Tx.ID, pick.index)
@@ -261,6 +242,142 @@ func (vm *SwapQueueVCUR) EndBlock(ctx cosmos.Context, mgr Manager) error {
 	return nil
 }
 
+// settleStreamingSwap sends out what a finished streaming swap has swapped so
+// far, and refunds the part of the deposit it has not swapped
+func (vm *SwapQueueVCUR) settleStreamingSwap(ctx cosmos.Context, mgr Manager, msg MsgSwap, swp StreamingSwap, handleErr error) error {
+	memo, err := ParseMemoWithMAYANames(ctx, vm.k, msg.Tx.Memo)
+	if err != nil {
+		return err
+	}
+	isSaversAdd := memo.IsType(TxAdd)
+
+	// If this is a savers add skip scheduling outbound
+	if !swp.Out.IsZero() && !isSaversAdd {
+		dexAgg := ""
+		if len(msg.Aggregator) > 0 {
+			dexAgg, err = FetchDexAggregator(
+				mgr.GetVersion(),
+				msg.TargetAsset.GetChain(),
+				msg.Aggregator,
+			)
+			if err != nil {
+				return err
+			}
+		}
+		dexAggTargetAsset := msg.AggregatorTargetAddress
+
+		toi := TxOutItem{
+			Chain:                 msg.TargetAsset.GetChain(),
+			InHash:                msg.Tx.ID,
+			ToAddress:             msg.Destination,
+			Coin:                  common.NewCoin(msg.TargetAsset, swp.Out),
+			Aggregator:            dexAgg,
+			AggregatorTargetAsset: dexAggTargetAsset,
+			AggregatorTargetLimit: msg.AggregatorTargetLimit,
+		}
+
+		if _, err := mgr.TxOutStore().TryAddTxOutItem(ctx, mgr, toi, cosmos.ZeroUint()); err != nil {
+			ctx.Logger().Error("fail streaming swap outbound", "error", err)
+			unrefundableCoinCleanup(ctx, mgr, toi, "failed_outbound")
+		}
+	}
+
+	if swp.Deposit.GT(swp.In) {
+		remainder := common.SafeSub(swp.Deposit, swp.In)
+		source := msg.Tx.Coins[0].Asset
+		refundCoin := common.NewCoin(source, remainder)
+		refundCoinTx := msg.Tx
+		refundCoinTx.Coins = common.NewCoins(refundCoin)
+		code := CodeSwapFail
+		if errors.Is(handleErr, errStreamingSwapCancelled) {
+			code = CodeStreamingSwapCancelled
+		}
+		// As this is a streaming swap's partial refund, the vault context may have changed, so do vault selection.
+		if refundErr := refundTx(ctx, ObservedTx{Tx: refundCoinTx}, mgr, code, handleErr.Error(), ""); refundErr != nil {
+			ctx.Logger().Error("fail to partial-refund swap", "error", refundErr)
+		}
+	}
+
+	evt := NewEventStreamingSwap(msg.Tx.Coins[0].Asset, msg.TargetAsset, swp)
+	if err := mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+		ctx.Logger().Error("fail to emit streaming swap event", "error", err)
+	}
+	return nil
+}
+
+// getStreamingSwapQueueItem finds the queued streaming swap with the given tx
+// id, along with its index in the swap queue
+func (vm *SwapQueueVCUR) getStreamingSwapQueueItem(ctx cosmos.Context, txID common.TxID) (MsgSwap, int, error) {
+	iterator := vm.k.GetSwapQueueIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var msg MsgSwap
+		if err := vm.k.Cdc().Unmarshal(iterator.Value(), &msg); err != nil {
+			ctx.Logger().Error("fail to fetch swap msg from queue", "error", err)
+			continue
+		}
+		if !msg.Tx.ID.Equals(txID) || !msg.IsStreaming() {
+			continue
+		}
+
+		ss := strings.Split(string(iterator.Key()), "-")
+		i, err := strconv.Atoi(ss[len(ss)-1])
+		if err != nil {
+			return MsgSwap{}, 0, fmt.Errorf("fail to parse swap queue msg index: %w", err)
+		}
+		return msg, i, nil
+	}
+	return MsgSwap{}, 0, fmt.Errorf("streaming swap (%s) not found", txID)
+}
+
+// CancelStreamingSwap stops the streaming swap the given message cancels
+// before it reaches its quantity. The portion swapped so far goes out to the
+// destination, and the unswapped deposit is refunded. Only the sender of the
+// streaming swap is able to cancel it. Whatever was sent along with the
+// cancellation is refunded as well.
+func (vm *SwapQueueVCUR) CancelStreamingSwap(ctx cosmos.Context, mgr Manager, msg MsgSwap) error {
+	if !msg.IsCancel() {
+		return fmt.Errorf("message does not cancel a swap")
+	}
+	item, index, err := vm.getStreamingSwapQueueItem(ctx, msg.CancelTxID)
+	if err != nil {
+		return err
+	}
+	if !item.Tx.FromAddress.Equals(msg.Tx.FromAddress) {
+		return fmt.Errorf("only the sender of the streaming swap can cancel it")
+	}
+	// internal streaming swaps (savers, loans) are settled by their own flows
+	memo, err := ParseMemoWithMAYANames(ctx, vm.k, item.Tx.Memo)
+	if err != nil || !(memo.IsType(TxSwap) || memo.IsType(TxDCA)) {
+		return fmt.Errorf("only streaming swaps and dca orders can be cancelled")
+	}
+
+	// the first sub-swap may not have happened yet
+	swp := item.GetStreamingSwap()
+	if vm.k.StreamingSwapExists(ctx, item.Tx.ID) {
+		swp, err = vm.k.GetStreamingSwap(ctx, item.Tx.ID)
+		if err != nil {
+			return fmt.Errorf("fail to fetch streaming swap: %w", err)
+		}
+	}
+
+	vm.k.RemoveSwapQueueItem(ctx, item.Tx.ID, index)
+	vm.k.RemoveStreamingSwap(ctx, item.Tx.ID)
+	if err = vm.settleStreamingSwap(ctx, mgr, item, swp, errStreamingSwapCancelled); err != nil {
+		return err
+	}
+
+	evt := NewEventStreamingSwapCancel(item, swp, msg.Tx.ID)
+	if err = mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+		ctx.Logger().Error("fail to emit streaming swap cancel event", "error", err)
+	}
+
+	if msg.Tx.Coins.IsEmpty() {
+		return nil
+	}
+	return refundTx(ctx, ObservedTx{Tx: msg.Tx}, mgr, CodeStreamingSwapCancelled, errStreamingSwapCancelled.Error(), "")
+}
+
 func (vm *SwapQueueVCUR) cleanupFailedPreferredAssetSwap(ctx cosmos.Context, mgr Manager, memo string, cacaoAmt cosmos.Uint) error {
 	ctx.Logger().Info("preferred asset swap failed, send cacao back to affiliate collector", "runeAmt", cacaoAmt.String(), "memo", memo)
 	// get the preferred asset swap's mayaname
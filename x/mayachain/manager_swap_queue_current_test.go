@@ -1,10 +1,13 @@
 package mayachain
 
 import (
+	"fmt"
+
 	. "gopkg.in/check.v1"
 
 	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/constants"
 	"gitlab.com/mayachain/mayanode/x/mayachain/keeper"
 )
 
@@ -332,3 +335,173 @@ func (s SwapQueueVCURSuite) TestStreamingSwapSelection(c *C) {
 	c.Assert(err, IsNil)
 	c.Check(items, HasLen, 1)
 }
+
+func (s SwapQueueVCURSuite) TestCancelStreamingSwap(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	k := mgr.Keeper()
+	queue := newSwapQueueVCUR(k)
+
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceCacao = cosmos.NewUint(10000 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(10000 * common.One)
+	pool.LPUnits = cosmos.NewUint(10000 * common.One)
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+	vault := GetRandomVault()
+	vault.Coins = common.NewCoins(common.NewCoin(common.BNBAsset, cosmos.NewUint(10000*common.One)))
+	c.Assert(k.SetVault(ctx, vault), IsNil)
+	out := common.NewCoin(common.BaseAsset(), cosmos.NewUint(40*common.One))
+	c.Assert(k.MintToModule(ctx, ModuleName, out), IsNil)
+	c.Assert(k.SendFromModuleToModule(ctx, ModuleName, AsgardName, common.NewCoins(out)), IsNil)
+
+	vaultAddr, err := vault.GetAddress(common.BNBChain)
+	c.Assert(err, IsNil)
+	sender := GetRandomBNBAddress()
+	destination := GetRandomBaseAddress()
+	tx := common.NewTx(
+		GetRandomTxHash(),
+		sender,
+		vaultAddr,
+		common.NewCoins(common.NewCoin(common.BNBAsset, cosmos.NewUint(100*common.One))),
+		BNBGasFeeSingleton,
+		fmt.Sprintf("=:MAYA.CACAO:%s:0/1/10", destination),
+	)
+	msg := NewMsgSwap(tx, common.BaseAsset(), destination, cosmos.ZeroUint(), common.NoAddress, cosmos.ZeroUint(), "", "", nil, MarketOrder, 10, 1, GetRandomBech32Addr())
+	c.Assert(k.SetSwapQueueItem(ctx, *msg, 0), IsNil)
+	swp := msg.GetStreamingSwap()
+	swp.Count = 4
+	swp.In = cosmos.NewUint(40 * common.One)
+	swp.Out = out.Amount
+	k.SetStreamingSwap(ctx, swp)
+
+	cancelTx := common.NewTx(
+		GetRandomTxHash(),
+		GetRandomBNBAddress(),
+		vaultAddr,
+		common.NewCoins(common.NewCoin(common.BNBAsset, cosmos.NewUint(common.One))),
+		BNBGasFeeSingleton,
+		"cancel:"+tx.ID.String(),
+	)
+	cancel := NewMsgSwapCancel(cancelTx, tx.ID, GetRandomBech32Addr())
+
+	// only the sender of the streaming swap is able to cancel it
+	c.Check(queue.CancelStreamingSwap(ctx, mgr, *cancel), NotNil)
+	c.Check(k.HasSwapQueueItem(ctx, tx.ID, 0), Equals, true)
+	c.Check(k.StreamingSwapExists(ctx, tx.ID), Equals, true)
+
+	// unknown streaming swap
+	unknown := NewMsgSwapCancel(cancelTx, GetRandomTxHash(), GetRandomBech32Addr())
+	c.Check(queue.CancelStreamingSwap(ctx, mgr, *unknown), NotNil)
+
+	cancel.Tx.FromAddress = sender
+	c.Assert(queue.CancelStreamingSwap(ctx, mgr, *cancel), IsNil)
+	c.Check(k.HasSwapQueueItem(ctx, tx.ID, 0), Equals, false)
+	c.Check(k.StreamingSwapExists(ctx, tx.ID), Equals, false)
+
+	// swapped portion went out to the destination
+	destAcc, err := destination.AccAddress()
+	c.Assert(err, IsNil)
+	c.Check(k.GetBalance(ctx, destAcc).AmountOf(common.BaseNative.Native()).IsZero(), Equals, false)
+
+	// unswapped deposit and the cancel tx coins are refunded to the sender
+	items, err := mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 2)
+	c.Check(items[0].ToAddress.Equals(sender), Equals, true)
+	c.Check(items[0].InHash.Equals(tx.ID), Equals, true)
+	c.Check(items[1].ToAddress.Equals(sender), Equals, true)
+	c.Check(items[1].InHash.Equals(cancelTx.ID), Equals, true)
+
+	found := false
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type == "streaming_swap_cancel" {
+			found = true
+		}
+	}
+	c.Check(found, Equals, true)
+
+	// a swap can only be cancelled once
+	c.Check(queue.CancelStreamingSwap(ctx, mgr, *cancel), NotNil)
+}
+
+func (s SwapQueueVCURSuite) TestDCAOrder(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	k := mgr.Keeper()
+	queue := newSwapQueueVCUR(k)
+
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceCacao = cosmos.NewUint(10000 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(10000 * common.One)
+	pool.LPUnits = cosmos.NewUint(10000 * common.One)
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+
+	destination := GetRandomBaseAddress()
+	tx := common.NewTx(
+		GetRandomTxHash(),
+		GetRandomBNBAddress(),
+		GetRandomBNBAddress(),
+		common.NewCoins(common.NewCoin(common.BNBAsset, cosmos.NewUint(100*common.One))),
+		BNBGasFeeSingleton,
+		fmt.Sprintf("dca:MAYA.CACAO:%s:3000000000/10/4", destination),
+	)
+	// every slice of 25 BNB needs to swap to at least 30 CACAO
+	msg := NewMsgSwap(tx, common.BaseAsset(), destination, cosmos.NewUint(30*common.One), common.NoAddress, cosmos.ZeroUint(), "", "", nil, DCAOrder, 4, 10, GetRandomBech32Addr())
+
+	// dca orders are disabled by default
+	c.Check(addSwapV124(ctx, mgr, *msg), NotNil)
+	k.SetMimir(ctx, constants.DCAOrdersEnabled.String(), 1)
+	// dca orders are checked against their own limits
+	k.SetMimir(ctx, constants.DCAMaxQuantity.String(), 3)
+	c.Check(addSwapV124(ctx, mgr, *msg), NotNil)
+	k.SetMimir(ctx, constants.DCAMaxQuantity.String(), 4)
+	k.SetMimir(ctx, constants.DCAMaxLength.String(), 39)
+	c.Check(addSwapV124(ctx, mgr, *msg), NotNil)
+	k.SetMimir(ctx, constants.DCAMaxLength.String(), 40)
+	c.Assert(addSwapV124(ctx, mgr, *msg), IsNil)
+	c.Check(k.HasSwapQueueItem(ctx, tx.ID, 0), Equals, true)
+	c.Check(eventTypeEmitted(ctx, "dca_order"), Equals, true)
+
+	// the first slice misses its price limit, the deposit stays parked
+	c.Assert(queue.EndBlock(ctx, mgr), IsNil)
+	c.Check(k.HasSwapQueueItem(ctx, tx.ID, 0), Equals, true)
+	swp, err := k.GetStreamingSwap(ctx, tx.ID)
+	c.Assert(err, IsNil)
+	c.Check(swp.Count, Equals, uint64(1))
+	c.Check(swp.Interval, Equals, uint64(10))
+	c.Check(swp.Quantity, Equals, uint64(4))
+	c.Check(swp.In.IsZero(), Equals, true)
+	c.Check(swp.FailedSwaps, DeepEquals, []uint64{1})
+	c.Check(eventTypeEmitted(ctx, "dca_slice"), Equals, true)
+	items, err := mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Check(items, HasLen, 0)
+
+	// the next slice isn't due before the interval has passed
+	pool.BalanceCacao = cosmos.NewUint(20000 * common.One)
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	c.Assert(queue.EndBlock(ctx, mgr), IsNil)
+	swp, err = k.GetStreamingSwap(ctx, tx.ID)
+	c.Assert(err, IsNil)
+	c.Check(swp.Count, Equals, uint64(1))
+
+	// the price has moved, the second slice swaps
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	c.Assert(queue.EndBlock(ctx, mgr), IsNil)
+	swp, err = k.GetStreamingSwap(ctx, tx.ID)
+	c.Assert(err, IsNil)
+	c.Check(swp.Count, Equals, uint64(2))
+	c.Check(swp.In.Equal(cosmos.NewUint(25*common.One)), Equals, true)
+	c.Check(swp.Out.GT(cosmos.NewUint(30*common.One)), Equals, true)
+	c.Check(swp.FailedSwaps, DeepEquals, []uint64{1})
+}
+
+func eventTypeEmitted(ctx cosmos.Context, typ string) bool {
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type == typ {
+			return true
+		}
+	}
+	return false
+}
@@ -81,6 +81,11 @@ func (vm *SwapQueueV110) FetchQueue(ctx cosmos.Context, mgr Manager) (swapItems,
 	return items, nil
 }
 
+// CancelStreamingSwap is not supported by this version
+func (vm *SwapQueueV110) CancelStreamingSwap(ctx cosmos.Context, mgr Manager, msg MsgSwap) error {
+	return errBadVersion
+}
+
 // EndBlock trigger the real swap to be processed
 func (vm *SwapQueueV110) EndBlock(ctx cosmos.Context, mgr Manager) error {
 	handler := NewInternalHandler(mgr)
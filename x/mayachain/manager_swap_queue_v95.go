@@ -50,6 +50,11 @@ func (vm *SwapQueueV95) FetchQueue(ctx cosmos.Context) (swapItems, error) { // n
 	return items, nil
 }
 
+// CancelStreamingSwap is not supported by this version
+func (vm *SwapQueueV95) CancelStreamingSwap(ctx cosmos.Context, mgr Manager, msg MsgSwap) error {
+	return errBadVersion
+}
+
 // EndBlock trigger the real swap to be processed
 func (vm *SwapQueueV95) EndBlock(ctx cosmos.Context, mgr Manager) error {
 	handler := NewInternalHandler(mgr)
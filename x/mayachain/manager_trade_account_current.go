@@ -121,3 +121,37 @@ func (s *TradeMgrVCUR) Withdrawal(ctx cosmos.Context, asset common.Asset, amount
 
 	return tokensToClaim, nil
 }
+
+// Transfer moves the trade units backing amount of the asset from one trade
+// account to another. The depth and total units of the asset are unchanged.
+func (s *TradeMgrVCUR) Transfer(ctx cosmos.Context, asset common.Asset, amount cosmos.Uint, from, to cosmos.AccAddress, txID common.TxID) (cosmos.Uint, error) {
+	asset = asset.GetTradeAsset()
+	tu, err := s.keeper.GetTradeUnit(ctx, asset)
+	if err != nil {
+		return cosmos.ZeroUint(), err
+	}
+
+	tr, err := s.keeper.GetTradeAccount(ctx, from, asset)
+	if err != nil {
+		return cosmos.ZeroUint(), err
+	}
+
+	// same share calculation as a withdrawal, capped at the account's units
+	assetAvailable := common.GetSafeShare(tr.Units, tu.Units, tu.Depth)
+	unitsToMove := common.GetSafeShare(amount, assetAvailable, tr.Units)
+	tokensToMove := common.GetSafeShare(unitsToMove, tr.Units, assetAvailable)
+	if unitsToMove.IsZero() {
+		return cosmos.ZeroUint(), nil
+	}
+
+	if err := s.keeper.TransferTradeAccount(ctx, from, to, asset, unitsToMove); err != nil {
+		return cosmos.ZeroUint(), err
+	}
+
+	transferEvent := NewEventTradeAccountTransfer(tokensToMove, asset, common.Address(from.String()), common.Address(to.String()), unitsToMove, txID)
+	if err := s.eventMgr.EmitEvent(ctx, transferEvent); err != nil {
+		ctx.Logger().Error("fail to emit trade account transfer event", "error", err)
+	}
+
+	return tokensToMove, nil
+}
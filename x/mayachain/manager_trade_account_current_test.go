@@ -114,3 +114,41 @@ func (s *TradeManagerVCURSuite) TestReducedDepth(c *C) {
 	c.Assert(err, IsNil)
 	c.Check(tr.Units.String(), Equals, tu.Units.String())
 }
+
+func (s *TradeManagerVCURSuite) TestTransfer(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	eventMgr, err := GetEventManager(GetCurrentVersion())
+	c.Assert(err, IsNil)
+	mgr := newTradeMgrVCUR(k, eventMgr)
+
+	asset := common.BTCAsset.GetTradeAsset()
+	addr1 := GetRandomBech32Addr()
+	addr2 := GetRandomBech32Addr()
+
+	_, err = mgr.Deposit(ctx, asset, cosmos.NewUint(100*common.One), addr1, common.NoAddress, common.BlankTxID)
+	c.Assert(err, IsNil)
+
+	amt, err := mgr.Transfer(ctx, asset, cosmos.NewUint(40*common.One), addr1, addr2, common.BlankTxID)
+	c.Assert(err, IsNil)
+	c.Check(amt.String(), Equals, cosmos.NewUint(40*common.One).String())
+	c.Check(mgr.BalanceOf(ctx, asset, addr1).String(), Equals, cosmos.NewUint(60*common.One).String())
+	c.Check(mgr.BalanceOf(ctx, asset, addr2).String(), Equals, cosmos.NewUint(40*common.One).String())
+
+	// total units and depth are unchanged by a transfer
+	tu, err := k.GetTradeUnit(ctx, asset)
+	c.Assert(err, IsNil)
+	c.Check(tu.Depth.String(), Equals, cosmos.NewUint(100*common.One).String())
+	c.Check(tu.Units.String(), Equals, cosmos.NewUint(100*common.One).String())
+
+	// transfer more than the balance moves the whole balance
+	amt, err = mgr.Transfer(ctx, asset, cosmos.NewUint(100*common.One), addr2, addr1, common.BlankTxID)
+	c.Assert(err, IsNil)
+	c.Check(amt.String(), Equals, cosmos.NewUint(40*common.One).String())
+	c.Check(mgr.BalanceOf(ctx, asset, addr1).String(), Equals, cosmos.NewUint(100*common.One).String())
+	c.Check(mgr.BalanceOf(ctx, asset, addr2).String(), Equals, cosmos.ZeroUint().String())
+
+	// nothing left to transfer
+	amt, err = mgr.Transfer(ctx, asset, cosmos.NewUint(common.One), addr2, addr1, common.BlankTxID)
+	c.Assert(err, IsNil)
+	c.Check(amt.IsZero(), Equals, true)
+}
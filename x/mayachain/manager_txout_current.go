@@ -205,7 +205,7 @@ func (tos *TxOutStorageVCUR) takeAffiliateFee(ctx cosmos.Context, mgr Manager, t
 	}
 
 	// Only allow outbound affiliate fees for swaps that have an affiliate fee
-	if memo.IsType(TxSwap) && len(memo.GetAffiliatesBasisPoints()) > 0 {
+	if (memo.IsType(TxSwap) || memo.IsType(TxLimitOrder) || memo.IsType(TxDCA)) && len(memo.GetAffiliatesBasisPoints()) > 0 {
 		tx := common.Tx{
 			ID:          toi.InHash,
 			Chain:       toi.Chain,
@@ -240,6 +240,14 @@ func (tos *TxOutStorageVCUR) takeAffiliateFee(ctx cosmos.Context, mgr Manager, t
 // return bool indicate whether the transaction had been added successful or not
 // return error indicate error
 func (tos *TxOutStorageVCUR) cachedTryAddTxOutItem(ctx cosmos.Context, mgr Manager, toi TxOutItem, minOut cosmos.Uint) (bool, error) {
+	// the lane is classified once and carried by the outputs. Outbounds of the
+	// internal lanes are never delayed, when the chain's lane is full for this
+	// block they are left for the next round (ie the next migration round)
+	toi.Lane = getTxOutLane(ctx, tos.keeper, mgr.GetVersion(), toi)
+	if isInternalTxOutLane(toi.Lane) && isTxOutLaneFull(ctx, tos.keeper, mgr.GetVersion(), ctx.BlockHeight(), toi.Chain, toi.Lane) {
+		return false, nil
+	}
+
 	outputs, totalOutboundFeeCacao, err := tos.prepareTxOutItem(ctx, toi)
 	if err != nil {
 		return false, fmt.Errorf("fail to prepare outbound tx: %w", err)
@@ -335,6 +343,11 @@ func (tos *TxOutStorageVCUR) UnSafeAddTxOutItem(ctx cosmos.Context, mgr Manager,
 	if toi.ToAddress.IsNoop() {
 		return nil
 	}
+	// yggdrasil funds are left for the next funding round when the lane is full
+	memo, _ := ParseMemo(mgr.GetVersion(), toi.Memo) // ignore err
+	if memo.IsType(TxYggdrasilFund) && isTxOutLaneFull(ctx, tos.keeper, mgr.GetVersion(), height, toi.Chain, TxOutLaneYggdrasil) {
+		return ErrTxOutLaneFull
+	}
 	return tos.addToBlockOut(ctx, mgr, toi, height)
 }
 
@@ -748,6 +761,7 @@ func (tos *TxOutStorageVCUR) addToBlockOut(ctx cosmos.Context, mgr Manager, item
 	if err != nil {
 		ctx.Logger().Error("fail to get vault", "error", err)
 	}
+	item.Lane = getTxOutLane(ctx, tos.keeper, mgr.GetVersion(), item)
 	memo, _ := ParseMemo(mgr.GetVersion(), item.Memo) // ignore err
 	labels := []metrics.Label{
 		telemetry.NewLabel("vault_type", vault.Type.String()),
@@ -789,8 +803,15 @@ func (tos *TxOutStorageVCUR) CalcTxOutHeight(ctx cosmos.Context, version semver.
 		maxTxOutOffset = tos.constAccessor.GetInt64Value(constants.MaxTxOutOffset)
 	}
 
-	// if volume threshold is zero
-	if minVolumeThreshold.IsZero() || txOutDelayRate == 0 {
+	// on top of the value based delay, the number of outbounds a chain sends
+	// per block can be limited per lane, so a burst of refunds or affiliate
+	// payouts doesn't hold back the swaps, and the other way around
+	lane := getTxOutLane(ctx, tos.keeper, version, toi)
+	laneLimit := getTxOutLaneLimit(ctx, tos.keeper, lane, toi.Chain)
+
+	// if volume threshold is zero, only the lane limit applies
+	volumeDelay := !minVolumeThreshold.IsZero() && txOutDelayRate != 0
+	if !volumeDelay && laneLimit == 0 {
 		return ctx.BlockHeight(), nil
 	}
 
@@ -805,43 +826,61 @@ func (tos *TxOutStorageVCUR) CalcTxOutHeight(ctx cosmos.Context, version semver.
 		runeValue = pool.AssetValueInRune(toi.Coin.Amount)
 	}
 
-	// sum value of scheduled txns (including this one)
-	sumValue := runeValue
-	for height := ctx.BlockHeight() + 1; height <= ctx.BlockHeight()+txOutDelayMax; height++ {
-		value, err := tos.keeper.GetTxOutValue(ctx, height)
-		if err != nil {
-			ctx.Logger().Error("fail to get tx out array from key value store", "error", err)
-			continue
+	targetBlock := ctx.BlockHeight()
+	if volumeDelay {
+		// sum value of scheduled txns (including this one)
+		sumValue := runeValue
+		for height := ctx.BlockHeight() + 1; height <= ctx.BlockHeight()+txOutDelayMax; height++ {
+			value, err := tos.keeper.GetTxOutValue(ctx, height)
+			if err != nil {
+				ctx.Logger().Error("fail to get tx out array from key value store", "error", err)
+				continue
+			}
+			if height > ctx.BlockHeight()+maxTxOutOffset && value.IsZero() {
+				// we've hit our max offset, and an empty block, we can assume the
+				// rest will be empty as well
+				break
+			}
+			sumValue = sumValue.Add(value)
 		}
-		if height > ctx.BlockHeight()+maxTxOutOffset && value.IsZero() {
-			// we've hit our max offset, and an empty block, we can assume the
-			// rest will be empty as well
-			break
+		// reduce delay rate relative to the total scheduled value. In high volume
+		// scenarios, this causes the network to send outbound transactions slower,
+		// giving the community & NOs time to analyze and react. In an attack
+		// scenario, the attacker is likely going to move as much value as possible
+		// (as we've seen in the past). The act of doing this will slow down their
+		// own transaction(s), reducing the attack's effectiveness.
+		txOutDelayRate -= int64(sumValue.Uint64()) / minTxOutVolumeThreshold
+		if txOutDelayRate < 1 {
+			txOutDelayRate = 1
 		}
-		sumValue = sumValue.Add(value)
-	}
-	// reduce delay rate relative to the total scheduled value. In high volume
-	// scenarios, this causes the network to send outbound transactions slower,
-	// giving the community & NOs time to analyze and react. In an attack
-	// scenario, the attacker is likely going to move as much value as possible
-	// (as we've seen in the past). The act of doing this will slow down their
-	// own transaction(s), reducing the attack's effectiveness.
-	txOutDelayRate -= int64(sumValue.Uint64()) / minTxOutVolumeThreshold
-	if txOutDelayRate < 1 {
-		txOutDelayRate = 1
-	}
 
-	// calculate the minimum number of blocks in the future the txn has to be
-	minBlocks := int64(runeValue.Uint64()) / txOutDelayRate
-	// min shouldn't be anything longer than the max txout offset
-	if minBlocks > maxTxOutOffset {
-		minBlocks = maxTxOutOffset
+		// calculate the minimum number of blocks in the future the txn has to be
+		minBlocks := int64(runeValue.Uint64()) / txOutDelayRate
+		// min shouldn't be anything longer than the max txout offset
+		if minBlocks > maxTxOutOffset {
+			minBlocks = maxTxOutOffset
+		}
+		targetBlock += minBlocks
 	}
-	targetBlock := ctx.BlockHeight() + minBlocks
 
-	// find targetBlock that has space for new txout item.
+	// find targetBlock that has space for new txout item, and room in its lane.
 	count := int64(0)
 	for count < txOutDelayMax { // max set 1 day into the future
+		if laneLimit > 0 {
+			_, laneCount, err := getTxOutLaneUsage(ctx, tos.keeper, version, targetBlock, toi.Chain, lane)
+			if err != nil {
+				ctx.Logger().Error("fail to get outbound lane usage for block height", "error", err)
+				break
+			}
+			if laneCount >= laneLimit {
+				targetBlock++
+				count++
+				continue
+			}
+		}
+		if !volumeDelay {
+			break
+		}
 		txOutValue, err := tos.keeper.GetTxOutValue(ctx, targetBlock)
 		if err != nil {
 			ctx.Logger().Error("fail to get txOutValue for block height", "error", err)
@@ -1,6 +1,8 @@
 package mayachain
 
 import (
+	"errors"
+
 	. "gopkg.in/check.v1"
 
 	"gitlab.com/mayachain/mayanode/common"
@@ -549,6 +551,7 @@ type TestCalcKeeper struct {
 	keeper.KVStoreDummy
 	value map[int64]cosmos.Uint
 	mimir map[string]int64
+	txOut map[int64]*TxOut
 }
 
 func (k *TestCalcKeeper) GetPool(ctx cosmos.Context, asset common.Asset) (types.Pool, error) {
@@ -563,6 +566,13 @@ func (k *TestCalcKeeper) GetMimir(ctx cosmos.Context, key string) (int64, error)
 	return k.mimir[key], nil
 }
 
+func (k *TestCalcKeeper) GetTxOut(ctx cosmos.Context, height int64) (*TxOut, error) {
+	if txOut, ok := k.txOut[height]; ok {
+		return txOut, nil
+	}
+	return NewTxOut(height), nil
+}
+
 func (k *TestCalcKeeper) GetTxOutValue(ctx cosmos.Context, height int64) (cosmos.Uint, error) {
 	val, ok := k.value[height]
 	if !ok {
@@ -617,6 +627,121 @@ func (s TxOutStoreVCURSuite) TestcalcTxOutHeight(c *C) {
 	addValue(targetBlock, value)
 }
 
+func (s TxOutStoreVCURSuite) TestCalcTxOutHeightLanes(c *C) {
+	keeper := &TestCalcKeeper{
+		value: make(map[int64]cosmos.Uint),
+		mimir: make(map[string]int64),
+		txOut: make(map[int64]*TxOut),
+	}
+
+	keeper.mimir["MinTxOutVolumeThreshold"] = 25_00000000
+	keeper.mimir["TxOutDelayRate"] = 25_00000000
+	keeper.mimir["MaxTxOutOffset"] = 720
+	keeper.mimir["TxOutDelayMax"] = 17280
+
+	ctx, _ := setupManagerForTest(c)
+	txout := TxOutStorageVCUR{keeper: keeper}
+	pool, _ := keeper.GetPool(ctx, common.BNBAsset)
+
+	addItem := func(h int64, toi TxOutItem) {
+		if _, ok := keeper.txOut[h]; !ok {
+			keeper.txOut[h] = NewTxOut(h)
+		}
+		keeper.txOut[h].TxArray = append(keeper.txOut[h].TxArray, toi)
+		if _, ok := keeper.value[h]; !ok {
+			keeper.value[h] = cosmos.ZeroUint()
+		}
+		keeper.value[h] = keeper.value[h].Add(pool.AssetValueInRune(toi.Coin.Amount))
+	}
+
+	outbound := TxOutItem{
+		Chain: common.BNBChain,
+		Coin:  common.NewCoin(common.BNBAsset, cosmos.NewUint(common.One/10)),
+		Memo:  "OUT:nomnomnom",
+	}
+	targetBlock, err := txout.CalcTxOutHeight(ctx, keeper.GetVersion(), outbound)
+	c.Assert(err, IsNil)
+	c.Check(targetBlock, Equals, ctx.BlockHeight())
+	addItem(targetBlock, outbound)
+
+	// the refund lane is limited to one outbound per block on BNB
+	keeper.mimir["TxOutLaneLimit-REFUND-BNB"] = 1
+	refund := TxOutItem{
+		Chain: common.BNBChain,
+		Coin:  common.NewCoin(common.BNBAsset, cosmos.NewUint(common.One/10)),
+		Memo:  "REFUND:nomnomnom",
+	}
+	targetBlock, err = txout.CalcTxOutHeight(ctx, keeper.GetVersion(), refund)
+	c.Assert(err, IsNil)
+	c.Check(targetBlock, Equals, ctx.BlockHeight())
+	addItem(targetBlock, refund)
+
+	targetBlock, err = txout.CalcTxOutHeight(ctx, keeper.GetVersion(), refund)
+	c.Assert(err, IsNil)
+	c.Check(targetBlock, Equals, ctx.BlockHeight()+1)
+	addItem(targetBlock, refund)
+
+	// the limit is per chain and per lane
+	refund.Chain = common.ETHChain
+	targetBlock, err = txout.CalcTxOutHeight(ctx, keeper.GetVersion(), refund)
+	c.Assert(err, IsNil)
+	c.Check(targetBlock, Equals, ctx.BlockHeight())
+
+	targetBlock, err = txout.CalcTxOutHeight(ctx, keeper.GetVersion(), outbound)
+	c.Assert(err, IsNil)
+	c.Check(targetBlock, Equals, ctx.BlockHeight())
+
+	// the value scheduled on any chain delays the outbounds of all of them
+	for h := ctx.BlockHeight(); h <= ctx.BlockHeight()+720; h++ {
+		addItem(h, TxOutItem{
+			Chain: common.BNBChain,
+			Coin:  common.NewCoin(common.BNBAsset, cosmos.NewUint(50000*common.One)),
+			Memo:  "OUT:nomnomnom",
+			Lane:  TxOutLaneSwap,
+		})
+	}
+	outbound.Chain = common.ETHChain
+	targetBlock, err = txout.CalcTxOutHeight(ctx, keeper.GetVersion(), outbound)
+	c.Assert(err, IsNil)
+	c.Check(targetBlock > ctx.BlockHeight(), Equals, true)
+}
+
+func (s TxOutStoreVCURSuite) TestTxOutLaneStoredOnItem(c *C) {
+	w := getHandlerTestWrapper(c, 1, true, true)
+	txOutStore := newTxOutStorageVCUR(w.keeper, w.mgr.GetConstants(), w.mgr.EventMgr(), w.mgr.GasMgr())
+
+	vault := GetRandomVault()
+	vault.Coins = common.Coins{
+		common.NewCoin(common.BNBAsset, cosmos.NewUint(10000*common.One)),
+	}
+	c.Assert(w.keeper.SetVault(w.ctx, vault), IsNil)
+
+	item := TxOutItem{
+		Chain:       common.BNBChain,
+		ToAddress:   GetRandomBNBAddress(),
+		VaultPubKey: vault.PubKey,
+		InHash:      GetRandomTxHash(),
+		Coin:        common.NewCoin(common.BNBAsset, cosmos.NewUint(common.One)),
+		Memo:        NewRefundMemo(GetRandomTxHash()).String(),
+	}
+	c.Assert(txOutStore.UnSafeAddTxOutItem(w.ctx, w.mgr, item, w.ctx.BlockHeight()), IsNil)
+	msgs, err := txOutStore.GetOutboundItems(w.ctx)
+	c.Assert(err, IsNil)
+	c.Assert(msgs, HasLen, 1)
+	c.Check(msgs[0].Lane, Equals, TxOutLaneRefund)
+
+	// yggdrasil funds are left for a later round once the lane is full
+	w.keeper.SetMimir(w.ctx, "TxOutLaneLimit-YGGDRASIL-BNB", 1)
+	item.InHash = common.BlankTxID
+	item.Memo = NewYggdrasilFund(w.ctx.BlockHeight()).String()
+	c.Assert(txOutStore.UnSafeAddTxOutItem(w.ctx, w.mgr, item, w.ctx.BlockHeight()), IsNil)
+	c.Check(errors.Is(txOutStore.UnSafeAddTxOutItem(w.ctx, w.mgr, item, w.ctx.BlockHeight()), ErrTxOutLaneFull), Equals, true)
+	msgs, err = txOutStore.GetOutboundItems(w.ctx)
+	c.Assert(err, IsNil)
+	c.Assert(msgs, HasLen, 2)
+	c.Check(msgs[1].Lane, Equals, TxOutLaneYggdrasil)
+}
+
 func (s TxOutStoreVCURSuite) TestAddOutTxItem_MultipleOutboundWillBeScheduledAtTheSameBlockHeight(c *C) {
 	SetupConfigForTest()
 	w := getHandlerTestWrapper(c, 1, true, true)
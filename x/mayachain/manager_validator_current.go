@@ -130,55 +130,107 @@ func (vm *ValidatorMgrVCUR) churn(ctx cosmos.Context) error {
 
 	desiredValidatorSet := vm.k.GetConfigInt64(ctx, constants.DesiredValidatorSet)
 	asgardSize := vm.k.GetConfigInt64(ctx, constants.AsgardSize)
+
+	// mark bad, old, low bond, and old version validators
+	if _, err := vm.markChurnOutActors(ctx, constAccessor); err != nil {
+		return err
+	}
+
+	next, ok, err := vm.nextVaultNodeAccounts(ctx, int(desiredValidatorSet), constAccessor)
+	if err != nil {
+		return err
+	}
+	if ok {
+		for _, nodeAccSet := range vm.splitNext(ctx, next, asgardSize) {
+			if err = vm.networkMgr.TriggerKeygen(ctx, nodeAccSet); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// markChurnOutActors updates the list of ready actors and marks the active
+// validators to churn out for bad behavior, low bond, low version and age. It
+// returns the reason each active validator with a leave score got it for.
+func (vm *ValidatorMgrVCUR) markChurnOutActors(ctx cosmos.Context, constAccessor constants.ConstantValues) (map[string]string, error) {
 	redline := vm.k.GetConfigInt64(ctx, constants.BadValidatorRedline)
 	minSlashPointsForBadValidator := vm.k.GetConfigInt64(ctx, constants.MinSlashPointsForBadValidator)
 
 	// update list of ready actors
 	if err := vm.markReadyActors(ctx, constAccessor); err != nil {
-		return err
+		return nil, err
 	}
 
 	// clear leave scores
 	if err := vm.clearLeaveScores(ctx); err != nil {
-		return err
+		return nil, err
+	}
+
+	// the first reason a validator gets a leave score for is the one reported
+	reasons := make(map[string]string)
+	record := func(reason string) error {
+		active, err := vm.k.ListActiveValidators(ctx)
+		if err != nil {
+			return err
+		}
+		for _, na := range active {
+			if na.LeaveScore == 0 {
+				continue
+			}
+			if _, ok := reasons[na.NodeAddress.String()]; ok {
+				continue
+			}
+			switch {
+			case reason != "":
+				reasons[na.NodeAddress.String()] = reason
+			case na.ForcedToLeave:
+				reasons[na.NodeAddress.String()] = "forced_to_leave"
+			case na.RequestedToLeave:
+				reasons[na.NodeAddress.String()] = "requested_to_leave"
+			}
+		}
+		return nil
+	}
+
+	// validators scored by the leave handler keep their score
+	if err := record(""); err != nil {
+		return nil, err
 	}
 
-	// Mark bad, old, low bond, and old version validators
 	// mark someone to get churned out for bad behavior
-	err := vm.markBadActor(ctx, minSlashPointsForBadValidator, redline)
-	if err != nil {
-		return err
+	if err := vm.markBadActor(ctx, minSlashPointsForBadValidator, redline); err != nil {
+		return nil, err
+	}
+	if err := record("bad_behavior"); err != nil {
+		return nil, err
 	}
 
 	// mark someone to get churned out for low bond
-	if err = vm.markLowBondActor(ctx); err != nil {
-		return err
+	if err := vm.markLowBondActor(ctx); err != nil {
+		return nil, err
+	}
+	if err := record("low_bond"); err != nil {
+		return nil, err
 	}
 
 	// mark someone to get churned out for low version
-	if err = vm.markLowVersionValidators(ctx, constAccessor); err != nil {
-		return err
+	if err := vm.markLowVersionValidators(ctx, constAccessor); err != nil {
+		return nil, err
 	}
-
-	// mark someone to get churned out for age
-	if err = vm.markOldActor(ctx); err != nil {
-		return err
+	if err := record("low_version"); err != nil {
+		return nil, err
 	}
 
-	var next NodeAccounts
-	var ok bool
-	next, ok, err = vm.nextVaultNodeAccounts(ctx, int(desiredValidatorSet), constAccessor)
-	if err != nil {
-		return err
+	// mark someone to get churned out for age
+	if err := vm.markOldActor(ctx); err != nil {
+		return nil, err
 	}
-	if ok {
-		for _, nodeAccSet := range vm.splitNext(ctx, next, asgardSize) {
-			if err = vm.networkMgr.TriggerKeygen(ctx, nodeAccSet); err != nil {
-				return err
-			}
-		}
+	if err := record("age"); err != nil {
+		return nil, err
 	}
-	return nil
+
+	return reasons, nil
 }
 
 // splits given list of node accounts into separate list of nas, for separate
@@ -289,6 +341,66 @@ func (vm *ValidatorMgrVCUR) splitNext(ctx cosmos.Context, nas NodeAccounts, asga
 	return groups
 }
 
+// churnOutNode is a node account the next churn would remove from the active
+// set, along with the reason it was selected
+type churnOutNode struct {
+	NodeAccount NodeAccount
+	Reason      string
+}
+
+// previewChurn runs the churn selection logic and returns the nodes that would
+// be churned out, the ready nodes that would be churned in and the resulting
+// asgard split, without triggering a keygen. Like churn, it updates node
+// statuses and leave scores, so it must be given a cache context that is
+// discarded afterwards.
+func (vm *ValidatorMgrVCUR) previewChurn(ctx cosmos.Context) (bool, []churnOutNode, NodeAccounts, []NodeAccounts, error) {
+	constAccessor := vm.k.GetConstants()
+
+	desiredValidatorSet := vm.k.GetConfigInt64(ctx, constants.DesiredValidatorSet)
+	asgardSize := vm.k.GetConfigInt64(ctx, constants.AsgardSize)
+
+	active, err := vm.k.ListActiveValidators(ctx)
+	if err != nil {
+		return false, nil, nil, nil, err
+	}
+	reasons, err := vm.markChurnOutActors(ctx, constAccessor)
+	if err != nil {
+		return false, nil, nil, nil, err
+	}
+
+	next, ok, err := vm.nextVaultNodeAccounts(ctx, int(desiredValidatorSet), constAccessor)
+	if err != nil {
+		return false, nil, nil, nil, err
+	}
+	if !ok {
+		return false, nil, nil, nil, nil
+	}
+
+	inNext := make(map[string]bool, len(next))
+	for _, na := range next {
+		inNext[na.NodeAddress.String()] = true
+	}
+	wasActive := make(map[string]bool, len(active))
+	out := make([]churnOutNode, 0)
+	for _, na := range active {
+		wasActive[na.NodeAddress.String()] = true
+		if !inNext[na.NodeAddress.String()] {
+			out = append(out, churnOutNode{
+				NodeAccount: na,
+				Reason:      reasons[na.NodeAddress.String()],
+			})
+		}
+	}
+	in := make(NodeAccounts, 0)
+	for _, na := range next {
+		if !wasActive[na.NodeAddress.String()] {
+			in = append(in, na)
+		}
+	}
+
+	return true, out, in, vm.splitNext(ctx, next, asgardSize), nil
+}
+
 // EndBlock when block commit
 func (vm *ValidatorMgrVCUR) EndBlock(ctx cosmos.Context, mgr Manager) []abci.ValidatorUpdate {
 	height := ctx.BlockHeight()
@@ -598,6 +710,9 @@ func (vm *ValidatorMgrVCUR) payNodeAccountBondAward(ctx cosmos.Context, lastChur
 		return ErrInternal(err, fmt.Sprintf("fail to parse bond address(%s)", na.BondAddress))
 	}
 
+	// an operator fee change that has been scheduled for this or an earlier block is due now
+	bp.ApplyPendingOperatorFee(ctx.BlockHeight())
+
 	// Distribute reward to bond providers and remove the NodeOperatorFee portion for node operator payout.
 	// (This is the full fee from other bond providers' rewards, plus an equivalent proportion of the node operator's rewards.)
 	nodeOperatorFees := common.GetSafeShare(bp.NodeOperatorFee, cosmos.NewUint(10000), reward)
@@ -780,3 +780,112 @@ func (vts *validatorMgrVCURTestSuite) TestFindNextVaultNodeAccountsBFT(c *C) {
 	c.Assert(rotate, Equals, false)
 	c.Assert(nasAfter, HasLen, 4, Commentf("%d", len(nasAfter)))
 }
+
+func (vts *validatorMgrVCURTestSuite) TestPendingOperatorFee(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	ctx = ctx.WithBlockHeight(20)
+
+	mgr := NewDummyMgrWithKeeper(k)
+	validatorMgr := newValidatorMgrVCUR(k, mgr.NetworkMgr(), mgr.TxOutStore(), mgr.EventMgr())
+	FundModule(c, ctx, mgr.Keeper(), BondName, 100*common.One)
+
+	network, _ := k.GetNetwork(ctx)
+	network.BondRewardRune = cosmos.NewUint(20 * common.One)
+	c.Assert(k.SetNetwork(ctx, network), IsNil)
+
+	na := GetRandomValidatorNode(NodeActive)
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+	operator, _ := na.BondAddress.AccAddress()
+	provider := GetRandomBech32Addr()
+	bp := NewBondProviders(na.NodeAddress)
+	bp.Providers = append(bp.Providers, NewBondProvider(operator), NewBondProvider(provider))
+	bp.Providers[0].Bonded = true
+	bp.Providers[1].Bonded = true
+	bp.NodeOperatorFee = cosmos.NewUint(1000)
+	bp.PendingNodeOperatorFee = 5000
+	bp.PendingNodeOperatorFeeHeight = 21
+	c.Assert(k.SetBondProviders(ctx, bp), IsNil)
+
+	bpBonds := []cosmos.Uint{cosmos.NewUint(common.One), cosmos.NewUint(common.One)}
+	nodeBond := cosmos.NewUint(2 * common.One)
+	balance := func(addr cosmos.AccAddress) uint64 {
+		return k.GetBalance(ctx, addr).AmountOf(common.BaseAsset().Native()).Uint64()
+	}
+
+	// the fee change isn't due yet, the current fee is taken: the operator
+	// gets the 10% fee plus half of the rest
+	c.Assert(validatorMgr.payNodeAccountBondAward(ctx, 10, na, cosmos.NewUint(10*common.One), nodeBond, bpBonds, mgr), IsNil)
+	c.Check(balance(operator), Equals, uint64(55*common.One/10))
+	c.Check(balance(provider), Equals, uint64(45*common.One/10))
+	bp, err := k.GetBondProviders(ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(bp.NodeOperatorFee.Uint64(), Equals, uint64(1000))
+	c.Check(bp.HasPendingOperatorFee(), Equals, true)
+
+	// from the scheduled height on the new fee is taken
+	ctx = ctx.WithBlockHeight(21)
+	c.Assert(validatorMgr.payNodeAccountBondAward(ctx, 11, na, cosmos.NewUint(10*common.One), nodeBond, bpBonds, mgr), IsNil)
+	c.Check(balance(operator), Equals, uint64(13*common.One))
+	c.Check(balance(provider), Equals, uint64(7*common.One))
+	bp, err = k.GetBondProviders(ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(bp.NodeOperatorFee.Uint64(), Equals, uint64(5000))
+	c.Check(bp.HasPendingOperatorFee(), Equals, false)
+}
+
+func (vts *validatorMgrVCURTestSuite) TestPreviewChurn(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	vm := newValidatorMgrVCUR(mgr.Keeper(), mgr.NetworkMgr(), mgr.TxOutStore(), mgr.EventMgr())
+
+	addNode := func(status NodeStatus) NodeAccount {
+		na := GetRandomValidatorNode(status)
+		bp := NewBondProviders(na.NodeAddress)
+		acc, err := na.BondAddress.AccAddress()
+		c.Assert(err, IsNil)
+		bp.Providers = append(bp.Providers, NewBondProvider(acc))
+		bp.Providers[0].Bonded = true
+		SetupLiquidityBondForTest(c, ctx, mgr.Keeper(), common.BNBAsset, na.BondAddress, na, cosmos.NewUint(100*common.One))
+		c.Assert(mgr.Keeper().SetBondProviders(ctx, bp), IsNil)
+		c.Assert(mgr.Keeper().SetNodeAccount(ctx, na), IsNil)
+		return na
+	}
+	active := make(NodeAccounts, 0)
+	for i := 0; i < 12; i++ {
+		active = append(active, addNode(NodeActive))
+	}
+	standby := addNode(NodeStandby)
+	// the leave handler scores the node when it requests to leave, without a
+	// score it doesn't count towards the nodes removed by the churn
+	active[0].RequestedToLeave = true
+	active[0].LeaveScore = 1
+	c.Assert(mgr.Keeper().SetNodeAccount(ctx, active[0]), IsNil)
+	mgr.Keeper().SetNodeAccountSlashPoints(ctx, active[1].NodeAddress, 1000)
+
+	cacheCtx, _ := ctx.CacheContext()
+	rotation, out, in, asgards, err := vm.previewChurn(cacheCtx)
+	c.Assert(err, IsNil)
+	c.Assert(rotation, Equals, true)
+
+	reasons := make(map[string]string)
+	for _, item := range out {
+		reasons[item.NodeAccount.NodeAddress.String()] = item.Reason
+	}
+	c.Check(reasons[active[0].NodeAddress.String()], Equals, "requested_to_leave")
+	c.Check(reasons[active[1].NodeAddress.String()], Equals, "bad_behavior")
+	c.Assert(in, HasLen, 1)
+	c.Check(in[0].NodeAddress.Equals(standby.NodeAddress), Equals, true)
+
+	members := 0
+	for _, nas := range asgards {
+		members += len(nas)
+	}
+	c.Check(members, Equals, 12-len(out)+len(in))
+
+	// the preview must not change the committed state
+	na, err := mgr.Keeper().GetNodeAccount(ctx, standby.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(na.Status, Equals, NodeStandby)
+	na, err = mgr.Keeper().GetNodeAccount(ctx, active[1].NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(na.LeaveScore, Equals, uint64(0))
+}
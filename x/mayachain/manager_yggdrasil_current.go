@@ -292,6 +292,10 @@ func (ymgr YggMgrVCUR) sendCoinsToYggdrasil(ctx cosmos.Context, coins common.Coi
 				GasRate: int64(mgr.GasMgr().GetGasRate(ctx, coin.Asset.GetChain()).Uint64()),
 			}
 			if err := mgr.TxOutStore().UnSafeAddTxOutItem(ctx, mgr, toi, ctx.BlockHeight()); err != nil {
+				if errors.Is(err, ErrTxOutLaneFull) {
+					// the chain's yggdrasil lane is full, fund it on a later round
+					continue
+				}
 				return count, err
 			}
 			count++
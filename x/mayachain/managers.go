@@ -26,6 +26,10 @@ const (
 // ErrNotEnoughToPayFee will happen when the emitted asset is not enough to pay for fee
 var ErrNotEnoughToPayFee = errors.New("not enough asset to pay for fees")
 
+// ErrTxOutLaneFull will happen when the chain already sends as many outbounds of
+// the lane in the block as its limit allows
+var ErrTxOutLaneFull = errors.New("outbound lane is full")
+
 // Manager is an interface to define all the required methods
 type Manager interface {
 	GetConstants() constants.ConstantValues
@@ -52,6 +56,7 @@ type TradeAccountManager interface {
 	EndBlock(ctx cosmos.Context, keeper keeper.Keeper) error
 	Deposit(_ cosmos.Context, _ common.Asset, amount cosmos.Uint, owner cosmos.AccAddress, assetAddr common.Address, _ common.TxID) (cosmos.Uint, error)
 	Withdrawal(_ cosmos.Context, _ common.Asset, amount cosmos.Uint, owner cosmos.AccAddress, assetAddr common.Address, _ common.TxID) (cosmos.Uint, error)
+	Transfer(_ cosmos.Context, _ common.Asset, amount cosmos.Uint, from, to cosmos.AccAddress, _ common.TxID) (cosmos.Uint, error)
 	BalanceOf(_ cosmos.Context, _ common.Asset, owner cosmos.AccAddress) cosmos.Uint
 }
 
@@ -125,11 +130,14 @@ type PoolManager interface {
 // SwapQueue interface define the contract of Swap Queue
 type SwapQueue interface {
 	EndBlock(ctx cosmos.Context, mgr Manager) error
+	CancelStreamingSwap(ctx cosmos.Context, mgr Manager, msg MsgSwap) error
 }
 
 // OrderBook interface define the contract of Order Book
 type OrderBook interface {
 	EndBlock(ctx cosmos.Context, mgr Manager) error
+	AddOrderBookItem(ctx cosmos.Context, msg MsgSwap) error
+	CancelOrderBookItem(ctx cosmos.Context, mgr Manager, msg MsgSwap) error
 }
 
 // Slasher define all the method to perform slash
@@ -401,8 +409,10 @@ func GetEventManager(version semver.Version) (EventManager, error) {
 func GetTxOutStore(version semver.Version, keeper keeper.Keeper, eventMgr EventManager, gasManager GasManager) (TxOutStore, error) {
 	constAccessor := constants.GetConstantValues(version)
 	switch {
-	case version.GTE(semver.MustParse("1.123.0")): // trade-accounts
+	case version.GTE(semver.MustParse("1.124.0")):
 		return newTxOutStorageVCUR(keeper, constAccessor, eventMgr, gasManager), nil
+	case version.GTE(semver.MustParse("1.123.0")): // trade-accounts
+		return newTxOutStorageV123(keeper, constAccessor, eventMgr, gasManager), nil
 	case version.GTE(semver.MustParse("1.120.0")):
 		return newTxOutStorageV120(keeper, constAccessor, eventMgr, gasManager), nil
 	case version.GTE(semver.MustParse("1.118.0")):
@@ -457,8 +467,10 @@ func GetNetworkManager(version semver.Version, keeper keeper.Keeper, txOutStore
 // GetValidatorManager create a new instance of Validator Manager
 func GetValidatorManager(version semver.Version, keeper keeper.Keeper, networkMgr NetworkManager, txOutStore TxOutStore, eventMgr EventManager) (ValidatorManager, error) {
 	switch {
-	case version.GTE(semver.MustParse("1.123.0")):
+	case version.GTE(semver.MustParse("1.124.0")):
 		return newValidatorMgrVCUR(keeper, networkMgr, txOutStore, eventMgr), nil
+	case version.GTE(semver.MustParse("1.123.0")):
+		return newValidatorMgrV123(keeper, networkMgr, txOutStore, eventMgr), nil
 	case version.GTE(semver.MustParse("1.114.0")):
 		return newValidatorMgrV114(keeper, networkMgr, txOutStore, eventMgr), nil
 	case version.GTE(semver.MustParse("1.110.0")):
@@ -520,8 +532,10 @@ func GetSwapQueue(version semver.Version, keeper keeper.Keeper) (SwapQueue, erro
 // GetOrderBook retrieve a OrderBook that is compatible with the given version
 func GetOrderBook(version semver.Version, keeper keeper.Keeper) (OrderBook, error) {
 	switch {
-	case version.GTE(semver.MustParse("0.1.0")):
+	case version.GTE(semver.MustParse("1.124.0")):
 		return newOrderBookVCUR(keeper), nil
+	case version.GTE(semver.MustParse("0.1.0")):
+		return newOrderBookV123(keeper), nil
 	default:
 		return nil, errInvalidVersion
 	}
@@ -42,6 +42,19 @@ const (
 	TxCacaoPoolWithdraw
 	TxTradeAccountDeposit
 	TxTradeAccountWithdrawal
+	TxCancelOrder
+	TxDCA
+	TxTradeAccountTransfer
+	TxLoanOpen
+	TxLoanRepayment
+	TxAffiliateClaim
+	TxMAYANameList
+	TxMAYANameDelist
+	TxMAYANameBuy
+	TxBondProviderWhitelist
+	TxBondProviderRemove
+	TxNodeOperatorFee
+	TxBondProviderTransfer
 )
 
 var stringToTxTypeMap = map[string]TxType{
@@ -53,6 +66,10 @@ var stringToTxTypeMap = map[string]TxType{
 	"swap":        TxSwap,
 	"s":           TxSwap,
 	"=":           TxSwap,
+	"limito":      TxLimitOrder,
+	"lo":          TxLimitOrder,
+	"cancel":      TxCancelOrder,
+	"dca":         TxDCA,
 	"out":         TxOutbound,
 	"donate":      TxDonate,
 	"d":           TxDonate,
@@ -74,12 +91,28 @@ var stringToTxTypeMap = map[string]TxType{
 	"pool-":       TxCacaoPoolWithdraw,
 	"trade+":      TxTradeAccountDeposit,
 	"trade-":      TxTradeAccountWithdrawal,
+	"trade=":      TxTradeAccountTransfer,
+	"loan+":       TxLoanOpen,
+	"$+":          TxLoanOpen,
+	"loan-":       TxLoanRepayment,
+	"$-":          TxLoanRepayment,
+	"claim":       TxAffiliateClaim,
+	"list":        TxMAYANameList,
+	"delist":      TxMAYANameDelist,
+	"buy":         TxMAYANameBuy,
+	"bp+":         TxBondProviderWhitelist,
+	"bp-":         TxBondProviderRemove,
+	"bpfee":       TxNodeOperatorFee,
+	"bp=":         TxBondProviderTransfer,
 }
 
 var txToStringMap = map[TxType]string{
 	TxAdd:                    "add",
 	TxWithdraw:               "withdraw",
 	TxSwap:                   "swap",
+	TxLimitOrder:             "limito",
+	TxCancelOrder:            "cancel",
+	TxDCA:                    "dca",
 	TxOutbound:               "out",
 	TxRefund:                 "refund",
 	TxDonate:                 "donate",
@@ -99,6 +132,17 @@ var txToStringMap = map[TxType]string{
 	TxCacaoPoolWithdraw:      "pool-",
 	TxTradeAccountDeposit:    "trade+",
 	TxTradeAccountWithdrawal: "trade-",
+	TxTradeAccountTransfer:   "trade=",
+	TxLoanOpen:               "loan+",
+	TxLoanRepayment:          "loan-",
+	TxAffiliateClaim:         "claim",
+	TxMAYANameList:           "list",
+	TxMAYANameDelist:         "delist",
+	TxMAYANameBuy:            "buy",
+	TxBondProviderWhitelist:  "bp+",
+	TxBondProviderRemove:     "bp-",
+	TxNodeOperatorFee:        "bpfee",
+	TxBondProviderTransfer:   "bp=",
 }
 
 // converts a string into a txType
@@ -114,7 +158,7 @@ func StringToTxType(s string) (TxType, error) {
 
 func (tx TxType) IsInbound() bool {
 	switch tx {
-	case TxAdd, TxWithdraw, TxCacaoPoolDeposit, TxCacaoPoolWithdraw, TxTradeAccountDeposit, TxSwap, TxDonate, TxBond, TxUnbond, TxLeave, TxReserve, TxNoOp, TxMAYAName, TxForgiveSlash:
+	case TxAdd, TxWithdraw, TxCacaoPoolDeposit, TxCacaoPoolWithdraw, TxTradeAccountDeposit, TxSwap, TxLimitOrder, TxCancelOrder, TxDCA, TxLoanOpen, TxLoanRepayment, TxDonate, TxBond, TxUnbond, TxLeave, TxReserve, TxNoOp, TxMAYAName, TxForgiveSlash:
 		return true
 	default:
 		return false
@@ -142,7 +186,7 @@ func (tx TxType) IsInternal() bool {
 // HasOutbound whether the txtype might trigger outbound tx
 func (tx TxType) HasOutbound() bool {
 	switch tx {
-	case TxAdd, TxBond, TxCacaoPoolDeposit, TxTradeAccountDeposit, TxDonate, TxYggdrasilReturn, TxReserve, TxMigrate, TxRagnarok:
+	case TxAdd, TxBond, TxCacaoPoolDeposit, TxTradeAccountDeposit, TxTradeAccountTransfer, TxAffiliateClaim, TxMAYANameList, TxMAYANameDelist, TxMAYANameBuy, TxBondProviderWhitelist, TxBondProviderRemove, TxNodeOperatorFee, TxBondProviderTransfer, TxDonate, TxYggdrasilReturn, TxReserve, TxMigrate, TxRagnarok:
 		return false
 	default:
 		return true
@@ -234,7 +278,7 @@ func parseBase(version semver.Version, memo string) (MemoBase, []string, error)
 	}
 
 	switch mem.TxType {
-	case TxDonate, TxAdd, TxSwap, TxWithdraw:
+	case TxDonate, TxAdd, TxSwap, TxLimitOrder, TxDCA, TxWithdraw:
 		if len(parts) < 2 {
 			return mem, parts, fmt.Errorf("cannot parse given memo: length %d", len(parts))
 		}
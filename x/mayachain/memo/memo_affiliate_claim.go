@@ -0,0 +1,36 @@
+package mayachain
+
+import (
+	"fmt"
+
+	"gitlab.com/mayachain/mayanode/common"
+)
+
+// AffiliateClaimMemo pays out the affiliate collector of the MAYAName owner
+// right away, in the preferred asset or in CACAO when no asset is given
+type AffiliateClaimMemo struct {
+	MemoBase
+	Name string
+}
+
+// String implement fmt.Stringer
+func (m AffiliateClaimMemo) String() string {
+	if m.Asset.IsEmpty() {
+		return fmt.Sprintf("%s:%s", m.TxType.String(), m.Name)
+	}
+	return fmt.Sprintf("%s:%s:%s", m.TxType.String(), m.Name, m.Asset)
+}
+
+// NewAffiliateClaimMemo create a new AffiliateClaimMemo
+func NewAffiliateClaimMemo(name string, asset common.Asset) AffiliateClaimMemo {
+	return AffiliateClaimMemo{
+		MemoBase: MemoBase{TxType: TxAffiliateClaim, Asset: asset},
+		Name:     name,
+	}
+}
+
+func (p *parser) ParseAffiliateClaimMemo() (AffiliateClaimMemo, error) {
+	name := p.getName(1)
+	asset := p.getAsset(2, false, common.EmptyAsset)
+	return NewAffiliateClaimMemo(name, asset), p.Error()
+}
@@ -0,0 +1,142 @@
+package mayachain
+
+import (
+	"fmt"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+)
+
+// BondProviderWhitelistMemo lets a node operator whitelist a bond provider
+// memo format: bp+:node:provider
+type BondProviderWhitelistMemo struct {
+	MemoBase
+	NodeAddress     cosmos.AccAddress
+	ProviderAddress cosmos.AccAddress
+}
+
+func (m BondProviderWhitelistMemo) GetAccAddress() cosmos.AccAddress { return m.NodeAddress }
+
+// String implement fmt.Stringer
+func (m BondProviderWhitelistMemo) String() string {
+	return fmt.Sprintf("%s:%s:%s", m.TxType.String(), m.NodeAddress, m.ProviderAddress)
+}
+
+// NewBondProviderWhitelistMemo create a new BondProviderWhitelistMemo
+func NewBondProviderWhitelistMemo(nodeAddr, providerAddr cosmos.AccAddress) BondProviderWhitelistMemo {
+	return BondProviderWhitelistMemo{
+		MemoBase:        MemoBase{TxType: TxBondProviderWhitelist},
+		NodeAddress:     nodeAddr,
+		ProviderAddress: providerAddr,
+	}
+}
+
+func (p *parser) ParseBondProviderWhitelistMemo() (BondProviderWhitelistMemo, error) {
+	nodeAddr := p.getAccAddress(1, true, nil)
+	providerAddr := p.getAccAddress(2, true, nil)
+	return NewBondProviderWhitelistMemo(nodeAddr, providerAddr), p.Error()
+}
+
+// BondProviderRemoveMemo lets a node operator remove a bond provider that has
+// no liquidity bonded to the node
+// memo format: bp-:node:provider
+type BondProviderRemoveMemo struct {
+	MemoBase
+	NodeAddress     cosmos.AccAddress
+	ProviderAddress cosmos.AccAddress
+}
+
+func (m BondProviderRemoveMemo) GetAccAddress() cosmos.AccAddress { return m.NodeAddress }
+
+// String implement fmt.Stringer
+func (m BondProviderRemoveMemo) String() string {
+	return fmt.Sprintf("%s:%s:%s", m.TxType.String(), m.NodeAddress, m.ProviderAddress)
+}
+
+// NewBondProviderRemoveMemo create a new BondProviderRemoveMemo
+func NewBondProviderRemoveMemo(nodeAddr, providerAddr cosmos.AccAddress) BondProviderRemoveMemo {
+	return BondProviderRemoveMemo{
+		MemoBase:        MemoBase{TxType: TxBondProviderRemove},
+		NodeAddress:     nodeAddr,
+		ProviderAddress: providerAddr,
+	}
+}
+
+func (p *parser) ParseBondProviderRemoveMemo() (BondProviderRemoveMemo, error) {
+	nodeAddr := p.getAccAddress(1, true, nil)
+	providerAddr := p.getAccAddress(2, true, nil)
+	return NewBondProviderRemoveMemo(nodeAddr, providerAddr), p.Error()
+}
+
+// NodeOperatorFeeMemo changes the operator fee of a node, in basis points
+// memo format: bpfee:node:fee
+type NodeOperatorFeeMemo struct {
+	MemoBase
+	NodeAddress cosmos.AccAddress
+	OperatorFee int64
+}
+
+func (m NodeOperatorFeeMemo) GetAccAddress() cosmos.AccAddress { return m.NodeAddress }
+
+// String implement fmt.Stringer
+func (m NodeOperatorFeeMemo) String() string {
+	return fmt.Sprintf("%s:%s:%d", m.TxType.String(), m.NodeAddress, m.OperatorFee)
+}
+
+// NewNodeOperatorFeeMemo create a new NodeOperatorFeeMemo
+func NewNodeOperatorFeeMemo(nodeAddr cosmos.AccAddress, operatorFee int64) NodeOperatorFeeMemo {
+	return NodeOperatorFeeMemo{
+		MemoBase:    MemoBase{TxType: TxNodeOperatorFee},
+		NodeAddress: nodeAddr,
+		OperatorFee: operatorFee,
+	}
+}
+
+func (p *parser) ParseNodeOperatorFeeMemo() (NodeOperatorFeeMemo, error) {
+	nodeAddr := p.getAccAddress(1, true, nil)
+	operatorFee := p.getInt64(2, true, 0)
+	return NewNodeOperatorFeeMemo(nodeAddr, operatorFee), p.Error()
+}
+
+// BondProviderTransferMemo moves LP units the sender has bonded to a node over
+// to another bond provider of the same node, zero units transfers everything
+// memo format: bp=:node:to:asset:?units
+type BondProviderTransferMemo struct {
+	MemoBase
+	NodeAddress cosmos.AccAddress
+	ToAddress   cosmos.AccAddress
+	Units       cosmos.Uint
+}
+
+func (m BondProviderTransferMemo) GetAccAddress() cosmos.AccAddress { return m.NodeAddress }
+func (m BondProviderTransferMemo) GetAmount() cosmos.Uint           { return m.Units }
+
+// String implement fmt.Stringer
+func (m BondProviderTransferMemo) String() string {
+	memo := fmt.Sprintf("%s:%s:%s:%s", m.TxType.String(), m.NodeAddress, m.ToAddress, m.Asset)
+	if !m.Units.IsZero() {
+		return fmt.Sprintf("%s:%s", memo, m.Units)
+	}
+	return memo
+}
+
+// NewBondProviderTransferMemo create a new BondProviderTransferMemo
+func NewBondProviderTransferMemo(nodeAddr, toAddr cosmos.AccAddress, asset common.Asset, units cosmos.Uint) BondProviderTransferMemo {
+	return BondProviderTransferMemo{
+		MemoBase: MemoBase{
+			TxType: TxBondProviderTransfer,
+			Asset:  asset,
+		},
+		NodeAddress: nodeAddr,
+		ToAddress:   toAddr,
+		Units:       units,
+	}
+}
+
+func (p *parser) ParseBondProviderTransferMemo() (BondProviderTransferMemo, error) {
+	nodeAddr := p.getAccAddress(1, true, nil)
+	toAddr := p.getAccAddress(2, true, nil)
+	asset := p.getAsset(3, true, common.EmptyAsset)
+	units := p.getUint(4, false, 0)
+	return NewBondProviderTransferMemo(nodeAddr, toAddr, asset, units), p.Error()
+}
@@ -1,6 +1,7 @@
 package mayachain
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/blang/semver"
@@ -8,12 +9,15 @@ import (
 	"gitlab.com/mayachain/mayanode/constants"
 )
 
-// "pool+"
+// "pool+:<auto-compound>"
 
 type CacaoPoolDepositMemo struct {
 	MemoBase
+	AutoCompound int64 // -1 when not set
 }
 
+func (m CacaoPoolDepositMemo) GetAutoCompound() int64 { return m.AutoCompound }
+
 func (m CacaoPoolDepositMemo) String() string {
 	return m.string(false)
 }
@@ -23,17 +27,40 @@ func (m CacaoPoolDepositMemo) ShortString() string {
 }
 
 func (m CacaoPoolDepositMemo) string(short bool) string {
-	return "pool+"
+	if m.AutoCompound < 0 {
+		return "pool+"
+	}
+	return fmt.Sprintf("pool+:%d", m.AutoCompound)
 }
 
-func NewCacaoPoolDepositMemo() CacaoPoolDepositMemo {
+func NewCacaoPoolDepositMemo(autoCompound int64) CacaoPoolDepositMemo {
 	return CacaoPoolDepositMemo{
-		MemoBase: MemoBase{TxType: TxCacaoPoolDeposit},
+		MemoBase:     MemoBase{TxType: TxCacaoPoolDeposit},
+		AutoCompound: autoCompound,
 	}
 }
 
 func (p *parser) ParseCacaoPoolDepositMemo() (CacaoPoolDepositMemo, error) {
-	return NewCacaoPoolDepositMemo(), nil
+	switch {
+	case p.version.GTE(semver.MustParse("1.124.0")):
+		return p.ParseCacaoPoolDepositMemoV124()
+	default:
+		return p.ParseCacaoPoolDepositMemoV1()
+	}
+}
+
+// ParseCacaoPoolDepositMemoV124 the provider opts in to auto-compounding by
+// setting the optional flag to 1 and out by setting it to 0, a deposit without
+// the flag leaves it as it is
+func (p *parser) ParseCacaoPoolDepositMemoV124() (CacaoPoolDepositMemo, error) {
+	autoCompound := int64(-1)
+	if p.get(1) != "" {
+		autoCompound = p.getInt64(1, false, -1)
+		if autoCompound != 0 && autoCompound != 1 {
+			p.addErr(fmt.Errorf("auto-compound flag must be 0 or 1: %s", p.get(1)))
+		}
+	}
+	return NewCacaoPoolDepositMemo(autoCompound), p.Error()
 }
 
 // "pool-:<basis-points>:<affiliate>:<affiliate-basis-points>"
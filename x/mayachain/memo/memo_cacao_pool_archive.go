@@ -6,6 +6,10 @@ import (
 	"gitlab.com/mayachain/mayanode/constants"
 )
 
+func (p *parser) ParseCacaoPoolDepositMemoV1() (CacaoPoolDepositMemo, error) {
+	return NewCacaoPoolDepositMemo(-1), nil
+}
+
 func (p *parser) ParseCacaoPoolWithdrawMemoV1() (CacaoPoolWithdrawMemo, error) {
 	basisPoints := p.getUint(1, true, cosmos.ZeroInt().Uint64())
 	affiliateAddress := p.getAddressWithKeeper(2, false, common.NoAddress, common.BASEChain, p.version)
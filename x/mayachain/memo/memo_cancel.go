@@ -0,0 +1,35 @@
+package mayachain
+
+import (
+	"fmt"
+
+	"gitlab.com/mayachain/mayanode/common"
+)
+
+// CancelOrderMemo cancels a limit order still sitting in the order book, or
+// stops a streaming swap early. It has to be sent by the same address that
+// placed the order or swap.
+type CancelOrderMemo struct {
+	MemoBase
+	TxID common.TxID
+}
+
+func (m CancelOrderMemo) GetTxID() common.TxID { return m.TxID }
+
+// String implement fmt.Stringer
+func (m CancelOrderMemo) String() string {
+	return fmt.Sprintf("%s:%s", m.TxType.String(), m.TxID.String())
+}
+
+// NewCancelOrderMemo create a new CancelOrderMemo
+func NewCancelOrderMemo(txID common.TxID) CancelOrderMemo {
+	return CancelOrderMemo{
+		MemoBase: MemoBase{TxType: TxCancelOrder},
+		TxID:     txID,
+	}
+}
+
+func (p *parser) ParseCancelOrderMemo() (CancelOrderMemo, error) {
+	txID := p.getTxID(1, true, common.BlankTxID)
+	return NewCancelOrderMemo(txID), p.Error()
+}
@@ -0,0 +1,72 @@
+package mayachain
+
+import (
+	"fmt"
+
+	"gitlab.com/mayachain/mayanode/common"
+	cosmos "gitlab.com/mayachain/mayanode/common/cosmos"
+)
+
+// LoanOpenMemo opens (or adds to) a loan backed by the trade asset sent with
+// the deposit. The debt is paid out in the target asset to the target address.
+// An empty debt amount borrows as much as the collateral allows.
+type LoanOpenMemo struct {
+	MemoBase
+	TargetAddress common.Address
+	DebtAmount    cosmos.Uint
+}
+
+func (m LoanOpenMemo) GetDestination() common.Address { return m.TargetAddress }
+func (m LoanOpenMemo) GetAmount() cosmos.Uint         { return m.DebtAmount }
+
+// String implement fmt.Stringer
+func (m LoanOpenMemo) String() string {
+	if m.DebtAmount.IsZero() {
+		return fmt.Sprintf("%s:%s:%s", m.TxType.String(), m.Asset, m.TargetAddress)
+	}
+	return fmt.Sprintf("%s:%s:%s:%s", m.TxType.String(), m.Asset, m.TargetAddress, m.DebtAmount)
+}
+
+// NewLoanOpenMemo create a new LoanOpenMemo
+func NewLoanOpenMemo(targetAsset common.Asset, targetAddress common.Address, debtAmount cosmos.Uint) LoanOpenMemo {
+	return LoanOpenMemo{
+		MemoBase:      MemoBase{TxType: TxLoanOpen, Asset: targetAsset},
+		TargetAddress: targetAddress,
+		DebtAmount:    debtAmount,
+	}
+}
+
+func (p *parser) ParseLoanOpenMemo() (LoanOpenMemo, error) {
+	targetAsset := p.getAsset(1, true, common.EmptyAsset)
+	targetAddress := p.getAddressWithKeeper(2, true, common.NoAddress, targetAsset.GetChain(), p.version)
+	debtAmount := p.getUintWithScientificNotation(3, false, 0)
+	return NewLoanOpenMemo(targetAsset, targetAddress, debtAmount), p.Error()
+}
+
+// LoanRepaymentMemo repays (part of) the debt of the loan the owner has
+// against the collateral asset, anyone can repay a loan
+type LoanRepaymentMemo struct {
+	MemoBase
+	Owner cosmos.AccAddress
+}
+
+func (m LoanRepaymentMemo) GetAccAddress() cosmos.AccAddress { return m.Owner }
+
+// String implement fmt.Stringer
+func (m LoanRepaymentMemo) String() string {
+	return fmt.Sprintf("%s:%s:%s", m.TxType.String(), m.Asset, m.Owner)
+}
+
+// NewLoanRepaymentMemo create a new LoanRepaymentMemo
+func NewLoanRepaymentMemo(collateralAsset common.Asset, owner cosmos.AccAddress) LoanRepaymentMemo {
+	return LoanRepaymentMemo{
+		MemoBase: MemoBase{TxType: TxLoanRepayment, Asset: collateralAsset},
+		Owner:    owner,
+	}
+}
+
+func (p *parser) ParseLoanRepaymentMemo() (LoanRepaymentMemo, error) {
+	collateralAsset := p.getAsset(1, true, common.EmptyAsset)
+	owner := p.getAccAddress(2, true, nil)
+	return NewLoanRepaymentMemo(collateralAsset.GetTradeAsset(), owner), p.Error()
+}
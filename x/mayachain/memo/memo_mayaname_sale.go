@@ -0,0 +1,117 @@
+package mayachain
+
+import (
+	"fmt"
+	"strings"
+
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+)
+
+// MAYANameListMemo lists a MAYAName for sale at a CACAO price, the keep flags
+// ("a" for aliases, "s" for subaffiliates) select what the buyer takes over
+// memo format: list:name:price:?expire:?keep
+type MAYANameListMemo struct {
+	MemoBase
+	Name              string
+	Price             cosmos.Uint
+	Expire            int64
+	KeepAliases       bool
+	KeepSubaffiliates bool
+}
+
+func (m MAYANameListMemo) GetName() string        { return m.Name }
+func (m MAYANameListMemo) GetAmount() cosmos.Uint { return m.Price }
+func (m MAYANameListMemo) GetBlockExpire() int64  { return m.Expire }
+
+// String implement fmt.Stringer
+func (m MAYANameListMemo) String() string {
+	memo := fmt.Sprintf("%s:%s:%s", m.TxType.String(), m.Name, m.Price)
+	keep := ""
+	if m.KeepAliases {
+		keep += "a"
+	}
+	if m.KeepSubaffiliates {
+		keep += "s"
+	}
+	switch {
+	case keep != "":
+		return fmt.Sprintf("%s:%d:%s", memo, m.Expire, keep)
+	case m.Expire > 0:
+		return fmt.Sprintf("%s:%d", memo, m.Expire)
+	default:
+		return memo
+	}
+}
+
+// NewMAYANameListMemo create a new MAYANameListMemo
+func NewMAYANameListMemo(name string, price cosmos.Uint, expire int64, keepAliases, keepSubaffiliates bool) MAYANameListMemo {
+	return MAYANameListMemo{
+		MemoBase:          MemoBase{TxType: TxMAYANameList},
+		Name:              name,
+		Price:             price,
+		Expire:            expire,
+		KeepAliases:       keepAliases,
+		KeepSubaffiliates: keepSubaffiliates,
+	}
+}
+
+func (p *parser) ParseMAYANameListMemo() (MAYANameListMemo, error) {
+	name := p.getName(1)
+	price := p.getUintWithScientificNotation(2, true, 0)
+	expire := p.getInt64(3, false, 0)
+	keep := strings.ToLower(p.get(4))
+	if strings.Trim(keep, "as") != "" {
+		p.addErr(fmt.Errorf("cannot parse '%s' as keep flags", keep))
+	}
+	return NewMAYANameListMemo(name, price, expire, strings.Contains(keep, "a"), strings.Contains(keep, "s")), p.Error()
+}
+
+// MAYANameDelistMemo cancels the open listing of a MAYAName
+type MAYANameDelistMemo struct {
+	MemoBase
+	Name string
+}
+
+func (m MAYANameDelistMemo) GetName() string { return m.Name }
+
+// String implement fmt.Stringer
+func (m MAYANameDelistMemo) String() string {
+	return fmt.Sprintf("%s:%s", m.TxType.String(), m.Name)
+}
+
+// NewMAYANameDelistMemo create a new MAYANameDelistMemo
+func NewMAYANameDelistMemo(name string) MAYANameDelistMemo {
+	return MAYANameDelistMemo{
+		MemoBase: MemoBase{TxType: TxMAYANameDelist},
+		Name:     name,
+	}
+}
+
+func (p *parser) ParseMAYANameDelistMemo() (MAYANameDelistMemo, error) {
+	return NewMAYANameDelistMemo(p.getName(1)), p.Error()
+}
+
+// MAYANameBuyMemo buys a listed MAYAName with the CACAO sent along
+type MAYANameBuyMemo struct {
+	MemoBase
+	Name string
+}
+
+func (m MAYANameBuyMemo) GetName() string { return m.Name }
+
+// String implement fmt.Stringer
+func (m MAYANameBuyMemo) String() string {
+	return fmt.Sprintf("%s:%s", m.TxType.String(), m.Name)
+}
+
+// NewMAYANameBuyMemo create a new MAYANameBuyMemo
+func NewMAYANameBuyMemo(name string) MAYANameBuyMemo {
+	return MAYANameBuyMemo{
+		MemoBase: MemoBase{TxType: TxMAYANameBuy},
+		Name:     name,
+	}
+}
+
+func (p *parser) ParseMAYANameBuyMemo() (MAYANameBuyMemo, error) {
+	return NewMAYANameBuyMemo(p.getName(1)), p.Error()
+}
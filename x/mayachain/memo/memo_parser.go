@@ -65,6 +65,12 @@ func (p *parser) parse() (mem Memo, err error) {
 			err = p.Error()
 		}
 	}()
+	if p.version.LT(semver.MustParse("1.124.0")) {
+		switch p.getType() {
+		case TxLimitOrder, TxCancelOrder, TxDCA, TxTradeAccountTransfer, TxLoanOpen, TxLoanRepayment, TxAffiliateClaim, TxMAYANameList, TxMAYANameDelist, TxMAYANameBuy, TxBondProviderWhitelist, TxBondProviderRemove, TxNodeOperatorFee, TxBondProviderTransfer:
+			return EmptyMemo, fmt.Errorf("TxType not supported: %s", p.getType().String())
+		}
+	}
 	switch p.getType() {
 	case TxLeave:
 		return p.ParseLeaveMemo()
@@ -78,8 +84,10 @@ func (p *parser) parse() (mem Memo, err error) {
 		return p.ParseCacaoPoolDepositMemo()
 	case TxCacaoPoolWithdraw:
 		return p.ParseCacaoPoolWithdrawMemo()
-	case TxSwap:
+	case TxSwap, TxLimitOrder, TxDCA:
 		return p.ParseSwapMemo()
+	case TxCancelOrder:
+		return p.ParseCancelOrderMemo()
 	case TxOutbound:
 		return p.ParseOutboundMemo()
 	case TxRefund:
@@ -108,6 +116,28 @@ func (p *parser) parse() (mem Memo, err error) {
 		return p.ParseTradeAccountDeposit()
 	case TxTradeAccountWithdrawal:
 		return p.ParseTradeAccountWithdrawal()
+	case TxTradeAccountTransfer:
+		return p.ParseTradeAccountTransfer()
+	case TxLoanOpen:
+		return p.ParseLoanOpenMemo()
+	case TxLoanRepayment:
+		return p.ParseLoanRepaymentMemo()
+	case TxAffiliateClaim:
+		return p.ParseAffiliateClaimMemo()
+	case TxMAYANameList:
+		return p.ParseMAYANameListMemo()
+	case TxMAYANameDelist:
+		return p.ParseMAYANameDelistMemo()
+	case TxMAYANameBuy:
+		return p.ParseMAYANameBuyMemo()
+	case TxBondProviderWhitelist:
+		return p.ParseBondProviderWhitelistMemo()
+	case TxBondProviderRemove:
+		return p.ParseBondProviderRemoveMemo()
+	case TxNodeOperatorFee:
+		return p.ParseNodeOperatorFeeMemo()
+	case TxBondProviderTransfer:
+		return p.ParseBondProviderTransferMemo()
 	default:
 		return EmptyMemo, fmt.Errorf("TxType not supported: %s", p.getType().String())
 	}
@@ -146,6 +176,15 @@ func (p *parser) get(idx int) string {
 	return p.parts[idx]
 }
 
+func (p *parser) getName(idx int) string {
+	p.incRequired(true)
+	name := p.get(idx)
+	if name == "" {
+		p.addErr(fmt.Errorf("mayaname is required"))
+	}
+	return name
+}
+
 func (p *parser) getInt64(idx int, required bool, def int64) int64 {
 	p.incRequired(required)
 	value, err := strconv.ParseInt(p.get(idx), 10, 64)
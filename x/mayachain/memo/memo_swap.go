@@ -28,6 +28,7 @@ type SwapMemo struct {
 	RefundAddress         common.Address
 	Affiliates            []string
 	AffiliatesBasisPoints []cosmos.Uint
+	ExpiryHeight          int64
 }
 
 func (m SwapMemo) GetDestination() common.Address          { return m.Destination }
@@ -43,6 +44,7 @@ func (m SwapMemo) GetStreamInterval() uint64               { return m.StreamInte
 func (m SwapMemo) GetRefundAddress() common.Address        { return m.RefundAddress }
 func (m SwapMemo) GetAffiliates() []string                 { return m.Affiliates }
 func (m SwapMemo) GetAffiliatesBasisPoints() []cosmos.Uint { return m.AffiliatesBasisPoints }
+func (m SwapMemo) GetExpiryHeight() int64                  { return m.ExpiryHeight }
 
 func (m SwapMemo) String() string {
 	return m.string(false)
@@ -68,6 +70,11 @@ func (m SwapMemo) string(short bool) string {
 		slipLimit = fmt.Sprintf("%s/%d/%d", m.SlipLimit.String(), m.StreamInterval, m.StreamQuantity)
 	}
 
+	// limit orders can't stream, the second part is the expiry height instead
+	if m.TxType == TxLimitOrder && m.ExpiryHeight > 0 {
+		slipLimit = fmt.Sprintf("%s/%d", m.SlipLimit.String(), m.ExpiryHeight)
+	}
+
 	var assetString string
 	if short && len(m.Asset.ShortCode()) > 0 {
 		assetString = m.Asset.ShortCode()
@@ -97,7 +104,7 @@ func (m SwapMemo) string(short bool) string {
 	}
 
 	last := 3
-	if !m.SlipLimit.IsZero() || m.StreamInterval > 0 || m.StreamQuantity > 1 {
+	if !m.SlipLimit.IsZero() || m.StreamInterval > 0 || m.StreamQuantity > 1 || m.ExpiryHeight > 0 {
 		last = 4
 	}
 
@@ -148,6 +155,8 @@ func (p *parser) ParseSwapMemo() (SwapMemo, error) {
 		return ParseSwapMemoV1(p.ctx, p.keeper, p.getAsset(1, true, common.EmptyAsset), p.parts)
 	}
 	switch {
+	case p.keeper.GetVersion().GTE(semver.MustParse("1.124.0")):
+		return p.ParseSwapMemoV124()
 	case p.keeper.GetVersion().GTE(semver.MustParse("1.121.0")):
 		return p.ParseSwapMemoV121()
 	case p.keeper.GetVersion().GTE(semver.MustParse("1.118.0")):
@@ -163,9 +172,15 @@ func (p *parser) ParseSwapMemo() (SwapMemo, error) {
 	}
 }
 
-func (p *parser) ParseSwapMemoV121() (SwapMemo, error) {
+func (p *parser) ParseSwapMemoV124() (SwapMemo, error) {
 	var err error
 	var order types.OrderType
+	switch p.getType() {
+	case TxLimitOrder:
+		order = types.OrderType_limit
+	case TxDCA:
+		order = types.OrderType_dca
+	}
 	asset := p.getAsset(1, true, common.EmptyAsset)
 
 	// DESTADDR can be empty , if it is empty , it will swap to the sender address
@@ -175,7 +190,55 @@ func (p *parser) ParseSwapMemoV121() (SwapMemo, error) {
 	var slip cosmos.Uint
 	streamInterval := uint64(0)
 	streamQuantity := uint64(0)
-	if strings.Contains(p.get(3), "/") {
+	expiryHeight := int64(0)
+	switch {
+	case order == types.OrderType_limit:
+		// limit orders are never streamed, instead the price limit is
+		// optionally followed by the block height the order expires at
+		// (LIM/EXPIRY)
+		parts := strings.SplitN(p.get(3), "/", 2)
+		slip, err = parseTradeTarget(parts[0])
+		if err != nil {
+			return SwapMemo{}, fmt.Errorf("swap price limit:%s is invalid: %s", parts[0], err)
+		}
+		if slip.IsZero() {
+			return SwapMemo{}, fmt.Errorf("limit orders must have a price limit")
+		}
+		if len(parts) > 1 && parts[1] != "" {
+			expiryHeight, err = strconv.ParseInt(parts[1], 10, 64)
+			if err != nil {
+				return SwapMemo{}, fmt.Errorf("failed to parse expiry height: %s: %s", parts[1], err)
+			}
+			if expiryHeight < 0 {
+				return SwapMemo{}, fmt.Errorf("expiry height cannot be negative: %d", expiryHeight)
+			}
+		}
+	case order == types.OrderType_dca:
+		// dca orders are always spread over several slices, the price limit
+		// applies to every slice on its own (LIM/INTERVAL/QUANTITY)
+		parts := strings.SplitN(p.get(3), "/", 3)
+		if len(parts) < 3 {
+			return SwapMemo{}, fmt.Errorf("invalid dca order format: %s", p.get(3))
+		}
+		if parts[0] == "" {
+			parts[0] = "0"
+		}
+		slip, err = parseTradeTarget(parts[0])
+		if err != nil {
+			return SwapMemo{}, fmt.Errorf("swap price limit:%s is invalid: %s", parts[0], err)
+		}
+		streamInterval, err = strconv.ParseUint(parts[1], 10, 64)
+		if err != nil {
+			return SwapMemo{}, fmt.Errorf("failed to parse dca interval: %s: %s", parts[1], err)
+		}
+		streamQuantity, err = strconv.ParseUint(parts[2], 10, 64)
+		if err != nil {
+			return SwapMemo{}, fmt.Errorf("failed to parse dca quantity: %s: %s", parts[2], err)
+		}
+		if streamInterval == 0 || streamQuantity < 2 {
+			return SwapMemo{}, fmt.Errorf("dca orders need an interval and at least two slices")
+		}
+	case strings.Contains(p.get(3), "/"):
 		parts := strings.SplitN(p.get(3), "/", 3)
 		for i := range parts {
 			if parts[i] == "" {
@@ -201,7 +264,7 @@ func (p *parser) ParseSwapMemoV121() (SwapMemo, error) {
 				return SwapMemo{}, fmt.Errorf("failed to parse stream quantity: %s: %s", parts[2], err)
 			}
 		}
-	} else {
+	default:
 		slip = p.getUintWithScientificNotation(3, false, 0)
 	}
 
@@ -244,5 +307,13 @@ func (p *parser) ParseSwapMemoV121() (SwapMemo, error) {
 	dexTargetAddress := p.get(7)
 	dexTargetLimit := p.getUintWithScientificNotation(8, false, 0)
 
-	return NewSwapMemo(asset, destination, slip, affAddr, totalAffBps, dexAgg, dexTargetAddress, dexTargetLimit, order, streamQuantity, streamInterval, refundAddress, affiliates, affFeeBps), p.Error()
+	swapMemo := NewSwapMemo(asset, destination, slip, affAddr, totalAffBps, dexAgg, dexTargetAddress, dexTargetLimit, order, streamQuantity, streamInterval, refundAddress, affiliates, affFeeBps)
+	switch order {
+	case types.OrderType_limit:
+		swapMemo.TxType = TxLimitOrder
+		swapMemo.ExpiryHeight = expiryHeight
+	case types.OrderType_dca:
+		swapMemo.TxType = TxDCA
+	}
+	return swapMemo, p.Error()
 }
@@ -393,3 +393,87 @@ func (p *parser) ParseSwapMemoV118() (SwapMemo, error) {
 
 	return NewSwapMemo(asset, destination, slip, affAddr, totalAffBps, dexAgg, dexTargetAddress, dexTargetLimit, order, streamQuantity, streamInterval, refundAddress, affiliates, affFeeBps), p.Error()
 }
+
+func (p *parser) ParseSwapMemoV121() (SwapMemo, error) {
+	var err error
+	var order types.OrderType
+	asset := p.getAsset(1, true, common.EmptyAsset)
+
+	// DESTADDR can be empty , if it is empty , it will swap to the sender address
+	destination, refundAddress := p.getAddressAndRefundAddressWithKeeper(2, false, common.NoAddress, asset.Chain)
+
+	// price limit can be empty , when it is empty , there is no price protection
+	var slip cosmos.Uint
+	streamInterval := uint64(0)
+	streamQuantity := uint64(0)
+	if strings.Contains(p.get(3), "/") {
+		parts := strings.SplitN(p.get(3), "/", 3)
+		for i := range parts {
+			if parts[i] == "" {
+				parts[i] = "0"
+			}
+		}
+		if len(parts) < 1 {
+			return SwapMemo{}, fmt.Errorf("invalid streaming swap format: %s", p.get(3))
+		}
+		slip, err = parseTradeTarget(parts[0])
+		if err != nil {
+			return SwapMemo{}, fmt.Errorf("swap price limit:%s is invalid: %s", parts[0], err)
+		}
+		if len(parts) > 1 {
+			streamInterval, err = strconv.ParseUint(parts[1], 10, 64)
+			if err != nil {
+				return SwapMemo{}, fmt.Errorf("failed to parse stream frequency: %s: %s", parts[1], err)
+			}
+		}
+		if len(parts) > 2 {
+			streamQuantity, err = strconv.ParseUint(parts[2], 10, 64)
+			if err != nil {
+				return SwapMemo{}, fmt.Errorf("failed to parse stream quantity: %s: %s", parts[2], err)
+			}
+		}
+	} else {
+		slip = p.getUintWithScientificNotation(3, false, 0)
+	}
+
+	maxAffiliateFeeBasisPoints := cosmos.NewUint(uint64(p.getConfigInt64(constants.MaxAffiliateFeeBasisPoints)))
+	affiliates, affFeeBps, totalAffBps := p.getMultipleAffiliatesAndBps(4, false, maxAffiliateFeeBasisPoints)
+
+	maxAffiliates := p.getConfigInt64(constants.MultipleAffiliatesMaxCount)
+	if len(affiliates) > int(maxAffiliates) {
+		return SwapMemo{}, fmt.Errorf("maximum allowed affiliates is %d", maxAffiliates)
+	}
+
+	// TODO: Remove on hardfork
+	// Set a affiliate address (even though it is not used) - to pass validation
+	affAddr := common.NoAddress
+	if !totalAffBps.IsZero() && len(affiliates) > 0 {
+		affAddr = p.getAddressFromString(affiliates[0], common.BASEChain, false)
+		// if affiliate address is empty and mayaname exists, that means mayaname doesn't have maya alias, use the owner address
+		if affAddr.IsEmpty() && p.keeper.MAYANameExists(p.ctx, affiliates[0]) {
+			var mn types.MAYAName
+			mn, err = p.keeper.GetMAYAName(p.ctx, affiliates[0])
+			if err != nil {
+				return SwapMemo{}, fmt.Errorf("failed to get MAYAName %s: %w", affiliates[0], err)
+			}
+			affAddr = common.Address(mn.Owner.String())
+			// if owner is empty, try maya alias
+			if affAddr.IsEmpty() {
+				affAddr = mn.GetAlias(common.BASEChain)
+			}
+			// if for some reason both owner and maya alias are empty, set affiliate collector module as affiliate address (used only for validation, no funds will be sent there)
+			if affAddr.IsEmpty() {
+				affAddr, err = p.keeper.GetModuleAddress(types.AffiliateCollectorName)
+				if err != nil {
+					return SwapMemo{}, fmt.Errorf("failed to get affiliate collector module address: %w", err)
+				}
+			}
+		}
+	}
+
+	dexAgg := p.get(6)
+	dexTargetAddress := p.get(7)
+	dexTargetLimit := p.getUintWithScientificNotation(8, false, 0)
+
+	return NewSwapMemo(asset, destination, slip, affAddr, totalAffBps, dexAgg, dexTargetAddress, dexTargetLimit, order, streamQuantity, streamInterval, refundAddress, affiliates, affFeeBps), p.Error()
+}
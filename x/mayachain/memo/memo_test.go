@@ -6,6 +6,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/blang/semver"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/store"
 	"github.com/tendermint/tendermint/libs/log"
@@ -121,7 +122,7 @@ func (s *MemoSuite) SetUpSuite(c *C) {
 }
 
 func (s *MemoSuite) TestTxType(c *C) {
-	for _, trans := range []TxType{TxAdd, TxWithdraw, TxSwap, TxOutbound, TxDonate, TxBond, TxUnbond, TxLeave} {
+	for _, trans := range []TxType{TxAdd, TxWithdraw, TxSwap, TxLimitOrder, TxCancelOrder, TxDCA, TxOutbound, TxDonate, TxBond, TxUnbond, TxLeave} {
 		tx, err := StringToTxType(trans.String())
 		c.Assert(err, IsNil)
 		c.Check(tx, Equals, trans)
@@ -198,6 +199,163 @@ func (s *MemoSuite) TestParseWithAbbreviated(c *C) {
 	fmt.Println(tr2)
 	c.Check(tr2.GetAddress().Equals(bnbAddr), Equals, true)
 
+	memo, err = ParseMemoWithMAYANames(ctx, k, fmt.Sprintf("trade=:%s", trAccAddr))
+	c.Assert(err, IsNil)
+	tr3, ok := memo.(TradeAccountTransferMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(tr3.IsType(TxTradeAccountTransfer), Equals, true)
+	c.Check(tr3.GetAccAddress().Equals(trAccAddr), Equals, true)
+
+	_, err = ParseMemoWithMAYANames(ctx, k, fmt.Sprintf("trade=:%s", bnbAddr))
+	c.Assert(err, NotNil)
+
+	// loan unit tests
+	memo, err = ParseMemoWithMAYANames(ctx, k, fmt.Sprintf("loan+:BNB.BNB:%s:1e8", bnbAddr))
+	c.Assert(err, IsNil)
+	lo, ok := memo.(LoanOpenMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(lo.IsType(TxLoanOpen), Equals, true)
+	c.Check(lo.IsInbound(), Equals, true)
+	c.Check(lo.GetAsset().Equals(common.BNBAsset), Equals, true)
+	c.Check(lo.GetDestination().Equals(bnbAddr), Equals, true)
+	c.Check(lo.GetAmount().Uint64(), Equals, uint64(common.One))
+
+	memo, err = ParseMemoWithMAYANames(ctx, k, fmt.Sprintf("$+:BNB.BNB:%s", bnbAddr))
+	c.Assert(err, IsNil)
+	c.Check(memo.IsType(TxLoanOpen), Equals, true)
+	c.Check(memo.GetAmount().IsZero(), Equals, true)
+
+	memo, err = ParseMemoWithMAYANames(ctx, k, fmt.Sprintf("loan-:BTC~BTC:%s", trAccAddr))
+	c.Assert(err, IsNil)
+	lr, ok := memo.(LoanRepaymentMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(lr.IsType(TxLoanRepayment), Equals, true)
+	c.Check(lr.GetAsset().Equals(common.BTCAsset.GetTradeAsset()), Equals, true)
+	c.Check(lr.GetAccAddress().Equals(trAccAddr), Equals, true)
+
+	_, err = ParseMemoWithMAYANames(ctx, k, "loan-:BTC~BTC")
+	c.Assert(err, NotNil)
+
+	// affiliate claim unit tests
+	memo, err = ParseMemoWithMAYANames(ctx, k, "claim:alice")
+	c.Assert(err, IsNil)
+	ac, ok := memo.(AffiliateClaimMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(ac.IsType(TxAffiliateClaim), Equals, true)
+	c.Check(ac.Name, Equals, "alice")
+	c.Check(ac.GetAsset().IsEmpty(), Equals, true)
+	c.Check(ac.String(), Equals, "claim:alice")
+
+	memo, err = ParseMemoWithMAYANames(ctx, k, "claim:alice:BTC.BTC")
+	c.Assert(err, IsNil)
+	c.Check(memo.GetAsset().Equals(common.BTCAsset), Equals, true)
+	c.Check(memo.String(), Equals, "claim:alice:BTC.BTC")
+
+	_, err = ParseMemoWithMAYANames(ctx, k, "claim")
+	c.Assert(err, NotNil)
+	_, err = ParseMemoWithMAYANames(ctx, k, "claim:alice:NOT-AN-ASSET!")
+	c.Assert(err, NotNil)
+
+	// mayaname sale unit tests
+	memo, err = ParseMemoWithMAYANames(ctx, k, "list:alice:100e8")
+	c.Assert(err, IsNil)
+	ml, ok := memo.(MAYANameListMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(ml.IsType(TxMAYANameList), Equals, true)
+	c.Check(ml.GetName(), Equals, "alice")
+	c.Check(ml.GetAmount().Uint64(), Equals, uint64(100*common.One))
+	c.Check(ml.GetBlockExpire(), Equals, int64(0))
+	c.Check(ml.KeepAliases, Equals, false)
+	c.Check(ml.KeepSubaffiliates, Equals, false)
+	c.Check(ml.String(), Equals, "list:alice:10000000000")
+
+	memo, err = ParseMemoWithMAYANames(ctx, k, "list:alice:100:500:as")
+	c.Assert(err, IsNil)
+	ml, ok = memo.(MAYANameListMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(ml.GetBlockExpire(), Equals, int64(500))
+	c.Check(ml.KeepAliases, Equals, true)
+	c.Check(ml.KeepSubaffiliates, Equals, true)
+	c.Check(ml.String(), Equals, "list:alice:100:500:as")
+
+	memo, err = ParseMemoWithMAYANames(ctx, k, "list:alice:100::s")
+	c.Assert(err, IsNil)
+	ml, ok = memo.(MAYANameListMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(ml.KeepAliases, Equals, false)
+	c.Check(ml.KeepSubaffiliates, Equals, true)
+
+	_, err = ParseMemoWithMAYANames(ctx, k, "list:alice")
+	c.Assert(err, NotNil)
+	_, err = ParseMemoWithMAYANames(ctx, k, "list:alice:100:500:x")
+	c.Assert(err, NotNil)
+
+	memo, err = ParseMemoWithMAYANames(ctx, k, "delist:alice")
+	c.Assert(err, IsNil)
+	c.Check(memo.IsType(TxMAYANameDelist), Equals, true)
+	c.Check(memo.String(), Equals, "delist:alice")
+
+	memo, err = ParseMemoWithMAYANames(ctx, k, "buy:alice")
+	c.Assert(err, IsNil)
+	mb, ok := memo.(MAYANameBuyMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(mb.IsType(TxMAYANameBuy), Equals, true)
+	c.Check(mb.GetName(), Equals, "alice")
+
+	_, err = ParseMemoWithMAYANames(ctx, k, "buy")
+	c.Assert(err, NotNil)
+
+	// bond provider management unit tests
+	nodeAddr := types.GetRandomBech32Addr()
+	providerAddr := types.GetRandomBech32Addr()
+	memo, err = ParseMemoWithMAYANames(ctx, k, fmt.Sprintf("bp+:%s:%s", nodeAddr, providerAddr))
+	c.Assert(err, IsNil)
+	bw, ok := memo.(BondProviderWhitelistMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(bw.IsType(TxBondProviderWhitelist), Equals, true)
+	c.Check(bw.GetAccAddress().Equals(nodeAddr), Equals, true)
+	c.Check(bw.ProviderAddress.Equals(providerAddr), Equals, true)
+	c.Check(bw.String(), Equals, fmt.Sprintf("bp+:%s:%s", nodeAddr, providerAddr))
+
+	memo, err = ParseMemoWithMAYANames(ctx, k, fmt.Sprintf("bp-:%s:%s", nodeAddr, providerAddr))
+	c.Assert(err, IsNil)
+	br, ok := memo.(BondProviderRemoveMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(br.IsType(TxBondProviderRemove), Equals, true)
+	c.Check(br.ProviderAddress.Equals(providerAddr), Equals, true)
+
+	_, err = ParseMemoWithMAYANames(ctx, k, fmt.Sprintf("bp+:%s", nodeAddr))
+	c.Assert(err, NotNil)
+
+	memo, err = ParseMemoWithMAYANames(ctx, k, fmt.Sprintf("bpfee:%s:2500", nodeAddr))
+	c.Assert(err, IsNil)
+	bf, ok := memo.(NodeOperatorFeeMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(bf.IsType(TxNodeOperatorFee), Equals, true)
+	c.Check(bf.OperatorFee, Equals, int64(2500))
+	c.Check(bf.String(), Equals, fmt.Sprintf("bpfee:%s:2500", nodeAddr))
+
+	_, err = ParseMemoWithMAYANames(ctx, k, fmt.Sprintf("bpfee:%s", nodeAddr))
+	c.Assert(err, NotNil)
+
+	memo, err = ParseMemoWithMAYANames(ctx, k, fmt.Sprintf("bp=:%s:%s:BTC.BTC", nodeAddr, providerAddr))
+	c.Assert(err, IsNil)
+	bt, ok := memo.(BondProviderTransferMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(bt.IsType(TxBondProviderTransfer), Equals, true)
+	c.Check(bt.GetAsset().Equals(common.BTCAsset), Equals, true)
+	c.Check(bt.GetAmount().IsZero(), Equals, true)
+	c.Check(bt.ToAddress.Equals(providerAddr), Equals, true)
+	c.Check(bt.String(), Equals, fmt.Sprintf("bp=:%s:%s:BTC.BTC", nodeAddr, providerAddr))
+
+	memo, err = ParseMemoWithMAYANames(ctx, k, fmt.Sprintf("bp=:%s:%s:BTC.BTC:1000", nodeAddr, providerAddr))
+	c.Assert(err, IsNil)
+	c.Check(memo.GetAmount().Uint64(), Equals, uint64(1000))
+	c.Check(memo.String(), Equals, fmt.Sprintf("bp=:%s:%s:BTC.BTC:1000", nodeAddr, providerAddr))
+
+	_, err = ParseMemoWithMAYANames(ctx, k, fmt.Sprintf("bp=:%s:%s", nodeAddr, providerAddr))
+	c.Assert(err, NotNil)
+
 	// custom refund address
 	refundAddr := types.GetRandomBaseAddress()
 	memo, err = ParseMemoWithMAYANames(ctx, k, fmt.Sprintf("=:b:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6/%s:87e7", refundAddr.String()))
@@ -454,6 +612,106 @@ func (s *MemoSuite) TestParseWithAbbreviated(c *C) {
 	c.Assert(err, NotNil)
 }
 
+func (s *MemoSuite) TestParseLimitOrder(c *C) {
+	ctx := s.ctx
+	k := s.k
+
+	// test limit order with expiry height
+	memo, err := ParseMemoWithMAYANames(ctx, k, "limito:"+common.ETHAsset.String()+":0xe3c64974c78f5693bd2bc68b3221d58df5c6e877:1200/500")
+	c.Assert(err, IsNil)
+	c.Check(memo.IsType(TxLimitOrder), Equals, true, Commentf("MEMO: %+v", memo))
+	c.Check(memo.GetSlipLimit().Equal(cosmos.NewUint(1200)), Equals, true)
+	c.Check(memo.IsInbound(), Equals, true)
+	swapMemo, ok := memo.(SwapMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(swapMemo.GetOrderType(), Equals, types.OrderType_limit)
+	c.Check(swapMemo.GetExpiryHeight(), Equals, int64(500))
+	c.Check(swapMemo.GetStreamQuantity(), Equals, uint64(0))
+	c.Check(swapMemo.GetStreamInterval(), Equals, uint64(0))
+	c.Check(swapMemo.String(), Equals, "limito:ETH.ETH:0xe3c64974c78f5693bd2bc68b3221d58df5c6e877:1200/500")
+
+	// limit order without expiry height
+	memo, err = ParseMemoWithMAYANames(ctx, k, "lo:"+common.ETHAsset.String()+":0xe3c64974c78f5693bd2bc68b3221d58df5c6e877:1200")
+	c.Assert(err, IsNil)
+	swapMemo, ok = memo.(SwapMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(swapMemo.GetOrderType(), Equals, types.OrderType_limit)
+	c.Check(swapMemo.GetExpiryHeight(), Equals, int64(0))
+	c.Check(swapMemo.String(), Equals, "limito:ETH.ETH:0xe3c64974c78f5693bd2bc68b3221d58df5c6e877:1200")
+
+	// limit orders need a price limit
+	_, err = ParseMemoWithMAYANames(ctx, k, "limito:"+common.ETHAsset.String()+":0xe3c64974c78f5693bd2bc68b3221d58df5c6e877::")
+	c.Assert(err, NotNil)
+	_, err = ParseMemoWithMAYANames(ctx, k, "limito:"+common.ETHAsset.String()+":0xe3c64974c78f5693bd2bc68b3221d58df5c6e877:1200/bogus")
+	c.Assert(err, NotNil)
+
+	// test cancel order
+	txID := types.GetRandomTxHash()
+	memo, err = ParseMemoWithMAYANames(ctx, k, "cancel:"+txID.String())
+	c.Assert(err, IsNil)
+	c.Check(memo.IsType(TxCancelOrder), Equals, true)
+	c.Check(memo.GetTxID().Equals(txID), Equals, true)
+	c.Check(memo.IsInbound(), Equals, true)
+	c.Check(memo.String(), Equals, "cancel:"+txID.String())
+	_, err = ParseMemoWithMAYANames(ctx, k, "cancel")
+	c.Assert(err, NotNil)
+}
+
+func (s *MemoSuite) TestParseNewTxTypesBeforeV124(c *C) {
+	// memos of tx types added in 1.124.0 are unknown to earlier versions
+	version := semver.MustParse("1.123.0")
+	for _, memo := range []string{
+		"limito:ETH.ETH:0xe3c64974c78f5693bd2bc68b3221d58df5c6e877:1200/500",
+		"lo:ETH.ETH:0xe3c64974c78f5693bd2bc68b3221d58df5c6e877:1200",
+		"cancel:" + types.GetRandomTxHash().String(),
+		"dca:ETH.ETH:0xe3c64974c78f5693bd2bc68b3221d58df5c6e877:1200/14400/30",
+		"trade=:" + types.GetRandomBech32Addr().String(),
+		"loan+:BNB.BNB:" + types.GetRandomBNBAddress().String(),
+		"$+:BNB.BNB:" + types.GetRandomBNBAddress().String(),
+		"loan-:BTC~BTC:" + types.GetRandomBech32Addr().String(),
+		"$-:BTC~BTC:" + types.GetRandomBech32Addr().String(),
+		"claim:alice",
+		"list:alice:100e8",
+		"delist:alice",
+		"buy:alice",
+		"bp+:" + types.GetRandomBech32Addr().String() + ":" + types.GetRandomBech32Addr().String(),
+		"bp-:" + types.GetRandomBech32Addr().String() + ":" + types.GetRandomBech32Addr().String(),
+		"bpfee:" + types.GetRandomBech32Addr().String() + ":2500",
+		"bp=:" + types.GetRandomBech32Addr().String() + ":" + types.GetRandomBech32Addr().String(),
+	} {
+		_, err := ParseMemo(version, memo)
+		c.Check(err, ErrorMatches, "TxType not supported.*", Commentf("%s", memo))
+	}
+}
+
+func (s *MemoSuite) TestParseDCAOrder(c *C) {
+	ctx := s.ctx
+	k := s.k
+
+	memo, err := ParseMemoWithMAYANames(ctx, k, "dca:"+common.ETHAsset.String()+":0xe3c64974c78f5693bd2bc68b3221d58df5c6e877:1200/14400/30")
+	c.Assert(err, IsNil)
+	c.Check(memo.IsType(TxDCA), Equals, true, Commentf("MEMO: %+v", memo))
+	c.Check(memo.GetSlipLimit().Equal(cosmos.NewUint(1200)), Equals, true)
+	c.Check(memo.IsInbound(), Equals, true)
+	swapMemo, ok := memo.(SwapMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(swapMemo.GetOrderType(), Equals, types.OrderType_dca)
+	c.Check(swapMemo.GetStreamInterval(), Equals, uint64(14400))
+	c.Check(swapMemo.GetStreamQuantity(), Equals, uint64(30))
+	c.Check(swapMemo.String(), Equals, "dca:ETH.ETH:0xe3c64974c78f5693bd2bc68b3221d58df5c6e877:1200/14400/30")
+
+	// the slice limit is optional
+	memo, err = ParseMemoWithMAYANames(ctx, k, "dca:"+common.ETHAsset.String()+":0xe3c64974c78f5693bd2bc68b3221d58df5c6e877:/100/2")
+	c.Assert(err, IsNil)
+	c.Check(memo.GetSlipLimit().IsZero(), Equals, true)
+
+	// dca orders need an interval and at least two slices
+	for _, lim := range []string{"", "1200", "1200/14400", "1200/0/30", "1200/14400/1", "1200/bogus/30"} {
+		_, err = ParseMemoWithMAYANames(ctx, k, "dca:"+common.ETHAsset.String()+":0xe3c64974c78f5693bd2bc68b3221d58df5c6e877:"+lim)
+		c.Check(err, NotNil, Commentf("%s", lim))
+	}
+}
+
 func (s *MemoSuite) TestParse(c *C) {
 	ctx := s.ctx
 	k := s.k
@@ -1,6 +1,7 @@
 package mayachain
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -26,6 +27,7 @@ import (
 	"gitlab.com/mayachain/mayanode/x/mayachain/client/cli"
 	"gitlab.com/mayachain/mayanode/x/mayachain/client/rest"
 	"gitlab.com/mayachain/mayanode/x/mayachain/keeper"
+	"gitlab.com/mayachain/mayanode/x/mayachain/types"
 )
 
 // type check to ensure the interface is properly implemented
@@ -74,10 +76,11 @@ func (AppModuleBasic) RegisterRESTRoutes(ctx client.Context, rtr *mux.Router) {
 	sdkRest.RegisterRoutes(ctx, rtr, StoreKey)
 }
 
-// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the mint module.
-// mayanode current doesn't have grpc endpoint yet
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the mayachain module.
 func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
-	// types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx))
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
 }
 
 // GetQueryCmd get the root query command of this module
@@ -98,6 +101,7 @@ type AppModule struct {
 	mgr              *Mgrs
 	keybaseStore     cosmos.KeybaseStore
 	telemetryEnabled bool
+	queryCtx         QueryContextFunc
 }
 
 // NewAppModule creates a new AppModule Object
@@ -114,6 +118,13 @@ func NewAppModule(k keeper.Keeper, cdc codec.BinaryCodec, coinKeeper bankkeeper.
 	}
 }
 
+// WithQueryContext sets the function the gRPC server streams read the state
+// with, as the BaseApp only creates query contexts for unary calls
+func (am AppModule) WithQueryContext(queryCtx QueryContextFunc) AppModule {
+	am.queryCtx = queryCtx
+	return am
+}
+
 func (AppModule) Name() string {
 	return ModuleName
 }
@@ -144,7 +155,7 @@ func (am AppModule) LegacyQuerierHandler(legacyQuerierCdc *codec.LegacyAmino) sd
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	// types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
-	// types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+	types.RegisterQueryServer(cfg.QueryServer(), NewQueryServerImpl(am.mgr, am.queryCtx))
 }
 
 func (am AppModule) NewQuerierHandler() sdk.Querier {
@@ -216,9 +227,18 @@ func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.V
 	if am.mgr.GetVersion().LT(semver.MustParse("1.90.0")) {
 		_ = am.mgr.Keeper().GetLowestActiveVersion(ctx) // TODO: remove me on hard fork
 	}
+	if am.mgr.GetVersion().GTE(semver.MustParse("1.124.0")) && am.mgr.Keeper().GetConfigInt64(ctx, constants.EnableOrderBooks) > 0 {
+		if err := am.mgr.OrderBookMgr().EndBlock(ctx, am.mgr); err != nil {
+			ctx.Logger().Error("fail to process order books", "error", err)
+		}
+	}
 	if err := am.mgr.SwapQ().EndBlock(ctx, am.mgr); err != nil {
 		ctx.Logger().Error("fail to process swap queue", "error", err)
 	}
+	if am.mgr.GetVersion().GTE(semver.MustParse("1.124.0")) {
+		expireMAYANameListings(ctx, am.mgr)
+		liquidateLoans(ctx, am.mgr)
+	}
 
 	// slash node accounts for not observing any accepted inbound tx
 	if err := am.mgr.Slasher().LackObserving(ctx, am.mgr.GetConstants()); err != nil {
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -112,14 +113,24 @@ func NewQuerier(mgr *Mgrs, kbs cosmos.KeybaseStore) cosmos.Querier {
 			return queryLastBlockHeights(ctx, path[1:], req, mgr)
 		case q.QueryNode.Key:
 			return queryNode(ctx, path[1:], req, mgr)
+		case q.QueryNodeSlashes.Key:
+			return queryNodeSlashes(ctx, path[1:], mgr)
 		case q.QueryNodes.Key:
 			return queryNodes(ctx, path[1:], req, mgr)
+		case q.QueryChurnPreview.Key:
+			return queryChurnPreview(ctx, mgr)
 		case q.QueryInboundAddresses.Key:
 			return queryInboundAddresses(ctx, path[1:], req, mgr)
 		case q.QueryNetwork.Key:
 			return queryNetwork(ctx, mgr)
 		case q.QueryPOL.Key:
 			return queryPOL(ctx, mgr)
+		case q.QueryPOLPreview.Key:
+			return queryPOLPreview(ctx, mgr)
+		case q.QueryPOLPools.Key:
+			return queryPOLPools(ctx, mgr)
+		case q.QueryPOLPoolHistory.Key:
+			return queryPOLPoolHistory(ctx, path[1:], mgr)
 		case q.QueryBalanceModule.Key:
 			return queryBalanceModule(ctx, path[1:], mgr)
 		case q.QueryVaultsAsgard.Key:
@@ -128,6 +139,10 @@ func NewQuerier(mgr *Mgrs, kbs cosmos.KeybaseStore) cosmos.Querier {
 			return queryYggdrasilVaults(ctx, mgr)
 		case q.QueryVault.Key:
 			return queryVault(ctx, path[1:], mgr)
+		case q.QueryVaultMigration.Key:
+			return queryVaultMigration(ctx, path[1:], mgr)
+		case q.QueryVaultSolvency.Key:
+			return queryVaultSolvency(ctx, path[1:], mgr)
 		case q.QueryVaultPubkeys.Key:
 			return queryVaultsPubkeys(ctx, mgr)
 		case q.QueryConstantValues.Key:
@@ -162,24 +177,72 @@ func NewQuerier(mgr *Mgrs, kbs cosmos.KeybaseStore) cosmos.Querier {
 			return queryScheduledOutbound(ctx, mgr)
 		case q.QuerySwapQueue.Key:
 			return querySwapQueue(ctx, mgr)
+		case q.QuerySwapQueuePage.Key:
+			return querySwapQueuePage(ctx, req.Data, mgr)
+		case q.QueryPendingOutboundPage.Key:
+			return queryPendingOutboundPage(ctx, req.Data, mgr)
+		case q.QueryScheduledOutboundPage.Key:
+			return queryScheduledOutboundPage(ctx, req.Data, mgr)
 		case q.QueryStreamingSwap.Key:
 			return queryStreamingSwap(ctx, path[1:], mgr)
 		case q.QueryStreamingSwaps.Key:
 			return queryStreamingSwaps(ctx, mgr)
+		case q.QueryOrderBooks.Key:
+			return queryOrderBooks(ctx, mgr)
+		case q.QueryOrderBook.Key:
+			return queryOrderBook(ctx, path[1:], mgr)
+		case q.QueryOrderBookOrder.Key:
+			return queryOrderBookOrder(ctx, path[1:], mgr)
+		case q.QueryDCAOrders.Key:
+			return queryDCAOrders(ctx, mgr)
+		case q.QueryDCAOrder.Key:
+			return queryDCAOrder(ctx, path[1:], mgr)
+		case q.QueryLoans.Key:
+			return queryLoans(ctx, mgr)
+		case q.QueryOwnerLoans.Key:
+			return queryOwnerLoans(ctx, path[1:], mgr)
+		case q.QueryAffiliateCollectors.Key:
+			return queryAffiliateCollectors(ctx, mgr)
+		case q.QueryAffiliateCollector.Key:
+			return queryAffiliateCollector(ctx, path[1:], mgr)
+		case q.QueryPortfolio.Key:
+			return queryPortfolio(ctx, path[1:], mgr)
 		case q.QueryTssKeygenMetrics.Key:
 			return queryTssKeygenMetric(ctx, path[1:], req, mgr)
 		case q.QueryTssMetrics.Key:
 			return queryTssMetric(ctx, path[1:], req, mgr)
 		case q.QueryMAYAName.Key:
 			return queryMAYAName(ctx, path[1:], req, mgr)
+		case q.QueryMAYANamesByOwner.Key:
+			return queryMAYANamesByOwner(ctx, path[1:], mgr)
+		case q.QueryMAYANamesByAddress.Key:
+			return queryMAYANamesByAddress(ctx, path[1:], mgr)
+		case q.QueryMAYANameListings.Key:
+			return queryMAYANameListings(ctx, mgr)
+		case q.QueryMAYANameListing.Key:
+			return queryMAYANameListing(ctx, path[1:], mgr)
 		case q.QueryLiquidityAuctionTier.Key:
 			return queryLiquidityAuctionTier(ctx, path[1:], req, mgr)
 		case q.QueryQuoteSwap.Key:
 			return queryQuoteSwap(ctx, req, mgr)
+		case q.QueryQuoteSwapRoutes.Key:
+			return queryQuoteSwapRoutes(ctx, req, mgr)
 		case q.QueryQuoteSaverDeposit.Key:
 			return queryQuoteSaverDeposit(ctx, path[1:], req, mgr)
 		case q.QueryQuoteSaverWithdraw.Key:
 			return queryQuoteSaverWithdraw(ctx, path[1:], req, mgr)
+		case q.QueryQuoteLiquidityAdd.Key:
+			return queryQuoteLiquidityAdd(ctx, path[1:], req, mgr)
+		case q.QueryQuoteLiquidityWithdraw.Key:
+			return queryQuoteLiquidityWithdraw(ctx, path[1:], req, mgr)
+		case q.QueryQuoteTradeDeposit.Key:
+			return queryQuoteTradeDeposit(ctx, path[1:], req, mgr)
+		case q.QueryQuoteTradeWithdraw.Key:
+			return queryQuoteTradeWithdraw(ctx, path[1:], req, mgr)
+		case q.QueryQuoteCacaoPoolDeposit.Key:
+			return queryQuoteCacaoPoolDeposit(ctx, path[1:], req, mgr)
+		case q.QueryQuoteCacaoPoolWithdraw.Key:
+			return queryQuoteCacaoPoolWithdraw(ctx, path[1:], req, mgr)
 		case q.QueryInvariants.Key:
 			return queryInvariants(ctx, mgr)
 		case q.QueryInvariant.Key:
@@ -242,6 +305,10 @@ func queryMAYAName(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr
 		return nil, ErrInternal(err, "fail to fetch MAYAName")
 	}
 
+	return jsonify(ctx, newMAYANameResponse(ctx, mgr, name))
+}
+
+func newMAYANameResponse(ctx cosmos.Context, mgr *Mgrs, name MAYAName) openapi.Mayaname {
 	affRune := cosmos.ZeroUint()
 	affCol, err := mgr.Keeper().GetAffiliateCollector(ctx, name.Owner)
 	if err == nil {
@@ -268,7 +335,7 @@ func queryMAYAName(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr
 
 	threshold := getPreferredAssetSwapThreshold(ctx, mgr, name.PreferredAsset)
 
-	resp := openapi.Mayaname{
+	return openapi.Mayaname{
 		Name:                             wrapString(name.Name),
 		ExpireBlockHeight:                wrapInt64(name.ExpireBlockHeight),
 		Owner:                            wrapString(name.Owner.String()),
@@ -279,8 +346,6 @@ func queryMAYAName(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr
 		AffiliateBps:                     wrapInt64(int64(name.GetAffiliateBps().BigInt().Uint64())),
 		Subaffiliates:                    subaffiliates,
 	}
-
-	return jsonify(ctx, resp)
 }
 
 func queryVault(ctx cosmos.Context, path []string, mgr *Mgrs) ([]byte, error) {
@@ -318,6 +383,166 @@ func queryVault(ctx cosmos.Context, path []string, mgr *Mgrs) ([]byte, error) {
 	return jsonify(ctx, resp)
 }
 
+func queryVaultMigration(ctx cosmos.Context, path []string, mgr *Mgrs) ([]byte, error) {
+	if len(path) < 1 {
+		return nil, errors.New("not enough parameters")
+	}
+	pubkey, err := common.NewPubKey(path[0])
+	if err != nil {
+		return nil, fmt.Errorf("%s is invalid pubkey", path[0])
+	}
+	v, err := mgr.Keeper().GetVault(ctx, pubkey)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get vault with pubkey(%s),err:%w", pubkey, err)
+	}
+	if v.IsEmpty() {
+		return nil, errors.New("vault not found")
+	}
+	if v.Status != RetiringVault && v.Status != InactiveVault {
+		return nil, fmt.Errorf("vault(%s) is not migrating, status: %s", pubkey, v.Status)
+	}
+	migration, err := mgr.Keeper().GetVaultMigration(ctx, pubkey)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get vault migration(%s): %w", pubkey, err)
+	}
+
+	migrateInterval := fetchConfigInt64(ctx, mgr, constants.FundMigrationInterval)
+	rounds := mgr.GetConstants().GetInt64Value(constants.ChurnMigrateRounds)
+	resp := openapi.VaultMigration{
+		PubKey:           v.PubKey.String(),
+		Status:           v.Status.String(),
+		RetiringHeight:   v.StatusSince,
+		MigrateInterval:  migrateInterval,
+		Rounds:           rounds,
+		Signers:          make([]string, 0),
+		Chains:           make([]openapi.VaultMigrationChain, 0),
+		PendingOutbounds: make([]openapi.TxOutItem, 0),
+	}
+	if migration.CompletedHeight > 0 {
+		resp.CompletedHeight = wrapInt64(migration.CompletedHeight)
+	}
+
+	if v.Status == RetiringVault && migrateInterval > 0 {
+		// a migration round runs every migrate interval blocks from the height
+		// the vault started retiring, the last round migrates all the funds left
+		started := (ctx.BlockHeight()-v.StatusSince)/migrateInterval + 1
+		if started < 0 {
+			started = 0
+		}
+		if started < rounds {
+			resp.RoundsRemaining = rounds - started
+		}
+		resp.NextRoundHeight = wrapInt64(v.StatusSince + started*migrateInterval)
+		// once all the rounds have run, funds left behind are migrated on the
+		// next round
+		completion := v.StatusSince + (rounds-1)*migrateInterval
+		if resp.RoundsRemaining == 0 {
+			completion = resp.GetNextRoundHeight()
+		}
+		resp.EstimatedCompletionHeight = wrapInt64(completion)
+	}
+
+	for _, member := range v.GetMembership() {
+		var na NodeAccount
+		na, err = mgr.Keeper().GetNodeAccountByPubKey(ctx, member)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get node account by pubkey(%s): %w", member, err)
+		}
+		if na.IsEmpty() {
+			continue
+		}
+		resp.Signers = append(resp.Signers, na.NodeAddress.String())
+	}
+
+	chains := v.GetChains()
+	for _, coins := range []common.Coins{v.Coins, migration.Migrated} {
+		for _, coin := range coins {
+			if !chains.Has(coin.Asset.GetChain()) {
+				chains = append(chains, coin.Asset.GetChain())
+			}
+		}
+	}
+	for _, chain := range chains {
+		item := openapi.VaultMigrationChain{
+			Chain:     chain.String(),
+			Remaining: make([]openapi.Coin, 0),
+			Migrated:  make([]openapi.Coin, 0),
+		}
+		for _, coin := range v.Coins {
+			if coin.Asset.GetChain().Equals(chain) && !coin.IsEmpty() {
+				item.Remaining = append(item.Remaining, castCoin(coin))
+			}
+		}
+		for _, coin := range migration.Migrated {
+			if coin.Asset.GetChain().Equals(chain) {
+				item.Migrated = append(item.Migrated, castCoin(coin))
+			}
+		}
+		resp.Chains = append(resp.Chains, item)
+	}
+
+	outbounds, err := getPendingOutbounds(ctx, mgr)
+	if err != nil {
+		return nil, err
+	}
+	for _, toi := range outbounds {
+		if toi.GetVaultPubKey() != v.PubKey.String() {
+			continue
+		}
+		memo, _ := ParseMemoWithMAYANames(ctx, mgr.Keeper(), toi.GetMemo())
+		if !memo.IsType(TxMigrate) {
+			continue
+		}
+		resp.PendingOutbounds = append(resp.PendingOutbounds, toi)
+	}
+
+	return jsonify(ctx, resp)
+}
+
+func queryVaultSolvency(ctx cosmos.Context, path []string, mgr *Mgrs) ([]byte, error) {
+	if len(path) < 2 {
+		return nil, errors.New("not enough parameters")
+	}
+	pubkey, err := common.NewPubKey(path[0])
+	if err != nil {
+		return nil, fmt.Errorf("%s is invalid pubkey", path[0])
+	}
+	chain, err := common.NewChain(path[1])
+	if err != nil {
+		return nil, fmt.Errorf("%s is invalid chain", path[1])
+	}
+
+	resp := openapi.SolvencyHistory{
+		PubKey:  pubkey.String(),
+		Chain:   chain.String(),
+		Records: make([]openapi.SolvencyRecord, 0),
+	}
+	iterator := mgr.Keeper().GetSolvencyRecordIterator(ctx, pubkey, chain)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record SolvencyRecord
+		if err = mgr.Keeper().Cdc().Unmarshal(iterator.Value(), &record); err != nil {
+			return nil, fmt.Errorf("fail to unmarshal solvency record: %w", err)
+		}
+		item := openapi.SolvencyRecord{
+			Height: record.Height,
+			Deltas: make([]openapi.SolvencyDelta, 0, len(record.Deltas)),
+		}
+		for _, delta := range record.Deltas {
+			item.Deltas = append(item.Deltas, openapi.SolvencyDelta{
+				Asset:        delta.Asset.String(),
+				VaultAmount:  delta.VaultAmount.String(),
+				WalletAmount: delta.WalletAmount.String(),
+				Delta:        delta.Delta().String(),
+				Gap:          delta.Gap(),
+			})
+		}
+		resp.Records = append(resp.Records, item)
+	}
+
+	return jsonify(ctx, resp)
+}
+
 func queryAsgardVaults(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
 	vaults, err := mgr.Keeper().GetAsgardVaults(ctx)
 	if err != nil {
@@ -605,9 +830,27 @@ func queryCACAOProvider(ctx cosmos.Context, path []string, mgr *Mgrs) ([]byte, e
 		LastDepositHeight:  rp.LastDepositHeight,
 		LastWithdrawHeight: rp.LastWithdrawHeight,
 	}
+	setCACAOProviderOptions(ctx, mgr, &result, rp)
 	return jsonify(ctx, result)
 }
 
+// setCACAOProviderOptions adds the auto-compounding state and the withdraw
+// queued by the CACAO provider, if any, to the response
+func setCACAOProviderOptions(ctx cosmos.Context, mgr *Mgrs, result *openapi.CACAOProvider, rp types.CACAOProvider) {
+	result.AutoCompound = wrapBool(rp.AutoCompound)
+	if !rp.CompoundedAmount.IsZero() {
+		result.CompoundedAmount = wrapString(rp.CompoundedAmount.String())
+	}
+
+	msg, err := mgr.Keeper().GetCACAOPoolWithdrawRequest(ctx, rp.CacaoAddress)
+	if err != nil {
+		return
+	}
+	depositMaturity := mgr.Keeper().GetConfigInt64(ctx, constants.CACAOPoolDepositMaturityBlocks)
+	result.QueuedWithdrawBasisPoints = wrapInt64(int64(msg.BasisPoints.Uint64()))
+	result.QueuedWithdrawHeight = wrapInt64(rp.LastDepositHeight + depositMaturity)
+}
+
 // queryCACAOProviders
 func queryCACAOProviders(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
 	// get cacaopool value to determine current value and pnl
@@ -624,7 +867,7 @@ func queryCACAOProviders(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
 	iterator := mgr.Keeper().GetCACAOProviderIterator(ctx)
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
-		var rp types.CACAOProvider
+		rp := types.NewCACAOProvider(nil)
 		mgr.Keeper().Cdc().MustUnmarshal(iterator.Value(), &rp)
 
 		providerValue := common.GetSafeShare(rp.Units, cacaoPool.TotalUnits(), cacaoPoolValue)
@@ -632,7 +875,7 @@ func queryCACAOProviders(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
 		providerPnl.Sub(providerPnl, rp.DepositAmount.BigInt())
 		providerPnl.Add(providerPnl, rp.WithdrawAmount.BigInt())
 
-		cacaoProviders = append(cacaoProviders, openapi.CACAOProvider{
+		cacaoProvider := openapi.CACAOProvider{
 			CacaoAddress:       rp.CacaoAddress.String(),
 			Units:              rp.Units.String(),
 			Value:              providerValue.String(),
@@ -641,7 +884,9 @@ func queryCACAOProviders(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
 			WithdrawAmount:     rp.WithdrawAmount.String(),
 			LastDepositHeight:  rp.LastDepositHeight,
 			LastWithdrawHeight: rp.LastWithdrawHeight,
-		})
+		}
+		setCACAOProviderOptions(ctx, mgr, &cacaoProvider, rp)
+		cacaoProviders = append(cacaoProviders, cacaoProvider)
 	}
 	return jsonify(ctx, cacaoProviders)
 }
@@ -699,6 +944,23 @@ func queryPOL(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
 }
 
 func queryInboundAddresses(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	resp, err := getInboundAddresses(ctx, mgr)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := json.MarshalIndent(resp, "", "	")
+	if err != nil {
+		ctx.Logger().Error("fail to marshal current pool address to json", "error", err)
+		return nil, fmt.Errorf("fail to marshal current pool address to json: %w", err)
+	}
+
+	return res, nil
+}
+
+// getInboundAddresses returns the inbound address of every chain of the most
+// secure active asgard vault
+func getInboundAddresses(ctx cosmos.Context, mgr *Mgrs) ([]openapi.InboundAddress, error) {
 	active, err := mgr.Keeper().GetAsgardVaultsByStatus(ctx, ActiveVault)
 	if err != nil {
 		ctx.Logger().Error("fail to get active vaults", "error", err)
@@ -776,13 +1038,7 @@ func queryInboundAddresses(ctx cosmos.Context, path []string, req abci.RequestQu
 		resp = append(resp, addr)
 	}
 
-	res, err := json.MarshalIndent(resp, "", "	")
-	if err != nil {
-		ctx.Logger().Error("fail to marshal current pool address to json", "error", err)
-		return nil, fmt.Errorf("fail to marshal current pool address to json: %w", err)
-	}
-
-	return res, nil
+	return resp, nil
 }
 
 func populateBondAndBondedPools(ctx cosmos.Context, mgr *Mgrs, result *openapi.Node, nodeAddress cosmos.AccAddress) (cosmos.Uint, error) {
@@ -830,6 +1086,10 @@ func populateBondAndBondedPools(ctx cosmos.Context, mgr *Mgrs, result *openapi.N
 		NodeOperatorFee: bp.NodeOperatorFee.String(),
 		Providers:       providers,
 	}
+	if bp.HasPendingOperatorFee() {
+		result.BondProviders.PendingNodeOperatorFee = wrapString(cosmos.NewUint(uint64(bp.PendingNodeOperatorFee)).String())
+		result.BondProviders.PendingNodeOperatorFeeHeight = wrapInt64(bp.PendingNodeOperatorFeeHeight)
+	}
 
 	// Set bond
 	result.Bond = bond.String()
@@ -996,6 +1256,74 @@ func getNodeCurrentRewards(ctx cosmos.Context, mgr *Mgrs, nodeAcc NodeAccount, l
 	return reward, nil
 }
 
+// queryNodeSlashes return the slash points of the node per reason for the
+// current churn cycle, and its slash ledger
+// /mayachain/node/{nodeaddress}/slashes
+func queryNodeSlashes(ctx cosmos.Context, path []string, mgr *Mgrs) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, errors.New("node address not provided")
+	}
+	addr, err := cosmos.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, cosmos.ErrUnknownRequest("invalid account address")
+	}
+
+	slashPts, err := mgr.Keeper().GetNodeAccountSlashPoints(ctx, addr)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get node slash points: %w", err)
+	}
+	vaults, err := mgr.Keeper().GetAsgardVaultsByStatus(ctx, ActiveVault)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get active vaults: %w", err)
+	}
+	// same churn height the bad actor score of the node is relative to
+	var lastChurnHeight int64
+	for _, vault := range vaults {
+		if vault.BlockHeight > lastChurnHeight {
+			lastChurnHeight = vault.BlockHeight
+		}
+	}
+
+	result := openapi.NodeSlashes{
+		NodeAddress:           addr.String(),
+		SlashPoints:           slashPts,
+		ChurnCycleStartHeight: lastChurnHeight,
+		Totals:                make([]openapi.NodeSlashReason, 0),
+		Entries:               make([]openapi.NodeSlashEntry, 0),
+	}
+	totals := make(map[string]int64)
+	iterator := mgr.Keeper().GetNodeSlashEntryIterator(ctx, addr)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var entry NodeSlashEntry
+		if err = mgr.Keeper().Cdc().Unmarshal(iterator.Value(), &entry); err != nil {
+			return nil, fmt.Errorf("fail to unmarshal node slash entry: %w", err)
+		}
+		result.Entries = append(result.Entries, openapi.NodeSlashEntry{
+			Height: entry.Height,
+			Reason: entry.Reason,
+			Points: entry.Points,
+		})
+		if entry.Height >= lastChurnHeight {
+			totals[entry.Reason] += entry.Points
+		}
+	}
+
+	reasons := make([]string, 0, len(totals))
+	for reason := range totals {
+		reasons = append(reasons, reason)
+	}
+	sort.Strings(reasons)
+	for _, reason := range reasons {
+		result.Totals = append(result.Totals, openapi.NodeSlashReason{
+			Reason: reason,
+			Points: totals[reason],
+		})
+	}
+
+	return jsonify(ctx, result)
+}
+
 // queryNodes return all the nodes that has bond
 // /thorchain/nodes
 func queryNodes(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
@@ -1574,7 +1902,7 @@ func checkPending(ctx cosmos.Context, keeper keeper.Keeper, voter ObservedTxVote
 
 	memoType := memo.GetType()
 	// If the memo asset is a synth, as with Savers add liquidity or withdraw, a swap is assumed to be involved.
-	if memoType == TxSwap || memoType == TxLimitOrder || memo.GetAsset().IsVaultAsset() {
+	if memoType == TxSwap || memoType == TxLimitOrder || memoType == TxDCA || memo.GetAsset().IsVaultAsset() {
 		isSwap = true
 		// Only check the KVStore when the inbound transaction has already been finalised
 		// and when there haven't been any Actions planned.
@@ -2498,6 +2826,16 @@ func queryMimirWithKey(ctx cosmos.Context, path []string, req abci.RequestQuery,
 }
 
 func queryMimirValues(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	values, err := getMimirValues(ctx, mgr)
+	if err != nil {
+		return nil, err
+	}
+	return jsonify(ctx, values)
+}
+
+// getMimirValues returns the effective value of every mimir key that is set by
+// the admin or has reached node consensus
+func getMimirValues(ctx cosmos.Context, mgr *Mgrs) (map[string]int64, error) {
 	values := make(map[string]int64)
 
 	// collect keys
@@ -2530,7 +2868,7 @@ func queryMimirValues(ctx cosmos.Context, path []string, req abci.RequestQuery,
 		values[k] = v
 	}
 
-	return jsonify(ctx, values)
+	return values, nil
 }
 
 func queryMimirAdminValues(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
@@ -2647,6 +2985,11 @@ func queryBan(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgr
 }
 
 func queryScheduledOutbound(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
+	return jsonify(ctx, getScheduledOutbounds(ctx, mgr))
+}
+
+// getScheduledOutbounds returns the outbounds scheduled for future blocks
+func getScheduledOutbounds(ctx cosmos.Context, mgr *Mgrs) []openapi.TxOutItem {
 	result := make([]openapi.TxOutItem, 0)
 	constAccessor := mgr.GetConstants()
 	maxTxOutOffset, err := mgr.Keeper().GetMimir(ctx, constants.MaxTxOutOffset.String())
@@ -2665,14 +3008,25 @@ func queryScheduledOutbound(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
 			break
 		}
 		for _, toi := range txOut.TxArray {
-			result = append(result, castTxOutItem(toi, height))
+			item := castTxOutItem(toi, height)
+			item.Lane = wrapString(getTxOutLane(ctx, mgr.Keeper(), mgr.GetVersion(), toi))
+			result = append(result, item)
 		}
 	}
 
-	return jsonify(ctx, result)
+	return result
 }
 
 func queryPendingOutbound(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
+	result, err := getPendingOutbounds(ctx, mgr)
+	if err != nil {
+		return nil, err
+	}
+	return jsonify(ctx, result)
+}
+
+// getPendingOutbounds returns the outbounds which have not been signed yet
+func getPendingOutbounds(ctx cosmos.Context, mgr *Mgrs) ([]openapi.TxOutItem, error) {
 	constAccessor := mgr.GetConstants()
 	signingTransactionPeriod := constAccessor.GetInt64Value(constants.SigningTransactionPeriod)
 	rescheduleCoalesceBlocks := mgr.Keeper().GetConfigInt64(ctx, constants.RescheduleCoalesceBlocks)
@@ -2704,7 +3058,7 @@ func queryPendingOutbound(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
 		}
 	}
 
-	return jsonify(ctx, result)
+	return result, nil
 }
 
 func querySwapQueue(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
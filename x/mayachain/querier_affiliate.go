@@ -0,0 +1,146 @@
+package mayachain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	openapi "gitlab.com/mayachain/mayanode/openapi/gen"
+)
+
+// queryAffiliateCollectors returns every affiliate fee collector
+func queryAffiliateCollectors(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
+	affCols, err := mgr.Keeper().GetAffiliateCollectors(ctx)
+	if err != nil {
+		return nil, ErrInternal(err, "fail to get affiliate collectors")
+	}
+	pending, err := getPendingPreferredAssetSwaps(ctx, mgr)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]openapi.AffiliateCollector, 0, len(affCols))
+	for _, affCol := range affCols {
+		affColResp, err := newAffiliateCollectorResponse(ctx, mgr, affCol, pending)
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, affColResp)
+	}
+	return jsonify(ctx, resp)
+}
+
+// queryAffiliateCollector returns the affiliate fee collector of the given owner
+func queryAffiliateCollector(ctx cosmos.Context, path []string, mgr *Mgrs) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, errors.New("address not provided")
+	}
+	owner, err := cosmos.AccAddressFromBech32(path[0])
+	if err != nil {
+		ctx.Logger().Error("fail to parse address", "error", err)
+		return nil, fmt.Errorf("could not parse address: %w", err)
+	}
+	affCol, err := mgr.Keeper().GetAffiliateCollector(ctx, owner)
+	if err != nil {
+		return nil, ErrInternal(err, "fail to get affiliate collector")
+	}
+	pending, err := getPendingPreferredAssetSwaps(ctx, mgr)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := newAffiliateCollectorResponse(ctx, mgr, affCol, pending)
+	if err != nil {
+		return nil, err
+	}
+	return jsonify(ctx, resp)
+}
+
+// getPendingPreferredAssetSwaps returns the preferred asset swaps in the swap
+// queue by the owner they pay out to
+func getPendingPreferredAssetSwaps(ctx cosmos.Context, mgr *Mgrs) (map[string][]openapi.AffiliatePendingSwap, error) {
+	affColAddress, err := mgr.Keeper().GetModuleAddress(AffiliateCollectorName)
+	if err != nil {
+		return nil, ErrInternal(err, "fail to get affiliate collector module address")
+	}
+	prefix := fmt.Sprintf("%s-", PreferredAssetSwapMemoPrefix)
+	pending := make(map[string][]openapi.AffiliatePendingSwap)
+	iter := mgr.Keeper().GetSwapQueueIterator(ctx)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var msg MsgSwap
+		if err := mgr.Keeper().Cdc().Unmarshal(iter.Value(), &msg); err != nil {
+			ctx.Logger().Error("fail to unmarshal swap queue item", "error", err)
+			continue
+		}
+		if !strings.HasPrefix(msg.Tx.Memo, prefix) || !msg.Tx.FromAddress.Equals(affColAddress) {
+			continue
+		}
+		owner := msg.Signer.String()
+		pending[owner] = append(pending[owner], openapi.AffiliatePendingSwap{
+			TxId:        msg.Tx.ID.String(),
+			Mayaname:    strings.TrimPrefix(msg.Tx.Memo, prefix),
+			CacaoAmount: msg.Tx.Coins.GetCoin(common.BaseAsset()).Amount.String(),
+			TargetAsset: msg.TargetAsset.String(),
+			Destination: msg.Destination.String(),
+		})
+	}
+	return pending, nil
+}
+
+func newAffiliateCollectorResponse(ctx cosmos.Context, mgr *Mgrs, affCol AffiliateFeeCollector, pending map[string][]openapi.AffiliatePendingSwap) (openapi.AffiliateCollector, error) {
+	names, err := mgr.Keeper().GetMAYANamesByOwner(ctx, affCol.OwnerAddress)
+	if err != nil {
+		return openapi.AffiliateCollector{}, ErrInternal(err, "fail to get mayanames by owner")
+	}
+	mayanames := make([]openapi.AffiliateCollectorMayaname, 0, len(names))
+	for _, n := range names {
+		if !mgr.Keeper().MAYANameExists(ctx, n) {
+			continue
+		}
+		name, err := mgr.Keeper().GetMAYAName(ctx, n)
+		if err != nil {
+			return openapi.AffiliateCollector{}, ErrInternal(err, "fail to fetch MAYAName")
+		}
+		if !name.Owner.Equals(affCol.OwnerAddress) {
+			continue
+		}
+		mayanames = append(mayanames, openapi.AffiliateCollectorMayaname{
+			Name:                             name.Name,
+			PreferredAsset:                   name.PreferredAsset.String(),
+			PreferredAssetSwapThresholdCacao: getPreferredAssetSwapThreshold(ctx, mgr, name.PreferredAsset).String(),
+		})
+	}
+
+	records, err := mgr.Keeper().GetAffiliatePayouts(ctx, affCol.OwnerAddress)
+	if err != nil {
+		return openapi.AffiliateCollector{}, ErrInternal(err, "fail to get affiliate payouts")
+	}
+	payouts := make([]openapi.AffiliatePayout, 0, len(records))
+	for _, payout := range records {
+		payoutResp := openapi.AffiliatePayout{
+			Mayaname:    payout.Mayaname,
+			Asset:       payout.Asset.String(),
+			CacaoAmount: payout.CacaoAmount.String(),
+			Height:      payout.Height,
+			Claimed:     payout.Claimed,
+		}
+		if !payout.TxID.IsEmpty() && !payout.TxID.Equals(common.BlankTxID) {
+			payoutResp.TxId = wrapString(payout.TxID.String())
+		}
+		payouts = append(payouts, payoutResp)
+	}
+
+	swaps := pending[affCol.OwnerAddress.String()]
+	if swaps == nil {
+		swaps = []openapi.AffiliatePendingSwap{}
+	}
+
+	return openapi.AffiliateCollector{
+		Owner:        affCol.OwnerAddress.String(),
+		CacaoAmount:  affCol.CacaoAmount.String(),
+		Mayanames:    mayanames,
+		PendingSwaps: swaps,
+		Payouts:      payouts,
+	}, nil
+}
@@ -0,0 +1,76 @@
+package mayachain
+
+import (
+	"fmt"
+
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/constants"
+	openapi "gitlab.com/mayachain/mayanode/openapi/gen"
+)
+
+// queryChurnPreview returns which nodes the churn would move out of and into
+// the active set if it happened at the current height, and how the resulting
+// set would be split into asgard vaults
+func queryChurnPreview(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
+	// the churn selection writes node statuses and leave scores, run it on a
+	// cache context that is never committed
+	cacheCtx, _ := ctx.CacheContext()
+	vm := newValidatorMgrVCUR(mgr.Keeper(), mgr.NetworkMgr(), mgr.TxOutStore(), mgr.EventMgr())
+	rotation, out, in, asgards, err := vm.previewChurn(cacheCtx)
+	if err != nil {
+		return nil, fmt.Errorf("fail to preview churn: %w", err)
+	}
+
+	churnInterval := mgr.Keeper().GetConfigInt64(ctx, constants.ChurnInterval)
+	result := openapi.ChurnPreview{
+		NextChurnHeight: vm.getLastChurnHeight(ctx) + churnInterval,
+		Rotation:        rotation,
+		ChurnOut:        make([]openapi.ChurnPreviewNode, 0, len(out)),
+		ChurnIn:         make([]openapi.ChurnPreviewNode, 0, len(in)),
+		Asgards:         make([]openapi.ChurnPreviewAsgard, 0, len(asgards)),
+	}
+	for _, item := range out {
+		var node openapi.ChurnPreviewNode
+		node, err = newChurnPreviewNode(ctx, mgr, item.NodeAccount)
+		if err != nil {
+			return nil, err
+		}
+		node.Reason = wrapString(item.Reason)
+		result.ChurnOut = append(result.ChurnOut, node)
+	}
+	for _, na := range in {
+		var node openapi.ChurnPreviewNode
+		node, err = newChurnPreviewNode(ctx, mgr, na)
+		if err != nil {
+			return nil, err
+		}
+		result.ChurnIn = append(result.ChurnIn, node)
+	}
+	for _, nas := range asgards {
+		members := make([]string, 0, len(nas))
+		for _, na := range nas {
+			members = append(members, na.NodeAddress.String())
+		}
+		result.Asgards = append(result.Asgards, openapi.ChurnPreviewAsgard{Members: members})
+	}
+
+	return jsonify(ctx, result)
+}
+
+// newChurnPreviewNode reports the node as it is in the committed state, not as
+// the churn preview left it
+func newChurnPreviewNode(ctx cosmos.Context, mgr *Mgrs, na NodeAccount) (openapi.ChurnPreviewNode, error) {
+	current, err := mgr.Keeper().GetNodeAccount(ctx, na.NodeAddress)
+	if err != nil {
+		return openapi.ChurnPreviewNode{}, fmt.Errorf("fail to get node account(%s): %w", na.NodeAddress, err)
+	}
+	bond, err := mgr.Keeper().CalcNodeLiquidityBond(ctx, current)
+	if err != nil {
+		return openapi.ChurnPreviewNode{}, fmt.Errorf("fail to calculate node liquidity bond(%s): %w", na.NodeAddress, err)
+	}
+	return openapi.ChurnPreviewNode{
+		NodeAddress: current.NodeAddress.String(),
+		Status:      current.Status.String(),
+		TotalBond:   bond.String(),
+	}, nil
+}
@@ -0,0 +1,106 @@
+package mayachain
+
+import (
+	"errors"
+	"fmt"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	openapi "gitlab.com/mayachain/mayanode/openapi/gen"
+)
+
+// DCA orders are parked in the swap queue until their last slice is swapped.
+// The streaming swap record of an order only exists once its first slice has
+// been attempted, until then the order is reported as deposited.
+
+// queryDCAOrders returns every dca order in the swap queue
+func queryDCAOrders(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
+	orders := make([]openapi.DCAOrder, 0)
+	iter := mgr.Keeper().GetSwapQueueIterator(ctx)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var msg MsgSwap
+		if err := mgr.Keeper().Cdc().Unmarshal(iter.Value(), &msg); err != nil {
+			ctx.Logger().Error("fail to unmarshal swap queue item", "error", err)
+			continue
+		}
+		if !msg.IsDCA() || len(msg.Tx.Coins) == 0 {
+			continue
+		}
+		swp, err := getDCAOrderStreamingSwap(ctx, mgr, msg)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, newDCAOrder(msg, swp))
+	}
+	return jsonify(ctx, orders)
+}
+
+// queryDCAOrder returns a single dca order from the swap queue
+func queryDCAOrder(ctx cosmos.Context, path []string, mgr *Mgrs) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, errors.New("tx id not provided")
+	}
+	txid, err := common.NewTxID(path[0])
+	if err != nil {
+		ctx.Logger().Error("fail to parse txid", "error", err)
+		return nil, fmt.Errorf("could not parse txid: %w", err)
+	}
+
+	// DCA orders are always queued at index zero
+	msg, err := mgr.Keeper().GetSwapQueueItem(ctx, txid, 0)
+	if err != nil {
+		return nil, fmt.Errorf("could not get dca order: %w", err)
+	}
+	if !msg.IsDCA() || len(msg.Tx.Coins) == 0 {
+		return nil, fmt.Errorf("%s is not a dca order", txid)
+	}
+	swp, err := getDCAOrderStreamingSwap(ctx, mgr, msg)
+	if err != nil {
+		return nil, err
+	}
+	return jsonify(ctx, newDCAOrder(msg, swp))
+}
+
+func getDCAOrderStreamingSwap(ctx cosmos.Context, mgr *Mgrs, msg MsgSwap) (StreamingSwap, error) {
+	if !mgr.Keeper().StreamingSwapExists(ctx, msg.Tx.ID) {
+		return msg.GetStreamingSwap(), nil
+	}
+	swp, err := mgr.Keeper().GetStreamingSwap(ctx, msg.Tx.ID)
+	if err != nil {
+		return StreamingSwap{}, fmt.Errorf("could not get streaming swap: %w", err)
+	}
+	return swp, nil
+}
+
+func newDCAOrder(msg MsgSwap, swp StreamingSwap) openapi.DCAOrder {
+	var failedSwaps []int64
+	if swp.FailedSwaps != nil {
+		failedSwaps = make([]int64, len(swp.FailedSwaps))
+		for i := range swp.FailedSwaps {
+			failedSwaps[i] = int64(swp.FailedSwaps[i])
+		}
+	}
+
+	order := openapi.DCAOrder{
+		TxId:              msg.Tx.ID.String(),
+		Sender:            msg.Tx.FromAddress.String(),
+		SourceAsset:       msg.Tx.Coins[0].Asset.String(),
+		TargetAsset:       msg.TargetAsset.String(),
+		Destination:       msg.Destination.String(),
+		Interval:          int64(swp.Interval),
+		Quantity:          int64(swp.Quantity),
+		Count:             int64(swp.Count),
+		SliceLimit:        msg.TradeTarget.String(),
+		Deposit:           swp.Deposit.String(),
+		In:                swp.In.String(),
+		Out:               swp.Out.String(),
+		FailedSwaps:       failedSwaps,
+		FailedSwapReasons: swp.FailedSwapReasons,
+	}
+	if swp.LastHeight > 0 {
+		order.LastHeight = wrapInt64(swp.LastHeight)
+		order.NextHeight = wrapInt64(swp.LastHeight + int64(swp.Interval))
+	}
+	return order
+}
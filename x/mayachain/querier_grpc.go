@@ -0,0 +1,832 @@
+package mayachain
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	openapi "gitlab.com/mayachain/mayanode/openapi/gen"
+	"gitlab.com/mayachain/mayanode/x/mayachain/types"
+)
+
+// QueryContextFunc returns a read only context of the committed state at the
+// given height, or at the latest height when the height is zero
+type QueryContextFunc func(height int64) (cosmos.Context, error)
+
+// queryServer implements the gRPC Query service of the mayachain module. The
+// stored records are returned as they are kept in the kvstore. Inbound
+// addresses and quotes share their implementation with the legacy querier, so
+// both interfaces always agree.
+type queryServer struct {
+	mgr      *Mgrs
+	queryCtx QueryContextFunc
+}
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns the gRPC Query service of the mayachain module.
+// The BaseApp only creates the query context of unary calls, so the server
+// streams read the state through queryCtx.
+func NewQueryServerImpl(mgr *Mgrs, queryCtx QueryContextFunc) types.QueryServer {
+	return queryServer{mgr: mgr, queryCtx: queryCtx}
+}
+
+func (s queryServer) unwrap(c context.Context) cosmos.Context {
+	ctx := sdk.UnwrapSDKContext(c)
+	initManager(s.mgr, ctx) // NOOP except regtest
+	return ctx
+}
+
+// streamContext returns the context a server stream reads the state from, at
+// the height requested in the metadata of the call, the latest one by default
+func (s queryServer) streamContext(c context.Context) (cosmos.Context, error) {
+	if ctx, ok := c.Value(sdk.SdkContextKey).(sdk.Context); ok {
+		initManager(s.mgr, ctx) // NOOP except regtest
+		return ctx, nil
+	}
+	if s.queryCtx == nil {
+		return cosmos.Context{}, status.Error(codes.Unavailable, "query context is not available")
+	}
+	var height int64
+	if md, ok := metadata.FromIncomingContext(c); ok {
+		if heights := md.Get(grpctypes.GRPCBlockHeightHeader); len(heights) == 1 {
+			var err error
+			height, err = strconv.ParseInt(heights[0], 10, 64)
+			if err != nil || height < 0 {
+				return cosmos.Context{}, status.Errorf(codes.InvalidArgument, "invalid height header: %s", heights[0])
+			}
+		}
+	}
+	ctx, err := s.queryCtx(height)
+	if err != nil {
+		return cosmos.Context{}, status.Errorf(codes.InvalidArgument, "fail to create query context: %s", err)
+	}
+	initManager(s.mgr, ctx) // NOOP except regtest
+	return ctx, nil
+}
+
+func (s queryServer) Pool(c context.Context, req *types.QueryPoolRequest) (*types.QueryPoolResponse, error) {
+	ctx := s.unwrap(c)
+	asset, err := common.NewAssetWithShortCodes(s.mgr.GetVersion(), req.Asset)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "fail to parse asset: %s", err)
+	}
+	if !s.mgr.Keeper().PoolExist(ctx, asset) {
+		return nil, status.Errorf(codes.NotFound, "pool (%s) does not exist", asset)
+	}
+	pool, err := s.mgr.Keeper().GetPool(ctx, asset)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "fail to get pool: %s", err)
+	}
+	return &types.QueryPoolResponse{Pool: pool}, nil
+}
+
+func (s queryServer) Pools(c context.Context, req *types.QueryPoolsRequest) (*types.QueryPoolsResponse, error) {
+	ctx := s.unwrap(c)
+	resp := &types.QueryPoolsResponse{Pools: make([]Pool, 0)}
+	err := s.eachPool(ctx, func(pool Pool) error {
+		resp.Pools = append(resp.Pools, pool)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s queryServer) StreamPools(req *types.QueryPoolsRequest, stream types.Query_StreamPoolsServer) error {
+	ctx, err := s.streamContext(stream.Context())
+	if err != nil {
+		return err
+	}
+	return s.eachPool(ctx, func(pool Pool) error {
+		return stream.Send(&types.QueryPoolResponse{Pool: pool})
+	})
+}
+
+func (s queryServer) eachPool(ctx cosmos.Context, fn func(Pool) error) error {
+	iter := s.mgr.Keeper().GetPoolIterator(ctx)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var pool Pool
+		if err := s.mgr.Keeper().Cdc().Unmarshal(iter.Value(), &pool); err != nil {
+			return status.Errorf(codes.Internal, "fail to unmarshal pool: %s", err)
+		}
+		if err := fn(pool); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s queryServer) LiquidityProvider(c context.Context, req *types.QueryLiquidityProviderRequest) (*types.QueryLiquidityProviderResponse, error) {
+	ctx := s.unwrap(c)
+	asset, err := common.NewAsset(req.Asset)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "fail to parse asset: %s", err)
+	}
+	addr, err := common.NewAddress(req.Address, s.mgr.GetVersion())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "fail to parse address: %s", err)
+	}
+	lp, err := s.mgr.Keeper().GetLiquidityProvider(ctx, asset, addr)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "fail to get liquidity provider: %s", err)
+	}
+	if lp.LastAddHeight == 0 {
+		return nil, status.Errorf(codes.NotFound, "liquidity provider (%s) does not exist in pool (%s)", addr, asset)
+	}
+	return &types.QueryLiquidityProviderResponse{LiquidityProvider: lp}, nil
+}
+
+func (s queryServer) LiquidityProviders(c context.Context, req *types.QueryLiquidityProvidersRequest) (*types.QueryLiquidityProvidersResponse, error) {
+	ctx := s.unwrap(c)
+	resp := &types.QueryLiquidityProvidersResponse{LiquidityProviders: make([]LiquidityProvider, 0)}
+	err := s.eachLiquidityProvider(ctx, req.Asset, func(lp LiquidityProvider) error {
+		resp.LiquidityProviders = append(resp.LiquidityProviders, lp)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s queryServer) StreamLiquidityProviders(req *types.QueryLiquidityProvidersRequest, stream types.Query_StreamLiquidityProvidersServer) error {
+	ctx, err := s.streamContext(stream.Context())
+	if err != nil {
+		return err
+	}
+	return s.eachLiquidityProvider(ctx, req.Asset, func(lp LiquidityProvider) error {
+		return stream.Send(&types.QueryLiquidityProviderResponse{LiquidityProvider: lp})
+	})
+}
+
+func (s queryServer) eachLiquidityProvider(ctx cosmos.Context, assetStr string, fn func(LiquidityProvider) error) error {
+	asset, err := common.NewAsset(assetStr)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "fail to parse asset: %s", err)
+	}
+	iter := s.mgr.Keeper().GetLiquidityProviderIterator(ctx, asset)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var lp LiquidityProvider
+		if err := s.mgr.Keeper().Cdc().Unmarshal(iter.Value(), &lp); err != nil {
+			return status.Errorf(codes.Internal, "fail to unmarshal liquidity provider: %s", err)
+		}
+		if err := fn(lp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s queryServer) Node(c context.Context, req *types.QueryNodeRequest) (*types.QueryNodeResponse, error) {
+	ctx := s.unwrap(c)
+	addr, err := cosmos.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid node address: %s", err)
+	}
+	node, err := s.mgr.Keeper().GetNodeAccount(ctx, addr)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "fail to get node account: %s", err)
+	}
+	if node.IsEmpty() {
+		return nil, status.Errorf(codes.NotFound, "node (%s) does not exist", addr)
+	}
+	return &types.QueryNodeResponse{Node: node}, nil
+}
+
+func (s queryServer) Nodes(c context.Context, req *types.QueryNodesRequest) (*types.QueryNodesResponse, error) {
+	ctx := s.unwrap(c)
+	resp := &types.QueryNodesResponse{Nodes: make([]NodeAccount, 0)}
+	err := s.eachNode(ctx, func(node NodeAccount) error {
+		resp.Nodes = append(resp.Nodes, node)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s queryServer) StreamNodes(req *types.QueryNodesRequest, stream types.Query_StreamNodesServer) error {
+	ctx, err := s.streamContext(stream.Context())
+	if err != nil {
+		return err
+	}
+	return s.eachNode(ctx, func(node NodeAccount) error {
+		return stream.Send(&types.QueryNodeResponse{Node: node})
+	})
+}
+
+func (s queryServer) eachNode(ctx cosmos.Context, fn func(NodeAccount) error) error {
+	iter := s.mgr.Keeper().GetNodeAccountIterator(ctx)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var node NodeAccount
+		if err := s.mgr.Keeper().Cdc().Unmarshal(iter.Value(), &node); err != nil {
+			return status.Errorf(codes.Internal, "fail to unmarshal node account: %s", err)
+		}
+		if err := fn(node); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s queryServer) Vault(c context.Context, req *types.QueryVaultRequest) (*types.QueryVaultResponse, error) {
+	ctx := s.unwrap(c)
+	pubkey, err := common.NewPubKey(req.PubKey)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s is invalid pubkey", req.PubKey)
+	}
+	if !s.mgr.Keeper().VaultExists(ctx, pubkey) {
+		return nil, status.Errorf(codes.NotFound, "vault (%s) does not exist", pubkey)
+	}
+	vault, err := s.mgr.Keeper().GetVault(ctx, pubkey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "fail to get vault: %s", err)
+	}
+	return &types.QueryVaultResponse{Vault: vault}, nil
+}
+
+func (s queryServer) AsgardVaults(c context.Context, req *types.QueryAsgardVaultsRequest) (*types.QueryAsgardVaultsResponse, error) {
+	ctx := s.unwrap(c)
+	vaults, err := s.mgr.Keeper().GetAsgardVaults(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "fail to get asgard vaults: %s", err)
+	}
+	resp := &types.QueryAsgardVaultsResponse{Vaults: make([]Vault, 0, len(vaults))}
+	for _, vault := range vaults {
+		if vault.IsEmpty() {
+			continue
+		}
+		resp.Vaults = append(resp.Vaults, vault)
+	}
+	return resp, nil
+}
+
+func (s queryServer) InboundAddresses(c context.Context, req *types.QueryInboundAddressesRequest) (*types.QueryInboundAddressesResponse, error) {
+	ctx := s.unwrap(c)
+	addresses, err := getInboundAddresses(ctx, s.mgr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &types.QueryInboundAddressesResponse{InboundAddresses: make([]types.InboundAddress, 0, len(addresses))}
+	for _, addr := range addresses {
+		resp.InboundAddresses = append(resp.InboundAddresses, types.InboundAddress{
+			Chain:                unwrapString(addr.Chain),
+			PubKey:               unwrapString(addr.PubKey),
+			Address:              unwrapString(addr.Address),
+			Router:               unwrapString(addr.Router),
+			Halted:               addr.Halted,
+			GlobalTradingPaused:  unwrapBool(addr.GlobalTradingPaused),
+			ChainTradingPaused:   unwrapBool(addr.ChainTradingPaused),
+			ChainLpActionsPaused: unwrapBool(addr.ChainLpActionsPaused),
+			GasRate:              unwrapString(addr.GasRate),
+			GasRateUnits:         unwrapString(addr.GasRateUnits),
+			OutboundTxSize:       unwrapString(addr.OutboundTxSize),
+			OutboundFee:          unwrapString(addr.OutboundFee),
+			DustThreshold:        unwrapString(addr.DustThreshold),
+		})
+	}
+	return resp, nil
+}
+
+func (s queryServer) Mimir(c context.Context, req *types.QueryMimirRequest) (*types.QueryMimirResponse, error) {
+	ctx := s.unwrap(c)
+	if req.Key == "" {
+		return nil, status.Error(codes.InvalidArgument, "no mimir key")
+	}
+	value, err := s.mgr.Keeper().GetMimir(ctx, req.Key)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "fail to get mimir with key:%s, err : %s", req.Key, err)
+	}
+	return &types.QueryMimirResponse{Value: value}, nil
+}
+
+func (s queryServer) Mimirs(c context.Context, req *types.QueryMimirsRequest) (*types.QueryMimirsResponse, error) {
+	ctx := s.unwrap(c)
+	values, err := getMimirValues(ctx, s.mgr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryMimirsResponse{Mimirs: values}, nil
+}
+
+func (s queryServer) StreamingSwap(c context.Context, req *types.QueryStreamingSwapRequest) (*types.QueryStreamingSwapResponse, error) {
+	ctx := s.unwrap(c)
+	txID, err := common.NewTxID(req.TxId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "could not parse txid: %s", err)
+	}
+	if !s.mgr.Keeper().StreamingSwapExists(ctx, txID) {
+		return nil, status.Errorf(codes.NotFound, "streaming swap (%s) does not exist", txID)
+	}
+	swp, err := s.mgr.Keeper().GetStreamingSwap(ctx, txID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not get streaming swap: %s", err)
+	}
+	return &types.QueryStreamingSwapResponse{StreamingSwap: swp}, nil
+}
+
+func (s queryServer) StreamingSwaps(c context.Context, req *types.QueryStreamingSwapsRequest) (*types.QueryStreamingSwapsResponse, error) {
+	ctx := s.unwrap(c)
+	resp := &types.QueryStreamingSwapsResponse{StreamingSwaps: make([]StreamingSwap, 0)}
+	err := s.eachStreamingSwap(ctx, func(swp StreamingSwap) error {
+		resp.StreamingSwaps = append(resp.StreamingSwaps, swp)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s queryServer) StreamStreamingSwaps(req *types.QueryStreamingSwapsRequest, stream types.Query_StreamStreamingSwapsServer) error {
+	ctx, err := s.streamContext(stream.Context())
+	if err != nil {
+		return err
+	}
+	return s.eachStreamingSwap(ctx, func(swp StreamingSwap) error {
+		return stream.Send(&types.QueryStreamingSwapResponse{StreamingSwap: swp})
+	})
+}
+
+func (s queryServer) eachStreamingSwap(ctx cosmos.Context, fn func(StreamingSwap) error) error {
+	iter := s.mgr.Keeper().GetStreamingSwapIterator(ctx)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var swp StreamingSwap
+		if err := s.mgr.Keeper().Cdc().Unmarshal(iter.Value(), &swp); err != nil {
+			return status.Errorf(codes.Internal, "fail to unmarshal streaming swap: %s", err)
+		}
+		if err := fn(swp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s queryServer) TradeAccount(c context.Context, req *types.QueryTradeAccountRequest) (*types.QueryTradeAccountResponse, error) {
+	ctx := s.unwrap(c)
+	addr, err := cosmos.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "could not parse address: %s", err)
+	}
+	resp := &types.QueryTradeAccountResponse{TradeAccounts: make([]TradeAccount, 0)}
+	iter := s.mgr.Keeper().GetTradeAccountIteratorWithAddress(ctx, addr)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var ta TradeAccount
+		if err := s.mgr.Keeper().Cdc().Unmarshal(iter.Value(), &ta); err != nil {
+			return nil, status.Errorf(codes.Internal, "fail to unmarshal trade account: %s", err)
+		}
+		if ta.Units.IsZero() {
+			continue
+		}
+		resp.TradeAccounts = append(resp.TradeAccounts, ta)
+	}
+	return resp, nil
+}
+
+func (s queryServer) TradeAccounts(c context.Context, req *types.QueryTradeAccountsRequest) (*types.QueryTradeAccountsResponse, error) {
+	ctx := s.unwrap(c)
+	asset, err := common.NewAsset(req.Asset)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "could not parse asset: %s", err)
+	}
+	resp := &types.QueryTradeAccountsResponse{TradeAccounts: make([]TradeAccount, 0)}
+	iter := s.mgr.Keeper().GetTradeAccountIterator(ctx)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var ta TradeAccount
+		if err := s.mgr.Keeper().Cdc().Unmarshal(iter.Value(), &ta); err != nil {
+			return nil, status.Errorf(codes.Internal, "fail to unmarshal trade account: %s", err)
+		}
+		if !ta.Asset.Equals(asset) || ta.Units.IsZero() {
+			continue
+		}
+		resp.TradeAccounts = append(resp.TradeAccounts, ta)
+	}
+	return resp, nil
+}
+
+func (s queryServer) MAYAName(c context.Context, req *types.QueryMAYANameRequest) (*types.QueryMAYANameResponse, error) {
+	ctx := s.unwrap(c)
+	if !s.mgr.Keeper().MAYANameExists(ctx, req.Name) {
+		return nil, status.Errorf(codes.NotFound, "MAYAName (%s) does not exist", req.Name)
+	}
+	name, err := s.mgr.Keeper().GetMAYAName(ctx, req.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "fail to fetch MAYAName: %s", err)
+	}
+	return &types.QueryMAYANameResponse{Mayaname: name}, nil
+}
+
+func (s queryServer) QuoteSwap(c context.Context, req *types.QueryQuoteSwapRequest) (*types.QueryQuoteSwapResponse, error) {
+	ctx := s.unwrap(c)
+	params := quoteRequestParams(map[string]string{
+		fromAssetParam:     req.FromAsset,
+		toAssetParam:       req.ToAsset,
+		amountParam:        req.Amount,
+		destinationParam:   req.Destination,
+		refundAddressParam: req.RefundAddress,
+		affiliateParam:     req.Affiliate,
+		affiliateBpsParam:  req.AffiliateBps,
+	}, map[string]uint64{
+		intervalParam:              req.StreamingInterval,
+		quantityParam:              req.StreamingQuantity,
+		toleranceBasisPointsParam:  req.ToleranceBps,
+		liquidityToleranceBpsParam: req.LiquidityToleranceBps,
+	})
+
+	quote, err := quoteSwapCandidate(ctx, s.mgr, params)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryQuoteSwapResponse{
+		InboundAddress:             unwrapString(quote.InboundAddress),
+		InboundConfirmationBlocks:  unwrapInt64(quote.InboundConfirmationBlocks),
+		InboundConfirmationSeconds: unwrapInt64(quote.InboundConfirmationSeconds),
+		OutboundDelayBlocks:        quote.OutboundDelayBlocks,
+		OutboundDelaySeconds:       quote.OutboundDelaySeconds,
+		Fees:                       quoteFees(quote.Fees),
+		Router:                     unwrapString(quote.Router),
+		Expiry:                     quote.Expiry,
+		Warning:                    quote.Warning,
+		Notes:                      quote.Notes,
+		DustThreshold:              unwrapString(quote.DustThreshold),
+		RecommendedMinAmountIn:     unwrapString(quote.RecommendedMinAmountIn),
+		RecommendedGasRate:         unwrapString(quote.RecommendedGasRate),
+		GasRateUnits:               unwrapString(quote.GasRateUnits),
+		Memo:                       unwrapString(quote.Memo),
+		ExpectedAmountOut:          quote.ExpectedAmountOut,
+		MaxStreamingQuantity:       unwrapInt64(quote.MaxStreamingQuantity),
+		StreamingSwapBlocks:        unwrapInt64(quote.StreamingSwapBlocks),
+		StreamingSwapSeconds:       unwrapInt64(quote.StreamingSwapSeconds),
+		TotalSwapSeconds:           unwrapInt64(quote.TotalSwapSeconds),
+	}, nil
+}
+
+func (s queryServer) QuoteSwapRoutes(c context.Context, req *types.QueryQuoteSwapRoutesRequest) (*types.QueryQuoteSwapRoutesResponse, error) {
+	ctx := s.unwrap(c)
+	params := quoteRequestParams(map[string]string{
+		fromAssetParam:     req.FromAsset,
+		toAssetParam:       req.ToAsset,
+		amountParam:        req.Amount,
+		destinationParam:   req.Destination,
+		refundAddressParam: req.RefundAddress,
+		affiliateParam:     req.Affiliate,
+		affiliateBpsParam:  req.AffiliateBps,
+	}, map[string]uint64{
+		intervalParam:             req.StreamingInterval,
+		toleranceBasisPointsParam: req.ToleranceBps,
+	})
+
+	quote, err := quoteSwapRoutes(ctx, s.mgr, params)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	resp := &types.QueryQuoteSwapRoutesResponse{Routes: make([]types.QuoteSwapRoute, 0, len(quote.Routes))}
+	for _, route := range quote.Routes {
+		resp.Routes = append(resp.Routes, types.QuoteSwapRoute{
+			InboundAddress:             unwrapString(route.InboundAddress),
+			InboundConfirmationBlocks:  unwrapInt64(route.InboundConfirmationBlocks),
+			InboundConfirmationSeconds: unwrapInt64(route.InboundConfirmationSeconds),
+			OutboundDelayBlocks:        route.OutboundDelayBlocks,
+			OutboundDelaySeconds:       route.OutboundDelaySeconds,
+			Fees:                       quoteFees(route.Fees),
+			Router:                     unwrapString(route.Router),
+			Expiry:                     route.Expiry,
+			Warning:                    route.Warning,
+			Notes:                      route.Notes,
+			DustThreshold:              unwrapString(route.DustThreshold),
+			RecommendedMinAmountIn:     unwrapString(route.RecommendedMinAmountIn),
+			RecommendedGasRate:         unwrapString(route.RecommendedGasRate),
+			GasRateUnits:               unwrapString(route.GasRateUnits),
+			Route:                      route.Route,
+			ToAsset:                    route.ToAsset,
+			Memo:                       unwrapString(route.Memo),
+			ExpectedAmountOut:          route.ExpectedAmountOut,
+			StreamingInterval:          route.StreamingInterval,
+			StreamingQuantity:          route.StreamingQuantity,
+			MaxStreamingQuantity:       unwrapInt64(route.MaxStreamingQuantity),
+			StreamingSwapBlocks:        unwrapInt64(route.StreamingSwapBlocks),
+			StreamingSwapSeconds:       unwrapInt64(route.StreamingSwapSeconds),
+			TotalSwapSeconds:           unwrapInt64(route.TotalSwapSeconds),
+		})
+	}
+	return resp, nil
+}
+
+func (s queryServer) QuoteSaverDeposit(c context.Context, req *types.QueryQuoteSaverDepositRequest) (*types.QueryQuoteSaverDepositResponse, error) {
+	ctx := s.unwrap(c)
+	params := quoteRequestParams(map[string]string{
+		assetParam:  req.Asset,
+		amountParam: req.Amount,
+	}, nil)
+
+	quote, err := quoteSaverDeposit(ctx, s.mgr, params)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryQuoteSaverDepositResponse{
+		InboundAddress:             quote.InboundAddress,
+		InboundConfirmationBlocks:  unwrapInt64(quote.InboundConfirmationBlocks),
+		InboundConfirmationSeconds: unwrapInt64(quote.InboundConfirmationSeconds),
+		OutboundDelayBlocks:        unwrapInt64(quote.OutboundDelayBlocks),
+		OutboundDelaySeconds:       unwrapInt64(quote.OutboundDelaySeconds),
+		Fees:                       quoteFees(quote.Fees),
+		Router:                     unwrapString(quote.Router),
+		Expiry:                     quote.Expiry,
+		Warning:                    quote.Warning,
+		Notes:                      quote.Notes,
+		DustThreshold:              unwrapString(quote.DustThreshold),
+		RecommendedMinAmountIn:     unwrapString(quote.RecommendedMinAmountIn),
+		RecommendedGasRate:         quote.RecommendedGasRate,
+		GasRateUnits:               quote.GasRateUnits,
+		Memo:                       quote.Memo,
+		ExpectedAmountOut:          unwrapString(quote.ExpectedAmountOut),
+		ExpectedAmountDeposit:      quote.ExpectedAmountDeposit,
+	}, nil
+}
+
+func (s queryServer) QuoteSaverWithdraw(c context.Context, req *types.QueryQuoteSaverWithdrawRequest) (*types.QueryQuoteSaverWithdrawResponse, error) {
+	ctx := s.unwrap(c)
+	params := quoteRequestParams(map[string]string{
+		assetParam:   req.Asset,
+		addressParam: req.Address,
+	}, map[string]uint64{
+		withdrawBasisPointsParam: req.WithdrawBps,
+	})
+
+	quote, err := quoteSaverWithdraw(ctx, s.mgr, params)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryQuoteSaverWithdrawResponse{
+		InboundAddress:             quote.InboundAddress,
+		InboundConfirmationBlocks:  unwrapInt64(quote.InboundConfirmationBlocks),
+		InboundConfirmationSeconds: unwrapInt64(quote.InboundConfirmationSeconds),
+		OutboundDelayBlocks:        quote.OutboundDelayBlocks,
+		OutboundDelaySeconds:       quote.OutboundDelaySeconds,
+		Fees:                       quoteFees(quote.Fees),
+		Router:                     unwrapString(quote.Router),
+		Expiry:                     quote.Expiry,
+		Warning:                    quote.Warning,
+		Notes:                      quote.Notes,
+		DustThreshold:              unwrapString(quote.DustThreshold),
+		RecommendedMinAmountIn:     unwrapString(quote.RecommendedMinAmountIn),
+		RecommendedGasRate:         quote.RecommendedGasRate,
+		GasRateUnits:               quote.GasRateUnits,
+		Memo:                       quote.Memo,
+		DustAmount:                 quote.DustAmount,
+		ExpectedAmountOut:          quote.ExpectedAmountOut,
+	}, nil
+}
+
+func (s queryServer) QuoteLiquidityAdd(c context.Context, req *types.QueryQuoteLiquidityAddRequest) (*types.QueryQuoteLiquidityAddResponse, error) {
+	ctx := s.unwrap(c)
+	params := quoteRequestParams(map[string]string{
+		assetParam:        req.Asset,
+		amountCacaoParam:  req.AmountCacao,
+		amountAssetParam:  req.AmountAsset,
+		cacaoAddressParam: req.CacaoAddress,
+		assetAddressParam: req.AssetAddress,
+	}, nil)
+
+	quote, err := quoteLiquidityAdd(ctx, s.mgr, params)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryQuoteLiquidityAddResponse{
+		InboundAddress:             unwrapString(quote.InboundAddress),
+		InboundConfirmationBlocks:  unwrapInt64(quote.InboundConfirmationBlocks),
+		InboundConfirmationSeconds: unwrapInt64(quote.InboundConfirmationSeconds),
+		Expiry:                     quote.Expiry,
+		Warning:                    quote.Warning,
+		Notes:                      quote.Notes,
+		Router:                     unwrapString(quote.Router),
+		DustThreshold:              unwrapString(quote.DustThreshold),
+		RecommendedMinAmountIn:     unwrapString(quote.RecommendedMinAmountIn),
+		RecommendedGasRate:         quote.RecommendedGasRate,
+		GasRateUnits:               quote.GasRateUnits,
+		Memo:                       unwrapString(quote.Memo),
+		CacaoMemo:                  unwrapString(quote.CacaoMemo),
+		ExpectedPoolUnits:          quote.ExpectedPoolUnits,
+		PoolUnits:                  quote.PoolUnits,
+		PoolShareBps:               quote.PoolShareBps,
+		SlippageBps:                quote.SlippageBps,
+		LiquidityAuction:           quoteLiquidityAuctionInfo(quote.LiquidityAuction),
+	}, nil
+}
+
+func (s queryServer) QuoteLiquidityWithdraw(c context.Context, req *types.QueryQuoteLiquidityWithdrawRequest) (*types.QueryQuoteLiquidityWithdrawResponse, error) {
+	ctx := s.unwrap(c)
+	params := quoteRequestParams(map[string]string{
+		assetParam:           req.Asset,
+		addressParam:         req.Address,
+		withdrawalAssetParam: req.WithdrawalAsset,
+	}, map[string]uint64{
+		withdrawBasisPointsParam: req.WithdrawBps,
+	})
+
+	quote, err := quoteLiquidityWithdraw(ctx, s.mgr, params)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryQuoteLiquidityWithdrawResponse{
+		InboundAddress:            unwrapString(quote.InboundAddress),
+		OutboundDelayBlocks:       quote.OutboundDelayBlocks,
+		OutboundDelaySeconds:      quote.OutboundDelaySeconds,
+		Router:                    unwrapString(quote.Router),
+		Expiry:                    quote.Expiry,
+		Warning:                   quote.Warning,
+		Notes:                     quote.Notes,
+		DustThreshold:             unwrapString(quote.DustThreshold),
+		RecommendedGasRate:        quote.RecommendedGasRate,
+		GasRateUnits:              quote.GasRateUnits,
+		Memo:                      quote.Memo,
+		DustAmount:                quote.DustAmount,
+		WithdrawUnits:             quote.WithdrawUnits,
+		ExpectedAmountOutCacao:    quote.ExpectedAmountOutCacao,
+		ExpectedAmountOutAsset:    quote.ExpectedAmountOutAsset,
+		OutboundFeeCacao:          quote.OutboundFeeCacao,
+		OutboundFeeAsset:          quote.OutboundFeeAsset,
+		ImpermanentLossProtection: unwrapString(quote.ImpermanentLossProtection),
+		LiquidityAuction:          quoteLiquidityAuctionInfo(quote.LiquidityAuction),
+	}, nil
+}
+
+func (s queryServer) QuoteTradeDeposit(c context.Context, req *types.QueryQuoteTradeDepositRequest) (*types.QueryQuoteTradeDepositResponse, error) {
+	ctx := s.unwrap(c)
+	params := quoteRequestParams(map[string]string{
+		assetParam:   req.Asset,
+		amountParam:  req.Amount,
+		addressParam: req.Address,
+	}, nil)
+
+	quote, err := quoteTradeDeposit(ctx, s.mgr, params)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryQuoteTradeDepositResponse{
+		InboundAddress:             quote.InboundAddress,
+		InboundConfirmationBlocks:  unwrapInt64(quote.InboundConfirmationBlocks),
+		InboundConfirmationSeconds: unwrapInt64(quote.InboundConfirmationSeconds),
+		Router:                     unwrapString(quote.Router),
+		Expiry:                     quote.Expiry,
+		Warning:                    quote.Warning,
+		Notes:                      quote.Notes,
+		DustThreshold:              unwrapString(quote.DustThreshold),
+		RecommendedMinAmountIn:     unwrapString(quote.RecommendedMinAmountIn),
+		RecommendedGasRate:         quote.RecommendedGasRate,
+		GasRateUnits:               quote.GasRateUnits,
+		Memo:                       quote.Memo,
+		ExpectedAmountOut:          quote.ExpectedAmountOut,
+		ExpectedUnits:              quote.ExpectedUnits,
+	}, nil
+}
+
+func (s queryServer) QuoteTradeWithdraw(c context.Context, req *types.QueryQuoteTradeWithdrawRequest) (*types.QueryQuoteTradeWithdrawResponse, error) {
+	ctx := s.unwrap(c)
+	params := quoteRequestParams(map[string]string{
+		assetParam:   req.Asset,
+		amountParam:  req.Amount,
+		addressParam: req.Address,
+	}, nil)
+
+	quote, err := quoteTradeWithdraw(ctx, s.mgr, params)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryQuoteTradeWithdrawResponse{
+		OutboundDelayBlocks:  quote.OutboundDelayBlocks,
+		OutboundDelaySeconds: quote.OutboundDelaySeconds,
+		Fees:                 quoteFees(quote.Fees),
+		Expiry:               quote.Expiry,
+		Warning:              quote.Warning,
+		Notes:                quote.Notes,
+		Memo:                 quote.Memo,
+		ExpectedAmountOut:    quote.ExpectedAmountOut,
+	}, nil
+}
+
+func (s queryServer) QuoteCacaoPoolDeposit(c context.Context, req *types.QueryQuoteCacaoPoolDepositRequest) (*types.QueryQuoteCacaoPoolDepositResponse, error) {
+	ctx := s.unwrap(c)
+	params := quoteRequestParams(map[string]string{
+		amountParam: req.Amount,
+	}, nil)
+
+	quote, err := quoteCacaoPoolDeposit(ctx, s.mgr, params)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryQuoteCacaoPoolDepositResponse{
+		Expiry:          quote.Expiry,
+		Warning:         quote.Warning,
+		Notes:           quote.Notes,
+		Memo:            quote.Memo,
+		ExpectedUnits:   quote.ExpectedUnits,
+		MaturityBlocks:  quote.MaturityBlocks,
+		MaturitySeconds: quote.MaturitySeconds,
+	}, nil
+}
+
+func (s queryServer) QuoteCacaoPoolWithdraw(c context.Context, req *types.QueryQuoteCacaoPoolWithdrawRequest) (*types.QueryQuoteCacaoPoolWithdrawResponse, error) {
+	ctx := s.unwrap(c)
+	params := quoteRequestParams(map[string]string{
+		addressParam: req.Address,
+	}, map[string]uint64{
+		withdrawBasisPointsParam: req.WithdrawBps,
+	})
+
+	quote, err := quoteCacaoPoolWithdraw(ctx, s.mgr, params)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryQuoteCacaoPoolWithdrawResponse{
+		Expiry:            quote.Expiry,
+		Warning:           quote.Warning,
+		Notes:             quote.Notes,
+		Memo:              quote.Memo,
+		ExpectedAmountOut: quote.ExpectedAmountOut,
+		WithdrawUnits:     quote.WithdrawUnits,
+		MaturityBlocks:    quote.MaturityBlocks,
+		MaturitySeconds:   quote.MaturitySeconds,
+	}, nil
+}
+
+// quoteRequestParams returns the set fields of a quote request as the query
+// parameters of the matching legacy endpoint
+func quoteRequestParams(strs map[string]string, uints map[string]uint64) url.Values {
+	params := url.Values{}
+	for key, value := range strs {
+		if value != "" {
+			params.Set(key, value)
+		}
+	}
+	for key, value := range uints {
+		if value > 0 {
+			params.Set(key, strconv.FormatUint(value, 10))
+		}
+	}
+	return params
+}
+
+func quoteFees(fees openapi.QuoteFees) types.QuoteFees {
+	return types.QuoteFees{
+		Asset:       fees.Asset,
+		Affiliate:   unwrapString(fees.Affiliate),
+		Outbound:    unwrapString(fees.Outbound),
+		Liquidity:   fees.Liquidity,
+		Total:       fees.Total,
+		SlippageBps: fees.SlippageBps,
+		TotalBps:    fees.TotalBps,
+	}
+}
+
+func quoteLiquidityAuctionInfo(auction *openapi.QuoteLiquidityAuction) *types.QuoteLiquidityAuction {
+	if auction == nil {
+		return nil
+	}
+	return &types.QuoteLiquidityAuction{
+		Tier:                      auction.Tier,
+		WithdrawLimitBps:          auction.WithdrawLimitBps,
+		WithdrawLimitActive:       auction.WithdrawLimitActive,
+		WithdrawCounter:           auction.WithdrawCounter,
+		LastWithdrawCounterHeight: auction.LastWithdrawCounterHeight,
+	}
+}
+
+func unwrapString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func unwrapInt64(d *int64) int64 {
+	if d == nil {
+		return 0
+	}
+	return *d
+}
+
+func unwrapBool(b *bool) bool {
+	if b == nil {
+		return false
+	}
+	return *b
+}
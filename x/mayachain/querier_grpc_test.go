@@ -0,0 +1,267 @@
+package mayachain
+
+import (
+	"context"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/constants"
+	"gitlab.com/mayachain/mayanode/x/mayachain/types"
+)
+
+type QueryServerSuite struct{}
+
+var _ = Suite(&QueryServerSuite{})
+
+func (s *QueryServerSuite) TestPools(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	srv := NewQueryServerImpl(mgr, nil)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceCacao = cosmos.NewUint(100 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(10 * common.One)
+	pool.LPUnits = cosmos.NewUint(100)
+	pool.Status = PoolAvailable
+	c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+
+	resp, err := srv.Pool(goCtx, &types.QueryPoolRequest{Asset: "BNB.BNB"})
+	c.Assert(err, IsNil)
+	c.Check(resp.Pool.Asset.Equals(common.BNBAsset), Equals, true)
+	c.Check(resp.Pool.BalanceCacao.Uint64(), Equals, uint64(100*common.One))
+
+	_, err = srv.Pool(goCtx, &types.QueryPoolRequest{Asset: "BTC.BTC"})
+	c.Assert(status.Code(err), Equals, codes.NotFound)
+	_, err = srv.Pool(goCtx, &types.QueryPoolRequest{Asset: ""})
+	c.Assert(status.Code(err), Equals, codes.InvalidArgument)
+
+	pools, err := srv.Pools(goCtx, &types.QueryPoolsRequest{})
+	c.Assert(err, IsNil)
+	c.Assert(pools.Pools, HasLen, 1)
+
+	lp := LiquidityProvider{
+		Asset:         common.BNBAsset,
+		CacaoAddress:  GetRandomBaseAddress(),
+		Units:         cosmos.NewUint(100),
+		LastAddHeight: 1,
+	}
+	mgr.Keeper().SetLiquidityProvider(ctx, lp)
+	lpResp, err := srv.LiquidityProvider(goCtx, &types.QueryLiquidityProviderRequest{
+		Asset:   "BNB.BNB",
+		Address: lp.CacaoAddress.String(),
+	})
+	c.Assert(err, IsNil)
+	c.Check(lpResp.LiquidityProvider.Units.Uint64(), Equals, uint64(100))
+	_, err = srv.LiquidityProvider(goCtx, &types.QueryLiquidityProviderRequest{
+		Asset:   "BNB.BNB",
+		Address: GetRandomBaseAddress().String(),
+	})
+	c.Assert(status.Code(err), Equals, codes.NotFound)
+
+	lps, err := srv.LiquidityProviders(goCtx, &types.QueryLiquidityProvidersRequest{Asset: "BNB.BNB"})
+	c.Assert(err, IsNil)
+	c.Assert(lps.LiquidityProviders, HasLen, 1)
+}
+
+func (s *QueryServerSuite) TestNodesAndVaults(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	srv := NewQueryServerImpl(mgr, nil)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	na := GetRandomValidatorNode(NodeActive)
+	c.Assert(mgr.Keeper().SetNodeAccount(ctx, na), IsNil)
+	nodeResp, err := srv.Node(goCtx, &types.QueryNodeRequest{Address: na.NodeAddress.String()})
+	c.Assert(err, IsNil)
+	c.Check(nodeResp.Node.NodeAddress.Equals(na.NodeAddress), Equals, true)
+	_, err = srv.Node(goCtx, &types.QueryNodeRequest{Address: GetRandomBech32Addr().String()})
+	c.Assert(status.Code(err), Equals, codes.NotFound)
+	_, err = srv.Node(goCtx, &types.QueryNodeRequest{Address: "bogus"})
+	c.Assert(status.Code(err), Equals, codes.InvalidArgument)
+
+	nodes, err := srv.Nodes(goCtx, &types.QueryNodesRequest{})
+	c.Assert(err, IsNil)
+	c.Assert(nodes.Nodes, HasLen, 1)
+
+	vault := GetRandomVault()
+	c.Assert(mgr.Keeper().SetVault(ctx, vault), IsNil)
+	vaultResp, err := srv.Vault(goCtx, &types.QueryVaultRequest{PubKey: vault.PubKey.String()})
+	c.Assert(err, IsNil)
+	c.Check(vaultResp.Vault.PubKey.Equals(vault.PubKey), Equals, true)
+	_, err = srv.Vault(goCtx, &types.QueryVaultRequest{PubKey: GetRandomPubKey().String()})
+	c.Assert(status.Code(err), Equals, codes.NotFound)
+
+	vaults, err := srv.AsgardVaults(goCtx, &types.QueryAsgardVaultsRequest{})
+	c.Assert(err, IsNil)
+	c.Assert(vaults.Vaults, HasLen, 1)
+
+	inbound, err := srv.InboundAddresses(goCtx, &types.QueryInboundAddressesRequest{})
+	c.Assert(err, IsNil)
+	c.Assert(len(inbound.InboundAddresses) > 0, Equals, true)
+}
+
+func (s *QueryServerSuite) TestMimirAndMAYAName(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	srv := NewQueryServerImpl(mgr, nil)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	mgr.Keeper().SetMimir(ctx, "HaltTrading", 1)
+	mimir, err := srv.Mimir(goCtx, &types.QueryMimirRequest{Key: "HaltTrading"})
+	c.Assert(err, IsNil)
+	c.Check(mimir.Value, Equals, int64(1))
+	_, err = srv.Mimir(goCtx, &types.QueryMimirRequest{})
+	c.Assert(status.Code(err), Equals, codes.InvalidArgument)
+
+	mimirs, err := srv.Mimirs(goCtx, &types.QueryMimirsRequest{})
+	c.Assert(err, IsNil)
+	c.Check(mimirs.Mimirs["HALTTRADING"], Equals, int64(1))
+
+	owner := GetRandomBech32Addr()
+	mn := NewMAYAName("hello", 50, []MAYANameAlias{{Chain: common.BASEChain, Address: GetRandomBaseAddress()}}, common.EmptyAsset, owner, cosmos.ZeroUint(), nil)
+	mgr.Keeper().SetMAYAName(ctx, mn)
+	nameResp, err := srv.MAYAName(goCtx, &types.QueryMAYANameRequest{Name: "hello"})
+	c.Assert(err, IsNil)
+	c.Check(nameResp.Mayaname.Owner.Equals(owner), Equals, true)
+	_, err = srv.MAYAName(goCtx, &types.QueryMAYANameRequest{Name: "nobody"})
+	c.Assert(status.Code(err), Equals, codes.NotFound)
+}
+
+func (s *QueryServerSuite) TestStreamingSwapsAndTradeAccounts(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	srv := NewQueryServerImpl(mgr, nil)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	txID := GetRandomTxHash()
+	swp := NewStreamingSwap(txID, 10, 20, cosmos.NewUint(100), cosmos.NewUint(1000))
+	mgr.Keeper().SetStreamingSwap(ctx, swp)
+	swpResp, err := srv.StreamingSwap(goCtx, &types.QueryStreamingSwapRequest{TxId: txID.String()})
+	c.Assert(err, IsNil)
+	c.Check(swpResp.StreamingSwap.Quantity, Equals, uint64(10))
+	_, err = srv.StreamingSwap(goCtx, &types.QueryStreamingSwapRequest{TxId: GetRandomTxHash().String()})
+	c.Assert(status.Code(err), Equals, codes.NotFound)
+
+	swps, err := srv.StreamingSwaps(goCtx, &types.QueryStreamingSwapsRequest{})
+	c.Assert(err, IsNil)
+	c.Assert(swps.StreamingSwaps, HasLen, 1)
+
+	owner := GetRandomBech32Addr()
+	asset := common.BTCAsset.GetTradeAsset()
+	ta := types.NewTradeAccount(owner, asset)
+	ta.Units = cosmos.NewUint(500)
+	mgr.Keeper().SetTradeAccount(ctx, ta)
+	empty := types.NewTradeAccount(GetRandomBech32Addr(), asset)
+	mgr.Keeper().SetTradeAccount(ctx, empty)
+
+	taResp, err := srv.TradeAccount(goCtx, &types.QueryTradeAccountRequest{Address: owner.String()})
+	c.Assert(err, IsNil)
+	c.Assert(taResp.TradeAccounts, HasLen, 1)
+	c.Check(taResp.TradeAccounts[0].Units.Uint64(), Equals, uint64(500))
+
+	tas, err := srv.TradeAccounts(goCtx, &types.QueryTradeAccountsRequest{Asset: asset.String()})
+	c.Assert(err, IsNil)
+	c.Assert(tas.TradeAccounts, HasLen, 1)
+}
+
+type poolStreamMock struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*types.QueryPoolResponse
+}
+
+func (m *poolStreamMock) Context() context.Context { return m.ctx }
+
+func (m *poolStreamMock) Send(resp *types.QueryPoolResponse) error {
+	m.sent = append(m.sent, resp)
+	return nil
+}
+
+type nodeStreamMock struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*types.QueryNodeResponse
+}
+
+func (m *nodeStreamMock) Context() context.Context { return m.ctx }
+
+func (m *nodeStreamMock) Send(resp *types.QueryNodeResponse) error {
+	m.sent = append(m.sent, resp)
+	return nil
+}
+
+func (s *QueryServerSuite) TestStreams(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	for _, asset := range []common.Asset{common.BNBAsset, common.BTCAsset} {
+		pool := NewPool()
+		pool.Asset = asset
+		pool.Status = PoolAvailable
+		c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+	}
+	c.Assert(mgr.Keeper().SetNodeAccount(ctx, GetRandomValidatorNode(NodeActive)), IsNil)
+
+	// the context of the call is used when it carries one
+	srv := NewQueryServerImpl(mgr, nil)
+	poolStream := &poolStreamMock{ctx: sdk.WrapSDKContext(ctx)}
+	c.Assert(srv.StreamPools(&types.QueryPoolsRequest{}, poolStream), IsNil)
+	c.Assert(poolStream.sent, HasLen, 2)
+
+	// without a query context the state can't be read
+	poolStream = &poolStreamMock{ctx: context.Background()}
+	err := srv.StreamPools(&types.QueryPoolsRequest{}, poolStream)
+	c.Assert(status.Code(err), Equals, codes.Unavailable)
+
+	// otherwise the query context is created at the requested height
+	var height int64 = -1
+	srv = NewQueryServerImpl(mgr, func(h int64) (cosmos.Context, error) {
+		height = h
+		if h > ctx.BlockHeight() {
+			return cosmos.Context{}, errors.New("height is not committed yet")
+		}
+		return ctx, nil
+	})
+	nodeStream := &nodeStreamMock{ctx: context.Background()}
+	c.Assert(srv.StreamNodes(&types.QueryNodesRequest{}, nodeStream), IsNil)
+	c.Check(height, Equals, int64(0))
+	c.Assert(nodeStream.sent, HasLen, 1)
+
+	md := metadata.Pairs(grpctypes.GRPCBlockHeightHeader, "1000")
+	nodeStream = &nodeStreamMock{ctx: metadata.NewIncomingContext(context.Background(), md)}
+	err = srv.StreamNodes(&types.QueryNodesRequest{}, nodeStream)
+	c.Assert(status.Code(err), Equals, codes.InvalidArgument)
+	c.Check(height, Equals, int64(1000))
+	c.Check(nodeStream.sent, HasLen, 0)
+
+	md = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, "-1")
+	nodeStream = &nodeStreamMock{ctx: metadata.NewIncomingContext(context.Background(), md)}
+	err = srv.StreamNodes(&types.QueryNodesRequest{}, nodeStream)
+	c.Assert(status.Code(err), Equals, codes.InvalidArgument)
+}
+
+func (s *QueryServerSuite) TestQuotes(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	srv := NewQueryServerImpl(mgr, nil)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	_, err := srv.QuoteCacaoPoolDeposit(goCtx, &types.QueryQuoteCacaoPoolDepositRequest{Amount: "100"})
+	c.Assert(status.Code(err), Equals, codes.InvalidArgument)
+
+	mgr.Keeper().SetMimir(ctx, constants.CACAOPoolEnabled.String(), 1)
+	quote, err := srv.QuoteCacaoPoolDeposit(goCtx, &types.QueryQuoteCacaoPoolDepositRequest{Amount: "100"})
+	c.Assert(err, IsNil)
+	c.Check(quote.Memo, Equals, "pool+")
+	c.Check(quote.ExpectedUnits, Equals, "100")
+	c.Check(quote.Expiry > 0, Equals, true)
+
+	_, err = srv.QuoteTradeDeposit(goCtx, &types.QueryQuoteTradeDepositRequest{Asset: "BTC.BTC"})
+	c.Assert(status.Code(err), Equals, codes.InvalidArgument)
+	_, err = srv.QuoteSwap(goCtx, &types.QueryQuoteSwapRequest{FromAsset: "BTC.BTC", ToAsset: "BNB.BNB"})
+	c.Assert(status.Code(err), Equals, codes.InvalidArgument)
+}
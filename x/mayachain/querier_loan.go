@@ -0,0 +1,93 @@
+package mayachain
+
+import (
+	"errors"
+	"fmt"
+
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/constants"
+	openapi "gitlab.com/mayachain/mayanode/openapi/gen"
+)
+
+// queryLoans returns every open loan
+func queryLoans(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
+	return queryLoansFromIterator(ctx, mgr, mgr.Keeper().GetLoanIterator(ctx))
+}
+
+// queryOwnerLoans returns the open loans of the given owner
+func queryOwnerLoans(ctx cosmos.Context, path []string, mgr *Mgrs) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, errors.New("address not provided")
+	}
+	owner, err := cosmos.AccAddressFromBech32(path[0])
+	if err != nil {
+		ctx.Logger().Error("fail to parse address", "error", err)
+		return nil, fmt.Errorf("could not parse address: %w", err)
+	}
+	return queryLoansFromIterator(ctx, mgr, mgr.Keeper().GetLoanIteratorWithOwner(ctx, owner))
+}
+
+func queryLoansFromIterator(ctx cosmos.Context, mgr *Mgrs, iter cosmos.Iterator) ([]byte, error) {
+	defer iter.Close()
+	loans := make([]openapi.Loan, 0)
+	for ; iter.Valid(); iter.Next() {
+		var loan Loan
+		if err := mgr.Keeper().Cdc().Unmarshal(iter.Value(), &loan); err != nil {
+			ctx.Logger().Error("fail to unmarshal loan", "error", err)
+			continue
+		}
+		if !loan.IsOpen() {
+			continue
+		}
+		loanResp, err := newLoanResponse(ctx, mgr, loan)
+		if err != nil {
+			return nil, err
+		}
+		loans = append(loans, loanResp)
+	}
+	return jsonify(ctx, loans)
+}
+
+// newLoanResponse values the loan at the current pool prices. The values are
+// left at zero while the collateral or debt pool is unavailable.
+func newLoanResponse(ctx cosmos.Context, mgr *Mgrs, loan Loan) (openapi.Loan, error) {
+	collateral, err := loanCollateralAmount(ctx, mgr, loan.CollateralAsset, loan.CollateralUnits)
+	if err != nil {
+		return openapi.Loan{}, fmt.Errorf("fail to get collateral of loan: %w", err)
+	}
+	collateralValue, err := loanAssetValueInCacao(ctx, mgr, loan.CollateralAsset, collateral)
+	if err != nil {
+		collateralValue = cosmos.ZeroUint()
+	}
+	debtValue, err := loanAssetValueInCacao(ctx, mgr, loan.DebtAsset, loan.DebtOutstanding())
+	if err != nil {
+		debtValue = cosmos.ZeroUint()
+	}
+
+	minCR := getLoanMinCollateralRatio(ctx, mgr, loan.CollateralAsset)
+	cr := loanCollateralizationRatio(collateralValue, debtValue)
+	health := int64(0)
+	if minCR > 0 {
+		health = cr * int64(constants.MaxBasisPts) / minCR
+	}
+
+	return openapi.Loan{
+		Owner:                     loan.Owner.String(),
+		CollateralAsset:           loan.CollateralAsset.String(),
+		CollateralUnits:           loan.CollateralUnits.String(),
+		CollateralCurrent:         collateral.String(),
+		CollateralDeposited:       loan.CollateralDeposited.String(),
+		CollateralWithdrawn:       loan.CollateralWithdrawn.String(),
+		CollateralValueCacao:      collateralValue.String(),
+		DebtAsset:                 loan.DebtAsset.String(),
+		DebtIssued:                loan.DebtIssued.String(),
+		DebtRepaid:                loan.DebtRepaid.String(),
+		DebtOutstanding:           loan.DebtOutstanding().String(),
+		DebtValueCacao:            debtValue.String(),
+		CollateralizationRatio:    cr,
+		MinCollateralizationRatio: minCR,
+		HealthFactor:              health,
+		LastOpenHeight:            loan.LastOpenHeight,
+		LastRepayHeight:           loan.LastRepayHeight,
+	}, nil
+}
@@ -0,0 +1,75 @@
+package mayachain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	openapi "gitlab.com/mayachain/mayanode/openapi/gen"
+)
+
+// The reverse lookups read the owner and alias indexes of the keeper. An index
+// can still list a name that has expired, or been re-registered by someone
+// else, so every name is checked against its current record.
+
+// queryMAYANamesByOwner returns the mayanames owned by the given address
+func queryMAYANamesByOwner(ctx cosmos.Context, path []string, mgr *Mgrs) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, errors.New("address not provided")
+	}
+	owner, err := cosmos.AccAddressFromBech32(path[0])
+	if err != nil {
+		ctx.Logger().Error("fail to parse address", "error", err)
+		return nil, fmt.Errorf("could not parse address: %w", err)
+	}
+	names, err := mgr.Keeper().GetMAYANamesByOwner(ctx, owner)
+	if err != nil {
+		return nil, ErrInternal(err, "fail to get mayanames by owner")
+	}
+	return queryMAYANamesMatching(ctx, mgr, names, func(name MAYAName) bool {
+		return name.Owner.Equals(owner)
+	})
+}
+
+// queryMAYANamesByAddress returns the mayanames with an alias pointing at the
+// given address of the given chain
+func queryMAYANamesByAddress(ctx cosmos.Context, path []string, mgr *Mgrs) ([]byte, error) {
+	if len(path) < 2 {
+		return nil, errors.New("chain and address not provided")
+	}
+	chain, err := common.NewChain(path[0])
+	if err != nil {
+		return nil, fmt.Errorf("could not parse chain: %w", err)
+	}
+	addr, err := common.NewAddress(path[1], mgr.GetVersion())
+	if err != nil {
+		return nil, fmt.Errorf("could not parse address: %w", err)
+	}
+	names, err := mgr.Keeper().GetMAYANamesByAlias(ctx, chain, addr)
+	if err != nil {
+		return nil, ErrInternal(err, "fail to get mayanames by address")
+	}
+	return queryMAYANamesMatching(ctx, mgr, names, func(name MAYAName) bool {
+		return strings.EqualFold(name.GetAlias(chain).String(), addr.String())
+	})
+}
+
+func queryMAYANamesMatching(ctx cosmos.Context, mgr *Mgrs, names []string, match func(MAYAName) bool) ([]byte, error) {
+	resp := make([]openapi.Mayaname, 0, len(names))
+	for _, n := range names {
+		if !mgr.Keeper().MAYANameExists(ctx, n) {
+			continue
+		}
+		name, err := mgr.Keeper().GetMAYAName(ctx, n)
+		if err != nil {
+			return nil, ErrInternal(err, "fail to fetch MAYAName")
+		}
+		if !match(name) {
+			continue
+		}
+		resp = append(resp, newMAYANameResponse(ctx, mgr, name))
+	}
+	return jsonify(ctx, resp)
+}
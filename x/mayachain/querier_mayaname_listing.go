@@ -0,0 +1,68 @@
+package mayachain
+
+import (
+	"errors"
+	"fmt"
+
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	openapi "gitlab.com/mayachain/mayanode/openapi/gen"
+)
+
+// queryMAYANameListings returns the MAYANames open for sale
+func queryMAYANameListings(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
+	listings := make([]openapi.MayanameListing, 0)
+	iter := mgr.Keeper().GetMAYANameListingIterator(ctx)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var listing MAYANameListing
+		if err := mgr.Keeper().Cdc().Unmarshal(iter.Value(), &listing); err != nil {
+			ctx.Logger().Error("fail to unmarshal mayaname listing", "error", err)
+			continue
+		}
+		if !isOpenMAYANameListing(ctx, mgr, listing) {
+			continue
+		}
+		listings = append(listings, newMAYANameListingResponse(listing))
+	}
+	return jsonify(ctx, listings)
+}
+
+// queryMAYANameListing returns the open listing of the given MAYAName
+func queryMAYANameListing(ctx cosmos.Context, path []string, mgr *Mgrs) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, errors.New("name not provided")
+	}
+	listing, err := mgr.Keeper().GetMAYANameListing(ctx, path[0])
+	if err != nil {
+		return nil, err
+	}
+	if !isOpenMAYANameListing(ctx, mgr, listing) {
+		return nil, fmt.Errorf("mayaname %s is not listed", path[0])
+	}
+	return jsonify(ctx, newMAYANameListingResponse(listing))
+}
+
+// isOpenMAYANameListing returns true when the listing has not expired and its
+// seller still owns the MAYAName, which is when it can be bought
+func isOpenMAYANameListing(ctx cosmos.Context, mgr *Mgrs, listing MAYANameListing) bool {
+	if listing.IsExpired(ctx.BlockHeight()) || !mgr.Keeper().MAYANameExists(ctx, listing.Name) {
+		return false
+	}
+	mn, err := mgr.Keeper().GetMAYAName(ctx, listing.Name)
+	if err != nil {
+		return false
+	}
+	return mn.Owner.Equals(listing.Seller)
+}
+
+func newMAYANameListingResponse(listing MAYANameListing) openapi.MayanameListing {
+	return openapi.MayanameListing{
+		Name:              listing.Name,
+		Seller:            listing.Seller.String(),
+		Price:             listing.Price.String(),
+		ExpireHeight:      listing.ExpireHeight,
+		KeepAliases:       listing.KeepAliases,
+		KeepSubaffiliates: listing.KeepSubaffiliates,
+		Height:            listing.Height,
+	}
+}
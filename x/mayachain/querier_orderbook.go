@@ -0,0 +1,259 @@
+package mayachain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	openapi "gitlab.com/mayachain/mayanode/openapi/gen"
+)
+
+// Prices in the order book queries are expressed the same way limit orders are
+// indexed in the kvstore, which is the amount of source asset paid for one unit
+// (1e8) of target asset. The higher the price, the sooner a limit order fills.
+
+// queryOrderBooks returns the order book of every trade pair that has open
+// limit orders
+func queryOrderBooks(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
+	pairs := make(tradePairs, 0)
+	iter := mgr.Keeper().GetOrderBookItemIterator(ctx)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var msg MsgSwap
+		if err := mgr.Keeper().Cdc().Unmarshal(iter.Value(), &msg); err != nil {
+			ctx.Logger().Error("fail to unmarshal order book item", "error", err)
+			continue
+		}
+		if msg.OrderType != LimitOrder || len(msg.Tx.Coins) == 0 {
+			continue
+		}
+		pair := genTradePair(msg.Tx.Coins[0].Asset, msg.TargetAsset)
+		found := false
+		for _, p := range pairs {
+			if p.Equals(pair) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			pairs = append(pairs, pair)
+		}
+	}
+	sort.SliceStable(pairs, func(i, j int) bool {
+		return pairs[i].String() < pairs[j].String()
+	})
+
+	books := make([]openapi.OrderBook, 0, len(pairs))
+	for _, pair := range pairs {
+		books = append(books, newOrderBook(ctx, mgr, pair.source, pair.target))
+	}
+	return jsonify(ctx, books)
+}
+
+// queryOrderBook returns the order book of the given trade pair
+func queryOrderBook(ctx cosmos.Context, path []string, mgr *Mgrs) ([]byte, error) {
+	if len(path) < 2 {
+		return nil, errors.New("source and target asset not provided")
+	}
+	source, err := common.NewAsset(path[0])
+	if err != nil {
+		ctx.Logger().Error("fail to parse source asset", "error", err)
+		return nil, fmt.Errorf("could not parse source asset: %w", err)
+	}
+	target, err := common.NewAsset(path[1])
+	if err != nil {
+		ctx.Logger().Error("fail to parse target asset", "error", err)
+		return nil, fmt.Errorf("could not parse target asset: %w", err)
+	}
+	if source.Equals(target) {
+		return nil, errors.New("source and target asset cannot be the same")
+	}
+
+	return jsonify(ctx, newOrderBook(ctx, mgr, source, target))
+}
+
+// queryOrderBookOrder returns a single limit order from the order book
+func queryOrderBookOrder(ctx cosmos.Context, path []string, mgr *Mgrs) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, errors.New("tx id not provided")
+	}
+	txid, err := common.NewTxID(path[0])
+	if err != nil {
+		ctx.Logger().Error("fail to parse txid", "error", err)
+		return nil, fmt.Errorf("could not parse txid: %w", err)
+	}
+	msg, err := mgr.Keeper().GetOrderBookItem(ctx, txid)
+	if err != nil {
+		return nil, fmt.Errorf("could not get limit order: %w", err)
+	}
+	if msg.OrderType != LimitOrder || len(msg.Tx.Coins) == 0 {
+		return nil, fmt.Errorf("%s is not a limit order", txid)
+	}
+
+	fill, err := mgr.Keeper().GetLimitOrderFill(ctx, txid)
+	if err != nil {
+		return nil, fmt.Errorf("could not get limit order fill: %w", err)
+	}
+
+	poolRatio := getOrderBookPoolRatio(ctx, mgr, msg.Tx.Coins[0].Asset, msg.TargetAsset)
+	return jsonify(ctx, newLimitOrder(msg, fill, poolRatio))
+}
+
+// newOrderBook walks the order book index of the given trade pair, best price
+// first, and aggregates the unfilled part of the limit orders of each price
+// level
+func newOrderBook(ctx cosmos.Context, mgr *Mgrs, source, target common.Asset) openapi.OrderBook {
+	poolRatio := getOrderBookPoolRatio(ctx, mgr, source, target)
+	book := openapi.OrderBook{
+		SourceAsset: source.String(),
+		TargetAsset: target.String(),
+		PoolPrice:   poolRatio.String(),
+		Levels:      make([]openapi.OrderBookLevel, 0),
+		Orders:      make([]openapi.LimitOrder, 0),
+	}
+	depth := cosmos.ZeroUint()
+
+	iter := mgr.Keeper().GetOrderBookIndexIterator(ctx, LimitOrder, source, target)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		value := ProtoStrings{Value: make([]string, 0)}
+		if err := mgr.Keeper().Cdc().Unmarshal(iter.Value(), &value); err != nil {
+			ctx.Logger().Error("fail to fetch indexed txn hashes", "error", err)
+			continue
+		}
+
+		quantity := cosmos.ZeroUint()
+		tradeTarget := cosmos.ZeroUint()
+		var orders int64
+		for _, rec := range value.Value {
+			hash, err := common.NewTxID(rec)
+			if err != nil {
+				ctx.Logger().Error("fail to parse tx hash", "error", err)
+				continue
+			}
+			msg, err := mgr.Keeper().GetOrderBookItem(ctx, hash)
+			if err != nil {
+				ctx.Logger().Error("fail to fetch msg swap", "error", err)
+				continue
+			}
+			fill, err := mgr.Keeper().GetLimitOrderFill(ctx, hash)
+			if err != nil {
+				ctx.Logger().Error("fail to fetch limit order fill", "error", err)
+				continue
+			}
+			order := newLimitOrder(msg, fill, poolRatio)
+			remaining := fill.Remaining(msg.Tx.Coins[0].Amount)
+			quantity = quantity.Add(remaining)
+			tradeTarget = tradeTarget.Add(common.GetSafeShare(remaining, msg.Tx.Coins[0].Amount, msg.TradeTarget))
+			orders++
+			book.Orders = append(book.Orders, order)
+		}
+		if orders == 0 {
+			continue
+		}
+
+		// the price of a level is the ratio it is indexed by
+		parts := strings.Split(string(iter.Key()), "/")
+		price, err := strconv.ParseUint(parts[len(parts)-2], 10, 64)
+		if err != nil {
+			ctx.Logger().Error("fail to parse ratio", "key", string(iter.Key()), "error", err)
+			continue
+		}
+		book.Levels = append(book.Levels, openapi.OrderBookLevel{
+			Price:       strconv.FormatUint(price, 10),
+			Quantity:    quantity.String(),
+			TradeTarget: tradeTarget.String(),
+			Orders:      orders,
+		})
+		depth = depth.Add(quantity)
+	}
+	book.Depth = depth.String()
+
+	return book
+}
+
+func newLimitOrder(msg MsgSwap, fill LimitOrderFill, poolRatio cosmos.Uint) openapi.LimitOrder {
+	deposit := msg.Tx.Coins[0]
+	price := cosmos.ZeroUint()
+	if !msg.TradeTarget.IsZero() {
+		price = deposit.Amount.MulUint64(common.One).Quo(msg.TradeTarget)
+	}
+
+	// distance is how far the pool price has to move, in basis points, until
+	// the limit order is able to fill (ignoring fees and slip)
+	var distance int64
+	if !poolRatio.IsZero() {
+		diff := cosmos.NewIntFromBigInt(poolRatio.BigInt()).Sub(cosmos.NewIntFromBigInt(price.BigInt()))
+		distance = diff.MulRaw(10_000).Quo(cosmos.NewIntFromBigInt(poolRatio.BigInt())).Int64()
+	}
+
+	order := openapi.LimitOrder{
+		TxId:             msg.Tx.ID.String(),
+		Sender:           msg.Tx.FromAddress.String(),
+		SourceAsset:      deposit.Asset.String(),
+		TargetAsset:      msg.TargetAsset.String(),
+		Destination:      msg.Destination.String(),
+		Deposit:          deposit.Amount.String(),
+		Remaining:        fill.Remaining(deposit.Amount).String(),
+		TradeTarget:      msg.TradeTarget.String(),
+		Price:            price.String(),
+		PoolPrice:        poolRatio.String(),
+		PriceDistanceBps: distance,
+	}
+	if msg.ExpiryHeight > 0 {
+		order.ExpiryHeight = wrapInt64(msg.ExpiryHeight)
+	}
+	return order
+}
+
+// getOrderBookPoolRatio returns the amount of source asset the pools ask for
+// one unit of the target asset, ignoring fees and slip. This is the same ratio
+// the order book manager checks limit orders against.
+func getOrderBookPoolRatio(ctx cosmos.Context, mgr *Mgrs, source, target common.Asset) cosmos.Uint {
+	getPool := func(asset common.Asset) (Pool, bool) {
+		pool, err := mgr.Keeper().GetPool(ctx, asset.GetLayer1Asset())
+		if err != nil {
+			ctx.Logger().Error("fail to get pool", "asset", asset, "error", err)
+			return pool, false
+		}
+		if pool.IsEmpty() || pool.BalanceCacao.IsZero() || pool.BalanceAsset.IsZero() {
+			return pool, false
+		}
+		return pool, true
+	}
+
+	one := cosmos.NewUint(common.One)
+	switch {
+	case source.IsNativeBase():
+		pool, ok := getPool(target)
+		if !ok {
+			return cosmos.ZeroUint()
+		}
+		return pool.BalanceCacao.MulUint64(common.One).Quo(pool.BalanceAsset)
+	case target.IsNativeBase():
+		pool, ok := getPool(source)
+		if !ok {
+			return cosmos.ZeroUint()
+		}
+		return pool.BalanceAsset.MulUint64(common.One).Quo(pool.BalanceCacao)
+	default:
+		sourcePool, ok := getPool(source)
+		if !ok {
+			return cosmos.ZeroUint()
+		}
+		targetPool, ok := getPool(target)
+		if !ok {
+			return cosmos.ZeroUint()
+		}
+		cacaoAmt := common.GetSafeShare(one, sourcePool.BalanceAsset, sourcePool.BalanceCacao)
+		emit := common.GetSafeShare(cacaoAmt, targetPool.BalanceCacao, targetPool.BalanceAsset)
+		if emit.IsZero() {
+			return cosmos.ZeroUint()
+		}
+		return one.MulUint64(common.One).Quo(emit)
+	}
+}
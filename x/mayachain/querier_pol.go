@@ -0,0 +1,130 @@
+package mayachain
+
+import (
+	"errors"
+	"fmt"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	openapi "gitlab.com/mayachain/mayanode/openapi/gen"
+)
+
+// queryPOLPreview returns, for every pool the POL is active in, what the POL
+// cycle would add or remove the next time it evaluates the pool, and why
+func queryPOLPreview(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
+	pol, err := mgr.Keeper().GetPOL(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get POL: %w", err)
+	}
+	polAddress, err := mgr.Keeper().GetModuleAddress(ReserveName)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get POL address: %w", err)
+	}
+
+	pools := getPOLPools(ctx, mgr)
+	result := make([]openapi.POLPoolPreview, 0, len(pools))
+	for i, pool := range pools {
+		var decision polDecision
+		decision, err = evaluatePOLStrategy(ctx, mgr, pool, pol, polAddress)
+		if err != nil {
+			return nil, fmt.Errorf("fail to evaluate POL strategy of %s: %w", pool.Asset, err)
+		}
+
+		// the POL cycle evaluates a single pool per block, pools[height % len(pools)]
+		n := int64(len(pools))
+		next := ctx.BlockHeight() + 1
+		next += (int64(i) - next%n + n) % n
+
+		result = append(result, openapi.POLPoolPreview{
+			Asset:                  decision.Asset.String(),
+			Action:                 decision.Action,
+			CacaoAmount:            decision.CacaoAmount.String(),
+			SynthUtilization:       decision.Utilization.String(),
+			TargetSynthUtilization: decision.TargetUtilization.String(),
+			Buffer:                 decision.Buffer.String(),
+			MaxCacaoDeposit:        decision.MaxPoolDeposit.String(),
+			CacaoValue:             decision.CacaoValue.String(),
+			CooldownBlocks:         decision.Cooldown,
+			LastMovementHeight:     wrapInt64(decision.LastMovementHeight),
+			NextCycleHeight:        next,
+			Reason:                 decision.Reason,
+		})
+	}
+
+	return jsonify(ctx, result)
+}
+
+func newPOLPoolResponse(ledger POLPool, height int64, value cosmos.Uint) openapi.POLPool {
+	realized := ledger.RealizedPnL()
+	unrealized := ledger.UnrealizedPnL(value)
+	return openapi.POLPool{
+		Asset:              ledger.Asset.String(),
+		Height:             height,
+		Units:              ledger.Units.String(),
+		CacaoDeposited:     ledger.CacaoDeposited.String(),
+		CacaoWithdrawn:     ledger.CacaoWithdrawn.String(),
+		EntryValue:         ledger.EntryValue.String(),
+		Value:              value.String(),
+		RealizedPnl:        realized.String(),
+		UnrealizedPnl:      unrealized.String(),
+		Pnl:                realized.Add(unrealized).String(),
+		LastMovementHeight: wrapInt64(ledger.LastMovementHeight),
+	}
+}
+
+// queryPOLPools returns the POL ledger of every pool the POL has, or had,
+// liquidity in, valued at the current height
+func queryPOLPools(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
+	polAddress, err := mgr.Keeper().GetModuleAddress(ReserveName)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get POL address: %w", err)
+	}
+	pools, err := mgr.Keeper().GetPools(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get pools: %w", err)
+	}
+
+	result := make([]openapi.POLPool, 0)
+	for _, pool := range pools {
+		if pool.Asset.IsNative() || pool.BalanceCacao.IsZero() {
+			continue
+		}
+		var ledger POLPool
+		ledger, err = getPOLPoolLedger(ctx, mgr, pool.Asset, polAddress)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get POL ledger of %s: %w", pool.Asset, err)
+		}
+		if ledger.Units.IsZero() && ledger.CacaoDeposited.IsZero() {
+			continue
+		}
+		synthSupply := mgr.Keeper().GetTotalSupply(ctx, pool.Asset.GetSyntheticAsset())
+		pool.CalcUnits(mgr.GetVersion(), synthSupply)
+		result = append(result, newPOLPoolResponse(ledger, ctx.BlockHeight(), getPOLValue(pool, ledger.Units)))
+	}
+
+	return jsonify(ctx, result)
+}
+
+// queryPOLPoolHistory returns the snapshots of the POL ledger of a pool, oldest first
+func queryPOLPoolHistory(ctx cosmos.Context, path []string, mgr *Mgrs) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, errors.New("asset not provided")
+	}
+	asset, err := common.NewAsset(path[0])
+	if err != nil {
+		return nil, fmt.Errorf("could not parse asset: %w", err)
+	}
+
+	result := make([]openapi.POLPool, 0)
+	iterator := mgr.Keeper().GetPOLPoolSnapshotIterator(ctx, asset)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot POLPoolSnapshot
+		if err = mgr.Keeper().Cdc().Unmarshal(iterator.Value(), &snapshot); err != nil {
+			return nil, fmt.Errorf("fail to unmarshal POL snapshot: %w", err)
+		}
+		result = append(result, newPOLPoolResponse(snapshot.Pool, snapshot.Height, snapshot.Value))
+	}
+
+	return jsonify(ctx, result)
+}
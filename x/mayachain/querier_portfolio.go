@@ -0,0 +1,245 @@
+package mayachain
+
+import (
+	"errors"
+	"fmt"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/constants"
+	openapi "gitlab.com/mayachain/mayanode/openapi/gen"
+)
+
+// queryPortfolio returns every liquidity, savers, CACAO pool, trade account and
+// bonded liquidity position of the given MAYA or L1 address
+func queryPortfolio(ctx cosmos.Context, path []string, mgr *Mgrs) ([]byte, error) {
+	if len(path) == 0 || len(path[0]) == 0 {
+		return nil, errors.New("address not provided")
+	}
+	addr, err := common.NewAddress(path[0], mgr.GetVersion())
+	if err != nil {
+		return nil, fmt.Errorf("fail to parse address: %w", err)
+	}
+
+	portfolio := openapi.Portfolio{
+		Address:            addr.String(),
+		LiquidityProviders: make([]openapi.PortfolioLiquidityProvider, 0),
+		Savers:             make([]openapi.Saver, 0),
+		TradeAccounts:      make([]openapi.PortfolioTradeAccount, 0),
+		Bonds:              make([]openapi.PortfolioBond, 0),
+	}
+
+	isNative := addr.IsChain(common.BASEChain, mgr.GetVersion())
+	iter := mgr.Keeper().GetPoolIterator(ctx)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var pool Pool
+		if err = mgr.Keeper().Cdc().Unmarshal(iter.Value(), &pool); err != nil {
+			ctx.Logger().Error("fail to unmarshal pool", "error", err)
+			continue
+		}
+		var lps []LiquidityProvider
+		lps, err = getPortfolioLiquidityProviders(ctx, mgr, pool.Asset, addr, isNative)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get liquidity providers of %s: %w", pool.Asset, err)
+		}
+		for _, lp := range lps {
+			if pool.Asset.IsVaultAsset() {
+				portfolio.Savers = append(portfolio.Savers, newSaver(lp, pool))
+				continue
+			}
+			portfolio.LiquidityProviders = append(portfolio.LiquidityProviders, newPortfolioLiquidityProvider(ctx, mgr, lp, pool))
+			portfolio.Bonds = append(portfolio.Bonds, newPortfolioBonds(lp, pool)...)
+		}
+	}
+
+	// the CACAO pool and trade accounts are only held by MAYA addresses
+	if !isNative {
+		return jsonify(ctx, portfolio)
+	}
+	accAddr, err := addr.AccAddress()
+	if err != nil {
+		return nil, fmt.Errorf("fail to convert address: %w", err)
+	}
+
+	cacaoProvider, err := mgr.Keeper().GetCACAOProvider(ctx, accAddr)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get CACAO provider: %w", err)
+	}
+	if !cacaoProvider.Units.IsZero() {
+		var cacaoPool CACAOPool
+		cacaoPool, err = mgr.Keeper().GetCACAOPool(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get CACAO pool: %w", err)
+		}
+		var poolValue cosmos.Uint
+		poolValue, err = cacaoPoolValue(ctx, mgr)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get cacao pool value: %w", err)
+		}
+		providerValue := common.GetSafeShare(cacaoProvider.Units, cacaoPool.TotalUnits(), poolValue)
+		providerPnl := providerValue.BigInt()
+		providerPnl.Sub(providerPnl, cacaoProvider.DepositAmount.BigInt())
+		providerPnl.Add(providerPnl, cacaoProvider.WithdrawAmount.BigInt())
+		portfolio.CacaoProvider = &openapi.CACAOProvider{
+			CacaoAddress:       cacaoProvider.CacaoAddress.String(),
+			Units:              cacaoProvider.Units.String(),
+			Value:              providerValue.String(),
+			Pnl:                providerPnl.String(),
+			DepositAmount:      cacaoProvider.DepositAmount.String(),
+			WithdrawAmount:     cacaoProvider.WithdrawAmount.String(),
+			LastDepositHeight:  cacaoProvider.LastDepositHeight,
+			LastWithdrawHeight: cacaoProvider.LastWithdrawHeight,
+		}
+		setCACAOProviderOptions(ctx, mgr, portfolio.CacaoProvider, cacaoProvider)
+	}
+
+	taIter := mgr.Keeper().GetTradeAccountIteratorWithAddress(ctx, accAddr)
+	defer taIter.Close()
+	for ; taIter.Valid(); taIter.Next() {
+		var ta TradeAccount
+		if err = mgr.Keeper().Cdc().Unmarshal(taIter.Value(), &ta); err != nil {
+			continue
+		}
+		if ta.Units.IsZero() {
+			continue
+		}
+		var tu TradeUnit
+		tu, err = mgr.Keeper().GetTradeUnit(ctx, ta.Asset)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get trade unit of %s: %w", ta.Asset, err)
+		}
+		portfolio.TradeAccounts = append(portfolio.TradeAccounts, openapi.PortfolioTradeAccount{
+			Asset:      ta.Asset.String(),
+			Units:      ta.Units.String(),
+			AssetValue: common.GetSafeShare(ta.Units, tu.Units, tu.Depth).String(),
+		})
+	}
+
+	return jsonify(ctx, portfolio)
+}
+
+// getPortfolioLiquidityProviders returns the liquidity providers of the pool
+// held by the given address. Liquidity providers are keyed by their MAYA
+// address when they have one, so an L1 address also has to be matched against
+// the asset address of every liquidity provider of a pool on its chain.
+func getPortfolioLiquidityProviders(ctx cosmos.Context, mgr *Mgrs, asset common.Asset, addr common.Address, isNative bool) ([]LiquidityProvider, error) {
+	if !isNative && !addr.IsChain(asset.GetLayer1Asset().GetChain(), mgr.GetVersion()) {
+		return nil, nil
+	}
+	if isNative || asset.IsVaultAsset() {
+		lp, err := mgr.Keeper().GetLiquidityProvider(ctx, asset, addr)
+		if err != nil {
+			return nil, err
+		}
+		if lp.Units.IsZero() && lp.PendingCacao.IsZero() && lp.PendingAsset.IsZero() {
+			return nil, nil
+		}
+		return []LiquidityProvider{lp}, nil
+	}
+
+	var lps []LiquidityProvider
+	iter := mgr.Keeper().GetLiquidityProviderIterator(ctx, asset)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var lp LiquidityProvider
+		if err := mgr.Keeper().Cdc().Unmarshal(iter.Value(), &lp); err != nil {
+			ctx.Logger().Error("fail to unmarshal liquidity provider", "error", err)
+			continue
+		}
+		if lp.AssetAddress.Equals(addr) {
+			lps = append(lps, lp)
+		}
+	}
+	return lps, nil
+}
+
+func newPortfolioLiquidityProvider(ctx cosmos.Context, mgr *Mgrs, lp LiquidityProvider, pool Pool) openapi.PortfolioLiquidityProvider {
+	synthSupply := mgr.Keeper().GetTotalSupply(ctx, pool.Asset.GetSyntheticAsset())
+	_, cacaoRedeemValue := lp.GetRuneRedeemValue(mgr.GetVersion(), pool, synthSupply)
+	_, assetRedeemValue := lp.GetAssetRedeemValue(mgr.GetVersion(), pool, synthSupply)
+
+	bondedUnits := cosmos.ZeroUint()
+	if !lp.NodeBondAddress.Empty() {
+		bondedUnits = lp.Units
+	} else {
+		for _, bond := range lp.BondedNodes {
+			bondedUnits = bondedUnits.Add(bond.Units)
+		}
+	}
+
+	return openapi.PortfolioLiquidityProvider{
+		Asset:             lp.Asset.String(),
+		CacaoAddress:      wrapString(lp.CacaoAddress.String()),
+		AssetAddress:      wrapString(lp.AssetAddress.String()),
+		LastAddHeight:     wrapInt64(lp.LastAddHeight),
+		Units:             lp.Units.String(),
+		PendingCacao:      lp.PendingCacao.String(),
+		PendingAsset:      lp.PendingAsset.String(),
+		CacaoDepositValue: lp.CacaoDepositValue.String(),
+		AssetDepositValue: lp.AssetDepositValue.String(),
+		CacaoRedeemValue:  cacaoRedeemValue.String(),
+		AssetRedeemValue:  assetRedeemValue.String(),
+		BondedUnits:       bondedUnits.String(),
+		ImpLossProtection: newPortfolioImpLossProtection(ctx, mgr, lp, pool),
+	}
+}
+
+// newPortfolioImpLossProtection returns the impermanent loss protection a full
+// withdrawal of the liquidity provider would receive at the current height, as
+// calculated by the withdraw handler
+func newPortfolioImpLossProtection(ctx cosmos.Context, mgr *Mgrs, lp LiquidityProvider, pool Pool) openapi.PortfolioImpLossProtection {
+	fullProtectionLine, err := mgr.Keeper().GetMimir(ctx, constants.FullImpLossProtectionBlocks.String())
+	if fullProtectionLine < 0 || err != nil {
+		fullProtectionLine = mgr.GetConstants().GetInt64Value(constants.FullImpLossProtectionBlocks)
+	}
+	ilpDisabled, err := mgr.Keeper().GetMimir(ctx, fmt.Sprintf("ILP-DISABLED-%s", pool.Asset))
+	if err != nil {
+		ilpDisabled = 0
+	}
+
+	result := openapi.PortfolioImpLossProtection{
+		Enabled:         fullProtectionLine > 0 && pool.Status == PoolAvailable && ilpDisabled <= 0,
+		ProtectionCacao: cosmos.ZeroUint().String(),
+	}
+	if !result.Enabled {
+		return result
+	}
+
+	lastAddHeight := lp.LastAddHeight
+	if lastAddHeight < pool.StatusSince {
+		lastAddHeight = pool.StatusSince
+	}
+	protection, _, _ := calcImpLossV102(ctx, mgr, lastAddHeight, lp, cosmos.NewUint(constants.MaxBasisPts), fullProtectionLine, pool)
+	result.ProtectionCacao = protection.String()
+	return result
+}
+
+// newPortfolioBonds returns the liquidity of the liquidity provider bonded to
+// nodes, valued in cacao
+func newPortfolioBonds(lp LiquidityProvider, pool Pool) []openapi.PortfolioBond {
+	var bonds []openapi.PortfolioBond
+	addBond := func(nodeAddr cosmos.AccAddress, units cosmos.Uint) {
+		if units.IsZero() {
+			return
+		}
+		value := common.GetSafeShare(units, pool.LPUnits, pool.BalanceCacao)
+		value = value.Add(pool.AssetValueInRune(common.GetSafeShare(units, pool.LPUnits, pool.BalanceAsset)))
+		bonds = append(bonds, openapi.PortfolioBond{
+			NodeAddress: nodeAddr.String(),
+			Asset:       lp.Asset.String(),
+			Units:       units.String(),
+			CacaoValue:  value.String(),
+		})
+	}
+
+	// the deprecated NodeBondAddress bonds all the liquidity to a single node
+	if !lp.NodeBondAddress.Empty() {
+		addBond(lp.NodeBondAddress, lp.Units)
+		return bonds
+	}
+	for _, bond := range lp.BondedNodes {
+		addBond(bond.NodeAddress, bond.Units)
+	}
+	return bonds
+}
@@ -0,0 +1,243 @@
+package mayachain
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	openapi "gitlab.com/mayachain/mayanode/openapi/gen"
+)
+
+const (
+	queueOffsetParam      = "offset"
+	queueLimitParam       = "limit"
+	queueChainParam       = "chain"
+	queueAssetParam       = "asset"
+	queueDestinationParam = "destination"
+	queueVaultParam       = "vault"
+	queueFromHeightParam  = "from_height"
+	queueToHeightParam    = "to_height"
+
+	defaultQueuePageLimit = 100
+	maxQueuePageLimit     = 1000
+)
+
+// queueFilter holds the pagination and filter parameters of the queue page
+// endpoints. Empty fields do not filter.
+type queueFilter struct {
+	offset      int64
+	limit       int64
+	chain       common.Chain
+	asset       common.Asset
+	destination string
+	vault       string
+	fromHeight  int64
+	toHeight    int64
+}
+
+func parseQueueFilter(data []byte) (queueFilter, error) {
+	filter := queueFilter{limit: defaultQueuePageLimit}
+	if len(data) == 0 {
+		return filter, nil
+	}
+	u, err := url.ParseRequestURI(string(data))
+	if err != nil {
+		return filter, fmt.Errorf("bad params: %w", err)
+	}
+	params := u.Query()
+
+	parseInt := func(key string) (int64, error) {
+		value := params.Get(key)
+		if value == "" {
+			return 0, nil
+		}
+		i, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || i < 0 {
+			return 0, fmt.Errorf("invalid %s: %s", key, value)
+		}
+		return i, nil
+	}
+
+	if filter.offset, err = parseInt(queueOffsetParam); err != nil {
+		return filter, err
+	}
+	if params.Get(queueLimitParam) != "" {
+		if filter.limit, err = parseInt(queueLimitParam); err != nil {
+			return filter, err
+		}
+		if filter.limit == 0 || filter.limit > maxQueuePageLimit {
+			return filter, fmt.Errorf("limit must be between 1 and %d", maxQueuePageLimit)
+		}
+	}
+	if filter.fromHeight, err = parseInt(queueFromHeightParam); err != nil {
+		return filter, err
+	}
+	if filter.toHeight, err = parseInt(queueToHeightParam); err != nil {
+		return filter, err
+	}
+	if filter.toHeight > 0 && filter.toHeight < filter.fromHeight {
+		return filter, fmt.Errorf("%s must not be less than %s", queueToHeightParam, queueFromHeightParam)
+	}
+	if chain := params.Get(queueChainParam); chain != "" {
+		if filter.chain, err = common.NewChain(chain); err != nil {
+			return filter, fmt.Errorf("invalid chain: %w", err)
+		}
+	}
+	if asset := params.Get(queueAssetParam); asset != "" {
+		if filter.asset, err = common.NewAsset(asset); err != nil {
+			return filter, fmt.Errorf("invalid asset: %w", err)
+		}
+	}
+	filter.destination = params.Get(queueDestinationParam)
+	filter.vault = params.Get(queueVaultParam)
+	return filter, nil
+}
+
+// hasHeightWindow returns true if the filter restricts the height
+func (f queueFilter) hasHeightWindow() bool {
+	return f.fromHeight > 0 || f.toHeight > 0
+}
+
+func (f queueFilter) matchHeight(height int64) bool {
+	if f.fromHeight > 0 && height < f.fromHeight {
+		return false
+	}
+	if f.toHeight > 0 && height > f.toHeight {
+		return false
+	}
+	return true
+}
+
+// page returns the start and end index of the requested page over total items
+func (f queueFilter) page(total int) (int, int) {
+	start := f.offset
+	if start > int64(total) {
+		start = int64(total)
+	}
+	end := start + f.limit
+	if end > int64(total) {
+		end = int64(total)
+	}
+	return int(start), int(end)
+}
+
+func (f queueFilter) matchTxOutItem(item openapi.TxOutItem) bool {
+	if !f.chain.IsEmpty() && !strings.EqualFold(item.Chain, f.chain.String()) {
+		return false
+	}
+	if !f.asset.IsEmpty() && !strings.EqualFold(item.Coin.Asset, f.asset.String()) {
+		return false
+	}
+	if f.destination != "" && !strings.EqualFold(item.ToAddress, f.destination) {
+		return false
+	}
+	if f.vault != "" && (item.VaultPubKey == nil || *item.VaultPubKey != f.vault) {
+		return false
+	}
+	if f.hasHeightWindow() && (item.Height == nil || !f.matchHeight(*item.Height)) {
+		return false
+	}
+	return true
+}
+
+// queryOutboundPage returns a page of the given outbounds which match the
+// filter, together with the number of matching outbounds per chain
+func queryOutboundPage(ctx cosmos.Context, data []byte, items []openapi.TxOutItem) ([]byte, error) {
+	filter, err := parseQueueFilter(data)
+	if err != nil {
+		return nil, err
+	}
+
+	matched := make([]openapi.TxOutItem, 0)
+	chainTotals := make(map[string]int64)
+	for _, item := range items {
+		if !filter.matchTxOutItem(item) {
+			continue
+		}
+		matched = append(matched, item)
+		chainTotals[item.Chain]++
+	}
+
+	start, end := filter.page(len(matched))
+	return jsonify(ctx, openapi.OutboundQueuePageResponse{
+		Total:       int64(len(matched)),
+		Offset:      filter.offset,
+		Limit:       filter.limit,
+		ChainTotals: chainTotals,
+		Outbounds:   matched[start:end],
+	})
+}
+
+func queryPendingOutboundPage(ctx cosmos.Context, data []byte, mgr *Mgrs) ([]byte, error) {
+	items, err := getPendingOutbounds(ctx, mgr)
+	if err != nil {
+		return nil, err
+	}
+	return queryOutboundPage(ctx, data, items)
+}
+
+func queryScheduledOutboundPage(ctx cosmos.Context, data []byte, mgr *Mgrs) ([]byte, error) {
+	return queryOutboundPage(ctx, data, getScheduledOutbounds(ctx, mgr))
+}
+
+// querySwapQueuePage returns a page of the swap queue which match the filter.
+// Swaps are counted against the chain of their target asset, while the vault
+// and height window are matched against the observed inbound.
+func querySwapQueuePage(ctx cosmos.Context, data []byte, mgr *Mgrs) ([]byte, error) {
+	filter, err := parseQueueFilter(data)
+	if err != nil {
+		return nil, err
+	}
+
+	matched := make([]openapi.MsgSwap, 0)
+	chainTotals := make(map[string]int64)
+	iterator := mgr.Keeper().GetSwapQueueIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var msg MsgSwap
+		if err := mgr.Keeper().Cdc().Unmarshal(iterator.Value(), &msg); err != nil {
+			continue
+		}
+		chain := msg.TargetAsset.GetChain()
+		if !filter.chain.IsEmpty() && !chain.Equals(filter.chain) {
+			continue
+		}
+		if !filter.asset.IsEmpty() && !msg.TargetAsset.Equals(filter.asset) &&
+			(len(msg.Tx.Coins) == 0 || !msg.Tx.Coins[0].Asset.Equals(filter.asset)) {
+			continue
+		}
+		if filter.destination != "" && !strings.EqualFold(msg.Destination.String(), filter.destination) {
+			continue
+		}
+		if filter.vault != "" || filter.hasHeightWindow() {
+			voter, err := mgr.Keeper().GetObservedTxInVoter(ctx, msg.Tx.ID)
+			if err != nil {
+				return nil, fmt.Errorf("fail to get observed tx voter: %w", err)
+			}
+			if filter.vault != "" && voter.Tx.ObservedPubKey.String() != filter.vault {
+				continue
+			}
+			height := voter.FinalisedHeight
+			if height == 0 {
+				height = voter.Height
+			}
+			if filter.hasHeightWindow() && !filter.matchHeight(height) {
+				continue
+			}
+		}
+		matched = append(matched, castMsgSwap(msg))
+		chainTotals[chain.String()]++
+	}
+
+	start, end := filter.page(len(matched))
+	return jsonify(ctx, openapi.SwapQueuePageResponse{
+		Total:       int64(len(matched)),
+		Offset:      filter.offset,
+		Limit:       filter.limit,
+		ChainTotals: chainTotals,
+		Swaps:       matched[start:end],
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -41,14 +42,30 @@ const (
 	liquidityToleranceBpsParam = "liquidity_tolerance_bps"
 	// affiliateParams            = "affiliates"
 	// affiliateBpsParam         = "affiliate_bps"
-	minOutParam        = "min_out"
-	intervalParam      = "streaming_interval"
-	quantityParam      = "streaming_quantity"
-	refundAddressParam = "refund_address"
+	minOutParam          = "min_out"
+	intervalParam        = "streaming_interval"
+	quantityParam        = "streaming_quantity"
+	refundAddressParam   = "refund_address"
+	amountCacaoParam     = "amount_cacao"
+	amountAssetParam     = "amount_asset"
+	cacaoAddressParam    = "cacao_address"
+	assetAddressParam    = "asset_address"
+	withdrawalAssetParam = "withdrawal_asset"
 
 	quoteWarning         = "Do not cache this response. Do not send funds after the expiry."
 	quoteExpiration      = 15 * time.Minute
 	ethBlockRewardAndFee = 3 * 1e18
+
+	// quoteTxID is the inbound hash of simulated messages, it must not be blank since
+	// handlers treat a blank hash as a ragnarok transaction
+	quoteTxID common.TxID = "0000000000000000000000000000000000000000000000000000000000000001"
+
+	// quoteRouteStreamingToleranceBps is the output a route may give up against its best
+	// streaming quantity in exchange for finishing in fewer sub-swaps
+	quoteRouteStreamingToleranceBps = 10
+
+	// quoteRouteMaxStreamingCandidates bounds the streaming quantities simulated per route
+	quoteRouteMaxStreamingCandidates = 6
 )
 
 // var nullLogger = &log.TendermintLogWrapper{Logger: zerolog.New(io.Discard)}
@@ -499,6 +516,41 @@ func quoteConvertAsset(ctx cosmos.Context, mgr *Mgrs, fromAsset common.Asset, am
 	return amount, nil
 }
 
+// quoteLiquidityAuction returns the liquidity auction tier restrictions for the provided
+// liquidity provider, or nil if the cacao address has no tier
+func quoteLiquidityAuction(ctx cosmos.Context, mgr *Mgrs, lp LiquidityProvider) (*openapi.QuoteLiquidityAuction, error) {
+	if lp.CacaoAddress.IsEmpty() {
+		return nil, nil
+	}
+	tier, err := mgr.Keeper().GetLiquidityAuctionTier(ctx, lp.CacaoAddress)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get liquidity auction tier: %w", err)
+	}
+	if tier == 0 {
+		return nil, nil
+	}
+
+	cv := mgr.GetConstants()
+	withdrawLimit, err := getWithdrawLimit(ctx, mgr, cv, lp.CacaoAddress)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get withdraw limit: %w", err)
+	}
+
+	// the withdraw counter resets once a day has passed since it started
+	withdrawCounter := lp.WithdrawCounter
+	if ctx.BlockHeight() >= lp.LastWithdrawCounterHeight+cv.GetInt64Value(constants.BlocksPerDay) {
+		withdrawCounter = cosmos.ZeroUint()
+	}
+
+	return &openapi.QuoteLiquidityAuction{
+		Tier:                      tier,
+		WithdrawLimitBps:          withdrawLimit,
+		WithdrawLimitActive:       isWithinWithdrawDaysLimit(ctx, mgr, cv, lp.CacaoAddress),
+		WithdrawCounter:           withdrawCounter.String(),
+		LastWithdrawCounterHeight: lp.LastWithdrawCounterHeight,
+	}, nil
+}
+
 // -------------------------------------------------------------------------------------
 // Swap
 // -------------------------------------------------------------------------------------
@@ -563,37 +615,45 @@ func queryQuoteSwap(ctx cosmos.Context, req abci.RequestQuery, mgr *Mgrs) ([]byt
 		return quoteErrorResponse(err)
 	}
 
+	res, err := quoteSwap(ctx, mgr, params)
+	if err != nil {
+		return quoteErrorResponse(err)
+	}
+	return json.MarshalIndent(res, "", "  ")
+}
+
+func quoteSwap(ctx cosmos.Context, mgr *Mgrs, params url.Values) (*openapi.QuoteSwapResponse, error) {
 	// validate required parameters
 	for _, p := range []string{fromAssetParam, toAssetParam, amountParam} {
 		if len(params[p]) == 0 {
-			return quoteErrorResponse(fmt.Errorf("missing required parameter %s", p))
+			return nil, fmt.Errorf("missing required parameter %s", p)
 		}
 	}
 
 	// parse assets
 	fromAsset, err := common.NewAssetWithShortCodes(mgr.GetVersion(), params[fromAssetParam][0])
 	if err != nil {
-		return quoteErrorResponse(fmt.Errorf("bad from asset: %w", err))
+		return nil, fmt.Errorf("bad from asset: %w", err)
 	}
 	fromAsset = fuzzyAssetMatch(ctx, mgr.Keeper(), fromAsset)
 	toAsset, err := common.NewAssetWithShortCodes(mgr.GetVersion(), params[toAssetParam][0])
 	if err != nil {
-		return quoteErrorResponse(fmt.Errorf("bad to asset: %w", err))
+		return nil, fmt.Errorf("bad to asset: %w", err)
 	}
 	toAsset = fuzzyAssetMatch(ctx, mgr.Keeper(), toAsset)
 
 	// parse amount
 	amount, err := cosmos.ParseUint(params[amountParam][0])
 	if err != nil {
-		return quoteErrorResponse(fmt.Errorf("bad amount: %w", err))
+		return nil, fmt.Errorf("bad amount: %w", err)
 	}
 
 	if amount.LT(fromAsset.Chain.DustThreshold()) {
-		return quoteErrorResponse(fmt.Errorf("amount less than dust threshold"))
+		return nil, fmt.Errorf("amount less than dust threshold")
 	}
 
 	if len(params[toleranceBasisPointsParam]) > 0 && len(params[liquidityToleranceBpsParam]) > 0 {
-		return quoteErrorResponse(fmt.Errorf("must only include one of: tolerance_bps or liquidity_tolerance_bps"))
+		return nil, fmt.Errorf("must only include one of: tolerance_bps or liquidity_tolerance_bps")
 	}
 
 	// parse streaming interval
@@ -601,14 +661,14 @@ func queryQuoteSwap(ctx cosmos.Context, req abci.RequestQuery, mgr *Mgrs) ([]byt
 	if len(params[intervalParam]) > 0 {
 		streamingInterval, err = strconv.ParseUint(params[intervalParam][0], 10, 64)
 		if err != nil {
-			return quoteErrorResponse(fmt.Errorf("bad streaming interval amount: %w", err))
+			return nil, fmt.Errorf("bad streaming interval amount: %w", err)
 		}
 	}
 	streamingQuantity := uint64(0) // default value
 	if len(params[quantityParam]) > 0 {
 		streamingQuantity, err = strconv.ParseUint(params[quantityParam][0], 10, 64)
 		if err != nil {
-			return quoteErrorResponse(fmt.Errorf("bad streaming quantity amount: %w", err))
+			return nil, fmt.Errorf("bad streaming quantity amount: %w", err)
 		}
 	}
 	swp := StreamingSwap{
@@ -617,7 +677,7 @@ func queryQuoteSwap(ctx cosmos.Context, req abci.RequestQuery, mgr *Mgrs) ([]byt
 	}
 	maxSwapQuantity, err := getMaxSwapQuantity(ctx, mgr, fromAsset, toAsset, swp)
 	if err != nil {
-		return quoteErrorResponse(fmt.Errorf("failed to calculate max streaming swap quantity: %w", err))
+		return nil, fmt.Errorf("failed to calculate max streaming swap quantity: %w", err)
 	}
 
 	// cap the streaming quantity to the max swap quantity
@@ -630,12 +690,12 @@ func queryQuoteSwap(ctx cosmos.Context, req abci.RequestQuery, mgr *Mgrs) ([]byt
 		// mint required coins to asgard so swap can be simulated
 		err = mgr.Keeper().MintToModule(ctx, ModuleName, common.NewCoin(fromAsset, amount))
 		if err != nil {
-			return quoteErrorResponse(fmt.Errorf("failed to mint coins to module: %w", err))
+			return nil, fmt.Errorf("failed to mint coins to module: %w", err)
 		}
 
 		err = mgr.Keeper().SendFromModuleToModule(ctx, ModuleName, AsgardName, common.NewCoins(common.NewCoin(fromAsset, amount)))
 		if err != nil {
-			return quoteErrorResponse(fmt.Errorf("failed to send coins to asgard: %w", err))
+			return nil, fmt.Errorf("failed to send coins to asgard: %w", err)
 		}
 	}
 
@@ -645,17 +705,15 @@ func queryQuoteSwap(ctx cosmos.Context, req abci.RequestQuery, mgr *Mgrs) ([]byt
 	if len(params[destinationParam]) > 0 {
 		destination, err = quoteParseAddress(ctx, mgr, params[destinationParam][0], toAsset.Chain)
 		if err != nil {
-			return quoteErrorResponse(fmt.Errorf("bad destination address: %w", err))
+			return nil, fmt.Errorf("bad destination address: %w", err)
 		}
 
 	} else {
-		chain := common.BASEChain
-		if !toAsset.IsSyntheticAsset() {
-			chain = toAsset.Chain
-		}
+		// synths and trade assets are received on mayachain
+		chain := toAsset.GetChain()
 		destination, err = types.GetRandomPubkeyForChain(chain).GetAddress(chain)
 		if err != nil {
-			return quoteErrorResponse(fmt.Errorf("failed to generate address: %w", err))
+			return nil, fmt.Errorf("failed to generate address: %w", err)
 		}
 		sendMemo = false // do not send memo if destination was random
 	}
@@ -668,27 +726,27 @@ func queryQuoteSwap(ctx cosmos.Context, req abci.RequestQuery, mgr *Mgrs) ([]byt
 		var toleranceBasisPoints sdk.Uint
 		toleranceBasisPoints, err = sdk.ParseUint(params[toleranceBasisPointsParam][0])
 		if err != nil {
-			return quoteErrorResponse(fmt.Errorf("bad tolerance basis points: %w", err))
+			return nil, fmt.Errorf("bad tolerance basis points: %w", err)
 		}
 		if toleranceBasisPoints.GT(sdk.NewUint(10000)) {
-			return quoteErrorResponse(fmt.Errorf("tolerance basis points must be less than 10000"))
+			return nil, fmt.Errorf("tolerance basis points must be less than 10000")
 		}
 
 		// convert to a limit of target asset amount assuming zero fees and slip
 		var feelessEmit sdk.Uint
 		feelessEmit, err = quoteConvertAsset(ctx, mgr, fromAsset, amount, toAsset)
 		if err != nil {
-			return quoteErrorResponse(err)
+			return nil, err
 		}
 
 		limit = feelessEmit.MulUint64(10000 - toleranceBasisPoints.Uint64()).QuoUint64(10000)
 	} else if len(params[liquidityToleranceBpsParam]) > 0 {
 		liquidityToleranceBps, err = sdk.ParseUint(params[liquidityToleranceBpsParam][0])
 		if err != nil {
-			return quoteErrorResponse(fmt.Errorf("bad liquidity tolerance basis points: %w", err))
+			return nil, fmt.Errorf("bad liquidity tolerance basis points: %w", err)
 		}
 		if liquidityToleranceBps.GTE(sdk.NewUint(10000)) {
-			return quoteErrorResponse(fmt.Errorf("liquidity tolerance basis points must be less than 10000"))
+			return nil, fmt.Errorf("liquidity tolerance basis points must be less than 10000")
 		}
 	}
 
@@ -697,14 +755,14 @@ func queryQuoteSwap(ctx cosmos.Context, req abci.RequestQuery, mgr *Mgrs) ([]byt
 	if len(params[refundAddressParam]) > 0 {
 		refundAddress, err = quoteParseAddress(ctx, mgr, params[refundAddressParam][0], fromAsset.Chain)
 		if err != nil {
-			return quoteErrorResponse(fmt.Errorf("bad refund address: %w", err))
+			return nil, fmt.Errorf("bad refund address: %w", err)
 		}
 	}
 
 	// parse affiliate params
 	affiliates, affiliateBps, affiliateForMemo, totalBps, err := parseMultipleAffiliateParams(ctx, mgr, params[affiliateParam], params[affiliateBpsParam])
 	if err != nil {
-		return quoteErrorResponse(fmt.Errorf("bad affiliate params: %w", err))
+		return nil, fmt.Errorf("bad affiliate params: %w", err)
 	}
 
 	// create the memo
@@ -734,7 +792,7 @@ func queryQuoteSwap(ctx cosmos.Context, req abci.RequestQuery, mgr *Mgrs) ([]byt
 	var fromAddress common.Address
 	fromAddress, err = fromPubkey.GetAddress(fromChain)
 	if err != nil {
-		return quoteErrorResponse(fmt.Errorf("bad from address: %w", err))
+		return nil, fmt.Errorf("bad from address: %w", err)
 	}
 
 	// if from asset is a trade asset, create fake balance
@@ -742,11 +800,11 @@ func queryQuoteSwap(ctx cosmos.Context, req abci.RequestQuery, mgr *Mgrs) ([]byt
 		var mayaAddr cosmos.AccAddress
 		mayaAddr, err = fromPubkey.GetThorAddress()
 		if err != nil {
-			return quoteErrorResponse(fmt.Errorf("failed to get maya address: %w", err))
+			return nil, fmt.Errorf("failed to get maya address: %w", err)
 		}
 		_, err = mgr.TradeAccountManager().Deposit(ctx, fromAsset, amount, mayaAddr, common.NoAddress, common.BlankTxID)
 		if err != nil {
-			return quoteErrorResponse(fmt.Errorf("failed to deposit trade asset: %w", err))
+			return nil, fmt.Errorf("failed to deposit trade asset: %w", err)
 		}
 	}
 
@@ -779,7 +837,7 @@ func queryQuoteSwap(ctx cosmos.Context, req abci.RequestQuery, mgr *Mgrs) ([]byt
 	// simulate the swap
 	res, emitAmount, outboundFeeAmount, err := quoteSimulateSwap(ctx, mgr, amount, msg, 1)
 	if err != nil {
-		return quoteErrorResponse(fmt.Errorf("failed to simulate swap: %w", err))
+		return nil, fmt.Errorf("failed to simulate swap: %w", err)
 	}
 
 	// if we're using a streaming swap, calculate emit amount by a sub-swap amount instead
@@ -793,7 +851,7 @@ func queryQuoteSwap(ctx cosmos.Context, req abci.RequestQuery, mgr *Mgrs) ([]byt
 		var streamRes *openapi.QuoteSwapResponse
 		streamRes, emitAmount, _, err = quoteSimulateSwap(ctx, mgr, amount, msg, streamingQuantity)
 		if err != nil {
-			return quoteErrorResponse(fmt.Errorf("failed to simulate swap: %w", err))
+			return nil, fmt.Errorf("failed to simulate swap: %w", err)
 		}
 		res.Fees = streamRes.Fees
 	}
@@ -809,7 +867,7 @@ func queryQuoteSwap(ctx cosmos.Context, req abci.RequestQuery, mgr *Mgrs) ([]byt
 	// }
 	// res, emitAmount, outboundFeeAmount, err := quoteSimulateSwap(ctx, mgr, amount, msg, streamingQuantity)
 	// if err != nil {
-	//   return quoteErrorResponse(fmt.Errorf("failed to simulate swap: %w", err))
+	//   return nil, fmt.Errorf("failed to simulate swap: %w", err)
 	// }
 
 	// get the pool to calculate the affiliate amount in cacao
@@ -817,7 +875,7 @@ func queryQuoteSwap(ctx cosmos.Context, req abci.RequestQuery, mgr *Mgrs) ([]byt
 	if !toAsset.IsNative() {
 		pool, err = mgr.Keeper().GetPool(ctx, toAsset)
 		if err != nil {
-			return quoteErrorResponse(fmt.Errorf("failed to get pool: %w", err))
+			return nil, fmt.Errorf("failed to get pool: %w", err)
 		}
 	}
 	// get the native (cacao) tx fee in cacao
@@ -863,7 +921,7 @@ func queryQuoteSwap(ctx cosmos.Context, req abci.RequestQuery, mgr *Mgrs) ([]byt
 
 	// check invariant
 	if emitAmount.LT(outboundFeeAmount) {
-		return quoteErrorResponse(fmt.Errorf("invariant broken: emit %s less than outbound fee %s", emitAmount, outboundFeeAmount))
+		return nil, fmt.Errorf("invariant broken: emit %s less than outbound fee %s", emitAmount, outboundFeeAmount)
 	}
 
 	// the amount out will deduct the outbound fee
@@ -893,7 +951,7 @@ func queryQuoteSwap(ctx cosmos.Context, req abci.RequestQuery, mgr *Mgrs) ([]byt
 
 		// this is the shortest we can make it
 		if len(memoString) > fromAsset.GetChain().MaxMemoLength() {
-			return quoteErrorResponse(fmt.Errorf("generated memo too long for source chain: %s (%d/%d)", memoString, len(memoString), fromAsset.Chain.MaxMemoLength()))
+			return nil, fmt.Errorf("generated memo too long for source chain: %s (%d/%d)", memoString, len(memoString), fromAsset.Chain.MaxMemoLength())
 		}
 	}
 
@@ -909,7 +967,7 @@ func queryQuoteSwap(ctx cosmos.Context, req abci.RequestQuery, mgr *Mgrs) ([]byt
 	// estimate the inbound info
 	inboundAddress, routerAddress, inboundConfirmations, err := quoteInboundInfo(ctx, mgr, amount, fromAsset.GetChain(), fromAsset)
 	if err != nil {
-		return quoteErrorResponse(err)
+		return nil, err
 	}
 	res.InboundAddress = wrapString(inboundAddress.String())
 	if inboundConfirmations > 0 {
@@ -919,12 +977,12 @@ func queryQuoteSwap(ctx cosmos.Context, req abci.RequestQuery, mgr *Mgrs) ([]byt
 
 	res.OutboundDelayBlocks = 0
 	res.OutboundDelaySeconds = 0
-	if !toAsset.Chain.IsBASEChain() {
+	if !toAsset.GetChain().IsBASEChain() {
 		// estimate the outbound info
 		var outboundDelay int64
 		outboundDelay, err = quoteOutboundInfo(ctx, mgr, common.Coin{Asset: toAsset, Amount: emitAmount})
 		if err != nil {
-			return quoteErrorResponse(err)
+			return nil, err
 		}
 		res.OutboundDelayBlocks = outboundDelay
 		res.OutboundDelaySeconds = outboundDelay * common.BASEChain.ApproximateBlockMilliseconds() / 1000
@@ -957,7 +1015,7 @@ func queryQuoteSwap(ctx cosmos.Context, req abci.RequestQuery, mgr *Mgrs) ([]byt
 	res.Expiry = time.Now().Add(quoteExpiration).Unix()
 	minSwapAmount, err := calculateMinSwapAmount(ctx, mgr, fromAsset, toAsset, totalBps)
 	if err != nil {
-		return quoteErrorResponse(fmt.Errorf("failed to calculate min amount in: %s", err.Error()))
+		return nil, fmt.Errorf("failed to calculate min amount in: %s", err.Error())
 	}
 	res.RecommendedMinAmountIn = wrapString(minSwapAmount.String())
 
@@ -968,9 +1026,246 @@ func queryQuoteSwap(ctx cosmos.Context, req abci.RequestQuery, mgr *Mgrs) ([]byt
 		res.GasRateUnits = wrapString(fromAsset.Chain.GetGasUnits())
 	}
 
+	return res, nil
+}
+
+// -------------------------------------------------------------------------------------
+// Swap Routes
+// -------------------------------------------------------------------------------------
+
+// quoteSwapCandidate runs a swap quote against a throwaway cache of the state, so the
+// synths minted and trade balances deposited for one candidate do not leak into the next.
+func quoteSwapCandidate(ctx cosmos.Context, mgr *Mgrs, params url.Values) (*openapi.QuoteSwapResponse, error) {
+	cacheCtx, _ := ctx.CacheContext()
+	return quoteSwap(cacheCtx, mgr, params)
+}
+
+// quoteSwapRouteStreaming simulates the route without streaming and at up to
+// quoteRouteMaxStreamingCandidates streaming quantities, halving from the maximum, and
+// returns the quote with the fewest sub-swaps that is within
+// quoteRouteStreamingToleranceBps of the best expected output.
+func quoteSwapRouteStreaming(ctx cosmos.Context, mgr *Mgrs, params url.Values, interval, maxQuantity uint64) (*openapi.QuoteSwapResponse, uint64, error) {
+	type candidate struct {
+		quantity uint64
+		res      *openapi.QuoteSwapResponse
+		out      cosmos.Uint
+	}
+	var candidates []candidate
+	add := func(quantity uint64) error {
+		q := url.Values{}
+		for k, v := range params {
+			q[k] = v
+		}
+		if quantity > 0 {
+			q.Set(intervalParam, strconv.FormatUint(interval, 10))
+			q.Set(quantityParam, strconv.FormatUint(quantity, 10))
+		}
+		res, err := quoteSwapCandidate(ctx, mgr, q)
+		if err != nil {
+			return err
+		}
+		out, err := cosmos.ParseUint(res.ExpectedAmountOut)
+		if err != nil {
+			return fmt.Errorf("bad expected amount out: %w", err)
+		}
+		candidates = append(candidates, candidate{quantity: quantity, res: res, out: out})
+		return nil
+	}
+
+	if err := add(0); err != nil {
+		return nil, 0, err
+	}
+
+	// a failing streaming candidate (e.g. streaming paused) leaves the plain swap
+	for quantity := maxQuantity; quantity > 1 && len(candidates) <= quoteRouteMaxStreamingCandidates; quantity /= 2 {
+		if err := add(quantity); err != nil {
+			ctx.Logger().Debug("skipping streaming candidate", "quantity", quantity, "error", err)
+			break
+		}
+	}
+
+	best := cosmos.ZeroUint()
+	for _, c := range candidates {
+		if c.out.GT(best) {
+			best = c.out
+		}
+	}
+	threshold := best.MulUint64(10000 - quoteRouteStreamingToleranceBps).QuoUint64(10000)
+	chosen := candidates[0]
+	for _, c := range candidates {
+		if c.out.LT(threshold) {
+			continue
+		}
+		if chosen.out.LT(threshold) || c.quantity < chosen.quantity {
+			chosen = c
+		}
+	}
+	return chosen.res, chosen.quantity, nil
+}
+
+func queryQuoteSwapRoutes(ctx cosmos.Context, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	// extract parameters
+	params, err := quoteParseParams(req.Data)
+	if err != nil {
+		return quoteErrorResponse(err)
+	}
+
+	res, err := quoteSwapRoutes(ctx, mgr, params)
+	if err != nil {
+		return quoteErrorResponse(err)
+	}
 	return json.MarshalIndent(res, "", "  ")
 }
 
+func quoteSwapRoutes(ctx cosmos.Context, mgr *Mgrs, params url.Values) (*openapi.QuoteSwapRoutesResponse, error) {
+	// validate required parameters
+	for _, p := range []string{fromAssetParam, toAssetParam, amountParam} {
+		if len(params[p]) == 0 {
+			return nil, fmt.Errorf("missing required parameter %s", p)
+		}
+	}
+
+	// parse assets
+	fromAsset, err := common.NewAssetWithShortCodes(mgr.GetVersion(), params[fromAssetParam][0])
+	if err != nil {
+		return nil, fmt.Errorf("bad from asset: %w", err)
+	}
+	fromAsset = fuzzyAssetMatch(ctx, mgr.Keeper(), fromAsset)
+	toAsset, err := common.NewAssetWithShortCodes(mgr.GetVersion(), params[toAssetParam][0])
+	if err != nil {
+		return nil, fmt.Errorf("bad to asset: %w", err)
+	}
+	toAsset = fuzzyAssetMatch(ctx, mgr.Keeper(), toAsset)
+
+	// parse amount
+	amount, err := cosmos.ParseUint(params[amountParam][0])
+	if err != nil {
+		return nil, fmt.Errorf("bad amount: %w", err)
+	}
+
+	// parse streaming interval, routes stream every block unless told otherwise
+	streamingInterval := uint64(1)
+	if len(params[intervalParam]) > 0 {
+		streamingInterval, err = strconv.ParseUint(params[intervalParam][0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("bad streaming interval amount: %w", err)
+		}
+	}
+
+	// the layer1 asset can also be received as a trade asset or a synth
+	type swapRoute struct {
+		name  string
+		asset common.Asset
+	}
+	layer1Asset := toAsset.GetLayer1Asset()
+	routes := []swapRoute{{name: "layer1", asset: layer1Asset}}
+	if !layer1Asset.IsNative() {
+		routes = append(routes,
+			swapRoute{name: "trade", asset: layer1Asset.GetTradeAsset()},
+			swapRoute{name: "synth", asset: layer1Asset.GetSyntheticAsset()},
+		)
+	}
+
+	// pass through the parameters shared by every route
+	base := url.Values{}
+	for _, p := range []string{fromAssetParam, amountParam, refundAddressParam, toleranceBasisPointsParam, affiliateParam, affiliateBpsParam} {
+		if len(params[p]) > 0 {
+			base[p] = params[p]
+		}
+	}
+
+	res := &openapi.QuoteSwapRoutesResponse{Routes: []openapi.QuoteSwapRoute{}}
+	var firstErr error
+	for _, route := range routes {
+		if route.asset.Equals(fromAsset) {
+			continue
+		}
+
+		q := url.Values{}
+		for k, v := range base {
+			q[k] = v
+		}
+		q.Set(toAssetParam, route.asset.String())
+
+		// only generate a memo for the routes the destination can receive
+		if len(params[destinationParam]) > 0 {
+			chain := route.asset.GetChain()
+			destination, err := quoteParseAddress(ctx, mgr, params[destinationParam][0], chain)
+			if err == nil && destination.IsChain(chain, mgr.GetVersion()) {
+				q.Set(destinationParam, destination.String())
+			}
+		}
+
+		swp := StreamingSwap{
+			Interval: streamingInterval,
+			Deposit:  amount,
+		}
+		// quote the plain swap only if the route cannot stream
+		maxQuantity, err := getMaxSwapQuantity(ctx, mgr, fromAsset, route.asset, swp)
+		if err != nil {
+			ctx.Logger().Debug("route cannot stream", "route", route.name, "error", err)
+			maxQuantity = 0
+		}
+
+		swapRes, quantity, err := quoteSwapRouteStreaming(ctx, mgr, q, streamingInterval, maxQuantity)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("%s route: %w", route.name, err)
+			}
+			continue
+		}
+
+		interval := int64(0)
+		if quantity > 0 {
+			interval = int64(streamingInterval)
+		}
+		res.Routes = append(res.Routes, openapi.QuoteSwapRoute{
+			Route:                      route.name,
+			ToAsset:                    route.asset.String(),
+			Memo:                       swapRes.Memo,
+			ExpectedAmountOut:          swapRes.ExpectedAmountOut,
+			StreamingInterval:          interval,
+			StreamingQuantity:          int64(quantity),
+			MaxStreamingQuantity:       swapRes.MaxStreamingQuantity,
+			StreamingSwapBlocks:        swapRes.StreamingSwapBlocks,
+			StreamingSwapSeconds:       swapRes.StreamingSwapSeconds,
+			TotalSwapSeconds:           swapRes.TotalSwapSeconds,
+			InboundAddress:             swapRes.InboundAddress,
+			InboundConfirmationBlocks:  swapRes.InboundConfirmationBlocks,
+			InboundConfirmationSeconds: swapRes.InboundConfirmationSeconds,
+			OutboundDelayBlocks:        swapRes.OutboundDelayBlocks,
+			OutboundDelaySeconds:       swapRes.OutboundDelaySeconds,
+			Fees:                       swapRes.Fees,
+			Router:                     swapRes.Router,
+			Expiry:                     swapRes.Expiry,
+			Warning:                    swapRes.Warning,
+			Notes:                      swapRes.Notes,
+			DustThreshold:              swapRes.DustThreshold,
+			RecommendedMinAmountIn:     swapRes.RecommendedMinAmountIn,
+			RecommendedGasRate:         swapRes.RecommendedGasRate,
+			GasRateUnits:               swapRes.GasRateUnits,
+		})
+	}
+	if len(res.Routes) == 0 {
+		if firstErr == nil {
+			firstErr = fmt.Errorf("no route from %s to %s", fromAsset, toAsset)
+		}
+		return nil, firstErr
+	}
+
+	// rank by expected output, breaking ties with the faster route
+	sort.SliceStable(res.Routes, func(i, j int) bool {
+		a := cosmos.NewUintFromString(res.Routes[i].ExpectedAmountOut)
+		b := cosmos.NewUintFromString(res.Routes[j].ExpectedAmountOut)
+		if !a.Equal(b) {
+			return a.GT(b)
+		}
+		return res.Routes[i].GetTotalSwapSeconds() < res.Routes[j].GetTotalSwapSeconds()
+	})
+
+	return res, nil
+}
+
 // -------------------------------------------------------------------------------------
 // Saver Deposit
 // -------------------------------------------------------------------------------------
@@ -982,30 +1277,38 @@ func queryQuoteSaverDeposit(ctx cosmos.Context, path []string, req abci.RequestQ
 		return quoteErrorResponse(err)
 	}
 
+	res, err := quoteSaverDeposit(ctx, mgr, params)
+	if err != nil {
+		return quoteErrorResponse(err)
+	}
+	return json.MarshalIndent(res, "", "  ")
+}
+
+func quoteSaverDeposit(ctx cosmos.Context, mgr *Mgrs, params url.Values) (*openapi.QuoteSaverDepositResponse, error) {
 	// validate required parameters
 	for _, p := range []string{assetParam, amountParam} {
 		if len(params[p]) == 0 {
-			return quoteErrorResponse(fmt.Errorf("missing required parameter %s", p))
+			return nil, fmt.Errorf("missing required parameter %s", p)
 		}
 	}
 
 	// parse asset
 	asset, err := common.NewAssetWithShortCodes(mgr.GetVersion(), params[assetParam][0])
 	if err != nil {
-		return quoteErrorResponse(fmt.Errorf("bad asset: %w", err))
+		return nil, fmt.Errorf("bad asset: %w", err)
 	}
 	asset = fuzzyAssetMatch(ctx, mgr.Keeper(), asset)
 
 	// parse amount
 	amount, err := cosmos.ParseUint(params[amountParam][0])
 	if err != nil {
-		return quoteErrorResponse(fmt.Errorf("bad amount: %w", err))
+		return nil, fmt.Errorf("bad amount: %w", err)
 	}
 
 	// parse affiliate
 	affiliate, affiliateMemo, affiliateBps, depositAmount, _, _, err := quoteHandleAffiliate(ctx, mgr, params, amount)
 	if err != nil {
-		return quoteErrorResponse(err)
+		return nil, err
 	}
 
 	// generate deposit memo
@@ -1036,13 +1339,13 @@ func queryQuoteSaverDeposit(ctx cosmos.Context, path []string, req abci.RequestQ
 	swapReq := abci.RequestQuery{Data: []byte("/mayachain/quote/swap?" + q.Encode())}
 	swapResRaw, err := queryQuoteSwap(ctx, swapReq, mgr)
 	if err != nil {
-		return quoteErrorResponse(fmt.Errorf("unable to queryQuoteSwap: %w", err))
+		return nil, fmt.Errorf("unable to queryQuoteSwap: %w", err)
 	}
 
 	var swapRes *openapi.QuoteSwapResponse
 	err = json.Unmarshal(swapResRaw, &swapRes)
 	if err != nil {
-		return quoteErrorResponse(fmt.Errorf("unable to unmarshal swapRes: %w", err))
+		return nil, fmt.Errorf("unable to unmarshal swapRes: %w", err)
 	}
 
 	expectedAmountOut, _ := sdk.ParseUint(swapRes.ExpectedAmountOut)
@@ -1063,7 +1366,7 @@ func queryQuoteSaverDeposit(ctx cosmos.Context, path []string, req abci.RequestQ
 	// estimate the inbound info
 	inboundAddress, _, inboundConfirmations, err := quoteInboundInfo(ctx, mgr, amount, asset.GetLayer1Asset().Chain, asset)
 	if err != nil {
-		return quoteErrorResponse(err)
+		return nil, err
 	}
 	res.InboundAddress = inboundAddress.String()
 	res.InboundConfirmationBlocks = wrapInt64(inboundConfirmations)
@@ -1083,7 +1386,7 @@ func queryQuoteSaverDeposit(ctx cosmos.Context, path []string, req abci.RequestQ
 	res.RecommendedGasRate = inboundGas.String()
 	res.GasRateUnits = chain.GetGasUnits()
 
-	return json.MarshalIndent(res, "", "  ")
+	return res, nil
 }
 
 // -------------------------------------------------------------------------------------
@@ -1097,17 +1400,25 @@ func queryQuoteSaverWithdraw(ctx cosmos.Context, path []string, req abci.Request
 		return quoteErrorResponse(err)
 	}
 
+	res, err := quoteSaverWithdraw(ctx, mgr, params)
+	if err != nil {
+		return quoteErrorResponse(err)
+	}
+	return json.MarshalIndent(res, "", "  ")
+}
+
+func quoteSaverWithdraw(ctx cosmos.Context, mgr *Mgrs, params url.Values) (*openapi.QuoteSaverWithdrawResponse, error) {
 	// validate required parameters
 	for _, p := range []string{assetParam, addressParam, withdrawBasisPointsParam} {
 		if len(params[p]) == 0 {
-			return quoteErrorResponse(fmt.Errorf("missing required parameter %s", p))
+			return nil, fmt.Errorf("missing required parameter %s", p)
 		}
 	}
 
 	// parse asset
 	asset, err := common.NewAssetWithShortCodes(mgr.GetVersion(), params[assetParam][0])
 	if err != nil {
-		return quoteErrorResponse(fmt.Errorf("bad asset: %w", err))
+		return nil, fmt.Errorf("bad asset: %w", err)
 	}
 	asset = fuzzyAssetMatch(ctx, mgr.Keeper(), asset)
 	asset = asset.GetSyntheticAsset() // always use the vault asset
@@ -1115,30 +1426,30 @@ func queryQuoteSaverWithdraw(ctx cosmos.Context, path []string, req abci.Request
 	// parse address
 	address, err := common.NewAddress(params[addressParam][0], mgr.GetVersion())
 	if err != nil {
-		return quoteErrorResponse(fmt.Errorf("bad address: %w", err))
+		return nil, fmt.Errorf("bad address: %w", err)
 	}
 
 	// parse basis points
 	basisPoints, err := cosmos.ParseUint(params[withdrawBasisPointsParam][0])
 	if err != nil {
-		return quoteErrorResponse(fmt.Errorf("bad basis points: %w", err))
+		return nil, fmt.Errorf("bad basis points: %w", err)
 	}
 
 	// validate basis points
 	if basisPoints.GT(sdk.NewUint(10_000)) {
-		return quoteErrorResponse(fmt.Errorf("basis points must be less than 10000"))
+		return nil, fmt.Errorf("basis points must be less than 10000")
 	}
 
 	// get liquidity provider
 	lp, err := mgr.Keeper().GetLiquidityProvider(ctx, asset, address)
 	if err != nil {
-		return quoteErrorResponse(fmt.Errorf("failed to get liquidity provider: %w", err))
+		return nil, fmt.Errorf("failed to get liquidity provider: %w", err)
 	}
 
 	// get the pool
 	pool, err := mgr.Keeper().GetPool(ctx, asset)
 	if err != nil {
-		return quoteErrorResponse(fmt.Errorf("failed to get pool: %w", err))
+		return nil, fmt.Errorf("failed to get pool: %w", err)
 	}
 
 	// get the liquidity provider share of the pool
@@ -1156,13 +1467,13 @@ func queryQuoteSaverWithdraw(ctx cosmos.Context, path []string, req abci.Request
 	swapReq := abci.RequestQuery{Data: []byte("/mayachain/quote/swap?" + q.Encode())}
 	swapResRaw, err := queryQuoteSwap(ctx, swapReq, mgr)
 	if err != nil {
-		return quoteErrorResponse(fmt.Errorf("unable to queryQuoteSwap: %w", err))
+		return nil, fmt.Errorf("unable to queryQuoteSwap: %w", err)
 	}
 
 	var swapRes *openapi.QuoteSwapResponse
 	err = json.Unmarshal(swapResRaw, &swapRes)
 	if err != nil {
-		return quoteErrorResponse(fmt.Errorf("unable to unmarshal swapRes: %w", err))
+		return nil, fmt.Errorf("unable to unmarshal swapRes: %w", err)
 	}
 
 	// use the swap result info to generate the withdraw quote
@@ -1176,7 +1487,7 @@ func queryQuoteSaverWithdraw(ctx cosmos.Context, path []string, req abci.Request
 	// estimate the inbound info
 	inboundAddress, _, _, err := quoteInboundInfo(ctx, mgr, amount, asset.GetLayer1Asset().Chain, asset)
 	if err != nil {
-		return quoteErrorResponse(err)
+		return nil, err
 	}
 	res.InboundAddress = inboundAddress.String()
 
@@ -1185,7 +1496,7 @@ func queryQuoteSaverWithdraw(ctx cosmos.Context, path []string, req abci.Request
 	outboundCoin := common.Coin{Asset: asset.GetLayer1Asset(), Amount: expectedAmountOut}
 	outboundDelay, err := quoteOutboundInfo(ctx, mgr, outboundCoin)
 	if err != nil {
-		return quoteErrorResponse(err)
+		return nil, err
 	}
 	res.OutboundDelayBlocks = outboundDelay
 	res.OutboundDelaySeconds = outboundDelay * common.BASEChain.ApproximateBlockMilliseconds() / 1000
@@ -1204,5 +1515,755 @@ func queryQuoteSaverWithdraw(ctx cosmos.Context, path []string, req abci.Request
 	res.RecommendedGasRate = inboundGas.String()
 	res.GasRateUnits = chain.GetGasUnits()
 
+	return res, nil
+}
+
+// -------------------------------------------------------------------------------------
+// Liquidity Add
+// -------------------------------------------------------------------------------------
+
+func queryQuoteLiquidityAdd(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	// extract parameters
+	params, err := quoteParseParams(req.Data)
+	if err != nil {
+		return quoteErrorResponse(err)
+	}
+
+	res, err := quoteLiquidityAdd(ctx, mgr, params)
+	if err != nil {
+		return quoteErrorResponse(err)
+	}
+	return json.MarshalIndent(res, "", "  ")
+}
+
+func quoteLiquidityAdd(ctx cosmos.Context, mgr *Mgrs, params url.Values) (*openapi.QuoteLiquidityAddResponse, error) {
+	// validate required parameters
+	if len(params[assetParam]) == 0 {
+		return nil, fmt.Errorf("missing required parameter %s", assetParam)
+	}
+
+	// parse asset
+	asset, err := common.NewAssetWithShortCodes(mgr.GetVersion(), params[assetParam][0])
+	if err != nil {
+		return nil, fmt.Errorf("bad asset: %w", err)
+	}
+	asset = fuzzyAssetMatch(ctx, mgr.Keeper(), asset)
+	if asset.IsSyntheticAsset() {
+		return nil, fmt.Errorf("use the saver deposit quote for synthetic assets")
+	}
+	if asset.IsBase() {
+		return nil, fmt.Errorf("asset cannot be cacao")
+	}
+
+	// parse amounts
+	amountCacao, amountAsset := sdk.ZeroUint(), sdk.ZeroUint()
+	if len(params[amountCacaoParam]) > 0 {
+		amountCacao, err = cosmos.ParseUint(params[amountCacaoParam][0])
+		if err != nil {
+			return nil, fmt.Errorf("bad cacao amount: %w", err)
+		}
+	}
+	if len(params[amountAssetParam]) > 0 {
+		amountAsset, err = cosmos.ParseUint(params[amountAssetParam][0])
+		if err != nil {
+			return nil, fmt.Errorf("bad asset amount: %w", err)
+		}
+	}
+	if amountCacao.IsZero() && amountAsset.IsZero() {
+		return nil, fmt.Errorf("one of %s or %s must be provided", amountCacaoParam, amountAssetParam)
+	}
+
+	// parse addresses
+	cacaoAddress, assetAddress := common.NoAddress, common.NoAddress
+	if len(params[cacaoAddressParam]) > 0 {
+		cacaoAddress, err = quoteParseAddress(ctx, mgr, params[cacaoAddressParam][0], common.BASEChain)
+		if err != nil {
+			return nil, fmt.Errorf("bad cacao address: %w", err)
+		}
+		if !cacaoAddress.IsChain(common.BASEChain, mgr.GetVersion()) {
+			return nil, fmt.Errorf("cacao address must be a %s address", common.BASEChain)
+		}
+	}
+	if len(params[assetAddressParam]) > 0 {
+		assetAddress, err = quoteParseAddress(ctx, mgr, params[assetAddressParam][0], asset.GetChain())
+		if err != nil {
+			return nil, fmt.Errorf("bad asset address: %w", err)
+		}
+		if !assetAddress.IsChain(asset.GetChain(), mgr.GetVersion()) {
+			return nil, fmt.Errorf("asset address must be a %s address", asset.GetChain())
+		}
+	}
+
+	// a symmetric add is paired by the addresses in the memos
+	if !amountCacao.IsZero() && !amountAsset.IsZero() && (cacaoAddress.IsEmpty() || assetAddress.IsEmpty()) {
+		return nil, fmt.Errorf("%s and %s are required for a symmetric add", cacaoAddressParam, assetAddressParam)
+	}
+
+	// get the pool
+	pool, err := mgr.Keeper().GetPool(ctx, asset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pool: %w", err)
+	}
+	if pool.IsEmpty() {
+		return nil, fmt.Errorf("pool does not exist")
+	}
+	synthSupply := mgr.Keeper().GetTotalSupply(ctx, pool.Asset.GetSyntheticAsset())
+	pool.CalcUnits(mgr.GetVersion(), synthSupply)
+
+	// calculate the units the same way the add liquidity handler does
+	poolUnits, liquidityUnits, err := calculatePoolUnitsV1(pool.GetPoolUnits(), pool.BalanceCacao, pool.BalanceAsset, amountCacao, amountAsset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate pool units: %w", err)
+	}
+
+	// slip is the asymmetry of the add relative to the pool: |R a - r A| / ((r + R) (a + A))
+	slipBps := sdk.ZeroUint()
+	if !pool.BalanceCacao.IsZero() && !pool.BalanceAsset.IsZero() {
+		ra := pool.BalanceCacao.Mul(amountAsset)
+		rA := amountCacao.Mul(pool.BalanceAsset)
+		slipNumerator := common.SafeSub(ra, rA).Add(common.SafeSub(rA, ra))
+		slipDenominator := amountCacao.Add(pool.BalanceCacao).Mul(amountAsset.Add(pool.BalanceAsset))
+		slipBps = slipNumerator.MulUint64(constants.MaxBasisPts).Quo(slipDenominator)
+	}
+
+	res := &openapi.QuoteLiquidityAddResponse{
+		ExpectedPoolUnits: liquidityUnits.String(),
+		PoolUnits:         poolUnits.String(),
+		PoolShareBps:      common.GetSafeShare(liquidityUnits, poolUnits, sdk.NewUint(constants.MaxBasisPts)).BigInt().Int64(),
+		SlippageBps:       slipBps.BigInt().Int64(),
+	}
+
+	// generate the memo for each side of the add
+	if !amountAsset.IsZero() {
+		memo := fmt.Sprintf("+:%s", asset.String())
+		if !cacaoAddress.IsEmpty() {
+			memo = fmt.Sprintf("%s:%s", memo, cacaoAddress.String())
+		}
+		res.Memo = wrapString(memo)
+	}
+	if !amountCacao.IsZero() {
+		memo := fmt.Sprintf("+:%s", asset.String())
+		if !assetAddress.IsEmpty() {
+			memo = fmt.Sprintf("%s:%s", memo, assetAddress.String())
+		}
+		res.CacaoMemo = wrapString(memo)
+	}
+
+	// estimate the inbound info, the cacao side is deposited on mayachain
+	chain := common.BASEChain
+	inboundAmount := amountCacao
+	if !amountAsset.IsZero() {
+		chain = asset.GetChain()
+		inboundAmount = amountAsset
+	}
+	inboundAddress, routerAddress, inboundConfirmations, err := quoteInboundInfo(ctx, mgr, inboundAmount, chain, asset)
+	if err != nil {
+		return nil, err
+	}
+	if !inboundAddress.IsEmpty() {
+		res.InboundAddress = wrapString(inboundAddress.String())
+	}
+	if !routerAddress.IsEmpty() {
+		res.Router = wrapString(routerAddress.String())
+	}
+	if inboundConfirmations > 0 {
+		res.InboundConfirmationBlocks = wrapInt64(inboundConfirmations)
+		res.InboundConfirmationSeconds = wrapInt64(inboundConfirmations * chain.ApproximateBlockMilliseconds() / 1000)
+	}
+
+	// get the liquidity auction restrictions of an existing position
+	if !cacaoAddress.IsEmpty() {
+		var lp LiquidityProvider
+		lp, err = mgr.Keeper().GetLiquidityProvider(ctx, asset, cacaoAddress)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get liquidity provider: %w", err)
+		}
+		res.LiquidityAuction, err = quoteLiquidityAuction(ctx, mgr, lp)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// set info fields
+	if !chain.DustThreshold().IsZero() {
+		res.DustThreshold = wrapString(chain.DustThreshold().String())
+		res.RecommendedMinAmountIn = res.DustThreshold
+	}
+	res.Notes = chain.InboundNotes()
+	res.Warning = quoteWarning
+	res.Expiry = time.Now().Add(quoteExpiration).Unix()
+
+	// set inbound recommended gas
+	inboundGas := mgr.GasMgr().GetGasRate(ctx, chain)
+	res.RecommendedGasRate = inboundGas.String()
+	res.GasRateUnits = chain.GetGasUnits()
+
+	return res, nil
+}
+
+// -------------------------------------------------------------------------------------
+// Liquidity Withdraw
+// -------------------------------------------------------------------------------------
+
+func queryQuoteLiquidityWithdraw(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	// extract parameters
+	params, err := quoteParseParams(req.Data)
+	if err != nil {
+		return quoteErrorResponse(err)
+	}
+
+	res, err := quoteLiquidityWithdraw(ctx, mgr, params)
+	if err != nil {
+		return quoteErrorResponse(err)
+	}
 	return json.MarshalIndent(res, "", "  ")
 }
+
+func quoteLiquidityWithdraw(ctx cosmos.Context, mgr *Mgrs, params url.Values) (*openapi.QuoteLiquidityWithdrawResponse, error) {
+	// validate required parameters
+	for _, p := range []string{assetParam, addressParam, withdrawBasisPointsParam} {
+		if len(params[p]) == 0 {
+			return nil, fmt.Errorf("missing required parameter %s", p)
+		}
+	}
+
+	// parse asset
+	asset, err := common.NewAssetWithShortCodes(mgr.GetVersion(), params[assetParam][0])
+	if err != nil {
+		return nil, fmt.Errorf("bad asset: %w", err)
+	}
+	asset = fuzzyAssetMatch(ctx, mgr.Keeper(), asset)
+	if asset.IsSyntheticAsset() {
+		return nil, fmt.Errorf("use the saver withdraw quote for synthetic assets")
+	}
+
+	// parse address
+	address, err := common.NewAddress(params[addressParam][0], mgr.GetVersion())
+	if err != nil {
+		return nil, fmt.Errorf("bad address: %w", err)
+	}
+
+	// parse basis points
+	basisPoints, err := cosmos.ParseUint(params[withdrawBasisPointsParam][0])
+	if err != nil {
+		return nil, fmt.Errorf("bad basis points: %w", err)
+	}
+
+	// validate basis points
+	if basisPoints.IsZero() || basisPoints.GT(sdk.NewUint(constants.MaxBasisPts)) {
+		return nil, fmt.Errorf("basis points must be between 1 and 10000")
+	}
+
+	// parse withdrawal asset
+	withdrawalAsset := common.EmptyAsset
+	if len(params[withdrawalAssetParam]) > 0 {
+		withdrawalAsset, err = common.NewAssetWithShortCodes(mgr.GetVersion(), params[withdrawalAssetParam][0])
+		if err != nil {
+			return nil, fmt.Errorf("bad withdrawal asset: %w", err)
+		}
+		withdrawalAsset = fuzzyAssetMatch(ctx, mgr.Keeper(), withdrawalAsset)
+	}
+
+	// get liquidity provider
+	lp, err := mgr.Keeper().GetLiquidityProvider(ctx, asset, address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get liquidity provider: %w", err)
+	}
+
+	// generate the withdraw memo
+	memo := fmt.Sprintf("-:%s:%s", asset.String(), basisPoints.String())
+	if !withdrawalAsset.IsEmpty() {
+		memo = fmt.Sprintf("%s:%s", memo, withdrawalAsset.String())
+	}
+
+	// the withdraw is sent from the chain of the position address
+	chain := common.BASEChain
+	if !address.IsChain(common.BASEChain, mgr.GetVersion()) {
+		chain = asset.GetChain()
+	}
+
+	// use the first active node account as the signer
+	nodeAccounts, err := mgr.Keeper().ListActiveValidators(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("no active node accounts: %w", err)
+	}
+	if len(nodeAccounts) == 0 {
+		return nil, fmt.Errorf("no active node accounts")
+	}
+
+	// simulate the withdraw
+	tx := common.Tx{
+		ID:          quoteTxID,
+		Chain:       chain,
+		FromAddress: address,
+		Coins:       common.Coins{},
+		Memo:        memo,
+	}
+	msg := NewMsgWithdrawLiquidity(tx, address, basisPoints, asset, withdrawalAsset, nodeAccounts[0].NodeAddress)
+	events, err := simulateInternal(ctx, mgr, msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to simulate withdraw: %w", err)
+	}
+
+	// extract the withdrawn amounts and outbound fees from the events
+	units, emitCacao, emitAsset := sdk.ZeroUint(), sdk.ZeroUint(), sdk.ZeroUint()
+	feeCacao, feeAsset := sdk.ZeroUint(), sdk.ZeroUint()
+	impLossProtection := sdk.ZeroUint()
+	for _, e := range events {
+		em := eventMap(e)
+		switch e.Type {
+		case types.WithdrawEventType:
+			units = sdk.NewUintFromString(em["liquidity_provider_units"])
+			emitCacao = sdk.NewUintFromString(em["emit_cacao"])
+			emitAsset = sdk.NewUintFromString(em["emit_asset"])
+			impLossProtection = sdk.NewUintFromString(em["imp_loss_protection"])
+		case types.PendingLiquidity:
+			emitCacao = sdk.NewUintFromString(em["cacao_amount"])
+			emitAsset = sdk.NewUintFromString(em["asset_amount"])
+		case types.FeeEventType:
+			for _, coinStr := range strings.Split(em["coins"], ", ") {
+				var coin common.Coin
+				coin, err = common.ParseCoin(coinStr)
+				if err != nil {
+					return nil, fmt.Errorf("unable to parse outbound fee coin: %w", err)
+				}
+				if coin.Asset.IsBase() {
+					feeCacao = feeCacao.Add(coin.Amount)
+				} else {
+					feeAsset = feeAsset.Add(coin.Amount)
+				}
+			}
+		}
+	}
+
+	res := &openapi.QuoteLiquidityWithdrawResponse{
+		Memo:                      memo,
+		DustAmount:                chain.DustThreshold().String(),
+		WithdrawUnits:             units.String(),
+		ExpectedAmountOutCacao:    common.SafeSub(emitCacao, feeCacao).String(),
+		ExpectedAmountOutAsset:    common.SafeSub(emitAsset, feeAsset).String(),
+		OutboundFeeCacao:          feeCacao.String(),
+		OutboundFeeAsset:          feeAsset.String(),
+		ImpermanentLossProtection: wrapString(impLossProtection.String()),
+	}
+
+	// estimate the inbound info
+	inboundAddress, routerAddress, _, err := quoteInboundInfo(ctx, mgr, chain.DustThreshold(), chain, asset)
+	if err != nil {
+		return nil, err
+	}
+	if !inboundAddress.IsEmpty() {
+		res.InboundAddress = wrapString(inboundAddress.String())
+	}
+	if !routerAddress.IsEmpty() {
+		res.Router = wrapString(routerAddress.String())
+	}
+
+	// estimate the outbound info, the asset outbound is the slowest
+	outboundCoin := common.NewCoin(common.BaseNative, emitCacao)
+	if !emitAsset.IsZero() {
+		outboundCoin = common.NewCoin(asset, emitAsset)
+	}
+	outboundDelay, err := quoteOutboundInfo(ctx, mgr, outboundCoin)
+	if err != nil {
+		return nil, err
+	}
+	res.OutboundDelayBlocks = outboundDelay
+	res.OutboundDelaySeconds = outboundDelay * common.BASEChain.ApproximateBlockMilliseconds() / 1000
+
+	// get the liquidity auction restrictions of the position
+	res.LiquidityAuction, err = quoteLiquidityAuction(ctx, mgr, lp)
+	if err != nil {
+		return nil, err
+	}
+
+	// set info fields
+	if !chain.DustThreshold().IsZero() {
+		res.DustThreshold = wrapString(chain.DustThreshold().String())
+	}
+	res.Notes = chain.InboundNotes()
+	res.Warning = quoteWarning
+	res.Expiry = time.Now().Add(quoteExpiration).Unix()
+
+	// set inbound recommended gas
+	inboundGas := mgr.GasMgr().GetGasRate(ctx, chain)
+	res.RecommendedGasRate = inboundGas.String()
+	res.GasRateUnits = chain.GetGasUnits()
+
+	return res, nil
+}
+
+// -------------------------------------------------------------------------------------
+// Trade Account Deposit
+// -------------------------------------------------------------------------------------
+
+func queryQuoteTradeDeposit(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	// extract parameters
+	params, err := quoteParseParams(req.Data)
+	if err != nil {
+		return quoteErrorResponse(err)
+	}
+
+	res, err := quoteTradeDeposit(ctx, mgr, params)
+	if err != nil {
+		return quoteErrorResponse(err)
+	}
+	return json.MarshalIndent(res, "", "  ")
+}
+
+func quoteTradeDeposit(ctx cosmos.Context, mgr *Mgrs, params url.Values) (*openapi.QuoteTradeDepositResponse, error) {
+	// validate required parameters
+	for _, p := range []string{assetParam, amountParam, addressParam} {
+		if len(params[p]) == 0 {
+			return nil, fmt.Errorf("missing required parameter %s", p)
+		}
+	}
+
+	// ensure trade account deposits are enabled
+	if mgr.Keeper().GetConfigInt64(ctx, constants.TradeAccountsEnabled) <= 0 {
+		return nil, fmt.Errorf("trade accounts are disabled")
+	}
+	if mgr.Keeper().GetConfigInt64(ctx, constants.TradeAccountsDepositEnabled) <= 0 {
+		return nil, fmt.Errorf("trade accounts deposits are disabled")
+	}
+
+	// parse asset
+	asset, err := common.NewAssetWithShortCodes(mgr.GetVersion(), params[assetParam][0])
+	if err != nil {
+		return nil, fmt.Errorf("bad asset: %w", err)
+	}
+	asset = fuzzyAssetMatch(ctx, mgr.Keeper(), asset)
+	if asset.IsSyntheticAsset() || asset.IsTradeAsset() || asset.GetChain().IsBASEChain() {
+		return nil, fmt.Errorf("asset must be a layer 1 asset")
+	}
+
+	// parse amount
+	amount, err := cosmos.ParseUint(params[amountParam][0])
+	if err != nil {
+		return nil, fmt.Errorf("bad amount: %w", err)
+	}
+	if amount.IsZero() {
+		return nil, fmt.Errorf("amount cannot be zero")
+	}
+
+	// parse address
+	address, err := quoteParseAddress(ctx, mgr, params[addressParam][0], common.BASEChain)
+	if err != nil {
+		return nil, fmt.Errorf("bad address: %w", err)
+	}
+	if !address.IsChain(common.BASEChain, mgr.GetVersion()) {
+		return nil, fmt.Errorf("address must be a %s address", common.BASEChain)
+	}
+
+	// calculate the units the same way the trade account manager does
+	tu, err := mgr.Keeper().GetTradeUnit(ctx, asset.GetTradeAsset())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trade unit: %w", err)
+	}
+	units := amount
+	if !tu.Units.IsZero() && !tu.Depth.IsZero() {
+		units = common.GetUncappedShare(amount, tu.Depth, tu.Units)
+	}
+
+	res := &openapi.QuoteTradeDepositResponse{
+		Memo:              fmt.Sprintf("%s:%s", mem.TxTradeAccountDeposit.String(), address.String()),
+		ExpectedAmountOut: amount.String(),
+		ExpectedUnits:     units.String(),
+	}
+
+	// estimate the inbound info
+	chain := asset.GetChain()
+	inboundAddress, routerAddress, inboundConfirmations, err := quoteInboundInfo(ctx, mgr, amount, chain, asset)
+	if err != nil {
+		return nil, err
+	}
+	res.InboundAddress = inboundAddress.String()
+	if !routerAddress.IsEmpty() {
+		res.Router = wrapString(routerAddress.String())
+	}
+	if inboundConfirmations > 0 {
+		res.InboundConfirmationBlocks = wrapInt64(inboundConfirmations)
+		res.InboundConfirmationSeconds = wrapInt64(inboundConfirmations * chain.ApproximateBlockMilliseconds() / 1000)
+	}
+
+	// set info fields
+	if !chain.DustThreshold().IsZero() {
+		res.DustThreshold = wrapString(chain.DustThreshold().String())
+		res.RecommendedMinAmountIn = res.DustThreshold
+	}
+	res.Notes = chain.InboundNotes()
+	res.Warning = quoteWarning
+	res.Expiry = time.Now().Add(quoteExpiration).Unix()
+
+	// set inbound recommended gas
+	inboundGas := mgr.GasMgr().GetGasRate(ctx, chain)
+	res.RecommendedGasRate = inboundGas.String()
+	res.GasRateUnits = chain.GetGasUnits()
+
+	return res, nil
+}
+
+// -------------------------------------------------------------------------------------
+// Trade Account Withdraw
+// -------------------------------------------------------------------------------------
+
+func queryQuoteTradeWithdraw(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	// extract parameters
+	params, err := quoteParseParams(req.Data)
+	if err != nil {
+		return quoteErrorResponse(err)
+	}
+
+	res, err := quoteTradeWithdraw(ctx, mgr, params)
+	if err != nil {
+		return quoteErrorResponse(err)
+	}
+	return json.MarshalIndent(res, "", "  ")
+}
+
+func quoteTradeWithdraw(ctx cosmos.Context, mgr *Mgrs, params url.Values) (*openapi.QuoteTradeWithdrawResponse, error) {
+	// validate required parameters
+	for _, p := range []string{assetParam, amountParam, addressParam} {
+		if len(params[p]) == 0 {
+			return nil, fmt.Errorf("missing required parameter %s", p)
+		}
+	}
+
+	// ensure trade account withdrawals are enabled
+	if mgr.Keeper().GetConfigInt64(ctx, constants.TradeAccountsEnabled) <= 0 {
+		return nil, fmt.Errorf("trade accounts are disabled")
+	}
+	if mgr.Keeper().GetConfigInt64(ctx, constants.TradeAccountsWithdrawEnabled) <= 0 {
+		return nil, fmt.Errorf("trade accounts withdrawals are disabled")
+	}
+
+	// parse asset, the layer 1 asset is accepted for the trade asset
+	asset, err := common.NewAssetWithShortCodes(mgr.GetVersion(), params[assetParam][0])
+	if err != nil {
+		return nil, fmt.Errorf("bad asset: %w", err)
+	}
+	if asset.IsSyntheticAsset() || asset.GetLayer1Asset().GetChain().IsBASEChain() {
+		return nil, fmt.Errorf("asset must be a trade asset")
+	}
+	layer1Asset := fuzzyAssetMatch(ctx, mgr.Keeper(), asset.GetLayer1Asset())
+	asset = layer1Asset.GetTradeAsset()
+
+	// parse amount
+	amount, err := cosmos.ParseUint(params[amountParam][0])
+	if err != nil {
+		return nil, fmt.Errorf("bad amount: %w", err)
+	}
+	if amount.IsZero() {
+		return nil, fmt.Errorf("amount cannot be zero")
+	}
+
+	// parse address
+	chain := layer1Asset.GetChain()
+	address, err := quoteParseAddress(ctx, mgr, params[addressParam][0], chain)
+	if err != nil {
+		return nil, fmt.Errorf("bad address: %w", err)
+	}
+	if !address.IsChain(chain, mgr.GetVersion()) {
+		return nil, fmt.Errorf("address must be a %s address", chain)
+	}
+
+	// the outbound fee is deducted from the withdrawn amount
+	outboundFee := mgr.GasMgr().GetFee(ctx, chain, layer1Asset)
+	if amount.LTE(outboundFee) {
+		return nil, fmt.Errorf("amount less than outbound fee %s", outboundFee.String())
+	}
+	expectedAmountOut := common.SafeSub(amount, outboundFee)
+
+	res := &openapi.QuoteTradeWithdrawResponse{
+		Memo:              fmt.Sprintf("%s:%s", mem.TxTradeAccountWithdrawal.String(), address.String()),
+		ExpectedAmountOut: expectedAmountOut.String(),
+		Fees: openapi.QuoteFees{
+			Asset:       layer1Asset.String(),
+			Liquidity:   "0",
+			Outbound:    wrapString(outboundFee.String()),
+			Total:       outboundFee.String(),
+			SlippageBps: 0,
+			TotalBps:    outboundFee.MulUint64(constants.MaxBasisPts).Quo(amount).BigInt().Int64(),
+		},
+	}
+
+	// estimate the outbound info
+	outboundDelay, err := quoteOutboundInfo(ctx, mgr, common.NewCoin(layer1Asset, expectedAmountOut))
+	if err != nil {
+		return nil, err
+	}
+	res.OutboundDelayBlocks = outboundDelay
+	res.OutboundDelaySeconds = outboundDelay * common.BASEChain.ApproximateBlockMilliseconds() / 1000
+
+	// set info fields, the withdraw is a deposit of the trade asset on mayachain
+	res.Notes = common.BASEChain.InboundNotes()
+	res.Warning = quoteWarning
+	res.Expiry = time.Now().Add(quoteExpiration).Unix()
+
+	return res, nil
+}
+
+// -------------------------------------------------------------------------------------
+// CACAO Pool Deposit
+// -------------------------------------------------------------------------------------
+
+func queryQuoteCacaoPoolDeposit(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	// extract parameters
+	params, err := quoteParseParams(req.Data)
+	if err != nil {
+		return quoteErrorResponse(err)
+	}
+
+	res, err := quoteCacaoPoolDeposit(ctx, mgr, params)
+	if err != nil {
+		return quoteErrorResponse(err)
+	}
+	return json.MarshalIndent(res, "", "  ")
+}
+
+func quoteCacaoPoolDeposit(ctx cosmos.Context, mgr *Mgrs, params url.Values) (*openapi.QuoteCacaoPoolDepositResponse, error) {
+	// validate required parameters
+	if len(params[amountParam]) == 0 {
+		return nil, fmt.Errorf("missing required parameter %s", amountParam)
+	}
+
+	// ensure the cacao pool is enabled
+	if mgr.Keeper().GetConfigInt64(ctx, constants.CACAOPoolEnabled) <= 0 {
+		return nil, fmt.Errorf("CACAOPool disabled")
+	}
+
+	// parse amount
+	amount, err := cosmos.ParseUint(params[amountParam][0])
+	if err != nil {
+		return nil, fmt.Errorf("bad amount: %w", err)
+	}
+	if amount.IsZero() {
+		return nil, fmt.Errorf("amount cannot be zero")
+	}
+
+	// calculate the units the same way the deposit handler does
+	cacaoPool, err := mgr.Keeper().GetCACAOPool(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cacao pool: %w", err)
+	}
+	poolValue, err := cacaoPoolValue(ctx, mgr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cacao pool value: %w", err)
+	}
+	units := amount
+	if !cacaoPool.TotalUnits().IsZero() {
+		units = common.GetSafeShare(amount, poolValue, cacaoPool.TotalUnits())
+	}
+
+	maturity := mgr.Keeper().GetConfigInt64(ctx, constants.CACAOPoolDepositMaturityBlocks)
+	res := &openapi.QuoteCacaoPoolDepositResponse{
+		Memo:            mem.TxCacaoPoolDeposit.String(),
+		ExpectedUnits:   units.String(),
+		MaturityBlocks:  maturity,
+		MaturitySeconds: maturity * common.BASEChain.ApproximateBlockMilliseconds() / 1000,
+	}
+
+	// set info fields, the deposit is a deposit of cacao on mayachain
+	res.Notes = common.BASEChain.InboundNotes()
+	res.Warning = quoteWarning
+	res.Expiry = time.Now().Add(quoteExpiration).Unix()
+
+	return res, nil
+}
+
+// -------------------------------------------------------------------------------------
+// CACAO Pool Withdraw
+// -------------------------------------------------------------------------------------
+
+func queryQuoteCacaoPoolWithdraw(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	// extract parameters
+	params, err := quoteParseParams(req.Data)
+	if err != nil {
+		return quoteErrorResponse(err)
+	}
+
+	res, err := quoteCacaoPoolWithdraw(ctx, mgr, params)
+	if err != nil {
+		return quoteErrorResponse(err)
+	}
+	return json.MarshalIndent(res, "", "  ")
+}
+
+func quoteCacaoPoolWithdraw(ctx cosmos.Context, mgr *Mgrs, params url.Values) (*openapi.QuoteCacaoPoolWithdrawResponse, error) {
+	// validate required parameters
+	for _, p := range []string{addressParam, withdrawBasisPointsParam} {
+		if len(params[p]) == 0 {
+			return nil, fmt.Errorf("missing required parameter %s", p)
+		}
+	}
+
+	// ensure the cacao pool is enabled
+	if mgr.Keeper().GetConfigInt64(ctx, constants.CACAOPoolEnabled) <= 0 {
+		return nil, fmt.Errorf("CACAOPool disabled")
+	}
+
+	// parse address
+	address, err := quoteParseAddress(ctx, mgr, params[addressParam][0], common.BASEChain)
+	if err != nil {
+		return nil, fmt.Errorf("bad address: %w", err)
+	}
+	accAddress, err := address.AccAddress()
+	if err != nil {
+		return nil, fmt.Errorf("address must be a %s address: %w", common.BASEChain, err)
+	}
+
+	// parse basis points
+	basisPoints, err := cosmos.ParseUint(params[withdrawBasisPointsParam][0])
+	if err != nil {
+		return nil, fmt.Errorf("bad basis points: %w", err)
+	}
+	if basisPoints.IsZero() || basisPoints.GT(sdk.NewUint(constants.MaxBasisPts)) {
+		return nil, fmt.Errorf("basis points must be between 1 and 10000")
+	}
+
+	// get the provider
+	cacaoProvider, err := mgr.Keeper().GetCACAOProvider(ctx, accAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cacao provider: %w", err)
+	}
+	if cacaoProvider.Units.IsZero() {
+		return nil, fmt.Errorf("address has no cacao pool position")
+	}
+
+	// calculate the withdraw the same way the withdraw handler does
+	cacaoPool, err := mgr.Keeper().GetCACAOPool(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cacao pool: %w", err)
+	}
+	poolValue, err := cacaoPoolValue(ctx, mgr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cacao pool value: %w", err)
+	}
+	withdrawUnits := common.GetSafeShare(basisPoints, sdk.NewUint(constants.MaxBasisPts), cacaoProvider.Units)
+	withdrawAmount := common.GetSafeShare(withdrawUnits, cacaoPool.TotalUnits(), poolValue)
+	if withdrawAmount.GT(mgr.Keeper().GetRuneBalanceOfModule(ctx, CACAOPoolName)) {
+		return nil, fmt.Errorf("not enough CACAO in CACAOPool module")
+	}
+
+	// the withdraw is rejected until the last deposit matures
+	maturity := mgr.Keeper().GetConfigInt64(ctx, constants.CACAOPoolDepositMaturityBlocks)
+	maturityBlocks := maturity - (ctx.BlockHeight() - cacaoProvider.LastDepositHeight)
+	if maturityBlocks < 0 {
+		maturityBlocks = 0
+	}
+
+	res := &openapi.QuoteCacaoPoolWithdrawResponse{
+		Memo:              fmt.Sprintf("%s:%s", mem.TxCacaoPoolWithdraw.String(), basisPoints.String()),
+		ExpectedAmountOut: withdrawAmount.String(),
+		WithdrawUnits:     withdrawUnits.String(),
+		MaturityBlocks:    maturityBlocks,
+		MaturitySeconds:   maturityBlocks * common.BASEChain.ApproximateBlockMilliseconds() / 1000,
+	}
+
+	// set info fields, the withdraw is a deposit on mayachain
+	res.Notes = common.BASEChain.InboundNotes()
+	res.Warning = quoteWarning
+	res.Expiry = time.Now().Add(quoteExpiration).Unix()
+
+	return res, nil
+}
@@ -1,13 +1,16 @@
 package mayachain
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
 
+	"github.com/armon/go-metrics"
 	"github.com/blang/semver"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	. "gopkg.in/check.v1"
@@ -638,6 +641,121 @@ func (s *QuerierSuite) TestQueryQueue(c *C) {
 	c.Assert(json.Unmarshal(result, &q), IsNil)
 }
 
+func (s *QuerierSuite) TestQueryQueuePages(c *C) {
+	vault := GetRandomPubKey()
+	destination := GetRandomBNBAddress()
+	for i := 0; i < 5; i++ {
+		item := GetRandomTxOutItem()
+		if i == 0 {
+			item.ToAddress = destination
+		}
+		if i < 3 {
+			item.VaultPubKey = vault
+		}
+		c.Assert(s.k.AppendTxOut(s.ctx, s.ctx.BlockHeight(), item), IsNil)
+	}
+	item := GetRandomTxOutItem()
+	item.Chain = common.BTCChain
+	item.Coin = common.NewCoin(common.BTCAsset, cosmos.NewUint(common.One))
+	c.Assert(s.k.AppendTxOut(s.ctx, s.ctx.BlockHeight(), item), IsNil)
+	c.Assert(s.k.AppendTxOut(s.ctx, s.ctx.BlockHeight()+10, item), IsNil)
+
+	outboundPage := func(params url.Values) openapi.OutboundQueuePageResponse {
+		req := abci.RequestQuery{Data: []byte("/mayachain/queue/outbound/page?" + params.Encode())}
+		result, err := s.querier(s.ctx, []string{query.QueryPendingOutboundPage.Key}, req)
+		c.Assert(err, IsNil)
+		var page openapi.OutboundQueuePageResponse
+		c.Assert(json.Unmarshal(result, &page), IsNil)
+		return page
+	}
+
+	page := outboundPage(url.Values{"limit": {"2"}})
+	c.Check(page.Total, Equals, int64(6))
+	c.Check(page.Outbounds, HasLen, 2)
+	c.Check(page.ChainTotals["BNB"], Equals, int64(5))
+	c.Check(page.ChainTotals["BTC"], Equals, int64(1))
+
+	page = outboundPage(url.Values{"limit": {"2"}, "offset": {"5"}})
+	c.Check(page.Total, Equals, int64(6))
+	c.Check(page.Outbounds, HasLen, 1)
+
+	page = outboundPage(url.Values{"offset": {"10"}})
+	c.Check(page.Outbounds, HasLen, 0)
+
+	page = outboundPage(url.Values{"chain": {"BTC"}})
+	c.Check(page.Total, Equals, int64(1))
+	c.Check(page.Outbounds[0].Coin.Asset, Equals, "BTC.BTC")
+
+	page = outboundPage(url.Values{"asset": {"BNB.BNB"}, "vault": {vault.String()}})
+	c.Check(page.Total, Equals, int64(3))
+
+	page = outboundPage(url.Values{"destination": {destination.String()}})
+	c.Check(page.Total, Equals, int64(1))
+
+	page = outboundPage(url.Values{"from_height": {strconv.FormatInt(s.ctx.BlockHeight()+1, 10)}})
+	c.Check(page.Total, Equals, int64(0))
+
+	for _, bad := range []url.Values{
+		{"limit": {"0"}},
+		{"limit": {"1001"}},
+		{"offset": {"-1"}},
+		{"chain": {"B@D"}},
+		{"from_height": {"10"}, "to_height": {"5"}},
+	} {
+		req := abci.RequestQuery{Data: []byte("/mayachain/queue/outbound/page?" + bad.Encode())}
+		_, err := s.querier(s.ctx, []string{query.QueryPendingOutboundPage.Key}, req)
+		c.Check(err, NotNil, Commentf("%v", bad))
+	}
+
+	// scheduled outbounds are filtered by their scheduled height
+	req := abci.RequestQuery{Data: []byte("/mayachain/queue/scheduled/page?to_height=" + strconv.FormatInt(s.ctx.BlockHeight()+10, 10))}
+	result, err := s.querier(s.ctx, []string{query.QueryScheduledOutboundPage.Key}, req)
+	c.Assert(err, IsNil)
+	var scheduled openapi.OutboundQueuePageResponse
+	c.Assert(json.Unmarshal(result, &scheduled), IsNil)
+	c.Check(scheduled.Total, Equals, int64(1))
+	c.Check(*scheduled.Outbounds[0].Height, Equals, s.ctx.BlockHeight()+10)
+
+	// swaps are counted against the chain of the target asset
+	for i, target := range []common.Asset{common.BTCAsset, common.ETHAsset, common.ETHAsset} {
+		tx := GetRandomTx()
+		tx.Coins = common.NewCoins(common.NewCoin(common.BNBAsset, cosmos.NewUint(common.One)))
+		msg := NewMsgSwap(tx, target, GetRandomETHAddress(), cosmos.ZeroUint(), common.NoAddress, cosmos.ZeroUint(), "", "", nil, MarketOrder, 0, 0, GetRandomBech32Addr())
+		c.Assert(s.k.SetSwapQueueItem(s.ctx, *msg, i), IsNil)
+	}
+	req = abci.RequestQuery{Data: []byte("/mayachain/queue/swap/page?limit=1&asset=ETH.ETH")}
+	result, err = s.querier(s.ctx, []string{query.QuerySwapQueuePage.Key}, req)
+	c.Assert(err, IsNil)
+	var swaps openapi.SwapQueuePageResponse
+	c.Assert(json.Unmarshal(result, &swaps), IsNil)
+	c.Check(swaps.Total, Equals, int64(2))
+	c.Check(swaps.Swaps, HasLen, 1)
+	c.Check(swaps.ChainTotals["ETH"], Equals, int64(2))
+	c.Check(swaps.ChainTotals["BTC"], Equals, int64(0))
+}
+
+func (s *QuerierSuite) TestQueryScheduledOutboundLanes(c *C) {
+	refund := GetRandomTxOutItem()
+	refund.Memo = NewRefundMemo(refund.InHash).String()
+	migrate := GetRandomTxOutItem()
+	migrate.InHash = common.BlankTxID
+	migrate.Memo = NewMigrateMemo(s.ctx.BlockHeight()).String()
+	outbound := GetRandomTxOutItem()
+	outbound.Memo = NewOutboundMemo(outbound.InHash).String()
+	for _, item := range []TxOutItem{refund, migrate, outbound} {
+		c.Assert(s.k.AppendTxOut(s.ctx, s.ctx.BlockHeight()+1, item), IsNil)
+	}
+
+	result, err := s.querier(s.ctx, []string{query.QueryScheduledOutbound.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var scheduled []openapi.TxOutItem
+	c.Assert(json.Unmarshal(result, &scheduled), IsNil)
+	c.Assert(scheduled, HasLen, 3)
+	c.Check(scheduled[0].GetLane(), Equals, TxOutLaneRefund)
+	c.Check(scheduled[1].GetLane(), Equals, TxOutLaneMigrate)
+	c.Check(scheduled[2].GetLane(), Equals, TxOutLaneSwap)
+}
+
 func (s *QuerierSuite) TestQueryHeights(c *C) {
 	result, err := s.querier(s.ctx, []string{
 		query.QueryHeights.Key,
@@ -734,6 +852,8 @@ func (s *QuerierSuite) TestQueryNodeAccount(c *C) {
 	c.Assert(err, IsNil)
 	bp.Providers = append(bp.Providers, NewBondProvider(acc))
 	bp.Providers[0].Bonded = true
+	bp.PendingNodeOperatorFee = 3000
+	bp.PendingNodeOperatorFeeHeight = 500
 	SetupLiquidityBondForTest(c, s.ctx, s.k, common.BNBAsset, na.BondAddress, na, cosmos.NewUint(1000*common.One))
 	c.Assert(s.k.SetBondProviders(s.ctx, bp), IsNil)
 	c.Assert(s.k.SetNodeAccount(s.ctx, na), IsNil)
@@ -749,6 +869,8 @@ func (s *QuerierSuite) TestQueryNodeAccount(c *C) {
 	c.Assert(err, IsNil)
 	var r openapi.Node
 	c.Assert(json.Unmarshal(result, &r), IsNil)
+	c.Check(r.BondProviders.GetPendingNodeOperatorFee(), Equals, "3000")
+	c.Check(r.BondProviders.GetPendingNodeOperatorFeeHeight(), Equals, int64(500))
 
 	/* Check bond-weighted rewards estimation works */
 	// Add another node with 75% of the bond
@@ -791,6 +913,7 @@ func (s *QuerierSuite) TestQueryNodeAccount(c *C) {
 	c.Assert(err, IsNil)
 	var r3 openapi.Node
 	c.Assert(json.Unmarshal(result, &r3), IsNil)
+	c.Check(r3.BondProviders.PendingNodeOperatorFee, IsNil)
 
 	// Second node has 75% of bond, but should have 50% of rewards too
 	c.Assert(r3.Bond, Equals, cosmos.NewUint(common.One*6000).String(), Commentf("expected %s, got %s", cosmos.NewUint(6000*common.One).String(), r3.Bond))
@@ -952,6 +1075,105 @@ func (s *QuerierSuite) TestQueryVault(c *C) {
 	c.Assert(vault.BlockHeight, Equals, returnVault.BlockHeight)
 }
 
+func (s *QuerierSuite) TestQueryVaultMigration(c *C) {
+	na := GetRandomValidatorNode(NodeActive)
+	c.Assert(s.k.SetNodeAccount(s.ctx, na), IsNil)
+	s.k.SetMimir(s.ctx, constants.FundMigrationInterval.String(), 10)
+	rounds := s.mgr.GetConstants().GetInt64Value(constants.ChurnMigrateRounds)
+
+	vault := GetRandomVault()
+	vault.Status = RetiringVault
+	vault.StatusSince = 100
+	vault.Membership = []string{na.PubKeySet.Secp256k1.String()}
+	vault.Chains = []string{common.BNBChain.String(), common.BTCChain.String()}
+	vault.Coins = common.NewCoins(common.NewCoin(common.BNBAsset, cosmos.NewUint(100*common.One)))
+	c.Assert(s.k.SetVault(s.ctx, vault), IsNil)
+	migration := NewVaultMigration(vault.PubKey)
+	migration.AddMigrated(common.NewCoins(common.NewCoin(common.BTCAsset, cosmos.NewUint(common.One))))
+	s.k.SetVaultMigration(s.ctx, migration)
+
+	ctx := s.ctx.WithBlockHeight(125)
+	txOut := NewTxOut(ctx.BlockHeight())
+	txOut.TxArray = append(txOut.TxArray, TxOutItem{
+		Chain:       common.BNBChain,
+		ToAddress:   GetRandomBNBAddress(),
+		VaultPubKey: vault.PubKey,
+		Coin:        common.NewCoin(common.BNBAsset, cosmos.NewUint(60*common.One)),
+		Memo:        NewMigrateMemo(120).String(),
+		InHash:      common.BlankTxID,
+	}, TxOutItem{
+		Chain:       common.BNBChain,
+		ToAddress:   GetRandomBNBAddress(),
+		VaultPubKey: vault.PubKey,
+		Coin:        common.NewCoin(common.BNBAsset, cosmos.NewUint(common.One)),
+		Memo:        NewOutboundMemo(GetRandomTxHash()).String(),
+		InHash:      GetRandomTxHash(),
+	})
+	c.Assert(s.k.SetTxOut(ctx, txOut), IsNil)
+
+	path := []string{query.QueryVaultMigration.Key, vault.PubKey.String()}
+	result, err := s.querier(ctx, path, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var resp openapi.VaultMigration
+	c.Assert(json.Unmarshal(result, &resp), IsNil)
+	c.Check(resp.Status, Equals, RetiringVault.String())
+	c.Check(resp.RetiringHeight, Equals, int64(100))
+	c.Check(resp.MigrateInterval, Equals, int64(10))
+	c.Check(resp.Rounds, Equals, rounds)
+	c.Check(resp.RoundsRemaining, Equals, rounds-3)
+	c.Check(resp.GetNextRoundHeight(), Equals, int64(130))
+	c.Check(resp.GetEstimatedCompletionHeight(), Equals, 100+(rounds-1)*10)
+	c.Check(resp.HasCompletedHeight(), Equals, false)
+	c.Check(resp.Signers, DeepEquals, []string{na.NodeAddress.String()})
+	c.Assert(resp.Chains, HasLen, 2)
+	c.Check(resp.Chains[0].Chain, Equals, common.BNBChain.String())
+	c.Assert(resp.Chains[0].Remaining, HasLen, 1)
+	c.Check(resp.Chains[0].Migrated, HasLen, 0)
+	c.Check(resp.Chains[1].Chain, Equals, common.BTCChain.String())
+	c.Check(resp.Chains[1].Remaining, HasLen, 0)
+	c.Assert(resp.Chains[1].Migrated, HasLen, 1)
+	c.Check(resp.Chains[1].Migrated[0].Amount, Equals, cosmos.NewUint(common.One).String())
+	c.Assert(resp.PendingOutbounds, HasLen, 1)
+	c.Check(resp.PendingOutbounds[0].Coin.Amount, Equals, cosmos.NewUint(60*common.One).String())
+
+	// active vaults are not migrating
+	vault.Status = ActiveVault
+	c.Assert(s.k.SetVault(s.ctx, vault), IsNil)
+	_, err = s.querier(ctx, path, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+}
+
+func (s *QuerierSuite) TestQueryVaultSolvency(c *C) {
+	pk := GetRandomPubKey()
+	for _, height := range []int64{10, 20} {
+		record := NewSolvencyRecord(pk, common.ETHChain, height)
+		record.Deltas = append(record.Deltas, NewSolvencyDelta(common.ETHAsset, cosmos.NewUint(1000), cosmos.NewUint(994)))
+		s.k.SetSolvencyRecord(s.ctx.WithBlockHeight(height), record)
+	}
+	other := NewSolvencyRecord(pk, common.BTCChain, 20)
+	s.k.SetSolvencyRecord(s.ctx.WithBlockHeight(20), other)
+
+	path := []string{query.QueryVaultSolvency.Key, pk.String(), common.ETHChain.String()}
+	result, err := s.querier(s.ctx.WithBlockHeight(20), path, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var resp openapi.SolvencyHistory
+	c.Assert(json.Unmarshal(result, &resp), IsNil)
+	c.Check(resp.PubKey, Equals, pk.String())
+	c.Check(resp.Chain, Equals, common.ETHChain.String())
+	c.Assert(resp.Records, HasLen, 2)
+	c.Check(resp.Records[0].Height, Equals, int64(10))
+	c.Check(resp.Records[1].Height, Equals, int64(20))
+	c.Assert(resp.Records[1].Deltas, HasLen, 1)
+	c.Check(resp.Records[1].Deltas[0].Asset, Equals, common.ETHAsset.String())
+	c.Check(resp.Records[1].Deltas[0].Delta, Equals, "-6")
+	c.Check(resp.Records[1].Deltas[0].Gap, Equals, int64(60))
+
+	_, err = s.querier(s.ctx, []string{query.QueryVaultSolvency.Key, pk.String()}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+	_, err = s.querier(s.ctx, []string{query.QueryVaultSolvency.Key, "bogus", common.ETHChain.String()}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+}
+
 func (s *QuerierSuite) TestQueryVersion(c *C) {
 	result, err := s.querier(s.ctx, []string{
 		query.QueryVersion.Key,
@@ -1107,6 +1329,416 @@ func (s *QuerierSuite) TestQueryMayaname(c *C) {
 	c.Assert(*r.Subaffiliates[1].Bps, Equals, int64(2000))
 }
 
+func (s *QuerierSuite) TestQueryMayanamesReverse(c *C) {
+	grace := s.mgr.GetConstants().GetInt64Value(constants.MAYANameGracePeriodBlocks)
+	ctx := s.ctx.WithBlockHeight(grace + 100)
+	owner := GetRandomBech32Addr()
+	btcAddr := GetRandomBTCAddress()
+	aliases := []MAYANameAlias{{Chain: common.BTCChain, Address: btcAddr}}
+	s.mgr.Keeper().SetMAYAName(ctx, NewMAYAName("hello", ctx.BlockHeight()+100, aliases, common.EmptyAsset, owner, cosmos.ZeroUint(), nil))
+	s.mgr.Keeper().SetMAYAName(ctx, NewMAYAName("world", ctx.BlockHeight()+100, aliases, common.EmptyAsset, GetRandomBech32Addr(), cosmos.ZeroUint(), nil))
+	// expired names are not returned
+	s.mgr.Keeper().SetMAYAName(ctx, NewMAYAName("expired", 10, aliases, common.EmptyAsset, owner, cosmos.ZeroUint(), nil))
+
+	result, err := s.querier(ctx, []string{query.QueryMAYANamesByOwner.Key, owner.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var names []openapi.Mayaname
+	c.Assert(json.Unmarshal(result, &names), IsNil)
+	c.Assert(names, HasLen, 1)
+	c.Check(*names[0].Name, Equals, "hello")
+
+	result, err = s.querier(ctx, []string{query.QueryMAYANamesByAddress.Key, "BTC", btcAddr.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	names = nil
+	c.Assert(json.Unmarshal(result, &names), IsNil)
+	c.Assert(names, HasLen, 2)
+	c.Check(*names[0].Name, Equals, "hello")
+	c.Check(*names[1].Name, Equals, "world")
+
+	result, err = s.querier(ctx, []string{query.QueryMAYANamesByAddress.Key, "ETH", btcAddr.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	names = nil
+	c.Assert(json.Unmarshal(result, &names), IsNil)
+	c.Assert(names, HasLen, 0)
+
+	_, err = s.querier(ctx, []string{query.QueryMAYANamesByOwner.Key, "bogus"}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+	_, err = s.querier(ctx, []string{query.QueryMAYANamesByAddress.Key, "BTC"}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+}
+
+func (s *QuerierSuite) TestQueryDCAOrders(c *C) {
+	newOrder := func(market bool) MsgSwap {
+		tx := GetRandomTx()
+		tx.Coins = common.NewCoins(common.NewCoin(common.BTCAsset, cosmos.NewUint(common.One)))
+		orderType := DCAOrder
+		if market {
+			orderType = MarketOrder
+		}
+		msg := NewMsgSwap(tx, common.ETHAsset, GetRandomETHAddress(), cosmos.NewUint(common.One), common.NoAddress, cosmos.ZeroUint(), "", "", nil, orderType, 4, 100, GetRandomBech32Addr())
+		c.Assert(s.k.SetSwapQueueItem(s.ctx, *msg, 0), IsNil)
+		return *msg
+	}
+	pending := newOrder(false)
+	order := newOrder(false)
+	streaming := newOrder(true)
+
+	// the first slice of the order has been swapped
+	swp := order.GetStreamingSwap()
+	swp.Count = 1
+	swp.LastHeight = 50
+	swp.In = cosmos.NewUint(common.One / 4)
+	swp.Out = cosmos.NewUint(2 * common.One)
+	s.k.SetStreamingSwap(s.ctx, swp)
+
+	result, err := s.querier(s.ctx, []string{query.QueryDCAOrders.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var orders []openapi.DCAOrder
+	c.Assert(json.Unmarshal(result, &orders), IsNil)
+	c.Assert(orders, HasLen, 2)
+
+	result, err = s.querier(s.ctx, []string{query.QueryDCAOrder.Key, order.Tx.ID.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var dca openapi.DCAOrder
+	c.Assert(json.Unmarshal(result, &dca), IsNil)
+	c.Check(dca.Sender, Equals, order.Tx.FromAddress.String())
+	c.Check(dca.SourceAsset, Equals, "BTC.BTC")
+	c.Check(dca.TargetAsset, Equals, "ETH.ETH")
+	c.Check(dca.Interval, Equals, int64(100))
+	c.Check(dca.Quantity, Equals, int64(4))
+	c.Check(dca.Count, Equals, int64(1))
+	c.Check(dca.SliceLimit, Equals, cosmos.NewUint(common.One).String())
+	c.Check(dca.In, Equals, cosmos.NewUint(common.One/4).String())
+	c.Check(dca.Out, Equals, cosmos.NewUint(2*common.One).String())
+	c.Assert(dca.NextHeight, NotNil)
+	c.Check(*dca.NextHeight, Equals, int64(150))
+
+	// orders without a slice yet are reported as deposited
+	result, err = s.querier(s.ctx, []string{query.QueryDCAOrder.Key, pending.Tx.ID.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	dca = openapi.DCAOrder{}
+	c.Assert(json.Unmarshal(result, &dca), IsNil)
+	c.Check(dca.Count, Equals, int64(0))
+	c.Check(dca.Deposit, Equals, cosmos.NewUint(common.One).String())
+	c.Check(dca.NextHeight, IsNil)
+
+	_, err = s.querier(s.ctx, []string{query.QueryDCAOrder.Key, streaming.Tx.ID.String()}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+	_, err = s.querier(s.ctx, []string{query.QueryDCAOrder.Key, GetRandomTxHash().String()}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+}
+
+func (s *QuerierSuite) TestQueryAffiliateCollectors(c *C) {
+	owner := GetRandomBech32Addr()
+	btcAddr := GetRandomBTCAddress()
+	mn := NewMAYAName("alice", s.ctx.BlockHeight()+100, []MAYANameAlias{{Chain: common.BTCChain, Address: btcAddr}}, common.BTCAsset, owner, cosmos.ZeroUint(), nil)
+	s.k.SetMAYAName(s.ctx, mn)
+	s.k.SetAffiliateCollector(s.ctx, NewAffiliateFeeCollector(owner, cosmos.NewUint(5*common.One)))
+	c.Assert(s.k.AddAffiliatePayout(s.ctx, owner, NewAffiliatePayout(mn.Name, common.BaseNative, cosmos.NewUint(common.One), common.BlankTxID, 10, true)), IsNil)
+
+	// a preferred asset swap waiting in the queue
+	affColAddress, err := s.k.GetModuleAddress(AffiliateCollectorName)
+	c.Assert(err, IsNil)
+	txID := GetRandomTxHash()
+	coin := common.NewCoin(common.BaseAsset(), cosmos.NewUint(2*common.One))
+	tx := common.NewTx(txID, affColAddress, GetRandomBaseAddress(), common.NewCoins(coin), common.Gas{}, "MAYA-PREFERRED-ASSET-alice")
+	swap := NewMsgSwap(tx, common.BTCAsset, btcAddr, cosmos.ZeroUint(), common.NoAddress, cosmos.ZeroUint(), "", "", nil, MarketOrder, 0, 0, owner)
+	c.Assert(s.k.SetSwapQueueItem(s.ctx, *swap, 0), IsNil)
+
+	s.k.SetAffiliateCollector(s.ctx, NewAffiliateFeeCollector(GetRandomBech32Addr(), cosmos.NewUint(common.One)))
+
+	result, err := s.querier(s.ctx, []string{query.QueryAffiliateCollectors.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var affCols []openapi.AffiliateCollector
+	c.Assert(json.Unmarshal(result, &affCols), IsNil)
+	c.Assert(affCols, HasLen, 2)
+
+	result, err = s.querier(s.ctx, []string{query.QueryAffiliateCollector.Key, owner.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var affCol openapi.AffiliateCollector
+	c.Assert(json.Unmarshal(result, &affCol), IsNil)
+	c.Check(affCol.Owner, Equals, owner.String())
+	c.Check(affCol.CacaoAmount, Equals, cosmos.NewUint(5*common.One).String())
+	c.Assert(affCol.Mayanames, HasLen, 1)
+	c.Check(affCol.Mayanames[0].Name, Equals, "alice")
+	c.Check(affCol.Mayanames[0].PreferredAsset, Equals, "BTC.BTC")
+	c.Assert(affCol.PendingSwaps, HasLen, 1)
+	c.Check(affCol.PendingSwaps[0].TxId, Equals, txID.String())
+	c.Check(affCol.PendingSwaps[0].Mayaname, Equals, "alice")
+	c.Check(affCol.PendingSwaps[0].CacaoAmount, Equals, cosmos.NewUint(2*common.One).String())
+	c.Check(affCol.PendingSwaps[0].Destination, Equals, btcAddr.String())
+	c.Assert(affCol.Payouts, HasLen, 1)
+	c.Check(affCol.Payouts[0].Asset, Equals, common.BaseNative.String())
+	c.Check(affCol.Payouts[0].TxId, IsNil)
+	c.Check(affCol.Payouts[0].Claimed, Equals, true)
+
+	_, err = s.querier(s.ctx, []string{query.QueryAffiliateCollector.Key, "bogus"}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+}
+
+func (s *QuerierSuite) TestQueryMayanameListings(c *C) {
+	aliases := []MAYANameAlias{{Chain: common.BTCChain, Address: GetRandomBTCAddress()}}
+	for _, name := range []string{"alice", "bob", "carol"} {
+		owner := GetRandomBech32Addr()
+		s.k.SetMAYAName(s.ctx, NewMAYAName(name, s.ctx.BlockHeight()+100, aliases, common.EmptyAsset, owner, cosmos.ZeroUint(), nil))
+		s.k.SetMAYANameListing(s.ctx, NewMAYANameListing(name, owner, cosmos.NewUint(common.One), s.ctx.BlockHeight()+10, false, false, s.ctx.BlockHeight()))
+	}
+
+	// an expired listing
+	bob, err := s.k.GetMAYANameListing(s.ctx, "bob")
+	c.Assert(err, IsNil)
+	bob.ExpireHeight = s.ctx.BlockHeight() - 1
+	s.k.SetMAYANameListing(s.ctx, bob)
+
+	// a stale listing, the mayaname changed hands since it was listed
+	carol, err := s.k.GetMAYAName(s.ctx, "carol")
+	c.Assert(err, IsNil)
+	carol.Owner = GetRandomBech32Addr()
+	s.k.SetMAYAName(s.ctx, carol)
+
+	result, err := s.querier(s.ctx, []string{query.QueryMAYANameListings.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var listings []openapi.MayanameListing
+	c.Assert(json.Unmarshal(result, &listings), IsNil)
+	c.Assert(listings, HasLen, 1)
+	c.Check(listings[0].Name, Equals, "alice")
+	c.Check(listings[0].Price, Equals, cosmos.NewUint(common.One).String())
+
+	result, err = s.querier(s.ctx, []string{query.QueryMAYANameListing.Key, "alice"}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var listing openapi.MayanameListing
+	c.Assert(json.Unmarshal(result, &listing), IsNil)
+	c.Check(listing.ExpireHeight, Equals, s.ctx.BlockHeight()+10)
+
+	_, err = s.querier(s.ctx, []string{query.QueryMAYANameListing.Key, "bob"}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+	_, err = s.querier(s.ctx, []string{query.QueryMAYANameListing.Key, "carol"}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+}
+
+func (s *QuerierSuite) TestQueryPortfolio(c *C) {
+	pool := NewPool()
+	pool.Asset = common.BTCAsset
+	pool.Status = PoolAvailable
+	pool.BalanceCacao = cosmos.NewUint(1000 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(10 * common.One)
+	pool.LPUnits = cosmos.NewUint(1000)
+	c.Assert(s.k.SetPool(s.ctx, pool), IsNil)
+	saversPool := NewPool()
+	saversPool.Asset = common.BTCAsset.GetSyntheticAsset()
+	saversPool.Status = PoolAvailable
+	saversPool.BalanceAsset = cosmos.NewUint(10 * common.One)
+	saversPool.LPUnits = cosmos.NewUint(10 * common.One)
+	c.Assert(s.k.SetPool(s.ctx, saversPool), IsNil)
+
+	owner := GetRandomBaseAddress()
+	btcAddr := GetRandomBTCAddress()
+	nodeAddr := GetRandomBech32Addr()
+	s.k.SetLiquidityProvider(s.ctx, LiquidityProvider{
+		Asset:             common.BTCAsset,
+		CacaoAddress:      owner,
+		AssetAddress:      btcAddr,
+		Units:             cosmos.NewUint(100),
+		PendingCacao:      cosmos.ZeroUint(),
+		PendingAsset:      cosmos.ZeroUint(),
+		CacaoDepositValue: cosmos.NewUint(100 * common.One),
+		AssetDepositValue: cosmos.NewUint(common.One),
+		BondedNodes:       []types.LPBondedNode{{NodeAddress: nodeAddr, Units: cosmos.NewUint(40)}},
+	})
+	s.k.SetLiquidityProvider(s.ctx, LiquidityProvider{
+		Asset:             common.BTCAsset.GetSyntheticAsset(),
+		AssetAddress:      btcAddr,
+		Units:             cosmos.NewUint(common.One),
+		PendingCacao:      cosmos.ZeroUint(),
+		PendingAsset:      cosmos.ZeroUint(),
+		CacaoDepositValue: cosmos.ZeroUint(),
+		AssetDepositValue: cosmos.NewUint(common.One),
+	})
+
+	accAddr, err := owner.AccAddress()
+	c.Assert(err, IsNil)
+	cacaoPool := NewCACAOPool()
+	cacaoPool.PoolUnits = cosmos.NewUint(10)
+	s.k.SetCACAOPool(s.ctx, cacaoPool)
+	cacaoProvider := types.NewCACAOProvider(accAddr)
+	cacaoProvider.Units = cosmos.NewUint(10)
+	s.k.SetCACAOProvider(s.ctx, cacaoProvider)
+	s.k.SetTradeUnit(s.ctx, TradeUnit{
+		Asset: common.BTCAsset.GetTradeAsset(),
+		Units: cosmos.NewUint(2 * common.One),
+		Depth: cosmos.NewUint(4 * common.One),
+	})
+	ta := types.NewTradeAccount(accAddr, common.BTCAsset.GetTradeAsset())
+	ta.Units = cosmos.NewUint(common.One)
+	s.k.SetTradeAccount(s.ctx, ta)
+
+	// by MAYA address
+	result, err := s.querier(s.ctx, []string{query.QueryPortfolio.Key, owner.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var portfolio openapi.Portfolio
+	c.Assert(json.Unmarshal(result, &portfolio), IsNil)
+	c.Assert(portfolio.LiquidityProviders, HasLen, 1)
+	lp := portfolio.LiquidityProviders[0]
+	c.Check(lp.Asset, Equals, "BTC.BTC")
+	c.Check(lp.CacaoRedeemValue, Equals, cosmos.NewUint(100*common.One).String())
+	c.Check(lp.AssetRedeemValue, Equals, cosmos.NewUint(common.One).String())
+	c.Check(lp.BondedUnits, Equals, "40")
+	c.Check(lp.ImpLossProtection.Enabled, Equals, true)
+	c.Check(lp.ImpLossProtection.ProtectionCacao, Equals, "0")
+	c.Check(portfolio.Savers, HasLen, 0)
+	c.Assert(portfolio.Bonds, HasLen, 1)
+	c.Check(portfolio.Bonds[0].NodeAddress, Equals, nodeAddr.String())
+	c.Check(portfolio.Bonds[0].CacaoValue, Equals, cosmos.NewUint(80*common.One).String())
+	c.Assert(portfolio.CacaoProvider, NotNil)
+	c.Check(portfolio.CacaoProvider.Units, Equals, "10")
+	c.Assert(portfolio.TradeAccounts, HasLen, 1)
+	c.Check(portfolio.TradeAccounts[0].AssetValue, Equals, cosmos.NewUint(2*common.One).String())
+
+	// by L1 address
+	result, err = s.querier(s.ctx, []string{query.QueryPortfolio.Key, btcAddr.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	portfolio = openapi.Portfolio{}
+	c.Assert(json.Unmarshal(result, &portfolio), IsNil)
+	c.Assert(portfolio.LiquidityProviders, HasLen, 1)
+	c.Check(*portfolio.LiquidityProviders[0].CacaoAddress, Equals, owner.String())
+	c.Assert(portfolio.Savers, HasLen, 1)
+	c.Check(portfolio.Savers[0].AssetRedeemValue, Equals, cosmos.NewUint(common.One).String())
+	c.Check(portfolio.CacaoProvider, IsNil)
+	c.Check(portfolio.TradeAccounts, HasLen, 0)
+
+	_, err = s.querier(s.ctx, []string{query.QueryPortfolio.Key, ""}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+}
+
+func (s *QuerierSuite) TestQueryLoans(c *C) {
+	pool := NewPool()
+	pool.Asset = common.BTCAsset
+	pool.Status = PoolAvailable
+	pool.BalanceCacao = cosmos.NewUint(1000 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(10 * common.One)
+	c.Assert(s.k.SetPool(s.ctx, pool), IsNil)
+	s.k.SetTradeUnit(s.ctx, TradeUnit{
+		Asset: common.BTCAsset.GetTradeAsset(),
+		Units: cosmos.NewUint(2 * common.One),
+		Depth: cosmos.NewUint(2 * common.One),
+	})
+
+	owner := GetRandomBech32Addr()
+	loan := NewLoan(owner, common.BTCAsset.GetTradeAsset(), common.BaseNative)
+	loan.CollateralUnits = cosmos.NewUint(common.One)
+	loan.CollateralDeposited = cosmos.NewUint(common.One)
+	loan.DebtIssued = cosmos.NewUint(40 * common.One)
+	loan.LastOpenHeight = 10
+	s.k.SetLoan(s.ctx, loan)
+
+	other := NewLoan(GetRandomBech32Addr(), common.BTCAsset.GetTradeAsset(), common.BaseNative)
+	other.CollateralUnits = cosmos.NewUint(common.One)
+	other.DebtIssued = cosmos.NewUint(10 * common.One)
+	s.k.SetLoan(s.ctx, other)
+
+	result, err := s.querier(s.ctx, []string{query.QueryLoans.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var loans []openapi.Loan
+	c.Assert(json.Unmarshal(result, &loans), IsNil)
+	c.Assert(loans, HasLen, 2)
+
+	result, err = s.querier(s.ctx, []string{query.QueryOwnerLoans.Key, owner.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	loans = nil
+	c.Assert(json.Unmarshal(result, &loans), IsNil)
+	c.Assert(loans, HasLen, 1)
+	c.Check(loans[0].Owner, Equals, owner.String())
+	c.Check(loans[0].CollateralAsset, Equals, "BTC~BTC")
+	c.Check(loans[0].CollateralCurrent, Equals, cosmos.NewUint(common.One).String())
+	c.Check(loans[0].CollateralValueCacao, Equals, cosmos.NewUint(100*common.One).String())
+	c.Check(loans[0].DebtOutstanding, Equals, cosmos.NewUint(40*common.One).String())
+	c.Check(loans[0].DebtValueCacao, Equals, cosmos.NewUint(40*common.One).String())
+	c.Check(loans[0].CollateralizationRatio, Equals, int64(25_000))
+	c.Check(loans[0].MinCollateralizationRatio, Equals, int64(20_000))
+	c.Check(loans[0].HealthFactor, Equals, int64(12_500))
+	c.Check(loans[0].LastOpenHeight, Equals, int64(10))
+
+	_, err = s.querier(s.ctx, []string{query.QueryOwnerLoans.Key, "bogus"}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+}
+
+func (s *QuerierSuite) TestQueryOrderBook(c *C) {
+	poolBTC := NewPool()
+	poolBTC.Asset = common.BTCAsset
+	poolBTC.Status = PoolAvailable
+	poolBTC.BalanceCacao = cosmos.NewUint(1000 * common.One)
+	poolBTC.BalanceAsset = cosmos.NewUint(10 * common.One)
+	c.Assert(s.k.SetPool(s.ctx, poolBTC), IsNil)
+	poolETH := NewPool()
+	poolETH.Asset = common.ETHAsset
+	poolETH.Status = PoolAvailable
+	poolETH.BalanceCacao = cosmos.NewUint(1000 * common.One)
+	poolETH.BalanceAsset = cosmos.NewUint(100 * common.One)
+	c.Assert(s.k.SetPool(s.ctx, poolETH), IsNil)
+
+	newOrder := func(amt, target uint64) MsgSwap {
+		tx := GetRandomTx()
+		tx.Coins = common.NewCoins(common.NewCoin(common.BTCAsset, cosmos.NewUint(amt)))
+		msg := NewMsgSwap(tx, common.ETHAsset, GetRandomETHAddress(), cosmos.NewUint(target), common.NoAddress, cosmos.ZeroUint(), "", "", nil, LimitOrder, 0, 0, GetRandomBech32Addr())
+		c.Assert(s.k.SetOrderBookItem(s.ctx, *msg), IsNil)
+		return *msg
+	}
+	// the pools price 1 ETH at 0.1 BTC, so the first order can already fill
+	crossed := newOrder(common.One, 9*common.One)
+	order := newOrder(common.One, 12*common.One)
+	order.ExpiryHeight = s.ctx.BlockHeight() + 10
+	c.Assert(s.k.SetOrderBookItem(s.ctx, order), IsNil)
+	newOrder(2*common.One, 24*common.One)
+
+	// a quarter of the order has been filled already
+	fill := NewLimitOrderFill(order.Tx.ID)
+	fill.In = cosmos.NewUint(common.One / 4)
+	fill.Out = cosmos.NewUint(3 * common.One)
+	s.k.SetLimitOrderFill(s.ctx, fill)
+
+	result, err := s.querier(s.ctx, []string{query.QueryOrderBook.Key, "BTC.BTC", "ETH.ETH"}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var book openapi.OrderBook
+	c.Assert(json.Unmarshal(result, &book), IsNil)
+	c.Check(book.PoolPrice, Equals, "10000000")
+	c.Check(book.Depth, Equals, cosmos.NewUint(4*common.One-common.One/4).String())
+	c.Assert(book.Levels, HasLen, 2)
+	c.Check(book.Levels[0].Price, Equals, "11111111")
+	c.Check(book.Levels[0].Orders, Equals, int64(1))
+	c.Check(book.Levels[1].Price, Equals, "8333333")
+	c.Check(book.Levels[1].Orders, Equals, int64(2))
+	c.Check(book.Levels[1].Quantity, Equals, cosmos.NewUint(3*common.One-common.One/4).String())
+	c.Check(book.Levels[1].TradeTarget, Equals, cosmos.NewUint(33*common.One).String())
+	c.Assert(book.Orders, HasLen, 3)
+	c.Check(book.Orders[0].TxId, Equals, crossed.Tx.ID.String())
+	c.Check(book.Orders[0].PriceDistanceBps, Equals, int64(-1111))
+
+	result, err = s.querier(s.ctx, []string{query.QueryOrderBookOrder.Key, order.Tx.ID.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var limit openapi.LimitOrder
+	c.Assert(json.Unmarshal(result, &limit), IsNil)
+	c.Check(limit.Sender, Equals, order.Tx.FromAddress.String())
+	c.Check(limit.Deposit, Equals, cosmos.NewUint(common.One).String())
+	c.Check(limit.Remaining, Equals, cosmos.NewUint(common.One-common.One/4).String())
+	c.Check(limit.Price, Equals, "8333333")
+	c.Check(limit.PriceDistanceBps, Equals, int64(1666))
+	c.Assert(limit.ExpiryHeight, NotNil)
+	c.Check(*limit.ExpiryHeight, Equals, order.ExpiryHeight)
+
+	result, err = s.querier(s.ctx, []string{query.QueryOrderBooks.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var books []openapi.OrderBook
+	c.Assert(json.Unmarshal(result, &books), IsNil)
+	c.Assert(books, HasLen, 1)
+	c.Check(books[0].SourceAsset, Equals, "BTC.BTC")
+	c.Check(books[0].TargetAsset, Equals, "ETH.ETH")
+
+	_, err = s.querier(s.ctx, []string{query.QueryOrderBookOrder.Key, GetRandomTxHash().String()}, abci.RequestQuery{})
+	c.Check(err, NotNil)
+	_, err = s.querier(s.ctx, []string{query.QueryOrderBook.Key, "BTC.BTC"}, abci.RequestQuery{})
+	c.Check(err, NotNil)
+}
+
 func (s *QuerierSuite) TestQueryQuoteSwap(c *C) {
 	addr := GetRandomBaseAddress()
 	owner, _ := addr.AccAddress()
@@ -1256,6 +1888,139 @@ func (s *QuerierSuite) TestQueryQuoteSwapWithAffiliates(c *C) {
 	checkSwapQuoteResults(c, qsr, poolETH, 10000000, affFeeBps)
 }
 
+func (s *QuerierSuite) TestQueryQuoteLiquidityAdd(c *C) {
+	pubKey := GetRandomPubKey()
+	asgard := NewVault(s.ctx.BlockHeight(), ActiveVault, AsgardVault, pubKey, common.Chains{common.BNBChain}.Strings(), []ChainContract{})
+	c.Assert(s.mgr.Keeper().SetVault(s.ctx, asgard), IsNil)
+
+	poolBNB := NewPool()
+	poolBNB.Asset = common.BNBAsset
+	poolBNB.LPUnits = cosmos.NewUint(100000 * common.One)
+	poolBNB.BalanceAsset = cosmos.NewUint(100000 * common.One)
+	poolBNB.BalanceCacao = cosmos.NewUint(10000000000 * common.One)
+	c.Assert(s.mgr.Keeper().SetPool(s.ctx, poolBNB), IsNil)
+
+	cacaoAddr := GetRandomBaseAddress()
+	bnbAddr := GetRandomBNBAddress()
+
+	// symmetric add at the pool ratio has no slip
+	q := url.Values{}
+	q.Add(assetParam, "BNB.BNB")
+	q.Add(amountCacaoParam, cosmos.NewUint(10000000*common.One).String())
+	q.Add(amountAssetParam, cosmos.NewUint(100*common.One).String())
+	q.Add(cacaoAddressParam, cacaoAddr.String())
+	q.Add(assetAddressParam, bnbAddr.String())
+	req := abci.RequestQuery{Data: []byte("/mayachain/quote/liquidity/add?" + q.Encode())}
+	res, err := s.querier(s.ctx, []string{query.QueryQuoteLiquidityAdd.Key}, req)
+	c.Assert(err, IsNil)
+	var qar openapi.QuoteLiquidityAddResponse
+	c.Assert(json.Unmarshal(res, &qar), IsNil)
+	c.Check(qar.ExpectedPoolUnits, Equals, cosmos.NewUint(100*common.One).String())
+	c.Check(qar.PoolUnits, Equals, cosmos.NewUint(100100*common.One).String())
+	c.Check(qar.PoolShareBps, Equals, int64(10))
+	c.Check(qar.SlippageBps, Equals, int64(0))
+	c.Check(qar.GetMemo(), Equals, "+:BNB.BNB:"+cacaoAddr.String())
+	c.Check(qar.GetCacaoMemo(), Equals, "+:BNB.BNB:"+bnbAddr.String())
+	bnbInbound, err := asgard.GetAddress(common.BNBChain)
+	c.Assert(err, IsNil)
+	c.Check(qar.GetInboundAddress(), Equals, bnbInbound.String())
+	c.Check(qar.LiquidityAuction, IsNil)
+
+	// asymmetric add slips by the share of the pool it adds
+	q = url.Values{}
+	q.Add(assetParam, "BNB.BNB")
+	q.Add(amountAssetParam, cosmos.NewUint(100*common.One).String())
+	req = abci.RequestQuery{Data: []byte("/mayachain/quote/liquidity/add?" + q.Encode())}
+	res, err = s.querier(s.ctx, []string{query.QueryQuoteLiquidityAdd.Key}, req)
+	c.Assert(err, IsNil)
+	qar = openapi.QuoteLiquidityAddResponse{}
+	c.Assert(json.Unmarshal(res, &qar), IsNil)
+	c.Check(qar.ExpectedPoolUnits, Equals, "4995004995")
+	c.Check(qar.SlippageBps, Equals, int64(9))
+	c.Check(qar.GetMemo(), Equals, "+:BNB.BNB")
+	c.Check(qar.CacaoMemo, IsNil)
+
+	// symmetric add requires both addresses to pair the deposits
+	q = url.Values{}
+	q.Add(assetParam, "BNB.BNB")
+	q.Add(amountCacaoParam, cosmos.NewUint(10000000*common.One).String())
+	q.Add(amountAssetParam, cosmos.NewUint(100*common.One).String())
+	req = abci.RequestQuery{Data: []byte("/mayachain/quote/liquidity/add?" + q.Encode())}
+	res, err = s.querier(s.ctx, []string{query.QueryQuoteLiquidityAdd.Key}, req)
+	c.Assert(err, IsNil)
+	c.Check(strings.Contains(string(res), "required for a symmetric add"), Equals, true)
+}
+
+func (s *QuerierSuite) TestQueryQuoteLiquidityWithdraw(c *C) {
+	pubKey := GetRandomPubKey()
+	asgard := NewVault(s.ctx.BlockHeight(), ActiveVault, AsgardVault, pubKey, common.Chains{common.BNBChain}.Strings(), []ChainContract{})
+	asgard.AddFunds(common.Coins{common.NewCoin(common.BNBAsset, cosmos.NewUint(100000*common.One))})
+	c.Assert(s.mgr.Keeper().SetVault(s.ctx, asgard), IsNil)
+	nodeAccount := GetRandomValidatorNode(NodeActive)
+	c.Assert(s.mgr.Keeper().SetNodeAccount(s.ctx, nodeAccount), IsNil)
+
+	poolBNB := NewPool()
+	poolBNB.Asset = common.BNBAsset
+	poolBNB.LPUnits = cosmos.NewUint(100000 * common.One)
+	poolBNB.BalanceAsset = cosmos.NewUint(100000 * common.One)
+	poolBNB.BalanceCacao = cosmos.NewUint(10000000000 * common.One)
+	c.Assert(s.mgr.Keeper().SetPool(s.ctx, poolBNB), IsNil)
+
+	lp := LiquidityProvider{
+		Asset:             common.BNBAsset,
+		CacaoAddress:      GetRandomBaseAddress(),
+		AssetAddress:      GetRandomBNBAddress(),
+		Units:             cosmos.NewUint(100 * common.One),
+		PendingCacao:      cosmos.ZeroUint(),
+		PendingAsset:      cosmos.ZeroUint(),
+		CacaoDepositValue: cosmos.ZeroUint(),
+		AssetDepositValue: cosmos.ZeroUint(),
+		WithdrawCounter:   cosmos.ZeroUint(),
+	}
+	s.mgr.Keeper().SetLiquidityProvider(s.ctx, lp)
+
+	q := url.Values{}
+	q.Add(assetParam, "BNB.BNB")
+	q.Add(addressParam, lp.CacaoAddress.String())
+	q.Add(withdrawBasisPointsParam, "5000")
+	req := abci.RequestQuery{Data: []byte("/mayachain/quote/liquidity/withdraw?" + q.Encode())}
+	res, err := s.querier(s.ctx, []string{query.QueryQuoteLiquidityWithdraw.Key}, req)
+	c.Assert(err, IsNil)
+	var qwr openapi.QuoteLiquidityWithdrawResponse
+	c.Assert(json.Unmarshal(res, &qwr), IsNil)
+	c.Check(qwr.Memo, Equals, "-:BNB.BNB:5000")
+	c.Check(qwr.WithdrawUnits, Equals, cosmos.NewUint(50*common.One).String())
+	outAsset := cosmos.NewUintFromString(qwr.ExpectedAmountOutAsset)
+	feeAsset := cosmos.NewUintFromString(qwr.OutboundFeeAsset)
+	c.Check(feeAsset.IsZero(), Equals, false)
+	c.Check(outAsset.Add(feeAsset).String(), Equals, cosmos.NewUint(50*common.One).String())
+	outCacao := cosmos.NewUintFromString(qwr.ExpectedAmountOutCacao)
+	feeCacao := cosmos.NewUintFromString(qwr.OutboundFeeCacao)
+	c.Check(outCacao.Add(feeCacao).String(), Equals, cosmos.NewUint(5000000*common.One).String())
+	c.Check(qwr.LiquidityAuction, IsNil)
+
+	// the simulation must not modify the position
+	lp, err = s.mgr.Keeper().GetLiquidityProvider(s.ctx, common.BNBAsset, lp.CacaoAddress)
+	c.Assert(err, IsNil)
+	c.Check(lp.Units.String(), Equals, cosmos.NewUint(100*common.One).String())
+
+	// a position without units cannot be withdrawn
+	q.Set(addressParam, GetRandomBaseAddress().String())
+	req = abci.RequestQuery{Data: []byte("/mayachain/quote/liquidity/withdraw?" + q.Encode())}
+	res, err = s.querier(s.ctx, []string{query.QueryQuoteLiquidityWithdraw.Key}, req)
+	c.Assert(err, IsNil)
+	c.Check(strings.Contains(string(res), "failed to simulate withdraw"), Equals, true)
+
+	// a quote without active node accounts returns an error instead of panicking
+	nodeAccount.Status = NodeStandby
+	c.Assert(s.mgr.Keeper().SetNodeAccount(s.ctx, nodeAccount), IsNil)
+	q.Set(addressParam, lp.CacaoAddress.String())
+	req = abci.RequestQuery{Data: []byte("/mayachain/quote/liquidity/withdraw?" + q.Encode())}
+	res, err = s.querier(s.ctx, []string{query.QueryQuoteLiquidityWithdraw.Key}, req)
+	c.Assert(err, IsNil)
+	c.Check(strings.Contains(string(res), "no active node accounts"), Equals, true)
+}
+
 func checkSwapQuoteResults(c *C, qsr openapi.QuoteSwapResponse, pool Pool, amtInAsset uint64, affBps uint64) {
 	// verify fees
 	feesLiquidity := cosmos.NewUintFromString(qsr.Fees.Liquidity)
@@ -1337,3 +2102,392 @@ func (s *QuerierSuite) TestQueryNodesShowsRequestedToLeave(c *C) {
 	}
 	c.Assert(foundNoBond, Equals, false, Commentf("Node that requested to leave with little bond should not be in results"))
 }
+
+func (s *QuerierSuite) TestQueryQuoteTradeAccount(c *C) {
+	pubKey := GetRandomPubKey()
+	asgard := NewVault(s.ctx.BlockHeight(), ActiveVault, AsgardVault, pubKey, common.Chains{common.BNBChain}.Strings(), []ChainContract{})
+	c.Assert(s.mgr.Keeper().SetVault(s.ctx, asgard), IsNil)
+
+	poolBNB := NewPool()
+	poolBNB.Asset = common.BNBAsset
+	poolBNB.LPUnits = cosmos.NewUint(100000 * common.One)
+	poolBNB.BalanceAsset = cosmos.NewUint(100000 * common.One)
+	poolBNB.BalanceCacao = cosmos.NewUint(10000000000 * common.One)
+	c.Assert(s.mgr.Keeper().SetPool(s.ctx, poolBNB), IsNil)
+
+	owner := GetRandomBaseAddress()
+	q := url.Values{}
+	q.Add(assetParam, "BNB.BNB")
+	q.Add(amountParam, cosmos.NewUint(50*common.One).String())
+	q.Add(addressParam, owner.String())
+	req := abci.RequestQuery{Data: []byte("/mayachain/quote/trade/deposit?" + q.Encode())}
+
+	// quotes follow the trade account mimir
+	s.mgr.Keeper().SetMimir(s.ctx, constants.TradeAccountsDepositEnabled.String(), 0)
+	res, err := s.querier(s.ctx, []string{query.QueryQuoteTradeDeposit.Key}, req)
+	c.Assert(err, IsNil)
+	c.Check(strings.Contains(string(res), "trade accounts deposits are disabled"), Equals, true)
+	s.mgr.Keeper().SetMimir(s.ctx, constants.TradeAccountsDepositEnabled.String(), 1)
+
+	// units follow the existing trade unit share price
+	tu := types.NewTradeUnit(common.BNBAsset.GetTradeAsset())
+	tu.Units = cosmos.NewUint(100 * common.One)
+	tu.Depth = cosmos.NewUint(200 * common.One)
+	s.mgr.Keeper().SetTradeUnit(s.ctx, tu)
+
+	res, err = s.querier(s.ctx, []string{query.QueryQuoteTradeDeposit.Key}, req)
+	c.Assert(err, IsNil)
+	var qdr openapi.QuoteTradeDepositResponse
+	c.Assert(json.Unmarshal(res, &qdr), IsNil)
+	c.Check(qdr.Memo, Equals, "trade+:"+owner.String())
+	c.Check(qdr.ExpectedAmountOut, Equals, cosmos.NewUint(50*common.One).String())
+	c.Check(qdr.ExpectedUnits, Equals, cosmos.NewUint(25*common.One).String())
+	bnbInbound, err := asgard.GetAddress(common.BNBChain)
+	c.Assert(err, IsNil)
+	c.Check(qdr.InboundAddress, Equals, bnbInbound.String())
+
+	// withdraw deducts the outbound fee
+	dest := GetRandomBNBAddress()
+	q = url.Values{}
+	q.Add(assetParam, "BNB~BNB")
+	q.Add(amountParam, cosmos.NewUint(50*common.One).String())
+	q.Add(addressParam, dest.String())
+	req = abci.RequestQuery{Data: []byte("/mayachain/quote/trade/withdraw?" + q.Encode())}
+	res, err = s.querier(s.ctx, []string{query.QueryQuoteTradeWithdraw.Key}, req)
+	c.Assert(err, IsNil)
+	var qwr openapi.QuoteTradeWithdrawResponse
+	c.Assert(json.Unmarshal(res, &qwr), IsNil)
+	c.Check(qwr.Memo, Equals, "trade-:"+dest.String())
+	outboundFee := s.mgr.GasMgr().GetFee(s.ctx, common.BNBChain, common.BNBAsset)
+	c.Check(qwr.Fees.GetOutbound(), Equals, outboundFee.String())
+	c.Check(qwr.ExpectedAmountOut, Equals, cosmos.NewUint(50*common.One).Sub(outboundFee).String())
+
+	// the withdraw address must be on the asset chain
+	q.Set(addressParam, GetRandomBaseAddress().String())
+	req = abci.RequestQuery{Data: []byte("/mayachain/quote/trade/withdraw?" + q.Encode())}
+	res, err = s.querier(s.ctx, []string{query.QueryQuoteTradeWithdraw.Key}, req)
+	c.Assert(err, IsNil)
+	c.Check(strings.Contains(string(res), "address must be a BNB address"), Equals, true)
+}
+
+func (s *QuerierSuite) TestQueryQuoteCacaoPool(c *C) {
+	s.mgr.Keeper().SetMimir(s.ctx, constants.CACAOPoolEnabled.String(), 1)
+
+	// seed the cacao pool with a position at a share price of two
+	coin := common.NewCoin(common.BaseNative, cosmos.NewUint(200*common.One))
+	c.Assert(s.mgr.Keeper().MintToModule(s.ctx, ModuleName, coin), IsNil)
+	c.Assert(s.mgr.Keeper().SendFromModuleToModule(s.ctx, ModuleName, CACAOPoolName, common.NewCoins(coin)), IsNil)
+	addr := GetRandomBaseAddress()
+	acc, err := addr.AccAddress()
+	c.Assert(err, IsNil)
+	provider := types.NewCACAOProvider(acc)
+	provider.Units = cosmos.NewUint(100 * common.One)
+	provider.LastDepositHeight = s.ctx.BlockHeight()
+	s.mgr.Keeper().SetCACAOProvider(s.ctx, provider)
+	cacaoPool := NewCACAOPool()
+	cacaoPool.PoolUnits = cosmos.NewUint(100 * common.One)
+	s.mgr.Keeper().SetCACAOPool(s.ctx, cacaoPool)
+	maturity := s.mgr.Keeper().GetConfigInt64(s.ctx, constants.CACAOPoolDepositMaturityBlocks)
+
+	q := url.Values{}
+	q.Add(amountParam, cosmos.NewUint(50*common.One).String())
+	req := abci.RequestQuery{Data: []byte("/mayachain/quote/cacaopool/deposit?" + q.Encode())}
+	res, err := s.querier(s.ctx, []string{query.QueryQuoteCacaoPoolDeposit.Key}, req)
+	c.Assert(err, IsNil)
+	var qdr openapi.QuoteCacaoPoolDepositResponse
+	c.Assert(json.Unmarshal(res, &qdr), IsNil)
+	c.Check(qdr.Memo, Equals, "pool+")
+	c.Check(qdr.ExpectedUnits, Equals, cosmos.NewUint(25*common.One).String())
+	c.Check(qdr.MaturityBlocks, Equals, maturity)
+
+	// the position is not yet mature
+	q = url.Values{}
+	q.Add(addressParam, addr.String())
+	q.Add(withdrawBasisPointsParam, "5000")
+	req = abci.RequestQuery{Data: []byte("/mayachain/quote/cacaopool/withdraw?" + q.Encode())}
+	res, err = s.querier(s.ctx, []string{query.QueryQuoteCacaoPoolWithdraw.Key}, req)
+	c.Assert(err, IsNil)
+	var qwr openapi.QuoteCacaoPoolWithdrawResponse
+	c.Assert(json.Unmarshal(res, &qwr), IsNil)
+	c.Check(qwr.Memo, Equals, "pool-:5000")
+	c.Check(qwr.WithdrawUnits, Equals, cosmos.NewUint(50*common.One).String())
+	c.Check(qwr.ExpectedAmountOut, Equals, cosmos.NewUint(100*common.One).String())
+	c.Check(qwr.MaturityBlocks, Equals, maturity)
+
+	ctx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + maturity)
+	res, err = s.querier(ctx, []string{query.QueryQuoteCacaoPoolWithdraw.Key}, req)
+	c.Assert(err, IsNil)
+	qwr = openapi.QuoteCacaoPoolWithdrawResponse{}
+	c.Assert(json.Unmarshal(res, &qwr), IsNil)
+	c.Check(qwr.MaturityBlocks, Equals, int64(0))
+}
+
+func (s *QuerierSuite) TestQueryQuoteSwapRoutes(c *C) {
+	pubKey := GetRandomPubKey()
+	asgard := NewVault(s.ctx.BlockHeight(), ActiveVault, AsgardVault, pubKey, common.Chains{common.BNBChain}.Strings(), []ChainContract{})
+	asgard.AddFunds(common.Coins{common.NewCoin(common.BNBAsset, cosmos.NewUint(100000*common.One))})
+	c.Assert(s.mgr.Keeper().SetVault(s.ctx, asgard), IsNil)
+
+	poolBNB := NewPool()
+	poolBNB.Asset = common.BNBAsset
+	poolBNB.LPUnits = cosmos.NewUint(100000 * common.One)
+	poolBNB.BalanceAsset = cosmos.NewUint(100000 * common.One)
+	poolBNB.BalanceCacao = cosmos.NewUint(10000000000 * common.One)
+	c.Assert(s.mgr.Keeper().SetPool(s.ctx, poolBNB), IsNil)
+	nodeAccount := GetRandomValidatorNode(NodeActive)
+	c.Assert(s.mgr.Keeper().SetNodeAccount(s.ctx, nodeAccount), IsNil)
+
+	destination := GetRandomBNBAddress()
+	q := url.Values{}
+	q.Add(fromAssetParam, "MAYA.CACAO")
+	q.Add(toAssetParam, "BNB.BNB")
+	q.Add(amountParam, cosmos.NewUint(1000000000*common.One).String())
+	q.Add(destinationParam, destination.String())
+	req := abci.RequestQuery{Data: []byte("/mayachain/quote/swap/routes?" + q.Encode())}
+
+	res, err := s.querier(s.ctx, []string{query.QueryQuoteSwapRoutes.Key}, req)
+	c.Assert(err, IsNil)
+	var qsr openapi.QuoteSwapRoutesResponse
+	c.Assert(json.Unmarshal(res, &qsr), IsNil)
+	c.Assert(qsr.Routes, HasLen, 3)
+
+	// routes are ranked by expected output
+	routes := map[string]*openapi.QuoteSwapRoute{}
+	prev := cosmos.ZeroUint()
+	for i := range qsr.Routes {
+		route := &qsr.Routes[i]
+		out := cosmos.NewUintFromString(route.ExpectedAmountOut)
+		if i > 0 {
+			c.Check(out.LTE(prev), Equals, true)
+		}
+		prev = out
+		routes[route.Route] = route
+
+		// the swap is large enough to stream
+		c.Check(route.StreamingInterval, Equals, int64(1))
+		c.Check(route.StreamingQuantity > 1, Equals, true)
+		c.Check(route.StreamingQuantity <= route.GetMaxStreamingQuantity(), Equals, true)
+	}
+	c.Check(routes["layer1"].ToAsset, Equals, "BNB.BNB")
+	c.Check(routes["trade"].ToAsset, Equals, "BNB~BNB")
+	c.Check(routes["synth"].ToAsset, Equals, "BNB/BNB")
+
+	// only the layer1 route can be sent to the bnb destination
+	c.Check(routes["layer1"].GetMemo(), Equals, fmt.Sprintf("=:BNB.BNB:%s:0/1/%d", destination, routes["layer1"].StreamingQuantity))
+	c.Check(routes["trade"].Memo, IsNil)
+	c.Check(routes["synth"].Memo, IsNil)
+
+	// native routes have no outbound delay
+	c.Check(routes["layer1"].OutboundDelayBlocks > 0, Equals, true)
+	c.Check(routes["trade"].OutboundDelayBlocks, Equals, int64(0))
+	c.Check(routes["synth"].OutboundDelayBlocks, Equals, int64(0))
+
+	// a zero interval disables streaming
+	q.Set(intervalParam, "0")
+	req = abci.RequestQuery{Data: []byte("/mayachain/quote/swap/routes?" + q.Encode())}
+	res, err = s.querier(s.ctx, []string{query.QueryQuoteSwapRoutes.Key}, req)
+	c.Assert(err, IsNil)
+	var plain openapi.QuoteSwapRoutesResponse
+	c.Assert(json.Unmarshal(res, &plain), IsNil)
+	c.Assert(plain.Routes, HasLen, 3)
+	for _, route := range plain.Routes {
+		c.Check(route.StreamingQuantity, Equals, int64(0))
+		c.Check(route.StreamingInterval, Equals, int64(0))
+		c.Check(cosmos.NewUintFromString(route.ExpectedAmountOut).LT(cosmos.NewUintFromString(routes[route.Route].ExpectedAmountOut)), Equals, true)
+	}
+
+	// a target without a pool has no route
+	q.Set(toAssetParam, "BTC.BTC")
+	req = abci.RequestQuery{Data: []byte("/mayachain/quote/swap/routes?" + q.Encode())}
+	res, err = s.querier(s.ctx, []string{query.QueryQuoteSwapRoutes.Key}, req)
+	c.Assert(err, IsNil)
+	c.Check(strings.Contains(string(res), "layer1 route"), Equals, true)
+}
+
+func (s *QuerierSuite) TestQueryNodeSlashes(c *C) {
+	na := GetRandomValidatorNode(NodeActive)
+	c.Assert(s.k.SetNodeAccount(s.ctx, na), IsNil)
+	vault := GetRandomVault()
+	vault.BlockHeight = 100
+	c.Assert(s.k.SetVault(s.ctx, vault), IsNil)
+	withReason := func(ctx cosmos.Context, reason string) cosmos.Context {
+		return ctx.WithContext(context.WithValue(ctx.Context(), constants.CtxMetricLabels, []metrics.Label{
+			telemetry.NewLabel("reason", reason),
+		}))
+	}
+
+	// slash points received before the last churn are not in the totals
+	ctx := s.ctx.WithBlockHeight(90)
+	c.Assert(s.k.IncNodeAccountSlashPoints(withReason(ctx, "not_signing"), na.NodeAddress, 5), IsNil)
+	ctx = s.ctx.WithBlockHeight(110)
+	c.Assert(s.k.IncNodeAccountSlashPoints(withReason(ctx, "not_observing"), na.NodeAddress, 2), IsNil)
+	c.Assert(s.k.IncNodeAccountSlashPoints(withReason(ctx, "failed_keysign"), na.NodeAddress, 3), IsNil)
+	ctx = s.ctx.WithBlockHeight(111)
+	c.Assert(s.k.IncNodeAccountSlashPoints(withReason(ctx, "not_observing"), na.NodeAddress, 2), IsNil)
+
+	path := []string{query.QueryNodeSlashes.Key, na.NodeAddress.String()}
+	result, err := s.querier(ctx, path, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var slashes openapi.NodeSlashes
+	c.Assert(json.Unmarshal(result, &slashes), IsNil)
+	c.Check(slashes.NodeAddress, Equals, na.NodeAddress.String())
+	c.Check(slashes.SlashPoints, Equals, int64(12))
+	c.Check(slashes.ChurnCycleStartHeight, Equals, int64(100))
+	c.Assert(slashes.Totals, HasLen, 2)
+	c.Check(slashes.Totals[0].Reason, Equals, "failed_keysign")
+	c.Check(slashes.Totals[0].Points, Equals, int64(3))
+	c.Check(slashes.Totals[1].Reason, Equals, "not_observing")
+	c.Check(slashes.Totals[1].Points, Equals, int64(4))
+	c.Assert(slashes.Entries, HasLen, 4)
+	c.Check(slashes.Entries[0].Height, Equals, int64(90))
+
+	_, err = s.querier(ctx, []string{query.QueryNodeSlashes.Key, "bogus"}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+}
+
+func (s *QuerierSuite) TestQueryChurnPreview(c *C) {
+	addNode := func(status NodeStatus) NodeAccount {
+		na := GetRandomValidatorNode(status)
+		bp := NewBondProviders(na.NodeAddress)
+		acc, err := na.BondAddress.AccAddress()
+		c.Assert(err, IsNil)
+		bp.Providers = append(bp.Providers, NewBondProvider(acc))
+		bp.Providers[0].Bonded = true
+		SetupLiquidityBondForTest(c, s.ctx, s.k, common.BNBAsset, na.BondAddress, na, cosmos.NewUint(100*common.One))
+		c.Assert(s.k.SetBondProviders(s.ctx, bp), IsNil)
+		c.Assert(s.k.SetNodeAccount(s.ctx, na), IsNil)
+		return na
+	}
+	leaving := addNode(NodeActive)
+	// the leave handler scores the node when it requests to leave
+	leaving.RequestedToLeave = true
+	leaving.LeaveScore = 1
+	c.Assert(s.k.SetNodeAccount(s.ctx, leaving), IsNil)
+	for i := 0; i < 3; i++ {
+		addNode(NodeActive)
+	}
+	joining := addNode(NodeStandby)
+
+	result, err := s.querier(s.ctx, []string{query.QueryChurnPreview.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var preview openapi.ChurnPreview
+	c.Assert(json.Unmarshal(result, &preview), IsNil)
+	c.Check(preview.Rotation, Equals, true)
+	c.Assert(preview.ChurnOut, HasLen, 1)
+	c.Check(preview.ChurnOut[0].NodeAddress, Equals, leaving.NodeAddress.String())
+	c.Check(preview.ChurnOut[0].Status, Equals, NodeActive.String())
+	c.Check(preview.ChurnOut[0].GetReason(), Equals, "requested_to_leave")
+	c.Assert(preview.ChurnIn, HasLen, 1)
+	c.Check(preview.ChurnIn[0].NodeAddress, Equals, joining.NodeAddress.String())
+	c.Check(preview.ChurnIn[0].Status, Equals, NodeStandby.String())
+	c.Assert(preview.Asgards, HasLen, 1)
+	c.Check(preview.Asgards[0].Members, HasLen, 4)
+
+	// the preview is not committed
+	na, err := s.k.GetNodeAccount(s.ctx, joining.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(na.Status, Equals, NodeStandby)
+}
+
+func (s *QuerierSuite) TestQueryPOLPreview(c *C) {
+	pool := NewPool()
+	pool.Asset = common.BTCAsset
+	pool.Status = PoolAvailable
+	pool.BalanceCacao = cosmos.NewUint(1000 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(10 * common.One)
+	pool.LPUnits = cosmos.NewUint(1000)
+	c.Assert(s.k.SetPool(s.ctx, pool), IsNil)
+
+	// pools without POL enabled are not listed
+	result, err := s.querier(s.ctx, []string{query.QueryPOLPreview.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var preview []openapi.POLPoolPreview
+	c.Assert(json.Unmarshal(result, &preview), IsNil)
+	c.Check(preview, HasLen, 0)
+
+	s.k.SetMimir(s.ctx, "POL-BTC-BTC", 1)
+	result, err = s.querier(s.ctx, []string{query.QueryPOLPreview.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	c.Assert(json.Unmarshal(result, &preview), IsNil)
+	c.Assert(preview, HasLen, 1)
+	c.Check(preview[0].Asset, Equals, "BTC.BTC")
+	c.Check(preview[0].Action, Equals, POLActionNone)
+	c.Check(preview[0].Reason, Equals, "POL is disabled")
+	c.Check(preview[0].NextCycleHeight, Equals, s.ctx.BlockHeight()+1)
+
+	// the pool target takes precedence over the network wide target
+	s.k.SetMimir(s.ctx, constants.POLSynthUtilization.String(), 1000)
+	s.k.SetMimir(s.ctx, "POLSynthUtilization-BTC-BTC", 2500)
+	result, err = s.querier(s.ctx, []string{query.QueryPOLPreview.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	c.Assert(json.Unmarshal(result, &preview), IsNil)
+	c.Assert(preview, HasLen, 1)
+	c.Check(preview[0].TargetSynthUtilization, Equals, "2500")
+	c.Check(preview[0].SynthUtilization, Equals, "0")
+	c.Check(preview[0].Reason, Equals, "no POL liquidity in pool")
+}
+
+func (s *QuerierSuite) TestQueryPOLPools(c *C) {
+	polAddress, err := s.k.GetModuleAddress(ReserveName)
+	c.Assert(err, IsNil)
+
+	pool := NewPool()
+	pool.Asset = common.BTCAsset
+	pool.Status = PoolAvailable
+	pool.BalanceCacao = cosmos.NewUint(1000 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(10 * common.One)
+	pool.LPUnits = cosmos.NewUint(1000 * common.One)
+	c.Assert(s.k.SetPool(s.ctx, pool), IsNil)
+
+	// the POL has no liquidity in the pool
+	result, err := s.querier(s.ctx, []string{query.QueryPOLPools.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var pools []openapi.POLPool
+	c.Assert(json.Unmarshal(result, &pools), IsNil)
+	c.Check(pools, HasLen, 0)
+
+	// a POL position without ledger is valued at its deposit value
+	s.k.SetLiquidityProvider(s.ctx, LiquidityProvider{
+		Asset:             common.BTCAsset,
+		CacaoAddress:      polAddress,
+		Units:             cosmos.NewUint(100 * common.One),
+		CacaoDepositValue: cosmos.NewUint(90 * common.One),
+		AssetDepositValue: cosmos.NewUint(common.One),
+		PendingCacao:      cosmos.ZeroUint(),
+		PendingAsset:      cosmos.ZeroUint(),
+		LastAddHeight:     5,
+	})
+	result, err = s.querier(s.ctx, []string{query.QueryPOLPools.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	c.Assert(json.Unmarshal(result, &pools), IsNil)
+	c.Assert(pools, HasLen, 1)
+	c.Check(pools[0].Asset, Equals, "BTC.BTC")
+	c.Check(pools[0].Height, Equals, s.ctx.BlockHeight())
+	c.Check(pools[0].EntryValue, Equals, "18000000000")
+	c.Check(pools[0].Value, Equals, "20000000000")
+	c.Check(pools[0].RealizedPnl, Equals, "0")
+	c.Check(pools[0].UnrealizedPnl, Equals, "2000000000")
+	c.Check(pools[0].Pnl, Equals, "2000000000")
+	c.Check(pools[0].GetLastMovementHeight(), Equals, int64(5))
+
+	// history
+	ledger := NewPOLPool(common.BTCAsset)
+	ledger.Deposit(cosmos.NewUint(100*common.One), cosmos.NewUint(100*common.One), 5)
+	ledger.Withdraw(cosmos.NewUint(60*common.One), cosmos.NewUint(50*common.One), 8)
+	s.k.SetPOLPoolSnapshot(s.ctx, POLPoolSnapshot{Pool: ledger, Height: 20, Value: cosmos.NewUint(40 * common.One)})
+	s.k.SetPOLPoolSnapshot(s.ctx, POLPoolSnapshot{Pool: ledger, Height: 10, Value: cosmos.NewUint(55 * common.One)})
+	result, err = s.querier(s.ctx, []string{query.QueryPOLPoolHistory.Key, "BTC.BTC"}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var history []openapi.POLPool
+	c.Assert(json.Unmarshal(result, &history), IsNil)
+	c.Assert(history, HasLen, 2)
+	c.Check(history[0].Height, Equals, int64(10))
+	c.Check(history[0].RealizedPnl, Equals, "1000000000")
+	c.Check(history[0].UnrealizedPnl, Equals, "500000000")
+	c.Check(history[0].Pnl, Equals, "1500000000")
+	c.Check(history[1].Height, Equals, int64(20))
+	c.Check(history[1].UnrealizedPnl, Equals, "-1000000000")
+
+	_, err = s.querier(s.ctx, []string{query.QueryPOLPoolHistory.Key}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+}
@@ -33,67 +33,98 @@ func (q Query) Path(args ...string) string {
 
 // query endpoints supported by the thorchain Querier
 var (
-	QueryPool                 = Query{Key: "pool", EndpointTemplate: "/%s/pool/{%s}"}
-	QueryPools                = Query{Key: "pools", EndpointTemplate: "/%s/pools"}
-	QueryLiquidityProviders   = Query{Key: "lps", EndpointTemplate: "/%s/pool/{%s}/liquidity_providers"}
-	QueryLiquidityProvider    = Query{Key: "lp", EndpointTemplate: "/%s/pool/{%s}/liquidity_provider/{%s}"}
-	QuerySavers               = Query{Key: "savers", EndpointTemplate: "/%s/pool/{%s}/savers"}
-	QuerySaver                = Query{Key: "saver", EndpointTemplate: "/%s/pool/{%s}/saver/{%s}"}
-	QueryTx                   = Query{Key: "tx", EndpointTemplate: "/%s/tx/{%s}"}
-	QueryTradeUnit            = Query{Key: "tradeunit", EndpointTemplate: "/%s/trade/unit/{%s}"}
-	QueryTradeUnits           = Query{Key: "tradeunits", EndpointTemplate: "/%s/trade/units"}
-	QueryTradeAccount         = Query{Key: "tradeaccount", EndpointTemplate: "/%s/trade/account/{%s}"}
-	QueryTradeAccounts        = Query{Key: "tradeaccounts", EndpointTemplate: "/%s/trade/accounts/{%s}"}
-	QueryTxVoterOld           = Query{Key: "txvoterold", EndpointTemplate: "/%s/tx/{%s}/signers"}
-	QueryTxVoter              = Query{Key: "txvoter", EndpointTemplate: "/%s/tx/details/{%s}"}
-	QueryTxInVoter            = Query{Key: "txinvoter", EndpointTemplate: "/%s/tx/in/{%s}"}
-	QueryTxOutVoter           = Query{Key: "txoutvoter", EndpointTemplate: "/%s/tx/out/{%s}"}
-	QueryTxStages             = Query{Key: "txstages", EndpointTemplate: "/%s/tx/stages/{%s}"}
-	QueryTxStatus             = Query{Key: "txstatus", EndpointTemplate: "/%s/tx/status/{%s}"}
-	QueryKeysignArray         = Query{Key: "keysign", EndpointTemplate: "/%s/keysign/{%s}"}
-	QueryKeysignArrayPubkey   = Query{Key: "keysignpubkey", EndpointTemplate: "/%s/keysign/{%s}/{%s}"}
-	QueryKeygensPubkey        = Query{Key: "keygenspubkey", EndpointTemplate: "/%s/keygen/{%s}/{%s}"}
-	QueryQueue                = Query{Key: "outqueue", EndpointTemplate: "/%s/queue"}
-	QueryHeights              = Query{Key: "heights", EndpointTemplate: "/%s/lastblock"}
-	QueryChainHeights         = Query{Key: "chainheights", EndpointTemplate: "/%s/lastblock/{%s}"}
-	QueryNodes                = Query{Key: "nodes", EndpointTemplate: "/%s/nodes"}
-	QueryNode                 = Query{Key: "node", EndpointTemplate: "/%s/node/{%s}"}
-	QueryInboundAddresses     = Query{Key: "inboundaddresses", EndpointTemplate: "/%s/inbound_addresses"}
-	QueryNetwork              = Query{Key: "network", EndpointTemplate: "/%s/network"}
-	QueryPOL                  = Query{Key: "pol", EndpointTemplate: "/%s/pol"}
-	QueryStreamingSwap        = Query{Key: "streamingswap", EndpointTemplate: "/%s/swap/streaming/{%s}"}
-	QueryStreamingSwaps       = Query{Key: "streamingswaps", EndpointTemplate: "/%s/swaps/streaming"}
-	QueryBalanceModule        = Query{Key: "balancemodule", EndpointTemplate: "/%s/balance/module/{%s}"}
-	QueryVaultsAsgard         = Query{Key: "vaultsasgard", EndpointTemplate: "/%s/vaults/asgard"}
-	QueryVaultsYggdrasil      = Query{Key: "vaultsyggdrasil", EndpointTemplate: "/%s/vaults/yggdrasil"}
-	QueryVault                = Query{Key: "vault", EndpointTemplate: "/%s/vault/{%s}"}
-	QueryVaultPubkeys         = Query{Key: "vaultpubkeys", EndpointTemplate: "/%s/vaults/pubkeys"}
-	QueryConstantValues       = Query{Key: "constants", EndpointTemplate: "/%s/constants"}
-	QueryVersion              = Query{Key: "version", EndpointTemplate: "/%s/version"}
-	QueryMimirValues          = Query{Key: "mimirs", EndpointTemplate: "/%s/mimir"}
-	QueryMimirWithKey         = Query{Key: "mimirwithkey", EndpointTemplate: "/%s/mimir/key/{%s}"}
-	QueryMimirAdminValues     = Query{Key: "adminmimirs", EndpointTemplate: "/%s/mimir/admin"}
-	QueryMimirNodesValues     = Query{Key: "nodesmimirs", EndpointTemplate: "/%s/mimir/nodes"}
-	QueryMimirNodesAllValues  = Query{Key: "nodesmimirsall", EndpointTemplate: "/%s/mimir/nodes_all"}
-	QueryMimirNodeValues      = Query{Key: "nodemimirs", EndpointTemplate: "/%s/mimir/node/{%s}"}
-	QueryBan                  = Query{Key: "ban", EndpointTemplate: "/%s/ban/{%s}"}
-	QueryRagnarok             = Query{Key: "ragnarok", EndpointTemplate: "/%s/ragnarok"}
-	QueryCACAOPool            = Query{Key: "cacaopool", EndpointTemplate: "/%s/cacaopool"}
-	QueryCACAOProviders       = Query{Key: "cacaoproviders", EndpointTemplate: "/%s/cacao_providers"}
-	QueryCACAOProvider        = Query{Key: "cacaoprovider", EndpointTemplate: "/%s/cacao_provider/{%s}"}
-	QueryPendingOutbound      = Query{Key: "pendingoutbound", EndpointTemplate: "/%s/queue/outbound"}
-	QueryScheduledOutbound    = Query{Key: "scheduledoutbound", EndpointTemplate: "/%s/queue/scheduled"}
-	QuerySwapQueue            = Query{Key: "swapqueue", EndpointTemplate: "/%s/queue/swap"}
-	QueryTssKeygenMetrics     = Query{Key: "tss_keygen_metric", EndpointTemplate: "/%s/metric/keygen/{%s}"}
-	QueryTssMetrics           = Query{Key: "tss_metric", EndpointTemplate: "/%s/metrics"}
-	QueryMAYAName             = Query{Key: "mayaname", EndpointTemplate: "/%s/mayaname/{%s}"}
-	QueryLiquidityAuctionTier = Query{Key: "la_tier", EndpointTemplate: "/%s/liquidity_auction_tier/{%s}/{%s}"}
-	QueryQuoteSwap            = Query{Key: "quoteswap", EndpointTemplate: "/%s/quote/swap"}
-	QueryQuoteSaverDeposit    = Query{Key: "quotesaverdeposit", EndpointTemplate: "/%s/quote/saver/deposit"}
-	QueryQuoteSaverWithdraw   = Query{Key: "quotesaverwithdraw", EndpointTemplate: "/%s/quote/saver/withdraw"}
-	QueryBlock                = Query{Key: "block", EndpointTemplate: "/%s/block"}
-	QueryInvariants           = Query{Key: "invariants", EndpointTemplate: "/%s/invariants"}
-	QueryInvariant            = Query{Key: "invariant", EndpointTemplate: "/%s/invariant/{%s}"}
+	QueryPool                   = Query{Key: "pool", EndpointTemplate: "/%s/pool/{%s}"}
+	QueryPools                  = Query{Key: "pools", EndpointTemplate: "/%s/pools"}
+	QueryLiquidityProviders     = Query{Key: "lps", EndpointTemplate: "/%s/pool/{%s}/liquidity_providers"}
+	QueryLiquidityProvider      = Query{Key: "lp", EndpointTemplate: "/%s/pool/{%s}/liquidity_provider/{%s}"}
+	QuerySavers                 = Query{Key: "savers", EndpointTemplate: "/%s/pool/{%s}/savers"}
+	QuerySaver                  = Query{Key: "saver", EndpointTemplate: "/%s/pool/{%s}/saver/{%s}"}
+	QueryTx                     = Query{Key: "tx", EndpointTemplate: "/%s/tx/{%s}"}
+	QueryTradeUnit              = Query{Key: "tradeunit", EndpointTemplate: "/%s/trade/unit/{%s}"}
+	QueryTradeUnits             = Query{Key: "tradeunits", EndpointTemplate: "/%s/trade/units"}
+	QueryTradeAccount           = Query{Key: "tradeaccount", EndpointTemplate: "/%s/trade/account/{%s}"}
+	QueryTradeAccounts          = Query{Key: "tradeaccounts", EndpointTemplate: "/%s/trade/accounts/{%s}"}
+	QueryTxVoterOld             = Query{Key: "txvoterold", EndpointTemplate: "/%s/tx/{%s}/signers"}
+	QueryTxVoter                = Query{Key: "txvoter", EndpointTemplate: "/%s/tx/details/{%s}"}
+	QueryTxInVoter              = Query{Key: "txinvoter", EndpointTemplate: "/%s/tx/in/{%s}"}
+	QueryTxOutVoter             = Query{Key: "txoutvoter", EndpointTemplate: "/%s/tx/out/{%s}"}
+	QueryTxStages               = Query{Key: "txstages", EndpointTemplate: "/%s/tx/stages/{%s}"}
+	QueryTxStatus               = Query{Key: "txstatus", EndpointTemplate: "/%s/tx/status/{%s}"}
+	QueryKeysignArray           = Query{Key: "keysign", EndpointTemplate: "/%s/keysign/{%s}"}
+	QueryKeysignArrayPubkey     = Query{Key: "keysignpubkey", EndpointTemplate: "/%s/keysign/{%s}/{%s}"}
+	QueryKeygensPubkey          = Query{Key: "keygenspubkey", EndpointTemplate: "/%s/keygen/{%s}/{%s}"}
+	QueryQueue                  = Query{Key: "outqueue", EndpointTemplate: "/%s/queue"}
+	QueryHeights                = Query{Key: "heights", EndpointTemplate: "/%s/lastblock"}
+	QueryChainHeights           = Query{Key: "chainheights", EndpointTemplate: "/%s/lastblock/{%s}"}
+	QueryNodes                  = Query{Key: "nodes", EndpointTemplate: "/%s/nodes"}
+	QueryNode                   = Query{Key: "node", EndpointTemplate: "/%s/node/{%s}"}
+	QueryNodeSlashes            = Query{Key: "nodeslashes", EndpointTemplate: "/%s/node/{%s}/slashes"}
+	QueryChurnPreview           = Query{Key: "churnpreview", EndpointTemplate: "/%s/churn/preview"}
+	QueryInboundAddresses       = Query{Key: "inboundaddresses", EndpointTemplate: "/%s/inbound_addresses"}
+	QueryNetwork                = Query{Key: "network", EndpointTemplate: "/%s/network"}
+	QueryPOL                    = Query{Key: "pol", EndpointTemplate: "/%s/pol"}
+	QueryPOLPreview             = Query{Key: "polpreview", EndpointTemplate: "/%s/pol/preview"}
+	QueryPOLPools               = Query{Key: "polpools", EndpointTemplate: "/%s/pol/pools"}
+	QueryPOLPoolHistory         = Query{Key: "polpoolhistory", EndpointTemplate: "/%s/pol/pool/{%s}/history"}
+	QueryStreamingSwap          = Query{Key: "streamingswap", EndpointTemplate: "/%s/swap/streaming/{%s}"}
+	QueryStreamingSwaps         = Query{Key: "streamingswaps", EndpointTemplate: "/%s/swaps/streaming"}
+	QueryOrderBooks             = Query{Key: "orderbooks", EndpointTemplate: "/%s/orderbook"}
+	QueryOrderBook              = Query{Key: "orderbook", EndpointTemplate: "/%s/orderbook/{%s}/{%s}"}
+	QueryOrderBookOrder         = Query{Key: "orderbookorder", EndpointTemplate: "/%s/orderbook/order/{%s}"}
+	QueryDCAOrders              = Query{Key: "dcaorders", EndpointTemplate: "/%s/dca/orders"}
+	QueryDCAOrder               = Query{Key: "dcaorder", EndpointTemplate: "/%s/dca/order/{%s}"}
+	QueryLoans                  = Query{Key: "loans", EndpointTemplate: "/%s/loans"}
+	QueryOwnerLoans             = Query{Key: "ownerloans", EndpointTemplate: "/%s/loans/{%s}"}
+	QueryAffiliateCollectors    = Query{Key: "affiliatecollectors", EndpointTemplate: "/%s/affiliate_collectors"}
+	QueryAffiliateCollector     = Query{Key: "affiliatecollector", EndpointTemplate: "/%s/affiliate_collector/{%s}"}
+	QueryPortfolio              = Query{Key: "portfolio", EndpointTemplate: "/%s/portfolio/{%s}"}
+	QueryBalanceModule          = Query{Key: "balancemodule", EndpointTemplate: "/%s/balance/module/{%s}"}
+	QueryVaultsAsgard           = Query{Key: "vaultsasgard", EndpointTemplate: "/%s/vaults/asgard"}
+	QueryVaultsYggdrasil        = Query{Key: "vaultsyggdrasil", EndpointTemplate: "/%s/vaults/yggdrasil"}
+	QueryVault                  = Query{Key: "vault", EndpointTemplate: "/%s/vault/{%s}"}
+	QueryVaultMigration         = Query{Key: "vaultmigration", EndpointTemplate: "/%s/vault/{%s}/migration"}
+	QueryVaultSolvency          = Query{Key: "vaultsolvency", EndpointTemplate: "/%s/vault/{%s}/solvency/{%s}"}
+	QueryVaultPubkeys           = Query{Key: "vaultpubkeys", EndpointTemplate: "/%s/vaults/pubkeys"}
+	QueryConstantValues         = Query{Key: "constants", EndpointTemplate: "/%s/constants"}
+	QueryVersion                = Query{Key: "version", EndpointTemplate: "/%s/version"}
+	QueryMimirValues            = Query{Key: "mimirs", EndpointTemplate: "/%s/mimir"}
+	QueryMimirWithKey           = Query{Key: "mimirwithkey", EndpointTemplate: "/%s/mimir/key/{%s}"}
+	QueryMimirAdminValues       = Query{Key: "adminmimirs", EndpointTemplate: "/%s/mimir/admin"}
+	QueryMimirNodesValues       = Query{Key: "nodesmimirs", EndpointTemplate: "/%s/mimir/nodes"}
+	QueryMimirNodesAllValues    = Query{Key: "nodesmimirsall", EndpointTemplate: "/%s/mimir/nodes_all"}
+	QueryMimirNodeValues        = Query{Key: "nodemimirs", EndpointTemplate: "/%s/mimir/node/{%s}"}
+	QueryBan                    = Query{Key: "ban", EndpointTemplate: "/%s/ban/{%s}"}
+	QueryRagnarok               = Query{Key: "ragnarok", EndpointTemplate: "/%s/ragnarok"}
+	QueryCACAOPool              = Query{Key: "cacaopool", EndpointTemplate: "/%s/cacaopool"}
+	QueryCACAOProviders         = Query{Key: "cacaoproviders", EndpointTemplate: "/%s/cacao_providers"}
+	QueryCACAOProvider          = Query{Key: "cacaoprovider", EndpointTemplate: "/%s/cacao_provider/{%s}"}
+	QueryPendingOutbound        = Query{Key: "pendingoutbound", EndpointTemplate: "/%s/queue/outbound"}
+	QueryScheduledOutbound      = Query{Key: "scheduledoutbound", EndpointTemplate: "/%s/queue/scheduled"}
+	QuerySwapQueue              = Query{Key: "swapqueue", EndpointTemplate: "/%s/queue/swap"}
+	QuerySwapQueuePage          = Query{Key: "swapqueuepage", EndpointTemplate: "/%s/queue/swap/page"}
+	QueryPendingOutboundPage    = Query{Key: "pendingoutboundpage", EndpointTemplate: "/%s/queue/outbound/page"}
+	QueryScheduledOutboundPage  = Query{Key: "scheduledoutboundpage", EndpointTemplate: "/%s/queue/scheduled/page"}
+	QueryTssKeygenMetrics       = Query{Key: "tss_keygen_metric", EndpointTemplate: "/%s/metric/keygen/{%s}"}
+	QueryTssMetrics             = Query{Key: "tss_metric", EndpointTemplate: "/%s/metrics"}
+	QueryMAYAName               = Query{Key: "mayaname", EndpointTemplate: "/%s/mayaname/{%s}"}
+	QueryMAYANamesByOwner       = Query{Key: "mayanamesbyowner", EndpointTemplate: "/%s/mayaname/owner/{%s}"}
+	QueryMAYANamesByAddress     = Query{Key: "mayanamesbyaddress", EndpointTemplate: "/%s/mayaname/address/{%s}/{%s}"}
+	QueryMAYANameListings       = Query{Key: "mayanamelistings", EndpointTemplate: "/%s/mayaname_listings"}
+	QueryMAYANameListing        = Query{Key: "mayanamelisting", EndpointTemplate: "/%s/mayaname_listing/{%s}"}
+	QueryLiquidityAuctionTier   = Query{Key: "la_tier", EndpointTemplate: "/%s/liquidity_auction_tier/{%s}/{%s}"}
+	QueryQuoteSwap              = Query{Key: "quoteswap", EndpointTemplate: "/%s/quote/swap"}
+	QueryQuoteSwapRoutes        = Query{Key: "quoteswaproutes", EndpointTemplate: "/%s/quote/swap/routes"}
+	QueryQuoteSaverDeposit      = Query{Key: "quotesaverdeposit", EndpointTemplate: "/%s/quote/saver/deposit"}
+	QueryQuoteSaverWithdraw     = Query{Key: "quotesaverwithdraw", EndpointTemplate: "/%s/quote/saver/withdraw"}
+	QueryQuoteLiquidityAdd      = Query{Key: "quoteliquidityadd", EndpointTemplate: "/%s/quote/liquidity/add"}
+	QueryQuoteLiquidityWithdraw = Query{Key: "quoteliquiditywithdraw", EndpointTemplate: "/%s/quote/liquidity/withdraw"}
+	QueryQuoteTradeDeposit      = Query{Key: "quotetradedeposit", EndpointTemplate: "/%s/quote/trade/deposit"}
+	QueryQuoteTradeWithdraw     = Query{Key: "quotetradewithdraw", EndpointTemplate: "/%s/quote/trade/withdraw"}
+	QueryQuoteCacaoPoolDeposit  = Query{Key: "quotecacaopooldeposit", EndpointTemplate: "/%s/quote/cacaopool/deposit"}
+	QueryQuoteCacaoPoolWithdraw = Query{Key: "quotecacaopoolwithdraw", EndpointTemplate: "/%s/quote/cacaopool/withdraw"}
+	QueryBlock                  = Query{Key: "block", EndpointTemplate: "/%s/block"}
+	QueryInvariants             = Query{Key: "invariants", EndpointTemplate: "/%s/invariants"}
+	QueryInvariant              = Query{Key: "invariant", EndpointTemplate: "/%s/invariant/{%s}"}
 
 	// queries only available on regtest builds
 	QueryExport = Query{Key: "export", EndpointTemplate: "/%s/export"}
@@ -124,17 +155,34 @@ var Queries = []Query{
 	QueryHeights,
 	QueryChainHeights,
 	QueryNode,
+	QueryNodeSlashes,
 	QueryNodes,
+	QueryChurnPreview,
 	QueryInboundAddresses,
 	QueryNetwork,
 	QueryPOL,
+	QueryPOLPreview,
+	QueryPOLPools,
+	QueryPOLPoolHistory,
 	QueryStreamingSwap,
 	QueryStreamingSwaps,
+	QueryOrderBooks,
+	QueryOrderBookOrder, // must be registered before QueryOrderBook, both match "/orderbook/x/y"
+	QueryOrderBook,
+	QueryDCAOrders,
+	QueryDCAOrder,
+	QueryLoans,
+	QueryOwnerLoans,
+	QueryAffiliateCollectors,
+	QueryAffiliateCollector,
+	QueryPortfolio,
 	QueryBalanceModule,
 	QueryVaultsAsgard,
 	QueryVaultsYggdrasil,
 	QueryVaultPubkeys,
 	QueryVault,
+	QueryVaultMigration,
+	QueryVaultSolvency,
 	QueryKeygensPubkey,
 	QueryConstantValues,
 	QueryVersion,
@@ -152,13 +200,27 @@ var Queries = []Query{
 	QueryPendingOutbound,
 	QueryScheduledOutbound,
 	QuerySwapQueue,
+	QuerySwapQueuePage,
+	QueryPendingOutboundPage,
+	QueryScheduledOutboundPage,
 	QueryTssMetrics,
 	QueryTssKeygenMetrics,
 	QueryMAYAName,
+	QueryMAYANamesByOwner,
+	QueryMAYANamesByAddress,
+	QueryMAYANameListings,
+	QueryMAYANameListing,
 	QueryLiquidityAuctionTier,
 	QueryQuoteSwap,
+	QueryQuoteSwapRoutes,
 	QueryQuoteSaverDeposit,
 	QueryQuoteSaverWithdraw,
+	QueryQuoteLiquidityAdd,
+	QueryQuoteLiquidityWithdraw,
+	QueryQuoteTradeDeposit,
+	QueryQuoteTradeWithdraw,
+	QueryQuoteCacaoPoolDeposit,
+	QueryQuoteCacaoPoolWithdraw,
 	QueryBlock,
 	QueryInvariants,
 	QueryInvariant,
@@ -15,4 +15,6 @@ var _ = Suite(&QuerySuite{})
 func (s QuerySuite) TestQuery(c *C) {
 	c.Check(QueryTx.Endpoint("foo", "bar"), Equals, "/foo/tx/{bar}")
 	c.Check(QueryTx.Path("foo", "bar"), Equals, "custom/foo/tx/bar")
+	c.Check(QueryOrderBook.Endpoint("foo", "bar", "baz"), Equals, "/foo/orderbook/{bar}/{baz}")
+	c.Check(QueryOrderBook.Path("foo", "bar", "baz"), Equals, "custom/foo/orderbook/bar/baz")
 }
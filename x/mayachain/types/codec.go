@@ -51,6 +51,17 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgManageMAYAName{}, "mayachain/MsgManageMAYAName", nil)
 	cdc.RegisterConcrete(&MsgTradeAccountDeposit{}, "mayachain/MsgTradeAccountDeposit", nil)
 	cdc.RegisterConcrete(&MsgTradeAccountWithdrawal{}, "mayachain/MsgTradeAccountWithdrawal", nil)
+	cdc.RegisterConcrete(&MsgTradeAccountTransfer{}, "mayachain/MsgTradeAccountTransfer", nil)
+	cdc.RegisterConcrete(&MsgLoanOpen{}, "mayachain/MsgLoanOpen", nil)
+	cdc.RegisterConcrete(&MsgLoanRepayment{}, "mayachain/MsgLoanRepayment", nil)
+	cdc.RegisterConcrete(&MsgAffiliateClaim{}, "mayachain/MsgAffiliateClaim", nil)
+	cdc.RegisterConcrete(&MsgMAYANameList{}, "mayachain/MsgMAYANameList", nil)
+	cdc.RegisterConcrete(&MsgMAYANameDelist{}, "mayachain/MsgMAYANameDelist", nil)
+	cdc.RegisterConcrete(&MsgMAYANameBuy{}, "mayachain/MsgMAYANameBuy", nil)
+	cdc.RegisterConcrete(&MsgBondProviderWhitelist{}, "mayachain/MsgBondProviderWhitelist", nil)
+	cdc.RegisterConcrete(&MsgBondProviderRemove{}, "mayachain/MsgBondProviderRemove", nil)
+	cdc.RegisterConcrete(&MsgNodeOperatorFee{}, "mayachain/MsgNodeOperatorFee", nil)
+	cdc.RegisterConcrete(&MsgBondProviderTransfer{}, "mayachain/MsgBondProviderTransfer", nil)
 }
 
 // RegisterInterfaces register the types
@@ -88,4 +99,15 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*cosmos.Msg)(nil), &MsgSolvency{})
 	registry.RegisterImplementations((*cosmos.Msg)(nil), &MsgTradeAccountDeposit{})
 	registry.RegisterImplementations((*cosmos.Msg)(nil), &MsgTradeAccountWithdrawal{})
+	registry.RegisterImplementations((*cosmos.Msg)(nil), &MsgTradeAccountTransfer{})
+	registry.RegisterImplementations((*cosmos.Msg)(nil), &MsgLoanOpen{})
+	registry.RegisterImplementations((*cosmos.Msg)(nil), &MsgLoanRepayment{})
+	registry.RegisterImplementations((*cosmos.Msg)(nil), &MsgAffiliateClaim{})
+	registry.RegisterImplementations((*cosmos.Msg)(nil), &MsgMAYANameList{})
+	registry.RegisterImplementations((*cosmos.Msg)(nil), &MsgMAYANameDelist{})
+	registry.RegisterImplementations((*cosmos.Msg)(nil), &MsgMAYANameBuy{})
+	registry.RegisterImplementations((*cosmos.Msg)(nil), &MsgBondProviderWhitelist{})
+	registry.RegisterImplementations((*cosmos.Msg)(nil), &MsgBondProviderRemove{})
+	registry.RegisterImplementations((*cosmos.Msg)(nil), &MsgNodeOperatorFee{})
+	registry.RegisterImplementations((*cosmos.Msg)(nil), &MsgBondProviderTransfer{})
 }
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+)
+
+var _ cosmos.Msg = &MsgAffiliateClaim{}
+
+// NewMsgAffiliateClaim is a constructor function for MsgAffiliateClaim. An
+// empty asset claims into the preferred asset of the MAYAName.
+func NewMsgAffiliateClaim(name string, asset common.Asset, signer cosmos.AccAddress, tx common.Tx) *MsgAffiliateClaim {
+	return &MsgAffiliateClaim{
+		Tx:     tx,
+		Name:   name,
+		Asset:  asset,
+		Signer: signer,
+	}
+}
+
+// Route should return the route key of the module
+func (m *MsgAffiliateClaim) Route() string { return RouterKey }
+
+// Type should return the action
+func (m MsgAffiliateClaim) Type() string { return "affiliate_claim" }
+
+// ValidateBasic runs stateless checks on the message
+func (m *MsgAffiliateClaim) ValidateBasic() error {
+	if m.Name == "" {
+		return cosmos.ErrUnknownRequest("name cannot be empty")
+	}
+	if !m.Asset.IsEmpty() && (m.Asset.IsSyntheticAsset() || m.Asset.IsTradeAsset() || m.Asset.IsVaultAsset()) {
+		return cosmos.ErrUnknownRequest("asset must be a layer1 asset")
+	}
+	if !m.Tx.Coins.IsEmpty() {
+		return cosmos.ErrUnknownRequest("claim does not accept funds")
+	}
+	if m.Signer.Empty() {
+		return cosmos.ErrInvalidAddress(m.Signer.String())
+	}
+	if m.Tx.ID.IsEmpty() {
+		return cosmos.ErrUnknownRequest("txID cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (m *MsgAffiliateClaim) GetSignBytes() []byte {
+	return cosmos.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgAffiliateClaim) GetSigners() []cosmos.AccAddress {
+	return []cosmos.AccAddress{m.Signer}
+}
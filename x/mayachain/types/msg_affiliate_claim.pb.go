@@ -0,0 +1,485 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: mayachain/v1/x/mayachain/types/msg_affiliate_claim.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	common "gitlab.com/mayachain/mayanode/common"
+	gitlab_com_mayachain_mayanode_common "gitlab.com/mayachain/mayanode/common"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type MsgAffiliateClaim struct {
+	Tx     common.Tx                                     `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx"`
+	Name   string                                        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Asset  gitlab_com_mayachain_mayanode_common.Asset    `protobuf:"bytes,3,opt,name=asset,proto3,customtype=gitlab.com/mayachain/mayanode/common.Asset" json:"asset"`
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+}
+
+func (m *MsgAffiliateClaim) Reset()         { *m = MsgAffiliateClaim{} }
+func (m *MsgAffiliateClaim) String() string { return proto.CompactTextString(m) }
+func (*MsgAffiliateClaim) ProtoMessage()    {}
+func (*MsgAffiliateClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24c42c8eaa8c2f54, []int{0}
+}
+func (m *MsgAffiliateClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAffiliateClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAffiliateClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAffiliateClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAffiliateClaim.Merge(m, src)
+}
+func (m *MsgAffiliateClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAffiliateClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAffiliateClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAffiliateClaim proto.InternalMessageInfo
+
+func (m *MsgAffiliateClaim) GetTx() common.Tx {
+	if m != nil {
+		return m.Tx
+	}
+	return common.Tx{}
+}
+
+func (m *MsgAffiliateClaim) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgAffiliateClaim) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgAffiliateClaim)(nil), "types.MsgAffiliateClaim")
+}
+
+func init() {
+	proto.RegisterFile("mayachain/v1/x/mayachain/types/msg_affiliate_claim.proto", fileDescriptor_24c42c8eaa8c2f54)
+}
+
+var fileDescriptor_24c42c8eaa8c2f54 = []byte{
+	// 311 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xb2, 0xc8, 0x4d, 0xac, 0x4c,
+	0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x2f, 0x33, 0xd4, 0xaf, 0xd0, 0x47, 0x70, 0x4b, 0x2a, 0x0b,
+	0x52, 0x8b, 0xf5, 0x73, 0x8b, 0xd3, 0xe3, 0x13, 0xd3, 0xd2, 0x32, 0x73, 0x32, 0x13, 0x4b, 0x52,
+	0xe3, 0x93, 0x73, 0x12, 0x33, 0x73, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x58, 0xc1, 0x0a,
+	0xa4, 0x14, 0x50, 0x0c, 0x48, 0xce, 0xcf, 0xcd, 0xcd, 0xcf, 0x83, 0x52, 0x10, 0x85, 0x52, 0x22,
+	0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x11, 0x55, 0x7a, 0xcb, 0xc8, 0x25, 0xe8,
+	0x5b, 0x9c, 0xee, 0x08, 0x33, 0xdb, 0x19, 0x64, 0xb4, 0x90, 0x02, 0x17, 0x53, 0x49, 0x85, 0x04,
+	0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x11, 0x97, 0x1e, 0xd4, 0x98, 0x90, 0x0a, 0x27, 0x96, 0x13, 0xf7,
+	0xe4, 0x19, 0x82, 0x98, 0x4a, 0x2a, 0x84, 0x84, 0xb8, 0x58, 0xf2, 0x12, 0x73, 0x53, 0x25, 0x98,
+	0x14, 0x18, 0x35, 0x38, 0x83, 0xc0, 0x6c, 0xa1, 0x70, 0x2e, 0xd6, 0xc4, 0xe2, 0xe2, 0xd4, 0x12,
+	0x09, 0x66, 0xb0, 0x46, 0x5e, 0x98, 0x46, 0x47, 0x90, 0xa0, 0x93, 0x11, 0x48, 0xef, 0xad, 0x7b,
+	0xf2, 0x5a, 0xe9, 0x99, 0x25, 0x39, 0x89, 0x49, 0x20, 0x49, 0x24, 0x6f, 0x82, 0x58, 0x79, 0xf9,
+	0x29, 0xa9, 0xfa, 0xc8, 0x7a, 0x82, 0x20, 0xe6, 0x09, 0x79, 0x72, 0xb1, 0x15, 0x67, 0xa6, 0xe7,
+	0xa5, 0x16, 0x49, 0xb0, 0x28, 0x30, 0x6a, 0xf0, 0x38, 0x19, 0xfe, 0xba, 0x27, 0xaf, 0x9b, 0x9e,
+	0x59, 0x92, 0x51, 0x0a, 0x31, 0x26, 0x39, 0xbf, 0x38, 0x37, 0xbf, 0x18, 0x4a, 0xe9, 0x16, 0xa7,
+	0x64, 0x43, 0x42, 0x4d, 0xcf, 0x31, 0x39, 0xd9, 0x31, 0x25, 0xa5, 0x28, 0xb5, 0xb8, 0x38, 0x08,
+	0x6a, 0x80, 0x93, 0xe7, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7,
+	0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xe9, 0xe3,
+	0x77, 0x17, 0x46, 0x9c, 0x24, 0xb1, 0x81, 0x43, 0xd0, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x7f,
+	0x08, 0x9d, 0x8f, 0xbc, 0x01, 0x00, 0x00,
+}
+
+func (m *MsgAffiliateClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAffiliateClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAffiliateClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgAffiliateClaim(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Asset.Size()
+		i -= size
+		if _, err := m.Asset.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgAffiliateClaim(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMsgAffiliateClaim(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgAffiliateClaim(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgAffiliateClaim(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgAffiliateClaim(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAffiliateClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tx.Size()
+	n += 1 + l + sovMsgAffiliateClaim(uint64(l))
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMsgAffiliateClaim(uint64(l))
+	}
+	l = m.Asset.Size()
+	n += 1 + l + sovMsgAffiliateClaim(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgAffiliateClaim(uint64(l))
+	}
+	return n
+}
+
+func sovMsgAffiliateClaim(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgAffiliateClaim(x uint64) (n int) {
+	return sovMsgAffiliateClaim(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAffiliateClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgAffiliateClaim
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAffiliateClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAffiliateClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgAffiliateClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgAffiliateClaim
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgAffiliateClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgAffiliateClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgAffiliateClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgAffiliateClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgAffiliateClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgAffiliateClaim
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgAffiliateClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgAffiliateClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgAffiliateClaim
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgAffiliateClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgAffiliateClaim(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgAffiliateClaim
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgAffiliateClaim
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMsgAffiliateClaim(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMsgAffiliateClaim
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMsgAffiliateClaim
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMsgAffiliateClaim
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMsgAffiliateClaim
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMsgAffiliateClaim
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMsgAffiliateClaim
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMsgAffiliateClaim        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMsgAffiliateClaim          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMsgAffiliateClaim = fmt.Errorf("proto: unexpected end of group")
+)
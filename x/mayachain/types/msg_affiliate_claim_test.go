@@ -0,0 +1,43 @@
+package types
+
+import (
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	. "gopkg.in/check.v1"
+)
+
+type MsgAffiliateClaimSuite struct{}
+
+var _ = Suite(&MsgAffiliateClaimSuite{})
+
+func (MsgAffiliateClaimSuite) TestMsgAffiliateClaim(c *C) {
+	signer := GetRandomBech32Addr()
+	dummyTx := common.Tx{ID: "test"}
+
+	m := NewMsgAffiliateClaim("alice", common.EmptyAsset, signer, dummyTx)
+	EnsureMsgBasicCorrect(m, c)
+	c.Check(m.Type(), Equals, "affiliate_claim")
+
+	m = NewMsgAffiliateClaim("alice", common.BTCAsset, signer, dummyTx)
+	c.Check(m.ValidateBasic(), IsNil)
+
+	m = NewMsgAffiliateClaim("", common.BTCAsset, signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+
+	m = NewMsgAffiliateClaim("alice", common.BTCAsset.GetSyntheticAsset(), signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+
+	m = NewMsgAffiliateClaim("alice", common.BTCAsset.GetTradeAsset(), signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+
+	m = NewMsgAffiliateClaim("alice", common.EmptyAsset, cosmos.AccAddress{}, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+
+	m = NewMsgAffiliateClaim("alice", common.EmptyAsset, signer, common.Tx{})
+	c.Check(m.ValidateBasic(), NotNil)
+
+	// a claim doesn't take funds
+	tx := common.Tx{ID: "test", Coins: common.NewCoins(common.NewCoin(common.BaseNative, cosmos.NewUint(common.One)))}
+	m = NewMsgAffiliateClaim("alice", common.EmptyAsset, signer, tx)
+	c.Check(m.ValidateBasic(), NotNil)
+}
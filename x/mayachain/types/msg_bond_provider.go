@@ -0,0 +1,208 @@
+package types
+
+import (
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+)
+
+var (
+	_ cosmos.Msg = &MsgBondProviderWhitelist{}
+	_ cosmos.Msg = &MsgBondProviderRemove{}
+	_ cosmos.Msg = &MsgNodeOperatorFee{}
+	_ cosmos.Msg = &MsgBondProviderTransfer{}
+)
+
+// NewMsgBondProviderWhitelist is a constructor function for MsgBondProviderWhitelist
+func NewMsgBondProviderWhitelist(nodeAddr, providerAddr, signer cosmos.AccAddress, tx common.Tx) *MsgBondProviderWhitelist {
+	return &MsgBondProviderWhitelist{
+		Tx:              tx,
+		NodeAddress:     nodeAddr,
+		ProviderAddress: providerAddr,
+		Signer:          signer,
+	}
+}
+
+// Route should return the route key of the module
+func (m *MsgBondProviderWhitelist) Route() string { return RouterKey }
+
+// Type should return the action
+func (m MsgBondProviderWhitelist) Type() string { return "bond_provider_whitelist" }
+
+// ValidateBasic runs stateless checks on the message
+func (m *MsgBondProviderWhitelist) ValidateBasic() error {
+	if m.NodeAddress.Empty() {
+		return cosmos.ErrInvalidAddress("node address cannot be empty")
+	}
+	if m.ProviderAddress.Empty() {
+		return cosmos.ErrInvalidAddress("provider address cannot be empty")
+	}
+	if !m.Tx.Coins.IsEmpty() {
+		return cosmos.ErrUnknownRequest("whitelisting a bond provider does not accept funds")
+	}
+	if m.Signer.Empty() {
+		return cosmos.ErrInvalidAddress(m.Signer.String())
+	}
+	if m.Tx.ID.IsEmpty() {
+		return cosmos.ErrUnknownRequest("txID cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (m *MsgBondProviderWhitelist) GetSignBytes() []byte {
+	return cosmos.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgBondProviderWhitelist) GetSigners() []cosmos.AccAddress {
+	return []cosmos.AccAddress{m.Signer}
+}
+
+// NewMsgBondProviderRemove is a constructor function for MsgBondProviderRemove
+func NewMsgBondProviderRemove(nodeAddr, providerAddr, signer cosmos.AccAddress, tx common.Tx) *MsgBondProviderRemove {
+	return &MsgBondProviderRemove{
+		Tx:              tx,
+		NodeAddress:     nodeAddr,
+		ProviderAddress: providerAddr,
+		Signer:          signer,
+	}
+}
+
+// Route should return the route key of the module
+func (m *MsgBondProviderRemove) Route() string { return RouterKey }
+
+// Type should return the action
+func (m MsgBondProviderRemove) Type() string { return "bond_provider_remove" }
+
+// ValidateBasic runs stateless checks on the message
+func (m *MsgBondProviderRemove) ValidateBasic() error {
+	if m.NodeAddress.Empty() {
+		return cosmos.ErrInvalidAddress("node address cannot be empty")
+	}
+	if m.ProviderAddress.Empty() {
+		return cosmos.ErrInvalidAddress("provider address cannot be empty")
+	}
+	if !m.Tx.Coins.IsEmpty() {
+		return cosmos.ErrUnknownRequest("removing a bond provider does not accept funds")
+	}
+	if m.Signer.Empty() {
+		return cosmos.ErrInvalidAddress(m.Signer.String())
+	}
+	if m.Tx.ID.IsEmpty() {
+		return cosmos.ErrUnknownRequest("txID cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (m *MsgBondProviderRemove) GetSignBytes() []byte {
+	return cosmos.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgBondProviderRemove) GetSigners() []cosmos.AccAddress {
+	return []cosmos.AccAddress{m.Signer}
+}
+
+// NewMsgNodeOperatorFee is a constructor function for MsgNodeOperatorFee, the
+// operator fee is in basis points
+func NewMsgNodeOperatorFee(nodeAddr cosmos.AccAddress, operatorFee int64, signer cosmos.AccAddress, tx common.Tx) *MsgNodeOperatorFee {
+	return &MsgNodeOperatorFee{
+		Tx:          tx,
+		NodeAddress: nodeAddr,
+		OperatorFee: operatorFee,
+		Signer:      signer,
+	}
+}
+
+// Route should return the route key of the module
+func (m *MsgNodeOperatorFee) Route() string { return RouterKey }
+
+// Type should return the action
+func (m MsgNodeOperatorFee) Type() string { return "node_operator_fee" }
+
+// ValidateBasic runs stateless checks on the message
+func (m *MsgNodeOperatorFee) ValidateBasic() error {
+	if m.NodeAddress.Empty() {
+		return cosmos.ErrInvalidAddress("node address cannot be empty")
+	}
+	if m.OperatorFee < 0 || m.OperatorFee > 10000 {
+		return cosmos.ErrUnknownRequest("operator fee must be between 0 and 10000 basis points")
+	}
+	if !m.Tx.Coins.IsEmpty() {
+		return cosmos.ErrUnknownRequest("setting the operator fee does not accept funds")
+	}
+	if m.Signer.Empty() {
+		return cosmos.ErrInvalidAddress(m.Signer.String())
+	}
+	if m.Tx.ID.IsEmpty() {
+		return cosmos.ErrUnknownRequest("txID cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (m *MsgNodeOperatorFee) GetSignBytes() []byte {
+	return cosmos.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgNodeOperatorFee) GetSigners() []cosmos.AccAddress {
+	return []cosmos.AccAddress{m.Signer}
+}
+
+// NewMsgBondProviderTransfer is a constructor function for
+// MsgBondProviderTransfer, zero units transfers everything the signer has
+// bonded to the node in the given pool
+func NewMsgBondProviderTransfer(nodeAddr, toAddr cosmos.AccAddress, asset common.Asset, units cosmos.Uint, signer cosmos.AccAddress, tx common.Tx) *MsgBondProviderTransfer {
+	return &MsgBondProviderTransfer{
+		Tx:          tx,
+		NodeAddress: nodeAddr,
+		ToAddress:   toAddr,
+		Asset:       asset,
+		Units:       units,
+		Signer:      signer,
+	}
+}
+
+// Route should return the route key of the module
+func (m *MsgBondProviderTransfer) Route() string { return RouterKey }
+
+// Type should return the action
+func (m MsgBondProviderTransfer) Type() string { return "bond_provider_transfer" }
+
+// ValidateBasic runs stateless checks on the message
+func (m *MsgBondProviderTransfer) ValidateBasic() error {
+	if m.NodeAddress.Empty() {
+		return cosmos.ErrInvalidAddress("node address cannot be empty")
+	}
+	if m.ToAddress.Empty() {
+		return cosmos.ErrInvalidAddress("to address cannot be empty")
+	}
+	if m.ToAddress.Equals(m.Signer) {
+		return cosmos.ErrUnknownRequest("cannot transfer bond to yourself")
+	}
+	if m.Asset.IsEmpty() {
+		return cosmos.ErrUnknownRequest("asset cannot be empty")
+	}
+	if !m.Tx.Coins.IsEmpty() {
+		return cosmos.ErrUnknownRequest("transferring bond does not accept funds")
+	}
+	if m.Signer.Empty() {
+		return cosmos.ErrInvalidAddress(m.Signer.String())
+	}
+	if m.Tx.ID.IsEmpty() {
+		return cosmos.ErrUnknownRequest("txID cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (m *MsgBondProviderTransfer) GetSignBytes() []byte {
+	return cosmos.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgBondProviderTransfer) GetSigners() []cosmos.AccAddress {
+	return []cosmos.AccAddress{m.Signer}
+}
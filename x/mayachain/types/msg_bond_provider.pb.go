@@ -0,0 +1,1586 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: mayachain/v1/x/mayachain/types/msg_bond_provider.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	common "gitlab.com/mayachain/mayanode/common"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type MsgBondProviderWhitelist struct {
+	Tx              common.Tx                                     `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx"`
+	NodeAddress     github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=node_address,json=nodeAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"node_address,omitempty"`
+	ProviderAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=provider_address,json=providerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"provider_address,omitempty"`
+	Signer          github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+}
+
+func (m *MsgBondProviderWhitelist) Reset()         { *m = MsgBondProviderWhitelist{} }
+func (m *MsgBondProviderWhitelist) String() string { return proto.CompactTextString(m) }
+func (*MsgBondProviderWhitelist) ProtoMessage()    {}
+func (*MsgBondProviderWhitelist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_386052f0abeee279, []int{0}
+}
+func (m *MsgBondProviderWhitelist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBondProviderWhitelist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBondProviderWhitelist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBondProviderWhitelist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBondProviderWhitelist.Merge(m, src)
+}
+func (m *MsgBondProviderWhitelist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBondProviderWhitelist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBondProviderWhitelist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBondProviderWhitelist proto.InternalMessageInfo
+
+func (m *MsgBondProviderWhitelist) GetTx() common.Tx {
+	if m != nil {
+		return m.Tx
+	}
+	return common.Tx{}
+}
+
+func (m *MsgBondProviderWhitelist) GetNodeAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.NodeAddress
+	}
+	return nil
+}
+
+func (m *MsgBondProviderWhitelist) GetProviderAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.ProviderAddress
+	}
+	return nil
+}
+
+func (m *MsgBondProviderWhitelist) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
+type MsgBondProviderRemove struct {
+	Tx              common.Tx                                     `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx"`
+	NodeAddress     github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=node_address,json=nodeAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"node_address,omitempty"`
+	ProviderAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=provider_address,json=providerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"provider_address,omitempty"`
+	Signer          github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+}
+
+func (m *MsgBondProviderRemove) Reset()         { *m = MsgBondProviderRemove{} }
+func (m *MsgBondProviderRemove) String() string { return proto.CompactTextString(m) }
+func (*MsgBondProviderRemove) ProtoMessage()    {}
+func (*MsgBondProviderRemove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_386052f0abeee279, []int{1}
+}
+func (m *MsgBondProviderRemove) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBondProviderRemove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBondProviderRemove.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBondProviderRemove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBondProviderRemove.Merge(m, src)
+}
+func (m *MsgBondProviderRemove) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBondProviderRemove) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBondProviderRemove.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBondProviderRemove proto.InternalMessageInfo
+
+func (m *MsgBondProviderRemove) GetTx() common.Tx {
+	if m != nil {
+		return m.Tx
+	}
+	return common.Tx{}
+}
+
+func (m *MsgBondProviderRemove) GetNodeAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.NodeAddress
+	}
+	return nil
+}
+
+func (m *MsgBondProviderRemove) GetProviderAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.ProviderAddress
+	}
+	return nil
+}
+
+func (m *MsgBondProviderRemove) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
+type MsgNodeOperatorFee struct {
+	Tx          common.Tx                                     `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx"`
+	NodeAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=node_address,json=nodeAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"node_address,omitempty"`
+	OperatorFee int64                                         `protobuf:"varint,3,opt,name=operator_fee,json=operatorFee,proto3" json:"operator_fee,omitempty"`
+	Signer      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+}
+
+func (m *MsgNodeOperatorFee) Reset()         { *m = MsgNodeOperatorFee{} }
+func (m *MsgNodeOperatorFee) String() string { return proto.CompactTextString(m) }
+func (*MsgNodeOperatorFee) ProtoMessage()    {}
+func (*MsgNodeOperatorFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_386052f0abeee279, []int{2}
+}
+func (m *MsgNodeOperatorFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgNodeOperatorFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgNodeOperatorFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgNodeOperatorFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgNodeOperatorFee.Merge(m, src)
+}
+func (m *MsgNodeOperatorFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgNodeOperatorFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgNodeOperatorFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgNodeOperatorFee proto.InternalMessageInfo
+
+func (m *MsgNodeOperatorFee) GetTx() common.Tx {
+	if m != nil {
+		return m.Tx
+	}
+	return common.Tx{}
+}
+
+func (m *MsgNodeOperatorFee) GetNodeAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.NodeAddress
+	}
+	return nil
+}
+
+func (m *MsgNodeOperatorFee) GetOperatorFee() int64 {
+	if m != nil {
+		return m.OperatorFee
+	}
+	return 0
+}
+
+func (m *MsgNodeOperatorFee) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
+type MsgBondProviderTransfer struct {
+	Tx          common.Tx                                     `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx"`
+	NodeAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=node_address,json=nodeAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"node_address,omitempty"`
+	ToAddress   github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=to_address,json=toAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"to_address,omitempty"`
+	Asset       common.Asset                                  `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset"`
+	Units       github_com_cosmos_cosmos_sdk_types.Uint       `protobuf:"bytes,5,opt,name=units,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"units"`
+	Signer      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,6,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+}
+
+func (m *MsgBondProviderTransfer) Reset()         { *m = MsgBondProviderTransfer{} }
+func (m *MsgBondProviderTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgBondProviderTransfer) ProtoMessage()    {}
+func (*MsgBondProviderTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_386052f0abeee279, []int{3}
+}
+func (m *MsgBondProviderTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBondProviderTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBondProviderTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBondProviderTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBondProviderTransfer.Merge(m, src)
+}
+func (m *MsgBondProviderTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBondProviderTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBondProviderTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBondProviderTransfer proto.InternalMessageInfo
+
+func (m *MsgBondProviderTransfer) GetTx() common.Tx {
+	if m != nil {
+		return m.Tx
+	}
+	return common.Tx{}
+}
+
+func (m *MsgBondProviderTransfer) GetNodeAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.NodeAddress
+	}
+	return nil
+}
+
+func (m *MsgBondProviderTransfer) GetToAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.ToAddress
+	}
+	return nil
+}
+
+func (m *MsgBondProviderTransfer) GetAsset() common.Asset {
+	if m != nil {
+		return m.Asset
+	}
+	return common.Asset{}
+}
+
+func (m *MsgBondProviderTransfer) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgBondProviderWhitelist)(nil), "types.MsgBondProviderWhitelist")
+	proto.RegisterType((*MsgBondProviderRemove)(nil), "types.MsgBondProviderRemove")
+	proto.RegisterType((*MsgNodeOperatorFee)(nil), "types.MsgNodeOperatorFee")
+	proto.RegisterType((*MsgBondProviderTransfer)(nil), "types.MsgBondProviderTransfer")
+}
+
+func init() {
+	proto.RegisterFile("mayachain/v1/x/mayachain/types/msg_bond_provider.proto", fileDescriptor_386052f0abeee279)
+}
+
+var fileDescriptor_386052f0abeee279 = []byte{
+	// 456 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x94, 0x4f, 0x6b, 0x13, 0x41,
+	0x18, 0xc6, 0x33, 0x9b, 0x26, 0xd0, 0x49, 0x44, 0x59, 0x14, 0x97, 0x1e, 0x36, 0x6b, 0x2f, 0xc6,
+	0x43, 0x33, 0xb4, 0x82, 0xf7, 0x2c, 0x28, 0xf4, 0x50, 0x2d, 0x4b, 0x44, 0x10, 0x21, 0x4c, 0x76,
+	0xa6, 0x93, 0xc1, 0xee, 0xbc, 0x61, 0x66, 0x1a, 0xb6, 0xdf, 0xc2, 0x8f, 0xe0, 0xc7, 0x10, 0xfc,
+	0x02, 0x3d, 0xf6, 0x28, 0x1e, 0x82, 0x24, 0xdf, 0xa2, 0x27, 0xd9, 0xdd, 0x59, 0x5b, 0xeb, 0x41,
+	0x69, 0x85, 0x5c, 0x7a, 0x9a, 0x77, 0xfe, 0xbc, 0xbf, 0x99, 0xe7, 0x99, 0x97, 0x17, 0xbf, 0xc8,
+	0xe8, 0x29, 0x4d, 0xa7, 0x54, 0x2a, 0x32, 0xdf, 0x25, 0x39, 0xb9, 0x9c, 0xda, 0xd3, 0x19, 0x37,
+	0x24, 0x33, 0x62, 0x3c, 0x01, 0xc5, 0xc6, 0x33, 0x0d, 0x73, 0xc9, 0xb8, 0x1e, 0xcc, 0x34, 0x58,
+	0xf0, 0x5b, 0xe5, 0xf6, 0x56, 0xf4, 0x5b, 0x7a, 0x0a, 0x59, 0x06, 0xca, 0x0d, 0xd5, 0xc1, 0xad,
+	0x87, 0x02, 0x04, 0x94, 0x21, 0x29, 0xa2, 0x6a, 0x75, 0xfb, 0xab, 0x87, 0x83, 0x03, 0x23, 0x62,
+	0x50, 0xec, 0xd0, 0x81, 0xdf, 0x4d, 0xa5, 0xe5, 0xc7, 0xd2, 0x58, 0x3f, 0xc2, 0x9e, 0xcd, 0x03,
+	0x14, 0xa1, 0x7e, 0x67, 0x0f, 0x0f, 0x1c, 0x6d, 0x94, 0xc7, 0x1b, 0x67, 0x8b, 0x5e, 0x23, 0xf1,
+	0x6c, 0xee, 0x8f, 0x70, 0x57, 0x01, 0xe3, 0x63, 0xca, 0x98, 0xe6, 0xc6, 0x04, 0x5e, 0x84, 0xfa,
+	0xdd, 0x78, 0xf7, 0x62, 0xd1, 0xdb, 0x11, 0xd2, 0x4e, 0x4f, 0x26, 0x45, 0x16, 0x49, 0xc1, 0x64,
+	0x60, 0xdc, 0xb0, 0x63, 0xd8, 0xc7, 0x4a, 0xd3, 0x60, 0x98, 0xa6, 0xc3, 0x2a, 0x31, 0xe9, 0x14,
+	0x18, 0x37, 0xf1, 0x3f, 0xe0, 0x07, 0xb5, 0xca, 0x5f, 0xe4, 0xe6, 0x4d, 0xc9, 0xf7, 0x6b, 0x54,
+	0x4d, 0xdf, 0xc7, 0x6d, 0x23, 0x85, 0xe2, 0x3a, 0xd8, 0xb8, 0x29, 0xd3, 0x01, 0xb6, 0xbf, 0x78,
+	0xf8, 0xd1, 0x35, 0xf7, 0x12, 0x9e, 0xc1, 0x9c, 0xdf, 0x59, 0xf7, 0x37, 0xeb, 0x2e, 0x10, 0xf6,
+	0x0f, 0x8c, 0x78, 0x0d, 0x8c, 0xbf, 0x99, 0x71, 0x4d, 0x2d, 0xe8, 0x57, 0x7c, 0x7d, 0xbe, 0x3d,
+	0xc1, 0x5d, 0x70, 0xcf, 0x18, 0x1f, 0x71, 0x5e, 0x7a, 0xd6, 0x4c, 0x3a, 0x70, 0xe5, 0x69, 0xff,
+	0x51, 0xfc, 0xe7, 0x26, 0x7e, 0x7c, 0xad, 0x6e, 0x46, 0x9a, 0x2a, 0x73, 0xc4, 0xf5, 0xda, 0x1c,
+	0x38, 0xc4, 0xd8, 0xc2, 0xed, 0x6b, 0x66, 0xd3, 0x42, 0x4d, 0x7c, 0x86, 0x5b, 0xd4, 0x18, 0x6e,
+	0x4b, 0xbf, 0x3a, 0x7b, 0xf7, 0x6a, 0x31, 0xc3, 0x62, 0xd1, 0xe9, 0xa9, 0x4e, 0xf8, 0x2f, 0x71,
+	0xeb, 0x44, 0x49, 0x6b, 0x82, 0x56, 0x84, 0xfa, 0x9b, 0x31, 0x29, 0xf6, 0xbe, 0x2f, 0x7a, 0x4f,
+	0xff, 0xe1, 0xee, 0xb7, 0x52, 0xd9, 0xa4, 0xca, 0xbe, 0xf2, 0x45, 0xed, 0x5b, 0x7e, 0x51, 0xbc,
+	0x7f, 0xb6, 0x0c, 0xd1, 0xf9, 0x32, 0x44, 0x3f, 0x96, 0x21, 0xfa, 0xb4, 0x0a, 0x1b, 0xe7, 0xab,
+	0xb0, 0xf1, 0x6d, 0x15, 0x36, 0xde, 0x13, 0x21, 0xed, 0x31, 0xad, 0x80, 0x97, 0x5d, 0xb7, 0x88,
+	0x0a, 0x3b, 0xff, 0x6c, 0xdd, 0x93, 0x76, 0xd9, 0x6a, 0x9f, 0xff, 0x0c, 0x00, 0x00, 0xff, 0xff,
+	0x1b, 0x10, 0xea, 0xd1, 0xe3, 0x05, 0x00, 0x00,
+}
+
+func (m *MsgBondProviderWhitelist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBondProviderWhitelist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBondProviderWhitelist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgBondProvider(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintMsgBondProvider(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NodeAddress) > 0 {
+		i -= len(m.NodeAddress)
+		copy(dAtA[i:], m.NodeAddress)
+		i = encodeVarintMsgBondProvider(dAtA, i, uint64(len(m.NodeAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgBondProvider(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBondProviderRemove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBondProviderRemove) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBondProviderRemove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgBondProvider(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintMsgBondProvider(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NodeAddress) > 0 {
+		i -= len(m.NodeAddress)
+		copy(dAtA[i:], m.NodeAddress)
+		i = encodeVarintMsgBondProvider(dAtA, i, uint64(len(m.NodeAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgBondProvider(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgNodeOperatorFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgNodeOperatorFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgNodeOperatorFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgBondProvider(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OperatorFee != 0 {
+		i = encodeVarintMsgBondProvider(dAtA, i, uint64(m.OperatorFee))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NodeAddress) > 0 {
+		i -= len(m.NodeAddress)
+		copy(dAtA[i:], m.NodeAddress)
+		i = encodeVarintMsgBondProvider(dAtA, i, uint64(len(m.NodeAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgBondProvider(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBondProviderTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBondProviderTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBondProviderTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgBondProvider(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.Units.Size()
+		i -= size
+		if _, err := m.Units.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgBondProvider(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgBondProvider(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintMsgBondProvider(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NodeAddress) > 0 {
+		i -= len(m.NodeAddress)
+		copy(dAtA[i:], m.NodeAddress)
+		i = encodeVarintMsgBondProvider(dAtA, i, uint64(len(m.NodeAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgBondProvider(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgBondProvider(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgBondProvider(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgBondProviderWhitelist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tx.Size()
+	n += 1 + l + sovMsgBondProvider(uint64(l))
+	l = len(m.NodeAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgBondProvider(uint64(l))
+	}
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgBondProvider(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgBondProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBondProviderRemove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tx.Size()
+	n += 1 + l + sovMsgBondProvider(uint64(l))
+	l = len(m.NodeAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgBondProvider(uint64(l))
+	}
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgBondProvider(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgBondProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgNodeOperatorFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tx.Size()
+	n += 1 + l + sovMsgBondProvider(uint64(l))
+	l = len(m.NodeAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgBondProvider(uint64(l))
+	}
+	if m.OperatorFee != 0 {
+		n += 1 + sovMsgBondProvider(uint64(m.OperatorFee))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgBondProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBondProviderTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tx.Size()
+	n += 1 + l + sovMsgBondProvider(uint64(l))
+	l = len(m.NodeAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgBondProvider(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgBondProvider(uint64(l))
+	}
+	l = m.Asset.Size()
+	n += 1 + l + sovMsgBondProvider(uint64(l))
+	l = m.Units.Size()
+	n += 1 + l + sovMsgBondProvider(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgBondProvider(uint64(l))
+	}
+	return n
+}
+
+func sovMsgBondProvider(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgBondProvider(x uint64) (n int) {
+	return sovMsgBondProvider(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgBondProviderWhitelist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgBondProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBondProviderWhitelist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBondProviderWhitelist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgBondProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgBondProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeAddress = append(m.NodeAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.NodeAddress == nil {
+				m.NodeAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgBondProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = append(m.ProviderAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProviderAddress == nil {
+				m.ProviderAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgBondProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgBondProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBondProviderRemove) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgBondProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBondProviderRemove: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBondProviderRemove: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgBondProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgBondProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeAddress = append(m.NodeAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.NodeAddress == nil {
+				m.NodeAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgBondProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = append(m.ProviderAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProviderAddress == nil {
+				m.ProviderAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgBondProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgBondProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgNodeOperatorFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgBondProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgNodeOperatorFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgNodeOperatorFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgBondProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgBondProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeAddress = append(m.NodeAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.NodeAddress == nil {
+				m.NodeAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorFee", wireType)
+			}
+			m.OperatorFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgBondProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatorFee |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgBondProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgBondProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBondProviderTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgBondProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBondProviderTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBondProviderTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgBondProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgBondProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeAddress = append(m.NodeAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.NodeAddress == nil {
+				m.NodeAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgBondProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = append(m.ToAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ToAddress == nil {
+				m.ToAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgBondProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgBondProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Units.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgBondProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgBondProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgBondProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMsgBondProvider(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMsgBondProvider
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMsgBondProvider
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMsgBondProvider
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMsgBondProvider
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMsgBondProvider
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMsgBondProvider
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMsgBondProvider        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMsgBondProvider          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMsgBondProvider = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	. "gopkg.in/check.v1"
+)
+
+type MsgBondProviderSuite struct{}
+
+var _ = Suite(&MsgBondProviderSuite{})
+
+func (MsgBondProviderSuite) TestMsgBondProviderWhitelist(c *C) {
+	node := GetRandomBech32Addr()
+	provider := GetRandomBech32Addr()
+	signer := GetRandomBech32Addr()
+	dummyTx := common.Tx{ID: "test"}
+
+	m := NewMsgBondProviderWhitelist(node, provider, signer, dummyTx)
+	EnsureMsgBasicCorrect(m, c)
+	c.Check(m.Type(), Equals, "bond_provider_whitelist")
+
+	m = NewMsgBondProviderWhitelist(cosmos.AccAddress{}, provider, signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+	m = NewMsgBondProviderWhitelist(node, cosmos.AccAddress{}, signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+	m = NewMsgBondProviderWhitelist(node, provider, cosmos.AccAddress{}, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+
+	tx := common.Tx{ID: "test", Coins: common.NewCoins(common.NewCoin(common.BaseNative, cosmos.NewUint(common.One)))}
+	m = NewMsgBondProviderWhitelist(node, provider, signer, tx)
+	c.Check(m.ValidateBasic(), NotNil)
+}
+
+func (MsgBondProviderSuite) TestMsgBondProviderRemove(c *C) {
+	node := GetRandomBech32Addr()
+	provider := GetRandomBech32Addr()
+	signer := GetRandomBech32Addr()
+	dummyTx := common.Tx{ID: "test"}
+
+	m := NewMsgBondProviderRemove(node, provider, signer, dummyTx)
+	EnsureMsgBasicCorrect(m, c)
+	c.Check(m.Type(), Equals, "bond_provider_remove")
+
+	m = NewMsgBondProviderRemove(cosmos.AccAddress{}, provider, signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+	m = NewMsgBondProviderRemove(node, cosmos.AccAddress{}, signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+	m = NewMsgBondProviderRemove(node, provider, cosmos.AccAddress{}, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+}
+
+func (MsgBondProviderSuite) TestMsgNodeOperatorFee(c *C) {
+	node := GetRandomBech32Addr()
+	signer := GetRandomBech32Addr()
+	dummyTx := common.Tx{ID: "test"}
+
+	m := NewMsgNodeOperatorFee(node, 2000, signer, dummyTx)
+	EnsureMsgBasicCorrect(m, c)
+	c.Check(m.Type(), Equals, "node_operator_fee")
+
+	m = NewMsgNodeOperatorFee(node, 0, signer, dummyTx)
+	c.Check(m.ValidateBasic(), IsNil)
+	m = NewMsgNodeOperatorFee(node, 10000, signer, dummyTx)
+	c.Check(m.ValidateBasic(), IsNil)
+	m = NewMsgNodeOperatorFee(node, -1, signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+	m = NewMsgNodeOperatorFee(node, 10001, signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+	m = NewMsgNodeOperatorFee(cosmos.AccAddress{}, 2000, signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+	m = NewMsgNodeOperatorFee(node, 2000, cosmos.AccAddress{}, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+}
+
+func (MsgBondProviderSuite) TestMsgBondProviderTransfer(c *C) {
+	node := GetRandomBech32Addr()
+	to := GetRandomBech32Addr()
+	signer := GetRandomBech32Addr()
+	units := cosmos.NewUint(100)
+	dummyTx := common.Tx{ID: "test"}
+
+	m := NewMsgBondProviderTransfer(node, to, common.BTCAsset, units, signer, dummyTx)
+	EnsureMsgBasicCorrect(m, c)
+	c.Check(m.Type(), Equals, "bond_provider_transfer")
+
+	// zero units transfers everything
+	m = NewMsgBondProviderTransfer(node, to, common.BTCAsset, cosmos.ZeroUint(), signer, dummyTx)
+	c.Check(m.ValidateBasic(), IsNil)
+
+	m = NewMsgBondProviderTransfer(cosmos.AccAddress{}, to, common.BTCAsset, units, signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+	m = NewMsgBondProviderTransfer(node, cosmos.AccAddress{}, common.BTCAsset, units, signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+	m = NewMsgBondProviderTransfer(node, signer, common.BTCAsset, units, signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+	m = NewMsgBondProviderTransfer(node, to, common.EmptyAsset, units, signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+	m = NewMsgBondProviderTransfer(node, to, common.BTCAsset, units, cosmos.AccAddress{}, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+}
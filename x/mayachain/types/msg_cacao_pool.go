@@ -11,8 +11,9 @@ import (
 // NewMsgCacaoPoolDeposit create new MsgCacaoPoolDeposit message
 func NewMsgCacaoPoolDeposit(signer cosmos.AccAddress, tx common.Tx) *MsgCacaoPoolDeposit {
 	return &MsgCacaoPoolDeposit{
-		Signer: signer,
-		Tx:     tx,
+		Signer:       signer,
+		Tx:           tx,
+		AutoCompound: -1,
 	}
 }
 
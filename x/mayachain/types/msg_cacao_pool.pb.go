@@ -27,8 +27,9 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type MsgCacaoPoolDeposit struct {
-	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
-	Tx     common.Tx                                     `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx"`
+	Signer       github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+	Tx           common.Tx                                     `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx"`
+	AutoCompound int64                                         `protobuf:"varint,3,opt,name=auto_compound,json=autoCompound,proto3" json:"auto_compound,omitempty"`
 }
 
 func (m *MsgCacaoPoolDeposit) Reset()         { *m = MsgCacaoPoolDeposit{} }
@@ -78,6 +79,13 @@ func (m *MsgCacaoPoolDeposit) GetTx() common.Tx {
 	return common.Tx{}
 }
 
+func (m *MsgCacaoPoolDeposit) GetAutoCompound() int64 {
+	if m != nil {
+		return m.AutoCompound
+	}
+	return 0
+}
+
 type MsgCacaoPoolWithdraw struct {
 	Signer               github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
 	Tx                   common.Tx                                     `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx"`
@@ -151,31 +159,33 @@ func init() {
 }
 
 var fileDescriptor_0c0e32eaec62fdd6 = []byte{
-	// 383 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x92, 0xcd, 0xaa, 0xd3, 0x40,
-	0x14, 0x80, 0x33, 0xfd, 0x03, 0xa7, 0x5d, 0x68, 0x2c, 0x12, 0xba, 0x48, 0x42, 0x37, 0x76, 0x61,
-	0x33, 0xb4, 0x7d, 0x82, 0x46, 0x37, 0x5d, 0x08, 0x25, 0x28, 0x82, 0x9b, 0x30, 0x9d, 0xa4, 0xe9,
-	0x60, 0x92, 0x13, 0x32, 0xa3, 0xa6, 0x5b, 0x9f, 0xc0, 0x37, 0xf0, 0x75, 0xba, 0xec, 0x52, 0x5c,
-	0x04, 0x69, 0xdf, 0xa2, 0x2b, 0xc9, 0x8f, 0x8d, 0x45, 0xb8, 0x5c, 0xee, 0xe2, 0xae, 0xe6, 0x64,
-	0x4e, 0xe6, 0x3b, 0xdf, 0xe1, 0x1c, 0xbc, 0x88, 0xe8, 0x9e, 0xb2, 0x1d, 0xe5, 0x31, 0xf9, 0x32,
-	0x23, 0x19, 0x69, 0x3e, 0xe5, 0x3e, 0xf1, 0x05, 0x89, 0x44, 0xe0, 0x32, 0xca, 0x28, 0xb8, 0x09,
-	0x40, 0x68, 0x25, 0x29, 0x48, 0x50, 0xbb, 0x65, 0x6e, 0x64, 0xde, 0xbc, 0x65, 0x10, 0x45, 0x10,
-	0xd7, 0x47, 0xf5, 0xe3, 0x68, 0x18, 0x40, 0x00, 0x65, 0x48, 0x8a, 0xa8, 0xba, 0x1d, 0x7f, 0x43,
-	0xf8, 0xf9, 0x5b, 0x11, 0xbc, 0x2e, 0xb0, 0x6b, 0x80, 0xf0, 0x8d, 0x9f, 0x80, 0xe0, 0x52, 0x5d,
-	0xe1, 0x9e, 0xe0, 0x41, 0xec, 0xa7, 0x1a, 0x32, 0xd1, 0x64, 0x60, 0xcf, 0x2e, 0xb9, 0x31, 0x0d,
-	0xb8, 0xdc, 0x7d, 0xde, 0x58, 0x0c, 0x22, 0xc2, 0x40, 0x44, 0x20, 0xea, 0x63, 0x2a, 0xbc, 0x4f,
-	0x95, 0xa3, 0xb5, 0x64, 0x6c, 0xe9, 0x79, 0xa9, 0x2f, 0x84, 0x53, 0x03, 0x54, 0x13, 0xb7, 0x64,
-	0xa6, 0xb5, 0x4c, 0x34, 0xe9, 0xcf, 0xb1, 0x55, 0x3b, 0xbd, 0xcb, 0xec, 0xce, 0x21, 0x37, 0x14,
-	0xa7, 0x25, 0xb3, 0xf1, 0x8f, 0x36, 0x1e, 0xfe, 0x2b, 0xf1, 0x81, 0xcb, 0x9d, 0x97, 0xd2, 0xaf,
-	0x8f, 0x6a, 0xa1, 0x3a, 0x78, 0xb0, 0xa1, 0x82, 0x0b, 0x37, 0x01, 0x1e, 0x4b, 0xa1, 0xb5, 0x4d,
-	0x34, 0x79, 0x62, 0x93, 0x22, 0xff, 0x2b, 0x37, 0x5e, 0xde, 0xa3, 0xec, 0x7b, 0x1e, 0x4b, 0xa7,
-	0x5f, 0x42, 0xd6, 0x25, 0x43, 0x75, 0xf1, 0x33, 0xba, 0xdd, 0xf2, 0x90, 0x53, 0xe9, 0xbb, 0xb4,
-	0x52, 0xd2, 0x3a, 0x25, 0x78, 0x7e, 0xc9, 0x8d, 0x57, 0x01, 0x97, 0x21, 0xad, 0xa0, 0xcd, 0x00,
-	0x8b, 0x28, 0x06, 0xcf, 0xff, 0x3b, 0xbf, 0xba, 0x15, 0x0d, 0x39, 0x4f, 0xaf, 0xb0, 0xfa, 0x4e,
-	0xf5, 0xf1, 0x8b, 0xa6, 0xc0, 0x8d, 0x7e, 0xf7, 0x61, 0xfa, 0xc3, 0x2b, 0xce, 0x6e, 0xfa, 0xb0,
-	0x57, 0x87, 0x93, 0x8e, 0x8e, 0x27, 0x1d, 0xfd, 0x3e, 0xe9, 0xe8, 0xfb, 0x59, 0x57, 0x8e, 0x67,
-	0x5d, 0xf9, 0x79, 0xd6, 0x95, 0x8f, 0xe4, 0xee, 0x16, 0xfe, 0xdb, 0xe2, 0x4d, 0xaf, 0x5c, 0xbc,
-	0xc5, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4e, 0x44, 0xc7, 0x8e, 0xee, 0x02, 0x00, 0x00,
+	// 403 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x92, 0xcf, 0xae, 0xd2, 0x40,
+	0x14, 0x87, 0x3b, 0x70, 0x2f, 0x89, 0x03, 0x26, 0x5a, 0x89, 0x69, 0x58, 0xb4, 0x0d, 0x2e, 0x64,
+	0x21, 0x9d, 0x00, 0x4f, 0x40, 0x71, 0xc3, 0xc2, 0x84, 0x34, 0x1a, 0x13, 0x37, 0xcd, 0x30, 0x2d,
+	0x65, 0x62, 0xdb, 0xd3, 0x74, 0x06, 0x2d, 0x6f, 0xe1, 0x1b, 0xb8, 0xf4, 0x55, 0x58, 0xb2, 0x34,
+	0x2e, 0x1a, 0x03, 0x6f, 0xc1, 0xca, 0xf4, 0x8f, 0x54, 0x62, 0x62, 0x8c, 0x0b, 0x57, 0x73, 0xfa,
+	0x9b, 0xce, 0x37, 0xdf, 0xc9, 0x1c, 0x3c, 0x8b, 0xe8, 0x9e, 0xb2, 0x2d, 0xe5, 0x31, 0xf9, 0x30,
+	0x21, 0x19, 0x69, 0x3e, 0xe5, 0x3e, 0xf1, 0x05, 0x89, 0x44, 0xe0, 0x32, 0xca, 0x28, 0xb8, 0x09,
+	0x40, 0x68, 0x25, 0x29, 0x48, 0x50, 0xef, 0xcb, 0xbd, 0x81, 0x79, 0x73, 0x96, 0x41, 0x14, 0x41,
+	0x5c, 0x2f, 0xd5, 0x8f, 0x83, 0x7e, 0x00, 0x01, 0x94, 0x25, 0x29, 0xaa, 0x2a, 0x1d, 0x7e, 0x41,
+	0xf8, 0xc9, 0x2b, 0x11, 0x2c, 0x0a, 0xec, 0x0a, 0x20, 0x7c, 0xe9, 0x27, 0x20, 0xb8, 0x54, 0x97,
+	0xb8, 0x23, 0x78, 0x10, 0xfb, 0xa9, 0x86, 0x4c, 0x34, 0xea, 0xd9, 0x93, 0x4b, 0x6e, 0x8c, 0x03,
+	0x2e, 0xb7, 0xbb, 0xb5, 0xc5, 0x20, 0x22, 0x0c, 0x44, 0x04, 0xa2, 0x5e, 0xc6, 0xc2, 0x7b, 0x5f,
+	0x39, 0x5a, 0x73, 0xc6, 0xe6, 0x9e, 0x97, 0xfa, 0x42, 0x38, 0x35, 0x40, 0x35, 0x71, 0x4b, 0x66,
+	0x5a, 0xcb, 0x44, 0xa3, 0xee, 0x14, 0x5b, 0xb5, 0xd3, 0xeb, 0xcc, 0xbe, 0x3b, 0xe4, 0x86, 0xe2,
+	0xb4, 0x64, 0xa6, 0x3e, 0xc3, 0x0f, 0xe9, 0x4e, 0x82, 0xcb, 0x20, 0x4a, 0x60, 0x17, 0x7b, 0x5a,
+	0xdb, 0x44, 0xa3, 0xb6, 0xd3, 0x2b, 0xc2, 0x45, 0x9d, 0x0d, 0x3f, 0xb7, 0x71, 0xff, 0x57, 0xd3,
+	0xb7, 0x5c, 0x6e, 0xbd, 0x94, 0x7e, 0xfc, 0xbf, 0xaa, 0x0e, 0xee, 0xad, 0xa9, 0xe0, 0xc2, 0x4d,
+	0x80, 0xc7, 0x52, 0x94, 0xa6, 0x0f, 0x6c, 0x52, 0xec, 0x7f, 0xcb, 0x8d, 0xe7, 0x7f, 0x71, 0xed,
+	0x1b, 0x1e, 0x4b, 0xa7, 0x5b, 0x42, 0x56, 0x25, 0x43, 0x75, 0xf1, 0x63, 0xba, 0xd9, 0xf0, 0x90,
+	0x53, 0xe9, 0xbb, 0xb4, 0x52, 0xd2, 0xee, 0x4a, 0xf0, 0xf4, 0x92, 0x1b, 0x2f, 0x02, 0x2e, 0x43,
+	0x5a, 0x41, 0x9b, 0x57, 0x2e, 0xaa, 0x18, 0x3c, 0xff, 0xe7, 0x23, 0xd7, 0xad, 0x68, 0xc8, 0x79,
+	0x74, 0x85, 0xd5, 0x99, 0xea, 0xe3, 0xa7, 0xcd, 0x05, 0x37, 0xfa, 0xf7, 0xff, 0xa6, 0xdf, 0xbf,
+	0xe2, 0xec, 0xa6, 0x0f, 0x7b, 0x79, 0x38, 0xe9, 0xe8, 0x78, 0xd2, 0xd1, 0xf7, 0x93, 0x8e, 0x3e,
+	0x9d, 0x75, 0xe5, 0x78, 0xd6, 0x95, 0xaf, 0x67, 0x5d, 0x79, 0x47, 0xfe, 0xdc, 0xc2, 0x6f, 0xa3,
+	0xbe, 0xee, 0x94, 0xd3, 0x39, 0xfb, 0x11, 0x00, 0x00, 0xff, 0xff, 0xce, 0xfb, 0x45, 0x8f, 0x13,
+	0x03, 0x00, 0x00,
 }
 
 func (m *MsgCacaoPoolDeposit) Marshal() (dAtA []byte, err error) {
@@ -198,6 +208,11 @@ func (m *MsgCacaoPoolDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCompound != 0 {
+		i = encodeVarintMsgCacaoPool(dAtA, i, uint64(m.AutoCompound))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -308,6 +323,9 @@ func (m *MsgCacaoPoolDeposit) Size() (n int) {
 	}
 	l = m.Tx.Size()
 	n += 1 + l + sovMsgCacaoPool(uint64(l))
+	if m.AutoCompound != 0 {
+		n += 1 + sovMsgCacaoPool(uint64(m.AutoCompound))
+	}
 	return n
 }
 
@@ -436,6 +454,25 @@ func (m *MsgCacaoPoolDeposit) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompound", wireType)
+			}
+			m.AutoCompound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgCacaoPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompound |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgCacaoPool(dAtA[iNdEx:])
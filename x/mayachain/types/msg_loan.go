@@ -0,0 +1,119 @@
+package types
+
+import (
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+)
+
+var (
+	_ cosmos.Msg = &MsgLoanOpen{}
+	_ cosmos.Msg = &MsgLoanRepayment{}
+)
+
+// NewMsgLoanOpen is a constructor function for MsgLoanOpen
+func NewMsgLoanOpen(collateralAsset common.Asset, collateralAmount cosmos.Uint, targetAsset common.Asset, targetAddress common.Address, debtAmount cosmos.Uint, signer cosmos.AccAddress, tx common.Tx) *MsgLoanOpen {
+	return &MsgLoanOpen{
+		Tx:               tx,
+		CollateralAsset:  collateralAsset,
+		CollateralAmount: collateralAmount,
+		TargetAsset:      targetAsset,
+		TargetAddress:    targetAddress,
+		DebtAmount:       debtAmount,
+		Signer:           signer,
+	}
+}
+
+// Route should return the route key of the module
+func (m *MsgLoanOpen) Route() string { return RouterKey }
+
+// Type should return the action
+func (m MsgLoanOpen) Type() string { return "loan_open" }
+
+// ValidateBasic runs stateless checks on the message
+func (m *MsgLoanOpen) ValidateBasic() error {
+	if !m.CollateralAsset.IsTradeAsset() {
+		return cosmos.ErrUnknownRequest("collateral asset must be a trade asset")
+	}
+	if m.CollateralAmount.IsZero() {
+		return cosmos.ErrUnknownRequest("collateral amount cannot be zero")
+	}
+	if m.TargetAsset.IsEmpty() {
+		return cosmos.ErrUnknownRequest("target asset cannot be empty")
+	}
+	if m.TargetAsset.IsSyntheticAsset() || m.TargetAsset.IsTradeAsset() || m.TargetAsset.IsVaultAsset() {
+		return cosmos.ErrUnknownRequest("target asset must be a layer1 asset")
+	}
+	if m.TargetAddress.IsEmpty() {
+		return cosmos.ErrUnknownRequest("target address cannot be empty")
+	}
+	if m.Signer.Empty() {
+		return cosmos.ErrInvalidAddress(m.Signer.String())
+	}
+	if m.Tx.ID.IsEmpty() {
+		return cosmos.ErrUnknownRequest("txID cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (m *MsgLoanOpen) GetSignBytes() []byte {
+	return cosmos.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgLoanOpen) GetSigners() []cosmos.AccAddress {
+	return []cosmos.AccAddress{m.Signer}
+}
+
+// NewMsgLoanRepayment is a constructor function for MsgLoanRepayment
+func NewMsgLoanRepayment(owner cosmos.AccAddress, collateralAsset common.Asset, coin common.Coin, signer cosmos.AccAddress, tx common.Tx) *MsgLoanRepayment {
+	return &MsgLoanRepayment{
+		Tx:              tx,
+		Owner:           owner,
+		CollateralAsset: collateralAsset,
+		Coin:            coin,
+		Signer:          signer,
+	}
+}
+
+// Route should return the route key of the module
+func (m *MsgLoanRepayment) Route() string { return RouterKey }
+
+// Type should return the action
+func (m MsgLoanRepayment) Type() string { return "loan_repayment" }
+
+// ValidateBasic runs stateless checks on the message
+func (m *MsgLoanRepayment) ValidateBasic() error {
+	if m.Owner.Empty() {
+		return cosmos.ErrInvalidAddress(m.Owner.String())
+	}
+	if !m.CollateralAsset.IsTradeAsset() {
+		return cosmos.ErrUnknownRequest("collateral asset must be a trade asset")
+	}
+	if err := m.Coin.Valid(); err != nil {
+		return cosmos.ErrUnknownRequest(err.Error())
+	}
+	if m.Coin.IsEmpty() {
+		return cosmos.ErrUnknownRequest("repayment cannot be empty")
+	}
+	if m.Coin.Asset.IsSyntheticAsset() || m.Coin.Asset.IsVaultAsset() {
+		return cosmos.ErrUnknownRequest("cannot repay with a synthetic asset")
+	}
+	if m.Signer.Empty() {
+		return cosmos.ErrInvalidAddress(m.Signer.String())
+	}
+	if m.Tx.ID.IsEmpty() {
+		return cosmos.ErrUnknownRequest("txID cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (m *MsgLoanRepayment) GetSignBytes() []byte {
+	return cosmos.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgLoanRepayment) GetSigners() []cosmos.AccAddress {
+	return []cosmos.AccAddress{m.Signer}
+}
@@ -0,0 +1,1014 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: mayachain/v1/x/mayachain/types/msg_loan.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	common "gitlab.com/mayachain/mayanode/common"
+	gitlab_com_mayachain_mayanode_common "gitlab.com/mayachain/mayanode/common"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type MsgLoanOpen struct {
+	Tx               common.Tx                                     `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx"`
+	CollateralAsset  gitlab_com_mayachain_mayanode_common.Asset    `protobuf:"bytes,2,opt,name=collateral_asset,json=collateralAsset,proto3,customtype=gitlab.com/mayachain/mayanode/common.Asset" json:"collateral_asset"`
+	CollateralAmount github_com_cosmos_cosmos_sdk_types.Uint       `protobuf:"bytes,3,opt,name=collateral_amount,json=collateralAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"collateral_amount"`
+	TargetAsset      gitlab_com_mayachain_mayanode_common.Asset    `protobuf:"bytes,4,opt,name=target_asset,json=targetAsset,proto3,customtype=gitlab.com/mayachain/mayanode/common.Asset" json:"target_asset"`
+	TargetAddress    gitlab_com_mayachain_mayanode_common.Address  `protobuf:"bytes,5,opt,name=target_address,json=targetAddress,proto3,casttype=gitlab.com/mayachain/mayanode/common.Address" json:"target_address,omitempty"`
+	DebtAmount       github_com_cosmos_cosmos_sdk_types.Uint       `protobuf:"bytes,6,opt,name=debt_amount,json=debtAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"debt_amount"`
+	Signer           github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,7,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+}
+
+func (m *MsgLoanOpen) Reset()         { *m = MsgLoanOpen{} }
+func (m *MsgLoanOpen) String() string { return proto.CompactTextString(m) }
+func (*MsgLoanOpen) ProtoMessage()    {}
+func (*MsgLoanOpen) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686adefa7a45ea82, []int{0}
+}
+func (m *MsgLoanOpen) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLoanOpen) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLoanOpen.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLoanOpen) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLoanOpen.Merge(m, src)
+}
+func (m *MsgLoanOpen) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLoanOpen) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLoanOpen.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLoanOpen proto.InternalMessageInfo
+
+func (m *MsgLoanOpen) GetTx() common.Tx {
+	if m != nil {
+		return m.Tx
+	}
+	return common.Tx{}
+}
+
+func (m *MsgLoanOpen) GetTargetAddress() gitlab_com_mayachain_mayanode_common.Address {
+	if m != nil {
+		return m.TargetAddress
+	}
+	return ""
+}
+
+func (m *MsgLoanOpen) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
+type MsgLoanRepayment struct {
+	Tx              common.Tx                                     `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx"`
+	Owner           github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	CollateralAsset gitlab_com_mayachain_mayanode_common.Asset    `protobuf:"bytes,3,opt,name=collateral_asset,json=collateralAsset,proto3,customtype=gitlab.com/mayachain/mayanode/common.Asset" json:"collateral_asset"`
+	Coin            common.Coin                                   `protobuf:"bytes,4,opt,name=coin,proto3" json:"coin"`
+	Signer          github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,5,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+}
+
+func (m *MsgLoanRepayment) Reset()         { *m = MsgLoanRepayment{} }
+func (m *MsgLoanRepayment) String() string { return proto.CompactTextString(m) }
+func (*MsgLoanRepayment) ProtoMessage()    {}
+func (*MsgLoanRepayment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686adefa7a45ea82, []int{1}
+}
+func (m *MsgLoanRepayment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLoanRepayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLoanRepayment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLoanRepayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLoanRepayment.Merge(m, src)
+}
+func (m *MsgLoanRepayment) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLoanRepayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLoanRepayment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLoanRepayment proto.InternalMessageInfo
+
+func (m *MsgLoanRepayment) GetTx() common.Tx {
+	if m != nil {
+		return m.Tx
+	}
+	return common.Tx{}
+}
+
+func (m *MsgLoanRepayment) GetOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *MsgLoanRepayment) GetCoin() common.Coin {
+	if m != nil {
+		return m.Coin
+	}
+	return common.Coin{}
+}
+
+func (m *MsgLoanRepayment) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgLoanOpen)(nil), "types.MsgLoanOpen")
+	proto.RegisterType((*MsgLoanRepayment)(nil), "types.MsgLoanRepayment")
+}
+
+func init() {
+	proto.RegisterFile("mayachain/v1/x/mayachain/types/msg_loan.proto", fileDescriptor_686adefa7a45ea82)
+}
+
+var fileDescriptor_686adefa7a45ea82 = []byte{
+	// 455 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x37, 0xe9, 0xee, 0x8a, 0x93, 0xad, 0xd6, 0xe0, 0x21, 0xf4, 0x90, 0x84, 0x1e, 0x74,
+	0x11, 0x77, 0xc7, 0xd6, 0x4f, 0xb0, 0xf1, 0x20, 0x05, 0x45, 0x09, 0x8a, 0x20, 0x42, 0x99, 0x4d,
+	0x86, 0x69, 0x30, 0x99, 0x17, 0x32, 0x53, 0xcd, 0x7e, 0x0b, 0x3f, 0x56, 0x8f, 0xbd, 0x29, 0x1e,
+	0x82, 0xec, 0x7e, 0x8b, 0x9e, 0x24, 0x33, 0x13, 0x1a, 0x51, 0x64, 0xa9, 0xf6, 0x34, 0x33, 0x6f,
+	0xe6, 0xfd, 0xde, 0xfb, 0xff, 0x79, 0x0c, 0x9a, 0x15, 0x64, 0x45, 0x92, 0x53, 0x92, 0x71, 0xfc,
+	0xe9, 0x10, 0xd7, 0xf8, 0xea, 0x28, 0x57, 0x25, 0x15, 0xb8, 0x10, 0xec, 0x24, 0x07, 0xc2, 0xe7,
+	0x65, 0x05, 0x12, 0xdc, 0x91, 0x8a, 0xee, 0x87, 0xbf, 0x64, 0x25, 0x50, 0x14, 0xc0, 0xcd, 0xa2,
+	0x1f, 0xee, 0xdf, 0x67, 0xc0, 0x40, 0x6d, 0x71, 0xbb, 0xd3, 0xd1, 0x83, 0xaf, 0x43, 0xe4, 0xbc,
+	0x14, 0xec, 0x05, 0x10, 0xfe, 0xaa, 0xa4, 0xdc, 0x0d, 0x91, 0x2d, 0x6b, 0xcf, 0x0a, 0xad, 0xa9,
+	0x73, 0x84, 0xe6, 0x06, 0xf0, 0xa6, 0x8e, 0x86, 0xe7, 0x4d, 0x30, 0x88, 0x6d, 0x59, 0xbb, 0x39,
+	0xda, 0x4b, 0x20, 0xcf, 0x89, 0xa4, 0x15, 0xc9, 0x4f, 0x88, 0x10, 0x54, 0x7a, 0xb6, 0x7a, 0xbf,
+	0xdb, 0xbd, 0x5f, 0xb4, 0xc1, 0xe8, 0xa8, 0x4d, 0xf9, 0xde, 0x04, 0x8f, 0x58, 0x26, 0x73, 0xb2,
+	0x6c, 0x2f, 0x7b, 0x62, 0xda, 0x1d, 0x87, 0x94, 0xe2, 0x7e, 0x4e, 0x7c, 0xf7, 0x0a, 0xad, 0x02,
+	0xee, 0x07, 0x74, 0xaf, 0x5f, 0xad, 0x80, 0x33, 0x2e, 0xbd, 0x9d, 0xd0, 0x9a, 0xde, 0x8e, 0xb0,
+	0xe1, 0x3f, 0x64, 0x99, 0x3c, 0x3d, 0xd3, 0xfc, 0x04, 0x44, 0x01, 0xc2, 0x2c, 0x33, 0x91, 0x7e,
+	0xd4, 0xa6, 0xcd, 0xdf, 0x66, 0x5c, 0xc6, 0xbd, 0xbe, 0x17, 0x0a, 0xe4, 0xa6, 0x68, 0x22, 0x49,
+	0xc5, 0xa8, 0x34, 0x3a, 0x86, 0xff, 0x4b, 0x87, 0xa3, 0xb1, 0x5a, 0xc3, 0x3b, 0x74, 0xa7, 0xab,
+	0x92, 0xa6, 0x15, 0x15, 0xc2, 0x1b, 0x29, 0x01, 0x4f, 0x2e, 0x9b, 0xe0, 0xf1, 0x76, 0x50, 0x9d,
+	0x17, 0xef, 0x1a, 0xac, 0x3e, 0xba, 0xaf, 0x91, 0x93, 0xd2, 0xa5, 0xec, 0x6c, 0x19, 0x5f, 0xcf,
+	0x16, 0xd4, 0x32, 0x8c, 0x21, 0xc7, 0x68, 0x2c, 0x32, 0xc6, 0x69, 0xe5, 0xdd, 0x0a, 0xad, 0xe9,
+	0x24, 0x3a, 0xbc, 0x6c, 0x82, 0xd9, 0x16, 0xa0, 0x45, 0x92, 0x74, 0x3d, 0x1a, 0xc0, 0x41, 0x63,
+	0xa3, 0x3d, 0x33, 0x59, 0x31, 0x2d, 0xc9, 0xaa, 0xa0, 0x5c, 0x6e, 0x31, 0x5e, 0xcf, 0xd1, 0x08,
+	0x3e, 0xb7, 0x0d, 0xd8, 0xd7, 0x6d, 0x40, 0xe7, 0xff, 0x71, 0x4e, 0x77, 0x6e, 0x6c, 0x4e, 0x1f,
+	0xa0, 0x61, 0x02, 0x19, 0x37, 0x13, 0x34, 0xe9, 0x2a, 0x3c, 0x83, 0x8c, 0x1b, 0x71, 0xea, 0xbe,
+	0x67, 0xf0, 0xe8, 0x1f, 0x0d, 0x8e, 0x8e, 0xcf, 0xd7, 0xbe, 0x75, 0xb1, 0xf6, 0xad, 0x1f, 0x6b,
+	0xdf, 0xfa, 0xb2, 0xf1, 0x07, 0x17, 0x1b, 0x7f, 0xf0, 0x6d, 0xe3, 0x0f, 0xde, 0xe3, 0xbf, 0x2b,
+	0xf9, 0xed, 0x4f, 0x59, 0x8e, 0xd5, 0x67, 0xf0, 0xf4, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa3,
+	0x55, 0x10, 0xd6, 0x7c, 0x04, 0x00, 0x00,
+}
+
+func (m *MsgLoanOpen) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLoanOpen) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLoanOpen) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgLoan(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.DebtAmount.Size()
+		i -= size
+		if _, err := m.DebtAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgLoan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.TargetAddress) > 0 {
+		i -= len(m.TargetAddress)
+		copy(dAtA[i:], m.TargetAddress)
+		i = encodeVarintMsgLoan(dAtA, i, uint64(len(m.TargetAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.TargetAsset.Size()
+		i -= size
+		if _, err := m.TargetAsset.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgLoan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CollateralAmount.Size()
+		i -= size
+		if _, err := m.CollateralAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgLoan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CollateralAsset.Size()
+		i -= size
+		if _, err := m.CollateralAsset.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgLoan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgLoan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLoanRepayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLoanRepayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLoanRepayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgLoan(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgLoan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CollateralAsset.Size()
+		i -= size
+		if _, err := m.CollateralAsset.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgLoan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintMsgLoan(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgLoan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgLoan(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgLoan(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgLoanOpen) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tx.Size()
+	n += 1 + l + sovMsgLoan(uint64(l))
+	l = m.CollateralAsset.Size()
+	n += 1 + l + sovMsgLoan(uint64(l))
+	l = m.CollateralAmount.Size()
+	n += 1 + l + sovMsgLoan(uint64(l))
+	l = m.TargetAsset.Size()
+	n += 1 + l + sovMsgLoan(uint64(l))
+	l = len(m.TargetAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgLoan(uint64(l))
+	}
+	l = m.DebtAmount.Size()
+	n += 1 + l + sovMsgLoan(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgLoan(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLoanRepayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tx.Size()
+	n += 1 + l + sovMsgLoan(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovMsgLoan(uint64(l))
+	}
+	l = m.CollateralAsset.Size()
+	n += 1 + l + sovMsgLoan(uint64(l))
+	l = m.Coin.Size()
+	n += 1 + l + sovMsgLoan(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgLoan(uint64(l))
+	}
+	return n
+}
+
+func sovMsgLoan(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgLoan(x uint64) (n int) {
+	return sovMsgLoan(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgLoanOpen) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgLoan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLoanOpen: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLoanOpen: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgLoan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgLoan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollateralAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgLoan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollateralAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgLoan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgLoan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetAddress = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgLoan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DebtAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgLoan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgLoan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgLoan
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgLoan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLoanRepayment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgLoan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLoanRepayment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLoanRepayment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgLoan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgLoan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgLoan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollateralAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgLoan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgLoan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgLoan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgLoan
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgLoan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMsgLoan(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMsgLoan
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMsgLoan
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMsgLoan
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMsgLoan
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMsgLoan
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMsgLoan
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMsgLoan        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMsgLoan          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMsgLoan = fmt.Errorf("proto: unexpected end of group")
+)
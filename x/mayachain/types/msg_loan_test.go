@@ -0,0 +1,63 @@
+package types
+
+import (
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	. "gopkg.in/check.v1"
+)
+
+type MsgLoanSuite struct{}
+
+var _ = Suite(&MsgLoanSuite{})
+
+func (MsgLoanSuite) TestLoanOpen(c *C) {
+	collateral := common.BTCAsset.GetTradeAsset()
+	amt := cosmos.NewUint(100)
+	ethAddr := GetRandomETHAddress()
+	signer := GetRandomBech32Addr()
+	dummyTx := common.Tx{ID: "test"}
+
+	m := NewMsgLoanOpen(collateral, amt, common.ETHAsset, ethAddr, cosmos.ZeroUint(), signer, dummyTx)
+	EnsureMsgBasicCorrect(m, c)
+
+	m = NewMsgLoanOpen(common.BTCAsset, amt, common.ETHAsset, ethAddr, cosmos.ZeroUint(), signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+
+	m = NewMsgLoanOpen(collateral, cosmos.ZeroUint(), common.ETHAsset, ethAddr, cosmos.ZeroUint(), signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+
+	m = NewMsgLoanOpen(collateral, amt, common.ETHAsset.GetTradeAsset(), ethAddr, cosmos.ZeroUint(), signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+
+	m = NewMsgLoanOpen(collateral, amt, common.ETHAsset.GetSyntheticAsset(), ethAddr, cosmos.ZeroUint(), signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+
+	m = NewMsgLoanOpen(collateral, amt, common.ETHAsset, common.NoAddress, cosmos.ZeroUint(), signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+
+	m = NewMsgLoanOpen(collateral, amt, common.ETHAsset, ethAddr, cosmos.ZeroUint(), cosmos.AccAddress{}, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+}
+
+func (MsgLoanSuite) TestLoanRepayment(c *C) {
+	collateral := common.BTCAsset.GetTradeAsset()
+	owner := GetRandomBech32Addr()
+	signer := GetRandomBech32Addr()
+	coin := common.NewCoin(common.ETHAsset, cosmos.NewUint(100))
+	dummyTx := common.Tx{ID: "test"}
+
+	m := NewMsgLoanRepayment(owner, collateral, coin, signer, dummyTx)
+	EnsureMsgBasicCorrect(m, c)
+
+	m = NewMsgLoanRepayment(cosmos.AccAddress{}, collateral, coin, signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+
+	m = NewMsgLoanRepayment(owner, common.BTCAsset, coin, signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+
+	m = NewMsgLoanRepayment(owner, collateral, common.NewCoin(common.ETHAsset, cosmos.ZeroUint()), signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+
+	m = NewMsgLoanRepayment(owner, collateral, common.NewCoin(common.ETHAsset.GetSyntheticAsset(), cosmos.NewUint(100)), signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+}
@@ -0,0 +1,153 @@
+package types
+
+import (
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+)
+
+var (
+	_ cosmos.Msg = &MsgMAYANameList{}
+	_ cosmos.Msg = &MsgMAYANameDelist{}
+	_ cosmos.Msg = &MsgMAYANameBuy{}
+)
+
+// NewMsgMAYANameList is a constructor function for MsgMAYANameList. An expire
+// height of zero keeps the listing open until the MAYAName expires.
+func NewMsgMAYANameList(name string, price cosmos.Uint, expireHeight int64, keepAliases, keepSubaffiliates bool, signer cosmos.AccAddress, tx common.Tx) *MsgMAYANameList {
+	return &MsgMAYANameList{
+		Tx:                tx,
+		Name:              name,
+		Price:             price,
+		ExpireHeight:      expireHeight,
+		KeepAliases:       keepAliases,
+		KeepSubaffiliates: keepSubaffiliates,
+		Signer:            signer,
+	}
+}
+
+// Route should return the route key of the module
+func (m *MsgMAYANameList) Route() string { return RouterKey }
+
+// Type should return the action
+func (m MsgMAYANameList) Type() string { return "mayaname_list" }
+
+// ValidateBasic runs stateless checks on the message
+func (m *MsgMAYANameList) ValidateBasic() error {
+	if m.Name == "" {
+		return cosmos.ErrUnknownRequest("name cannot be empty")
+	}
+	if m.Price.IsZero() {
+		return cosmos.ErrUnknownRequest("price cannot be zero")
+	}
+	if m.ExpireHeight < 0 {
+		return cosmos.ErrUnknownRequest("expire height cannot be negative")
+	}
+	if !m.Tx.Coins.IsEmpty() {
+		return cosmos.ErrUnknownRequest("listing does not accept funds")
+	}
+	if m.Signer.Empty() {
+		return cosmos.ErrInvalidAddress(m.Signer.String())
+	}
+	if m.Tx.ID.IsEmpty() {
+		return cosmos.ErrUnknownRequest("txID cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (m *MsgMAYANameList) GetSignBytes() []byte {
+	return cosmos.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgMAYANameList) GetSigners() []cosmos.AccAddress {
+	return []cosmos.AccAddress{m.Signer}
+}
+
+// NewMsgMAYANameDelist is a constructor function for MsgMAYANameDelist
+func NewMsgMAYANameDelist(name string, signer cosmos.AccAddress, tx common.Tx) *MsgMAYANameDelist {
+	return &MsgMAYANameDelist{
+		Tx:     tx,
+		Name:   name,
+		Signer: signer,
+	}
+}
+
+// Route should return the route key of the module
+func (m *MsgMAYANameDelist) Route() string { return RouterKey }
+
+// Type should return the action
+func (m MsgMAYANameDelist) Type() string { return "mayaname_delist" }
+
+// ValidateBasic runs stateless checks on the message
+func (m *MsgMAYANameDelist) ValidateBasic() error {
+	if m.Name == "" {
+		return cosmos.ErrUnknownRequest("name cannot be empty")
+	}
+	if !m.Tx.Coins.IsEmpty() {
+		return cosmos.ErrUnknownRequest("delisting does not accept funds")
+	}
+	if m.Signer.Empty() {
+		return cosmos.ErrInvalidAddress(m.Signer.String())
+	}
+	if m.Tx.ID.IsEmpty() {
+		return cosmos.ErrUnknownRequest("txID cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (m *MsgMAYANameDelist) GetSignBytes() []byte {
+	return cosmos.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgMAYANameDelist) GetSigners() []cosmos.AccAddress {
+	return []cosmos.AccAddress{m.Signer}
+}
+
+// NewMsgMAYANameBuy is a constructor function for MsgMAYANameBuy
+func NewMsgMAYANameBuy(name string, coin common.Coin, signer cosmos.AccAddress, tx common.Tx) *MsgMAYANameBuy {
+	return &MsgMAYANameBuy{
+		Tx:     tx,
+		Name:   name,
+		Coin:   coin,
+		Signer: signer,
+	}
+}
+
+// Route should return the route key of the module
+func (m *MsgMAYANameBuy) Route() string { return RouterKey }
+
+// Type should return the action
+func (m MsgMAYANameBuy) Type() string { return "mayaname_buy" }
+
+// ValidateBasic runs stateless checks on the message
+func (m *MsgMAYANameBuy) ValidateBasic() error {
+	if m.Name == "" {
+		return cosmos.ErrUnknownRequest("name cannot be empty")
+	}
+	if !m.Coin.Asset.IsNativeBase() {
+		return cosmos.ErrUnknownRequest("a mayaname can only be bought with " + common.BaseNative.String())
+	}
+	if m.Coin.Amount.IsZero() {
+		return cosmos.ErrUnknownRequest("amount cannot be zero")
+	}
+	if m.Signer.Empty() {
+		return cosmos.ErrInvalidAddress(m.Signer.String())
+	}
+	if m.Tx.ID.IsEmpty() {
+		return cosmos.ErrUnknownRequest("txID cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (m *MsgMAYANameBuy) GetSignBytes() []byte {
+	return cosmos.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgMAYANameBuy) GetSigners() []cosmos.AccAddress {
+	return []cosmos.AccAddress{m.Signer}
+}
@@ -0,0 +1,1221 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: mayachain/v1/x/mayachain/types/msg_mayaname_sale.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	common "gitlab.com/mayachain/mayanode/common"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type MsgMAYANameList struct {
+	Tx                common.Tx                                     `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx"`
+	Name              string                                        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price             github_com_cosmos_cosmos_sdk_types.Uint       `protobuf:"bytes,3,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"price"`
+	ExpireHeight      int64                                         `protobuf:"varint,4,opt,name=expire_height,json=expireHeight,proto3" json:"expire_height,omitempty"`
+	KeepAliases       bool                                          `protobuf:"varint,5,opt,name=keep_aliases,json=keepAliases,proto3" json:"keep_aliases,omitempty"`
+	KeepSubaffiliates bool                                          `protobuf:"varint,6,opt,name=keep_subaffiliates,json=keepSubaffiliates,proto3" json:"keep_subaffiliates,omitempty"`
+	Signer            github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,7,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+}
+
+func (m *MsgMAYANameList) Reset()         { *m = MsgMAYANameList{} }
+func (m *MsgMAYANameList) String() string { return proto.CompactTextString(m) }
+func (*MsgMAYANameList) ProtoMessage()    {}
+func (*MsgMAYANameList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aeb418c126c903b4, []int{0}
+}
+func (m *MsgMAYANameList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMAYANameList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMAYANameList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMAYANameList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMAYANameList.Merge(m, src)
+}
+func (m *MsgMAYANameList) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMAYANameList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMAYANameList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMAYANameList proto.InternalMessageInfo
+
+func (m *MsgMAYANameList) GetTx() common.Tx {
+	if m != nil {
+		return m.Tx
+	}
+	return common.Tx{}
+}
+
+func (m *MsgMAYANameList) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgMAYANameList) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *MsgMAYANameList) GetKeepAliases() bool {
+	if m != nil {
+		return m.KeepAliases
+	}
+	return false
+}
+
+func (m *MsgMAYANameList) GetKeepSubaffiliates() bool {
+	if m != nil {
+		return m.KeepSubaffiliates
+	}
+	return false
+}
+
+func (m *MsgMAYANameList) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
+type MsgMAYANameDelist struct {
+	Tx     common.Tx                                     `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx"`
+	Name   string                                        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+}
+
+func (m *MsgMAYANameDelist) Reset()         { *m = MsgMAYANameDelist{} }
+func (m *MsgMAYANameDelist) String() string { return proto.CompactTextString(m) }
+func (*MsgMAYANameDelist) ProtoMessage()    {}
+func (*MsgMAYANameDelist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aeb418c126c903b4, []int{1}
+}
+func (m *MsgMAYANameDelist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMAYANameDelist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMAYANameDelist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMAYANameDelist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMAYANameDelist.Merge(m, src)
+}
+func (m *MsgMAYANameDelist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMAYANameDelist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMAYANameDelist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMAYANameDelist proto.InternalMessageInfo
+
+func (m *MsgMAYANameDelist) GetTx() common.Tx {
+	if m != nil {
+		return m.Tx
+	}
+	return common.Tx{}
+}
+
+func (m *MsgMAYANameDelist) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgMAYANameDelist) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
+type MsgMAYANameBuy struct {
+	Tx     common.Tx                                     `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx"`
+	Name   string                                        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Coin   common.Coin                                   `protobuf:"bytes,3,opt,name=coin,proto3" json:"coin"`
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+}
+
+func (m *MsgMAYANameBuy) Reset()         { *m = MsgMAYANameBuy{} }
+func (m *MsgMAYANameBuy) String() string { return proto.CompactTextString(m) }
+func (*MsgMAYANameBuy) ProtoMessage()    {}
+func (*MsgMAYANameBuy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aeb418c126c903b4, []int{2}
+}
+func (m *MsgMAYANameBuy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMAYANameBuy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMAYANameBuy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMAYANameBuy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMAYANameBuy.Merge(m, src)
+}
+func (m *MsgMAYANameBuy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMAYANameBuy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMAYANameBuy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMAYANameBuy proto.InternalMessageInfo
+
+func (m *MsgMAYANameBuy) GetTx() common.Tx {
+	if m != nil {
+		return m.Tx
+	}
+	return common.Tx{}
+}
+
+func (m *MsgMAYANameBuy) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgMAYANameBuy) GetCoin() common.Coin {
+	if m != nil {
+		return m.Coin
+	}
+	return common.Coin{}
+}
+
+func (m *MsgMAYANameBuy) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgMAYANameList)(nil), "types.MsgMAYANameList")
+	proto.RegisterType((*MsgMAYANameDelist)(nil), "types.MsgMAYANameDelist")
+	proto.RegisterType((*MsgMAYANameBuy)(nil), "types.MsgMAYANameBuy")
+}
+
+func init() {
+	proto.RegisterFile("mayachain/v1/x/mayachain/types/msg_mayaname_sale.proto", fileDescriptor_aeb418c126c903b4)
+}
+
+var fileDescriptor_aeb418c126c903b4 = []byte{
+	// 440 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x31, 0x8f, 0xd3, 0x30,
+	0x14, 0xc7, 0xeb, 0x36, 0x2d, 0xe0, 0x16, 0xd0, 0x59, 0x0c, 0xd1, 0x0d, 0x69, 0x38, 0x24, 0xc8,
+	0xd2, 0x5a, 0x77, 0x48, 0xec, 0x09, 0x20, 0x71, 0x12, 0xc7, 0x10, 0x60, 0x80, 0xa5, 0x72, 0xd3,
+	0x77, 0xae, 0x75, 0x49, 0x1c, 0xc5, 0x2e, 0x4a, 0xbf, 0x05, 0x03, 0x5f, 0x86, 0x6f, 0x70, 0x63,
+	0x47, 0xc4, 0x50, 0xa1, 0xf6, 0x5b, 0x30, 0x21, 0x3b, 0x41, 0x2d, 0x62, 0x39, 0x5d, 0x27, 0xbf,
+	0xfc, 0xdf, 0xfb, 0xff, 0xf5, 0x8b, 0xe5, 0x87, 0x5f, 0x64, 0x6c, 0xc9, 0x92, 0x39, 0x13, 0x39,
+	0xfd, 0x72, 0x4a, 0x2b, 0xba, 0xfb, 0xd4, 0xcb, 0x02, 0x14, 0xcd, 0x14, 0x9f, 0x18, 0x2d, 0x67,
+	0x19, 0x4c, 0x14, 0x4b, 0x61, 0x5c, 0x94, 0x52, 0x4b, 0xd2, 0xb5, 0xed, 0x63, 0xff, 0x1f, 0x7b,
+	0x22, 0xb3, 0x4c, 0xe6, 0xcd, 0x51, 0x0f, 0x1e, 0x3f, 0xe2, 0x92, 0x4b, 0x5b, 0x52, 0x53, 0xd5,
+	0xea, 0xc9, 0xaa, 0x8d, 0x1f, 0x5e, 0x28, 0x7e, 0x11, 0x7e, 0x0a, 0xdf, 0xb1, 0x0c, 0xde, 0x0a,
+	0xa5, 0x89, 0x8f, 0xdb, 0xba, 0x72, 0x91, 0x8f, 0x82, 0xfe, 0x19, 0x1e, 0x37, 0x21, 0x1f, 0xaa,
+	0xc8, 0xb9, 0x5e, 0x0f, 0x5b, 0x71, 0x5b, 0x57, 0x84, 0x60, 0xc7, 0x70, 0xb8, 0x6d, 0x1f, 0x05,
+	0xf7, 0x62, 0x5b, 0x93, 0xd7, 0xb8, 0x5b, 0x94, 0x22, 0x01, 0xb7, 0x63, 0xc4, 0x88, 0x9a, 0xe1,
+	0x9f, 0xeb, 0xe1, 0x33, 0x2e, 0xf4, 0x7c, 0x31, 0x35, 0x31, 0x34, 0x91, 0x2a, 0x93, 0xaa, 0x39,
+	0x46, 0x6a, 0x76, 0x55, 0xff, 0xdb, 0xf8, 0xa3, 0xc8, 0x75, 0x5c, 0xbb, 0xc9, 0x13, 0x7c, 0x1f,
+	0xaa, 0x42, 0x94, 0x30, 0x99, 0x83, 0xe0, 0x73, 0xed, 0x3a, 0x3e, 0x0a, 0x3a, 0xf1, 0xa0, 0x16,
+	0xdf, 0x58, 0x8d, 0x3c, 0xc6, 0x83, 0x2b, 0x80, 0x62, 0xc2, 0x52, 0xc1, 0x14, 0x28, 0xb7, 0xeb,
+	0xa3, 0xe0, 0x6e, 0xdc, 0x37, 0x5a, 0x58, 0x4b, 0x64, 0x84, 0x89, 0x1d, 0x51, 0x8b, 0x29, 0xbb,
+	0xbc, 0x14, 0xa9, 0x60, 0x1a, 0x94, 0xdb, 0xb3, 0x83, 0x47, 0xa6, 0xf3, 0x7e, 0xbf, 0x41, 0xce,
+	0x71, 0x4f, 0x09, 0x9e, 0x43, 0xe9, 0xde, 0xf1, 0x51, 0x30, 0x88, 0x4e, 0x7f, 0xaf, 0x87, 0xa3,
+	0x1b, 0xa0, 0x87, 0x49, 0x12, 0xce, 0x66, 0x25, 0x28, 0x15, 0x37, 0x01, 0x27, 0xdf, 0x10, 0x3e,
+	0xda, 0xbb, 0xd2, 0x57, 0x90, 0xde, 0xfe, 0x52, 0x77, 0x58, 0x9d, 0x43, 0xb1, 0xbe, 0x23, 0xfc,
+	0x60, 0x0f, 0x2b, 0x5a, 0x2c, 0x6f, 0xc9, 0xf4, 0x14, 0x3b, 0x89, 0x14, 0xb9, 0x25, 0xea, 0x9f,
+	0x0d, 0xfe, 0xfa, 0x5e, 0x4a, 0x91, 0x37, 0x4e, 0xdb, 0xdf, 0x63, 0x77, 0x0e, 0x64, 0x8f, 0xce,
+	0xaf, 0x37, 0x1e, 0x5a, 0x6d, 0x3c, 0xf4, 0x6b, 0xe3, 0xa1, 0xaf, 0x5b, 0xaf, 0xb5, 0xda, 0x7a,
+	0xad, 0x1f, 0x5b, 0xaf, 0xf5, 0x99, 0x72, 0xa1, 0x53, 0x56, 0x07, 0xee, 0x56, 0xc0, 0x2e, 0x8a,
+	0x9c, 0xc1, 0xff, 0x7b, 0x34, 0xed, 0xd9, 0x77, 0xff, 0xfc, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff,
+	0x2b, 0xa8, 0x00, 0x65, 0x70, 0x03, 0x00, 0x00,
+}
+
+func (m *MsgMAYANameList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMAYANameList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMAYANameList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgMayanameSale(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.KeepSubaffiliates {
+		i--
+		if m.KeepSubaffiliates {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.KeepAliases {
+		i--
+		if m.KeepAliases {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExpireHeight != 0 {
+		i = encodeVarintMsgMayanameSale(dAtA, i, uint64(m.ExpireHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgMayanameSale(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMsgMayanameSale(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgMayanameSale(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMAYANameDelist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMAYANameDelist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMAYANameDelist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgMayanameSale(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMsgMayanameSale(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgMayanameSale(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMAYANameBuy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMAYANameBuy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMAYANameBuy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgMayanameSale(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgMayanameSale(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMsgMayanameSale(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgMayanameSale(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgMayanameSale(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgMayanameSale(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgMAYANameList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tx.Size()
+	n += 1 + l + sovMsgMayanameSale(uint64(l))
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMsgMayanameSale(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovMsgMayanameSale(uint64(l))
+	if m.ExpireHeight != 0 {
+		n += 1 + sovMsgMayanameSale(uint64(m.ExpireHeight))
+	}
+	if m.KeepAliases {
+		n += 2
+	}
+	if m.KeepSubaffiliates {
+		n += 2
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgMayanameSale(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMAYANameDelist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tx.Size()
+	n += 1 + l + sovMsgMayanameSale(uint64(l))
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMsgMayanameSale(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgMayanameSale(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMAYANameBuy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tx.Size()
+	n += 1 + l + sovMsgMayanameSale(uint64(l))
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMsgMayanameSale(uint64(l))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovMsgMayanameSale(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgMayanameSale(uint64(l))
+	}
+	return n
+}
+
+func sovMsgMayanameSale(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgMayanameSale(x uint64) (n int) {
+	return sovMsgMayanameSale(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgMAYANameList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgMayanameSale
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMAYANameList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMAYANameList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgMayanameSale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgMayanameSale
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgMayanameSale
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgMayanameSale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgMayanameSale
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgMayanameSale
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgMayanameSale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgMayanameSale
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgMayanameSale
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireHeight", wireType)
+			}
+			m.ExpireHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgMayanameSale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepAliases", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgMayanameSale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.KeepAliases = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepSubaffiliates", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgMayanameSale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.KeepSubaffiliates = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgMayanameSale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgMayanameSale
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgMayanameSale
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgMayanameSale(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgMayanameSale
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgMayanameSale
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMAYANameDelist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgMayanameSale
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMAYANameDelist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMAYANameDelist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgMayanameSale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgMayanameSale
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgMayanameSale
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgMayanameSale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgMayanameSale
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgMayanameSale
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgMayanameSale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgMayanameSale
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgMayanameSale
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgMayanameSale(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgMayanameSale
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgMayanameSale
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMAYANameBuy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgMayanameSale
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMAYANameBuy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMAYANameBuy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgMayanameSale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgMayanameSale
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgMayanameSale
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgMayanameSale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgMayanameSale
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgMayanameSale
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgMayanameSale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgMayanameSale
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgMayanameSale
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgMayanameSale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgMayanameSale
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgMayanameSale
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgMayanameSale(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgMayanameSale
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgMayanameSale
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMsgMayanameSale(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMsgMayanameSale
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMsgMayanameSale
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMsgMayanameSale
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMsgMayanameSale
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMsgMayanameSale
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMsgMayanameSale
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMsgMayanameSale        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMsgMayanameSale          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMsgMayanameSale = fmt.Errorf("proto: unexpected end of group")
+)
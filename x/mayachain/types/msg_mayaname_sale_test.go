@@ -0,0 +1,67 @@
+package types
+
+import (
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	. "gopkg.in/check.v1"
+)
+
+type MsgMAYANameSaleSuite struct{}
+
+var _ = Suite(&MsgMAYANameSaleSuite{})
+
+func (MsgMAYANameSaleSuite) TestMsgMAYANameList(c *C) {
+	signer := GetRandomBech32Addr()
+	price := cosmos.NewUint(100 * common.One)
+	dummyTx := common.Tx{ID: "test"}
+
+	m := NewMsgMAYANameList("alice", price, 0, false, false, signer, dummyTx)
+	EnsureMsgBasicCorrect(m, c)
+	c.Check(m.Type(), Equals, "mayaname_list")
+
+	m = NewMsgMAYANameList("", price, 0, false, false, signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+	m = NewMsgMAYANameList("alice", cosmos.ZeroUint(), 0, false, false, signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+	m = NewMsgMAYANameList("alice", price, -1, false, false, signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+	m = NewMsgMAYANameList("alice", price, 0, false, false, cosmos.AccAddress{}, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+
+	tx := common.Tx{ID: "test", Coins: common.NewCoins(common.NewCoin(common.BaseNative, cosmos.NewUint(common.One)))}
+	m = NewMsgMAYANameList("alice", price, 0, false, false, signer, tx)
+	c.Check(m.ValidateBasic(), NotNil)
+}
+
+func (MsgMAYANameSaleSuite) TestMsgMAYANameDelist(c *C) {
+	signer := GetRandomBech32Addr()
+	dummyTx := common.Tx{ID: "test"}
+
+	m := NewMsgMAYANameDelist("alice", signer, dummyTx)
+	EnsureMsgBasicCorrect(m, c)
+	c.Check(m.Type(), Equals, "mayaname_delist")
+
+	m = NewMsgMAYANameDelist("", signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+	m = NewMsgMAYANameDelist("alice", cosmos.AccAddress{}, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+}
+
+func (MsgMAYANameSaleSuite) TestMsgMAYANameBuy(c *C) {
+	signer := GetRandomBech32Addr()
+	coin := common.NewCoin(common.BaseNative, cosmos.NewUint(100*common.One))
+	dummyTx := common.Tx{ID: "test"}
+
+	m := NewMsgMAYANameBuy("alice", coin, signer, dummyTx)
+	EnsureMsgBasicCorrect(m, c)
+	c.Check(m.Type(), Equals, "mayaname_buy")
+
+	m = NewMsgMAYANameBuy("", coin, signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+	m = NewMsgMAYANameBuy("alice", common.NewCoin(common.BTCAsset, cosmos.NewUint(common.One)), signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+	m = NewMsgMAYANameBuy("alice", common.NewCoin(common.BaseNative, cosmos.ZeroUint()), signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+	m = NewMsgMAYANameBuy("alice", coin, cosmos.AccAddress{}, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+}
@@ -27,16 +27,50 @@ func NewMsgSwap(tx common.Tx, target common.Asset, destination common.Address, t
 	}
 }
 
+// NewMsgSwapCancel is a constructor function for a MsgSwap that cancels the
+// limit order or streaming swap with the given tx id
+func NewMsgSwapCancel(tx common.Tx, cancelTxID common.TxID, signer cosmos.AccAddress) *MsgSwap {
+	return &MsgSwap{
+		Tx:                   tx,
+		TradeTarget:          cosmos.ZeroUint(),
+		AffiliateBasisPoints: cosmos.ZeroUint(),
+		Signer:               signer,
+		OrderType:            OrderType_cancel,
+		CancelTxID:           cancelTxID,
+	}
+}
+
+// IsCancel returns true when the message cancels a limit order or a streaming
+// swap, rather than swapping
+func (m *MsgSwap) IsCancel() bool {
+	return m.OrderType == OrderType_cancel
+}
+
+// IsLimitOrder returns true when the message is a limit order
+func (m *MsgSwap) IsLimitOrder() bool {
+	return m.OrderType == OrderType_limit
+}
+
+// IsDCA returns true when the message is a dollar-cost-averaging order
+func (m *MsgSwap) IsDCA() bool {
+	return m.OrderType == OrderType_dca
+}
+
 func (m *MsgSwap) IsStreaming() bool {
 	return m.StreamInterval > 0
 }
 
 func (m *MsgSwap) GetStreamingSwap() StreamingSwap {
+	target := m.TradeTarget
+	// the trade target of a dca order is the price limit of a single slice
+	if m.IsDCA() {
+		target = target.MulUint64(m.StreamQuantity)
+	}
 	return NewStreamingSwap(
 		m.Tx.ID,
 		m.StreamQuantity,
 		m.StreamInterval,
-		m.TradeTarget,
+		target,
 		m.Tx.Coins[0].Amount,
 	)
 }
@@ -47,6 +81,79 @@ func (m *MsgSwap) Route() string { return RouterKey }
 // Type should return the action
 func (m MsgSwap) Type() string { return "swap" }
 
+// ValidateBasicV124 runs stateless checks on the message
+func (m *MsgSwap) ValidateBasicV124(version semver.Version) error {
+	if m.Signer.Empty() {
+		return cosmos.ErrInvalidAddress(m.Signer.String())
+	}
+	if err := m.Tx.Valid(); err != nil {
+		return cosmos.ErrUnknownRequest(err.Error())
+	}
+	if m.IsCancel() {
+		return m.validateCancel()
+	}
+	if m.TargetAsset.IsEmpty() {
+		return cosmos.ErrUnknownRequest("swap Target cannot be empty")
+	}
+	if len(m.Tx.Coins) > 1 {
+		return cosmos.ErrUnknownRequest("not expecting multiple coins in a swap")
+	}
+	if m.Tx.Coins.IsEmpty() {
+		return cosmos.ErrUnknownRequest("swap coin cannot be empty")
+	}
+	for _, coin := range m.Tx.Coins {
+		if coin.Asset.Equals(m.TargetAsset) {
+			return cosmos.ErrUnknownRequest("swap Source and Target cannot be the same.")
+		}
+	}
+	if m.Tx.Coins.HasNoneNativeRune() {
+		return cosmos.ErrUnknownRequest("only NATIVE RUNE can be used for swap")
+	}
+	if m.Destination.IsEmpty() {
+		return cosmos.ErrUnknownRequest("swap Destination cannot be empty")
+	}
+	// verify AffiliateAddress & m.AffiliateBasisPoints
+	if m.AffiliateAddress.IsEmpty() {
+		// if AffiliateAddress not provided in the swap memo
+		// AffiliateBasisPoints must be empty or zero
+		if !(m.AffiliateBasisPoints.IsZero() || m.AffiliateBasisPoints.Equal(EmptyBps)) {
+			return cosmos.ErrUnknownRequest("swap affiliate address is empty while affiliate basis points is provided")
+		}
+	} else {
+		// if AffiliateAddress provided in the swap memo
+		// AffiliateAddress must be a valid MAYA address
+		if !m.AffiliateAddress.IsChain(common.BASEChain, version) {
+			return cosmos.ErrUnknownRequest("swap affiliate address must be a MAYA address")
+		}
+		// AffiliateBasisPoints must be provided too (but zero is allowed)
+		if m.AffiliateBasisPoints.Equal(EmptyBps) {
+			return cosmos.ErrUnknownRequest("swap affiliate basis points mut be provided")
+		}
+	}
+	if !m.Destination.IsNoop() && !m.Destination.IsChain(m.TargetAsset.GetChain(), version) {
+		return cosmos.ErrUnknownRequest("swap destination address is not the same chain as the target asset")
+	}
+	if len(m.Aggregator) != 0 && len(m.AggregatorTargetAddress) == 0 {
+		return cosmos.ErrUnknownRequest("aggregator target asset address is empty")
+	}
+	if len(m.AggregatorTargetAddress) > 0 && len(m.Aggregator) == 0 {
+		return cosmos.ErrUnknownRequest("aggregator is empty")
+	}
+	if m.ExpiryHeight < 0 {
+		return cosmos.ErrUnknownRequest("expiry height cannot be negative")
+	}
+	if m.ExpiryHeight > 0 && m.OrderType != OrderType_limit {
+		return cosmos.ErrUnknownRequest("expiry height is only supported on limit orders")
+	}
+	if m.OrderType == OrderType_limit && m.IsStreaming() {
+		return cosmos.ErrUnknownRequest("limit orders cannot be streaming swaps")
+	}
+	if m.IsDCA() && (!m.IsStreaming() || m.StreamQuantity < 2) {
+		return cosmos.ErrUnknownRequest("dca orders need an interval and at least two slices")
+	}
+	return nil
+}
+
 // ValidateBasicV112 runs stateless checks on the message
 func (m *MsgSwap) ValidateBasicV112(version semver.Version) error {
 	if m.Signer.Empty() {
@@ -105,6 +212,21 @@ func (m *MsgSwap) ValidateBasicV112(version semver.Version) error {
 	return nil
 }
 
+// validateCancel runs stateless checks on a message cancelling a limit order or
+// a streaming swap
+func (m *MsgSwap) validateCancel() error {
+	if m.CancelTxID.IsEmpty() {
+		return cosmos.ErrUnknownRequest("cancel tx id cannot be empty")
+	}
+	if m.CancelTxID.Equals(m.Tx.ID) {
+		return cosmos.ErrUnknownRequest("order cannot cancel itself")
+	}
+	if m.ExpiryHeight != 0 || m.IsStreaming() {
+		return cosmos.ErrUnknownRequest("cancel order cannot have an expiry height or be streaming")
+	}
+	return nil
+}
+
 // ValidateBasic runs stateless checks on the message
 func (m *MsgSwap) ValidateBasic() error {
 	if m.Signer.Empty() {
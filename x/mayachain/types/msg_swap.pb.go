@@ -31,16 +31,22 @@ type OrderType int32
 const (
 	OrderType_market OrderType = 0
 	OrderType_limit  OrderType = 1
+	OrderType_cancel OrderType = 2
+	OrderType_dca    OrderType = 3
 )
 
 var OrderType_name = map[int32]string{
 	0: "market",
 	1: "limit",
+	2: "cancel",
+	3: "dca",
 }
 
 var OrderType_value = map[string]int32{
 	"market": 0,
 	"limit":  1,
+	"cancel": 2,
+	"dca":    3,
 }
 
 func (x OrderType) String() string {
@@ -65,6 +71,8 @@ type MsgSwap struct {
 	OrderType               OrderType                                     `protobuf:"varint,11,opt,name=order_type,json=orderType,proto3,enum=types.OrderType" json:"order_type,omitempty"`
 	StreamQuantity          uint64                                        `protobuf:"varint,12,opt,name=stream_quantity,json=streamQuantity,proto3" json:"stream_quantity,omitempty"`
 	StreamInterval          uint64                                        `protobuf:"varint,13,opt,name=stream_interval,json=streamInterval,proto3" json:"stream_interval,omitempty"`
+	ExpiryHeight            int64                                         `protobuf:"varint,14,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	CancelTxID              gitlab_com_mayachain_mayanode_common.TxID     `protobuf:"bytes,15,opt,name=cancel_tx_id,json=cancelTxId,proto3,casttype=gitlab.com/mayachain/mayanode/common.TxID" json:"cancel_tx_id,omitempty"`
 }
 
 func (m *MsgSwap) Reset()         { *m = MsgSwap{} }
@@ -170,6 +178,20 @@ func (m *MsgSwap) GetStreamInterval() uint64 {
 	return 0
 }
 
+func (m *MsgSwap) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *MsgSwap) GetCancelTxID() gitlab_com_mayachain_mayanode_common.TxID {
+	if m != nil {
+		return m.CancelTxID
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("types.OrderType", OrderType_name, OrderType_value)
 	proto.RegisterType((*MsgSwap)(nil), "types.MsgSwap")
@@ -180,42 +202,47 @@ func init() {
 }
 
 var fileDescriptor_b1915766ca9ad929 = []byte{
-	// 546 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcf, 0x6f, 0x12, 0x41,
-	0x14, 0xc7, 0xd9, 0x5a, 0xa8, 0x3c, 0x68, 0xc5, 0x49, 0xb5, 0x63, 0x0f, 0xcb, 0xa6, 0x97, 0x12,
-	0x23, 0xac, 0xad, 0x89, 0x07, 0x6f, 0x70, 0x23, 0xd1, 0xa8, 0x2b, 0x5e, 0x4c, 0xcc, 0x66, 0x60,
-	0xa7, 0xd3, 0x49, 0xd9, 0x9d, 0x75, 0xe6, 0xb5, 0x85, 0xff, 0xc2, 0x3f, 0xab, 0xc7, 0x1e, 0x8d,
-	0x07, 0x62, 0xc0, 0xbf, 0xa2, 0x27, 0xb3, 0xbf, 0x00, 0x6d, 0x62, 0xb4, 0xa7, 0x79, 0xf3, 0xe5,
-	0xfb, 0x3e, 0xef, 0x47, 0x86, 0x85, 0x76, 0xc8, 0xa6, 0x6c, 0x74, 0xca, 0x64, 0xe4, 0x5e, 0x1c,
-	0xb9, 0x13, 0x77, 0x75, 0xc5, 0x69, 0xcc, 0x8d, 0x1b, 0x1a, 0xe1, 0x9b, 0x4b, 0x16, 0x77, 0x62,
-	0xad, 0x50, 0x91, 0x72, 0xaa, 0xee, 0x3b, 0xbf, 0x65, 0x8d, 0x54, 0x18, 0xaa, 0x28, 0x3f, 0x32,
-	0xe3, 0xfe, 0xae, 0x50, 0x42, 0xa5, 0xa1, 0x9b, 0x44, 0x99, 0x7a, 0xf0, 0xb3, 0x02, 0x5b, 0x6f,
-	0x8c, 0xf8, 0x70, 0xc9, 0x62, 0xe2, 0xc0, 0x06, 0x4e, 0xa8, 0xe5, 0x58, 0xad, 0xda, 0x31, 0x74,
-	0xf2, 0xe4, 0xc1, 0xa4, 0xb7, 0x79, 0x35, 0x6b, 0x96, 0xbc, 0x0d, 0x9c, 0x90, 0x97, 0x50, 0x47,
-	0xa6, 0x05, 0x47, 0x9f, 0x19, 0xc3, 0x91, 0x6e, 0xa4, 0xde, 0xed, 0xc2, 0xdb, 0x4d, 0xc4, 0xdc,
-	0x5e, 0xcb, 0x8c, 0xa9, 0x44, 0x3c, 0xa8, 0x05, 0xdc, 0xa0, 0x8c, 0x18, 0x4a, 0x15, 0xd1, 0x7b,
-	0x8e, 0xd5, 0xaa, 0xf6, 0x9e, 0xdf, 0xcc, 0x9a, 0xcf, 0x84, 0xc4, 0x31, 0x1b, 0x26, 0x80, 0xb5,
-	0x41, 0x93, 0x28, 0x52, 0x01, 0x2f, 0x06, 0xe8, 0x06, 0x81, 0xe6, 0xc6, 0x78, 0xeb, 0x10, 0xe2,
-	0x41, 0x1d, 0x35, 0x0b, 0xb8, 0x9f, 0x15, 0xa2, 0x9b, 0x29, 0xd4, 0x4d, 0x8a, 0x7f, 0x9f, 0x35,
-	0x0f, 0x85, 0xc4, 0xd3, 0xf3, 0x0c, 0x3c, 0x52, 0x26, 0x54, 0x26, 0x3f, 0xda, 0x26, 0x38, 0xcb,
-	0x36, 0xd9, 0xf9, 0x28, 0x23, 0xf4, 0x6a, 0x29, 0x64, 0x90, 0x32, 0xc8, 0x67, 0x78, 0xc8, 0x4e,
-	0x4e, 0xe4, 0x58, 0x32, 0xe4, 0x3e, 0xcb, 0xaa, 0xd2, 0xf2, 0x1d, 0xbb, 0x6d, 0x2c, 0x51, 0xb9,
-	0x42, 0x38, 0x3c, 0x5e, 0xe1, 0x87, 0xcc, 0x48, 0xe3, 0xc7, 0x4a, 0x46, 0x68, 0x68, 0xe5, 0x6e,
-	0xcd, 0xef, 0x2e, 0x71, 0xbd, 0x84, 0xf6, 0x2e, 0x85, 0x91, 0x3e, 0x54, 0x8c, 0x14, 0x11, 0xd7,
-	0x74, 0xcb, 0xb1, 0x5a, 0xf5, 0xde, 0xd1, 0xcd, 0xac, 0xd9, 0xfe, 0x07, 0x64, 0x77, 0x34, 0x2a,
-	0x7a, 0xcf, 0x01, 0xc4, 0x06, 0x60, 0x42, 0x68, 0x2e, 0x18, 0x2a, 0x4d, 0xef, 0x27, 0x5d, 0x7a,
-	0x6b, 0x0a, 0x79, 0x05, 0x4f, 0x56, 0x37, 0xbf, 0x78, 0x1b, 0xf9, 0xe2, 0xaa, 0xa9, 0x7d, 0x6f,
-	0x65, 0xc8, 0xb6, 0x5c, 0x6c, 0x43, 0xc0, 0xde, 0xed, 0xdc, 0xb1, 0x0c, 0x25, 0x52, 0x58, 0xae,
-	0xc3, 0xfa, 0x9f, 0x75, 0x3c, 0xfa, 0xb3, 0xd4, 0xeb, 0x84, 0x46, 0x5c, 0x00, 0xa5, 0x03, 0xae,
-	0xfd, 0xc4, 0x4a, 0x6b, 0x8e, 0xd5, 0xda, 0x39, 0x6e, 0x74, 0xb2, 0xbc, 0xb7, 0xc9, 0x0f, 0x83,
-	0x69, 0xcc, 0xbd, 0xaa, 0x2a, 0x42, 0x72, 0x08, 0x0f, 0x0c, 0x6a, 0xce, 0x42, 0xff, 0xcb, 0x39,
-	0x8b, 0x50, 0xe2, 0x94, 0xd6, 0x1d, 0xab, 0xb5, 0xe9, 0xed, 0x64, 0xf2, 0xfb, 0x5c, 0x5d, 0x33,
-	0xca, 0x08, 0xb9, 0xbe, 0x60, 0x63, 0xba, 0xbd, 0x6e, 0xec, 0xe7, 0xea, 0xd3, 0x03, 0xa8, 0x2e,
-	0x2b, 0x11, 0x80, 0x4a, 0xc8, 0xf4, 0x19, 0xc7, 0x46, 0x89, 0x54, 0xa1, 0x9c, 0x8e, 0xdc, 0xb0,
-	0x7a, 0xfd, 0xab, 0xb9, 0x6d, 0x5d, 0xcf, 0x6d, 0xeb, 0xc7, 0xdc, 0xb6, 0xbe, 0x2e, 0xec, 0xd2,
-	0xf5, 0xc2, 0x2e, 0x7d, 0x5b, 0xd8, 0xa5, 0x4f, 0xee, 0xdf, 0xdf, 0xdd, 0xad, 0x6f, 0xc4, 0xb0,
-	0x92, 0xfe, 0xb9, 0x5f, 0xfc, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x66, 0x70, 0x63, 0xa9, 0x4c, 0x04,
-	0x00, 0x00,
+	// 625 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x9b, 0x76, 0x6b, 0xe9, 0x6b, 0xb7, 0x15, 0x6b, 0x30, 0xb3, 0x43, 0x1a, 0xc1, 0x61,
+	0x05, 0xb1, 0x86, 0x0d, 0x09, 0x24, 0x38, 0xad, 0x70, 0xa0, 0x12, 0x08, 0x08, 0xe5, 0x82, 0x34,
+	0x45, 0x5e, 0xe2, 0xa5, 0xd6, 0x9a, 0x38, 0xd8, 0xde, 0x96, 0x7e, 0x0b, 0x3e, 0xd6, 0x8e, 0x3b,
+	0x22, 0x0e, 0x15, 0xea, 0x2e, 0x7c, 0x86, 0x9d, 0x50, 0x9c, 0xa4, 0x2d, 0x4c, 0x42, 0x63, 0xa7,
+	0xbc, 0xfc, 0xf3, 0x7f, 0xbf, 0xf7, 0xfc, 0x57, 0x64, 0xd8, 0x0e, 0xc9, 0x98, 0x78, 0x43, 0xc2,
+	0x22, 0xfb, 0x64, 0xc7, 0x4e, 0xec, 0xf9, 0xab, 0x1a, 0xc7, 0x54, 0xda, 0xa1, 0x0c, 0x5c, 0x79,
+	0x4a, 0xe2, 0x6e, 0x2c, 0xb8, 0xe2, 0x68, 0x59, 0xab, 0x9b, 0xd6, 0x1f, 0x5d, 0x1e, 0x0f, 0x43,
+	0x1e, 0xe5, 0x8f, 0xcc, 0xb8, 0xb9, 0x1e, 0xf0, 0x80, 0xeb, 0xd2, 0x4e, 0xab, 0x4c, 0xbd, 0xff,
+	0xab, 0x06, 0xb5, 0x77, 0x32, 0xf8, 0x74, 0x4a, 0x62, 0x64, 0x41, 0x59, 0x25, 0xd8, 0xb0, 0x8c,
+	0x4e, 0x63, 0x17, 0xba, 0x79, 0xf3, 0x20, 0xe9, 0x2d, 0x9d, 0x4d, 0xda, 0x25, 0xa7, 0xac, 0x12,
+	0xf4, 0x0c, 0x9a, 0x8a, 0x88, 0x80, 0x2a, 0x97, 0x48, 0x49, 0x15, 0x2e, 0x6b, 0xef, 0x4a, 0xe1,
+	0xdd, 0x4b, 0xc5, 0xdc, 0xde, 0xc8, 0x8c, 0x5a, 0x42, 0x0e, 0x34, 0x7c, 0x2a, 0x15, 0x8b, 0x88,
+	0x62, 0x3c, 0xc2, 0x15, 0xcb, 0xe8, 0xd4, 0x7b, 0x4f, 0x2e, 0x27, 0xed, 0xc7, 0x01, 0x53, 0x23,
+	0x72, 0x90, 0x02, 0x16, 0x0e, 0x9a, 0x56, 0x11, 0xf7, 0x69, 0x71, 0x80, 0x3d, 0xdf, 0x17, 0x54,
+	0x4a, 0x67, 0x11, 0x82, 0x1c, 0x68, 0x2a, 0x41, 0x7c, 0xea, 0x66, 0x83, 0xf0, 0x92, 0x86, 0xda,
+	0xe9, 0xf0, 0x1f, 0x93, 0xf6, 0x56, 0xc0, 0xd4, 0xf0, 0x38, 0x03, 0x7b, 0x5c, 0x86, 0x5c, 0xe6,
+	0x8f, 0x6d, 0xe9, 0x1f, 0x65, 0x49, 0x76, 0x3f, 0xb3, 0x48, 0x39, 0x0d, 0x0d, 0x19, 0x68, 0x06,
+	0xda, 0x87, 0xdb, 0xe4, 0xf0, 0x90, 0x8d, 0x18, 0x51, 0xd4, 0x25, 0xd9, 0x54, 0xbc, 0x7c, 0xc3,
+	0x6d, 0x5b, 0x33, 0x54, 0xae, 0x20, 0x0a, 0x77, 0xe7, 0xf8, 0x03, 0x22, 0x99, 0x74, 0x63, 0xce,
+	0x22, 0x25, 0x71, 0xf5, 0x66, 0xcb, 0xaf, 0xcf, 0x70, 0xbd, 0x94, 0xf6, 0x41, 0xc3, 0x50, 0x1f,
+	0xaa, 0x92, 0x05, 0x11, 0x15, 0xb8, 0x66, 0x19, 0x9d, 0x66, 0x6f, 0xe7, 0x72, 0xd2, 0xde, 0xbe,
+	0x06, 0x72, 0xcf, 0xf3, 0x8a, 0xdd, 0x73, 0x00, 0x32, 0x01, 0x48, 0x10, 0x08, 0x1a, 0x10, 0xc5,
+	0x05, 0xbe, 0x95, 0x6e, 0xe9, 0x2c, 0x28, 0xe8, 0x05, 0xdc, 0x9b, 0xbf, 0xb9, 0xc5, 0xbf, 0x91,
+	0x07, 0x57, 0xd7, 0xf6, 0x8d, 0xb9, 0x21, 0x4b, 0xb9, 0x48, 0x23, 0x80, 0x8d, 0xab, 0xbd, 0x23,
+	0x16, 0x32, 0x85, 0x61, 0x16, 0x87, 0xf1, 0x3f, 0x71, 0xdc, 0xf9, 0x7b, 0xd4, 0xdb, 0x94, 0x86,
+	0x6c, 0x00, 0x2e, 0x7c, 0x2a, 0xdc, 0xd4, 0x8a, 0x1b, 0x96, 0xd1, 0x59, 0xdd, 0x6d, 0x75, 0xb3,
+	0xbe, 0xf7, 0xe9, 0x87, 0xc1, 0x38, 0xa6, 0x4e, 0x9d, 0x17, 0x25, 0xda, 0x82, 0x35, 0xa9, 0x04,
+	0x25, 0xa1, 0xfb, 0xf5, 0x98, 0x44, 0x8a, 0xa9, 0x31, 0x6e, 0x5a, 0x46, 0x67, 0xc9, 0x59, 0xcd,
+	0xe4, 0x8f, 0xb9, 0xba, 0x60, 0x64, 0x91, 0xa2, 0xe2, 0x84, 0x8c, 0xf0, 0xca, 0xa2, 0xb1, 0x9f,
+	0xab, 0xe8, 0x01, 0xac, 0xd0, 0x24, 0x66, 0x62, 0xec, 0x0e, 0x29, 0x0b, 0x86, 0x0a, 0xaf, 0x5a,
+	0x46, 0xa7, 0xe2, 0x34, 0x33, 0xf1, 0x8d, 0xd6, 0xd0, 0x3e, 0x34, 0x3d, 0x12, 0x79, 0x74, 0xe4,
+	0xaa, 0xc4, 0x65, 0x3e, 0x5e, 0xd3, 0x29, 0xbc, 0x9c, 0x4e, 0xda, 0xf0, 0x4a, 0xeb, 0x83, 0xa4,
+	0xff, 0xfa, 0x72, 0xd2, 0x7e, 0x78, 0xad, 0xdf, 0x30, 0x35, 0x3b, 0xe0, 0x15, 0x8d, 0xfe, 0xa3,
+	0xe7, 0x50, 0x9f, 0x9d, 0x16, 0x01, 0x54, 0x43, 0x22, 0x8e, 0xa8, 0x6a, 0x95, 0x50, 0x1d, 0x96,
+	0x75, 0xec, 0x2d, 0x23, 0x95, 0xb3, 0x8e, 0x56, 0x19, 0xd5, 0xa0, 0xe2, 0x7b, 0xa4, 0x55, 0xe9,
+	0xf5, 0xcf, 0xa6, 0xa6, 0x71, 0x3e, 0x35, 0x8d, 0x9f, 0x53, 0xd3, 0xf8, 0x76, 0x61, 0x96, 0xce,
+	0x2f, 0xcc, 0xd2, 0xf7, 0x0b, 0xb3, 0xf4, 0xc5, 0xfe, 0xf7, 0x26, 0x57, 0x2e, 0xaf, 0x83, 0xaa,
+	0xbe, 0x75, 0x9e, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xb6, 0x22, 0xb1, 0xd3, 0xe5, 0x04, 0x00,
+	0x00,
 }
 
 func (m *MsgSwap) Marshal() (dAtA []byte, err error) {
@@ -238,6 +265,18 @@ func (m *MsgSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CancelTxID) > 0 {
+		i -= len(m.CancelTxID)
+		copy(dAtA[i:], m.CancelTxID)
+		i = encodeVarintMsgSwap(dAtA, i, uint64(len(m.CancelTxID)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintMsgSwap(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x70
+	}
 	if m.StreamInterval != 0 {
 		i = encodeVarintMsgSwap(dAtA, i, uint64(m.StreamInterval))
 		i--
@@ -401,6 +440,13 @@ func (m *MsgSwap) Size() (n int) {
 	if m.StreamInterval != 0 {
 		n += 1 + sovMsgSwap(uint64(m.StreamInterval))
 	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovMsgSwap(uint64(m.ExpiryHeight))
+	}
+	l = len(m.CancelTxID)
+	if l > 0 {
+		n += 1 + l + sovMsgSwap(uint64(l))
+	}
 	return n
 }
 
@@ -828,6 +874,57 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelTxID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CancelTxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgSwap(dAtA[iNdEx:])
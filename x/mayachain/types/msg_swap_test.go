@@ -160,3 +160,57 @@ func (MsgSwapSuite) TestMsgSwap(c *C) {
 	m = NewMsgSwap(tx, common.BNBAsset, GetRandomBNBAddress(), cosmos.ZeroUint(), GetRandomBaseAddress(), cosmos.NewUint(1024), "", "", nil, 0, 0, 0, addr)
 	c.Assert(m.ValidateBasicV63(semver.Version{}), NotNil)
 }
+
+func (MsgSwapSuite) TestMsgSwapLimitOrder(c *C) {
+	addr := GetRandomBech32Addr()
+	tx := GetRandomTx()
+	tx.Coins = common.Coins{common.NewCoin(common.BNBAsset, cosmos.NewUint(100000000))}
+	version := GetCurrentVersion()
+
+	m := NewMsgSwap(tx, common.BTCAsset, GetRandomBTCAddress(), cosmos.NewUint(200000000), common.NoAddress, cosmos.ZeroUint(), "", "", nil, OrderType_limit, 0, 0, addr)
+	m.ExpiryHeight = 100
+	c.Assert(m.ValidateBasicV124(version), IsNil)
+	m.ExpiryHeight = -1
+	c.Assert(m.ValidateBasicV124(version), NotNil)
+	m.ExpiryHeight = 0
+	m.StreamInterval = 1
+	c.Assert(m.ValidateBasicV124(version), NotNil)
+
+	// only limit orders can expire
+	m = NewMsgSwap(tx, common.BTCAsset, GetRandomBTCAddress(), cosmos.ZeroUint(), common.NoAddress, cosmos.ZeroUint(), "", "", nil, OrderType_market, 0, 0, addr)
+	m.ExpiryHeight = 100
+	c.Check(m.IsLimitOrder(), Equals, false)
+	c.Assert(m.ValidateBasicV124(version), NotNil)
+
+	// cancel
+	m = NewMsgSwapCancel(tx, GetRandomTxHash(), addr)
+	c.Check(m.IsCancel(), Equals, true)
+	c.Assert(m.ValidateBasicV124(version), IsNil)
+	m = NewMsgSwapCancel(tx, "", addr)
+	c.Assert(m.ValidateBasicV124(version), NotNil)
+	m = NewMsgSwapCancel(tx, tx.ID, addr)
+	c.Assert(m.ValidateBasicV124(version), NotNil)
+	m = NewMsgSwapCancel(tx, GetRandomTxHash(), cosmos.AccAddress{})
+	c.Assert(m.ValidateBasicV124(version), NotNil)
+}
+
+func (MsgSwapSuite) TestMsgSwapDCA(c *C) {
+	addr := GetRandomBech32Addr()
+	tx := GetRandomTx()
+	tx.Coins = common.Coins{common.NewCoin(common.BNBAsset, cosmos.NewUint(100000000))}
+	version := GetCurrentVersion()
+
+	m := NewMsgSwap(tx, common.BTCAsset, GetRandomBTCAddress(), cosmos.NewUint(1000), common.NoAddress, cosmos.ZeroUint(), "", "", nil, OrderType_dca, 10, 14400, addr)
+	c.Check(m.IsDCA(), Equals, true)
+	c.Check(m.IsStreaming(), Equals, true)
+	c.Assert(m.ValidateBasicV124(version), IsNil)
+	// the streaming swap trade target covers every slice
+	c.Check(m.GetStreamingSwap().TradeTarget.Uint64(), Equals, uint64(10000))
+
+	// dca orders need an interval and at least two slices
+	m.StreamQuantity = 1
+	c.Assert(m.ValidateBasicV124(version), NotNil)
+	m.StreamQuantity = 10
+	m.StreamInterval = 0
+	c.Assert(m.ValidateBasicV124(version), NotNil)
+}
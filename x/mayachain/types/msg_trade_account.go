@@ -9,6 +9,7 @@ import (
 var (
 	_ cosmos.Msg = &MsgTradeAccountDeposit{}
 	_ cosmos.Msg = &MsgTradeAccountWithdrawal{}
+	_ cosmos.Msg = &MsgTradeAccountTransfer{}
 )
 
 // NewMsgTradeAccountDeposit is a constructor function for MsgTradeAccountDeposit
@@ -121,3 +122,53 @@ func (m *MsgTradeAccountWithdrawal) GetSignBytes() []byte {
 func (m *MsgTradeAccountWithdrawal) GetSigners() []cosmos.AccAddress {
 	return []cosmos.AccAddress{m.Signer}
 }
+
+// NewMsgTradeAccountTransfer is a constructor function for MsgTradeAccountTransfer
+func NewMsgTradeAccountTransfer(asset common.Asset, amount cosmos.Uint, to, signer cosmos.AccAddress, tx common.Tx) *MsgTradeAccountTransfer {
+	return &MsgTradeAccountTransfer{
+		Tx:        tx,
+		Asset:     asset,
+		Amount:    amount,
+		ToAddress: to,
+		Signer:    signer,
+	}
+}
+
+// Route should return the pooldata of the module
+func (m *MsgTradeAccountTransfer) Route() string { return RouterKey }
+
+// Type should return the action
+func (m MsgTradeAccountTransfer) Type() string { return "set_trade_account_transfer" }
+
+// ValidateBasic runs stateless checks on the message
+func (m *MsgTradeAccountTransfer) ValidateBasic() error {
+	if m.Asset.IsEmpty() {
+		return cosmos.ErrUnknownRequest("asset cannot be empty")
+	}
+	if !m.Asset.IsTradeAsset() {
+		return cosmos.ErrUnknownRequest("asset must be a trade asset")
+	}
+	if m.Amount.IsZero() {
+		return cosmos.ErrUnknownRequest("amount cannot be zero")
+	}
+	if m.ToAddress.Empty() {
+		return cosmos.ErrInvalidAddress(m.ToAddress.String())
+	}
+	if m.Signer.Empty() {
+		return cosmos.ErrInvalidAddress(m.Signer.String())
+	}
+	if m.ToAddress.Equals(m.Signer) {
+		return cosmos.ErrUnknownRequest("cannot transfer to the same trade account")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (m *MsgTradeAccountTransfer) GetSignBytes() []byte {
+	return cosmos.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgTradeAccountTransfer) GetSigners() []cosmos.AccAddress {
+	return []cosmos.AccAddress{m.Signer}
+}
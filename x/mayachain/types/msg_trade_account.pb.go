@@ -150,9 +150,72 @@ func (m *MsgTradeAccountWithdrawal) GetSigner() github_com_cosmos_cosmos_sdk_typ
 	return nil
 }
 
+type MsgTradeAccountTransfer struct {
+	Tx        common.Tx                                     `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx"`
+	Asset     gitlab_com_mayachain_mayanode_common.Asset    `protobuf:"bytes,2,opt,name=asset,proto3,customtype=gitlab.com/mayachain/mayanode/common.Asset" json:"asset"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Uint       `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"amount"`
+	ToAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"to_address,omitempty"`
+	Signer    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,5,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+}
+
+func (m *MsgTradeAccountTransfer) Reset()         { *m = MsgTradeAccountTransfer{} }
+func (m *MsgTradeAccountTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgTradeAccountTransfer) ProtoMessage()    {}
+func (*MsgTradeAccountTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ffac6e85c6a9872, []int{2}
+}
+func (m *MsgTradeAccountTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTradeAccountTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTradeAccountTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTradeAccountTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTradeAccountTransfer.Merge(m, src)
+}
+func (m *MsgTradeAccountTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTradeAccountTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTradeAccountTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTradeAccountTransfer proto.InternalMessageInfo
+
+func (m *MsgTradeAccountTransfer) GetTx() common.Tx {
+	if m != nil {
+		return m.Tx
+	}
+	return common.Tx{}
+}
+
+func (m *MsgTradeAccountTransfer) GetToAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.ToAddress
+	}
+	return nil
+}
+
+func (m *MsgTradeAccountTransfer) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgTradeAccountDeposit)(nil), "types.MsgTradeAccountDeposit")
 	proto.RegisterType((*MsgTradeAccountWithdrawal)(nil), "types.MsgTradeAccountWithdrawal")
+	proto.RegisterType((*MsgTradeAccountTransfer)(nil), "types.MsgTradeAccountTransfer")
 }
 
 func init() {
@@ -160,32 +223,34 @@ func init() {
 }
 
 var fileDescriptor_5ffac6e85c6a9872 = []byte{
-	// 387 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x93, 0x3f, 0x4b, 0xc3, 0x40,
-	0x18, 0xc6, 0x93, 0xf4, 0x8f, 0xf4, 0x6c, 0x97, 0x20, 0x12, 0x3b, 0x24, 0xa1, 0x8b, 0x45, 0x6c,
-	0xcf, 0x56, 0x70, 0x4f, 0x10, 0xa4, 0x88, 0x4b, 0x68, 0x29, 0xb8, 0x94, 0x6b, 0x12, 0xd2, 0x60,
-	0x93, 0x2b, 0xb9, 0xab, 0xa6, 0xa3, 0xdf, 0xc0, 0x8f, 0xd5, 0xb1, 0xa3, 0x38, 0x04, 0x69, 0xbf,
-	0x82, 0x53, 0x27, 0x49, 0xee, 0x8a, 0x55, 0x41, 0x8a, 0x38, 0x39, 0xdd, 0x9b, 0x7b, 0x9f, 0xe7,
-	0xc7, 0x9b, 0xe7, 0x78, 0xc1, 0x45, 0x80, 0x66, 0xc8, 0x1e, 0x21, 0x3f, 0x84, 0xf7, 0x2d, 0x18,
-	0xc3, 0x8f, 0x4f, 0x3a, 0x9b, 0xb8, 0x04, 0x06, 0xc4, 0x1b, 0xd0, 0x08, 0x39, 0xee, 0x00, 0xd9,
-	0x36, 0x9e, 0x86, 0xb4, 0x39, 0x89, 0x30, 0xc5, 0x72, 0x21, 0x6b, 0x57, 0xf5, 0x4f, 0x76, 0x1b,
-	0x07, 0x01, 0x0e, 0xf9, 0xc1, 0x84, 0xd5, 0x03, 0x0f, 0x7b, 0x38, 0x2b, 0x61, 0x5a, 0xb1, 0xdb,
-	0xda, 0x9b, 0x04, 0x0e, 0x6f, 0x88, 0xd7, 0x4d, 0xc9, 0x06, 0x03, 0x5f, 0xba, 0x13, 0x4c, 0x7c,
-	0x2a, 0xeb, 0x40, 0xa2, 0xb1, 0x22, 0xea, 0x62, 0x7d, 0xbf, 0x0d, 0x9a, 0x9c, 0xd5, 0x8d, 0xcd,
-	0xfc, 0x3c, 0xd1, 0x04, 0x4b, 0xa2, 0xb1, 0xdc, 0x07, 0x05, 0x44, 0x88, 0x4b, 0x15, 0x29, 0x13,
-	0x55, 0x36, 0x22, 0x23, 0xbd, 0x34, 0xdb, 0xa9, 0xee, 0x25, 0xd1, 0x4e, 0x3c, 0x9f, 0x8e, 0xd1,
-	0x30, 0x6d, 0x6e, 0xfd, 0x55, 0x5a, 0x85, 0xd8, 0x71, 0xe1, 0xb6, 0xc7, 0x62, 0x3c, 0xf9, 0x0a,
-	0x14, 0x51, 0x90, 0xce, 0xa2, 0xe4, 0x74, 0xb1, 0x5e, 0x32, 0x21, 0x47, 0x1d, 0x7b, 0x3e, 0x1d,
-	0x4d, 0x19, 0xca, 0xc6, 0x24, 0xc0, 0x84, 0x1f, 0x0d, 0xe2, 0xdc, 0xb1, 0xa0, 0x9a, 0x3d, 0x3f,
-	0xa4, 0x16, 0xb7, 0xcb, 0xd7, 0x60, 0x0f, 0x39, 0x4e, 0xe4, 0x12, 0xa2, 0xe4, 0x75, 0xb1, 0x5e,
-	0x36, 0x5b, 0xeb, 0x44, 0x6b, 0xec, 0x40, 0x31, 0x6c, 0xdb, 0x60, 0x46, 0x6b, 0x43, 0x90, 0x3b,
-	0xa0, 0x48, 0x7c, 0x2f, 0x74, 0x23, 0xa5, 0xf0, 0x5b, 0x16, 0x07, 0xd4, 0x1e, 0x73, 0xe0, 0xe8,
-	0x4b, 0xec, 0x7d, 0x9f, 0x8e, 0x9c, 0x08, 0x3d, 0xa0, 0xf1, 0xbf, 0x48, 0xbe, 0x07, 0x2a, 0x19,
-	0x71, 0xb0, 0x9d, 0x7f, 0xc9, 0x3c, 0x5b, 0x27, 0xda, 0xe9, 0x6e, 0x63, 0xf1, 0xc8, 0xca, 0x19,
-	0xc6, 0xf8, 0xf3, 0x37, 0x30, 0x3b, 0xf3, 0xa5, 0x2a, 0x2e, 0x96, 0xaa, 0xf8, 0xba, 0x54, 0xc5,
-	0xa7, 0x95, 0x2a, 0x2c, 0x56, 0xaa, 0xf0, 0xbc, 0x52, 0x85, 0x5b, 0xf8, 0xf3, 0x80, 0xdf, 0x96,
-	0x73, 0x58, 0xcc, 0x96, 0xe9, 0xfc, 0x3d, 0x00, 0x00, 0xff, 0xff, 0x55, 0x41, 0x40, 0x73, 0xc5,
-	0x03, 0x00, 0x00,
+	// 420 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x94, 0x4f, 0x6b, 0xe2, 0x40,
+	0x18, 0xc6, 0x93, 0xf8, 0x67, 0x71, 0x56, 0x2f, 0x61, 0xd9, 0xcd, 0x7a, 0x48, 0x82, 0x97, 0x95,
+	0x65, 0x75, 0x56, 0x0b, 0xbd, 0x27, 0x14, 0x8a, 0x94, 0x42, 0x09, 0x8a, 0xd0, 0x4b, 0x18, 0x93,
+	0x69, 0x0c, 0x35, 0x19, 0xc9, 0x8c, 0x6d, 0x3c, 0xf6, 0x1b, 0xf4, 0x63, 0x79, 0xf4, 0x58, 0x7a,
+	0x08, 0x45, 0xbf, 0x42, 0x4f, 0x9e, 0x4a, 0xfe, 0x48, 0xad, 0x85, 0x22, 0xad, 0x27, 0x4f, 0xf3,
+	0x66, 0xe6, 0x79, 0x7e, 0x4c, 0x9e, 0x77, 0x78, 0xc1, 0xb1, 0x87, 0xa6, 0xc8, 0x1a, 0x22, 0xd7,
+	0x87, 0x37, 0x2d, 0x18, 0xc2, 0xd7, 0x4f, 0x36, 0x1d, 0x63, 0x0a, 0x3d, 0xea, 0x98, 0x2c, 0x40,
+	0x36, 0x36, 0x91, 0x65, 0x91, 0x89, 0xcf, 0x9a, 0xe3, 0x80, 0x30, 0x22, 0x16, 0x92, 0xe3, 0xaa,
+	0xfa, 0xc6, 0x6e, 0x11, 0xcf, 0x23, 0x7e, 0xb6, 0xa4, 0xc2, 0xea, 0x0f, 0x87, 0x38, 0x24, 0x29,
+	0x61, 0x5c, 0xa5, 0xbb, 0xb5, 0x67, 0x01, 0xfc, 0x3c, 0xa7, 0x4e, 0x37, 0x26, 0x6b, 0x29, 0xf8,
+	0x04, 0x8f, 0x09, 0x75, 0x99, 0xa8, 0x02, 0x81, 0x85, 0x12, 0xaf, 0xf2, 0xf5, 0xef, 0x6d, 0xd0,
+	0xcc, 0x58, 0xdd, 0x50, 0xcf, 0xcf, 0x22, 0x85, 0x33, 0x04, 0x16, 0x8a, 0x7d, 0x50, 0x40, 0x94,
+	0x62, 0x26, 0x09, 0x89, 0xa8, 0xb2, 0x16, 0x69, 0xf1, 0xa6, 0xde, 0x8e, 0x75, 0x8f, 0x91, 0xf2,
+	0xd7, 0x71, 0xd9, 0x08, 0x0d, 0xe2, 0xc3, 0x8d, 0xbf, 0x8a, 0x2b, 0x9f, 0xd8, 0x18, 0x6e, 0x7a,
+	0x8c, 0x94, 0x27, 0x9e, 0x82, 0x22, 0xf2, 0xe2, 0xbb, 0x48, 0x39, 0x95, 0xaf, 0x97, 0x74, 0x98,
+	0xa1, 0xfe, 0x38, 0x2e, 0x1b, 0x4e, 0x52, 0x94, 0x45, 0xa8, 0x47, 0x68, 0xb6, 0x34, 0xa8, 0x7d,
+	0x9d, 0x06, 0xd5, 0xec, 0xb9, 0x3e, 0x33, 0x32, 0xbb, 0x78, 0x06, 0xbe, 0x21, 0xdb, 0x0e, 0x30,
+	0xa5, 0x52, 0x5e, 0xe5, 0xeb, 0x65, 0xbd, 0xb5, 0x8a, 0x94, 0xc6, 0x0e, 0x14, 0xcd, 0xb2, 0xb4,
+	0xd4, 0x68, 0xac, 0x09, 0x62, 0x07, 0x14, 0xa9, 0xeb, 0xf8, 0x38, 0x90, 0x0a, 0x9f, 0x65, 0x65,
+	0x80, 0xda, 0x5d, 0x0e, 0xfc, 0xde, 0x8a, 0xbd, 0xef, 0xb2, 0xa1, 0x1d, 0xa0, 0x5b, 0x34, 0x3a,
+	0x88, 0xe4, 0x7b, 0xa0, 0x92, 0x10, 0xcd, 0xcd, 0xfc, 0x4b, 0xfa, 0xff, 0x55, 0xa4, 0xfc, 0xdb,
+	0xed, 0x5a, 0x59, 0x64, 0xe5, 0x04, 0xa3, 0xed, 0xbf, 0x07, 0x2b, 0x01, 0xfc, 0xda, 0xea, 0x41,
+	0x37, 0x40, 0x3e, 0xbd, 0xc2, 0xc1, 0x41, 0x74, 0xe0, 0x02, 0x00, 0x46, 0xcc, 0x2f, 0x3f, 0xff,
+	0x12, 0x23, 0xfb, 0x0f, 0x5f, 0xef, 0xcc, 0x16, 0x32, 0x3f, 0x5f, 0xc8, 0xfc, 0xd3, 0x42, 0xe6,
+	0xef, 0x97, 0x32, 0x37, 0x5f, 0xca, 0xdc, 0xc3, 0x52, 0xe6, 0x2e, 0xe1, 0xc7, 0x91, 0xbd, 0x9b,
+	0x8c, 0x83, 0x62, 0x32, 0xc9, 0x8e, 0x5e, 0x02, 0x00, 0x00, 0xff, 0xff, 0x74, 0xfa, 0x1d, 0x18,
+	0x42, 0x05, 0x00, 0x00,
 }
 
 func (m *MsgTradeAccountDeposit) Marshal() (dAtA []byte, err error) {
@@ -322,6 +387,73 @@ func (m *MsgTradeAccountWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgTradeAccountTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTradeAccountTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTradeAccountTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgTradeAccount(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintMsgTradeAccount(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgTradeAccount(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Asset.Size()
+		i -= size
+		if _, err := m.Asset.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgTradeAccount(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgTradeAccount(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMsgTradeAccount(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMsgTradeAccount(v)
 	base := offset
@@ -379,6 +511,29 @@ func (m *MsgTradeAccountWithdrawal) Size() (n int) {
 	return n
 }
 
+func (m *MsgTradeAccountTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tx.Size()
+	n += 1 + l + sovMsgTradeAccount(uint64(l))
+	l = m.Asset.Size()
+	n += 1 + l + sovMsgTradeAccount(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovMsgTradeAccount(uint64(l))
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgTradeAccount(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgTradeAccount(uint64(l))
+	}
+	return n
+}
+
 func sovMsgTradeAccount(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -825,6 +980,227 @@ func (m *MsgTradeAccountWithdrawal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgTradeAccountTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgTradeAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTradeAccountTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTradeAccountTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTradeAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgTradeAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgTradeAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTradeAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgTradeAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgTradeAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTradeAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgTradeAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgTradeAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTradeAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgTradeAccount
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgTradeAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = append(m.ToAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ToAddress == nil {
+				m.ToAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTradeAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgTradeAccount
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgTradeAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgTradeAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgTradeAccount
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgTradeAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMsgTradeAccount(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
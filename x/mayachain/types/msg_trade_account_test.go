@@ -52,3 +52,27 @@ func (MsgTradeAccountSuite) TestWithdrawal(c *C) {
 	m = NewMsgTradeAccountWithdrawal(asset, cosmos.ZeroUint(), GetRandomBaseAddress(), signer, dummyTx)
 	c.Check(m.ValidateBasicVersioned(version), NotNil)
 }
+
+func (MsgTradeAccountSuite) TestTransfer(c *C) {
+	asset := common.ETHAsset.GetTradeAsset()
+	amt := cosmos.NewUint(100)
+	to := GetRandomBech32Addr()
+	signer := GetRandomBech32Addr()
+	dummyTx := common.Tx{ID: "test"}
+
+	m := NewMsgTradeAccountTransfer(asset, amt, to, signer, dummyTx)
+	EnsureMsgBasicCorrect(m, c)
+	c.Check(m.Type(), Equals, "set_trade_account_transfer")
+
+	m = NewMsgTradeAccountTransfer(common.ETHAsset, amt, to, signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+
+	m = NewMsgTradeAccountTransfer(asset, cosmos.ZeroUint(), to, signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+
+	m = NewMsgTradeAccountTransfer(asset, amt, cosmos.AccAddress{}, signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+
+	m = NewMsgTradeAccountTransfer(asset, amt, signer, signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+}
@@ -0,0 +1,20264 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: mayachain/v1/x/mayachain/types/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type QueryPoolRequest struct {
+	Asset string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+}
+
+func (m *QueryPoolRequest) Reset()         { *m = QueryPoolRequest{} }
+func (m *QueryPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolRequest) ProtoMessage()    {}
+func (*QueryPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{0}
+}
+func (m *QueryPoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolRequest.Merge(m, src)
+}
+func (m *QueryPoolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolRequest proto.InternalMessageInfo
+
+func (m *QueryPoolRequest) GetAsset() string {
+	if m != nil {
+		return m.Asset
+	}
+	return ""
+}
+
+type QueryPoolResponse struct {
+	Pool Pool `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool"`
+}
+
+func (m *QueryPoolResponse) Reset()         { *m = QueryPoolResponse{} }
+func (m *QueryPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolResponse) ProtoMessage()    {}
+func (*QueryPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{1}
+}
+func (m *QueryPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolResponse.Merge(m, src)
+}
+func (m *QueryPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolResponse proto.InternalMessageInfo
+
+func (m *QueryPoolResponse) GetPool() Pool {
+	if m != nil {
+		return m.Pool
+	}
+	return Pool{}
+}
+
+type QueryPoolsRequest struct {
+}
+
+func (m *QueryPoolsRequest) Reset()         { *m = QueryPoolsRequest{} }
+func (m *QueryPoolsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsRequest) ProtoMessage()    {}
+func (*QueryPoolsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{2}
+}
+func (m *QueryPoolsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsRequest.Merge(m, src)
+}
+func (m *QueryPoolsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolsRequest proto.InternalMessageInfo
+
+type QueryPoolsResponse struct {
+	Pools []Pool `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools"`
+}
+
+func (m *QueryPoolsResponse) Reset()         { *m = QueryPoolsResponse{} }
+func (m *QueryPoolsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsResponse) ProtoMessage()    {}
+func (*QueryPoolsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{3}
+}
+func (m *QueryPoolsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsResponse.Merge(m, src)
+}
+func (m *QueryPoolsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolsResponse proto.InternalMessageInfo
+
+func (m *QueryPoolsResponse) GetPools() []Pool {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+type QueryLiquidityProviderRequest struct {
+	Asset   string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryLiquidityProviderRequest) Reset()         { *m = QueryLiquidityProviderRequest{} }
+func (m *QueryLiquidityProviderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidityProviderRequest) ProtoMessage()    {}
+func (*QueryLiquidityProviderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{4}
+}
+func (m *QueryLiquidityProviderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidityProviderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidityProviderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLiquidityProviderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityProviderRequest.Merge(m, src)
+}
+func (m *QueryLiquidityProviderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidityProviderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityProviderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLiquidityProviderRequest proto.InternalMessageInfo
+
+func (m *QueryLiquidityProviderRequest) GetAsset() string {
+	if m != nil {
+		return m.Asset
+	}
+	return ""
+}
+
+func (m *QueryLiquidityProviderRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryLiquidityProviderResponse struct {
+	LiquidityProvider LiquidityProvider `protobuf:"bytes,1,opt,name=liquidity_provider,json=liquidityProvider,proto3" json:"liquidity_provider"`
+}
+
+func (m *QueryLiquidityProviderResponse) Reset()         { *m = QueryLiquidityProviderResponse{} }
+func (m *QueryLiquidityProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidityProviderResponse) ProtoMessage()    {}
+func (*QueryLiquidityProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{5}
+}
+func (m *QueryLiquidityProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidityProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidityProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLiquidityProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityProviderResponse.Merge(m, src)
+}
+func (m *QueryLiquidityProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidityProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityProviderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLiquidityProviderResponse proto.InternalMessageInfo
+
+func (m *QueryLiquidityProviderResponse) GetLiquidityProvider() LiquidityProvider {
+	if m != nil {
+		return m.LiquidityProvider
+	}
+	return LiquidityProvider{}
+}
+
+type QueryLiquidityProvidersRequest struct {
+	Asset string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+}
+
+func (m *QueryLiquidityProvidersRequest) Reset()         { *m = QueryLiquidityProvidersRequest{} }
+func (m *QueryLiquidityProvidersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidityProvidersRequest) ProtoMessage()    {}
+func (*QueryLiquidityProvidersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{6}
+}
+func (m *QueryLiquidityProvidersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidityProvidersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidityProvidersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLiquidityProvidersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityProvidersRequest.Merge(m, src)
+}
+func (m *QueryLiquidityProvidersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidityProvidersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityProvidersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLiquidityProvidersRequest proto.InternalMessageInfo
+
+func (m *QueryLiquidityProvidersRequest) GetAsset() string {
+	if m != nil {
+		return m.Asset
+	}
+	return ""
+}
+
+type QueryLiquidityProvidersResponse struct {
+	LiquidityProviders []LiquidityProvider `protobuf:"bytes,1,rep,name=liquidity_providers,json=liquidityProviders,proto3" json:"liquidity_providers"`
+}
+
+func (m *QueryLiquidityProvidersResponse) Reset()         { *m = QueryLiquidityProvidersResponse{} }
+func (m *QueryLiquidityProvidersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidityProvidersResponse) ProtoMessage()    {}
+func (*QueryLiquidityProvidersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{7}
+}
+func (m *QueryLiquidityProvidersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidityProvidersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidityProvidersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLiquidityProvidersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityProvidersResponse.Merge(m, src)
+}
+func (m *QueryLiquidityProvidersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidityProvidersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityProvidersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLiquidityProvidersResponse proto.InternalMessageInfo
+
+func (m *QueryLiquidityProvidersResponse) GetLiquidityProviders() []LiquidityProvider {
+	if m != nil {
+		return m.LiquidityProviders
+	}
+	return nil
+}
+
+type QueryNodeRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryNodeRequest) Reset()         { *m = QueryNodeRequest{} }
+func (m *QueryNodeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNodeRequest) ProtoMessage()    {}
+func (*QueryNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{8}
+}
+func (m *QueryNodeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNodeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNodeRequest.Merge(m, src)
+}
+func (m *QueryNodeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNodeRequest proto.InternalMessageInfo
+
+func (m *QueryNodeRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryNodeResponse struct {
+	Node NodeAccount `protobuf:"bytes,1,opt,name=node,proto3" json:"node"`
+}
+
+func (m *QueryNodeResponse) Reset()         { *m = QueryNodeResponse{} }
+func (m *QueryNodeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNodeResponse) ProtoMessage()    {}
+func (*QueryNodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{9}
+}
+func (m *QueryNodeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNodeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNodeResponse.Merge(m, src)
+}
+func (m *QueryNodeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNodeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNodeResponse proto.InternalMessageInfo
+
+func (m *QueryNodeResponse) GetNode() NodeAccount {
+	if m != nil {
+		return m.Node
+	}
+	return NodeAccount{}
+}
+
+type QueryNodesRequest struct {
+}
+
+func (m *QueryNodesRequest) Reset()         { *m = QueryNodesRequest{} }
+func (m *QueryNodesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNodesRequest) ProtoMessage()    {}
+func (*QueryNodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{10}
+}
+func (m *QueryNodesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNodesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNodesRequest.Merge(m, src)
+}
+func (m *QueryNodesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNodesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNodesRequest proto.InternalMessageInfo
+
+type QueryNodesResponse struct {
+	Nodes []NodeAccount `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes"`
+}
+
+func (m *QueryNodesResponse) Reset()         { *m = QueryNodesResponse{} }
+func (m *QueryNodesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNodesResponse) ProtoMessage()    {}
+func (*QueryNodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{11}
+}
+func (m *QueryNodesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNodesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNodesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNodesResponse.Merge(m, src)
+}
+func (m *QueryNodesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNodesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNodesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNodesResponse proto.InternalMessageInfo
+
+func (m *QueryNodesResponse) GetNodes() []NodeAccount {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+type QueryVaultRequest struct {
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+}
+
+func (m *QueryVaultRequest) Reset()         { *m = QueryVaultRequest{} }
+func (m *QueryVaultRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultRequest) ProtoMessage()    {}
+func (*QueryVaultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{12}
+}
+func (m *QueryVaultRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVaultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultRequest.Merge(m, src)
+}
+func (m *QueryVaultRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVaultRequest proto.InternalMessageInfo
+
+func (m *QueryVaultRequest) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+type QueryVaultResponse struct {
+	Vault Vault `protobuf:"bytes,1,opt,name=vault,proto3" json:"vault"`
+}
+
+func (m *QueryVaultResponse) Reset()         { *m = QueryVaultResponse{} }
+func (m *QueryVaultResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultResponse) ProtoMessage()    {}
+func (*QueryVaultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{13}
+}
+func (m *QueryVaultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVaultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultResponse.Merge(m, src)
+}
+func (m *QueryVaultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVaultResponse proto.InternalMessageInfo
+
+func (m *QueryVaultResponse) GetVault() Vault {
+	if m != nil {
+		return m.Vault
+	}
+	return Vault{}
+}
+
+type QueryAsgardVaultsRequest struct {
+}
+
+func (m *QueryAsgardVaultsRequest) Reset()         { *m = QueryAsgardVaultsRequest{} }
+func (m *QueryAsgardVaultsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAsgardVaultsRequest) ProtoMessage()    {}
+func (*QueryAsgardVaultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{14}
+}
+func (m *QueryAsgardVaultsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAsgardVaultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAsgardVaultsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAsgardVaultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAsgardVaultsRequest.Merge(m, src)
+}
+func (m *QueryAsgardVaultsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAsgardVaultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAsgardVaultsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAsgardVaultsRequest proto.InternalMessageInfo
+
+type QueryAsgardVaultsResponse struct {
+	Vaults []Vault `protobuf:"bytes,1,rep,name=vaults,proto3" json:"vaults"`
+}
+
+func (m *QueryAsgardVaultsResponse) Reset()         { *m = QueryAsgardVaultsResponse{} }
+func (m *QueryAsgardVaultsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAsgardVaultsResponse) ProtoMessage()    {}
+func (*QueryAsgardVaultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{15}
+}
+func (m *QueryAsgardVaultsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAsgardVaultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAsgardVaultsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAsgardVaultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAsgardVaultsResponse.Merge(m, src)
+}
+func (m *QueryAsgardVaultsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAsgardVaultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAsgardVaultsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAsgardVaultsResponse proto.InternalMessageInfo
+
+func (m *QueryAsgardVaultsResponse) GetVaults() []Vault {
+	if m != nil {
+		return m.Vaults
+	}
+	return nil
+}
+
+type QueryInboundAddressesRequest struct {
+}
+
+func (m *QueryInboundAddressesRequest) Reset()         { *m = QueryInboundAddressesRequest{} }
+func (m *QueryInboundAddressesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInboundAddressesRequest) ProtoMessage()    {}
+func (*QueryInboundAddressesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{16}
+}
+func (m *QueryInboundAddressesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInboundAddressesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInboundAddressesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInboundAddressesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInboundAddressesRequest.Merge(m, src)
+}
+func (m *QueryInboundAddressesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInboundAddressesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInboundAddressesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInboundAddressesRequest proto.InternalMessageInfo
+
+type QueryInboundAddressesResponse struct {
+	InboundAddresses []InboundAddress `protobuf:"bytes,1,rep,name=inbound_addresses,json=inboundAddresses,proto3" json:"inbound_addresses"`
+}
+
+func (m *QueryInboundAddressesResponse) Reset()         { *m = QueryInboundAddressesResponse{} }
+func (m *QueryInboundAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInboundAddressesResponse) ProtoMessage()    {}
+func (*QueryInboundAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{17}
+}
+func (m *QueryInboundAddressesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInboundAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInboundAddressesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInboundAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInboundAddressesResponse.Merge(m, src)
+}
+func (m *QueryInboundAddressesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInboundAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInboundAddressesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInboundAddressesResponse proto.InternalMessageInfo
+
+func (m *QueryInboundAddressesResponse) GetInboundAddresses() []InboundAddress {
+	if m != nil {
+		return m.InboundAddresses
+	}
+	return nil
+}
+
+type InboundAddress struct {
+	Chain                string `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	PubKey               string `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	Address              string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Router               string `protobuf:"bytes,4,opt,name=router,proto3" json:"router,omitempty"`
+	Halted               bool   `protobuf:"varint,5,opt,name=halted,proto3" json:"halted,omitempty"`
+	GlobalTradingPaused  bool   `protobuf:"varint,6,opt,name=global_trading_paused,json=globalTradingPaused,proto3" json:"global_trading_paused,omitempty"`
+	ChainTradingPaused   bool   `protobuf:"varint,7,opt,name=chain_trading_paused,json=chainTradingPaused,proto3" json:"chain_trading_paused,omitempty"`
+	ChainLpActionsPaused bool   `protobuf:"varint,8,opt,name=chain_lp_actions_paused,json=chainLpActionsPaused,proto3" json:"chain_lp_actions_paused,omitempty"`
+	GasRate              string `protobuf:"bytes,9,opt,name=gas_rate,json=gasRate,proto3" json:"gas_rate,omitempty"`
+	GasRateUnits         string `protobuf:"bytes,10,opt,name=gas_rate_units,json=gasRateUnits,proto3" json:"gas_rate_units,omitempty"`
+	OutboundTxSize       string `protobuf:"bytes,11,opt,name=outbound_tx_size,json=outboundTxSize,proto3" json:"outbound_tx_size,omitempty"`
+	OutboundFee          string `protobuf:"bytes,12,opt,name=outbound_fee,json=outboundFee,proto3" json:"outbound_fee,omitempty"`
+	DustThreshold        string `protobuf:"bytes,13,opt,name=dust_threshold,json=dustThreshold,proto3" json:"dust_threshold,omitempty"`
+}
+
+func (m *InboundAddress) Reset()         { *m = InboundAddress{} }
+func (m *InboundAddress) String() string { return proto.CompactTextString(m) }
+func (*InboundAddress) ProtoMessage()    {}
+func (*InboundAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{18}
+}
+func (m *InboundAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InboundAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InboundAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InboundAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InboundAddress.Merge(m, src)
+}
+func (m *InboundAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *InboundAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_InboundAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InboundAddress proto.InternalMessageInfo
+
+func (m *InboundAddress) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *InboundAddress) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *InboundAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *InboundAddress) GetRouter() string {
+	if m != nil {
+		return m.Router
+	}
+	return ""
+}
+
+func (m *InboundAddress) GetHalted() bool {
+	if m != nil {
+		return m.Halted
+	}
+	return false
+}
+
+func (m *InboundAddress) GetGlobalTradingPaused() bool {
+	if m != nil {
+		return m.GlobalTradingPaused
+	}
+	return false
+}
+
+func (m *InboundAddress) GetChainTradingPaused() bool {
+	if m != nil {
+		return m.ChainTradingPaused
+	}
+	return false
+}
+
+func (m *InboundAddress) GetChainLpActionsPaused() bool {
+	if m != nil {
+		return m.ChainLpActionsPaused
+	}
+	return false
+}
+
+func (m *InboundAddress) GetGasRate() string {
+	if m != nil {
+		return m.GasRate
+	}
+	return ""
+}
+
+func (m *InboundAddress) GetGasRateUnits() string {
+	if m != nil {
+		return m.GasRateUnits
+	}
+	return ""
+}
+
+func (m *InboundAddress) GetOutboundTxSize() string {
+	if m != nil {
+		return m.OutboundTxSize
+	}
+	return ""
+}
+
+func (m *InboundAddress) GetOutboundFee() string {
+	if m != nil {
+		return m.OutboundFee
+	}
+	return ""
+}
+
+func (m *InboundAddress) GetDustThreshold() string {
+	if m != nil {
+		return m.DustThreshold
+	}
+	return ""
+}
+
+type QueryMimirRequest struct {
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (m *QueryMimirRequest) Reset()         { *m = QueryMimirRequest{} }
+func (m *QueryMimirRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMimirRequest) ProtoMessage()    {}
+func (*QueryMimirRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{19}
+}
+func (m *QueryMimirRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMimirRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMimirRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMimirRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMimirRequest.Merge(m, src)
+}
+func (m *QueryMimirRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMimirRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMimirRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMimirRequest proto.InternalMessageInfo
+
+func (m *QueryMimirRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type QueryMimirResponse struct {
+	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *QueryMimirResponse) Reset()         { *m = QueryMimirResponse{} }
+func (m *QueryMimirResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMimirResponse) ProtoMessage()    {}
+func (*QueryMimirResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{20}
+}
+func (m *QueryMimirResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMimirResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMimirResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMimirResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMimirResponse.Merge(m, src)
+}
+func (m *QueryMimirResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMimirResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMimirResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMimirResponse proto.InternalMessageInfo
+
+func (m *QueryMimirResponse) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+type QueryMimirsRequest struct {
+}
+
+func (m *QueryMimirsRequest) Reset()         { *m = QueryMimirsRequest{} }
+func (m *QueryMimirsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMimirsRequest) ProtoMessage()    {}
+func (*QueryMimirsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{21}
+}
+func (m *QueryMimirsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMimirsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMimirsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMimirsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMimirsRequest.Merge(m, src)
+}
+func (m *QueryMimirsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMimirsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMimirsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMimirsRequest proto.InternalMessageInfo
+
+type QueryMimirsResponse struct {
+	Mimirs map[string]int64 `protobuf:"bytes,1,rep,name=mimirs,proto3" json:"mimirs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (m *QueryMimirsResponse) Reset()         { *m = QueryMimirsResponse{} }
+func (m *QueryMimirsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMimirsResponse) ProtoMessage()    {}
+func (*QueryMimirsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{22}
+}
+func (m *QueryMimirsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMimirsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMimirsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMimirsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMimirsResponse.Merge(m, src)
+}
+func (m *QueryMimirsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMimirsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMimirsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMimirsResponse proto.InternalMessageInfo
+
+func (m *QueryMimirsResponse) GetMimirs() map[string]int64 {
+	if m != nil {
+		return m.Mimirs
+	}
+	return nil
+}
+
+type QueryStreamingSwapRequest struct {
+	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+}
+
+func (m *QueryStreamingSwapRequest) Reset()         { *m = QueryStreamingSwapRequest{} }
+func (m *QueryStreamingSwapRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamingSwapRequest) ProtoMessage()    {}
+func (*QueryStreamingSwapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{23}
+}
+func (m *QueryStreamingSwapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStreamingSwapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStreamingSwapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStreamingSwapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamingSwapRequest.Merge(m, src)
+}
+func (m *QueryStreamingSwapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStreamingSwapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamingSwapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStreamingSwapRequest proto.InternalMessageInfo
+
+func (m *QueryStreamingSwapRequest) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+type QueryStreamingSwapResponse struct {
+	StreamingSwap StreamingSwap `protobuf:"bytes,1,opt,name=streaming_swap,json=streamingSwap,proto3" json:"streaming_swap"`
+}
+
+func (m *QueryStreamingSwapResponse) Reset()         { *m = QueryStreamingSwapResponse{} }
+func (m *QueryStreamingSwapResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamingSwapResponse) ProtoMessage()    {}
+func (*QueryStreamingSwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{24}
+}
+func (m *QueryStreamingSwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStreamingSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStreamingSwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStreamingSwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamingSwapResponse.Merge(m, src)
+}
+func (m *QueryStreamingSwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStreamingSwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamingSwapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStreamingSwapResponse proto.InternalMessageInfo
+
+func (m *QueryStreamingSwapResponse) GetStreamingSwap() StreamingSwap {
+	if m != nil {
+		return m.StreamingSwap
+	}
+	return StreamingSwap{}
+}
+
+type QueryStreamingSwapsRequest struct {
+}
+
+func (m *QueryStreamingSwapsRequest) Reset()         { *m = QueryStreamingSwapsRequest{} }
+func (m *QueryStreamingSwapsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamingSwapsRequest) ProtoMessage()    {}
+func (*QueryStreamingSwapsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{25}
+}
+func (m *QueryStreamingSwapsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStreamingSwapsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStreamingSwapsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStreamingSwapsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamingSwapsRequest.Merge(m, src)
+}
+func (m *QueryStreamingSwapsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStreamingSwapsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamingSwapsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStreamingSwapsRequest proto.InternalMessageInfo
+
+type QueryStreamingSwapsResponse struct {
+	StreamingSwaps []StreamingSwap `protobuf:"bytes,1,rep,name=streaming_swaps,json=streamingSwaps,proto3" json:"streaming_swaps"`
+}
+
+func (m *QueryStreamingSwapsResponse) Reset()         { *m = QueryStreamingSwapsResponse{} }
+func (m *QueryStreamingSwapsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamingSwapsResponse) ProtoMessage()    {}
+func (*QueryStreamingSwapsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{26}
+}
+func (m *QueryStreamingSwapsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStreamingSwapsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStreamingSwapsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStreamingSwapsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamingSwapsResponse.Merge(m, src)
+}
+func (m *QueryStreamingSwapsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStreamingSwapsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamingSwapsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStreamingSwapsResponse proto.InternalMessageInfo
+
+func (m *QueryStreamingSwapsResponse) GetStreamingSwaps() []StreamingSwap {
+	if m != nil {
+		return m.StreamingSwaps
+	}
+	return nil
+}
+
+type QueryTradeAccountRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryTradeAccountRequest) Reset()         { *m = QueryTradeAccountRequest{} }
+func (m *QueryTradeAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTradeAccountRequest) ProtoMessage()    {}
+func (*QueryTradeAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{27}
+}
+func (m *QueryTradeAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTradeAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTradeAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTradeAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTradeAccountRequest.Merge(m, src)
+}
+func (m *QueryTradeAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTradeAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTradeAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTradeAccountRequest proto.InternalMessageInfo
+
+func (m *QueryTradeAccountRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryTradeAccountResponse struct {
+	TradeAccounts []TradeAccount `protobuf:"bytes,1,rep,name=trade_accounts,json=tradeAccounts,proto3" json:"trade_accounts"`
+}
+
+func (m *QueryTradeAccountResponse) Reset()         { *m = QueryTradeAccountResponse{} }
+func (m *QueryTradeAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTradeAccountResponse) ProtoMessage()    {}
+func (*QueryTradeAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{28}
+}
+func (m *QueryTradeAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTradeAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTradeAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTradeAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTradeAccountResponse.Merge(m, src)
+}
+func (m *QueryTradeAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTradeAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTradeAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTradeAccountResponse proto.InternalMessageInfo
+
+func (m *QueryTradeAccountResponse) GetTradeAccounts() []TradeAccount {
+	if m != nil {
+		return m.TradeAccounts
+	}
+	return nil
+}
+
+type QueryTradeAccountsRequest struct {
+	Asset string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+}
+
+func (m *QueryTradeAccountsRequest) Reset()         { *m = QueryTradeAccountsRequest{} }
+func (m *QueryTradeAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTradeAccountsRequest) ProtoMessage()    {}
+func (*QueryTradeAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{29}
+}
+func (m *QueryTradeAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTradeAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTradeAccountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTradeAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTradeAccountsRequest.Merge(m, src)
+}
+func (m *QueryTradeAccountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTradeAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTradeAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTradeAccountsRequest proto.InternalMessageInfo
+
+func (m *QueryTradeAccountsRequest) GetAsset() string {
+	if m != nil {
+		return m.Asset
+	}
+	return ""
+}
+
+type QueryTradeAccountsResponse struct {
+	TradeAccounts []TradeAccount `protobuf:"bytes,1,rep,name=trade_accounts,json=tradeAccounts,proto3" json:"trade_accounts"`
+}
+
+func (m *QueryTradeAccountsResponse) Reset()         { *m = QueryTradeAccountsResponse{} }
+func (m *QueryTradeAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTradeAccountsResponse) ProtoMessage()    {}
+func (*QueryTradeAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{30}
+}
+func (m *QueryTradeAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTradeAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTradeAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTradeAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTradeAccountsResponse.Merge(m, src)
+}
+func (m *QueryTradeAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTradeAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTradeAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTradeAccountsResponse proto.InternalMessageInfo
+
+func (m *QueryTradeAccountsResponse) GetTradeAccounts() []TradeAccount {
+	if m != nil {
+		return m.TradeAccounts
+	}
+	return nil
+}
+
+type QueryMAYANameRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *QueryMAYANameRequest) Reset()         { *m = QueryMAYANameRequest{} }
+func (m *QueryMAYANameRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMAYANameRequest) ProtoMessage()    {}
+func (*QueryMAYANameRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{31}
+}
+func (m *QueryMAYANameRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMAYANameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMAYANameRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMAYANameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMAYANameRequest.Merge(m, src)
+}
+func (m *QueryMAYANameRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMAYANameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMAYANameRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMAYANameRequest proto.InternalMessageInfo
+
+func (m *QueryMAYANameRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type QueryMAYANameResponse struct {
+	Mayaname MAYAName `protobuf:"bytes,1,opt,name=mayaname,proto3" json:"mayaname"`
+}
+
+func (m *QueryMAYANameResponse) Reset()         { *m = QueryMAYANameResponse{} }
+func (m *QueryMAYANameResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMAYANameResponse) ProtoMessage()    {}
+func (*QueryMAYANameResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{32}
+}
+func (m *QueryMAYANameResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMAYANameResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMAYANameResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMAYANameResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMAYANameResponse.Merge(m, src)
+}
+func (m *QueryMAYANameResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMAYANameResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMAYANameResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMAYANameResponse proto.InternalMessageInfo
+
+func (m *QueryMAYANameResponse) GetMayaname() MAYAName {
+	if m != nil {
+		return m.Mayaname
+	}
+	return MAYAName{}
+}
+
+type QueryQuoteSwapRequest struct {
+	FromAsset             string `protobuf:"bytes,1,opt,name=from_asset,json=fromAsset,proto3" json:"from_asset,omitempty"`
+	ToAsset               string `protobuf:"bytes,2,opt,name=to_asset,json=toAsset,proto3" json:"to_asset,omitempty"`
+	Amount                string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Destination           string `protobuf:"bytes,4,opt,name=destination,proto3" json:"destination,omitempty"`
+	RefundAddress         string `protobuf:"bytes,5,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
+	StreamingInterval     uint64 `protobuf:"varint,6,opt,name=streaming_interval,json=streamingInterval,proto3" json:"streaming_interval,omitempty"`
+	StreamingQuantity     uint64 `protobuf:"varint,7,opt,name=streaming_quantity,json=streamingQuantity,proto3" json:"streaming_quantity,omitempty"`
+	ToleranceBps          uint64 `protobuf:"varint,8,opt,name=tolerance_bps,json=toleranceBps,proto3" json:"tolerance_bps,omitempty"`
+	Affiliate             string `protobuf:"bytes,9,opt,name=affiliate,proto3" json:"affiliate,omitempty"`
+	AffiliateBps          string `protobuf:"bytes,10,opt,name=affiliate_bps,json=affiliateBps,proto3" json:"affiliate_bps,omitempty"`
+	LiquidityToleranceBps uint64 `protobuf:"varint,11,opt,name=liquidity_tolerance_bps,json=liquidityToleranceBps,proto3" json:"liquidity_tolerance_bps,omitempty"`
+}
+
+func (m *QueryQuoteSwapRequest) Reset()         { *m = QueryQuoteSwapRequest{} }
+func (m *QueryQuoteSwapRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteSwapRequest) ProtoMessage()    {}
+func (*QueryQuoteSwapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{33}
+}
+func (m *QueryQuoteSwapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuoteSwapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteSwapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQuoteSwapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteSwapRequest.Merge(m, src)
+}
+func (m *QueryQuoteSwapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuoteSwapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteSwapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQuoteSwapRequest proto.InternalMessageInfo
+
+func (m *QueryQuoteSwapRequest) GetFromAsset() string {
+	if m != nil {
+		return m.FromAsset
+	}
+	return ""
+}
+
+func (m *QueryQuoteSwapRequest) GetToAsset() string {
+	if m != nil {
+		return m.ToAsset
+	}
+	return ""
+}
+
+func (m *QueryQuoteSwapRequest) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *QueryQuoteSwapRequest) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *QueryQuoteSwapRequest) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
+	}
+	return ""
+}
+
+func (m *QueryQuoteSwapRequest) GetStreamingInterval() uint64 {
+	if m != nil {
+		return m.StreamingInterval
+	}
+	return 0
+}
+
+func (m *QueryQuoteSwapRequest) GetStreamingQuantity() uint64 {
+	if m != nil {
+		return m.StreamingQuantity
+	}
+	return 0
+}
+
+func (m *QueryQuoteSwapRequest) GetToleranceBps() uint64 {
+	if m != nil {
+		return m.ToleranceBps
+	}
+	return 0
+}
+
+func (m *QueryQuoteSwapRequest) GetAffiliate() string {
+	if m != nil {
+		return m.Affiliate
+	}
+	return ""
+}
+
+func (m *QueryQuoteSwapRequest) GetAffiliateBps() string {
+	if m != nil {
+		return m.AffiliateBps
+	}
+	return ""
+}
+
+func (m *QueryQuoteSwapRequest) GetLiquidityToleranceBps() uint64 {
+	if m != nil {
+		return m.LiquidityToleranceBps
+	}
+	return 0
+}
+
+type QueryQuoteSwapResponse struct {
+	InboundAddress             string    `protobuf:"bytes,1,opt,name=inbound_address,json=inboundAddress,proto3" json:"inbound_address,omitempty"`
+	InboundConfirmationBlocks  int64     `protobuf:"varint,2,opt,name=inbound_confirmation_blocks,json=inboundConfirmationBlocks,proto3" json:"inbound_confirmation_blocks,omitempty"`
+	InboundConfirmationSeconds int64     `protobuf:"varint,3,opt,name=inbound_confirmation_seconds,json=inboundConfirmationSeconds,proto3" json:"inbound_confirmation_seconds,omitempty"`
+	OutboundDelayBlocks        int64     `protobuf:"varint,4,opt,name=outbound_delay_blocks,json=outboundDelayBlocks,proto3" json:"outbound_delay_blocks,omitempty"`
+	OutboundDelaySeconds       int64     `protobuf:"varint,5,opt,name=outbound_delay_seconds,json=outboundDelaySeconds,proto3" json:"outbound_delay_seconds,omitempty"`
+	Fees                       QuoteFees `protobuf:"bytes,6,opt,name=fees,proto3" json:"fees"`
+	Router                     string    `protobuf:"bytes,7,opt,name=router,proto3" json:"router,omitempty"`
+	Expiry                     int64     `protobuf:"varint,8,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Warning                    string    `protobuf:"bytes,9,opt,name=warning,proto3" json:"warning,omitempty"`
+	Notes                      string    `protobuf:"bytes,10,opt,name=notes,proto3" json:"notes,omitempty"`
+	DustThreshold              string    `protobuf:"bytes,11,opt,name=dust_threshold,json=dustThreshold,proto3" json:"dust_threshold,omitempty"`
+	RecommendedMinAmountIn     string    `protobuf:"bytes,12,opt,name=recommended_min_amount_in,json=recommendedMinAmountIn,proto3" json:"recommended_min_amount_in,omitempty"`
+	RecommendedGasRate         string    `protobuf:"bytes,13,opt,name=recommended_gas_rate,json=recommendedGasRate,proto3" json:"recommended_gas_rate,omitempty"`
+	GasRateUnits               string    `protobuf:"bytes,14,opt,name=gas_rate_units,json=gasRateUnits,proto3" json:"gas_rate_units,omitempty"`
+	Memo                       string    `protobuf:"bytes,15,opt,name=memo,proto3" json:"memo,omitempty"`
+	ExpectedAmountOut          string    `protobuf:"bytes,16,opt,name=expected_amount_out,json=expectedAmountOut,proto3" json:"expected_amount_out,omitempty"`
+	MaxStreamingQuantity       int64     `protobuf:"varint,17,opt,name=max_streaming_quantity,json=maxStreamingQuantity,proto3" json:"max_streaming_quantity,omitempty"`
+	StreamingSwapBlocks        int64     `protobuf:"varint,18,opt,name=streaming_swap_blocks,json=streamingSwapBlocks,proto3" json:"streaming_swap_blocks,omitempty"`
+	StreamingSwapSeconds       int64     `protobuf:"varint,19,opt,name=streaming_swap_seconds,json=streamingSwapSeconds,proto3" json:"streaming_swap_seconds,omitempty"`
+	TotalSwapSeconds           int64     `protobuf:"varint,20,opt,name=total_swap_seconds,json=totalSwapSeconds,proto3" json:"total_swap_seconds,omitempty"`
+}
+
+func (m *QueryQuoteSwapResponse) Reset()         { *m = QueryQuoteSwapResponse{} }
+func (m *QueryQuoteSwapResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteSwapResponse) ProtoMessage()    {}
+func (*QueryQuoteSwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{34}
+}
+func (m *QueryQuoteSwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuoteSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteSwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQuoteSwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteSwapResponse.Merge(m, src)
+}
+func (m *QueryQuoteSwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuoteSwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteSwapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQuoteSwapResponse proto.InternalMessageInfo
+
+func (m *QueryQuoteSwapResponse) GetInboundAddress() string {
+	if m != nil {
+		return m.InboundAddress
+	}
+	return ""
+}
+
+func (m *QueryQuoteSwapResponse) GetInboundConfirmationBlocks() int64 {
+	if m != nil {
+		return m.InboundConfirmationBlocks
+	}
+	return 0
+}
+
+func (m *QueryQuoteSwapResponse) GetInboundConfirmationSeconds() int64 {
+	if m != nil {
+		return m.InboundConfirmationSeconds
+	}
+	return 0
+}
+
+func (m *QueryQuoteSwapResponse) GetOutboundDelayBlocks() int64 {
+	if m != nil {
+		return m.OutboundDelayBlocks
+	}
+	return 0
+}
+
+func (m *QueryQuoteSwapResponse) GetOutboundDelaySeconds() int64 {
+	if m != nil {
+		return m.OutboundDelaySeconds
+	}
+	return 0
+}
+
+func (m *QueryQuoteSwapResponse) GetFees() QuoteFees {
+	if m != nil {
+		return m.Fees
+	}
+	return QuoteFees{}
+}
+
+func (m *QueryQuoteSwapResponse) GetRouter() string {
+	if m != nil {
+		return m.Router
+	}
+	return ""
+}
+
+func (m *QueryQuoteSwapResponse) GetExpiry() int64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+func (m *QueryQuoteSwapResponse) GetWarning() string {
+	if m != nil {
+		return m.Warning
+	}
+	return ""
+}
+
+func (m *QueryQuoteSwapResponse) GetNotes() string {
+	if m != nil {
+		return m.Notes
+	}
+	return ""
+}
+
+func (m *QueryQuoteSwapResponse) GetDustThreshold() string {
+	if m != nil {
+		return m.DustThreshold
+	}
+	return ""
+}
+
+func (m *QueryQuoteSwapResponse) GetRecommendedMinAmountIn() string {
+	if m != nil {
+		return m.RecommendedMinAmountIn
+	}
+	return ""
+}
+
+func (m *QueryQuoteSwapResponse) GetRecommendedGasRate() string {
+	if m != nil {
+		return m.RecommendedGasRate
+	}
+	return ""
+}
+
+func (m *QueryQuoteSwapResponse) GetGasRateUnits() string {
+	if m != nil {
+		return m.GasRateUnits
+	}
+	return ""
+}
+
+func (m *QueryQuoteSwapResponse) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *QueryQuoteSwapResponse) GetExpectedAmountOut() string {
+	if m != nil {
+		return m.ExpectedAmountOut
+	}
+	return ""
+}
+
+func (m *QueryQuoteSwapResponse) GetMaxStreamingQuantity() int64 {
+	if m != nil {
+		return m.MaxStreamingQuantity
+	}
+	return 0
+}
+
+func (m *QueryQuoteSwapResponse) GetStreamingSwapBlocks() int64 {
+	if m != nil {
+		return m.StreamingSwapBlocks
+	}
+	return 0
+}
+
+func (m *QueryQuoteSwapResponse) GetStreamingSwapSeconds() int64 {
+	if m != nil {
+		return m.StreamingSwapSeconds
+	}
+	return 0
+}
+
+func (m *QueryQuoteSwapResponse) GetTotalSwapSeconds() int64 {
+	if m != nil {
+		return m.TotalSwapSeconds
+	}
+	return 0
+}
+
+type QuoteFees struct {
+	Asset       string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Affiliate   string `protobuf:"bytes,2,opt,name=affiliate,proto3" json:"affiliate,omitempty"`
+	Outbound    string `protobuf:"bytes,3,opt,name=outbound,proto3" json:"outbound,omitempty"`
+	Liquidity   string `protobuf:"bytes,4,opt,name=liquidity,proto3" json:"liquidity,omitempty"`
+	Total       string `protobuf:"bytes,5,opt,name=total,proto3" json:"total,omitempty"`
+	SlippageBps int64  `protobuf:"varint,6,opt,name=slippage_bps,json=slippageBps,proto3" json:"slippage_bps,omitempty"`
+	TotalBps    int64  `protobuf:"varint,7,opt,name=total_bps,json=totalBps,proto3" json:"total_bps,omitempty"`
+}
+
+func (m *QuoteFees) Reset()         { *m = QuoteFees{} }
+func (m *QuoteFees) String() string { return proto.CompactTextString(m) }
+func (*QuoteFees) ProtoMessage()    {}
+func (*QuoteFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{35}
+}
+func (m *QuoteFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuoteFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuoteFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuoteFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuoteFees.Merge(m, src)
+}
+func (m *QuoteFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuoteFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuoteFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuoteFees proto.InternalMessageInfo
+
+func (m *QuoteFees) GetAsset() string {
+	if m != nil {
+		return m.Asset
+	}
+	return ""
+}
+
+func (m *QuoteFees) GetAffiliate() string {
+	if m != nil {
+		return m.Affiliate
+	}
+	return ""
+}
+
+func (m *QuoteFees) GetOutbound() string {
+	if m != nil {
+		return m.Outbound
+	}
+	return ""
+}
+
+func (m *QuoteFees) GetLiquidity() string {
+	if m != nil {
+		return m.Liquidity
+	}
+	return ""
+}
+
+func (m *QuoteFees) GetTotal() string {
+	if m != nil {
+		return m.Total
+	}
+	return ""
+}
+
+func (m *QuoteFees) GetSlippageBps() int64 {
+	if m != nil {
+		return m.SlippageBps
+	}
+	return 0
+}
+
+func (m *QuoteFees) GetTotalBps() int64 {
+	if m != nil {
+		return m.TotalBps
+	}
+	return 0
+}
+
+type QueryQuoteSwapRoutesRequest struct {
+	FromAsset         string `protobuf:"bytes,1,opt,name=from_asset,json=fromAsset,proto3" json:"from_asset,omitempty"`
+	ToAsset           string `protobuf:"bytes,2,opt,name=to_asset,json=toAsset,proto3" json:"to_asset,omitempty"`
+	Amount            string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Destination       string `protobuf:"bytes,4,opt,name=destination,proto3" json:"destination,omitempty"`
+	RefundAddress     string `protobuf:"bytes,5,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
+	StreamingInterval uint64 `protobuf:"varint,6,opt,name=streaming_interval,json=streamingInterval,proto3" json:"streaming_interval,omitempty"`
+	ToleranceBps      uint64 `protobuf:"varint,7,opt,name=tolerance_bps,json=toleranceBps,proto3" json:"tolerance_bps,omitempty"`
+	Affiliate         string `protobuf:"bytes,8,opt,name=affiliate,proto3" json:"affiliate,omitempty"`
+	AffiliateBps      string `protobuf:"bytes,9,opt,name=affiliate_bps,json=affiliateBps,proto3" json:"affiliate_bps,omitempty"`
+}
+
+func (m *QueryQuoteSwapRoutesRequest) Reset()         { *m = QueryQuoteSwapRoutesRequest{} }
+func (m *QueryQuoteSwapRoutesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteSwapRoutesRequest) ProtoMessage()    {}
+func (*QueryQuoteSwapRoutesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{36}
+}
+func (m *QueryQuoteSwapRoutesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuoteSwapRoutesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteSwapRoutesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQuoteSwapRoutesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteSwapRoutesRequest.Merge(m, src)
+}
+func (m *QueryQuoteSwapRoutesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuoteSwapRoutesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteSwapRoutesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQuoteSwapRoutesRequest proto.InternalMessageInfo
+
+func (m *QueryQuoteSwapRoutesRequest) GetFromAsset() string {
+	if m != nil {
+		return m.FromAsset
+	}
+	return ""
+}
+
+func (m *QueryQuoteSwapRoutesRequest) GetToAsset() string {
+	if m != nil {
+		return m.ToAsset
+	}
+	return ""
+}
+
+func (m *QueryQuoteSwapRoutesRequest) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *QueryQuoteSwapRoutesRequest) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *QueryQuoteSwapRoutesRequest) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
+	}
+	return ""
+}
+
+func (m *QueryQuoteSwapRoutesRequest) GetStreamingInterval() uint64 {
+	if m != nil {
+		return m.StreamingInterval
+	}
+	return 0
+}
+
+func (m *QueryQuoteSwapRoutesRequest) GetToleranceBps() uint64 {
+	if m != nil {
+		return m.ToleranceBps
+	}
+	return 0
+}
+
+func (m *QueryQuoteSwapRoutesRequest) GetAffiliate() string {
+	if m != nil {
+		return m.Affiliate
+	}
+	return ""
+}
+
+func (m *QueryQuoteSwapRoutesRequest) GetAffiliateBps() string {
+	if m != nil {
+		return m.AffiliateBps
+	}
+	return ""
+}
+
+type QueryQuoteSwapRoutesResponse struct {
+	Routes []QuoteSwapRoute `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes"`
+}
+
+func (m *QueryQuoteSwapRoutesResponse) Reset()         { *m = QueryQuoteSwapRoutesResponse{} }
+func (m *QueryQuoteSwapRoutesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteSwapRoutesResponse) ProtoMessage()    {}
+func (*QueryQuoteSwapRoutesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{37}
+}
+func (m *QueryQuoteSwapRoutesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuoteSwapRoutesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteSwapRoutesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQuoteSwapRoutesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteSwapRoutesResponse.Merge(m, src)
+}
+func (m *QueryQuoteSwapRoutesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuoteSwapRoutesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteSwapRoutesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQuoteSwapRoutesResponse proto.InternalMessageInfo
+
+func (m *QueryQuoteSwapRoutesResponse) GetRoutes() []QuoteSwapRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+type QuoteSwapRoute struct {
+	InboundAddress             string    `protobuf:"bytes,1,opt,name=inbound_address,json=inboundAddress,proto3" json:"inbound_address,omitempty"`
+	InboundConfirmationBlocks  int64     `protobuf:"varint,2,opt,name=inbound_confirmation_blocks,json=inboundConfirmationBlocks,proto3" json:"inbound_confirmation_blocks,omitempty"`
+	InboundConfirmationSeconds int64     `protobuf:"varint,3,opt,name=inbound_confirmation_seconds,json=inboundConfirmationSeconds,proto3" json:"inbound_confirmation_seconds,omitempty"`
+	OutboundDelayBlocks        int64     `protobuf:"varint,4,opt,name=outbound_delay_blocks,json=outboundDelayBlocks,proto3" json:"outbound_delay_blocks,omitempty"`
+	OutboundDelaySeconds       int64     `protobuf:"varint,5,opt,name=outbound_delay_seconds,json=outboundDelaySeconds,proto3" json:"outbound_delay_seconds,omitempty"`
+	Fees                       QuoteFees `protobuf:"bytes,6,opt,name=fees,proto3" json:"fees"`
+	Router                     string    `protobuf:"bytes,7,opt,name=router,proto3" json:"router,omitempty"`
+	Expiry                     int64     `protobuf:"varint,8,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Warning                    string    `protobuf:"bytes,9,opt,name=warning,proto3" json:"warning,omitempty"`
+	Notes                      string    `protobuf:"bytes,10,opt,name=notes,proto3" json:"notes,omitempty"`
+	DustThreshold              string    `protobuf:"bytes,11,opt,name=dust_threshold,json=dustThreshold,proto3" json:"dust_threshold,omitempty"`
+	RecommendedMinAmountIn     string    `protobuf:"bytes,12,opt,name=recommended_min_amount_in,json=recommendedMinAmountIn,proto3" json:"recommended_min_amount_in,omitempty"`
+	RecommendedGasRate         string    `protobuf:"bytes,13,opt,name=recommended_gas_rate,json=recommendedGasRate,proto3" json:"recommended_gas_rate,omitempty"`
+	GasRateUnits               string    `protobuf:"bytes,14,opt,name=gas_rate_units,json=gasRateUnits,proto3" json:"gas_rate_units,omitempty"`
+	Route                      string    `protobuf:"bytes,15,opt,name=route,proto3" json:"route,omitempty"`
+	ToAsset                    string    `protobuf:"bytes,16,opt,name=to_asset,json=toAsset,proto3" json:"to_asset,omitempty"`
+	Memo                       string    `protobuf:"bytes,17,opt,name=memo,proto3" json:"memo,omitempty"`
+	ExpectedAmountOut          string    `protobuf:"bytes,18,opt,name=expected_amount_out,json=expectedAmountOut,proto3" json:"expected_amount_out,omitempty"`
+	StreamingInterval          int64     `protobuf:"varint,19,opt,name=streaming_interval,json=streamingInterval,proto3" json:"streaming_interval,omitempty"`
+	StreamingQuantity          int64     `protobuf:"varint,20,opt,name=streaming_quantity,json=streamingQuantity,proto3" json:"streaming_quantity,omitempty"`
+	MaxStreamingQuantity       int64     `protobuf:"varint,21,opt,name=max_streaming_quantity,json=maxStreamingQuantity,proto3" json:"max_streaming_quantity,omitempty"`
+	StreamingSwapBlocks        int64     `protobuf:"varint,22,opt,name=streaming_swap_blocks,json=streamingSwapBlocks,proto3" json:"streaming_swap_blocks,omitempty"`
+	StreamingSwapSeconds       int64     `protobuf:"varint,23,opt,name=streaming_swap_seconds,json=streamingSwapSeconds,proto3" json:"streaming_swap_seconds,omitempty"`
+	TotalSwapSeconds           int64     `protobuf:"varint,24,opt,name=total_swap_seconds,json=totalSwapSeconds,proto3" json:"total_swap_seconds,omitempty"`
+}
+
+func (m *QuoteSwapRoute) Reset()         { *m = QuoteSwapRoute{} }
+func (m *QuoteSwapRoute) String() string { return proto.CompactTextString(m) }
+func (*QuoteSwapRoute) ProtoMessage()    {}
+func (*QuoteSwapRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{38}
+}
+func (m *QuoteSwapRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuoteSwapRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuoteSwapRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuoteSwapRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuoteSwapRoute.Merge(m, src)
+}
+func (m *QuoteSwapRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuoteSwapRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuoteSwapRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuoteSwapRoute proto.InternalMessageInfo
+
+func (m *QuoteSwapRoute) GetInboundAddress() string {
+	if m != nil {
+		return m.InboundAddress
+	}
+	return ""
+}
+
+func (m *QuoteSwapRoute) GetInboundConfirmationBlocks() int64 {
+	if m != nil {
+		return m.InboundConfirmationBlocks
+	}
+	return 0
+}
+
+func (m *QuoteSwapRoute) GetInboundConfirmationSeconds() int64 {
+	if m != nil {
+		return m.InboundConfirmationSeconds
+	}
+	return 0
+}
+
+func (m *QuoteSwapRoute) GetOutboundDelayBlocks() int64 {
+	if m != nil {
+		return m.OutboundDelayBlocks
+	}
+	return 0
+}
+
+func (m *QuoteSwapRoute) GetOutboundDelaySeconds() int64 {
+	if m != nil {
+		return m.OutboundDelaySeconds
+	}
+	return 0
+}
+
+func (m *QuoteSwapRoute) GetFees() QuoteFees {
+	if m != nil {
+		return m.Fees
+	}
+	return QuoteFees{}
+}
+
+func (m *QuoteSwapRoute) GetRouter() string {
+	if m != nil {
+		return m.Router
+	}
+	return ""
+}
+
+func (m *QuoteSwapRoute) GetExpiry() int64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+func (m *QuoteSwapRoute) GetWarning() string {
+	if m != nil {
+		return m.Warning
+	}
+	return ""
+}
+
+func (m *QuoteSwapRoute) GetNotes() string {
+	if m != nil {
+		return m.Notes
+	}
+	return ""
+}
+
+func (m *QuoteSwapRoute) GetDustThreshold() string {
+	if m != nil {
+		return m.DustThreshold
+	}
+	return ""
+}
+
+func (m *QuoteSwapRoute) GetRecommendedMinAmountIn() string {
+	if m != nil {
+		return m.RecommendedMinAmountIn
+	}
+	return ""
+}
+
+func (m *QuoteSwapRoute) GetRecommendedGasRate() string {
+	if m != nil {
+		return m.RecommendedGasRate
+	}
+	return ""
+}
+
+func (m *QuoteSwapRoute) GetGasRateUnits() string {
+	if m != nil {
+		return m.GasRateUnits
+	}
+	return ""
+}
+
+func (m *QuoteSwapRoute) GetRoute() string {
+	if m != nil {
+		return m.Route
+	}
+	return ""
+}
+
+func (m *QuoteSwapRoute) GetToAsset() string {
+	if m != nil {
+		return m.ToAsset
+	}
+	return ""
+}
+
+func (m *QuoteSwapRoute) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *QuoteSwapRoute) GetExpectedAmountOut() string {
+	if m != nil {
+		return m.ExpectedAmountOut
+	}
+	return ""
+}
+
+func (m *QuoteSwapRoute) GetStreamingInterval() int64 {
+	if m != nil {
+		return m.StreamingInterval
+	}
+	return 0
+}
+
+func (m *QuoteSwapRoute) GetStreamingQuantity() int64 {
+	if m != nil {
+		return m.StreamingQuantity
+	}
+	return 0
+}
+
+func (m *QuoteSwapRoute) GetMaxStreamingQuantity() int64 {
+	if m != nil {
+		return m.MaxStreamingQuantity
+	}
+	return 0
+}
+
+func (m *QuoteSwapRoute) GetStreamingSwapBlocks() int64 {
+	if m != nil {
+		return m.StreamingSwapBlocks
+	}
+	return 0
+}
+
+func (m *QuoteSwapRoute) GetStreamingSwapSeconds() int64 {
+	if m != nil {
+		return m.StreamingSwapSeconds
+	}
+	return 0
+}
+
+func (m *QuoteSwapRoute) GetTotalSwapSeconds() int64 {
+	if m != nil {
+		return m.TotalSwapSeconds
+	}
+	return 0
+}
+
+type QueryQuoteSaverDepositRequest struct {
+	Asset  string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *QueryQuoteSaverDepositRequest) Reset()         { *m = QueryQuoteSaverDepositRequest{} }
+func (m *QueryQuoteSaverDepositRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteSaverDepositRequest) ProtoMessage()    {}
+func (*QueryQuoteSaverDepositRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{39}
+}
+func (m *QueryQuoteSaverDepositRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuoteSaverDepositRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteSaverDepositRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQuoteSaverDepositRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteSaverDepositRequest.Merge(m, src)
+}
+func (m *QueryQuoteSaverDepositRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuoteSaverDepositRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteSaverDepositRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQuoteSaverDepositRequest proto.InternalMessageInfo
+
+func (m *QueryQuoteSaverDepositRequest) GetAsset() string {
+	if m != nil {
+		return m.Asset
+	}
+	return ""
+}
+
+func (m *QueryQuoteSaverDepositRequest) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+type QueryQuoteSaverDepositResponse struct {
+	InboundAddress             string    `protobuf:"bytes,1,opt,name=inbound_address,json=inboundAddress,proto3" json:"inbound_address,omitempty"`
+	InboundConfirmationBlocks  int64     `protobuf:"varint,2,opt,name=inbound_confirmation_blocks,json=inboundConfirmationBlocks,proto3" json:"inbound_confirmation_blocks,omitempty"`
+	InboundConfirmationSeconds int64     `protobuf:"varint,3,opt,name=inbound_confirmation_seconds,json=inboundConfirmationSeconds,proto3" json:"inbound_confirmation_seconds,omitempty"`
+	OutboundDelayBlocks        int64     `protobuf:"varint,4,opt,name=outbound_delay_blocks,json=outboundDelayBlocks,proto3" json:"outbound_delay_blocks,omitempty"`
+	OutboundDelaySeconds       int64     `protobuf:"varint,5,opt,name=outbound_delay_seconds,json=outboundDelaySeconds,proto3" json:"outbound_delay_seconds,omitempty"`
+	Fees                       QuoteFees `protobuf:"bytes,6,opt,name=fees,proto3" json:"fees"`
+	Router                     string    `protobuf:"bytes,7,opt,name=router,proto3" json:"router,omitempty"`
+	Expiry                     int64     `protobuf:"varint,8,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Warning                    string    `protobuf:"bytes,9,opt,name=warning,proto3" json:"warning,omitempty"`
+	Notes                      string    `protobuf:"bytes,10,opt,name=notes,proto3" json:"notes,omitempty"`
+	DustThreshold              string    `protobuf:"bytes,11,opt,name=dust_threshold,json=dustThreshold,proto3" json:"dust_threshold,omitempty"`
+	RecommendedMinAmountIn     string    `protobuf:"bytes,12,opt,name=recommended_min_amount_in,json=recommendedMinAmountIn,proto3" json:"recommended_min_amount_in,omitempty"`
+	RecommendedGasRate         string    `protobuf:"bytes,13,opt,name=recommended_gas_rate,json=recommendedGasRate,proto3" json:"recommended_gas_rate,omitempty"`
+	GasRateUnits               string    `protobuf:"bytes,14,opt,name=gas_rate_units,json=gasRateUnits,proto3" json:"gas_rate_units,omitempty"`
+	Memo                       string    `protobuf:"bytes,15,opt,name=memo,proto3" json:"memo,omitempty"`
+	ExpectedAmountOut          string    `protobuf:"bytes,16,opt,name=expected_amount_out,json=expectedAmountOut,proto3" json:"expected_amount_out,omitempty"`
+	ExpectedAmountDeposit      string    `protobuf:"bytes,17,opt,name=expected_amount_deposit,json=expectedAmountDeposit,proto3" json:"expected_amount_deposit,omitempty"`
+}
+
+func (m *QueryQuoteSaverDepositResponse) Reset()         { *m = QueryQuoteSaverDepositResponse{} }
+func (m *QueryQuoteSaverDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteSaverDepositResponse) ProtoMessage()    {}
+func (*QueryQuoteSaverDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{40}
+}
+func (m *QueryQuoteSaverDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuoteSaverDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteSaverDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQuoteSaverDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteSaverDepositResponse.Merge(m, src)
+}
+func (m *QueryQuoteSaverDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuoteSaverDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteSaverDepositResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQuoteSaverDepositResponse proto.InternalMessageInfo
+
+func (m *QueryQuoteSaverDepositResponse) GetInboundAddress() string {
+	if m != nil {
+		return m.InboundAddress
+	}
+	return ""
+}
+
+func (m *QueryQuoteSaverDepositResponse) GetInboundConfirmationBlocks() int64 {
+	if m != nil {
+		return m.InboundConfirmationBlocks
+	}
+	return 0
+}
+
+func (m *QueryQuoteSaverDepositResponse) GetInboundConfirmationSeconds() int64 {
+	if m != nil {
+		return m.InboundConfirmationSeconds
+	}
+	return 0
+}
+
+func (m *QueryQuoteSaverDepositResponse) GetOutboundDelayBlocks() int64 {
+	if m != nil {
+		return m.OutboundDelayBlocks
+	}
+	return 0
+}
+
+func (m *QueryQuoteSaverDepositResponse) GetOutboundDelaySeconds() int64 {
+	if m != nil {
+		return m.OutboundDelaySeconds
+	}
+	return 0
+}
+
+func (m *QueryQuoteSaverDepositResponse) GetFees() QuoteFees {
+	if m != nil {
+		return m.Fees
+	}
+	return QuoteFees{}
+}
+
+func (m *QueryQuoteSaverDepositResponse) GetRouter() string {
+	if m != nil {
+		return m.Router
+	}
+	return ""
+}
+
+func (m *QueryQuoteSaverDepositResponse) GetExpiry() int64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+func (m *QueryQuoteSaverDepositResponse) GetWarning() string {
+	if m != nil {
+		return m.Warning
+	}
+	return ""
+}
+
+func (m *QueryQuoteSaverDepositResponse) GetNotes() string {
+	if m != nil {
+		return m.Notes
+	}
+	return ""
+}
+
+func (m *QueryQuoteSaverDepositResponse) GetDustThreshold() string {
+	if m != nil {
+		return m.DustThreshold
+	}
+	return ""
+}
+
+func (m *QueryQuoteSaverDepositResponse) GetRecommendedMinAmountIn() string {
+	if m != nil {
+		return m.RecommendedMinAmountIn
+	}
+	return ""
+}
+
+func (m *QueryQuoteSaverDepositResponse) GetRecommendedGasRate() string {
+	if m != nil {
+		return m.RecommendedGasRate
+	}
+	return ""
+}
+
+func (m *QueryQuoteSaverDepositResponse) GetGasRateUnits() string {
+	if m != nil {
+		return m.GasRateUnits
+	}
+	return ""
+}
+
+func (m *QueryQuoteSaverDepositResponse) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *QueryQuoteSaverDepositResponse) GetExpectedAmountOut() string {
+	if m != nil {
+		return m.ExpectedAmountOut
+	}
+	return ""
+}
+
+func (m *QueryQuoteSaverDepositResponse) GetExpectedAmountDeposit() string {
+	if m != nil {
+		return m.ExpectedAmountDeposit
+	}
+	return ""
+}
+
+type QueryQuoteSaverWithdrawRequest struct {
+	Asset       string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Address     string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	WithdrawBps uint64 `protobuf:"varint,3,opt,name=withdraw_bps,json=withdrawBps,proto3" json:"withdraw_bps,omitempty"`
+}
+
+func (m *QueryQuoteSaverWithdrawRequest) Reset()         { *m = QueryQuoteSaverWithdrawRequest{} }
+func (m *QueryQuoteSaverWithdrawRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteSaverWithdrawRequest) ProtoMessage()    {}
+func (*QueryQuoteSaverWithdrawRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{41}
+}
+func (m *QueryQuoteSaverWithdrawRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuoteSaverWithdrawRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteSaverWithdrawRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQuoteSaverWithdrawRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteSaverWithdrawRequest.Merge(m, src)
+}
+func (m *QueryQuoteSaverWithdrawRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuoteSaverWithdrawRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteSaverWithdrawRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQuoteSaverWithdrawRequest proto.InternalMessageInfo
+
+func (m *QueryQuoteSaverWithdrawRequest) GetAsset() string {
+	if m != nil {
+		return m.Asset
+	}
+	return ""
+}
+
+func (m *QueryQuoteSaverWithdrawRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryQuoteSaverWithdrawRequest) GetWithdrawBps() uint64 {
+	if m != nil {
+		return m.WithdrawBps
+	}
+	return 0
+}
+
+type QueryQuoteSaverWithdrawResponse struct {
+	InboundAddress             string    `protobuf:"bytes,1,opt,name=inbound_address,json=inboundAddress,proto3" json:"inbound_address,omitempty"`
+	InboundConfirmationBlocks  int64     `protobuf:"varint,2,opt,name=inbound_confirmation_blocks,json=inboundConfirmationBlocks,proto3" json:"inbound_confirmation_blocks,omitempty"`
+	InboundConfirmationSeconds int64     `protobuf:"varint,3,opt,name=inbound_confirmation_seconds,json=inboundConfirmationSeconds,proto3" json:"inbound_confirmation_seconds,omitempty"`
+	OutboundDelayBlocks        int64     `protobuf:"varint,4,opt,name=outbound_delay_blocks,json=outboundDelayBlocks,proto3" json:"outbound_delay_blocks,omitempty"`
+	OutboundDelaySeconds       int64     `protobuf:"varint,5,opt,name=outbound_delay_seconds,json=outboundDelaySeconds,proto3" json:"outbound_delay_seconds,omitempty"`
+	Fees                       QuoteFees `protobuf:"bytes,6,opt,name=fees,proto3" json:"fees"`
+	Router                     string    `protobuf:"bytes,7,opt,name=router,proto3" json:"router,omitempty"`
+	Expiry                     int64     `protobuf:"varint,8,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Warning                    string    `protobuf:"bytes,9,opt,name=warning,proto3" json:"warning,omitempty"`
+	Notes                      string    `protobuf:"bytes,10,opt,name=notes,proto3" json:"notes,omitempty"`
+	DustThreshold              string    `protobuf:"bytes,11,opt,name=dust_threshold,json=dustThreshold,proto3" json:"dust_threshold,omitempty"`
+	RecommendedMinAmountIn     string    `protobuf:"bytes,12,opt,name=recommended_min_amount_in,json=recommendedMinAmountIn,proto3" json:"recommended_min_amount_in,omitempty"`
+	RecommendedGasRate         string    `protobuf:"bytes,13,opt,name=recommended_gas_rate,json=recommendedGasRate,proto3" json:"recommended_gas_rate,omitempty"`
+	GasRateUnits               string    `protobuf:"bytes,14,opt,name=gas_rate_units,json=gasRateUnits,proto3" json:"gas_rate_units,omitempty"`
+	Memo                       string    `protobuf:"bytes,15,opt,name=memo,proto3" json:"memo,omitempty"`
+	DustAmount                 string    `protobuf:"bytes,16,opt,name=dust_amount,json=dustAmount,proto3" json:"dust_amount,omitempty"`
+	ExpectedAmountOut          string    `protobuf:"bytes,17,opt,name=expected_amount_out,json=expectedAmountOut,proto3" json:"expected_amount_out,omitempty"`
+}
+
+func (m *QueryQuoteSaverWithdrawResponse) Reset()         { *m = QueryQuoteSaverWithdrawResponse{} }
+func (m *QueryQuoteSaverWithdrawResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteSaverWithdrawResponse) ProtoMessage()    {}
+func (*QueryQuoteSaverWithdrawResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{42}
+}
+func (m *QueryQuoteSaverWithdrawResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuoteSaverWithdrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteSaverWithdrawResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQuoteSaverWithdrawResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteSaverWithdrawResponse.Merge(m, src)
+}
+func (m *QueryQuoteSaverWithdrawResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuoteSaverWithdrawResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteSaverWithdrawResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQuoteSaverWithdrawResponse proto.InternalMessageInfo
+
+func (m *QueryQuoteSaverWithdrawResponse) GetInboundAddress() string {
+	if m != nil {
+		return m.InboundAddress
+	}
+	return ""
+}
+
+func (m *QueryQuoteSaverWithdrawResponse) GetInboundConfirmationBlocks() int64 {
+	if m != nil {
+		return m.InboundConfirmationBlocks
+	}
+	return 0
+}
+
+func (m *QueryQuoteSaverWithdrawResponse) GetInboundConfirmationSeconds() int64 {
+	if m != nil {
+		return m.InboundConfirmationSeconds
+	}
+	return 0
+}
+
+func (m *QueryQuoteSaverWithdrawResponse) GetOutboundDelayBlocks() int64 {
+	if m != nil {
+		return m.OutboundDelayBlocks
+	}
+	return 0
+}
+
+func (m *QueryQuoteSaverWithdrawResponse) GetOutboundDelaySeconds() int64 {
+	if m != nil {
+		return m.OutboundDelaySeconds
+	}
+	return 0
+}
+
+func (m *QueryQuoteSaverWithdrawResponse) GetFees() QuoteFees {
+	if m != nil {
+		return m.Fees
+	}
+	return QuoteFees{}
+}
+
+func (m *QueryQuoteSaverWithdrawResponse) GetRouter() string {
+	if m != nil {
+		return m.Router
+	}
+	return ""
+}
+
+func (m *QueryQuoteSaverWithdrawResponse) GetExpiry() int64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+func (m *QueryQuoteSaverWithdrawResponse) GetWarning() string {
+	if m != nil {
+		return m.Warning
+	}
+	return ""
+}
+
+func (m *QueryQuoteSaverWithdrawResponse) GetNotes() string {
+	if m != nil {
+		return m.Notes
+	}
+	return ""
+}
+
+func (m *QueryQuoteSaverWithdrawResponse) GetDustThreshold() string {
+	if m != nil {
+		return m.DustThreshold
+	}
+	return ""
+}
+
+func (m *QueryQuoteSaverWithdrawResponse) GetRecommendedMinAmountIn() string {
+	if m != nil {
+		return m.RecommendedMinAmountIn
+	}
+	return ""
+}
+
+func (m *QueryQuoteSaverWithdrawResponse) GetRecommendedGasRate() string {
+	if m != nil {
+		return m.RecommendedGasRate
+	}
+	return ""
+}
+
+func (m *QueryQuoteSaverWithdrawResponse) GetGasRateUnits() string {
+	if m != nil {
+		return m.GasRateUnits
+	}
+	return ""
+}
+
+func (m *QueryQuoteSaverWithdrawResponse) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *QueryQuoteSaverWithdrawResponse) GetDustAmount() string {
+	if m != nil {
+		return m.DustAmount
+	}
+	return ""
+}
+
+func (m *QueryQuoteSaverWithdrawResponse) GetExpectedAmountOut() string {
+	if m != nil {
+		return m.ExpectedAmountOut
+	}
+	return ""
+}
+
+type QueryQuoteLiquidityAddRequest struct {
+	Asset        string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	AmountCacao  string `protobuf:"bytes,2,opt,name=amount_cacao,json=amountCacao,proto3" json:"amount_cacao,omitempty"`
+	AmountAsset  string `protobuf:"bytes,3,opt,name=amount_asset,json=amountAsset,proto3" json:"amount_asset,omitempty"`
+	CacaoAddress string `protobuf:"bytes,4,opt,name=cacao_address,json=cacaoAddress,proto3" json:"cacao_address,omitempty"`
+	AssetAddress string `protobuf:"bytes,5,opt,name=asset_address,json=assetAddress,proto3" json:"asset_address,omitempty"`
+}
+
+func (m *QueryQuoteLiquidityAddRequest) Reset()         { *m = QueryQuoteLiquidityAddRequest{} }
+func (m *QueryQuoteLiquidityAddRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteLiquidityAddRequest) ProtoMessage()    {}
+func (*QueryQuoteLiquidityAddRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{43}
+}
+func (m *QueryQuoteLiquidityAddRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuoteLiquidityAddRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteLiquidityAddRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQuoteLiquidityAddRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteLiquidityAddRequest.Merge(m, src)
+}
+func (m *QueryQuoteLiquidityAddRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuoteLiquidityAddRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteLiquidityAddRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQuoteLiquidityAddRequest proto.InternalMessageInfo
+
+func (m *QueryQuoteLiquidityAddRequest) GetAsset() string {
+	if m != nil {
+		return m.Asset
+	}
+	return ""
+}
+
+func (m *QueryQuoteLiquidityAddRequest) GetAmountCacao() string {
+	if m != nil {
+		return m.AmountCacao
+	}
+	return ""
+}
+
+func (m *QueryQuoteLiquidityAddRequest) GetAmountAsset() string {
+	if m != nil {
+		return m.AmountAsset
+	}
+	return ""
+}
+
+func (m *QueryQuoteLiquidityAddRequest) GetCacaoAddress() string {
+	if m != nil {
+		return m.CacaoAddress
+	}
+	return ""
+}
+
+func (m *QueryQuoteLiquidityAddRequest) GetAssetAddress() string {
+	if m != nil {
+		return m.AssetAddress
+	}
+	return ""
+}
+
+type QueryQuoteLiquidityAddResponse struct {
+	InboundAddress             string                 `protobuf:"bytes,1,opt,name=inbound_address,json=inboundAddress,proto3" json:"inbound_address,omitempty"`
+	InboundConfirmationBlocks  int64                  `protobuf:"varint,2,opt,name=inbound_confirmation_blocks,json=inboundConfirmationBlocks,proto3" json:"inbound_confirmation_blocks,omitempty"`
+	InboundConfirmationSeconds int64                  `protobuf:"varint,3,opt,name=inbound_confirmation_seconds,json=inboundConfirmationSeconds,proto3" json:"inbound_confirmation_seconds,omitempty"`
+	Expiry                     int64                  `protobuf:"varint,4,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Warning                    string                 `protobuf:"bytes,5,opt,name=warning,proto3" json:"warning,omitempty"`
+	Notes                      string                 `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+	Router                     string                 `protobuf:"bytes,7,opt,name=router,proto3" json:"router,omitempty"`
+	DustThreshold              string                 `protobuf:"bytes,8,opt,name=dust_threshold,json=dustThreshold,proto3" json:"dust_threshold,omitempty"`
+	RecommendedMinAmountIn     string                 `protobuf:"bytes,9,opt,name=recommended_min_amount_in,json=recommendedMinAmountIn,proto3" json:"recommended_min_amount_in,omitempty"`
+	RecommendedGasRate         string                 `protobuf:"bytes,10,opt,name=recommended_gas_rate,json=recommendedGasRate,proto3" json:"recommended_gas_rate,omitempty"`
+	GasRateUnits               string                 `protobuf:"bytes,11,opt,name=gas_rate_units,json=gasRateUnits,proto3" json:"gas_rate_units,omitempty"`
+	Memo                       string                 `protobuf:"bytes,12,opt,name=memo,proto3" json:"memo,omitempty"`
+	CacaoMemo                  string                 `protobuf:"bytes,13,opt,name=cacao_memo,json=cacaoMemo,proto3" json:"cacao_memo,omitempty"`
+	ExpectedPoolUnits          string                 `protobuf:"bytes,14,opt,name=expected_pool_units,json=expectedPoolUnits,proto3" json:"expected_pool_units,omitempty"`
+	PoolUnits                  string                 `protobuf:"bytes,15,opt,name=pool_units,json=poolUnits,proto3" json:"pool_units,omitempty"`
+	PoolShareBps               int64                  `protobuf:"varint,16,opt,name=pool_share_bps,json=poolShareBps,proto3" json:"pool_share_bps,omitempty"`
+	SlippageBps                int64                  `protobuf:"varint,17,opt,name=slippage_bps,json=slippageBps,proto3" json:"slippage_bps,omitempty"`
+	LiquidityAuction           *QuoteLiquidityAuction `protobuf:"bytes,18,opt,name=liquidity_auction,json=liquidityAuction,proto3" json:"liquidity_auction,omitempty"`
+}
+
+func (m *QueryQuoteLiquidityAddResponse) Reset()         { *m = QueryQuoteLiquidityAddResponse{} }
+func (m *QueryQuoteLiquidityAddResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteLiquidityAddResponse) ProtoMessage()    {}
+func (*QueryQuoteLiquidityAddResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{44}
+}
+func (m *QueryQuoteLiquidityAddResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuoteLiquidityAddResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteLiquidityAddResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQuoteLiquidityAddResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteLiquidityAddResponse.Merge(m, src)
+}
+func (m *QueryQuoteLiquidityAddResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuoteLiquidityAddResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteLiquidityAddResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQuoteLiquidityAddResponse proto.InternalMessageInfo
+
+func (m *QueryQuoteLiquidityAddResponse) GetInboundAddress() string {
+	if m != nil {
+		return m.InboundAddress
+	}
+	return ""
+}
+
+func (m *QueryQuoteLiquidityAddResponse) GetInboundConfirmationBlocks() int64 {
+	if m != nil {
+		return m.InboundConfirmationBlocks
+	}
+	return 0
+}
+
+func (m *QueryQuoteLiquidityAddResponse) GetInboundConfirmationSeconds() int64 {
+	if m != nil {
+		return m.InboundConfirmationSeconds
+	}
+	return 0
+}
+
+func (m *QueryQuoteLiquidityAddResponse) GetExpiry() int64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+func (m *QueryQuoteLiquidityAddResponse) GetWarning() string {
+	if m != nil {
+		return m.Warning
+	}
+	return ""
+}
+
+func (m *QueryQuoteLiquidityAddResponse) GetNotes() string {
+	if m != nil {
+		return m.Notes
+	}
+	return ""
+}
+
+func (m *QueryQuoteLiquidityAddResponse) GetRouter() string {
+	if m != nil {
+		return m.Router
+	}
+	return ""
+}
+
+func (m *QueryQuoteLiquidityAddResponse) GetDustThreshold() string {
+	if m != nil {
+		return m.DustThreshold
+	}
+	return ""
+}
+
+func (m *QueryQuoteLiquidityAddResponse) GetRecommendedMinAmountIn() string {
+	if m != nil {
+		return m.RecommendedMinAmountIn
+	}
+	return ""
+}
+
+func (m *QueryQuoteLiquidityAddResponse) GetRecommendedGasRate() string {
+	if m != nil {
+		return m.RecommendedGasRate
+	}
+	return ""
+}
+
+func (m *QueryQuoteLiquidityAddResponse) GetGasRateUnits() string {
+	if m != nil {
+		return m.GasRateUnits
+	}
+	return ""
+}
+
+func (m *QueryQuoteLiquidityAddResponse) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *QueryQuoteLiquidityAddResponse) GetCacaoMemo() string {
+	if m != nil {
+		return m.CacaoMemo
+	}
+	return ""
+}
+
+func (m *QueryQuoteLiquidityAddResponse) GetExpectedPoolUnits() string {
+	if m != nil {
+		return m.ExpectedPoolUnits
+	}
+	return ""
+}
+
+func (m *QueryQuoteLiquidityAddResponse) GetPoolUnits() string {
+	if m != nil {
+		return m.PoolUnits
+	}
+	return ""
+}
+
+func (m *QueryQuoteLiquidityAddResponse) GetPoolShareBps() int64 {
+	if m != nil {
+		return m.PoolShareBps
+	}
+	return 0
+}
+
+func (m *QueryQuoteLiquidityAddResponse) GetSlippageBps() int64 {
+	if m != nil {
+		return m.SlippageBps
+	}
+	return 0
+}
+
+func (m *QueryQuoteLiquidityAddResponse) GetLiquidityAuction() *QuoteLiquidityAuction {
+	if m != nil {
+		return m.LiquidityAuction
+	}
+	return nil
+}
+
+type QuoteLiquidityAuction struct {
+	Tier                      int64  `protobuf:"varint,1,opt,name=tier,proto3" json:"tier,omitempty"`
+	WithdrawLimitBps          int64  `protobuf:"varint,2,opt,name=withdraw_limit_bps,json=withdrawLimitBps,proto3" json:"withdraw_limit_bps,omitempty"`
+	WithdrawLimitActive       bool   `protobuf:"varint,3,opt,name=withdraw_limit_active,json=withdrawLimitActive,proto3" json:"withdraw_limit_active,omitempty"`
+	WithdrawCounter           string `protobuf:"bytes,4,opt,name=withdraw_counter,json=withdrawCounter,proto3" json:"withdraw_counter,omitempty"`
+	LastWithdrawCounterHeight int64  `protobuf:"varint,5,opt,name=last_withdraw_counter_height,json=lastWithdrawCounterHeight,proto3" json:"last_withdraw_counter_height,omitempty"`
+}
+
+func (m *QuoteLiquidityAuction) Reset()         { *m = QuoteLiquidityAuction{} }
+func (m *QuoteLiquidityAuction) String() string { return proto.CompactTextString(m) }
+func (*QuoteLiquidityAuction) ProtoMessage()    {}
+func (*QuoteLiquidityAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{45}
+}
+func (m *QuoteLiquidityAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuoteLiquidityAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuoteLiquidityAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuoteLiquidityAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuoteLiquidityAuction.Merge(m, src)
+}
+func (m *QuoteLiquidityAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuoteLiquidityAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuoteLiquidityAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuoteLiquidityAuction proto.InternalMessageInfo
+
+func (m *QuoteLiquidityAuction) GetTier() int64 {
+	if m != nil {
+		return m.Tier
+	}
+	return 0
+}
+
+func (m *QuoteLiquidityAuction) GetWithdrawLimitBps() int64 {
+	if m != nil {
+		return m.WithdrawLimitBps
+	}
+	return 0
+}
+
+func (m *QuoteLiquidityAuction) GetWithdrawLimitActive() bool {
+	if m != nil {
+		return m.WithdrawLimitActive
+	}
+	return false
+}
+
+func (m *QuoteLiquidityAuction) GetWithdrawCounter() string {
+	if m != nil {
+		return m.WithdrawCounter
+	}
+	return ""
+}
+
+func (m *QuoteLiquidityAuction) GetLastWithdrawCounterHeight() int64 {
+	if m != nil {
+		return m.LastWithdrawCounterHeight
+	}
+	return 0
+}
+
+type QueryQuoteLiquidityWithdrawRequest struct {
+	Asset           string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Address         string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	WithdrawBps     uint64 `protobuf:"varint,3,opt,name=withdraw_bps,json=withdrawBps,proto3" json:"withdraw_bps,omitempty"`
+	WithdrawalAsset string `protobuf:"bytes,4,opt,name=withdrawal_asset,json=withdrawalAsset,proto3" json:"withdrawal_asset,omitempty"`
+}
+
+func (m *QueryQuoteLiquidityWithdrawRequest) Reset()         { *m = QueryQuoteLiquidityWithdrawRequest{} }
+func (m *QueryQuoteLiquidityWithdrawRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteLiquidityWithdrawRequest) ProtoMessage()    {}
+func (*QueryQuoteLiquidityWithdrawRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{46}
+}
+func (m *QueryQuoteLiquidityWithdrawRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuoteLiquidityWithdrawRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteLiquidityWithdrawRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQuoteLiquidityWithdrawRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteLiquidityWithdrawRequest.Merge(m, src)
+}
+func (m *QueryQuoteLiquidityWithdrawRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuoteLiquidityWithdrawRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteLiquidityWithdrawRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQuoteLiquidityWithdrawRequest proto.InternalMessageInfo
+
+func (m *QueryQuoteLiquidityWithdrawRequest) GetAsset() string {
+	if m != nil {
+		return m.Asset
+	}
+	return ""
+}
+
+func (m *QueryQuoteLiquidityWithdrawRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryQuoteLiquidityWithdrawRequest) GetWithdrawBps() uint64 {
+	if m != nil {
+		return m.WithdrawBps
+	}
+	return 0
+}
+
+func (m *QueryQuoteLiquidityWithdrawRequest) GetWithdrawalAsset() string {
+	if m != nil {
+		return m.WithdrawalAsset
+	}
+	return ""
+}
+
+type QueryQuoteLiquidityWithdrawResponse struct {
+	InboundAddress            string                 `protobuf:"bytes,1,opt,name=inbound_address,json=inboundAddress,proto3" json:"inbound_address,omitempty"`
+	OutboundDelayBlocks       int64                  `protobuf:"varint,2,opt,name=outbound_delay_blocks,json=outboundDelayBlocks,proto3" json:"outbound_delay_blocks,omitempty"`
+	OutboundDelaySeconds      int64                  `protobuf:"varint,3,opt,name=outbound_delay_seconds,json=outboundDelaySeconds,proto3" json:"outbound_delay_seconds,omitempty"`
+	Router                    string                 `protobuf:"bytes,4,opt,name=router,proto3" json:"router,omitempty"`
+	Expiry                    int64                  `protobuf:"varint,5,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Warning                   string                 `protobuf:"bytes,6,opt,name=warning,proto3" json:"warning,omitempty"`
+	Notes                     string                 `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
+	DustThreshold             string                 `protobuf:"bytes,8,opt,name=dust_threshold,json=dustThreshold,proto3" json:"dust_threshold,omitempty"`
+	RecommendedGasRate        string                 `protobuf:"bytes,9,opt,name=recommended_gas_rate,json=recommendedGasRate,proto3" json:"recommended_gas_rate,omitempty"`
+	GasRateUnits              string                 `protobuf:"bytes,10,opt,name=gas_rate_units,json=gasRateUnits,proto3" json:"gas_rate_units,omitempty"`
+	Memo                      string                 `protobuf:"bytes,11,opt,name=memo,proto3" json:"memo,omitempty"`
+	DustAmount                string                 `protobuf:"bytes,12,opt,name=dust_amount,json=dustAmount,proto3" json:"dust_amount,omitempty"`
+	WithdrawUnits             string                 `protobuf:"bytes,13,opt,name=withdraw_units,json=withdrawUnits,proto3" json:"withdraw_units,omitempty"`
+	ExpectedAmountOutCacao    string                 `protobuf:"bytes,14,opt,name=expected_amount_out_cacao,json=expectedAmountOutCacao,proto3" json:"expected_amount_out_cacao,omitempty"`
+	ExpectedAmountOutAsset    string                 `protobuf:"bytes,15,opt,name=expected_amount_out_asset,json=expectedAmountOutAsset,proto3" json:"expected_amount_out_asset,omitempty"`
+	OutboundFeeCacao          string                 `protobuf:"bytes,16,opt,name=outbound_fee_cacao,json=outboundFeeCacao,proto3" json:"outbound_fee_cacao,omitempty"`
+	OutboundFeeAsset          string                 `protobuf:"bytes,17,opt,name=outbound_fee_asset,json=outboundFeeAsset,proto3" json:"outbound_fee_asset,omitempty"`
+	ImpermanentLossProtection string                 `protobuf:"bytes,18,opt,name=impermanent_loss_protection,json=impermanentLossProtection,proto3" json:"impermanent_loss_protection,omitempty"`
+	LiquidityAuction          *QuoteLiquidityAuction `protobuf:"bytes,19,opt,name=liquidity_auction,json=liquidityAuction,proto3" json:"liquidity_auction,omitempty"`
+}
+
+func (m *QueryQuoteLiquidityWithdrawResponse) Reset()         { *m = QueryQuoteLiquidityWithdrawResponse{} }
+func (m *QueryQuoteLiquidityWithdrawResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteLiquidityWithdrawResponse) ProtoMessage()    {}
+func (*QueryQuoteLiquidityWithdrawResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{47}
+}
+func (m *QueryQuoteLiquidityWithdrawResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuoteLiquidityWithdrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteLiquidityWithdrawResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQuoteLiquidityWithdrawResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteLiquidityWithdrawResponse.Merge(m, src)
+}
+func (m *QueryQuoteLiquidityWithdrawResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuoteLiquidityWithdrawResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteLiquidityWithdrawResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQuoteLiquidityWithdrawResponse proto.InternalMessageInfo
+
+func (m *QueryQuoteLiquidityWithdrawResponse) GetInboundAddress() string {
+	if m != nil {
+		return m.InboundAddress
+	}
+	return ""
+}
+
+func (m *QueryQuoteLiquidityWithdrawResponse) GetOutboundDelayBlocks() int64 {
+	if m != nil {
+		return m.OutboundDelayBlocks
+	}
+	return 0
+}
+
+func (m *QueryQuoteLiquidityWithdrawResponse) GetOutboundDelaySeconds() int64 {
+	if m != nil {
+		return m.OutboundDelaySeconds
+	}
+	return 0
+}
+
+func (m *QueryQuoteLiquidityWithdrawResponse) GetRouter() string {
+	if m != nil {
+		return m.Router
+	}
+	return ""
+}
+
+func (m *QueryQuoteLiquidityWithdrawResponse) GetExpiry() int64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+func (m *QueryQuoteLiquidityWithdrawResponse) GetWarning() string {
+	if m != nil {
+		return m.Warning
+	}
+	return ""
+}
+
+func (m *QueryQuoteLiquidityWithdrawResponse) GetNotes() string {
+	if m != nil {
+		return m.Notes
+	}
+	return ""
+}
+
+func (m *QueryQuoteLiquidityWithdrawResponse) GetDustThreshold() string {
+	if m != nil {
+		return m.DustThreshold
+	}
+	return ""
+}
+
+func (m *QueryQuoteLiquidityWithdrawResponse) GetRecommendedGasRate() string {
+	if m != nil {
+		return m.RecommendedGasRate
+	}
+	return ""
+}
+
+func (m *QueryQuoteLiquidityWithdrawResponse) GetGasRateUnits() string {
+	if m != nil {
+		return m.GasRateUnits
+	}
+	return ""
+}
+
+func (m *QueryQuoteLiquidityWithdrawResponse) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *QueryQuoteLiquidityWithdrawResponse) GetDustAmount() string {
+	if m != nil {
+		return m.DustAmount
+	}
+	return ""
+}
+
+func (m *QueryQuoteLiquidityWithdrawResponse) GetWithdrawUnits() string {
+	if m != nil {
+		return m.WithdrawUnits
+	}
+	return ""
+}
+
+func (m *QueryQuoteLiquidityWithdrawResponse) GetExpectedAmountOutCacao() string {
+	if m != nil {
+		return m.ExpectedAmountOutCacao
+	}
+	return ""
+}
+
+func (m *QueryQuoteLiquidityWithdrawResponse) GetExpectedAmountOutAsset() string {
+	if m != nil {
+		return m.ExpectedAmountOutAsset
+	}
+	return ""
+}
+
+func (m *QueryQuoteLiquidityWithdrawResponse) GetOutboundFeeCacao() string {
+	if m != nil {
+		return m.OutboundFeeCacao
+	}
+	return ""
+}
+
+func (m *QueryQuoteLiquidityWithdrawResponse) GetOutboundFeeAsset() string {
+	if m != nil {
+		return m.OutboundFeeAsset
+	}
+	return ""
+}
+
+func (m *QueryQuoteLiquidityWithdrawResponse) GetImpermanentLossProtection() string {
+	if m != nil {
+		return m.ImpermanentLossProtection
+	}
+	return ""
+}
+
+func (m *QueryQuoteLiquidityWithdrawResponse) GetLiquidityAuction() *QuoteLiquidityAuction {
+	if m != nil {
+		return m.LiquidityAuction
+	}
+	return nil
+}
+
+type QueryQuoteTradeDepositRequest struct {
+	Asset   string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Amount  string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryQuoteTradeDepositRequest) Reset()         { *m = QueryQuoteTradeDepositRequest{} }
+func (m *QueryQuoteTradeDepositRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteTradeDepositRequest) ProtoMessage()    {}
+func (*QueryQuoteTradeDepositRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{48}
+}
+func (m *QueryQuoteTradeDepositRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuoteTradeDepositRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteTradeDepositRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQuoteTradeDepositRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteTradeDepositRequest.Merge(m, src)
+}
+func (m *QueryQuoteTradeDepositRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuoteTradeDepositRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteTradeDepositRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQuoteTradeDepositRequest proto.InternalMessageInfo
+
+func (m *QueryQuoteTradeDepositRequest) GetAsset() string {
+	if m != nil {
+		return m.Asset
+	}
+	return ""
+}
+
+func (m *QueryQuoteTradeDepositRequest) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *QueryQuoteTradeDepositRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryQuoteTradeDepositResponse struct {
+	InboundAddress             string `protobuf:"bytes,1,opt,name=inbound_address,json=inboundAddress,proto3" json:"inbound_address,omitempty"`
+	InboundConfirmationBlocks  int64  `protobuf:"varint,2,opt,name=inbound_confirmation_blocks,json=inboundConfirmationBlocks,proto3" json:"inbound_confirmation_blocks,omitempty"`
+	InboundConfirmationSeconds int64  `protobuf:"varint,3,opt,name=inbound_confirmation_seconds,json=inboundConfirmationSeconds,proto3" json:"inbound_confirmation_seconds,omitempty"`
+	Router                     string `protobuf:"bytes,4,opt,name=router,proto3" json:"router,omitempty"`
+	Expiry                     int64  `protobuf:"varint,5,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Warning                    string `protobuf:"bytes,6,opt,name=warning,proto3" json:"warning,omitempty"`
+	Notes                      string `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
+	DustThreshold              string `protobuf:"bytes,8,opt,name=dust_threshold,json=dustThreshold,proto3" json:"dust_threshold,omitempty"`
+	RecommendedMinAmountIn     string `protobuf:"bytes,9,opt,name=recommended_min_amount_in,json=recommendedMinAmountIn,proto3" json:"recommended_min_amount_in,omitempty"`
+	RecommendedGasRate         string `protobuf:"bytes,10,opt,name=recommended_gas_rate,json=recommendedGasRate,proto3" json:"recommended_gas_rate,omitempty"`
+	GasRateUnits               string `protobuf:"bytes,11,opt,name=gas_rate_units,json=gasRateUnits,proto3" json:"gas_rate_units,omitempty"`
+	Memo                       string `protobuf:"bytes,12,opt,name=memo,proto3" json:"memo,omitempty"`
+	ExpectedAmountOut          string `protobuf:"bytes,13,opt,name=expected_amount_out,json=expectedAmountOut,proto3" json:"expected_amount_out,omitempty"`
+	ExpectedUnits              string `protobuf:"bytes,14,opt,name=expected_units,json=expectedUnits,proto3" json:"expected_units,omitempty"`
+}
+
+func (m *QueryQuoteTradeDepositResponse) Reset()         { *m = QueryQuoteTradeDepositResponse{} }
+func (m *QueryQuoteTradeDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteTradeDepositResponse) ProtoMessage()    {}
+func (*QueryQuoteTradeDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{49}
+}
+func (m *QueryQuoteTradeDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuoteTradeDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteTradeDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQuoteTradeDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteTradeDepositResponse.Merge(m, src)
+}
+func (m *QueryQuoteTradeDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuoteTradeDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteTradeDepositResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQuoteTradeDepositResponse proto.InternalMessageInfo
+
+func (m *QueryQuoteTradeDepositResponse) GetInboundAddress() string {
+	if m != nil {
+		return m.InboundAddress
+	}
+	return ""
+}
+
+func (m *QueryQuoteTradeDepositResponse) GetInboundConfirmationBlocks() int64 {
+	if m != nil {
+		return m.InboundConfirmationBlocks
+	}
+	return 0
+}
+
+func (m *QueryQuoteTradeDepositResponse) GetInboundConfirmationSeconds() int64 {
+	if m != nil {
+		return m.InboundConfirmationSeconds
+	}
+	return 0
+}
+
+func (m *QueryQuoteTradeDepositResponse) GetRouter() string {
+	if m != nil {
+		return m.Router
+	}
+	return ""
+}
+
+func (m *QueryQuoteTradeDepositResponse) GetExpiry() int64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+func (m *QueryQuoteTradeDepositResponse) GetWarning() string {
+	if m != nil {
+		return m.Warning
+	}
+	return ""
+}
+
+func (m *QueryQuoteTradeDepositResponse) GetNotes() string {
+	if m != nil {
+		return m.Notes
+	}
+	return ""
+}
+
+func (m *QueryQuoteTradeDepositResponse) GetDustThreshold() string {
+	if m != nil {
+		return m.DustThreshold
+	}
+	return ""
+}
+
+func (m *QueryQuoteTradeDepositResponse) GetRecommendedMinAmountIn() string {
+	if m != nil {
+		return m.RecommendedMinAmountIn
+	}
+	return ""
+}
+
+func (m *QueryQuoteTradeDepositResponse) GetRecommendedGasRate() string {
+	if m != nil {
+		return m.RecommendedGasRate
+	}
+	return ""
+}
+
+func (m *QueryQuoteTradeDepositResponse) GetGasRateUnits() string {
+	if m != nil {
+		return m.GasRateUnits
+	}
+	return ""
+}
+
+func (m *QueryQuoteTradeDepositResponse) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *QueryQuoteTradeDepositResponse) GetExpectedAmountOut() string {
+	if m != nil {
+		return m.ExpectedAmountOut
+	}
+	return ""
+}
+
+func (m *QueryQuoteTradeDepositResponse) GetExpectedUnits() string {
+	if m != nil {
+		return m.ExpectedUnits
+	}
+	return ""
+}
+
+type QueryQuoteTradeWithdrawRequest struct {
+	Asset   string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Amount  string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryQuoteTradeWithdrawRequest) Reset()         { *m = QueryQuoteTradeWithdrawRequest{} }
+func (m *QueryQuoteTradeWithdrawRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteTradeWithdrawRequest) ProtoMessage()    {}
+func (*QueryQuoteTradeWithdrawRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{50}
+}
+func (m *QueryQuoteTradeWithdrawRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuoteTradeWithdrawRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteTradeWithdrawRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQuoteTradeWithdrawRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteTradeWithdrawRequest.Merge(m, src)
+}
+func (m *QueryQuoteTradeWithdrawRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuoteTradeWithdrawRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteTradeWithdrawRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQuoteTradeWithdrawRequest proto.InternalMessageInfo
+
+func (m *QueryQuoteTradeWithdrawRequest) GetAsset() string {
+	if m != nil {
+		return m.Asset
+	}
+	return ""
+}
+
+func (m *QueryQuoteTradeWithdrawRequest) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *QueryQuoteTradeWithdrawRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryQuoteTradeWithdrawResponse struct {
+	OutboundDelayBlocks  int64     `protobuf:"varint,1,opt,name=outbound_delay_blocks,json=outboundDelayBlocks,proto3" json:"outbound_delay_blocks,omitempty"`
+	OutboundDelaySeconds int64     `protobuf:"varint,2,opt,name=outbound_delay_seconds,json=outboundDelaySeconds,proto3" json:"outbound_delay_seconds,omitempty"`
+	Fees                 QuoteFees `protobuf:"bytes,3,opt,name=fees,proto3" json:"fees"`
+	Expiry               int64     `protobuf:"varint,4,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Warning              string    `protobuf:"bytes,5,opt,name=warning,proto3" json:"warning,omitempty"`
+	Notes                string    `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+	Memo                 string    `protobuf:"bytes,7,opt,name=memo,proto3" json:"memo,omitempty"`
+	ExpectedAmountOut    string    `protobuf:"bytes,8,opt,name=expected_amount_out,json=expectedAmountOut,proto3" json:"expected_amount_out,omitempty"`
+}
+
+func (m *QueryQuoteTradeWithdrawResponse) Reset()         { *m = QueryQuoteTradeWithdrawResponse{} }
+func (m *QueryQuoteTradeWithdrawResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteTradeWithdrawResponse) ProtoMessage()    {}
+func (*QueryQuoteTradeWithdrawResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{51}
+}
+func (m *QueryQuoteTradeWithdrawResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuoteTradeWithdrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteTradeWithdrawResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQuoteTradeWithdrawResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteTradeWithdrawResponse.Merge(m, src)
+}
+func (m *QueryQuoteTradeWithdrawResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuoteTradeWithdrawResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteTradeWithdrawResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQuoteTradeWithdrawResponse proto.InternalMessageInfo
+
+func (m *QueryQuoteTradeWithdrawResponse) GetOutboundDelayBlocks() int64 {
+	if m != nil {
+		return m.OutboundDelayBlocks
+	}
+	return 0
+}
+
+func (m *QueryQuoteTradeWithdrawResponse) GetOutboundDelaySeconds() int64 {
+	if m != nil {
+		return m.OutboundDelaySeconds
+	}
+	return 0
+}
+
+func (m *QueryQuoteTradeWithdrawResponse) GetFees() QuoteFees {
+	if m != nil {
+		return m.Fees
+	}
+	return QuoteFees{}
+}
+
+func (m *QueryQuoteTradeWithdrawResponse) GetExpiry() int64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+func (m *QueryQuoteTradeWithdrawResponse) GetWarning() string {
+	if m != nil {
+		return m.Warning
+	}
+	return ""
+}
+
+func (m *QueryQuoteTradeWithdrawResponse) GetNotes() string {
+	if m != nil {
+		return m.Notes
+	}
+	return ""
+}
+
+func (m *QueryQuoteTradeWithdrawResponse) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *QueryQuoteTradeWithdrawResponse) GetExpectedAmountOut() string {
+	if m != nil {
+		return m.ExpectedAmountOut
+	}
+	return ""
+}
+
+type QueryQuoteCacaoPoolDepositRequest struct {
+	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *QueryQuoteCacaoPoolDepositRequest) Reset()         { *m = QueryQuoteCacaoPoolDepositRequest{} }
+func (m *QueryQuoteCacaoPoolDepositRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteCacaoPoolDepositRequest) ProtoMessage()    {}
+func (*QueryQuoteCacaoPoolDepositRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{52}
+}
+func (m *QueryQuoteCacaoPoolDepositRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuoteCacaoPoolDepositRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteCacaoPoolDepositRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQuoteCacaoPoolDepositRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteCacaoPoolDepositRequest.Merge(m, src)
+}
+func (m *QueryQuoteCacaoPoolDepositRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuoteCacaoPoolDepositRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteCacaoPoolDepositRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQuoteCacaoPoolDepositRequest proto.InternalMessageInfo
+
+func (m *QueryQuoteCacaoPoolDepositRequest) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+type QueryQuoteCacaoPoolDepositResponse struct {
+	Expiry          int64  `protobuf:"varint,1,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Warning         string `protobuf:"bytes,2,opt,name=warning,proto3" json:"warning,omitempty"`
+	Notes           string `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	Memo            string `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+	ExpectedUnits   string `protobuf:"bytes,5,opt,name=expected_units,json=expectedUnits,proto3" json:"expected_units,omitempty"`
+	MaturityBlocks  int64  `protobuf:"varint,6,opt,name=maturity_blocks,json=maturityBlocks,proto3" json:"maturity_blocks,omitempty"`
+	MaturitySeconds int64  `protobuf:"varint,7,opt,name=maturity_seconds,json=maturitySeconds,proto3" json:"maturity_seconds,omitempty"`
+}
+
+func (m *QueryQuoteCacaoPoolDepositResponse) Reset()         { *m = QueryQuoteCacaoPoolDepositResponse{} }
+func (m *QueryQuoteCacaoPoolDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteCacaoPoolDepositResponse) ProtoMessage()    {}
+func (*QueryQuoteCacaoPoolDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{53}
+}
+func (m *QueryQuoteCacaoPoolDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuoteCacaoPoolDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteCacaoPoolDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQuoteCacaoPoolDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteCacaoPoolDepositResponse.Merge(m, src)
+}
+func (m *QueryQuoteCacaoPoolDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuoteCacaoPoolDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteCacaoPoolDepositResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQuoteCacaoPoolDepositResponse proto.InternalMessageInfo
+
+func (m *QueryQuoteCacaoPoolDepositResponse) GetExpiry() int64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+func (m *QueryQuoteCacaoPoolDepositResponse) GetWarning() string {
+	if m != nil {
+		return m.Warning
+	}
+	return ""
+}
+
+func (m *QueryQuoteCacaoPoolDepositResponse) GetNotes() string {
+	if m != nil {
+		return m.Notes
+	}
+	return ""
+}
+
+func (m *QueryQuoteCacaoPoolDepositResponse) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *QueryQuoteCacaoPoolDepositResponse) GetExpectedUnits() string {
+	if m != nil {
+		return m.ExpectedUnits
+	}
+	return ""
+}
+
+func (m *QueryQuoteCacaoPoolDepositResponse) GetMaturityBlocks() int64 {
+	if m != nil {
+		return m.MaturityBlocks
+	}
+	return 0
+}
+
+func (m *QueryQuoteCacaoPoolDepositResponse) GetMaturitySeconds() int64 {
+	if m != nil {
+		return m.MaturitySeconds
+	}
+	return 0
+}
+
+type QueryQuoteCacaoPoolWithdrawRequest struct {
+	Address     string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	WithdrawBps uint64 `protobuf:"varint,2,opt,name=withdraw_bps,json=withdrawBps,proto3" json:"withdraw_bps,omitempty"`
+}
+
+func (m *QueryQuoteCacaoPoolWithdrawRequest) Reset()         { *m = QueryQuoteCacaoPoolWithdrawRequest{} }
+func (m *QueryQuoteCacaoPoolWithdrawRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteCacaoPoolWithdrawRequest) ProtoMessage()    {}
+func (*QueryQuoteCacaoPoolWithdrawRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{54}
+}
+func (m *QueryQuoteCacaoPoolWithdrawRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuoteCacaoPoolWithdrawRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteCacaoPoolWithdrawRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQuoteCacaoPoolWithdrawRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteCacaoPoolWithdrawRequest.Merge(m, src)
+}
+func (m *QueryQuoteCacaoPoolWithdrawRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuoteCacaoPoolWithdrawRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteCacaoPoolWithdrawRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQuoteCacaoPoolWithdrawRequest proto.InternalMessageInfo
+
+func (m *QueryQuoteCacaoPoolWithdrawRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryQuoteCacaoPoolWithdrawRequest) GetWithdrawBps() uint64 {
+	if m != nil {
+		return m.WithdrawBps
+	}
+	return 0
+}
+
+type QueryQuoteCacaoPoolWithdrawResponse struct {
+	Expiry            int64  `protobuf:"varint,1,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Warning           string `protobuf:"bytes,2,opt,name=warning,proto3" json:"warning,omitempty"`
+	Notes             string `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	Memo              string `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+	ExpectedAmountOut string `protobuf:"bytes,5,opt,name=expected_amount_out,json=expectedAmountOut,proto3" json:"expected_amount_out,omitempty"`
+	WithdrawUnits     string `protobuf:"bytes,6,opt,name=withdraw_units,json=withdrawUnits,proto3" json:"withdraw_units,omitempty"`
+	MaturityBlocks    int64  `protobuf:"varint,7,opt,name=maturity_blocks,json=maturityBlocks,proto3" json:"maturity_blocks,omitempty"`
+	MaturitySeconds   int64  `protobuf:"varint,8,opt,name=maturity_seconds,json=maturitySeconds,proto3" json:"maturity_seconds,omitempty"`
+}
+
+func (m *QueryQuoteCacaoPoolWithdrawResponse) Reset()         { *m = QueryQuoteCacaoPoolWithdrawResponse{} }
+func (m *QueryQuoteCacaoPoolWithdrawResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteCacaoPoolWithdrawResponse) ProtoMessage()    {}
+func (*QueryQuoteCacaoPoolWithdrawResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376719fd1e98fec5, []int{55}
+}
+func (m *QueryQuoteCacaoPoolWithdrawResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuoteCacaoPoolWithdrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteCacaoPoolWithdrawResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQuoteCacaoPoolWithdrawResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteCacaoPoolWithdrawResponse.Merge(m, src)
+}
+func (m *QueryQuoteCacaoPoolWithdrawResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuoteCacaoPoolWithdrawResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteCacaoPoolWithdrawResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQuoteCacaoPoolWithdrawResponse proto.InternalMessageInfo
+
+func (m *QueryQuoteCacaoPoolWithdrawResponse) GetExpiry() int64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+func (m *QueryQuoteCacaoPoolWithdrawResponse) GetWarning() string {
+	if m != nil {
+		return m.Warning
+	}
+	return ""
+}
+
+func (m *QueryQuoteCacaoPoolWithdrawResponse) GetNotes() string {
+	if m != nil {
+		return m.Notes
+	}
+	return ""
+}
+
+func (m *QueryQuoteCacaoPoolWithdrawResponse) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *QueryQuoteCacaoPoolWithdrawResponse) GetExpectedAmountOut() string {
+	if m != nil {
+		return m.ExpectedAmountOut
+	}
+	return ""
+}
+
+func (m *QueryQuoteCacaoPoolWithdrawResponse) GetWithdrawUnits() string {
+	if m != nil {
+		return m.WithdrawUnits
+	}
+	return ""
+}
+
+func (m *QueryQuoteCacaoPoolWithdrawResponse) GetMaturityBlocks() int64 {
+	if m != nil {
+		return m.MaturityBlocks
+	}
+	return 0
+}
+
+func (m *QueryQuoteCacaoPoolWithdrawResponse) GetMaturitySeconds() int64 {
+	if m != nil {
+		return m.MaturitySeconds
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryPoolRequest)(nil), "types.QueryPoolRequest")
+	proto.RegisterType((*QueryPoolResponse)(nil), "types.QueryPoolResponse")
+	proto.RegisterType((*QueryPoolsRequest)(nil), "types.QueryPoolsRequest")
+	proto.RegisterType((*QueryPoolsResponse)(nil), "types.QueryPoolsResponse")
+	proto.RegisterType((*QueryLiquidityProviderRequest)(nil), "types.QueryLiquidityProviderRequest")
+	proto.RegisterType((*QueryLiquidityProviderResponse)(nil), "types.QueryLiquidityProviderResponse")
+	proto.RegisterType((*QueryLiquidityProvidersRequest)(nil), "types.QueryLiquidityProvidersRequest")
+	proto.RegisterType((*QueryLiquidityProvidersResponse)(nil), "types.QueryLiquidityProvidersResponse")
+	proto.RegisterType((*QueryNodeRequest)(nil), "types.QueryNodeRequest")
+	proto.RegisterType((*QueryNodeResponse)(nil), "types.QueryNodeResponse")
+	proto.RegisterType((*QueryNodesRequest)(nil), "types.QueryNodesRequest")
+	proto.RegisterType((*QueryNodesResponse)(nil), "types.QueryNodesResponse")
+	proto.RegisterType((*QueryVaultRequest)(nil), "types.QueryVaultRequest")
+	proto.RegisterType((*QueryVaultResponse)(nil), "types.QueryVaultResponse")
+	proto.RegisterType((*QueryAsgardVaultsRequest)(nil), "types.QueryAsgardVaultsRequest")
+	proto.RegisterType((*QueryAsgardVaultsResponse)(nil), "types.QueryAsgardVaultsResponse")
+	proto.RegisterType((*QueryInboundAddressesRequest)(nil), "types.QueryInboundAddressesRequest")
+	proto.RegisterType((*QueryInboundAddressesResponse)(nil), "types.QueryInboundAddressesResponse")
+	proto.RegisterType((*InboundAddress)(nil), "types.InboundAddress")
+	proto.RegisterType((*QueryMimirRequest)(nil), "types.QueryMimirRequest")
+	proto.RegisterType((*QueryMimirResponse)(nil), "types.QueryMimirResponse")
+	proto.RegisterType((*QueryMimirsRequest)(nil), "types.QueryMimirsRequest")
+	proto.RegisterType((*QueryMimirsResponse)(nil), "types.QueryMimirsResponse")
+	proto.RegisterMapType((map[string]int64)(nil), "types.QueryMimirsResponse.MimirsEntry")
+	proto.RegisterType((*QueryStreamingSwapRequest)(nil), "types.QueryStreamingSwapRequest")
+	proto.RegisterType((*QueryStreamingSwapResponse)(nil), "types.QueryStreamingSwapResponse")
+	proto.RegisterType((*QueryStreamingSwapsRequest)(nil), "types.QueryStreamingSwapsRequest")
+	proto.RegisterType((*QueryStreamingSwapsResponse)(nil), "types.QueryStreamingSwapsResponse")
+	proto.RegisterType((*QueryTradeAccountRequest)(nil), "types.QueryTradeAccountRequest")
+	proto.RegisterType((*QueryTradeAccountResponse)(nil), "types.QueryTradeAccountResponse")
+	proto.RegisterType((*QueryTradeAccountsRequest)(nil), "types.QueryTradeAccountsRequest")
+	proto.RegisterType((*QueryTradeAccountsResponse)(nil), "types.QueryTradeAccountsResponse")
+	proto.RegisterType((*QueryMAYANameRequest)(nil), "types.QueryMAYANameRequest")
+	proto.RegisterType((*QueryMAYANameResponse)(nil), "types.QueryMAYANameResponse")
+	proto.RegisterType((*QueryQuoteSwapRequest)(nil), "types.QueryQuoteSwapRequest")
+	proto.RegisterType((*QueryQuoteSwapResponse)(nil), "types.QueryQuoteSwapResponse")
+	proto.RegisterType((*QuoteFees)(nil), "types.QuoteFees")
+	proto.RegisterType((*QueryQuoteSwapRoutesRequest)(nil), "types.QueryQuoteSwapRoutesRequest")
+	proto.RegisterType((*QueryQuoteSwapRoutesResponse)(nil), "types.QueryQuoteSwapRoutesResponse")
+	proto.RegisterType((*QuoteSwapRoute)(nil), "types.QuoteSwapRoute")
+	proto.RegisterType((*QueryQuoteSaverDepositRequest)(nil), "types.QueryQuoteSaverDepositRequest")
+	proto.RegisterType((*QueryQuoteSaverDepositResponse)(nil), "types.QueryQuoteSaverDepositResponse")
+	proto.RegisterType((*QueryQuoteSaverWithdrawRequest)(nil), "types.QueryQuoteSaverWithdrawRequest")
+	proto.RegisterType((*QueryQuoteSaverWithdrawResponse)(nil), "types.QueryQuoteSaverWithdrawResponse")
+	proto.RegisterType((*QueryQuoteLiquidityAddRequest)(nil), "types.QueryQuoteLiquidityAddRequest")
+	proto.RegisterType((*QueryQuoteLiquidityAddResponse)(nil), "types.QueryQuoteLiquidityAddResponse")
+	proto.RegisterType((*QuoteLiquidityAuction)(nil), "types.QuoteLiquidityAuction")
+	proto.RegisterType((*QueryQuoteLiquidityWithdrawRequest)(nil), "types.QueryQuoteLiquidityWithdrawRequest")
+	proto.RegisterType((*QueryQuoteLiquidityWithdrawResponse)(nil), "types.QueryQuoteLiquidityWithdrawResponse")
+	proto.RegisterType((*QueryQuoteTradeDepositRequest)(nil), "types.QueryQuoteTradeDepositRequest")
+	proto.RegisterType((*QueryQuoteTradeDepositResponse)(nil), "types.QueryQuoteTradeDepositResponse")
+	proto.RegisterType((*QueryQuoteTradeWithdrawRequest)(nil), "types.QueryQuoteTradeWithdrawRequest")
+	proto.RegisterType((*QueryQuoteTradeWithdrawResponse)(nil), "types.QueryQuoteTradeWithdrawResponse")
+	proto.RegisterType((*QueryQuoteCacaoPoolDepositRequest)(nil), "types.QueryQuoteCacaoPoolDepositRequest")
+	proto.RegisterType((*QueryQuoteCacaoPoolDepositResponse)(nil), "types.QueryQuoteCacaoPoolDepositResponse")
+	proto.RegisterType((*QueryQuoteCacaoPoolWithdrawRequest)(nil), "types.QueryQuoteCacaoPoolWithdrawRequest")
+	proto.RegisterType((*QueryQuoteCacaoPoolWithdrawResponse)(nil), "types.QueryQuoteCacaoPoolWithdrawResponse")
+}
+
+func init() {
+	proto.RegisterFile("mayachain/v1/x/mayachain/types/query.proto", fileDescriptor_376719fd1e98fec5)
+}
+
+var fileDescriptor_376719fd1e98fec5 = []byte{
+	// 3398 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x1b, 0x4d, 0x6f, 0x1b, 0xc7,
+	0xd5, 0x2b, 0x91, 0x12, 0xf5, 0x28, 0xd1, 0xd4, 0xe8, 0xc3, 0x14, 0x2d, 0xcb, 0xf6, 0xfa, 0x23,
+	0xb6, 0xe1, 0x88, 0x8e, 0x92, 0x3a, 0x71, 0xda, 0x26, 0x91, 0x9d, 0x26, 0x71, 0x6b, 0x27, 0x0e,
+	0xed, 0x36, 0x48, 0xd1, 0x84, 0x58, 0x71, 0xc7, 0xd4, 0x26, 0xcb, 0x5d, 0x7a, 0x77, 0x69, 0x4b,
+	0x31, 0x5c, 0x14, 0x29, 0xda, 0x4b, 0x0b, 0x34, 0x40, 0x8f, 0x39, 0x14, 0xed, 0xb9, 0x87, 0xfe,
+	0x87, 0xa2, 0x40, 0x6e, 0x31, 0xd0, 0x4b, 0x81, 0x00, 0x45, 0x9b, 0xf4, 0x3f, 0x14, 0x45, 0x2e,
+	0xc5, 0xcc, 0xbc, 0x59, 0xce, 0xec, 0x07, 0x49, 0xa9, 0x49, 0x90, 0x14, 0xbe, 0x18, 0xda, 0xf7,
+	0x31, 0xef, 0xcd, 0x9b, 0xf7, 0x35, 0x9c, 0x67, 0x38, 0xd7, 0xb5, 0x76, 0xad, 0xf6, 0xb6, 0xe5,
+	0x78, 0x8d, 0xbb, 0x4f, 0x34, 0x76, 0x1a, 0x83, 0xcf, 0x68, 0xb7, 0x47, 0xc3, 0xc6, 0x9d, 0x3e,
+	0x0d, 0x76, 0xd7, 0x7b, 0x81, 0x1f, 0xf9, 0xa4, 0xc8, 0x41, 0xf5, 0xc5, 0x8e, 0xdf, 0xf1, 0x39,
+	0xa4, 0xc1, 0xfe, 0x12, 0xc8, 0xfa, 0x6a, 0xc7, 0xf7, 0x3b, 0x2e, 0x6d, 0x58, 0x3d, 0xa7, 0x61,
+	0x79, 0x9e, 0x1f, 0x59, 0x91, 0xe3, 0x7b, 0x21, 0x62, 0xbf, 0x33, 0x42, 0x0c, 0xfb, 0xb7, 0xe5,
+	0x3a, 0x77, 0xfa, 0x8e, 0xed, 0x44, 0xbb, 0xad, 0x5e, 0xe0, 0xdf, 0x75, 0x6c, 0x1a, 0x20, 0xf7,
+	0xc6, 0x38, 0xdc, 0x0c, 0xe8, 0x59, 0x5d, 0x8a, 0x3c, 0x17, 0xc7, 0xe1, 0xf1, 0x7c, 0x9b, 0xb6,
+	0xac, 0x76, 0xdb, 0xef, 0x7b, 0x11, 0xf2, 0xad, 0x8f, 0xc3, 0xd7, 0xf3, 0x7d, 0x17, 0xe9, 0x9f,
+	0x19, 0x87, 0x3e, 0x8c, 0x02, 0x6a, 0x75, 0x1d, 0xaf, 0xd3, 0x0a, 0xef, 0x59, 0x3d, 0xe4, 0x7c,
+	0x7a, 0x1c, 0xce, 0x28, 0xb0, 0x52, 0x2a, 0x36, 0xc6, 0x61, 0xbc, 0x6b, 0xf5, 0x5d, 0x64, 0x30,
+	0xcf, 0x40, 0xf5, 0x75, 0x76, 0x8e, 0x37, 0x7c, 0xdf, 0x6d, 0xd2, 0x3b, 0x7d, 0x1a, 0x46, 0x64,
+	0x11, 0x8a, 0x56, 0x18, 0xd2, 0xa8, 0x66, 0x1c, 0x33, 0xce, 0xcc, 0x34, 0xc5, 0x87, 0xf9, 0x2c,
+	0xcc, 0x2b, 0x94, 0x61, 0xcf, 0xf7, 0x42, 0x4a, 0x4e, 0x41, 0x81, 0x6d, 0x98, 0x53, 0x96, 0x37,
+	0xca, 0xeb, 0x5c, 0xca, 0x3a, 0x23, 0xb9, 0x5c, 0xf8, 0xe8, 0xef, 0x47, 0x0f, 0x34, 0x39, 0xda,
+	0x5c, 0x50, 0x78, 0x43, 0x14, 0x63, 0x7e, 0x17, 0x88, 0x0a, 0xc4, 0x15, 0x1f, 0x83, 0x22, 0x63,
+	0x09, 0x6b, 0xc6, 0xb1, 0xc9, 0xec, 0x25, 0x05, 0xde, 0x7c, 0x0d, 0x8e, 0x70, 0xf6, 0x6b, 0xd2,
+	0x35, 0x6e, 0xa0, 0x67, 0x0c, 0xdd, 0x06, 0xa9, 0xc1, 0xb4, 0x65, 0xdb, 0x01, 0x0d, 0xc3, 0xda,
+	0x04, 0x87, 0xcb, 0x4f, 0xd3, 0x87, 0xb5, 0xbc, 0x05, 0x51, 0xb7, 0xeb, 0x40, 0xd2, 0x8e, 0x88,
+	0x7b, 0xaf, 0xa1, 0xa2, 0x29, 0x6e, 0xd4, 0x7a, 0xde, 0x4d, 0x22, 0xcc, 0x8b, 0x79, 0x02, 0xc3,
+	0xe1, 0x27, 0x11, 0xc0, 0xd1, 0x5c, 0x3e, 0xd4, 0xf4, 0x35, 0x58, 0x48, 0x6b, 0x2a, 0x6d, 0x3a,
+	0x4a, 0x55, 0x92, 0x52, 0x35, 0x34, 0xcf, 0xa3, 0x9f, 0xbc, 0xea, 0xdb, 0x54, 0x6a, 0xa7, 0x98,
+	0xd2, 0xd0, 0x4d, 0xb9, 0x89, 0xe7, 0x2d, 0xa8, 0x51, 0xa7, 0xf3, 0x50, 0x60, 0x41, 0x85, 0xf6,
+	0x22, 0xa8, 0x04, 0x23, 0xd9, 0x14, 0x3e, 0x2c, 0x5d, 0x86, 0x51, 0xc5, 0x2e, 0xc3, 0xf0, 0xb1,
+	0xcb, 0xbc, 0x88, 0x2e, 0x83, 0x40, 0x5c, 0x78, 0x1d, 0x8a, 0x8c, 0x45, 0x6e, 0x2f, 0x7f, 0x65,
+	0x41, 0x66, 0x9e, 0xc7, 0xa5, 0x7f, 0xc4, 0xe2, 0x40, 0x6e, 0xe6, 0x10, 0x4c, 0xf7, 0xfa, 0x5b,
+	0xad, 0x77, 0xe9, 0x2e, 0x6e, 0x66, 0xaa, 0xd7, 0xdf, 0xfa, 0x01, 0xdd, 0x35, 0x9f, 0x43, 0x99,
+	0x48, 0x8d, 0x32, 0xcf, 0x40, 0x91, 0x87, 0x11, 0xee, 0x66, 0x16, 0x65, 0x72, 0x22, 0x29, 0x8d,
+	0x13, 0x98, 0x75, 0xa8, 0x71, 0xfe, 0xcd, 0xb0, 0x63, 0x05, 0x36, 0x27, 0x88, 0xf7, 0xf3, 0x32,
+	0xac, 0x64, 0xe0, 0x50, 0xc4, 0x39, 0x98, 0xe2, 0x2b, 0xc8, 0x7d, 0x65, 0xc9, 0x40, 0x0a, 0x73,
+	0x0d, 0x56, 0xf9, 0x42, 0x57, 0xbd, 0x2d, 0xbf, 0xef, 0xd9, 0x9b, 0xe2, 0x1c, 0x06, 0x86, 0x73,
+	0x30, 0x58, 0xd2, 0x78, 0x14, 0xf6, 0x0a, 0xcc, 0x3b, 0x02, 0xd7, 0xb2, 0x24, 0x12, 0xe5, 0x2e,
+	0xa1, 0x5c, 0x9d, 0x17, 0x15, 0xa8, 0x3a, 0x89, 0x15, 0xcd, 0x4f, 0x26, 0xa1, 0xa2, 0x93, 0x32,
+	0x37, 0xe6, 0x49, 0x48, 0xba, 0x31, 0xff, 0x50, 0x2d, 0x3e, 0xa1, 0x5a, 0x5c, 0xf5, 0xab, 0x49,
+	0xcd, 0xaf, 0xc8, 0x32, 0x4c, 0x05, 0x7e, 0x3f, 0xa2, 0x41, 0xad, 0x20, 0x38, 0xc4, 0x17, 0x83,
+	0x6f, 0x5b, 0x6e, 0x44, 0xed, 0x5a, 0xf1, 0x98, 0x71, 0xa6, 0xd4, 0xc4, 0x2f, 0xb2, 0x01, 0x4b,
+	0x1d, 0xd7, 0xdf, 0xb2, 0x5c, 0x9e, 0x2c, 0x59, 0x92, 0xed, 0x59, 0xfd, 0x90, 0xda, 0xb5, 0x29,
+	0x4e, 0xb6, 0x20, 0x90, 0xb7, 0x04, 0xee, 0x06, 0x47, 0x91, 0x0b, 0xb0, 0xc8, 0xf5, 0x4b, 0xb2,
+	0x4c, 0x73, 0x16, 0xc2, 0x71, 0x3a, 0xc7, 0xb7, 0xe0, 0x90, 0xe0, 0x70, 0x7b, 0x2d, 0xab, 0xcd,
+	0x6b, 0x9b, 0x64, 0x2a, 0x71, 0x26, 0xb1, 0xe0, 0xb5, 0xde, 0xa6, 0x40, 0x22, 0xdb, 0x0a, 0x94,
+	0x3a, 0x56, 0xd8, 0x0a, 0xac, 0x88, 0xd6, 0x66, 0xc4, 0x3e, 0x3b, 0x56, 0xd8, 0xb4, 0x22, 0x4a,
+	0x4e, 0x42, 0x45, 0xa2, 0x5a, 0x7d, 0xcf, 0x89, 0xc2, 0x1a, 0x70, 0x82, 0x59, 0x24, 0xf8, 0x21,
+	0x83, 0x91, 0x33, 0x50, 0xf5, 0xfb, 0x91, 0x38, 0xb4, 0x68, 0xa7, 0x15, 0x3a, 0xef, 0xd1, 0x5a,
+	0x99, 0xd3, 0x55, 0x24, 0xfc, 0xd6, 0xce, 0x4d, 0xe7, 0x3d, 0x4a, 0x8e, 0xc3, 0x6c, 0x4c, 0x79,
+	0x9b, 0xd2, 0xda, 0x2c, 0xa7, 0x2a, 0x4b, 0xd8, 0x4b, 0x94, 0x65, 0xf2, 0x8a, 0xdd, 0x0f, 0xa3,
+	0x56, 0xb4, 0x1d, 0xd0, 0x70, 0xdb, 0x77, 0xed, 0xda, 0x1c, 0x27, 0x9a, 0x63, 0xd0, 0x5b, 0x12,
+	0x68, 0x9e, 0xc2, 0xd8, 0xb9, 0xee, 0x74, 0x9d, 0x38, 0xd3, 0x56, 0x61, 0x72, 0x10, 0x37, 0xec,
+	0x4f, 0xf3, 0x1c, 0x06, 0x0d, 0x92, 0xa1, 0x93, 0x2d, 0xb2, 0xa0, 0x71, 0xfb, 0x22, 0x05, 0x4c,
+	0x36, 0xc5, 0x87, 0xb9, 0xa8, 0xd2, 0xc6, 0x1e, 0xfb, 0x81, 0x01, 0x0b, 0x1a, 0x18, 0xd7, 0x78,
+	0x0e, 0xa6, 0xba, 0x1c, 0x82, 0xde, 0x79, 0x1a, 0xbd, 0x33, 0x83, 0x76, 0x5d, 0x7c, 0x7e, 0xcf,
+	0x8b, 0x82, 0xdd, 0x26, 0x72, 0xd5, 0x2f, 0x41, 0x59, 0x01, 0xa7, 0x55, 0x1f, 0x28, 0x39, 0xa1,
+	0x28, 0xf9, 0xec, 0xc4, 0x33, 0x86, 0x79, 0x01, 0xa3, 0xf5, 0xa6, 0x2c, 0xd9, 0x37, 0xef, 0x59,
+	0x3d, 0x69, 0x83, 0x05, 0x28, 0x46, 0x3b, 0x2d, 0xc7, 0xc6, 0xa5, 0x0a, 0xd1, 0xce, 0x55, 0xdb,
+	0x6c, 0x41, 0x3d, 0x8b, 0x03, 0xb7, 0xb2, 0x09, 0x15, 0xbd, 0xfa, 0x63, 0x32, 0x59, 0xc4, 0x2d,
+	0x69, 0x5c, 0x18, 0x6f, 0x73, 0xa1, 0x0a, 0x34, 0x57, 0xb3, 0x04, 0xc4, 0x36, 0xdc, 0x82, 0xc3,
+	0x99, 0x58, 0x94, 0x7f, 0x05, 0x0e, 0xea, 0xf2, 0xa5, 0x4d, 0x87, 0x29, 0x50, 0xd1, 0x14, 0x08,
+	0xcd, 0xa7, 0x30, 0xbd, 0xb1, 0x90, 0x90, 0xe9, 0x76, 0x74, 0x81, 0x78, 0x0b, 0x4d, 0xa9, 0x73,
+	0xa1, 0x5e, 0x2f, 0x40, 0x45, 0xeb, 0x6d, 0xa4, 0x5a, 0x0b, 0xa8, 0x96, 0xca, 0x24, 0xcd, 0x12,
+	0x29, 0xb0, 0xd0, 0x7c, 0x22, 0x63, 0xf9, 0x11, 0x45, 0xf5, 0x6d, 0xb4, 0x64, 0x82, 0xe5, 0x0b,
+	0x53, 0xe9, 0x1c, 0x2c, 0x0a, 0x17, 0xdd, 0x7c, 0x73, 0xf3, 0x55, 0xab, 0x1b, 0x17, 0x51, 0x02,
+	0x05, 0xd6, 0x9a, 0x4a, 0xb7, 0x61, 0x7f, 0x9b, 0xdf, 0x87, 0xa5, 0x04, 0x2d, 0xaa, 0xf1, 0x04,
+	0x94, 0x64, 0x2f, 0x8b, 0xbe, 0x72, 0x10, 0x15, 0x90, 0xa4, 0x28, 0x3c, 0x26, 0x33, 0xff, 0x34,
+	0x89, 0x8b, 0xbd, 0xde, 0xf7, 0x23, 0xaa, 0x7a, 0xec, 0x11, 0x80, 0xdb, 0x81, 0xdf, 0x6d, 0xa9,
+	0xc6, 0x98, 0x61, 0x90, 0x4d, 0xde, 0x28, 0xad, 0x40, 0x29, 0xf2, 0x11, 0x89, 0x9d, 0x52, 0xe4,
+	0x0b, 0xd4, 0x32, 0x4c, 0x59, 0x5d, 0xb6, 0x2d, 0xcc, 0xcf, 0xf8, 0x45, 0x8e, 0x41, 0xd9, 0xa6,
+	0x61, 0xe4, 0x78, 0xbc, 0xc1, 0xc7, 0x1c, 0xad, 0x82, 0x58, 0x96, 0x09, 0xe8, 0x6d, 0xa5, 0xca,
+	0xf0, 0x84, 0x3d, 0xd3, 0x9c, 0x13, 0x50, 0x59, 0x30, 0x1e, 0x07, 0x32, 0xf0, 0x4c, 0xc7, 0x8b,
+	0x68, 0x70, 0xd7, 0x72, 0x79, 0xd2, 0x2e, 0x34, 0xe7, 0x63, 0xcc, 0x55, 0x44, 0xe8, 0xe4, 0x77,
+	0xfa, 0x96, 0x17, 0x39, 0xd1, 0x2e, 0x4f, 0xd8, 0x2a, 0xf9, 0xeb, 0x88, 0x20, 0x27, 0x60, 0x2e,
+	0xf2, 0x5d, 0x1a, 0x58, 0x5e, 0x9b, 0xb6, 0xb6, 0x7a, 0x21, 0xcf, 0xd2, 0x85, 0xe6, 0x6c, 0x0c,
+	0xbc, 0xdc, 0x0b, 0xc9, 0x2a, 0xcc, 0x58, 0xb7, 0x6f, 0x3b, 0xae, 0x33, 0x48, 0xcf, 0x03, 0x00,
+	0x5b, 0x22, 0xfe, 0xe0, 0x4b, 0x60, 0x7e, 0x8e, 0x81, 0x6c, 0x89, 0x8b, 0x70, 0x68, 0xd0, 0x84,
+	0xe9, 0x12, 0xcb, 0x5c, 0xe2, 0x52, 0x8c, 0xbe, 0xa5, 0x88, 0x36, 0x7f, 0x31, 0x0d, 0xcb, 0xc9,
+	0x23, 0x8b, 0xbb, 0xe3, 0x83, 0x89, 0x32, 0x8d, 0x07, 0x57, 0xd1, 0xeb, 0x30, 0x79, 0x0e, 0x0e,
+	0x4b, 0xc2, 0xb6, 0xef, 0xdd, 0x76, 0x82, 0x2e, 0x3f, 0x80, 0xd6, 0x96, 0xeb, 0xb7, 0xdf, 0x0d,
+	0x31, 0xb7, 0xad, 0x20, 0xc9, 0x15, 0x85, 0xe2, 0x32, 0x27, 0x20, 0x2f, 0xc0, 0x6a, 0x26, 0x7f,
+	0x48, 0xdb, 0xbe, 0x67, 0x8b, 0xc2, 0x3c, 0xd9, 0xac, 0x67, 0x2c, 0x70, 0x53, 0x50, 0xb0, 0xda,
+	0x1b, 0xd7, 0x1c, 0x9b, 0xba, 0xd6, 0xae, 0x94, 0x5d, 0xe0, 0xac, 0x0b, 0x12, 0xf9, 0x22, 0xc3,
+	0xa1, 0xd4, 0xa7, 0x60, 0x39, 0xc1, 0x23, 0xe5, 0x15, 0x39, 0xd3, 0xa2, 0xc6, 0x24, 0x25, 0x9d,
+	0x83, 0xc2, 0x6d, 0x4a, 0x43, 0xee, 0x1f, 0xe5, 0x8d, 0x6a, 0x5c, 0x10, 0xfc, 0x88, 0xbe, 0x44,
+	0xa9, 0xec, 0x54, 0x38, 0x8d, 0xd2, 0x41, 0x4c, 0x27, 0x3b, 0x08, 0xba, 0xd3, 0x73, 0x82, 0x5d,
+	0xee, 0x0c, 0x93, 0x4d, 0xfc, 0x62, 0x29, 0xec, 0x9e, 0x15, 0x78, 0x8e, 0xd7, 0x91, 0x35, 0x1a,
+	0x3f, 0x59, 0x1a, 0xf1, 0xfc, 0x88, 0xca, 0xa3, 0x17, 0x1f, 0x19, 0x65, 0xb4, 0x9c, 0x51, 0x46,
+	0xc9, 0x25, 0x58, 0x09, 0x68, 0xdb, 0xef, 0x76, 0xa9, 0x67, 0x53, 0xbb, 0xd5, 0x75, 0xbc, 0x96,
+	0x88, 0xa1, 0x96, 0xe3, 0x61, 0x75, 0x5e, 0x56, 0x08, 0xae, 0x3b, 0xde, 0x26, 0x47, 0x5f, 0xf5,
+	0x58, 0x7f, 0xa2, 0xb2, 0xc6, 0x2d, 0x84, 0x28, 0xd7, 0x44, 0xc1, 0xbd, 0x9c, 0xdb, 0x4d, 0x54,
+	0x32, 0xba, 0x09, 0x02, 0x85, 0x2e, 0xed, 0xfa, 0xb5, 0x83, 0x22, 0x11, 0xb1, 0xbf, 0xc9, 0x3a,
+	0x2c, 0xd0, 0x9d, 0x1e, 0x6d, 0x47, 0xd4, 0x96, 0xfa, 0xf9, 0xfd, 0xa8, 0x56, 0xe5, 0x24, 0xf3,
+	0x12, 0x25, 0x54, 0x7b, 0xad, 0x1f, 0xb1, 0xf3, 0xeb, 0x5a, 0x3b, 0xad, 0x8c, 0x60, 0x9c, 0x17,
+	0xe7, 0xd7, 0xb5, 0x76, 0x6e, 0xa6, 0xe2, 0x71, 0x03, 0x96, 0xf4, 0x3a, 0x24, 0x3d, 0x85, 0x08,
+	0x4f, 0xd1, 0x2a, 0xce, 0xc0, 0x53, 0x12, 0x3c, 0xd2, 0x53, 0x16, 0x84, 0x24, 0x8d, 0x49, 0x7a,
+	0xca, 0x79, 0x20, 0x91, 0x1f, 0x59, 0xae, 0xce, 0xb1, 0xc8, 0x39, 0xaa, 0x1c, 0xa3, 0x50, 0x9b,
+	0x1f, 0x1b, 0x30, 0x13, 0x7b, 0x51, 0xce, 0x75, 0x52, 0x4b, 0x13, 0x13, 0xc9, 0x34, 0x51, 0x87,
+	0x92, 0xf4, 0x58, 0x4c, 0x95, 0xf1, 0x37, 0xe3, 0x8c, 0xc3, 0x1f, 0x53, 0xe5, 0x00, 0xc0, 0xa4,
+	0x71, 0x7d, 0x30, 0x3f, 0x8a, 0x0f, 0xd6, 0xc7, 0x85, 0xae, 0xd3, 0xeb, 0x59, 0x1d, 0x91, 0x46,
+	0xa6, 0xb8, 0xe6, 0x65, 0x09, 0x63, 0x49, 0xe7, 0x30, 0xcc, 0x88, 0x2d, 0x32, 0xfc, 0x34, 0xc7,
+	0x97, 0x38, 0x80, 0x65, 0x96, 0x8f, 0x27, 0xb0, 0x23, 0x18, 0x64, 0x16, 0xe6, 0xfe, 0xe1, 0xff,
+	0x5f, 0x49, 0x48, 0xe5, 0xf8, 0xe9, 0x51, 0x39, 0xbe, 0x34, 0x32, 0xc7, 0xcf, 0xa4, 0x73, 0xbc,
+	0x79, 0x13, 0x2f, 0x5e, 0x29, 0x83, 0x62, 0xc2, 0x7e, 0x12, 0xf3, 0x4d, 0xf2, 0x32, 0xa5, 0xd3,
+	0xcb, 0xdb, 0x9c, 0x20, 0x35, 0x7f, 0x5d, 0x82, 0x8a, 0x4e, 0xf0, 0x28, 0xf1, 0x3f, 0x4a, 0xfc,
+	0x5f, 0x56, 0xe2, 0x5f, 0x84, 0x22, 0xb7, 0x05, 0x66, 0x7e, 0xf1, 0xa1, 0xc5, 0x7a, 0x55, 0x8f,
+	0x75, 0x59, 0x29, 0xe6, 0x47, 0x57, 0x0a, 0x92, 0x57, 0x29, 0xb2, 0xc3, 0x59, 0xe4, 0xee, 0xb1,
+	0x3b, 0xbc, 0xc5, 0x04, 0x79, 0x5c, 0x51, 0xf2, 0xeb, 0xd0, 0xd2, 0x7e, 0xea, 0xd0, 0xf2, 0x7e,
+	0xea, 0xd0, 0xa1, 0x3d, 0xd7, 0xa1, 0x5a, 0x4e, 0x1d, 0xba, 0x8e, 0x3f, 0xde, 0x88, 0x94, 0x60,
+	0xdd, 0xa5, 0xc1, 0x8b, 0xb4, 0xe7, 0x87, 0x4e, 0x34, 0xfc, 0x97, 0xce, 0x41, 0x4a, 0x9e, 0x50,
+	0x53, 0xb2, 0xf9, 0xef, 0x22, 0xfe, 0xee, 0x98, 0xb1, 0xde, 0xa3, 0x36, 0xf3, 0x51, 0xb6, 0xf9,
+	0xfa, 0xb7, 0x99, 0x17, 0xe1, 0x50, 0x92, 0xde, 0x16, 0x1e, 0x8c, 0x39, 0x69, 0x49, 0xe7, 0x41,
+	0xf7, 0x36, 0xc3, 0x94, 0xe3, 0xbf, 0xe1, 0x44, 0xdb, 0x76, 0x60, 0xdd, 0xdb, 0xe7, 0x9b, 0x01,
+	0x6b, 0xc8, 0xee, 0xe1, 0x12, 0xbc, 0x45, 0x98, 0xe4, 0x5d, 0x46, 0x59, 0xc2, 0x58, 0x87, 0xf0,
+	0xcf, 0x22, 0xfe, 0x5c, 0x9f, 0x25, 0xf5, 0x51, 0xbc, 0x3d, 0x8a, 0xb7, 0xaf, 0x3e, 0xde, 0x8e,
+	0x42, 0x99, 0xef, 0x06, 0xcb, 0x83, 0x88, 0x33, 0x60, 0x20, 0xa1, 0x4e, 0x5e, 0x40, 0xce, 0xe7,
+	0x04, 0xa4, 0xf9, 0x17, 0x43, 0x2d, 0x51, 0xf1, 0xbb, 0xd2, 0xa6, 0x6d, 0x0f, 0x0f, 0xac, 0xe3,
+	0x30, 0x8b, 0xcb, 0xb7, 0xad, 0xb6, 0xe5, 0x63, 0x74, 0x95, 0x05, 0xec, 0x0a, 0x03, 0x29, 0x24,
+	0x82, 0x7f, 0x52, 0x25, 0x11, 0xfd, 0xc8, 0x09, 0x98, 0xe3, 0xec, 0x71, 0xec, 0x88, 0x5b, 0xc6,
+	0x2c, 0x07, 0xca, 0xc8, 0x61, 0xdd, 0x3c, 0xa3, 0x4e, 0xdc, 0x32, 0x66, 0x39, 0x10, 0x89, 0xcc,
+	0xcf, 0xb5, 0xd2, 0xa8, 0xef, 0xe3, 0x9b, 0x17, 0xaa, 0x03, 0xe7, 0x2f, 0xe4, 0x39, 0x7f, 0x31,
+	0xc7, 0xf9, 0xa7, 0x54, 0xe7, 0xcf, 0x0b, 0xae, 0x74, 0x50, 0x94, 0xf6, 0x1c, 0x14, 0x33, 0xfb,
+	0x0a, 0x0a, 0xd8, 0x43, 0x50, 0x94, 0x87, 0x04, 0xc5, 0xac, 0x12, 0x14, 0x47, 0x00, 0x84, 0x17,
+	0x71, 0x8c, 0x08, 0xbb, 0x19, 0x0e, 0xb9, 0x9e, 0xac, 0x51, 0x3d, 0xdf, 0x77, 0xb5, 0x90, 0x8b,
+	0x43, 0xe2, 0x86, 0xef, 0xbb, 0x42, 0xc4, 0x11, 0x00, 0x85, 0x4c, 0x44, 0xdf, 0x4c, 0x2f, 0x46,
+	0x9f, 0x84, 0x0a, 0x47, 0x87, 0xdb, 0x56, 0x20, 0x6e, 0x97, 0x55, 0x7e, 0x46, 0xb3, 0x0c, 0x7a,
+	0x93, 0x01, 0xd9, 0x05, 0x35, 0x79, 0xdf, 0x9f, 0x4f, 0xdf, 0xf7, 0xaf, 0xc2, 0xe0, 0x65, 0xb9,
+	0x65, 0xf5, 0xf9, 0x0b, 0x13, 0x6f, 0xbb, 0xcb, 0x1b, 0xab, 0x6a, 0xca, 0x1c, 0x38, 0xb3, 0xa0,
+	0x69, 0x56, 0xdd, 0x04, 0xc4, 0xfc, 0x8f, 0x01, 0x4b, 0x99, 0xb4, 0xcc, 0x5e, 0x91, 0x83, 0x4f,
+	0xdd, 0x93, 0x4d, 0xfe, 0x37, 0xeb, 0x61, 0xe3, 0xd2, 0xe7, 0x3a, 0x5d, 0x27, 0xe2, 0x1a, 0x0a,
+	0xb7, 0xae, 0x4a, 0xcc, 0x35, 0x86, 0x60, 0x6a, 0x6e, 0xc0, 0x52, 0x82, 0xda, 0x6a, 0x47, 0xce,
+	0x5d, 0xca, 0xdd, 0xb8, 0xd4, 0x5c, 0xd0, 0x18, 0x36, 0x39, 0x8a, 0x9c, 0x85, 0x78, 0x9d, 0x16,
+	0xff, 0x15, 0x3d, 0x7e, 0xf7, 0x3b, 0x28, 0xe1, 0x57, 0x04, 0x98, 0x3c, 0x0f, 0xab, 0xae, 0x15,
+	0x46, 0xad, 0x24, 0x7d, 0x6b, 0x9b, 0x3a, 0x9d, 0xed, 0x08, 0xeb, 0xcc, 0x0a, 0xa3, 0x79, 0x43,
+	0x67, 0x7d, 0x85, 0x13, 0x98, 0x7f, 0x30, 0xc0, 0xcc, 0x88, 0xfc, 0x2f, 0xbf, 0x3f, 0x50, 0x77,
+	0x69, 0xb9, 0x98, 0xe4, 0x12, 0xbb, 0xb4, 0x5c, 0x9e, 0xe8, 0xcc, 0x87, 0x53, 0x70, 0x62, 0xa8,
+	0x92, 0x7b, 0xcd, 0x51, 0xb9, 0xc5, 0x7c, 0x62, 0x3f, 0xc5, 0x7c, 0x72, 0x48, 0x31, 0x1f, 0xf2,
+	0x72, 0x8b, 0x39, 0xaa, 0x98, 0x97, 0xa3, 0xa6, 0x72, 0x72, 0xd4, 0xf4, 0xf0, 0x02, 0x9d, 0x99,
+	0x8b, 0xf2, 0x12, 0xca, 0xcc, 0x1e, 0x12, 0x0a, 0x0c, 0x49, 0x28, 0xe5, 0xfc, 0x2a, 0x3b, 0x9b,
+	0xaa, 0xb2, 0xa7, 0xa0, 0x12, 0x3b, 0x87, 0x58, 0x1a, 0x9f, 0x5c, 0x25, 0x54, 0xac, 0x7d, 0x09,
+	0x56, 0x32, 0x8a, 0x31, 0x56, 0x4c, 0x91, 0x7f, 0x96, 0x53, 0x25, 0x59, 0x14, 0xcf, 0x1c, 0x56,
+	0xe1, 0x64, 0x07, 0x73, 0x58, 0x45, 0x51, 0x3d, 0x0f, 0x44, 0x7d, 0x32, 0x46, 0x71, 0xa2, 0x55,
+	0xa8, 0x2a, 0x0f, 0xc7, 0x42, 0x50, 0x92, 0x5a, 0x48, 0x98, 0x4f, 0x51, 0x8b, 0xb5, 0x59, 0x69,
+	0xec, 0xf6, 0x68, 0xd0, 0xb5, 0x3c, 0xea, 0x45, 0x2d, 0xd7, 0x0f, 0xc3, 0x56, 0x2f, 0xf0, 0x23,
+	0x3a, 0xc8, 0x5e, 0x33, 0xcd, 0x15, 0x85, 0xe4, 0x9a, 0x1f, 0x86, 0x37, 0x62, 0x82, 0xec, 0x9c,
+	0xb7, 0xb0, 0xaf, 0x9c, 0xd7, 0x51, 0x1b, 0x17, 0xfe, 0x8a, 0xf7, 0xbf, 0xdc, 0xad, 0xf3, 0x47,
+	0x17, 0xcc, 0x87, 0x05, 0xb5, 0xb5, 0xd0, 0x25, 0x7d, 0x23, 0x5b, 0x8b, 0xaf, 0x43, 0x38, 0x7f,
+	0x43, 0x5a, 0x8b, 0x9c, 0x76, 0x7a, 0x2e, 0xef, 0x7e, 0x7b, 0x0a, 0x2a, 0x31, 0xbd, 0xda, 0x66,
+	0xcc, 0x49, 0x28, 0x17, 0x65, 0x6e, 0xa7, 0x3c, 0x6a, 0xbc, 0x72, 0xb5, 0x77, 0xe7, 0xfd, 0xf3,
+	0x84, 0x7a, 0x87, 0x4d, 0x88, 0x42, 0xef, 0xcd, 0xad, 0x25, 0xc6, 0x7e, 0x6a, 0xc9, 0xc4, 0x18,
+	0x17, 0xc3, 0xc9, 0xf1, 0x2e, 0x86, 0x5f, 0x48, 0x0f, 0x2c, 0x8f, 0x75, 0x7a, 0xf4, 0xb1, 0x96,
+	0xf2, 0x6e, 0x49, 0xdf, 0x86, 0xe3, 0x03, 0x23, 0xf2, 0xbc, 0xc9, 0xda, 0xc5, 0x44, 0xbe, 0x19,
+	0x1c, 0x8e, 0xa1, 0xfd, 0x6a, 0xf7, 0xb9, 0xd6, 0xa0, 0xa4, 0xb9, 0xf1, 0x14, 0x06, 0xfb, 0x35,
+	0xf2, 0xf6, 0x3b, 0x91, 0xb3, 0xdf, 0xc9, 0xac, 0xfd, 0x16, 0x94, 0xfd, 0xa6, 0xdd, 0xb2, 0x98,
+	0xe1, 0x96, 0x2c, 0x8d, 0x75, 0xad, 0xa8, 0x1f, 0xb0, 0xe4, 0x8c, 0x2e, 0x20, 0xde, 0xa9, 0x2a,
+	0x12, 0x8c, 0xa7, 0x7f, 0x16, 0xaa, 0x31, 0xa1, 0x3c, 0x77, 0xf1, 0x62, 0x15, 0x2f, 0x20, 0x7f,
+	0x02, 0xb5, 0x32, 0x37, 0x9f, 0x74, 0xf7, 0xdc, 0x79, 0x93, 0x54, 0x1f, 0x36, 0x91, 0xfe, 0x9d,
+	0xe6, 0x8f, 0x13, 0x6a, 0x73, 0x95, 0x21, 0xe3, 0x2b, 0xb0, 0x70, 0x8e, 0x47, 0x15, 0x87, 0x24,
+	0x8a, 0x44, 0x07, 0x31, 0x95, 0xd5, 0x41, 0x64, 0x9c, 0xc8, 0xf4, 0xd8, 0x27, 0x52, 0xca, 0x3c,
+	0x91, 0x8d, 0x4f, 0x56, 0xa1, 0xc8, 0xcd, 0x45, 0xde, 0x82, 0x02, 0x33, 0x14, 0x39, 0xa4, 0x4e,
+	0x62, 0x29, 0xf3, 0xc4, 0xf5, 0x5a, 0x1a, 0x21, 0x6c, 0x69, 0x9a, 0xef, 0xff, 0xf5, 0x5f, 0xbf,
+	0x9d, 0x58, 0x25, 0x89, 0xb9, 0x65, 0x76, 0x07, 0x6a, 0xdc, 0xe7, 0xc9, 0xea, 0x01, 0x79, 0x13,
+	0x8a, 0x7c, 0x42, 0x98, 0xa4, 0x96, 0x91, 0xcf, 0x96, 0xf5, 0x95, 0x0c, 0x0c, 0x4a, 0x38, 0xcc,
+	0x25, 0x2c, 0x91, 0x85, 0xb4, 0x84, 0x90, 0xfc, 0xde, 0x80, 0xf9, 0xd4, 0x10, 0x2c, 0x39, 0xa9,
+	0xae, 0x96, 0x37, 0x5d, 0x5c, 0x3f, 0x35, 0x82, 0x0a, 0xe5, 0x3f, 0xcf, 0xe5, 0x5f, 0x22, 0x4f,
+	0xe7, 0xef, 0xb0, 0x91, 0x9e, 0xd4, 0x6d, 0xdc, 0x47, 0xc7, 0x7d, 0x40, 0x3e, 0x34, 0x80, 0xa4,
+	0x07, 0x7d, 0xc9, 0x70, 0xf1, 0xb1, 0x65, 0x4e, 0x8f, 0x22, 0x43, 0x35, 0x2f, 0x72, 0x35, 0x2f,
+	0x90, 0xf5, 0x3d, 0xa9, 0x19, 0x92, 0x16, 0x14, 0x5e, 0xf5, 0x6d, 0xaa, 0x9f, 0xbd, 0x32, 0x23,
+	0xac, 0x9f, 0xbd, 0x3a, 0x0e, 0x6c, 0x9e, 0xe4, 0x22, 0xd7, 0xc8, 0xaa, 0x2e, 0xd2, 0xf3, 0x6d,
+	0xaa, 0x6c, 0xff, 0x4d, 0x28, 0xf2, 0x61, 0x5f, 0x92, 0x5a, 0x28, 0xfb, 0xf4, 0xb5, 0xc9, 0xe0,
+	0xbc, 0xd3, 0xe7, 0x63, 0xc0, 0xa4, 0x0d, 0x45, 0x3e, 0x4a, 0xab, 0x2f, 0xad, 0x0e, 0x05, 0xeb,
+	0x4b, 0x6b, 0x03, 0xc0, 0xe6, 0x29, 0xbe, 0xf4, 0x51, 0x72, 0x44, 0x5f, 0x9a, 0xcf, 0xe3, 0x36,
+	0xee, 0xe3, 0x60, 0xeb, 0x03, 0x72, 0x17, 0x66, 0xd5, 0xe1, 0x5e, 0x72, 0x54, 0x5d, 0x31, 0x63,
+	0x24, 0xb8, 0x7e, 0x2c, 0x9f, 0x00, 0x25, 0x9f, 0xe0, 0x92, 0x8f, 0x90, 0xc3, 0x19, 0x92, 0xc3,
+	0x86, 0xc5, 0x59, 0xc8, 0x2f, 0x0d, 0xa8, 0x26, 0x87, 0x7d, 0xc9, 0x09, 0x75, 0xed, 0x9c, 0x51,
+	0xe1, 0xfa, 0xc9, 0xe1, 0x44, 0xa8, 0xc4, 0x63, 0x5c, 0x89, 0xe3, 0xe4, 0xa8, 0xae, 0x44, 0x6a,
+	0x86, 0x98, 0x59, 0x99, 0xcf, 0x5b, 0xea, 0x56, 0x56, 0xc7, 0x47, 0x75, 0x2b, 0x6b, 0x13, 0xa3,
+	0x79, 0x56, 0xe6, 0xb3, 0x9c, 0x8d, 0x77, 0xe9, 0x6e, 0xe3, 0x3e, 0xb7, 0xf2, 0x4f, 0x60, 0x4a,
+	0x0c, 0x75, 0x92, 0x95, 0xac, 0x71, 0x50, 0x21, 0xa6, 0x9e, 0x3f, 0x29, 0x9a, 0xe7, 0x28, 0x5c,
+	0x0e, 0x79, 0xdf, 0x80, 0x39, 0x6d, 0x14, 0x92, 0x68, 0x87, 0x94, 0x35, 0x0e, 0x5a, 0x3f, 0x3e,
+	0x84, 0x02, 0x65, 0x9e, 0xe7, 0x32, 0x4f, 0x93, 0x93, 0xba, 0xcc, 0xf0, 0x9e, 0xd5, 0x6b, 0xc4,
+	0xaf, 0x86, 0x8d, 0xfb, 0x7c, 0xaa, 0xf4, 0x01, 0xf9, 0x29, 0x54, 0xf4, 0x31, 0x4e, 0x92, 0x2f,
+	0x22, 0xde, 0xb2, 0x39, 0x8c, 0x64, 0xb8, 0x89, 0xf9, 0x3c, 0xe8, 0x40, 0x0f, 0xf2, 0x33, 0x03,
+	0x66, 0xd5, 0x29, 0x47, 0xdd, 0x93, 0x33, 0xa6, 0x3f, 0x75, 0x4f, 0xce, 0x1a, 0xf4, 0x34, 0x1f,
+	0xe7, 0xa2, 0x1f, 0x23, 0xa7, 0x74, 0xd1, 0x7c, 0x70, 0xb2, 0x81, 0x93, 0x96, 0x4a, 0x2e, 0x60,
+	0xe7, 0xa0, 0x8d, 0x67, 0x92, 0x5c, 0x11, 0x61, 0xe6, 0x39, 0x64, 0xce, 0x76, 0xe6, 0x9d, 0x83,
+	0xa6, 0x45, 0x18, 0x97, 0x23, 0x17, 0x4a, 0x72, 0xd6, 0x92, 0x1c, 0xd6, 0x3c, 0x4a, 0x1f, 0xec,
+	0xac, 0xaf, 0x66, 0x23, 0x47, 0x38, 0x36, 0x8e, 0x6d, 0x36, 0xee, 0xb3, 0x7f, 0x1f, 0x90, 0x77,
+	0x70, 0x02, 0x89, 0x7b, 0x9d, 0xb6, 0x62, 0x72, 0x9c, 0xb3, 0x7e, 0x24, 0x07, 0x8b, 0x02, 0x8f,
+	0x71, 0x81, 0x75, 0x52, 0xd3, 0x05, 0xde, 0x61, 0x84, 0xfc, 0xb0, 0xc9, 0xcf, 0x0d, 0x38, 0x98,
+	0x18, 0x63, 0x21, 0x66, 0xf6, 0xa2, 0xea, 0xd0, 0x50, 0xfd, 0xc4, 0x50, 0x9a, 0xe1, 0xf9, 0x62,
+	0x20, 0xbe, 0x21, 0x66, 0x5f, 0xc8, 0xaf, 0x0c, 0x98, 0x4f, 0x3d, 0x4c, 0xeb, 0x35, 0x39, 0xef,
+	0x1d, 0x5c, 0xaf, 0xc9, 0xb9, 0xaf, 0xdb, 0xe6, 0x59, 0xae, 0xcb, 0x09, 0x72, 0x3c, 0x53, 0x17,
+	0xc6, 0xd1, 0xc0, 0xe7, 0x44, 0xf2, 0x1b, 0x03, 0x48, 0xfa, 0xdd, 0x8e, 0xe4, 0x08, 0x4a, 0xf4,
+	0xa3, 0x7a, 0xf5, 0xcd, 0x7f, 0xfe, 0x33, 0xcf, 0x71, 0x85, 0x4e, 0x12, 0x33, 0x5f, 0x21, 0xd9,
+	0xd0, 0x0d, 0xec, 0xa3, 0xbe, 0x4e, 0x64, 0xd8, 0x27, 0xe3, 0x11, 0x26, 0xc3, 0x3e, 0x59, 0x4f,
+	0x1c, 0xc3, 0xed, 0x13, 0x77, 0x01, 0x0d, 0xcb, 0xb6, 0xc9, 0xef, 0x0c, 0x58, 0xce, 0xfe, 0x31,
+	0x92, 0x9c, 0xcd, 0x17, 0x96, 0xb4, 0xd3, 0xb9, 0x71, 0x48, 0x51, 0xb9, 0x75, 0xae, 0xdc, 0x19,
+	0x72, 0x7a, 0xb8, 0x72, 0x69, 0x7b, 0xa9, 0x3f, 0xb9, 0x64, 0xd8, 0x2b, 0xe3, 0xb7, 0x9f, 0x0c,
+	0x7b, 0x65, 0xfd, 0x6e, 0x33, 0xdc, 0x5e, 0x22, 0x8d, 0xa4, 0xfc, 0x49, 0xbb, 0x43, 0x93, 0x1c,
+	0x41, 0xa3, 0xfd, 0x29, 0xf3, 0x2a, 0x3e, 0xdc, 0x9f, 0x84, 0x42, 0xb1, 0x7d, 0x3e, 0x94, 0x3f,
+	0xfa, 0x27, 0xaf, 0x94, 0xe4, 0x4c, 0x4a, 0x5a, 0xce, 0x9d, 0xb5, 0x7e, 0x76, 0x0c, 0xca, 0xe1,
+	0x29, 0x5f, 0xa8, 0xc6, 0x7f, 0x63, 0xe4, 0x3d, 0xa7, 0xb4, 0x57, 0xec, 0x5f, 0xa9, 0xfb, 0x18,
+	0x19, 0x22, 0x74, 0xb4, 0x7f, 0xe5, 0x5e, 0xef, 0x86, 0xfb, 0xd7, 0x40, 0xc1, 0xd8, 0x7e, 0x57,
+	0xa0, 0x2c, 0x0a, 0xeb, 0xa8, 0x4b, 0x4a, 0xee, 0x2d, 0xe8, 0x82, 0x41, 0xde, 0x81, 0x9a, 0x58,
+	0x64, 0xff, 0x9d, 0xfe, 0x78, 0xf7, 0x91, 0x0b, 0xc6, 0x40, 0xe1, 0x51, 0x7d, 0x75, 0x6e, 0xeb,
+	0x7e, 0xc1, 0x20, 0x6f, 0xc3, 0xa2, 0x58, 0x64, 0xef, 0x3d, 0xc9, 0xe8, 0xce, 0xe8, 0x82, 0x71,
+	0xf9, 0xea, 0x47, 0x9f, 0xae, 0x19, 0x0f, 0x3f, 0x5d, 0x33, 0xfe, 0xf1, 0xe9, 0x9a, 0xf1, 0xc1,
+	0x67, 0x6b, 0x07, 0x1e, 0x7e, 0xb6, 0x76, 0xe0, 0x6f, 0x9f, 0xad, 0x1d, 0xf8, 0x71, 0xa3, 0xe3,
+	0x44, 0xae, 0xb5, 0xb5, 0xde, 0xf6, 0xbb, 0xca, 0x09, 0xf1, 0xba, 0xc9, 0x6e, 0x0e, 0xa9, 0xff,
+	0xf2, 0xba, 0x35, 0xc5, 0xff, 0xa3, 0xeb, 0x93, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x04, 0xeb,
+	0x57, 0x49, 0xcf, 0x3c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
+	Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error)
+	LiquidityProvider(ctx context.Context, in *QueryLiquidityProviderRequest, opts ...grpc.CallOption) (*QueryLiquidityProviderResponse, error)
+	LiquidityProviders(ctx context.Context, in *QueryLiquidityProvidersRequest, opts ...grpc.CallOption) (*QueryLiquidityProvidersResponse, error)
+	Node(ctx context.Context, in *QueryNodeRequest, opts ...grpc.CallOption) (*QueryNodeResponse, error)
+	Nodes(ctx context.Context, in *QueryNodesRequest, opts ...grpc.CallOption) (*QueryNodesResponse, error)
+	Vault(ctx context.Context, in *QueryVaultRequest, opts ...grpc.CallOption) (*QueryVaultResponse, error)
+	AsgardVaults(ctx context.Context, in *QueryAsgardVaultsRequest, opts ...grpc.CallOption) (*QueryAsgardVaultsResponse, error)
+	InboundAddresses(ctx context.Context, in *QueryInboundAddressesRequest, opts ...grpc.CallOption) (*QueryInboundAddressesResponse, error)
+	Mimir(ctx context.Context, in *QueryMimirRequest, opts ...grpc.CallOption) (*QueryMimirResponse, error)
+	Mimirs(ctx context.Context, in *QueryMimirsRequest, opts ...grpc.CallOption) (*QueryMimirsResponse, error)
+	StreamingSwap(ctx context.Context, in *QueryStreamingSwapRequest, opts ...grpc.CallOption) (*QueryStreamingSwapResponse, error)
+	StreamingSwaps(ctx context.Context, in *QueryStreamingSwapsRequest, opts ...grpc.CallOption) (*QueryStreamingSwapsResponse, error)
+	TradeAccount(ctx context.Context, in *QueryTradeAccountRequest, opts ...grpc.CallOption) (*QueryTradeAccountResponse, error)
+	TradeAccounts(ctx context.Context, in *QueryTradeAccountsRequest, opts ...grpc.CallOption) (*QueryTradeAccountsResponse, error)
+	MAYAName(ctx context.Context, in *QueryMAYANameRequest, opts ...grpc.CallOption) (*QueryMAYANameResponse, error)
+	QuoteSwap(ctx context.Context, in *QueryQuoteSwapRequest, opts ...grpc.CallOption) (*QueryQuoteSwapResponse, error)
+	QuoteSwapRoutes(ctx context.Context, in *QueryQuoteSwapRoutesRequest, opts ...grpc.CallOption) (*QueryQuoteSwapRoutesResponse, error)
+	QuoteSaverDeposit(ctx context.Context, in *QueryQuoteSaverDepositRequest, opts ...grpc.CallOption) (*QueryQuoteSaverDepositResponse, error)
+	QuoteSaverWithdraw(ctx context.Context, in *QueryQuoteSaverWithdrawRequest, opts ...grpc.CallOption) (*QueryQuoteSaverWithdrawResponse, error)
+	QuoteLiquidityAdd(ctx context.Context, in *QueryQuoteLiquidityAddRequest, opts ...grpc.CallOption) (*QueryQuoteLiquidityAddResponse, error)
+	QuoteLiquidityWithdraw(ctx context.Context, in *QueryQuoteLiquidityWithdrawRequest, opts ...grpc.CallOption) (*QueryQuoteLiquidityWithdrawResponse, error)
+	QuoteTradeDeposit(ctx context.Context, in *QueryQuoteTradeDepositRequest, opts ...grpc.CallOption) (*QueryQuoteTradeDepositResponse, error)
+	QuoteTradeWithdraw(ctx context.Context, in *QueryQuoteTradeWithdrawRequest, opts ...grpc.CallOption) (*QueryQuoteTradeWithdrawResponse, error)
+	QuoteCacaoPoolDeposit(ctx context.Context, in *QueryQuoteCacaoPoolDepositRequest, opts ...grpc.CallOption) (*QueryQuoteCacaoPoolDepositResponse, error)
+	QuoteCacaoPoolWithdraw(ctx context.Context, in *QueryQuoteCacaoPoolWithdrawRequest, opts ...grpc.CallOption) (*QueryQuoteCacaoPoolWithdrawResponse, error)
+	StreamPools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (Query_StreamPoolsClient, error)
+	StreamLiquidityProviders(ctx context.Context, in *QueryLiquidityProvidersRequest, opts ...grpc.CallOption) (Query_StreamLiquidityProvidersClient, error)
+	StreamNodes(ctx context.Context, in *QueryNodesRequest, opts ...grpc.CallOption) (Query_StreamNodesClient, error)
+	StreamStreamingSwaps(ctx context.Context, in *QueryStreamingSwapsRequest, opts ...grpc.CallOption) (Query_StreamStreamingSwapsClient, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error) {
+	out := new(QueryPoolResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/Pool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error) {
+	out := new(QueryPoolsResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/Pools", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LiquidityProvider(ctx context.Context, in *QueryLiquidityProviderRequest, opts ...grpc.CallOption) (*QueryLiquidityProviderResponse, error) {
+	out := new(QueryLiquidityProviderResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/LiquidityProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LiquidityProviders(ctx context.Context, in *QueryLiquidityProvidersRequest, opts ...grpc.CallOption) (*QueryLiquidityProvidersResponse, error) {
+	out := new(QueryLiquidityProvidersResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/LiquidityProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Node(ctx context.Context, in *QueryNodeRequest, opts ...grpc.CallOption) (*QueryNodeResponse, error) {
+	out := new(QueryNodeResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/Node", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Nodes(ctx context.Context, in *QueryNodesRequest, opts ...grpc.CallOption) (*QueryNodesResponse, error) {
+	out := new(QueryNodesResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/Nodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Vault(ctx context.Context, in *QueryVaultRequest, opts ...grpc.CallOption) (*QueryVaultResponse, error) {
+	out := new(QueryVaultResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/Vault", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AsgardVaults(ctx context.Context, in *QueryAsgardVaultsRequest, opts ...grpc.CallOption) (*QueryAsgardVaultsResponse, error) {
+	out := new(QueryAsgardVaultsResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/AsgardVaults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InboundAddresses(ctx context.Context, in *QueryInboundAddressesRequest, opts ...grpc.CallOption) (*QueryInboundAddressesResponse, error) {
+	out := new(QueryInboundAddressesResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/InboundAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Mimir(ctx context.Context, in *QueryMimirRequest, opts ...grpc.CallOption) (*QueryMimirResponse, error) {
+	out := new(QueryMimirResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/Mimir", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Mimirs(ctx context.Context, in *QueryMimirsRequest, opts ...grpc.CallOption) (*QueryMimirsResponse, error) {
+	out := new(QueryMimirsResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/Mimirs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StreamingSwap(ctx context.Context, in *QueryStreamingSwapRequest, opts ...grpc.CallOption) (*QueryStreamingSwapResponse, error) {
+	out := new(QueryStreamingSwapResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/StreamingSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StreamingSwaps(ctx context.Context, in *QueryStreamingSwapsRequest, opts ...grpc.CallOption) (*QueryStreamingSwapsResponse, error) {
+	out := new(QueryStreamingSwapsResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/StreamingSwaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TradeAccount(ctx context.Context, in *QueryTradeAccountRequest, opts ...grpc.CallOption) (*QueryTradeAccountResponse, error) {
+	out := new(QueryTradeAccountResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/TradeAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TradeAccounts(ctx context.Context, in *QueryTradeAccountsRequest, opts ...grpc.CallOption) (*QueryTradeAccountsResponse, error) {
+	out := new(QueryTradeAccountsResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/TradeAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MAYAName(ctx context.Context, in *QueryMAYANameRequest, opts ...grpc.CallOption) (*QueryMAYANameResponse, error) {
+	out := new(QueryMAYANameResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/MAYAName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuoteSwap(ctx context.Context, in *QueryQuoteSwapRequest, opts ...grpc.CallOption) (*QueryQuoteSwapResponse, error) {
+	out := new(QueryQuoteSwapResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/QuoteSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuoteSwapRoutes(ctx context.Context, in *QueryQuoteSwapRoutesRequest, opts ...grpc.CallOption) (*QueryQuoteSwapRoutesResponse, error) {
+	out := new(QueryQuoteSwapRoutesResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/QuoteSwapRoutes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuoteSaverDeposit(ctx context.Context, in *QueryQuoteSaverDepositRequest, opts ...grpc.CallOption) (*QueryQuoteSaverDepositResponse, error) {
+	out := new(QueryQuoteSaverDepositResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/QuoteSaverDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuoteSaverWithdraw(ctx context.Context, in *QueryQuoteSaverWithdrawRequest, opts ...grpc.CallOption) (*QueryQuoteSaverWithdrawResponse, error) {
+	out := new(QueryQuoteSaverWithdrawResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/QuoteSaverWithdraw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuoteLiquidityAdd(ctx context.Context, in *QueryQuoteLiquidityAddRequest, opts ...grpc.CallOption) (*QueryQuoteLiquidityAddResponse, error) {
+	out := new(QueryQuoteLiquidityAddResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/QuoteLiquidityAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuoteLiquidityWithdraw(ctx context.Context, in *QueryQuoteLiquidityWithdrawRequest, opts ...grpc.CallOption) (*QueryQuoteLiquidityWithdrawResponse, error) {
+	out := new(QueryQuoteLiquidityWithdrawResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/QuoteLiquidityWithdraw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuoteTradeDeposit(ctx context.Context, in *QueryQuoteTradeDepositRequest, opts ...grpc.CallOption) (*QueryQuoteTradeDepositResponse, error) {
+	out := new(QueryQuoteTradeDepositResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/QuoteTradeDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuoteTradeWithdraw(ctx context.Context, in *QueryQuoteTradeWithdrawRequest, opts ...grpc.CallOption) (*QueryQuoteTradeWithdrawResponse, error) {
+	out := new(QueryQuoteTradeWithdrawResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/QuoteTradeWithdraw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuoteCacaoPoolDeposit(ctx context.Context, in *QueryQuoteCacaoPoolDepositRequest, opts ...grpc.CallOption) (*QueryQuoteCacaoPoolDepositResponse, error) {
+	out := new(QueryQuoteCacaoPoolDepositResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/QuoteCacaoPoolDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuoteCacaoPoolWithdraw(ctx context.Context, in *QueryQuoteCacaoPoolWithdrawRequest, opts ...grpc.CallOption) (*QueryQuoteCacaoPoolWithdrawResponse, error) {
+	out := new(QueryQuoteCacaoPoolWithdrawResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/QuoteCacaoPoolWithdraw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StreamPools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (Query_StreamPoolsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[0], "/types.Query/StreamPools", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryStreamPoolsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_StreamPoolsClient interface {
+	Recv() (*QueryPoolResponse, error)
+	grpc.ClientStream
+}
+
+type queryStreamPoolsClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryStreamPoolsClient) Recv() (*QueryPoolResponse, error) {
+	m := new(QueryPoolResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *queryClient) StreamLiquidityProviders(ctx context.Context, in *QueryLiquidityProvidersRequest, opts ...grpc.CallOption) (Query_StreamLiquidityProvidersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[1], "/types.Query/StreamLiquidityProviders", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryStreamLiquidityProvidersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_StreamLiquidityProvidersClient interface {
+	Recv() (*QueryLiquidityProviderResponse, error)
+	grpc.ClientStream
+}
+
+type queryStreamLiquidityProvidersClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryStreamLiquidityProvidersClient) Recv() (*QueryLiquidityProviderResponse, error) {
+	m := new(QueryLiquidityProviderResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *queryClient) StreamNodes(ctx context.Context, in *QueryNodesRequest, opts ...grpc.CallOption) (Query_StreamNodesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[2], "/types.Query/StreamNodes", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryStreamNodesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_StreamNodesClient interface {
+	Recv() (*QueryNodeResponse, error)
+	grpc.ClientStream
+}
+
+type queryStreamNodesClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryStreamNodesClient) Recv() (*QueryNodeResponse, error) {
+	m := new(QueryNodeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *queryClient) StreamStreamingSwaps(ctx context.Context, in *QueryStreamingSwapsRequest, opts ...grpc.CallOption) (Query_StreamStreamingSwapsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[3], "/types.Query/StreamStreamingSwaps", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryStreamStreamingSwapsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_StreamStreamingSwapsClient interface {
+	Recv() (*QueryStreamingSwapResponse, error)
+	grpc.ClientStream
+}
+
+type queryStreamStreamingSwapsClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryStreamStreamingSwapsClient) Recv() (*QueryStreamingSwapResponse, error) {
+	m := new(QueryStreamingSwapResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
+	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
+	LiquidityProvider(context.Context, *QueryLiquidityProviderRequest) (*QueryLiquidityProviderResponse, error)
+	LiquidityProviders(context.Context, *QueryLiquidityProvidersRequest) (*QueryLiquidityProvidersResponse, error)
+	Node(context.Context, *QueryNodeRequest) (*QueryNodeResponse, error)
+	Nodes(context.Context, *QueryNodesRequest) (*QueryNodesResponse, error)
+	Vault(context.Context, *QueryVaultRequest) (*QueryVaultResponse, error)
+	AsgardVaults(context.Context, *QueryAsgardVaultsRequest) (*QueryAsgardVaultsResponse, error)
+	InboundAddresses(context.Context, *QueryInboundAddressesRequest) (*QueryInboundAddressesResponse, error)
+	Mimir(context.Context, *QueryMimirRequest) (*QueryMimirResponse, error)
+	Mimirs(context.Context, *QueryMimirsRequest) (*QueryMimirsResponse, error)
+	StreamingSwap(context.Context, *QueryStreamingSwapRequest) (*QueryStreamingSwapResponse, error)
+	StreamingSwaps(context.Context, *QueryStreamingSwapsRequest) (*QueryStreamingSwapsResponse, error)
+	TradeAccount(context.Context, *QueryTradeAccountRequest) (*QueryTradeAccountResponse, error)
+	TradeAccounts(context.Context, *QueryTradeAccountsRequest) (*QueryTradeAccountsResponse, error)
+	MAYAName(context.Context, *QueryMAYANameRequest) (*QueryMAYANameResponse, error)
+	QuoteSwap(context.Context, *QueryQuoteSwapRequest) (*QueryQuoteSwapResponse, error)
+	QuoteSwapRoutes(context.Context, *QueryQuoteSwapRoutesRequest) (*QueryQuoteSwapRoutesResponse, error)
+	QuoteSaverDeposit(context.Context, *QueryQuoteSaverDepositRequest) (*QueryQuoteSaverDepositResponse, error)
+	QuoteSaverWithdraw(context.Context, *QueryQuoteSaverWithdrawRequest) (*QueryQuoteSaverWithdrawResponse, error)
+	QuoteLiquidityAdd(context.Context, *QueryQuoteLiquidityAddRequest) (*QueryQuoteLiquidityAddResponse, error)
+	QuoteLiquidityWithdraw(context.Context, *QueryQuoteLiquidityWithdrawRequest) (*QueryQuoteLiquidityWithdrawResponse, error)
+	QuoteTradeDeposit(context.Context, *QueryQuoteTradeDepositRequest) (*QueryQuoteTradeDepositResponse, error)
+	QuoteTradeWithdraw(context.Context, *QueryQuoteTradeWithdrawRequest) (*QueryQuoteTradeWithdrawResponse, error)
+	QuoteCacaoPoolDeposit(context.Context, *QueryQuoteCacaoPoolDepositRequest) (*QueryQuoteCacaoPoolDepositResponse, error)
+	QuoteCacaoPoolWithdraw(context.Context, *QueryQuoteCacaoPoolWithdrawRequest) (*QueryQuoteCacaoPoolWithdrawResponse, error)
+	StreamPools(*QueryPoolsRequest, Query_StreamPoolsServer) error
+	StreamLiquidityProviders(*QueryLiquidityProvidersRequest, Query_StreamLiquidityProvidersServer) error
+	StreamNodes(*QueryNodesRequest, Query_StreamNodesServer) error
+	StreamStreamingSwaps(*QueryStreamingSwapsRequest, Query_StreamStreamingSwapsServer) error
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPoolRequest) (*QueryPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
+}
+func (*UnimplementedQueryServer) Pools(ctx context.Context, req *QueryPoolsRequest) (*QueryPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pools not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityProvider(ctx context.Context, req *QueryLiquidityProviderRequest) (*QueryLiquidityProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityProvider not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityProviders(ctx context.Context, req *QueryLiquidityProvidersRequest) (*QueryLiquidityProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityProviders not implemented")
+}
+func (*UnimplementedQueryServer) Node(ctx context.Context, req *QueryNodeRequest) (*QueryNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Node not implemented")
+}
+func (*UnimplementedQueryServer) Nodes(ctx context.Context, req *QueryNodesRequest) (*QueryNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Nodes not implemented")
+}
+func (*UnimplementedQueryServer) Vault(ctx context.Context, req *QueryVaultRequest) (*QueryVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Vault not implemented")
+}
+func (*UnimplementedQueryServer) AsgardVaults(ctx context.Context, req *QueryAsgardVaultsRequest) (*QueryAsgardVaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AsgardVaults not implemented")
+}
+func (*UnimplementedQueryServer) InboundAddresses(ctx context.Context, req *QueryInboundAddressesRequest) (*QueryInboundAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InboundAddresses not implemented")
+}
+func (*UnimplementedQueryServer) Mimir(ctx context.Context, req *QueryMimirRequest) (*QueryMimirResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mimir not implemented")
+}
+func (*UnimplementedQueryServer) Mimirs(ctx context.Context, req *QueryMimirsRequest) (*QueryMimirsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mimirs not implemented")
+}
+func (*UnimplementedQueryServer) StreamingSwap(ctx context.Context, req *QueryStreamingSwapRequest) (*QueryStreamingSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamingSwap not implemented")
+}
+func (*UnimplementedQueryServer) StreamingSwaps(ctx context.Context, req *QueryStreamingSwapsRequest) (*QueryStreamingSwapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamingSwaps not implemented")
+}
+func (*UnimplementedQueryServer) TradeAccount(ctx context.Context, req *QueryTradeAccountRequest) (*QueryTradeAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TradeAccount not implemented")
+}
+func (*UnimplementedQueryServer) TradeAccounts(ctx context.Context, req *QueryTradeAccountsRequest) (*QueryTradeAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TradeAccounts not implemented")
+}
+func (*UnimplementedQueryServer) MAYAName(ctx context.Context, req *QueryMAYANameRequest) (*QueryMAYANameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MAYAName not implemented")
+}
+func (*UnimplementedQueryServer) QuoteSwap(ctx context.Context, req *QueryQuoteSwapRequest) (*QueryQuoteSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteSwap not implemented")
+}
+func (*UnimplementedQueryServer) QuoteSwapRoutes(ctx context.Context, req *QueryQuoteSwapRoutesRequest) (*QueryQuoteSwapRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteSwapRoutes not implemented")
+}
+func (*UnimplementedQueryServer) QuoteSaverDeposit(ctx context.Context, req *QueryQuoteSaverDepositRequest) (*QueryQuoteSaverDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteSaverDeposit not implemented")
+}
+func (*UnimplementedQueryServer) QuoteSaverWithdraw(ctx context.Context, req *QueryQuoteSaverWithdrawRequest) (*QueryQuoteSaverWithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteSaverWithdraw not implemented")
+}
+func (*UnimplementedQueryServer) QuoteLiquidityAdd(ctx context.Context, req *QueryQuoteLiquidityAddRequest) (*QueryQuoteLiquidityAddResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteLiquidityAdd not implemented")
+}
+func (*UnimplementedQueryServer) QuoteLiquidityWithdraw(ctx context.Context, req *QueryQuoteLiquidityWithdrawRequest) (*QueryQuoteLiquidityWithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteLiquidityWithdraw not implemented")
+}
+func (*UnimplementedQueryServer) QuoteTradeDeposit(ctx context.Context, req *QueryQuoteTradeDepositRequest) (*QueryQuoteTradeDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteTradeDeposit not implemented")
+}
+func (*UnimplementedQueryServer) QuoteTradeWithdraw(ctx context.Context, req *QueryQuoteTradeWithdrawRequest) (*QueryQuoteTradeWithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteTradeWithdraw not implemented")
+}
+func (*UnimplementedQueryServer) QuoteCacaoPoolDeposit(ctx context.Context, req *QueryQuoteCacaoPoolDepositRequest) (*QueryQuoteCacaoPoolDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteCacaoPoolDeposit not implemented")
+}
+func (*UnimplementedQueryServer) QuoteCacaoPoolWithdraw(ctx context.Context, req *QueryQuoteCacaoPoolWithdrawRequest) (*QueryQuoteCacaoPoolWithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteCacaoPoolWithdraw not implemented")
+}
+func (*UnimplementedQueryServer) StreamPools(req *QueryPoolsRequest, srv Query_StreamPoolsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPools not implemented")
+}
+func (*UnimplementedQueryServer) StreamLiquidityProviders(req *QueryLiquidityProvidersRequest, srv Query_StreamLiquidityProvidersServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamLiquidityProviders not implemented")
+}
+func (*UnimplementedQueryServer) StreamNodes(req *QueryNodesRequest, srv Query_StreamNodesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamNodes not implemented")
+}
+func (*UnimplementedQueryServer) StreamStreamingSwaps(req *QueryStreamingSwapsRequest, srv Query_StreamStreamingSwapsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamStreamingSwaps not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Pool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/Pool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pool(ctx, req.(*QueryPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Pools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Pools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/Pools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pools(ctx, req.(*QueryPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/LiquidityProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityProvider(ctx, req.(*QueryLiquidityProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidityProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/LiquidityProviders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityProviders(ctx, req.(*QueryLiquidityProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Node_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Node(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/Node",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Node(ctx, req.(*QueryNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Nodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Nodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/Nodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Nodes(ctx, req.(*QueryNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Vault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Vault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/Vault",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Vault(ctx, req.(*QueryVaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AsgardVaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAsgardVaultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AsgardVaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/AsgardVaults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AsgardVaults(ctx, req.(*QueryAsgardVaultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InboundAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInboundAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InboundAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/InboundAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InboundAddresses(ctx, req.(*QueryInboundAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Mimir_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMimirRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Mimir(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/Mimir",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Mimir(ctx, req.(*QueryMimirRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Mimirs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMimirsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Mimirs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/Mimirs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Mimirs(ctx, req.(*QueryMimirsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StreamingSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStreamingSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StreamingSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/StreamingSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StreamingSwap(ctx, req.(*QueryStreamingSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StreamingSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStreamingSwapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StreamingSwaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/StreamingSwaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StreamingSwaps(ctx, req.(*QueryStreamingSwapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TradeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTradeAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TradeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/TradeAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TradeAccount(ctx, req.(*QueryTradeAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TradeAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTradeAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TradeAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/TradeAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TradeAccounts(ctx, req.(*QueryTradeAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MAYAName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMAYANameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MAYAName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/MAYAName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MAYAName(ctx, req.(*QueryMAYANameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuoteSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuoteSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuoteSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/QuoteSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuoteSwap(ctx, req.(*QueryQuoteSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuoteSwapRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuoteSwapRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuoteSwapRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/QuoteSwapRoutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuoteSwapRoutes(ctx, req.(*QueryQuoteSwapRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuoteSaverDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuoteSaverDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuoteSaverDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/QuoteSaverDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuoteSaverDeposit(ctx, req.(*QueryQuoteSaverDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuoteSaverWithdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuoteSaverWithdrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuoteSaverWithdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/QuoteSaverWithdraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuoteSaverWithdraw(ctx, req.(*QueryQuoteSaverWithdrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuoteLiquidityAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuoteLiquidityAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuoteLiquidityAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/QuoteLiquidityAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuoteLiquidityAdd(ctx, req.(*QueryQuoteLiquidityAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuoteLiquidityWithdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuoteLiquidityWithdrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuoteLiquidityWithdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/QuoteLiquidityWithdraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuoteLiquidityWithdraw(ctx, req.(*QueryQuoteLiquidityWithdrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuoteTradeDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuoteTradeDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuoteTradeDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/QuoteTradeDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuoteTradeDeposit(ctx, req.(*QueryQuoteTradeDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuoteTradeWithdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuoteTradeWithdrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuoteTradeWithdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/QuoteTradeWithdraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuoteTradeWithdraw(ctx, req.(*QueryQuoteTradeWithdrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuoteCacaoPoolDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuoteCacaoPoolDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuoteCacaoPoolDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/QuoteCacaoPoolDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuoteCacaoPoolDeposit(ctx, req.(*QueryQuoteCacaoPoolDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuoteCacaoPoolWithdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuoteCacaoPoolWithdrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuoteCacaoPoolWithdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/QuoteCacaoPoolWithdraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuoteCacaoPoolWithdraw(ctx, req.(*QueryQuoteCacaoPoolWithdrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StreamPools_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryPoolsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).StreamPools(m, &queryStreamPoolsServer{stream})
+}
+
+type Query_StreamPoolsServer interface {
+	Send(*QueryPoolResponse) error
+	grpc.ServerStream
+}
+
+type queryStreamPoolsServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryStreamPoolsServer) Send(m *QueryPoolResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Query_StreamLiquidityProviders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryLiquidityProvidersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).StreamLiquidityProviders(m, &queryStreamLiquidityProvidersServer{stream})
+}
+
+type Query_StreamLiquidityProvidersServer interface {
+	Send(*QueryLiquidityProviderResponse) error
+	grpc.ServerStream
+}
+
+type queryStreamLiquidityProvidersServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryStreamLiquidityProvidersServer) Send(m *QueryLiquidityProviderResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Query_StreamNodes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryNodesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).StreamNodes(m, &queryStreamNodesServer{stream})
+}
+
+type Query_StreamNodesServer interface {
+	Send(*QueryNodeResponse) error
+	grpc.ServerStream
+}
+
+type queryStreamNodesServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryStreamNodesServer) Send(m *QueryNodeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Query_StreamStreamingSwaps_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryStreamingSwapsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).StreamStreamingSwaps(m, &queryStreamStreamingSwapsServer{stream})
+}
+
+type Query_StreamStreamingSwapsServer interface {
+	Send(*QueryStreamingSwapResponse) error
+	grpc.ServerStream
+}
+
+type queryStreamStreamingSwapsServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryStreamStreamingSwapsServer) Send(m *QueryStreamingSwapResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "types.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Pool",
+			Handler:    _Query_Pool_Handler,
+		},
+		{
+			MethodName: "Pools",
+			Handler:    _Query_Pools_Handler,
+		},
+		{
+			MethodName: "LiquidityProvider",
+			Handler:    _Query_LiquidityProvider_Handler,
+		},
+		{
+			MethodName: "LiquidityProviders",
+			Handler:    _Query_LiquidityProviders_Handler,
+		},
+		{
+			MethodName: "Node",
+			Handler:    _Query_Node_Handler,
+		},
+		{
+			MethodName: "Nodes",
+			Handler:    _Query_Nodes_Handler,
+		},
+		{
+			MethodName: "Vault",
+			Handler:    _Query_Vault_Handler,
+		},
+		{
+			MethodName: "AsgardVaults",
+			Handler:    _Query_AsgardVaults_Handler,
+		},
+		{
+			MethodName: "InboundAddresses",
+			Handler:    _Query_InboundAddresses_Handler,
+		},
+		{
+			MethodName: "Mimir",
+			Handler:    _Query_Mimir_Handler,
+		},
+		{
+			MethodName: "Mimirs",
+			Handler:    _Query_Mimirs_Handler,
+		},
+		{
+			MethodName: "StreamingSwap",
+			Handler:    _Query_StreamingSwap_Handler,
+		},
+		{
+			MethodName: "StreamingSwaps",
+			Handler:    _Query_StreamingSwaps_Handler,
+		},
+		{
+			MethodName: "TradeAccount",
+			Handler:    _Query_TradeAccount_Handler,
+		},
+		{
+			MethodName: "TradeAccounts",
+			Handler:    _Query_TradeAccounts_Handler,
+		},
+		{
+			MethodName: "MAYAName",
+			Handler:    _Query_MAYAName_Handler,
+		},
+		{
+			MethodName: "QuoteSwap",
+			Handler:    _Query_QuoteSwap_Handler,
+		},
+		{
+			MethodName: "QuoteSwapRoutes",
+			Handler:    _Query_QuoteSwapRoutes_Handler,
+		},
+		{
+			MethodName: "QuoteSaverDeposit",
+			Handler:    _Query_QuoteSaverDeposit_Handler,
+		},
+		{
+			MethodName: "QuoteSaverWithdraw",
+			Handler:    _Query_QuoteSaverWithdraw_Handler,
+		},
+		{
+			MethodName: "QuoteLiquidityAdd",
+			Handler:    _Query_QuoteLiquidityAdd_Handler,
+		},
+		{
+			MethodName: "QuoteLiquidityWithdraw",
+			Handler:    _Query_QuoteLiquidityWithdraw_Handler,
+		},
+		{
+			MethodName: "QuoteTradeDeposit",
+			Handler:    _Query_QuoteTradeDeposit_Handler,
+		},
+		{
+			MethodName: "QuoteTradeWithdraw",
+			Handler:    _Query_QuoteTradeWithdraw_Handler,
+		},
+		{
+			MethodName: "QuoteCacaoPoolDeposit",
+			Handler:    _Query_QuoteCacaoPoolDeposit_Handler,
+		},
+		{
+			MethodName: "QuoteCacaoPoolWithdraw",
+			Handler:    _Query_QuoteCacaoPoolWithdraw_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamPools",
+			Handler:       _Query_StreamPools_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamLiquidityProviders",
+			Handler:       _Query_StreamLiquidityProviders_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamNodes",
+			Handler:       _Query_StreamNodes_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamStreamingSwaps",
+			Handler:       _Query_StreamStreamingSwaps_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "mayachain/v1/x/mayachain/types/query.proto",
+}
+
+func (m *QueryPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityProviderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityProviderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LiquidityProvider.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityProvidersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityProvidersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityProvidersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LiquidityProviders) > 0 {
+		for iNdEx := len(m.LiquidityProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Node.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNodesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNodesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNodesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNodesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNodesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNodesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Vault.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAsgardVaultsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAsgardVaultsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAsgardVaultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAsgardVaultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAsgardVaultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAsgardVaultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Vaults) > 0 {
+		for iNdEx := len(m.Vaults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vaults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInboundAddressesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInboundAddressesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInboundAddressesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInboundAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInboundAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInboundAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InboundAddresses) > 0 {
+		for iNdEx := len(m.InboundAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InboundAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InboundAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InboundAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InboundAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DustThreshold) > 0 {
+		i -= len(m.DustThreshold)
+		copy(dAtA[i:], m.DustThreshold)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DustThreshold)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.OutboundFee) > 0 {
+		i -= len(m.OutboundFee)
+		copy(dAtA[i:], m.OutboundFee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OutboundFee)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.OutboundTxSize) > 0 {
+		i -= len(m.OutboundTxSize)
+		copy(dAtA[i:], m.OutboundTxSize)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OutboundTxSize)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.GasRateUnits) > 0 {
+		i -= len(m.GasRateUnits)
+		copy(dAtA[i:], m.GasRateUnits)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GasRateUnits)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.GasRate) > 0 {
+		i -= len(m.GasRate)
+		copy(dAtA[i:], m.GasRate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GasRate)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ChainLpActionsPaused {
+		i--
+		if m.ChainLpActionsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ChainTradingPaused {
+		i--
+		if m.ChainTradingPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.GlobalTradingPaused {
+		i--
+		if m.GlobalTradingPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Halted {
+		i--
+		if m.Halted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Router) > 0 {
+		i -= len(m.Router)
+		copy(dAtA[i:], m.Router)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Router)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMimirRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMimirRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMimirRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMimirResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMimirResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMimirResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMimirsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMimirsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMimirsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMimirsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMimirsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMimirsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Mimirs) > 0 {
+		for k := range m.Mimirs {
+			v := m.Mimirs[k]
+			baseI := i
+			i = encodeVarintQuery(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStreamingSwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStreamingSwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStreamingSwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStreamingSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStreamingSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStreamingSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.StreamingSwap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStreamingSwapsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStreamingSwapsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStreamingSwapsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStreamingSwapsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStreamingSwapsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStreamingSwapsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StreamingSwaps) > 0 {
+		for iNdEx := len(m.StreamingSwaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StreamingSwaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTradeAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTradeAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTradeAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTradeAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTradeAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTradeAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TradeAccounts) > 0 {
+		for iNdEx := len(m.TradeAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TradeAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTradeAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTradeAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTradeAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTradeAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTradeAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTradeAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TradeAccounts) > 0 {
+		for iNdEx := len(m.TradeAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TradeAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMAYANameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMAYANameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMAYANameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMAYANameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMAYANameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMAYANameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Mayaname.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteSwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteSwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteSwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LiquidityToleranceBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LiquidityToleranceBps))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.AffiliateBps) > 0 {
+		i -= len(m.AffiliateBps)
+		copy(dAtA[i:], m.AffiliateBps)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AffiliateBps)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Affiliate) > 0 {
+		i -= len(m.Affiliate)
+		copy(dAtA[i:], m.Affiliate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Affiliate)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ToleranceBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToleranceBps))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.StreamingQuantity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StreamingQuantity))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StreamingInterval != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StreamingInterval))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ToAsset) > 0 {
+		i -= len(m.ToAsset)
+		copy(dAtA[i:], m.ToAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ToAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAsset) > 0 {
+		i -= len(m.FromAsset)
+		copy(dAtA[i:], m.FromAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FromAsset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalSwapSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalSwapSeconds))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.StreamingSwapSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StreamingSwapSeconds))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.StreamingSwapBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StreamingSwapBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MaxStreamingQuantity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxStreamingQuantity))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.ExpectedAmountOut) > 0 {
+		i -= len(m.ExpectedAmountOut)
+		copy(dAtA[i:], m.ExpectedAmountOut)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExpectedAmountOut)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.GasRateUnits) > 0 {
+		i -= len(m.GasRateUnits)
+		copy(dAtA[i:], m.GasRateUnits)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GasRateUnits)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.RecommendedGasRate) > 0 {
+		i -= len(m.RecommendedGasRate)
+		copy(dAtA[i:], m.RecommendedGasRate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecommendedGasRate)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.RecommendedMinAmountIn) > 0 {
+		i -= len(m.RecommendedMinAmountIn)
+		copy(dAtA[i:], m.RecommendedMinAmountIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecommendedMinAmountIn)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.DustThreshold) > 0 {
+		i -= len(m.DustThreshold)
+		copy(dAtA[i:], m.DustThreshold)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DustThreshold)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Notes) > 0 {
+		i -= len(m.Notes)
+		copy(dAtA[i:], m.Notes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Notes)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Warning) > 0 {
+		i -= len(m.Warning)
+		copy(dAtA[i:], m.Warning)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Warning)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Expiry != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Expiry))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Router) > 0 {
+		i -= len(m.Router)
+		copy(dAtA[i:], m.Router)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Router)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.Fees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.OutboundDelaySeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OutboundDelaySeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OutboundDelayBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OutboundDelayBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.InboundConfirmationSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InboundConfirmationSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.InboundConfirmationBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InboundConfirmationBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.InboundAddress) > 0 {
+		i -= len(m.InboundAddress)
+		copy(dAtA[i:], m.InboundAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InboundAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuoteFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuoteFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuoteFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalBps))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SlippageBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlippageBps))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Total) > 0 {
+		i -= len(m.Total)
+		copy(dAtA[i:], m.Total)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Total)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Liquidity) > 0 {
+		i -= len(m.Liquidity)
+		copy(dAtA[i:], m.Liquidity)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Liquidity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Outbound) > 0 {
+		i -= len(m.Outbound)
+		copy(dAtA[i:], m.Outbound)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Outbound)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Affiliate) > 0 {
+		i -= len(m.Affiliate)
+		copy(dAtA[i:], m.Affiliate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Affiliate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteSwapRoutesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteSwapRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteSwapRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AffiliateBps) > 0 {
+		i -= len(m.AffiliateBps)
+		copy(dAtA[i:], m.AffiliateBps)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AffiliateBps)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Affiliate) > 0 {
+		i -= len(m.Affiliate)
+		copy(dAtA[i:], m.Affiliate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Affiliate)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ToleranceBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToleranceBps))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StreamingInterval != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StreamingInterval))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ToAsset) > 0 {
+		i -= len(m.ToAsset)
+		copy(dAtA[i:], m.ToAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ToAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAsset) > 0 {
+		i -= len(m.FromAsset)
+		copy(dAtA[i:], m.FromAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FromAsset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteSwapRoutesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteSwapRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteSwapRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuoteSwapRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuoteSwapRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuoteSwapRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalSwapSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalSwapSeconds))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.StreamingSwapSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StreamingSwapSeconds))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.StreamingSwapBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StreamingSwapBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.MaxStreamingQuantity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxStreamingQuantity))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.StreamingQuantity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StreamingQuantity))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.StreamingInterval != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StreamingInterval))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.ExpectedAmountOut) > 0 {
+		i -= len(m.ExpectedAmountOut)
+		copy(dAtA[i:], m.ExpectedAmountOut)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExpectedAmountOut)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.ToAsset) > 0 {
+		i -= len(m.ToAsset)
+		copy(dAtA[i:], m.ToAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ToAsset)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.Route) > 0 {
+		i -= len(m.Route)
+		copy(dAtA[i:], m.Route)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Route)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.GasRateUnits) > 0 {
+		i -= len(m.GasRateUnits)
+		copy(dAtA[i:], m.GasRateUnits)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GasRateUnits)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.RecommendedGasRate) > 0 {
+		i -= len(m.RecommendedGasRate)
+		copy(dAtA[i:], m.RecommendedGasRate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecommendedGasRate)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.RecommendedMinAmountIn) > 0 {
+		i -= len(m.RecommendedMinAmountIn)
+		copy(dAtA[i:], m.RecommendedMinAmountIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecommendedMinAmountIn)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.DustThreshold) > 0 {
+		i -= len(m.DustThreshold)
+		copy(dAtA[i:], m.DustThreshold)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DustThreshold)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Notes) > 0 {
+		i -= len(m.Notes)
+		copy(dAtA[i:], m.Notes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Notes)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Warning) > 0 {
+		i -= len(m.Warning)
+		copy(dAtA[i:], m.Warning)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Warning)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Expiry != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Expiry))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Router) > 0 {
+		i -= len(m.Router)
+		copy(dAtA[i:], m.Router)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Router)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.Fees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.OutboundDelaySeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OutboundDelaySeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OutboundDelayBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OutboundDelayBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.InboundConfirmationSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InboundConfirmationSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.InboundConfirmationBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InboundConfirmationBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.InboundAddress) > 0 {
+		i -= len(m.InboundAddress)
+		copy(dAtA[i:], m.InboundAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InboundAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteSaverDepositRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteSaverDepositRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteSaverDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteSaverDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteSaverDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteSaverDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExpectedAmountDeposit) > 0 {
+		i -= len(m.ExpectedAmountDeposit)
+		copy(dAtA[i:], m.ExpectedAmountDeposit)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExpectedAmountDeposit)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.ExpectedAmountOut) > 0 {
+		i -= len(m.ExpectedAmountOut)
+		copy(dAtA[i:], m.ExpectedAmountOut)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExpectedAmountOut)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.GasRateUnits) > 0 {
+		i -= len(m.GasRateUnits)
+		copy(dAtA[i:], m.GasRateUnits)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GasRateUnits)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.RecommendedGasRate) > 0 {
+		i -= len(m.RecommendedGasRate)
+		copy(dAtA[i:], m.RecommendedGasRate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecommendedGasRate)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.RecommendedMinAmountIn) > 0 {
+		i -= len(m.RecommendedMinAmountIn)
+		copy(dAtA[i:], m.RecommendedMinAmountIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecommendedMinAmountIn)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.DustThreshold) > 0 {
+		i -= len(m.DustThreshold)
+		copy(dAtA[i:], m.DustThreshold)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DustThreshold)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Notes) > 0 {
+		i -= len(m.Notes)
+		copy(dAtA[i:], m.Notes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Notes)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Warning) > 0 {
+		i -= len(m.Warning)
+		copy(dAtA[i:], m.Warning)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Warning)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Expiry != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Expiry))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Router) > 0 {
+		i -= len(m.Router)
+		copy(dAtA[i:], m.Router)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Router)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.Fees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.OutboundDelaySeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OutboundDelaySeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OutboundDelayBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OutboundDelayBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.InboundConfirmationSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InboundConfirmationSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.InboundConfirmationBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InboundConfirmationBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.InboundAddress) > 0 {
+		i -= len(m.InboundAddress)
+		copy(dAtA[i:], m.InboundAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InboundAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteSaverWithdrawRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteSaverWithdrawRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteSaverWithdrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WithdrawBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WithdrawBps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteSaverWithdrawResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteSaverWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteSaverWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExpectedAmountOut) > 0 {
+		i -= len(m.ExpectedAmountOut)
+		copy(dAtA[i:], m.ExpectedAmountOut)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExpectedAmountOut)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.DustAmount) > 0 {
+		i -= len(m.DustAmount)
+		copy(dAtA[i:], m.DustAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DustAmount)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.GasRateUnits) > 0 {
+		i -= len(m.GasRateUnits)
+		copy(dAtA[i:], m.GasRateUnits)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GasRateUnits)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.RecommendedGasRate) > 0 {
+		i -= len(m.RecommendedGasRate)
+		copy(dAtA[i:], m.RecommendedGasRate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecommendedGasRate)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.RecommendedMinAmountIn) > 0 {
+		i -= len(m.RecommendedMinAmountIn)
+		copy(dAtA[i:], m.RecommendedMinAmountIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecommendedMinAmountIn)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.DustThreshold) > 0 {
+		i -= len(m.DustThreshold)
+		copy(dAtA[i:], m.DustThreshold)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DustThreshold)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Notes) > 0 {
+		i -= len(m.Notes)
+		copy(dAtA[i:], m.Notes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Notes)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Warning) > 0 {
+		i -= len(m.Warning)
+		copy(dAtA[i:], m.Warning)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Warning)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Expiry != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Expiry))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Router) > 0 {
+		i -= len(m.Router)
+		copy(dAtA[i:], m.Router)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Router)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.Fees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.OutboundDelaySeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OutboundDelaySeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OutboundDelayBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OutboundDelayBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.InboundConfirmationSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InboundConfirmationSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.InboundConfirmationBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InboundConfirmationBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.InboundAddress) > 0 {
+		i -= len(m.InboundAddress)
+		copy(dAtA[i:], m.InboundAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InboundAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteLiquidityAddRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteLiquidityAddRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteLiquidityAddRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AssetAddress) > 0 {
+		i -= len(m.AssetAddress)
+		copy(dAtA[i:], m.AssetAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CacaoAddress) > 0 {
+		i -= len(m.CacaoAddress)
+		copy(dAtA[i:], m.CacaoAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CacaoAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AmountAsset) > 0 {
+		i -= len(m.AmountAsset)
+		copy(dAtA[i:], m.AmountAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AmountAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AmountCacao) > 0 {
+		i -= len(m.AmountCacao)
+		copy(dAtA[i:], m.AmountCacao)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AmountCacao)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteLiquidityAddResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteLiquidityAddResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteLiquidityAddResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LiquidityAuction != nil {
+		{
+			size, err := m.LiquidityAuction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.SlippageBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlippageBps))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.PoolShareBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolShareBps))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.PoolUnits) > 0 {
+		i -= len(m.PoolUnits)
+		copy(dAtA[i:], m.PoolUnits)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolUnits)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.ExpectedPoolUnits) > 0 {
+		i -= len(m.ExpectedPoolUnits)
+		copy(dAtA[i:], m.ExpectedPoolUnits)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExpectedPoolUnits)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.CacaoMemo) > 0 {
+		i -= len(m.CacaoMemo)
+		copy(dAtA[i:], m.CacaoMemo)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CacaoMemo)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.GasRateUnits) > 0 {
+		i -= len(m.GasRateUnits)
+		copy(dAtA[i:], m.GasRateUnits)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GasRateUnits)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.RecommendedGasRate) > 0 {
+		i -= len(m.RecommendedGasRate)
+		copy(dAtA[i:], m.RecommendedGasRate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecommendedGasRate)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.RecommendedMinAmountIn) > 0 {
+		i -= len(m.RecommendedMinAmountIn)
+		copy(dAtA[i:], m.RecommendedMinAmountIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecommendedMinAmountIn)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.DustThreshold) > 0 {
+		i -= len(m.DustThreshold)
+		copy(dAtA[i:], m.DustThreshold)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DustThreshold)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Router) > 0 {
+		i -= len(m.Router)
+		copy(dAtA[i:], m.Router)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Router)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Notes) > 0 {
+		i -= len(m.Notes)
+		copy(dAtA[i:], m.Notes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Notes)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Warning) > 0 {
+		i -= len(m.Warning)
+		copy(dAtA[i:], m.Warning)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Warning)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Expiry != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Expiry))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.InboundConfirmationSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InboundConfirmationSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.InboundConfirmationBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InboundConfirmationBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.InboundAddress) > 0 {
+		i -= len(m.InboundAddress)
+		copy(dAtA[i:], m.InboundAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InboundAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuoteLiquidityAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuoteLiquidityAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuoteLiquidityAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastWithdrawCounterHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastWithdrawCounterHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.WithdrawCounter) > 0 {
+		i -= len(m.WithdrawCounter)
+		copy(dAtA[i:], m.WithdrawCounter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawCounter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.WithdrawLimitActive {
+		i--
+		if m.WithdrawLimitActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WithdrawLimitBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WithdrawLimitBps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Tier != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Tier))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteLiquidityWithdrawRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteLiquidityWithdrawRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteLiquidityWithdrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawalAsset) > 0 {
+		i -= len(m.WithdrawalAsset)
+		copy(dAtA[i:], m.WithdrawalAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawalAsset)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.WithdrawBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WithdrawBps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteLiquidityWithdrawResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteLiquidityWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteLiquidityWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LiquidityAuction != nil {
+		{
+			size, err := m.LiquidityAuction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.ImpermanentLossProtection) > 0 {
+		i -= len(m.ImpermanentLossProtection)
+		copy(dAtA[i:], m.ImpermanentLossProtection)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ImpermanentLossProtection)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.OutboundFeeAsset) > 0 {
+		i -= len(m.OutboundFeeAsset)
+		copy(dAtA[i:], m.OutboundFeeAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OutboundFeeAsset)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.OutboundFeeCacao) > 0 {
+		i -= len(m.OutboundFeeCacao)
+		copy(dAtA[i:], m.OutboundFeeCacao)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OutboundFeeCacao)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.ExpectedAmountOutAsset) > 0 {
+		i -= len(m.ExpectedAmountOutAsset)
+		copy(dAtA[i:], m.ExpectedAmountOutAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExpectedAmountOutAsset)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.ExpectedAmountOutCacao) > 0 {
+		i -= len(m.ExpectedAmountOutCacao)
+		copy(dAtA[i:], m.ExpectedAmountOutCacao)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExpectedAmountOutCacao)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.WithdrawUnits) > 0 {
+		i -= len(m.WithdrawUnits)
+		copy(dAtA[i:], m.WithdrawUnits)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawUnits)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.DustAmount) > 0 {
+		i -= len(m.DustAmount)
+		copy(dAtA[i:], m.DustAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DustAmount)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.GasRateUnits) > 0 {
+		i -= len(m.GasRateUnits)
+		copy(dAtA[i:], m.GasRateUnits)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GasRateUnits)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.RecommendedGasRate) > 0 {
+		i -= len(m.RecommendedGasRate)
+		copy(dAtA[i:], m.RecommendedGasRate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecommendedGasRate)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.DustThreshold) > 0 {
+		i -= len(m.DustThreshold)
+		copy(dAtA[i:], m.DustThreshold)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DustThreshold)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Notes) > 0 {
+		i -= len(m.Notes)
+		copy(dAtA[i:], m.Notes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Notes)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Warning) > 0 {
+		i -= len(m.Warning)
+		copy(dAtA[i:], m.Warning)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Warning)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Expiry != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Expiry))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Router) > 0 {
+		i -= len(m.Router)
+		copy(dAtA[i:], m.Router)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Router)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OutboundDelaySeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OutboundDelaySeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OutboundDelayBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OutboundDelayBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.InboundAddress) > 0 {
+		i -= len(m.InboundAddress)
+		copy(dAtA[i:], m.InboundAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InboundAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteTradeDepositRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteTradeDepositRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteTradeDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteTradeDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteTradeDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteTradeDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExpectedUnits) > 0 {
+		i -= len(m.ExpectedUnits)
+		copy(dAtA[i:], m.ExpectedUnits)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExpectedUnits)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.ExpectedAmountOut) > 0 {
+		i -= len(m.ExpectedAmountOut)
+		copy(dAtA[i:], m.ExpectedAmountOut)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExpectedAmountOut)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.GasRateUnits) > 0 {
+		i -= len(m.GasRateUnits)
+		copy(dAtA[i:], m.GasRateUnits)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GasRateUnits)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.RecommendedGasRate) > 0 {
+		i -= len(m.RecommendedGasRate)
+		copy(dAtA[i:], m.RecommendedGasRate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecommendedGasRate)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.RecommendedMinAmountIn) > 0 {
+		i -= len(m.RecommendedMinAmountIn)
+		copy(dAtA[i:], m.RecommendedMinAmountIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecommendedMinAmountIn)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.DustThreshold) > 0 {
+		i -= len(m.DustThreshold)
+		copy(dAtA[i:], m.DustThreshold)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DustThreshold)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Notes) > 0 {
+		i -= len(m.Notes)
+		copy(dAtA[i:], m.Notes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Notes)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Warning) > 0 {
+		i -= len(m.Warning)
+		copy(dAtA[i:], m.Warning)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Warning)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Expiry != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Expiry))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Router) > 0 {
+		i -= len(m.Router)
+		copy(dAtA[i:], m.Router)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Router)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.InboundConfirmationSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InboundConfirmationSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.InboundConfirmationBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InboundConfirmationBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.InboundAddress) > 0 {
+		i -= len(m.InboundAddress)
+		copy(dAtA[i:], m.InboundAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InboundAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteTradeWithdrawRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteTradeWithdrawRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteTradeWithdrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteTradeWithdrawResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteTradeWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteTradeWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExpectedAmountOut) > 0 {
+		i -= len(m.ExpectedAmountOut)
+		copy(dAtA[i:], m.ExpectedAmountOut)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExpectedAmountOut)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Notes) > 0 {
+		i -= len(m.Notes)
+		copy(dAtA[i:], m.Notes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Notes)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Warning) > 0 {
+		i -= len(m.Warning)
+		copy(dAtA[i:], m.Warning)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Warning)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Expiry != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Expiry))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Fees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.OutboundDelaySeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OutboundDelaySeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OutboundDelayBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OutboundDelayBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteCacaoPoolDepositRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteCacaoPoolDepositRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteCacaoPoolDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteCacaoPoolDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteCacaoPoolDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteCacaoPoolDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaturitySeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaturitySeconds))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaturityBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaturityBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ExpectedUnits) > 0 {
+		i -= len(m.ExpectedUnits)
+		copy(dAtA[i:], m.ExpectedUnits)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExpectedUnits)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Notes) > 0 {
+		i -= len(m.Notes)
+		copy(dAtA[i:], m.Notes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Notes)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Warning) > 0 {
+		i -= len(m.Warning)
+		copy(dAtA[i:], m.Warning)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Warning)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Expiry != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Expiry))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteCacaoPoolWithdrawRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteCacaoPoolWithdrawRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteCacaoPoolWithdrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WithdrawBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WithdrawBps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteCacaoPoolWithdrawResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteCacaoPoolWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteCacaoPoolWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaturitySeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaturitySeconds))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaturityBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaturityBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.WithdrawUnits) > 0 {
+		i -= len(m.WithdrawUnits)
+		copy(dAtA[i:], m.WithdrawUnits)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawUnits)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ExpectedAmountOut) > 0 {
+		i -= len(m.ExpectedAmountOut)
+		copy(dAtA[i:], m.ExpectedAmountOut)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExpectedAmountOut)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Notes) > 0 {
+		i -= len(m.Notes)
+		copy(dAtA[i:], m.Notes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Notes)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Warning) > 0 {
+		i -= len(m.Warning)
+		copy(dAtA[i:], m.Warning)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Warning)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Expiry != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Expiry))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLiquidityProviderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidityProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LiquidityProvider.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLiquidityProvidersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidityProvidersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LiquidityProviders) > 0 {
+		for _, e := range m.LiquidityProviders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryNodeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Node.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryNodesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNodesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVaultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVaultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Vault.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAsgardVaultsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAsgardVaultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Vaults) > 0 {
+		for _, e := range m.Vaults {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryInboundAddressesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInboundAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InboundAddresses) > 0 {
+		for _, e := range m.InboundAddresses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InboundAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Router)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Halted {
+		n += 2
+	}
+	if m.GlobalTradingPaused {
+		n += 2
+	}
+	if m.ChainTradingPaused {
+		n += 2
+	}
+	if m.ChainLpActionsPaused {
+		n += 2
+	}
+	l = len(m.GasRate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.GasRateUnits)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OutboundTxSize)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OutboundFee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DustThreshold)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMimirRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMimirResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovQuery(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *QueryMimirsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMimirsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Mimirs) > 0 {
+		for k, v := range m.Mimirs {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + sovQuery(uint64(v))
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QueryStreamingSwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStreamingSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StreamingSwap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStreamingSwapsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStreamingSwapsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StreamingSwaps) > 0 {
+		for _, e := range m.StreamingSwaps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTradeAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTradeAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TradeAccounts) > 0 {
+		for _, e := range m.TradeAccounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTradeAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTradeAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TradeAccounts) > 0 {
+		for _, e := range m.TradeAccounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMAYANameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMAYANameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Mayaname.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryQuoteSwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ToAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StreamingInterval != 0 {
+		n += 1 + sovQuery(uint64(m.StreamingInterval))
+	}
+	if m.StreamingQuantity != 0 {
+		n += 1 + sovQuery(uint64(m.StreamingQuantity))
+	}
+	if m.ToleranceBps != 0 {
+		n += 1 + sovQuery(uint64(m.ToleranceBps))
+	}
+	l = len(m.Affiliate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AffiliateBps)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LiquidityToleranceBps != 0 {
+		n += 1 + sovQuery(uint64(m.LiquidityToleranceBps))
+	}
+	return n
+}
+
+func (m *QueryQuoteSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InboundAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.InboundConfirmationBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.InboundConfirmationBlocks))
+	}
+	if m.InboundConfirmationSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.InboundConfirmationSeconds))
+	}
+	if m.OutboundDelayBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.OutboundDelayBlocks))
+	}
+	if m.OutboundDelaySeconds != 0 {
+		n += 1 + sovQuery(uint64(m.OutboundDelaySeconds))
+	}
+	l = m.Fees.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Router)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Expiry != 0 {
+		n += 1 + sovQuery(uint64(m.Expiry))
+	}
+	l = len(m.Warning)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Notes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DustThreshold)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecommendedMinAmountIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecommendedGasRate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.GasRateUnits)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ExpectedAmountOut)
+	if l > 0 {
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.MaxStreamingQuantity != 0 {
+		n += 2 + sovQuery(uint64(m.MaxStreamingQuantity))
+	}
+	if m.StreamingSwapBlocks != 0 {
+		n += 2 + sovQuery(uint64(m.StreamingSwapBlocks))
+	}
+	if m.StreamingSwapSeconds != 0 {
+		n += 2 + sovQuery(uint64(m.StreamingSwapSeconds))
+	}
+	if m.TotalSwapSeconds != 0 {
+		n += 2 + sovQuery(uint64(m.TotalSwapSeconds))
+	}
+	return n
+}
+
+func (m *QuoteFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Affiliate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Outbound)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Liquidity)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Total)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SlippageBps != 0 {
+		n += 1 + sovQuery(uint64(m.SlippageBps))
+	}
+	if m.TotalBps != 0 {
+		n += 1 + sovQuery(uint64(m.TotalBps))
+	}
+	return n
+}
+
+func (m *QueryQuoteSwapRoutesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ToAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StreamingInterval != 0 {
+		n += 1 + sovQuery(uint64(m.StreamingInterval))
+	}
+	if m.ToleranceBps != 0 {
+		n += 1 + sovQuery(uint64(m.ToleranceBps))
+	}
+	l = len(m.Affiliate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AffiliateBps)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQuoteSwapRoutesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuoteSwapRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InboundAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.InboundConfirmationBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.InboundConfirmationBlocks))
+	}
+	if m.InboundConfirmationSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.InboundConfirmationSeconds))
+	}
+	if m.OutboundDelayBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.OutboundDelayBlocks))
+	}
+	if m.OutboundDelaySeconds != 0 {
+		n += 1 + sovQuery(uint64(m.OutboundDelaySeconds))
+	}
+	l = m.Fees.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Router)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Expiry != 0 {
+		n += 1 + sovQuery(uint64(m.Expiry))
+	}
+	l = len(m.Warning)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Notes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DustThreshold)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecommendedMinAmountIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecommendedGasRate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.GasRateUnits)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Route)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ToAsset)
+	if l > 0 {
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ExpectedAmountOut)
+	if l > 0 {
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.StreamingInterval != 0 {
+		n += 2 + sovQuery(uint64(m.StreamingInterval))
+	}
+	if m.StreamingQuantity != 0 {
+		n += 2 + sovQuery(uint64(m.StreamingQuantity))
+	}
+	if m.MaxStreamingQuantity != 0 {
+		n += 2 + sovQuery(uint64(m.MaxStreamingQuantity))
+	}
+	if m.StreamingSwapBlocks != 0 {
+		n += 2 + sovQuery(uint64(m.StreamingSwapBlocks))
+	}
+	if m.StreamingSwapSeconds != 0 {
+		n += 2 + sovQuery(uint64(m.StreamingSwapSeconds))
+	}
+	if m.TotalSwapSeconds != 0 {
+		n += 2 + sovQuery(uint64(m.TotalSwapSeconds))
+	}
+	return n
+}
+
+func (m *QueryQuoteSaverDepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQuoteSaverDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InboundAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.InboundConfirmationBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.InboundConfirmationBlocks))
+	}
+	if m.InboundConfirmationSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.InboundConfirmationSeconds))
+	}
+	if m.OutboundDelayBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.OutboundDelayBlocks))
+	}
+	if m.OutboundDelaySeconds != 0 {
+		n += 1 + sovQuery(uint64(m.OutboundDelaySeconds))
+	}
+	l = m.Fees.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Router)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Expiry != 0 {
+		n += 1 + sovQuery(uint64(m.Expiry))
+	}
+	l = len(m.Warning)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Notes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DustThreshold)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecommendedMinAmountIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecommendedGasRate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.GasRateUnits)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ExpectedAmountOut)
+	if l > 0 {
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ExpectedAmountDeposit)
+	if l > 0 {
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQuoteSaverWithdrawRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.WithdrawBps != 0 {
+		n += 1 + sovQuery(uint64(m.WithdrawBps))
+	}
+	return n
+}
+
+func (m *QueryQuoteSaverWithdrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InboundAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.InboundConfirmationBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.InboundConfirmationBlocks))
+	}
+	if m.InboundConfirmationSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.InboundConfirmationSeconds))
+	}
+	if m.OutboundDelayBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.OutboundDelayBlocks))
+	}
+	if m.OutboundDelaySeconds != 0 {
+		n += 1 + sovQuery(uint64(m.OutboundDelaySeconds))
+	}
+	l = m.Fees.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Router)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Expiry != 0 {
+		n += 1 + sovQuery(uint64(m.Expiry))
+	}
+	l = len(m.Warning)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Notes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DustThreshold)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecommendedMinAmountIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecommendedGasRate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.GasRateUnits)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DustAmount)
+	if l > 0 {
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ExpectedAmountOut)
+	if l > 0 {
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQuoteLiquidityAddRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AmountCacao)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AmountAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CacaoAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AssetAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQuoteLiquidityAddResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InboundAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.InboundConfirmationBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.InboundConfirmationBlocks))
+	}
+	if m.InboundConfirmationSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.InboundConfirmationSeconds))
+	}
+	if m.Expiry != 0 {
+		n += 1 + sovQuery(uint64(m.Expiry))
+	}
+	l = len(m.Warning)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Notes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Router)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DustThreshold)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecommendedMinAmountIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecommendedGasRate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.GasRateUnits)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CacaoMemo)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ExpectedPoolUnits)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PoolUnits)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolShareBps != 0 {
+		n += 2 + sovQuery(uint64(m.PoolShareBps))
+	}
+	if m.SlippageBps != 0 {
+		n += 2 + sovQuery(uint64(m.SlippageBps))
+	}
+	if m.LiquidityAuction != nil {
+		l = m.LiquidityAuction.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuoteLiquidityAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tier != 0 {
+		n += 1 + sovQuery(uint64(m.Tier))
+	}
+	if m.WithdrawLimitBps != 0 {
+		n += 1 + sovQuery(uint64(m.WithdrawLimitBps))
+	}
+	if m.WithdrawLimitActive {
+		n += 2
+	}
+	l = len(m.WithdrawCounter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LastWithdrawCounterHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastWithdrawCounterHeight))
+	}
+	return n
+}
+
+func (m *QueryQuoteLiquidityWithdrawRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.WithdrawBps != 0 {
+		n += 1 + sovQuery(uint64(m.WithdrawBps))
+	}
+	l = len(m.WithdrawalAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQuoteLiquidityWithdrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InboundAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OutboundDelayBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.OutboundDelayBlocks))
+	}
+	if m.OutboundDelaySeconds != 0 {
+		n += 1 + sovQuery(uint64(m.OutboundDelaySeconds))
+	}
+	l = len(m.Router)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Expiry != 0 {
+		n += 1 + sovQuery(uint64(m.Expiry))
+	}
+	l = len(m.Warning)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Notes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DustThreshold)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecommendedGasRate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.GasRateUnits)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DustAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.WithdrawUnits)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ExpectedAmountOutCacao)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ExpectedAmountOutAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OutboundFeeCacao)
+	if l > 0 {
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OutboundFeeAsset)
+	if l > 0 {
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ImpermanentLossProtection)
+	if l > 0 {
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.LiquidityAuction != nil {
+		l = m.LiquidityAuction.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQuoteTradeDepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQuoteTradeDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InboundAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.InboundConfirmationBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.InboundConfirmationBlocks))
+	}
+	if m.InboundConfirmationSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.InboundConfirmationSeconds))
+	}
+	l = len(m.Router)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Expiry != 0 {
+		n += 1 + sovQuery(uint64(m.Expiry))
+	}
+	l = len(m.Warning)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Notes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DustThreshold)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecommendedMinAmountIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecommendedGasRate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.GasRateUnits)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ExpectedAmountOut)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ExpectedUnits)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQuoteTradeWithdrawRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQuoteTradeWithdrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OutboundDelayBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.OutboundDelayBlocks))
+	}
+	if m.OutboundDelaySeconds != 0 {
+		n += 1 + sovQuery(uint64(m.OutboundDelaySeconds))
+	}
+	l = m.Fees.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Expiry != 0 {
+		n += 1 + sovQuery(uint64(m.Expiry))
+	}
+	l = len(m.Warning)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Notes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ExpectedAmountOut)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQuoteCacaoPoolDepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQuoteCacaoPoolDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Expiry != 0 {
+		n += 1 + sovQuery(uint64(m.Expiry))
+	}
+	l = len(m.Warning)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Notes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ExpectedUnits)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaturityBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.MaturityBlocks))
+	}
+	if m.MaturitySeconds != 0 {
+		n += 1 + sovQuery(uint64(m.MaturitySeconds))
+	}
+	return n
+}
+
+func (m *QueryQuoteCacaoPoolWithdrawRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.WithdrawBps != 0 {
+		n += 1 + sovQuery(uint64(m.WithdrawBps))
+	}
+	return n
+}
+
+func (m *QueryQuoteCacaoPoolWithdrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Expiry != 0 {
+		n += 1 + sovQuery(uint64(m.Expiry))
+	}
+	l = len(m.Warning)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Notes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ExpectedAmountOut)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.WithdrawUnits)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaturityBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.MaturityBlocks))
+	}
+	if m.MaturitySeconds != 0 {
+		n += 1 + sovQuery(uint64(m.MaturitySeconds))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, Pool{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityProviderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityProviderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProvider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityProvidersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityProvidersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityProvidersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityProvidersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityProviders = append(m.LiquidityProviders, LiquidityProvider{})
+			if err := m.LiquidityProviders[len(m.LiquidityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Node.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNodesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNodesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNodesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNodesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNodesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNodesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, NodeAccount{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vault", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Vault.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAsgardVaultsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAsgardVaultsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAsgardVaultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAsgardVaultsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAsgardVaultsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAsgardVaultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vaults = append(m.Vaults, Vault{})
+			if err := m.Vaults[len(m.Vaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInboundAddressesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInboundAddressesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInboundAddressesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInboundAddressesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInboundAddressesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInboundAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundAddresses = append(m.InboundAddresses, InboundAddress{})
+			if err := m.InboundAddresses[len(m.InboundAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InboundAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InboundAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InboundAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Router", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Router = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Halted = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalTradingPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GlobalTradingPaused = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainTradingPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ChainTradingPaused = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainLpActionsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ChainLpActionsPaused = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRateUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasRateUnits = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundTxSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundTxSize = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DustThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMimirRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMimirRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMimirRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMimirResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMimirResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMimirResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMimirsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMimirsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMimirsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMimirsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMimirsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMimirsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mimirs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mimirs == nil {
+				m.Mimirs = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mimirs[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStreamingSwapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStreamingSwapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStreamingSwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStreamingSwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStreamingSwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStreamingSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamingSwap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StreamingSwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStreamingSwapsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStreamingSwapsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStreamingSwapsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStreamingSwapsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStreamingSwapsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStreamingSwapsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamingSwaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StreamingSwaps = append(m.StreamingSwaps, StreamingSwap{})
+			if err := m.StreamingSwaps[len(m.StreamingSwaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTradeAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTradeAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTradeAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTradeAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTradeAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTradeAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TradeAccounts = append(m.TradeAccounts, TradeAccount{})
+			if err := m.TradeAccounts[len(m.TradeAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTradeAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTradeAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTradeAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTradeAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTradeAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTradeAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TradeAccounts = append(m.TradeAccounts, TradeAccount{})
+			if err := m.TradeAccounts[len(m.TradeAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMAYANameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMAYANameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMAYANameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMAYANameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMAYANameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMAYANameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mayaname", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Mayaname.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQuoteSwapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuoteSwapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuoteSwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamingInterval", wireType)
+			}
+			m.StreamingInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamingInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamingQuantity", wireType)
+			}
+			m.StreamingQuantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamingQuantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToleranceBps", wireType)
+			}
+			m.ToleranceBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToleranceBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Affiliate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Affiliate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AffiliateBps", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AffiliateBps = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityToleranceBps", wireType)
+			}
+			m.LiquidityToleranceBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LiquidityToleranceBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQuoteSwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuoteSwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuoteSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundConfirmationBlocks", wireType)
+			}
+			m.InboundConfirmationBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundConfirmationBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundConfirmationSeconds", wireType)
+			}
+			m.InboundConfirmationSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundConfirmationSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundDelayBlocks", wireType)
+			}
+			m.OutboundDelayBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundDelayBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundDelaySeconds", wireType)
+			}
+			m.OutboundDelaySeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundDelaySeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Router", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Router = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			m.Expiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warning", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Warning = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Notes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Notes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DustThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecommendedMinAmountIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecommendedMinAmountIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecommendedGasRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecommendedGasRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRateUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasRateUnits = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedAmountOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedAmountOut = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStreamingQuantity", wireType)
+			}
+			m.MaxStreamingQuantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStreamingQuantity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamingSwapBlocks", wireType)
+			}
+			m.StreamingSwapBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamingSwapBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamingSwapSeconds", wireType)
+			}
+			m.StreamingSwapSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamingSwapSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSwapSeconds", wireType)
+			}
+			m.TotalSwapSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSwapSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuoteFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuoteFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuoteFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Affiliate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Affiliate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outbound", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outbound = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Liquidity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlippageBps", wireType)
+			}
+			m.SlippageBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlippageBps |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBps", wireType)
+			}
+			m.TotalBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalBps |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQuoteSwapRoutesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuoteSwapRoutesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuoteSwapRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamingInterval", wireType)
+			}
+			m.StreamingInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamingInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToleranceBps", wireType)
+			}
+			m.ToleranceBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToleranceBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Affiliate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Affiliate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AffiliateBps", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AffiliateBps = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQuoteSwapRoutesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuoteSwapRoutesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuoteSwapRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, QuoteSwapRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuoteSwapRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuoteSwapRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuoteSwapRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundConfirmationBlocks", wireType)
+			}
+			m.InboundConfirmationBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundConfirmationBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundConfirmationSeconds", wireType)
+			}
+			m.InboundConfirmationSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundConfirmationSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundDelayBlocks", wireType)
+			}
+			m.OutboundDelayBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundDelayBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundDelaySeconds", wireType)
+			}
+			m.OutboundDelaySeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundDelaySeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Router", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Router = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			m.Expiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warning", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Warning = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Notes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Notes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DustThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecommendedMinAmountIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecommendedMinAmountIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecommendedGasRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecommendedGasRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRateUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasRateUnits = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedAmountOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedAmountOut = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamingInterval", wireType)
+			}
+			m.StreamingInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamingInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamingQuantity", wireType)
+			}
+			m.StreamingQuantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamingQuantity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStreamingQuantity", wireType)
+			}
+			m.MaxStreamingQuantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStreamingQuantity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamingSwapBlocks", wireType)
+			}
+			m.StreamingSwapBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamingSwapBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamingSwapSeconds", wireType)
+			}
+			m.StreamingSwapSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamingSwapSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSwapSeconds", wireType)
+			}
+			m.TotalSwapSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSwapSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQuoteSaverDepositRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuoteSaverDepositRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuoteSaverDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQuoteSaverDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuoteSaverDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuoteSaverDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundConfirmationBlocks", wireType)
+			}
+			m.InboundConfirmationBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundConfirmationBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundConfirmationSeconds", wireType)
+			}
+			m.InboundConfirmationSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundConfirmationSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundDelayBlocks", wireType)
+			}
+			m.OutboundDelayBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundDelayBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundDelaySeconds", wireType)
+			}
+			m.OutboundDelaySeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundDelaySeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Router", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Router = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			m.Expiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warning", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Warning = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Notes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Notes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DustThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecommendedMinAmountIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecommendedMinAmountIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecommendedGasRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecommendedGasRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRateUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasRateUnits = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedAmountOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedAmountOut = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedAmountDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedAmountDeposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQuoteSaverWithdrawRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuoteSaverWithdrawRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuoteSaverWithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawBps", wireType)
+			}
+			m.WithdrawBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQuoteSaverWithdrawResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuoteSaverWithdrawResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuoteSaverWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundConfirmationBlocks", wireType)
+			}
+			m.InboundConfirmationBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundConfirmationBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundConfirmationSeconds", wireType)
+			}
+			m.InboundConfirmationSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundConfirmationSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundDelayBlocks", wireType)
+			}
+			m.OutboundDelayBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundDelayBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundDelaySeconds", wireType)
+			}
+			m.OutboundDelaySeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundDelaySeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Router", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Router = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			m.Expiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warning", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Warning = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Notes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Notes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DustThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecommendedMinAmountIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecommendedMinAmountIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecommendedGasRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecommendedGasRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRateUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasRateUnits = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DustAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedAmountOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedAmountOut = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQuoteLiquidityAddRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuoteLiquidityAddRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuoteLiquidityAddRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountCacao", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountCacao = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacaoAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQuoteLiquidityAddResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuoteLiquidityAddResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuoteLiquidityAddResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundConfirmationBlocks", wireType)
+			}
+			m.InboundConfirmationBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundConfirmationBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundConfirmationSeconds", wireType)
+			}
+			m.InboundConfirmationSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundConfirmationSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			m.Expiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warning", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Warning = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Notes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Notes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Router", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Router = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DustThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecommendedMinAmountIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecommendedMinAmountIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecommendedGasRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecommendedGasRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRateUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasRateUnits = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacaoMemo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacaoMemo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedPoolUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedPoolUnits = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolUnits = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolShareBps", wireType)
+			}
+			m.PoolShareBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolShareBps |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlippageBps", wireType)
+			}
+			m.SlippageBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlippageBps |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityAuction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LiquidityAuction == nil {
+				m.LiquidityAuction = &QuoteLiquidityAuction{}
+			}
+			if err := m.LiquidityAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuoteLiquidityAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuoteLiquidityAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuoteLiquidityAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+			}
+			m.Tier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tier |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawLimitBps", wireType)
+			}
+			m.WithdrawLimitBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawLimitBps |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawLimitActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithdrawLimitActive = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawCounter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawCounter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastWithdrawCounterHeight", wireType)
+			}
+			m.LastWithdrawCounterHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastWithdrawCounterHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQuoteLiquidityWithdrawRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuoteLiquidityWithdrawRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuoteLiquidityWithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawBps", wireType)
+			}
+			m.WithdrawBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawalAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQuoteLiquidityWithdrawResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuoteLiquidityWithdrawResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuoteLiquidityWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundDelayBlocks", wireType)
+			}
+			m.OutboundDelayBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundDelayBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundDelaySeconds", wireType)
+			}
+			m.OutboundDelaySeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundDelaySeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Router", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Router = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			m.Expiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warning", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Warning = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Notes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Notes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DustThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecommendedGasRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecommendedGasRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRateUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasRateUnits = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DustAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawUnits = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedAmountOutCacao", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedAmountOutCacao = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedAmountOutAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedAmountOutAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundFeeCacao", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundFeeCacao = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundFeeAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundFeeAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImpermanentLossProtection", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImpermanentLossProtection = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityAuction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LiquidityAuction == nil {
+				m.LiquidityAuction = &QuoteLiquidityAuction{}
+			}
+			if err := m.LiquidityAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQuoteTradeDepositRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuoteTradeDepositRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuoteTradeDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQuoteTradeDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuoteTradeDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuoteTradeDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundConfirmationBlocks", wireType)
+			}
+			m.InboundConfirmationBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundConfirmationBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundConfirmationSeconds", wireType)
+			}
+			m.InboundConfirmationSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundConfirmationSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Router", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Router = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			m.Expiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warning", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Warning = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Notes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Notes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DustThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecommendedMinAmountIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecommendedMinAmountIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecommendedGasRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecommendedGasRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRateUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasRateUnits = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedAmountOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedAmountOut = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedUnits = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQuoteTradeWithdrawRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuoteTradeWithdrawRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuoteTradeWithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQuoteTradeWithdrawResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuoteTradeWithdrawResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuoteTradeWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundDelayBlocks", wireType)
+			}
+			m.OutboundDelayBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundDelayBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundDelaySeconds", wireType)
+			}
+			m.OutboundDelaySeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundDelaySeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			m.Expiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warning", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Warning = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Notes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Notes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedAmountOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedAmountOut = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQuoteCacaoPoolDepositRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuoteCacaoPoolDepositRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuoteCacaoPoolDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQuoteCacaoPoolDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuoteCacaoPoolDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuoteCacaoPoolDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			m.Expiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warning", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Warning = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Notes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Notes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedUnits = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaturityBlocks", wireType)
+			}
+			m.MaturityBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaturityBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaturitySeconds", wireType)
+			}
+			m.MaturitySeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaturitySeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQuoteCacaoPoolWithdrawRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuoteCacaoPoolWithdrawRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuoteCacaoPoolWithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawBps", wireType)
+			}
+			m.WithdrawBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQuoteCacaoPoolWithdrawResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuoteCacaoPoolWithdrawResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuoteCacaoPoolWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			m.Expiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warning", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Warning = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Notes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Notes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedAmountOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedAmountOut = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawUnits = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaturityBlocks", wireType)
+			}
+			m.MaturityBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaturityBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaturitySeconds", wireType)
+			}
+			m.MaturitySeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaturitySeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
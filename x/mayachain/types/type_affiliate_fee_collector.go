@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	ctypes "github.com/cosmos/cosmos-sdk/types"
+	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
 )
 
@@ -25,3 +26,15 @@ func (m *AffiliateFeeCollector) Valid() error {
 	}
 	return nil
 }
+
+// NewAffiliatePayout create a new instance of AffiliatePayout
+func NewAffiliatePayout(name string, asset common.Asset, cacaoAmount cosmos.Uint, txID common.TxID, height int64, claimed bool) AffiliatePayout {
+	return AffiliatePayout{
+		Mayaname:    name,
+		Asset:       asset,
+		CacaoAmount: cacaoAmount,
+		TxID:        txID,
+		Height:      height,
+		Claimed:     claimed,
+	}
+}
@@ -8,6 +8,8 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "gitlab.com/mayachain/mayanode/common"
+	gitlab_com_mayachain_mayanode_common "gitlab.com/mayachain/mayanode/common"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -69,8 +71,124 @@ func (m *AffiliateFeeCollector) GetOwnerAddress() github_com_cosmos_cosmos_sdk_t
 	return nil
 }
 
+type AffiliatePayout struct {
+	Mayaname    string                                     `protobuf:"bytes,1,opt,name=mayaname,proto3" json:"mayaname,omitempty"`
+	Asset       gitlab_com_mayachain_mayanode_common.Asset `protobuf:"bytes,2,opt,name=asset,proto3,customtype=gitlab.com/mayachain/mayanode/common.Asset" json:"asset"`
+	CacaoAmount github_com_cosmos_cosmos_sdk_types.Uint    `protobuf:"bytes,3,opt,name=cacao_amount,json=cacaoAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"cacao_amount"`
+	TxID        gitlab_com_mayachain_mayanode_common.TxID  `protobuf:"bytes,4,opt,name=tx_id,json=txId,proto3,casttype=gitlab.com/mayachain/mayanode/common.TxID" json:"tx_id,omitempty"`
+	Height      int64                                      `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Claimed     bool                                       `protobuf:"varint,6,opt,name=claimed,proto3" json:"claimed,omitempty"`
+}
+
+func (m *AffiliatePayout) Reset()         { *m = AffiliatePayout{} }
+func (m *AffiliatePayout) String() string { return proto.CompactTextString(m) }
+func (*AffiliatePayout) ProtoMessage()    {}
+func (*AffiliatePayout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75da2807b5261a80, []int{1}
+}
+func (m *AffiliatePayout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AffiliatePayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AffiliatePayout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AffiliatePayout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AffiliatePayout.Merge(m, src)
+}
+func (m *AffiliatePayout) XXX_Size() int {
+	return m.Size()
+}
+func (m *AffiliatePayout) XXX_DiscardUnknown() {
+	xxx_messageInfo_AffiliatePayout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AffiliatePayout proto.InternalMessageInfo
+
+func (m *AffiliatePayout) GetMayaname() string {
+	if m != nil {
+		return m.Mayaname
+	}
+	return ""
+}
+
+func (m *AffiliatePayout) GetTxID() gitlab_com_mayachain_mayanode_common.TxID {
+	if m != nil {
+		return m.TxID
+	}
+	return ""
+}
+
+func (m *AffiliatePayout) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AffiliatePayout) GetClaimed() bool {
+	if m != nil {
+		return m.Claimed
+	}
+	return false
+}
+
+type AffiliatePayouts struct {
+	Payouts []AffiliatePayout `protobuf:"bytes,1,rep,name=payouts,proto3" json:"payouts"`
+}
+
+func (m *AffiliatePayouts) Reset()         { *m = AffiliatePayouts{} }
+func (m *AffiliatePayouts) String() string { return proto.CompactTextString(m) }
+func (*AffiliatePayouts) ProtoMessage()    {}
+func (*AffiliatePayouts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75da2807b5261a80, []int{2}
+}
+func (m *AffiliatePayouts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AffiliatePayouts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AffiliatePayouts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AffiliatePayouts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AffiliatePayouts.Merge(m, src)
+}
+func (m *AffiliatePayouts) XXX_Size() int {
+	return m.Size()
+}
+func (m *AffiliatePayouts) XXX_DiscardUnknown() {
+	xxx_messageInfo_AffiliatePayouts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AffiliatePayouts proto.InternalMessageInfo
+
+func (m *AffiliatePayouts) GetPayouts() []AffiliatePayout {
+	if m != nil {
+		return m.Payouts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*AffiliateFeeCollector)(nil), "types.AffiliateFeeCollector")
+	proto.RegisterType((*AffiliatePayout)(nil), "types.AffiliatePayout")
+	proto.RegisterType((*AffiliatePayouts)(nil), "types.AffiliatePayouts")
 }
 
 func init() {
@@ -78,25 +196,36 @@ func init() {
 }
 
 var fileDescriptor_75da2807b5261a80 = []byte{
-	// 276 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x72, 0xcc, 0x4d, 0xac, 0x4c,
-	0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x2f, 0x33, 0xd4, 0xaf, 0xd0, 0x47, 0x70, 0x4b, 0x2a, 0x0b,
-	0x52, 0x8b, 0xc1, 0x64, 0x7c, 0x62, 0x5a, 0x5a, 0x66, 0x4e, 0x66, 0x62, 0x49, 0x6a, 0x7c, 0x5a,
-	0x6a, 0x6a, 0x7c, 0x72, 0x7e, 0x4e, 0x4e, 0x6a, 0x72, 0x49, 0x7e, 0x91, 0x5e, 0x41, 0x51, 0x7e,
-	0x49, 0xbe, 0x10, 0x2b, 0x58, 0xa5, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x58, 0x44, 0x1f, 0xc4,
-	0x82, 0x48, 0x2a, 0x1d, 0x66, 0xe4, 0x12, 0x75, 0x84, 0x69, 0x77, 0x4b, 0x4d, 0x75, 0x86, 0x69,
-	0x16, 0x0a, 0xe3, 0xe2, 0xcd, 0x2f, 0xcf, 0x4b, 0x2d, 0x8a, 0x4f, 0x4c, 0x49, 0x29, 0x4a, 0x2d,
-	0x2e, 0x96, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x71, 0x32, 0xfc, 0x75, 0x4f, 0x5e, 0x37, 0x3d, 0xb3,
-	0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x3f, 0x39, 0xbf, 0x38, 0x37, 0xbf, 0x18, 0x4a,
-	0xe9, 0x16, 0xa7, 0x64, 0x43, 0x1c, 0xa6, 0xe7, 0x98, 0x9c, 0xec, 0x08, 0xd1, 0x18, 0xc4, 0x03,
-	0x36, 0x07, 0xca, 0x13, 0x0a, 0xe2, 0xe2, 0x49, 0x4e, 0x4c, 0x4e, 0xcc, 0x8f, 0x4f, 0xcc, 0xcd,
-	0x2f, 0xcd, 0x2b, 0x91, 0x60, 0x52, 0x60, 0xd4, 0xe0, 0x74, 0xd2, 0x3f, 0x71, 0x4f, 0x9e, 0xe1,
-	0xd6, 0x3d, 0x79, 0x75, 0x22, 0x8c, 0x0e, 0xcd, 0xcc, 0x2b, 0x09, 0xe2, 0x06, 0x1b, 0xe2, 0x08,
-	0x36, 0xc3, 0xc9, 0xf3, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63,
-	0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xf4, 0xd3,
-	0x33, 0x4b, 0x72, 0x12, 0x21, 0xe6, 0x21, 0xc2, 0x0e, 0xc4, 0xca, 0xcb, 0x4f, 0x49, 0xc5, 0x0c,
-	0xd0, 0x24, 0x36, 0x70, 0xb8, 0x18, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x73, 0x7c, 0xd9, 0xb9,
-	0x79, 0x01, 0x00, 0x00,
+	// 455 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x4f, 0x6b, 0xdb, 0x30,
+	0x18, 0xc6, 0xa3, 0xe6, 0x4f, 0x5b, 0x25, 0x65, 0x43, 0x6c, 0xc5, 0xe4, 0x60, 0x9b, 0x5c, 0xe6,
+	0x0d, 0x6a, 0xd3, 0x8c, 0xed, 0x6e, 0x6f, 0x0c, 0xd2, 0xd3, 0x10, 0xfb, 0x03, 0xbb, 0x18, 0x55,
+	0x56, 0x1c, 0x31, 0xdb, 0x0a, 0x91, 0xb2, 0x25, 0xdf, 0x62, 0xdf, 0x69, 0x97, 0x1e, 0x7b, 0x2c,
+	0x3b, 0x98, 0x91, 0x7c, 0x8b, 0x9e, 0x86, 0x25, 0xbb, 0x5d, 0x57, 0x18, 0x81, 0x5e, 0xac, 0xf7,
+	0x15, 0x8f, 0x7e, 0x7a, 0x9e, 0xd7, 0x36, 0x0c, 0x73, 0xb2, 0x26, 0x74, 0x46, 0x78, 0x11, 0x7c,
+	0x3b, 0x0d, 0x56, 0xc1, 0x6d, 0xab, 0xd6, 0x73, 0x26, 0xf5, 0x33, 0x26, 0xd3, 0x29, 0xcf, 0x38,
+	0x51, 0x2c, 0x9e, 0x32, 0x16, 0x53, 0x91, 0x65, 0x8c, 0x2a, 0xb1, 0xf0, 0xe7, 0x0b, 0xa1, 0x04,
+	0xea, 0x6a, 0xe5, 0xd0, 0xbd, 0x43, 0xa2, 0x22, 0xcf, 0x45, 0x51, 0x2f, 0x46, 0x38, 0x7c, 0x92,
+	0x8a, 0x54, 0xe8, 0x32, 0xa8, 0x2a, 0xb3, 0x3b, 0xfa, 0x09, 0xe0, 0xd3, 0xb0, 0xb9, 0xe0, 0x1d,
+	0x63, 0x6f, 0x1a, 0x3c, 0xfa, 0x04, 0x8f, 0xc4, 0xf7, 0x82, 0x2d, 0x62, 0x92, 0x24, 0x0b, 0x26,
+	0xa5, 0x05, 0x5c, 0xe0, 0x0d, 0xa2, 0xd3, 0xeb, 0xd2, 0x39, 0x49, 0xb9, 0x9a, 0x2d, 0xcf, 0x7d,
+	0x2a, 0xf2, 0x80, 0x0a, 0x99, 0x0b, 0x59, 0x2f, 0x27, 0x32, 0xf9, 0x6a, 0xac, 0xfb, 0x21, 0xa5,
+	0xa1, 0x39, 0x88, 0x07, 0x9a, 0x53, 0x77, 0x08, 0xc3, 0x01, 0x25, 0x94, 0x88, 0x98, 0xe4, 0x62,
+	0x59, 0x28, 0x6b, 0xcf, 0x05, 0xde, 0x61, 0x14, 0x5c, 0x94, 0x4e, 0xeb, 0x57, 0xe9, 0x3c, 0xdb,
+	0x01, 0xfd, 0x91, 0x17, 0x0a, 0xf7, 0x35, 0x24, 0xd4, 0x8c, 0xd1, 0xd5, 0x1e, 0x7c, 0x74, 0x93,
+	0xe2, 0x3d, 0x59, 0x8b, 0xa5, 0x42, 0x43, 0x78, 0x50, 0xcd, 0xa4, 0x20, 0x39, 0xd3, 0xd6, 0x0f,
+	0xf1, 0x4d, 0x8f, 0x3e, 0xc3, 0x2e, 0x91, 0x92, 0x99, 0xcb, 0xfb, 0xe3, 0x23, 0xbf, 0x9e, 0x54,
+	0x58, 0x6d, 0x46, 0xe3, 0xda, 0xcb, 0x8b, 0x94, 0xab, 0x8c, 0x18, 0x2f, 0xb7, 0xe3, 0xd5, 0x10,
+	0x91, 0xb0, 0xe0, 0xef, 0x33, 0xd8, 0xf0, 0xee, 0x85, 0x6b, 0x3f, 0x3c, 0x1c, 0x3a, 0x83, 0x5d,
+	0xb5, 0x8a, 0x79, 0x62, 0x75, 0x34, 0xec, 0xd5, 0xa6, 0x74, 0x3a, 0x1f, 0x56, 0x93, 0xb7, 0xd7,
+	0xa5, 0xf3, 0x7c, 0x27, 0x87, 0x95, 0x18, 0x77, 0xd4, 0x6a, 0x92, 0xa0, 0x63, 0xd8, 0x9b, 0x31,
+	0x9e, 0xce, 0x94, 0xd5, 0x75, 0x81, 0xd7, 0xc6, 0x75, 0x87, 0x2c, 0xb8, 0x4f, 0x33, 0xc2, 0x73,
+	0x96, 0x58, 0x3d, 0x17, 0x78, 0x07, 0xb8, 0x69, 0x47, 0x67, 0xf0, 0xf1, 0x3f, 0x93, 0x95, 0xe8,
+	0x35, 0xdc, 0x9f, 0x9b, 0xd2, 0x02, 0x6e, 0xdb, 0xeb, 0x8f, 0x8f, 0xfd, 0xfa, 0xa5, 0xdf, 0x55,
+	0x46, 0x9d, 0x2a, 0x38, 0x6e, 0xc4, 0xd1, 0xe4, 0x62, 0x63, 0x83, 0xcb, 0x8d, 0x0d, 0x7e, 0x6f,
+	0x6c, 0xf0, 0x63, 0x6b, 0xb7, 0x2e, 0xb7, 0x76, 0xeb, 0x6a, 0x6b, 0xb7, 0xbe, 0x04, 0xff, 0x0f,
+	0x72, 0xef, 0xcf, 0x38, 0xef, 0xe9, 0xcf, 0xf7, 0xe5, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4e,
+	0x29, 0x57, 0xd3, 0x42, 0x03, 0x00, 0x00,
 }
 
 func (m *AffiliateFeeCollector) Marshal() (dAtA []byte, err error) {
@@ -139,6 +268,115 @@ func (m *AffiliateFeeCollector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AffiliatePayout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AffiliatePayout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AffiliatePayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Claimed {
+		i--
+		if m.Claimed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypeAffiliateFeeCollector(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintTypeAffiliateFeeCollector(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.CacaoAmount.Size()
+		i -= size
+		if _, err := m.CacaoAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeAffiliateFeeCollector(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Asset.Size()
+		i -= size
+		if _, err := m.Asset.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeAffiliateFeeCollector(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Mayaname) > 0 {
+		i -= len(m.Mayaname)
+		copy(dAtA[i:], m.Mayaname)
+		i = encodeVarintTypeAffiliateFeeCollector(dAtA, i, uint64(len(m.Mayaname)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AffiliatePayouts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AffiliatePayouts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AffiliatePayouts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payouts) > 0 {
+		for iNdEx := len(m.Payouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypeAffiliateFeeCollector(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypeAffiliateFeeCollector(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypeAffiliateFeeCollector(v)
 	base := offset
@@ -165,6 +403,48 @@ func (m *AffiliateFeeCollector) Size() (n int) {
 	return n
 }
 
+func (m *AffiliatePayout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Mayaname)
+	if l > 0 {
+		n += 1 + l + sovTypeAffiliateFeeCollector(uint64(l))
+	}
+	l = m.Asset.Size()
+	n += 1 + l + sovTypeAffiliateFeeCollector(uint64(l))
+	l = m.CacaoAmount.Size()
+	n += 1 + l + sovTypeAffiliateFeeCollector(uint64(l))
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTypeAffiliateFeeCollector(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypeAffiliateFeeCollector(uint64(m.Height))
+	}
+	if m.Claimed {
+		n += 2
+	}
+	return n
+}
+
+func (m *AffiliatePayouts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Payouts) > 0 {
+		for _, e := range m.Payouts {
+			l = e.Size()
+			n += 1 + l + sovTypeAffiliateFeeCollector(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTypeAffiliateFeeCollector(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -292,6 +572,316 @@ func (m *AffiliateFeeCollector) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AffiliatePayout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeAffiliateFeeCollector
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AffiliatePayout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AffiliatePayout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mayaname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeAffiliateFeeCollector
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeAffiliateFeeCollector
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeAffiliateFeeCollector
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mayaname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeAffiliateFeeCollector
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeAffiliateFeeCollector
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeAffiliateFeeCollector
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeAffiliateFeeCollector
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeAffiliateFeeCollector
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeAffiliateFeeCollector
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CacaoAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeAffiliateFeeCollector
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeAffiliateFeeCollector
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeAffiliateFeeCollector
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeAffiliateFeeCollector
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeAffiliateFeeCollector
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Claimed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeAffiliateFeeCollector(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeAffiliateFeeCollector
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeAffiliateFeeCollector
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AffiliatePayouts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeAffiliateFeeCollector
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AffiliatePayouts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AffiliatePayouts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeAffiliateFeeCollector
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeAffiliateFeeCollector
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeAffiliateFeeCollector
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payouts = append(m.Payouts, AffiliatePayout{})
+			if err := m.Payouts[len(m.Payouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeAffiliateFeeCollector(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeAffiliateFeeCollector
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeAffiliateFeeCollector
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypeAffiliateFeeCollector(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
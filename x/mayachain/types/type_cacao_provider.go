@@ -6,8 +6,9 @@ import (
 
 func NewCACAOProvider(addr cosmos.AccAddress) CACAOProvider {
 	return CACAOProvider{
-		CacaoAddress: addr,
-		Units:        cosmos.ZeroUint(),
+		CacaoAddress:     addr,
+		Units:            cosmos.ZeroUint(),
+		CompoundedAmount: cosmos.ZeroUint(),
 	}
 }
 
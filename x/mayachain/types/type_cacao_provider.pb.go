@@ -31,6 +31,8 @@ type CACAOProvider struct {
 	Units              github_com_cosmos_cosmos_sdk_types.Uint       `protobuf:"bytes,4,opt,name=units,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"units"`
 	LastDepositHeight  int64                                         `protobuf:"varint,5,opt,name=last_deposit_height,json=lastDepositHeight,proto3" json:"last_deposit_height,omitempty"`
 	LastWithdrawHeight int64                                         `protobuf:"varint,6,opt,name=last_withdraw_height,json=lastWithdrawHeight,proto3" json:"last_withdraw_height,omitempty"`
+	AutoCompound       bool                                          `protobuf:"varint,7,opt,name=auto_compound,json=autoCompound,proto3" json:"auto_compound,omitempty"`
+	CompoundedAmount   github_com_cosmos_cosmos_sdk_types.Uint       `protobuf:"bytes,8,opt,name=compounded_amount,json=compoundedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"compounded_amount"`
 }
 
 func (m *CACAOProvider) Reset()         { *m = CACAOProvider{} }
@@ -87,6 +89,13 @@ func (m *CACAOProvider) GetLastWithdrawHeight() int64 {
 	return 0
 }
 
+func (m *CACAOProvider) GetAutoCompound() bool {
+	if m != nil {
+		return m.AutoCompound
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*CACAOProvider)(nil), "types.CACAOProvider")
 }
@@ -96,30 +105,32 @@ func init() {
 }
 
 var fileDescriptor_4243b0235f0f23d0 = []byte{
-	// 354 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x31, 0x4f, 0xf2, 0x40,
-	0x18, 0xc7, 0xdb, 0x97, 0x17, 0x92, 0xf7, 0x02, 0xbc, 0xf1, 0x64, 0x68, 0x1c, 0x0a, 0x71, 0x91,
-	0x85, 0x9e, 0xc4, 0xc5, 0xb5, 0xa0, 0x89, 0x4e, 0x1a, 0x12, 0xd1, 0xb8, 0x34, 0xc7, 0x5d, 0xd3,
-	0x5e, 0xa4, 0xbd, 0xa6, 0x77, 0x80, 0x7c, 0x0b, 0x3f, 0x93, 0x13, 0x23, 0xa3, 0x71, 0x20, 0x06,
-	0xbe, 0x85, 0x93, 0xe9, 0xdd, 0x21, 0x83, 0x8b, 0x61, 0x69, 0x9f, 0xe7, 0xfe, 0xcf, 0xff, 0x97,
-	0xe7, 0x49, 0xfe, 0xe0, 0x3c, 0xc1, 0x73, 0x4c, 0x62, 0xcc, 0x52, 0x34, 0xed, 0xa2, 0x67, 0xb4,
-	0x6b, 0xe5, 0x3c, 0x0b, 0x85, 0xfa, 0x06, 0x04, 0x13, 0xcc, 0x83, 0x2c, 0xe7, 0x53, 0x46, 0xc3,
-	0xdc, 0xcb, 0x72, 0x2e, 0x39, 0x2c, 0xab, 0x81, 0xa3, 0x46, 0xc4, 0x23, 0xae, 0x5e, 0x50, 0x51,
-	0x69, 0xf1, 0xf8, 0xb5, 0x04, 0x6a, 0x7d, 0xbf, 0xef, 0xdf, 0xdc, 0x1a, 0x13, 0x1c, 0x82, 0x9a,
-	0xc6, 0x60, 0x4a, 0xf3, 0x50, 0x08, 0xc7, 0x6e, 0xd9, 0xed, 0x6a, 0xaf, 0xfb, 0xb9, 0x6a, 0x76,
-	0x22, 0x26, 0xe3, 0xc9, 0xc8, 0x23, 0x3c, 0x41, 0x84, 0x8b, 0x84, 0x0b, 0xf3, 0xeb, 0x08, 0xfa,
-	0xa4, 0xf7, 0xf0, 0x7c, 0x42, 0x7c, 0x6d, 0x1c, 0x54, 0x15, 0xc7, 0x74, 0x70, 0x08, 0xea, 0x34,
-	0xcc, 0xb8, 0x60, 0x32, 0xc0, 0x09, 0x9f, 0xa4, 0xd2, 0xf9, 0xd3, 0xb2, 0xdb, 0xff, 0x7a, 0x68,
-	0xb1, 0x6a, 0x5a, 0xef, 0xab, 0xe6, 0xc9, 0x2f, 0xe0, 0x77, 0x2c, 0x95, 0x83, 0x9a, 0xc1, 0xf8,
-	0x8a, 0x02, 0x1f, 0xc0, 0xff, 0x19, 0x93, 0x31, 0xcd, 0xf1, 0x6c, 0x0b, 0x2e, 0xed, 0x07, 0xae,
-	0x6f, 0x39, 0x86, 0x7c, 0x09, 0xca, 0x93, 0x94, 0x49, 0xe1, 0xfc, 0xdd, 0x8f, 0xa7, 0xdd, 0xd0,
-	0x03, 0x87, 0x63, 0x2c, 0x64, 0xb0, 0xbd, 0x3e, 0x0e, 0x59, 0x14, 0x4b, 0xa7, 0xdc, 0xb2, 0xdb,
-	0xa5, 0xc1, 0x41, 0x21, 0x5d, 0x68, 0xe5, 0x4a, 0x09, 0xf0, 0x14, 0x34, 0xd4, 0xfc, 0xf7, 0x55,
-	0xc6, 0x50, 0x51, 0x06, 0x58, 0x68, 0xf7, 0x46, 0xd2, 0x8e, 0xde, 0xf5, 0x62, 0xed, 0xda, 0xcb,
-	0xb5, 0x6b, 0x7f, 0xac, 0x5d, 0xfb, 0x65, 0xe3, 0x5a, 0xcb, 0x8d, 0x6b, 0xbd, 0x6d, 0x5c, 0xeb,
-	0x11, 0x45, 0x4c, 0x8e, 0xb1, 0xde, 0x75, 0x97, 0x98, 0xa2, 0x4a, 0x39, 0x0d, 0x7f, 0xc6, 0x68,
-	0x54, 0x51, 0xb1, 0x38, 0xfb, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x44, 0x03, 0xc4, 0x65, 0x6f, 0x02,
-	0x00, 0x00,
+	// 395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x31, 0xaf, 0xd3, 0x30,
+	0x10, 0xc7, 0x63, 0xde, 0xeb, 0xe3, 0x61, 0x35, 0x85, 0x9a, 0x0e, 0x11, 0x43, 0x1a, 0xc1, 0x40,
+	0x96, 0x26, 0x54, 0x2c, 0xac, 0x69, 0x41, 0x82, 0x09, 0x14, 0x89, 0x82, 0x10, 0x52, 0xe4, 0xda,
+	0x51, 0x62, 0xd1, 0xc4, 0x51, 0xec, 0xb4, 0xf4, 0x5b, 0xf0, 0xa5, 0x90, 0x3a, 0x76, 0x44, 0x0c,
+	0x15, 0x6a, 0xbf, 0x05, 0x13, 0x8a, 0xed, 0xd0, 0x81, 0x05, 0x75, 0x49, 0xee, 0xee, 0x7f, 0xff,
+	0x9f, 0x7c, 0xa7, 0x83, 0x2f, 0x0a, 0xbc, 0xc5, 0x24, 0xc7, 0xac, 0x0c, 0xd7, 0xd3, 0xf0, 0x6b,
+	0x78, 0x4e, 0xe5, 0xb6, 0x4a, 0x85, 0xfa, 0x26, 0x04, 0x13, 0xcc, 0x93, 0xaa, 0xe6, 0x6b, 0x46,
+	0xd3, 0x3a, 0xa8, 0x6a, 0x2e, 0x39, 0xea, 0xa9, 0x86, 0x47, 0xa3, 0x8c, 0x67, 0x5c, 0x55, 0xc2,
+	0x36, 0xd2, 0xe2, 0xe3, 0xef, 0xd7, 0xd0, 0x9e, 0x47, 0xf3, 0xe8, 0xed, 0x3b, 0x63, 0x42, 0x0b,
+	0x68, 0x6b, 0x0c, 0xa6, 0xb4, 0x4e, 0x85, 0x70, 0x80, 0x07, 0xfc, 0xfe, 0x6c, 0xfa, 0xfb, 0x30,
+	0x9e, 0x64, 0x4c, 0xe6, 0xcd, 0x32, 0x20, 0xbc, 0x08, 0x09, 0x17, 0x05, 0x17, 0xe6, 0x37, 0x11,
+	0xf4, 0x8b, 0x7e, 0x47, 0x10, 0x11, 0x12, 0x69, 0x63, 0xdc, 0x57, 0x1c, 0x93, 0xa1, 0x05, 0x1c,
+	0xd0, 0xb4, 0xe2, 0x82, 0xc9, 0x04, 0x17, 0xbc, 0x29, 0xa5, 0x73, 0xc7, 0x03, 0xfe, 0xbd, 0x59,
+	0xb8, 0x3b, 0x8c, 0xad, 0x9f, 0x87, 0xf1, 0xd3, 0xff, 0x80, 0xbf, 0x67, 0xa5, 0x8c, 0x6d, 0x83,
+	0x89, 0x14, 0x05, 0x7d, 0x84, 0xf7, 0x37, 0x4c, 0xe6, 0xb4, 0xc6, 0x9b, 0x0e, 0x7c, 0x75, 0x19,
+	0x78, 0xd0, 0x71, 0x0c, 0xf9, 0x15, 0xec, 0x35, 0x25, 0x93, 0xc2, 0xb9, 0xbe, 0x8c, 0xa7, 0xdd,
+	0x28, 0x80, 0x0f, 0x57, 0x58, 0xc8, 0xa4, 0x9b, 0x3e, 0x4f, 0x59, 0x96, 0x4b, 0xa7, 0xe7, 0x01,
+	0xff, 0x2a, 0x1e, 0xb6, 0xd2, 0x4b, 0xad, 0xbc, 0x56, 0x02, 0x7a, 0x06, 0x47, 0xaa, 0xff, 0xef,
+	0x54, 0xc6, 0x70, 0xa3, 0x0c, 0xa8, 0xd5, 0x3e, 0x18, 0xc9, 0x38, 0x9e, 0x40, 0x1b, 0x37, 0x92,
+	0x27, 0x84, 0x17, 0x15, 0x6f, 0x4a, 0xea, 0xdc, 0xf5, 0x80, 0x7f, 0x1b, 0xf7, 0xdb, 0xe2, 0xdc,
+	0xd4, 0xd0, 0x67, 0x38, 0xec, 0xf4, 0x94, 0x76, 0x9b, 0xba, 0xbd, 0x6c, 0xb2, 0x07, 0x67, 0x92,
+	0xde, 0xd5, 0xec, 0xcd, 0xee, 0xe8, 0x82, 0xfd, 0xd1, 0x05, 0xbf, 0x8e, 0x2e, 0xf8, 0x76, 0x72,
+	0xad, 0xfd, 0xc9, 0xb5, 0x7e, 0x9c, 0x5c, 0xeb, 0x53, 0x98, 0x31, 0xb9, 0xc2, 0x1a, 0x7a, 0x3e,
+	0xda, 0x36, 0x2a, 0x39, 0x4d, 0xff, 0xbd, 0xe4, 0xe5, 0x8d, 0xba, 0xcc, 0xe7, 0x7f, 0x02, 0x00,
+	0x00, 0xff, 0xff, 0xad, 0xd9, 0xae, 0xa7, 0xf2, 0x02, 0x00, 0x00,
 }
 
 func (m *CACAOProvider) Marshal() (dAtA []byte, err error) {
@@ -142,6 +153,26 @@ func (m *CACAOProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.CompoundedAmount.Size()
+		i -= size
+		if _, err := m.CompoundedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeCacaoProvider(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.AutoCompound {
+		i--
+		if m.AutoCompound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.LastWithdrawHeight != 0 {
 		i = encodeVarintTypeCacaoProvider(dAtA, i, uint64(m.LastWithdrawHeight))
 		i--
@@ -225,6 +256,11 @@ func (m *CACAOProvider) Size() (n int) {
 	if m.LastWithdrawHeight != 0 {
 		n += 1 + sovTypeCacaoProvider(uint64(m.LastWithdrawHeight))
 	}
+	if m.AutoCompound {
+		n += 2
+	}
+	l = m.CompoundedAmount.Size()
+	n += 1 + l + sovTypeCacaoProvider(uint64(l))
 	return n
 }
 
@@ -437,6 +473,60 @@ func (m *CACAOProvider) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeCacaoProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoCompound = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompoundedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeCacaoProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeCacaoProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeCacaoProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CompoundedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypeCacaoProvider(dAtA[iNdEx:])
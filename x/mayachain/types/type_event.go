@@ -38,6 +38,8 @@ const (
 	MAYANameEventType             = "mayaname"
 	CACAOPoolDepositEventType     = "cacao_pool_deposit"
 	CACAOPoolWithdrawEventType    = "cacao_pool_withdraw"
+	CACAOPoolQueuedEventType      = "cacao_pool_withdraw_queued"
+	CACAOPoolCompoundEventType    = "cacao_pool_compound"
 	TSSKeygenSuccess              = "tss_keygen_success"
 	TSSKeygenFailure              = "tss_keygen_failure"
 	TSSKeygenMetricEventType      = "tss_keygen"
@@ -45,6 +47,31 @@ const (
 	WithdrawEventType             = "withdraw"
 	TradeAccountDepositEventType  = "trade_account_deposit"
 	TradeAccountWithdrawEventType = "trade_account_withdraw"
+	TradeAccountTransferEventType = "trade_account_transfer"
+	LimitOrderCloseEventType      = "limit_order_close"
+	LimitOrderFillEventType       = "limit_order_fill"
+	StreamingSwapCancelEventType  = "streaming_swap_cancel"
+	DCAOrderEventType             = "dca_order"
+	DCASliceEventType             = "dca_slice"
+	LoanOpenEventType             = "loan_open"
+	LoanRepaymentEventType        = "loan_repayment"
+	LoanCloseEventType            = "loan_close"
+	LoanLiquidationEventType      = "loan_liquidation"
+	MAYANameListEventType         = "mayaname_list"
+	MAYANameDelistEventType       = "mayaname_delist"
+	MAYANameSaleEventType         = "mayaname_sale"
+	POLEventType                  = "pol"
+	BondProviderEventType         = "bond_provider"
+	NodeOperatorFeeEventType      = "node_operator_fee"
+	BondProviderTransferEventType = "bond_provider_transfer"
+	VaultMigratedEventType        = "vault_migrated"
+	SolvencyWarningEventType      = "solvency_warning"
+)
+
+// reasons a limit order is closed without being executed
+const (
+	LimitOrderCloseReasonExpired   = "expired"
+	LimitOrderCloseReasonCancelled = "cancelled"
 )
 
 // PoolMods a list of pool modifications
@@ -990,6 +1017,52 @@ func (m *EventCACAOPoolWithdraw) Events() (cosmos.Events, error) {
 	return cosmos.Events{evt}, nil
 }
 
+// NewEventCACAOPoolWithdrawQueued create a new CACAOPool withdraw queued event
+func NewEventCACAOPoolWithdrawQueued(cacaoAddress cosmos.AccAddress, basisPts, matureHeight int64, txID common.TxID) *EventCACAOPoolWithdrawQueued {
+	return &EventCACAOPoolWithdrawQueued{
+		CacaoAddress: cacaoAddress,
+		BasisPoints:  basisPts,
+		MatureHeight: matureHeight,
+		TxId:         txID,
+	}
+}
+
+// Type return the withdraw queued event type
+func (m *EventCACAOPoolWithdrawQueued) Type() string {
+	return CACAOPoolQueuedEventType
+}
+
+// Events return the cosmos event
+func (m *EventCACAOPoolWithdrawQueued) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("cacao_address", m.CacaoAddress.String()),
+		cosmos.NewAttribute("basis_points", strconv.FormatInt(m.BasisPoints, 10)),
+		cosmos.NewAttribute("mature_height", strconv.FormatInt(m.MatureHeight, 10)),
+		cosmos.NewAttribute("tx_id", m.TxId.String()))
+	return cosmos.Events{evt}, nil
+}
+
+// NewEventCACAOPoolCompound create a new CACAOPool compound event
+func NewEventCACAOPoolCompound(cacaoAddress cosmos.AccAddress, cacaoAmount cosmos.Uint) *EventCACAOPoolCompound {
+	return &EventCACAOPoolCompound{
+		CacaoAddress: cacaoAddress,
+		CacaoAmount:  cacaoAmount,
+	}
+}
+
+// Type return the compound event type
+func (m *EventCACAOPoolCompound) Type() string {
+	return CACAOPoolCompoundEventType
+}
+
+// Events return the cosmos event
+func (m *EventCACAOPoolCompound) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("cacao_address", m.CacaoAddress.String()),
+		cosmos.NewAttribute("cacao_amount", m.CacaoAmount.String()))
+	return cosmos.Events{evt}, nil
+}
+
 // NewEventCACAOPoolDeposit create a new CACAOPool deposit event
 func NewEventCACAOPoolDeposit(
 	cacaoAddress cosmos.AccAddress,
@@ -1087,6 +1160,42 @@ func (m *EventTradeAccountWithdraw) Events() (cosmos.Events, error) {
 	return cosmos.Events{evt}, nil
 }
 
+// NewEventTradeAccountTransfer create a new trade account transfer event
+func NewEventTradeAccountTransfer(
+	amt cosmos.Uint,
+	asset common.Asset,
+	from common.Address,
+	to common.Address,
+	units cosmos.Uint,
+	txID common.TxID,
+) *EventTradeAccountTransfer {
+	return &EventTradeAccountTransfer{
+		Amount:      amt,
+		Asset:       asset,
+		FromAddress: from,
+		ToAddress:   to,
+		Units:       units,
+		TxID:        txID,
+	}
+}
+
+// Type return the trade account transfer event type
+func (m *EventTradeAccountTransfer) Type() string {
+	return TradeAccountTransferEventType
+}
+
+// Events return the cosmos event
+func (m *EventTradeAccountTransfer) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("amount", m.Amount.String()),
+		cosmos.NewAttribute("asset", m.Asset.String()),
+		cosmos.NewAttribute("from_address", m.FromAddress.String()),
+		cosmos.NewAttribute("to_address", m.ToAddress.String()),
+		cosmos.NewAttribute("units", m.Units.String()),
+		cosmos.NewAttribute("tx_id", m.TxID.String()))
+	return cosmos.Events{evt}, nil
+}
+
 // NewEventSetMimir create a new instance of EventSetMimir
 func NewEventSetMimir(key, value string) *EventSetMimir {
 	return &EventSetMimir{
@@ -1132,3 +1241,567 @@ func (m *EventSetNodeMimir) Events() (cosmos.Events, error) {
 	)
 	return cosmos.Events{evt}, nil
 }
+
+// NewEventLimitOrderClose create a new instance of EventLimitOrderClose
+func NewEventLimitOrderClose(msg MsgSwap, reason string, cancelTxID common.TxID) *EventLimitOrderClose {
+	var source common.Coin
+	if len(msg.Tx.Coins) > 0 {
+		source = msg.Tx.Coins[0]
+	}
+	return &EventLimitOrderClose{
+		TxID:         msg.Tx.ID,
+		FromAddress:  msg.Tx.FromAddress,
+		Source:       source,
+		TargetAsset:  msg.TargetAsset,
+		TradeTarget:  msg.TradeTarget,
+		ExpiryHeight: msg.ExpiryHeight,
+		Reason:       reason,
+		CancelTxID:   cancelTxID,
+	}
+}
+
+// Type return a string which represent the type of this event
+func (m *EventLimitOrderClose) Type() string {
+	return LimitOrderCloseEventType
+}
+
+// Events return cosmos sdk events
+func (m *EventLimitOrderClose) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("tx_id", m.TxID.String()),
+		cosmos.NewAttribute("from_address", m.FromAddress.String()),
+		cosmos.NewAttribute("source", m.Source.String()),
+		cosmos.NewAttribute("target_asset", m.TargetAsset.String()),
+		cosmos.NewAttribute("trade_target", m.TradeTarget.String()),
+		cosmos.NewAttribute("expiry_height", strconv.FormatInt(m.ExpiryHeight, 10)),
+		cosmos.NewAttribute("reason", m.Reason),
+		cosmos.NewAttribute("cancel_tx_id", m.CancelTxID.String()),
+	)
+	return cosmos.Events{evt}, nil
+}
+
+// NewEventLimitOrderFill create a new instance of EventLimitOrderFill
+func NewEventLimitOrderFill(txID common.TxID, in, out common.Coin, fill LimitOrderFill, remaining cosmos.Uint) *EventLimitOrderFill {
+	return &EventLimitOrderFill{
+		TxID:      txID,
+		In:        in,
+		Out:       out,
+		FilledIn:  fill.In,
+		FilledOut: fill.Out,
+		Remaining: remaining,
+		Count:     fill.Count,
+	}
+}
+
+// Type return a string which represent the type of this event
+func (m *EventLimitOrderFill) Type() string {
+	return LimitOrderFillEventType
+}
+
+// Events return cosmos sdk events
+func (m *EventLimitOrderFill) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("tx_id", m.TxID.String()),
+		cosmos.NewAttribute("in", m.In.String()),
+		cosmos.NewAttribute("out", m.Out.String()),
+		cosmos.NewAttribute("filled_in", m.FilledIn.String()),
+		cosmos.NewAttribute("filled_out", m.FilledOut.String()),
+		cosmos.NewAttribute("remaining", m.Remaining.String()),
+		cosmos.NewAttribute("count", strconv.FormatUint(m.Count, 10)),
+	)
+	return cosmos.Events{evt}, nil
+}
+
+// NewEventStreamingSwapCancel create a new instance of EventStreamingSwapCancel
+func NewEventStreamingSwapCancel(msg MsgSwap, swp StreamingSwap, cancelTxID common.TxID) *EventStreamingSwapCancel {
+	source := msg.Tx.Coins[0].Asset
+	return &EventStreamingSwapCancel{
+		TxID:        swp.TxID,
+		CancelTxID:  cancelTxID,
+		FromAddress: msg.Tx.FromAddress,
+		Quantity:    swp.Quantity,
+		Count:       swp.Count,
+		Deposit:     common.NewCoin(source, swp.Deposit),
+		In:          common.NewCoin(source, swp.In),
+		Out:         common.NewCoin(msg.TargetAsset, swp.Out),
+		Refund:      common.NewCoin(source, common.SafeSub(swp.Deposit, swp.In)),
+	}
+}
+
+// Type return a string which represent the type of this event
+func (m *EventStreamingSwapCancel) Type() string {
+	return StreamingSwapCancelEventType
+}
+
+// Events return cosmos sdk events
+func (m *EventStreamingSwapCancel) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("tx_id", m.TxID.String()),
+		cosmos.NewAttribute("cancel_tx_id", m.CancelTxID.String()),
+		cosmos.NewAttribute("from_address", m.FromAddress.String()),
+		cosmos.NewAttribute("quantity", strconv.FormatUint(m.Quantity, 10)),
+		cosmos.NewAttribute("count", strconv.FormatUint(m.Count, 10)),
+		cosmos.NewAttribute("deposit", m.Deposit.String()),
+		cosmos.NewAttribute("in", m.In.String()),
+		cosmos.NewAttribute("out", m.Out.String()),
+		cosmos.NewAttribute("refund", m.Refund.String()),
+	)
+	return cosmos.Events{evt}, nil
+}
+
+// NewEventDCAOrder create a new instance of EventDCAOrder
+func NewEventDCAOrder(msg MsgSwap) *EventDCAOrder {
+	return &EventDCAOrder{
+		TxID:        msg.Tx.ID,
+		FromAddress: msg.Tx.FromAddress,
+		Destination: msg.Destination,
+		Deposit:     msg.Tx.Coins[0],
+		TargetAsset: msg.TargetAsset,
+		Interval:    msg.StreamInterval,
+		Quantity:    msg.StreamQuantity,
+		SliceLimit:  msg.TradeTarget,
+	}
+}
+
+// Type return a string which represent the type of this event
+func (m *EventDCAOrder) Type() string {
+	return DCAOrderEventType
+}
+
+// Events return cosmos sdk events
+func (m *EventDCAOrder) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("tx_id", m.TxID.String()),
+		cosmos.NewAttribute("from_address", m.FromAddress.String()),
+		cosmos.NewAttribute("destination", m.Destination.String()),
+		cosmos.NewAttribute("deposit", m.Deposit.String()),
+		cosmos.NewAttribute("target_asset", m.TargetAsset.String()),
+		cosmos.NewAttribute("interval", strconv.FormatUint(m.Interval, 10)),
+		cosmos.NewAttribute("quantity", strconv.FormatUint(m.Quantity, 10)),
+		cosmos.NewAttribute("slice_limit", m.SliceLimit.String()),
+	)
+	return cosmos.Events{evt}, nil
+}
+
+// NewEventDCASlice create a new instance of EventDCASlice, reason is empty
+// when the slice was swapped
+func NewEventDCASlice(msg MsgSwap, swp StreamingSwap, in, out cosmos.Uint, reason string) *EventDCASlice {
+	return &EventDCASlice{
+		TxID:       swp.TxID,
+		Quantity:   swp.Quantity,
+		Count:      swp.Count,
+		In:         common.NewCoin(msg.Tx.Coins[0].Asset, in),
+		Out:        common.NewCoin(msg.TargetAsset, out),
+		SliceLimit: msg.TradeTarget,
+		Reason:     reason,
+	}
+}
+
+// Type return a string which represent the type of this event
+func (m *EventDCASlice) Type() string {
+	return DCASliceEventType
+}
+
+// Events return cosmos sdk events
+func (m *EventDCASlice) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("tx_id", m.TxID.String()),
+		cosmos.NewAttribute("quantity", strconv.FormatUint(m.Quantity, 10)),
+		cosmos.NewAttribute("count", strconv.FormatUint(m.Count, 10)),
+		cosmos.NewAttribute("in", m.In.String()),
+		cosmos.NewAttribute("out", m.Out.String()),
+		cosmos.NewAttribute("slice_limit", m.SliceLimit.String()),
+		cosmos.NewAttribute("reason", m.Reason),
+	)
+	return cosmos.Events{evt}, nil
+}
+
+// NewEventLoanOpen create a new instance of EventLoanOpen
+func NewEventLoanOpen(loan Loan, collateral, units, debt cosmos.Uint, targetAddress common.Address, cr int64, txID common.TxID) *EventLoanOpen {
+	return &EventLoanOpen{
+		Owner:                  common.Address(loan.Owner.String()),
+		CollateralAsset:        loan.CollateralAsset,
+		CollateralDeposited:    collateral,
+		CollateralUnits:        units,
+		DebtAsset:              loan.DebtAsset,
+		DebtIssued:             debt,
+		TargetAddress:          targetAddress,
+		CollateralizationRatio: cr,
+		TxID:                   txID,
+	}
+}
+
+// Type return a string which represent the type of this event
+func (m *EventLoanOpen) Type() string {
+	return LoanOpenEventType
+}
+
+// Events return cosmos sdk events
+func (m *EventLoanOpen) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("owner", m.Owner.String()),
+		cosmos.NewAttribute("collateral_asset", m.CollateralAsset.String()),
+		cosmos.NewAttribute("collateral_deposited", m.CollateralDeposited.String()),
+		cosmos.NewAttribute("collateral_units", m.CollateralUnits.String()),
+		cosmos.NewAttribute("debt_asset", m.DebtAsset.String()),
+		cosmos.NewAttribute("debt_issued", m.DebtIssued.String()),
+		cosmos.NewAttribute("target_address", m.TargetAddress.String()),
+		cosmos.NewAttribute("collateralization_ratio", strconv.FormatInt(m.CollateralizationRatio, 10)),
+		cosmos.NewAttribute("tx_id", m.TxID.String()),
+	)
+	return cosmos.Events{evt}, nil
+}
+
+// NewEventLoanRepayment create a new instance of EventLoanRepayment
+func NewEventLoanRepayment(loan Loan, collateral, debt cosmos.Uint, coin common.Coin, txID common.TxID) *EventLoanRepayment {
+	return &EventLoanRepayment{
+		Owner:               common.Address(loan.Owner.String()),
+		CollateralAsset:     loan.CollateralAsset,
+		CollateralWithdrawn: collateral,
+		DebtAsset:           loan.DebtAsset,
+		DebtRepaid:          debt,
+		Coin:                coin,
+		TxID:                txID,
+	}
+}
+
+// Type return a string which represent the type of this event
+func (m *EventLoanRepayment) Type() string {
+	return LoanRepaymentEventType
+}
+
+// Events return cosmos sdk events
+func (m *EventLoanRepayment) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("owner", m.Owner.String()),
+		cosmos.NewAttribute("collateral_asset", m.CollateralAsset.String()),
+		cosmos.NewAttribute("collateral_withdrawn", m.CollateralWithdrawn.String()),
+		cosmos.NewAttribute("debt_asset", m.DebtAsset.String()),
+		cosmos.NewAttribute("debt_repaid", m.DebtRepaid.String()),
+		cosmos.NewAttribute("coin", m.Coin.String()),
+		cosmos.NewAttribute("tx_id", m.TxID.String()),
+	)
+	return cosmos.Events{evt}, nil
+}
+
+// NewEventLoanClose create a new instance of EventLoanClose, emitted once the
+// whole debt of a loan is repaid and its collateral released
+func NewEventLoanClose(loan Loan, txID common.TxID) *EventLoanClose {
+	return &EventLoanClose{
+		Owner:               common.Address(loan.Owner.String()),
+		CollateralAsset:     loan.CollateralAsset,
+		CollateralWithdrawn: loan.CollateralWithdrawn,
+		DebtAsset:           loan.DebtAsset,
+		DebtRepaid:          loan.DebtRepaid,
+		TxID:                txID,
+	}
+}
+
+// Type return a string which represent the type of this event
+func (m *EventLoanClose) Type() string {
+	return LoanCloseEventType
+}
+
+// Events return cosmos sdk events
+func (m *EventLoanClose) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("owner", m.Owner.String()),
+		cosmos.NewAttribute("collateral_asset", m.CollateralAsset.String()),
+		cosmos.NewAttribute("collateral_withdrawn", m.CollateralWithdrawn.String()),
+		cosmos.NewAttribute("debt_asset", m.DebtAsset.String()),
+		cosmos.NewAttribute("debt_repaid", m.DebtRepaid.String()),
+		cosmos.NewAttribute("tx_id", m.TxID.String()),
+	)
+	return cosmos.Events{evt}, nil
+}
+
+// NewEventLoanLiquidation create a new instance of EventLoanLiquidation
+func NewEventLoanLiquidation(loan Loan, collateral, debt, cacao cosmos.Uint, cr int64) *EventLoanLiquidation {
+	return &EventLoanLiquidation{
+		Owner:                  common.Address(loan.Owner.String()),
+		CollateralAsset:        loan.CollateralAsset,
+		CollateralLiquidated:   collateral,
+		DebtAsset:              loan.DebtAsset,
+		DebtRepaid:             debt,
+		CacaoRecovered:         cacao,
+		CollateralizationRatio: cr,
+	}
+}
+
+// Type return a string which represent the type of this event
+func (m *EventLoanLiquidation) Type() string {
+	return LoanLiquidationEventType
+}
+
+// Events return cosmos sdk events
+func (m *EventLoanLiquidation) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("owner", m.Owner.String()),
+		cosmos.NewAttribute("collateral_asset", m.CollateralAsset.String()),
+		cosmos.NewAttribute("collateral_liquidated", m.CollateralLiquidated.String()),
+		cosmos.NewAttribute("debt_asset", m.DebtAsset.String()),
+		cosmos.NewAttribute("debt_repaid", m.DebtRepaid.String()),
+		cosmos.NewAttribute("cacao_recovered", m.CacaoRecovered.String()),
+		cosmos.NewAttribute("collateralization_ratio", strconv.FormatInt(m.CollateralizationRatio, 10)),
+	)
+	return cosmos.Events{evt}, nil
+}
+
+// NewEventMAYANameList create a new instance of EventMAYANameList
+func NewEventMAYANameList(listing MAYANameListing, txID common.TxID) *EventMAYANameList {
+	return &EventMAYANameList{
+		Name:              listing.Name,
+		Seller:            common.Address(listing.Seller.String()),
+		Price:             listing.Price,
+		ExpireHeight:      listing.ExpireHeight,
+		KeepAliases:       listing.KeepAliases,
+		KeepSubaffiliates: listing.KeepSubaffiliates,
+		TxID:              txID,
+	}
+}
+
+// Type return a string which represent the type of this event
+func (m *EventMAYANameList) Type() string {
+	return MAYANameListEventType
+}
+
+// Events return cosmos sdk events
+func (m *EventMAYANameList) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("name", m.Name),
+		cosmos.NewAttribute("seller", m.Seller.String()),
+		cosmos.NewAttribute("price", m.Price.String()),
+		cosmos.NewAttribute("expire_height", strconv.FormatInt(m.ExpireHeight, 10)),
+		cosmos.NewAttribute("keep_aliases", strconv.FormatBool(m.KeepAliases)),
+		cosmos.NewAttribute("keep_subaffiliates", strconv.FormatBool(m.KeepSubaffiliates)),
+		cosmos.NewAttribute("tx_id", m.TxID.String()),
+	)
+	return cosmos.Events{evt}, nil
+}
+
+// NewEventMAYANameDelist create a new instance of EventMAYANameDelist
+func NewEventMAYANameDelist(listing MAYANameListing, txID common.TxID) *EventMAYANameDelist {
+	return &EventMAYANameDelist{
+		Name:   listing.Name,
+		Seller: common.Address(listing.Seller.String()),
+		TxID:   txID,
+	}
+}
+
+// Type return a string which represent the type of this event
+func (m *EventMAYANameDelist) Type() string {
+	return MAYANameDelistEventType
+}
+
+// Events return cosmos sdk events
+func (m *EventMAYANameDelist) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("name", m.Name),
+		cosmos.NewAttribute("seller", m.Seller.String()),
+		cosmos.NewAttribute("tx_id", m.TxID.String()),
+	)
+	return cosmos.Events{evt}, nil
+}
+
+// NewEventMAYANameSale create a new instance of EventMAYANameSale
+func NewEventMAYANameSale(listing MAYANameListing, buyer cosmos.AccAddress, txID common.TxID) *EventMAYANameSale {
+	return &EventMAYANameSale{
+		Name:   listing.Name,
+		Seller: common.Address(listing.Seller.String()),
+		Buyer:  common.Address(buyer.String()),
+		Price:  listing.Price,
+		TxID:   txID,
+	}
+}
+
+// Type return a string which represent the type of this event
+func (m *EventMAYANameSale) Type() string {
+	return MAYANameSaleEventType
+}
+
+// Events return cosmos sdk events
+func (m *EventMAYANameSale) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("name", m.Name),
+		cosmos.NewAttribute("seller", m.Seller.String()),
+		cosmos.NewAttribute("buyer", m.Buyer.String()),
+		cosmos.NewAttribute("price", m.Price.String()),
+		cosmos.NewAttribute("tx_id", m.TxID.String()),
+	)
+	return cosmos.Events{evt}, nil
+}
+
+// NewEventPOL create a new instance of EventPOL
+func NewEventPOL(pool common.Asset, action string, cacaoAmt, utilization, target, buffer cosmos.Uint, reason string) *EventPOL {
+	return &EventPOL{
+		Pool:                   pool,
+		Action:                 action,
+		CacaoAmount:            cacaoAmt,
+		SynthUtilization:       utilization,
+		TargetSynthUtilization: target,
+		Buffer:                 buffer,
+		Reason:                 reason,
+	}
+}
+
+// Type return a string which represent the type of this event
+func (m *EventPOL) Type() string {
+	return POLEventType
+}
+
+// Events return cosmos sdk events
+func (m *EventPOL) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("pool", m.Pool.String()),
+		cosmos.NewAttribute("action", m.Action),
+		cosmos.NewAttribute("cacao_amount", m.CacaoAmount.String()),
+		cosmos.NewAttribute("synth_utilization", m.SynthUtilization.String()),
+		cosmos.NewAttribute("target_synth_utilization", m.TargetSynthUtilization.String()),
+		cosmos.NewAttribute("buffer", m.Buffer.String()),
+		cosmos.NewAttribute("reason", m.Reason),
+	)
+	return cosmos.Events{evt}, nil
+}
+
+// NewEventBondProvider create a new instance of EventBondProvider, action is
+// either "whitelist" or "remove"
+func NewEventBondProvider(nodeAddr, providerAddr cosmos.AccAddress, action string, txID common.TxID) *EventBondProvider {
+	return &EventBondProvider{
+		NodeAddress:     nodeAddr,
+		ProviderAddress: providerAddr,
+		Action:          action,
+		TxID:            txID,
+	}
+}
+
+// Type return a string which represent the type of this event
+func (m *EventBondProvider) Type() string {
+	return BondProviderEventType
+}
+
+// Events return cosmos sdk events
+func (m *EventBondProvider) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("node_address", m.NodeAddress.String()),
+		cosmos.NewAttribute("provider_address", m.ProviderAddress.String()),
+		cosmos.NewAttribute("action", m.Action),
+		cosmos.NewAttribute("tx_id", m.TxID.String()),
+	)
+	return cosmos.Events{evt}, nil
+}
+
+// NewEventNodeOperatorFee create a new instance of EventNodeOperatorFee
+func NewEventNodeOperatorFee(nodeAddr cosmos.AccAddress, oldFee, newFee, applyHeight int64, txID common.TxID) *EventNodeOperatorFee {
+	return &EventNodeOperatorFee{
+		NodeAddress: nodeAddr,
+		OldFee:      oldFee,
+		NewFee:      newFee,
+		ApplyHeight: applyHeight,
+		TxID:        txID,
+	}
+}
+
+// Type return a string which represent the type of this event
+func (m *EventNodeOperatorFee) Type() string {
+	return NodeOperatorFeeEventType
+}
+
+// Events return cosmos sdk events
+func (m *EventNodeOperatorFee) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("node_address", m.NodeAddress.String()),
+		cosmos.NewAttribute("old_fee", strconv.FormatInt(m.OldFee, 10)),
+		cosmos.NewAttribute("new_fee", strconv.FormatInt(m.NewFee, 10)),
+		cosmos.NewAttribute("apply_height", strconv.FormatInt(m.ApplyHeight, 10)),
+		cosmos.NewAttribute("tx_id", m.TxID.String()),
+	)
+	return cosmos.Events{evt}, nil
+}
+
+// NewEventBondProviderTransfer create a new instance of EventBondProviderTransfer
+func NewEventBondProviderTransfer(nodeAddr, from, to cosmos.AccAddress, asset common.Asset, units cosmos.Uint, txID common.TxID) *EventBondProviderTransfer {
+	return &EventBondProviderTransfer{
+		NodeAddress: nodeAddr,
+		FromAddress: from,
+		ToAddress:   to,
+		Asset:       asset,
+		Units:       units,
+		TxID:        txID,
+	}
+}
+
+// Type return a string which represent the type of this event
+func (m *EventBondProviderTransfer) Type() string {
+	return BondProviderTransferEventType
+}
+
+// Events return cosmos sdk events
+func (m *EventBondProviderTransfer) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("node_address", m.NodeAddress.String()),
+		cosmos.NewAttribute("from_address", m.FromAddress.String()),
+		cosmos.NewAttribute("to_address", m.ToAddress.String()),
+		cosmos.NewAttribute("asset", m.Asset.String()),
+		cosmos.NewAttribute("units", m.Units.String()),
+		cosmos.NewAttribute("tx_id", m.TxID.String()),
+	)
+	return cosmos.Events{evt}, nil
+}
+
+// NewEventVaultMigrated create a new instance of EventVaultMigrated
+func NewEventVaultMigrated(pk common.PubKey, retiringHeight int64, migrated common.Coins) *EventVaultMigrated {
+	return &EventVaultMigrated{
+		PubKey:         pk,
+		RetiringHeight: retiringHeight,
+		Migrated:       migrated,
+	}
+}
+
+// Type return a string which represent the type of this event
+func (m *EventVaultMigrated) Type() string {
+	return VaultMigratedEventType
+}
+
+// Events return cosmos sdk events
+func (m *EventVaultMigrated) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("pub_key", m.PubKey.String()),
+		cosmos.NewAttribute("retiring_height", strconv.FormatInt(m.RetiringHeight, 10)),
+		cosmos.NewAttribute("migrated", m.Migrated.String()),
+	)
+	return cosmos.Events{evt}, nil
+}
+
+// NewEventSolvencyWarning create a new instance of EventSolvencyWarning
+func NewEventSolvencyWarning(pk common.PubKey, chain common.Chain, delta SolvencyDelta, warnGap, haltGap int64) *EventSolvencyWarning {
+	return &EventSolvencyWarning{
+		PubKey:       pk,
+		Chain:        chain,
+		Asset:        delta.Asset,
+		VaultAmount:  delta.VaultAmount,
+		WalletAmount: delta.WalletAmount,
+		Gap:          delta.Gap(),
+		WarnGap:      warnGap,
+		HaltGap:      haltGap,
+	}
+}
+
+// Type return a string which represent the type of this event
+func (m *EventSolvencyWarning) Type() string {
+	return SolvencyWarningEventType
+}
+
+// Events return cosmos sdk events
+func (m *EventSolvencyWarning) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("pub_key", m.PubKey.String()),
+		cosmos.NewAttribute("chain", m.Chain.String()),
+		cosmos.NewAttribute("asset", m.Asset.String()),
+		cosmos.NewAttribute("vault_amount", m.VaultAmount.String()),
+		cosmos.NewAttribute("wallet_amount", m.WalletAmount.String()),
+		cosmos.NewAttribute("gap", strconv.FormatInt(m.Gap, 10)),
+		cosmos.NewAttribute("warn_gap", strconv.FormatInt(m.WarnGap, 10)),
+		cosmos.NewAttribute("halt_gap", strconv.FormatInt(m.HaltGap, 10)),
+	)
+	return cosmos.Events{evt}, nil
+}
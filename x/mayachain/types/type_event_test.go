@@ -293,3 +293,85 @@ func (EventSuite) TestEventPoolStageCost(c *C) {
 	c.Check(err, IsNil)
 	c.Check(events, NotNil)
 }
+
+func (EventSuite) TestEventLimitOrderClose(c *C) {
+	tx := GetRandomTx()
+	msg := NewMsgSwap(tx, common.BTCAsset, GetRandomBTCAddress(), cosmos.NewUint(100), common.NoAddress, cosmos.ZeroUint(), "", "", nil, OrderType_limit, 0, 0, GetRandomBech32Addr())
+	msg.ExpiryHeight = 10
+	cancelTxID := GetRandomTxHash()
+	e := NewEventLimitOrderClose(*msg, LimitOrderCloseReasonCancelled, cancelTxID)
+	c.Check(e.Type(), Equals, "limit_order_close")
+	c.Check(e.TxID.Equals(tx.ID), Equals, true)
+	c.Check(e.CancelTxID.Equals(cancelTxID), Equals, true)
+	c.Check(e.ExpiryHeight, Equals, int64(10))
+	events, err := e.Events()
+	c.Check(err, IsNil)
+	c.Check(events, NotNil)
+}
+
+func (EventSuite) TestEventStreamingSwapCancel(c *C) {
+	tx := GetRandomTx()
+	tx.Coins = common.NewCoins(common.NewCoin(common.BTCAsset, cosmos.NewUint(1000)))
+	msg := NewMsgSwap(tx, common.BaseAsset(), GetRandomBaseAddress(), cosmos.ZeroUint(), common.NoAddress, cosmos.ZeroUint(), "", "", nil, OrderType_market, 10, 1, GetRandomBech32Addr())
+	swp := msg.GetStreamingSwap()
+	swp.Count = 3
+	swp.In = cosmos.NewUint(300)
+	swp.Out = cosmos.NewUint(150)
+	cancelTxID := GetRandomTxHash()
+	e := NewEventStreamingSwapCancel(*msg, swp, cancelTxID)
+	c.Check(e.Type(), Equals, "streaming_swap_cancel")
+	c.Check(e.TxID.Equals(tx.ID), Equals, true)
+	c.Check(e.CancelTxID.Equals(cancelTxID), Equals, true)
+	c.Check(e.Out.Equals(common.NewCoin(common.BaseAsset(), cosmos.NewUint(150))), Equals, true)
+	c.Check(e.Refund.Equals(common.NewCoin(common.BTCAsset, cosmos.NewUint(700))), Equals, true)
+	events, err := e.Events()
+	c.Check(err, IsNil)
+	c.Check(events, NotNil)
+}
+
+func (EventSuite) TestEventDCA(c *C) {
+	tx := GetRandomTx()
+	tx.Coins = common.NewCoins(common.NewCoin(common.BTCAsset, cosmos.NewUint(1000)))
+	msg := NewMsgSwap(tx, common.BaseAsset(), GetRandomBaseAddress(), cosmos.NewUint(40), common.NoAddress, cosmos.ZeroUint(), "", "", nil, OrderType_dca, 10, 100, GetRandomBech32Addr())
+
+	order := NewEventDCAOrder(*msg)
+	c.Check(order.Type(), Equals, "dca_order")
+	c.Check(order.TxID.Equals(tx.ID), Equals, true)
+	c.Check(order.Quantity, Equals, uint64(10))
+	c.Check(order.Interval, Equals, uint64(100))
+	c.Check(order.SliceLimit.Equal(cosmos.NewUint(40)), Equals, true)
+	events, err := order.Events()
+	c.Check(err, IsNil)
+	c.Check(events, NotNil)
+
+	swp := msg.GetStreamingSwap()
+	c.Check(swp.TradeTarget.Equal(cosmos.NewUint(400)), Equals, true)
+	swp.Count = 2
+	slice := NewEventDCASlice(*msg, swp, cosmos.NewUint(100), cosmos.NewUint(45), "")
+	c.Check(slice.Type(), Equals, "dca_slice")
+	c.Check(slice.Count, Equals, uint64(2))
+	c.Check(slice.In.Equals(common.NewCoin(common.BTCAsset, cosmos.NewUint(100))), Equals, true)
+	c.Check(slice.Out.Equals(common.NewCoin(common.BaseAsset(), cosmos.NewUint(45))), Equals, true)
+	events, err = slice.Events()
+	c.Check(err, IsNil)
+	c.Check(events, NotNil)
+}
+
+func (EventSuite) TestEventLimitOrderFill(c *C) {
+	txID := GetRandomTxHash()
+	fill := NewLimitOrderFill(txID)
+	fill.In = cosmos.NewUint(100)
+	fill.Out = cosmos.NewUint(50)
+	fill.Count = 1
+	in := common.NewCoin(common.BTCAsset, cosmos.NewUint(100))
+	out := common.NewCoin(common.BaseAsset(), cosmos.NewUint(50))
+	e := NewEventLimitOrderFill(txID, in, out, fill, cosmos.NewUint(300))
+	c.Check(e.Type(), Equals, "limit_order_fill")
+	c.Check(e.TxID.Equals(txID), Equals, true)
+	c.Check(e.FilledIn.Equal(cosmos.NewUint(100)), Equals, true)
+	c.Check(e.Remaining.Equal(cosmos.NewUint(300)), Equals, true)
+	c.Check(e.Count, Equals, uint64(1))
+	events, err := e.Events()
+	c.Check(err, IsNil)
+	c.Check(events, NotNil)
+}
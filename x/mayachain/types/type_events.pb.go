@@ -2609,6 +2609,119 @@ func (m *EventCACAOPoolWithdraw) GetAffiliateBasisPts() int64 {
 	return 0
 }
 
+type EventCACAOPoolWithdrawQueued struct {
+	CacaoAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=cacao_address,json=cacaoAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"cacao_address,omitempty"`
+	BasisPoints  int64                                         `protobuf:"varint,2,opt,name=basis_points,json=basisPoints,proto3" json:"basis_points,omitempty"`
+	MatureHeight int64                                         `protobuf:"varint,3,opt,name=mature_height,json=matureHeight,proto3" json:"mature_height,omitempty"`
+	TxId         gitlab_com_mayachain_mayanode_common.TxID     `protobuf:"bytes,4,opt,name=tx_id,json=txId,proto3,casttype=gitlab.com/mayachain/mayanode/common.TxID" json:"tx_id,omitempty"`
+}
+
+func (m *EventCACAOPoolWithdrawQueued) Reset()         { *m = EventCACAOPoolWithdrawQueued{} }
+func (m *EventCACAOPoolWithdrawQueued) String() string { return proto.CompactTextString(m) }
+func (*EventCACAOPoolWithdrawQueued) ProtoMessage()    {}
+func (*EventCACAOPoolWithdrawQueued) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd2486ffd24912c2, []int{40}
+}
+func (m *EventCACAOPoolWithdrawQueued) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCACAOPoolWithdrawQueued) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCACAOPoolWithdrawQueued.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCACAOPoolWithdrawQueued) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCACAOPoolWithdrawQueued.Merge(m, src)
+}
+func (m *EventCACAOPoolWithdrawQueued) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCACAOPoolWithdrawQueued) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCACAOPoolWithdrawQueued.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCACAOPoolWithdrawQueued proto.InternalMessageInfo
+
+func (m *EventCACAOPoolWithdrawQueued) GetCacaoAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.CacaoAddress
+	}
+	return nil
+}
+
+func (m *EventCACAOPoolWithdrawQueued) GetBasisPoints() int64 {
+	if m != nil {
+		return m.BasisPoints
+	}
+	return 0
+}
+
+func (m *EventCACAOPoolWithdrawQueued) GetMatureHeight() int64 {
+	if m != nil {
+		return m.MatureHeight
+	}
+	return 0
+}
+
+func (m *EventCACAOPoolWithdrawQueued) GetTxId() gitlab_com_mayachain_mayanode_common.TxID {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+type EventCACAOPoolCompound struct {
+	CacaoAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=cacao_address,json=cacaoAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"cacao_address,omitempty"`
+	CacaoAmount  github_com_cosmos_cosmos_sdk_types.Uint       `protobuf:"bytes,2,opt,name=cacao_amount,json=cacaoAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"cacao_amount"`
+}
+
+func (m *EventCACAOPoolCompound) Reset()         { *m = EventCACAOPoolCompound{} }
+func (m *EventCACAOPoolCompound) String() string { return proto.CompactTextString(m) }
+func (*EventCACAOPoolCompound) ProtoMessage()    {}
+func (*EventCACAOPoolCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd2486ffd24912c2, []int{41}
+}
+func (m *EventCACAOPoolCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCACAOPoolCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCACAOPoolCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCACAOPoolCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCACAOPoolCompound.Merge(m, src)
+}
+func (m *EventCACAOPoolCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCACAOPoolCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCACAOPoolCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCACAOPoolCompound proto.InternalMessageInfo
+
+func (m *EventCACAOPoolCompound) GetCacaoAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.CacaoAddress
+	}
+	return nil
+}
+
 type EventTradeAccountDeposit struct {
 	Amount       github_com_cosmos_cosmos_sdk_types.Uint      `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"amount"`
 	Asset        common.Asset                                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset"`
@@ -2621,7 +2734,7 @@ func (m *EventTradeAccountDeposit) Reset()         { *m = EventTradeAccountDepos
 func (m *EventTradeAccountDeposit) String() string { return proto.CompactTextString(m) }
 func (*EventTradeAccountDeposit) ProtoMessage()    {}
 func (*EventTradeAccountDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd2486ffd24912c2, []int{40}
+	return fileDescriptor_dd2486ffd24912c2, []int{42}
 }
 func (m *EventTradeAccountDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2690,7 +2803,7 @@ func (m *EventTradeAccountWithdraw) Reset()         { *m = EventTradeAccountWith
 func (m *EventTradeAccountWithdraw) String() string { return proto.CompactTextString(m) }
 func (*EventTradeAccountWithdraw) ProtoMessage()    {}
 func (*EventTradeAccountWithdraw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd2486ffd24912c2, []int{41}
+	return fileDescriptor_dd2486ffd24912c2, []int{43}
 }
 func (m *EventTradeAccountWithdraw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2747,1570 +2860,1802 @@ func (m *EventTradeAccountWithdraw) GetTxID() gitlab_com_mayachain_mayanode_comm
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("types.PendingLiquidityType", PendingLiquidityType_name, PendingLiquidityType_value)
-	proto.RegisterEnum("types.BondType", BondType_name, BondType_value)
-	proto.RegisterType((*PoolMod)(nil), "types.PoolMod")
-	proto.RegisterType((*EventStreamingSwap)(nil), "types.EventStreamingSwap")
-	proto.RegisterType((*EventSwap)(nil), "types.EventSwap")
-	proto.RegisterType((*EventAffiliateFee)(nil), "types.EventAffiliateFee")
-	proto.RegisterType((*EventAddLiquidity)(nil), "types.EventAddLiquidity")
-	proto.RegisterType((*EventWithdraw)(nil), "types.EventWithdraw")
-	proto.RegisterType((*EventPendingLiquidity)(nil), "types.EventPendingLiquidity")
-	proto.RegisterType((*EventDonate)(nil), "types.EventDonate")
-	proto.RegisterType((*EventPool)(nil), "types.EventPool")
-	proto.RegisterType((*PoolAmt)(nil), "types.PoolAmt")
-	proto.RegisterType((*EventRewardsV1)(nil), "types.EventRewardsV1")
-	proto.RegisterType((*EventRewards)(nil), "types.EventRewards")
-	proto.RegisterType((*EventRefund)(nil), "types.EventRefund")
-	proto.RegisterType((*EventBond)(nil), "types.EventBond")
-	proto.RegisterType((*EventBondV105)(nil), "types.EventBondV105")
-	proto.RegisterType((*GasPool)(nil), "types.GasPool")
-	proto.RegisterType((*EventGas)(nil), "types.EventGas")
-	proto.RegisterType((*EventReserve)(nil), "types.EventReserve")
-	proto.RegisterType((*EventScheduledOutbound)(nil), "types.EventScheduledOutbound")
-	proto.RegisterType((*EventSecurity)(nil), "types.EventSecurity")
-	proto.RegisterType((*EventSlash)(nil), "types.EventSlash")
-	proto.RegisterType((*EventSlashLiquidity)(nil), "types.EventSlashLiquidity")
-	proto.RegisterType((*EventErrata)(nil), "types.EventErrata")
-	proto.RegisterType((*EventFee)(nil), "types.EventFee")
-	proto.RegisterType((*EventOutbound)(nil), "types.EventOutbound")
-	proto.RegisterType((*EventTssKeygenSuccess)(nil), "types.EventTssKeygenSuccess")
-	proto.RegisterType((*EventTssKeygenFailure)(nil), "types.EventTssKeygenFailure")
-	proto.RegisterType((*EventTssKeygenMetric)(nil), "types.EventTssKeygenMetric")
-	proto.RegisterType((*EventTssKeysignMetric)(nil), "types.EventTssKeysignMetric")
-	proto.RegisterType((*EventSlashPoint)(nil), "types.EventSlashPoint")
-	proto.RegisterType((*EventPoolBalanceChanged)(nil), "types.EventPoolBalanceChanged")
-	proto.RegisterType((*EventSwitch)(nil), "types.EventSwitch")
-	proto.RegisterType((*EventSwitchV87)(nil), "types.EventSwitchV87")
-	proto.RegisterType((*EventMAYANameV111)(nil), "types.EventMAYANameV111")
-	proto.RegisterType((*EventMAYAName)(nil), "types.EventMAYAName")
-	proto.RegisterType((*EventSetMimir)(nil), "types.EventSetMimir")
-	proto.RegisterType((*EventSetNodeMimir)(nil), "types.EventSetNodeMimir")
-	proto.RegisterType((*EventCACAOPoolDeposit)(nil), "types.EventCACAOPoolDeposit")
-	proto.RegisterType((*EventCACAOPoolWithdrawV118)(nil), "types.EventCACAOPoolWithdrawV118")
-	proto.RegisterType((*EventCACAOPoolWithdraw)(nil), "types.EventCACAOPoolWithdraw")
-	proto.RegisterType((*EventTradeAccountDeposit)(nil), "types.EventTradeAccountDeposit")
-	proto.RegisterType((*EventTradeAccountWithdraw)(nil), "types.EventTradeAccountWithdraw")
+type EventTradeAccountTransfer struct {
+	Amount      github_com_cosmos_cosmos_sdk_types.Uint      `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"amount"`
+	Asset       common.Asset                                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset"`
+	FromAddress gitlab_com_mayachain_mayanode_common.Address `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3,casttype=gitlab.com/mayachain/mayanode/common.Address" json:"from_address,omitempty"`
+	ToAddress   gitlab_com_mayachain_mayanode_common.Address `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3,casttype=gitlab.com/mayachain/mayanode/common.Address" json:"to_address,omitempty"`
+	Units       github_com_cosmos_cosmos_sdk_types.Uint      `protobuf:"bytes,5,opt,name=units,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"units"`
+	TxID        gitlab_com_mayachain_mayanode_common.TxID    `protobuf:"bytes,6,opt,name=tx_id,json=txId,proto3,casttype=gitlab.com/mayachain/mayanode/common.TxID" json:"tx_id,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("mayachain/v1/x/mayachain/types/type_events.proto", fileDescriptor_dd2486ffd24912c2)
+func (m *EventTradeAccountTransfer) Reset()         { *m = EventTradeAccountTransfer{} }
+func (m *EventTradeAccountTransfer) String() string { return proto.CompactTextString(m) }
+func (*EventTradeAccountTransfer) ProtoMessage()    {}
+func (*EventTradeAccountTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd2486ffd24912c2, []int{44}
 }
-
-var fileDescriptor_dd2486ffd24912c2 = []byte{
-	// 2714 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcb, 0x6f, 0x1b, 0xc7,
-	0x19, 0x37, 0xb9, 0x7c, 0x7e, 0xa4, 0x2c, 0x6a, 0xec, 0x38, 0x8c, 0x83, 0x8a, 0xca, 0xa6, 0x4d,
-	0x64, 0xc7, 0x96, 0x2c, 0x17, 0x89, 0xd3, 0x02, 0x2d, 0x40, 0x4a, 0xb1, 0x23, 0xc7, 0x72, 0x94,
-	0x95, 0xac, 0x22, 0x2e, 0x82, 0xc5, 0x72, 0x77, 0x44, 0x0d, 0xcc, 0x7d, 0x64, 0x67, 0x56, 0xa2,
-	0xee, 0x2d, 0xfa, 0x00, 0xfa, 0x42, 0x8f, 0x3d, 0xb5, 0x87, 0xa2, 0xe9, 0x3f, 0xd0, 0x7b, 0x4f,
-	0xb9, 0xb4, 0x48, 0x6e, 0x41, 0x0f, 0x6a, 0xab, 0x00, 0x3d, 0x15, 0x45, 0xcf, 0x3e, 0x14, 0xc5,
-	0x3c, 0x76, 0x49, 0x8a, 0x91, 0x42, 0xad, 0x98, 0x26, 0x41, 0x7d, 0x91, 0x76, 0x5e, 0xbf, 0xf9,
-	0xe6, 0x7b, 0xcf, 0x37, 0x84, 0x1b, 0xae, 0xb5, 0x6f, 0xd9, 0x3b, 0x16, 0xf1, 0x16, 0x77, 0x97,
-	0x16, 0x7b, 0x8b, 0xfd, 0x26, 0xdb, 0x0f, 0x30, 0x15, 0x7f, 0x4d, 0xbc, 0x8b, 0x3d, 0x46, 0x17,
-	0x82, 0xd0, 0x67, 0x3e, 0xca, 0x8b, 0x81, 0xcb, 0x73, 0x43, 0x0b, 0x6d, 0xdf, 0x75, 0x7d, 0x4f,
-	0xfd, 0x93, 0x13, 0x2f, 0x2f, 0x8c, 0x03, 0x1d, 0xf8, 0x7e, 0x57, 0xcd, 0xff, 0xd6, 0x38, 0xf3,
-	0x43, 0x4c, 0x71, 0xb8, 0x8b, 0x4d, 0xdb, 0xf7, 0x58, 0x48, 0xda, 0x11, 0xf3, 0x43, 0xb5, 0x7c,
-	0xac, 0x93, 0xb0, 0x9e, 0xe9, 0x47, 0x4c, 0xad, 0xb8, 0xd8, 0xf1, 0x3b, 0xbe, 0xf8, 0x5c, 0xe4,
-	0x5f, 0xb2, 0x57, 0xff, 0x71, 0x16, 0x8a, 0xeb, 0xbe, 0xdf, 0x5d, 0xf3, 0x1d, 0x74, 0x05, 0xf2,
-	0x16, 0xa5, 0x98, 0xd5, 0x33, 0x73, 0x99, 0xf9, 0xca, 0xcd, 0xa9, 0x05, 0x75, 0xc0, 0x26, 0xef,
-	0x6c, 0xe5, 0xde, 0x3f, 0x68, 0x9c, 0x33, 0xe4, 0x0c, 0x74, 0x0f, 0xca, 0xb6, 0x65, 0x5b, 0xbe,
-	0x69, 0xb9, 0xac, 0x9e, 0x9d, 0xcb, 0xcc, 0x97, 0x5b, 0x8b, 0x7c, 0xfc, 0x2f, 0x07, 0x8d, 0x17,
-	0x3b, 0x84, 0xed, 0x44, 0x6d, 0xbe, 0x78, 0xd1, 0xf6, 0xa9, 0xeb, 0x53, 0xf5, 0xef, 0x3a, 0x75,
-	0x1e, 0x49, 0xea, 0x16, 0x1e, 0x10, 0x8f, 0x19, 0x25, 0x81, 0xd0, 0x74, 0x19, 0x7a, 0x36, 0x41,
-	0x73, 0x9c, 0xba, 0x36, 0x97, 0x99, 0x2f, 0xc5, 0x83, 0x8e, 0xc3, 0xb7, 0x12, 0x7b, 0x8a, 0xad,
-	0x72, 0x29, 0xb7, 0x12, 0x08, 0x6a, 0x2b, 0x85, 0xe6, 0x38, 0xf5, 0xbc, 0xdc, 0x4a, 0x0e, 0x3a,
-	0x8e, 0xfe, 0x2f, 0x0d, 0xd0, 0x6b, 0x5c, 0xfa, 0x1b, 0x2c, 0xc4, 0x96, 0x4b, 0xbc, 0xce, 0xc6,
-	0x9e, 0x15, 0xa0, 0xbb, 0x90, 0x67, 0x3d, 0x93, 0x38, 0x82, 0x2f, 0xe5, 0xd6, 0xcb, 0x87, 0x07,
-	0x8d, 0xdc, 0x66, 0x6f, 0x75, 0xe5, 0xf1, 0x41, 0xe3, 0x4a, 0x87, 0xb0, 0xae, 0x25, 0x29, 0xe8,
-	0x8b, 0x80, 0x7f, 0x79, 0xbe, 0x83, 0x63, 0x0d, 0xe1, 0x93, 0x8d, 0x1c, 0xeb, 0xad, 0x3a, 0xe8,
-	0x32, 0x94, 0x88, 0xc7, 0x70, 0xb8, 0x6b, 0x75, 0x05, 0xdf, 0x72, 0x46, 0xd2, 0xe6, 0x63, 0xef,
-	0x46, 0x96, 0xc7, 0x08, 0xdb, 0x17, 0x5c, 0xc8, 0x19, 0x49, 0x1b, 0x5d, 0x84, 0xbc, 0xed, 0x47,
-	0x9e, 0xe4, 0x40, 0xce, 0x90, 0x0d, 0xd4, 0x80, 0x4a, 0xd7, 0xa2, 0xcc, 0xdc, 0xc1, 0xa4, 0xb3,
-	0xc3, 0xc4, 0x79, 0x34, 0x03, 0x78, 0xd7, 0xeb, 0xa2, 0x07, 0x19, 0x50, 0x65, 0xa1, 0xe5, 0x60,
-	0x93, 0x59, 0x61, 0x07, 0xb3, 0x7a, 0x21, 0x1d, 0xff, 0x2a, 0x02, 0x64, 0x53, 0x60, 0xa0, 0x6b,
-	0x50, 0x74, 0x70, 0xe0, 0x53, 0xc2, 0xea, 0x45, 0xa1, 0x28, 0xd5, 0x58, 0x51, 0x96, 0x7d, 0xe2,
-	0x29, 0x3d, 0x89, 0xa7, 0x20, 0x1d, 0xb2, 0xc4, 0xab, 0x97, 0x8e, 0x9d, 0x98, 0x25, 0x1e, 0xfa,
-	0x2a, 0x68, 0x7e, 0xc4, 0xea, 0xe5, 0x63, 0x27, 0xf1, 0x61, 0xf4, 0x1c, 0x54, 0xb7, 0x2d, 0xd2,
-	0xc5, 0x8e, 0x49, 0xf7, 0xac, 0x80, 0xd6, 0x61, 0x4e, 0x9b, 0xcf, 0x19, 0x15, 0xd9, 0xc7, 0x05,
-	0x45, 0xd1, 0x02, 0x5c, 0x18, 0x98, 0x62, 0x86, 0xd8, 0xa2, 0xbe, 0x47, 0xeb, 0x95, 0x39, 0x6d,
-	0xbe, 0x6c, 0xcc, 0xf4, 0x67, 0x1a, 0x72, 0x40, 0xff, 0x30, 0x0f, 0x65, 0x29, 0x70, 0x2e, 0xe7,
-	0x17, 0x21, 0xc7, 0x0d, 0xf4, 0x24, 0xf5, 0x17, 0x13, 0xd0, 0x3a, 0x54, 0x04, 0xbe, 0x62, 0x6a,
-	0x4a, 0xfd, 0x07, 0x8e, 0xa1, 0x78, 0x7a, 0x0f, 0xca, 0x02, 0x91, 0x76, 0x49, 0x20, 0x64, 0x9f,
-	0x46, 0xc9, 0x39, 0xc2, 0x46, 0x97, 0x04, 0x68, 0x13, 0xa6, 0xba, 0xe4, 0xdd, 0x88, 0x38, 0x84,
-	0xed, 0x9b, 0xdb, 0x18, 0xa7, 0x35, 0x9b, 0x6a, 0x82, 0x72, 0x1b, 0x63, 0xe4, 0xc0, 0xa5, 0x21,
-	0x54, 0x93, 0x78, 0xa6, 0xb0, 0x52, 0xa1, 0x77, 0x29, 0xe0, 0x2f, 0x0c, 0xc2, 0xaf, 0x7a, 0xcb,
-	0x1c, 0x0b, 0x7d, 0x0d, 0xf2, 0xc4, 0x33, 0x59, 0x4f, 0xa8, 0x6a, 0xe5, 0x26, 0x2c, 0x24, 0x36,
-	0x14, 0x8b, 0x80, 0x78, 0x9b, 0x3d, 0x74, 0x05, 0x8a, 0x7e, 0xc4, 0x4c, 0xd6, 0xa3, 0x4a, 0x09,
-	0x47, 0x27, 0x16, 0xfc, 0x88, 0x6d, 0xf6, 0x28, 0x5a, 0x02, 0xc0, 0x2e, 0x61, 0xa6, 0xf4, 0x6d,
-	0xc7, 0x6b, 0x62, 0x99, 0xcf, 0x12, 0xc2, 0x16, 0x02, 0xde, 0xf7, 0xd8, 0x8e, 0x19, 0x79, 0x84,
-	0x51, 0xa1, 0x98, 0xa9, 0x04, 0xcc, 0x31, 0x1e, 0x70, 0x08, 0xf4, 0x0a, 0x3c, 0x4d, 0x63, 0xa7,
-	0x22, 0x95, 0x33, 0x31, 0x75, 0x10, 0x16, 0xfd, 0x14, 0x1d, 0xf4, 0x39, 0x6f, 0xc5, 0x76, 0x7f,
-	0x03, 0x2e, 0x1e, 0x59, 0x27, 0xdd, 0x40, 0x45, 0x2c, 0x42, 0x43, 0x8b, 0x96, 0xf9, 0x88, 0xfe,
-	0xb3, 0x1c, 0xcc, 0x08, 0x9d, 0x6e, 0x6e, 0x6f, 0x93, 0x2e, 0xb1, 0x18, 0xe6, 0xc2, 0x9b, 0xa4,
-	0x0f, 0x43, 0x90, 0x73, 0xb1, 0xeb, 0x4b, 0xbd, 0x37, 0xc4, 0x37, 0xf7, 0x5d, 0x62, 0x85, 0xe5,
-	0x62, 0xa9, 0xbf, 0x46, 0xd2, 0x46, 0x0f, 0x60, 0x2a, 0x71, 0xef, 0x21, 0xa6, 0x54, 0xa9, 0xe3,
-	0x8d, 0xc7, 0x07, 0x8d, 0x6b, 0x63, 0xed, 0xdd, 0x94, 0xeb, 0x8c, 0x6a, 0x1c, 0x14, 0x78, 0xab,
-	0x1f, 0xae, 0xf2, 0x9f, 0x1a, 0xae, 0x0c, 0xa8, 0x76, 0x42, 0x9f, 0x52, 0xd3, 0x72, 0x05, 0xf7,
-	0xd2, 0xba, 0x41, 0x01, 0xd2, 0x14, 0x18, 0x68, 0x0e, 0xaa, 0xdc, 0x08, 0xda, 0x01, 0x35, 0x19,
-	0xb1, 0x1f, 0x09, 0x35, 0xcc, 0x19, 0xb0, 0x8d, 0x71, 0x2b, 0xa0, 0x9b, 0xc4, 0x7e, 0x84, 0xee,
-	0x03, 0x6f, 0xc5, 0x7b, 0x96, 0xd2, 0xed, 0x59, 0xde, 0xc6, 0x58, 0xed, 0x78, 0x09, 0x0a, 0x81,
-	0x15, 0x62, 0x4f, 0x7a, 0xca, 0xb2, 0xa1, 0x5a, 0x68, 0x16, 0x2a, 0x34, 0x6a, 0x9b, 0x8a, 0x1a,
-	0xa5, 0x4f, 0x65, 0x1a, 0xb5, 0x6f, 0x0b, 0x5a, 0xf4, 0x5f, 0xe7, 0x63, 0x8d, 0x70, 0x9c, 0x7b,
-	0xb1, 0xc9, 0x8d, 0xef, 0xed, 0xb6, 0xe0, 0x7c, 0x10, 0xfa, 0xbb, 0xc4, 0xc1, 0xa1, 0xb2, 0x87,
-	0x94, 0x0e, 0x6f, 0x2a, 0x86, 0x91, 0x26, 0x31, 0xa2, 0x16, 0xda, 0x44, 0xd4, 0xc2, 0x80, 0x6a,
-	0x9c, 0x9a, 0x24, 0x01, 0x33, 0x8d, 0xac, 0x55, 0x76, 0x22, 0x38, 0x6f, 0x40, 0x35, 0xce, 0x41,
-	0x04, 0x66, 0x4a, 0x87, 0x57, 0x51, 0x69, 0x88, 0xc0, 0x7c, 0x1b, 0xe4, 0x16, 0xa6, 0xb4, 0x4b,
-	0xa9, 0x92, 0xdf, 0x38, 0x3c, 0x68, 0x94, 0x8c, 0xc8, 0xc3, 0xa7, 0xb7, 0x4d, 0x99, 0x42, 0x6d,
-	0x72, 0x03, 0x7d, 0x08, 0x72, 0x27, 0x05, 0x5d, 0x14, 0xd0, 0xdf, 0x3c, 0x3c, 0x68, 0x94, 0x85,
-	0x74, 0x53, 0x60, 0x5b, 0x6a, 0x9d, 0xc3, 0xa5, 0x96, 0x24, 0x50, 0x42, 0x6a, 0xa5, 0xb4, 0x52,
-	0x8b, 0xd3, 0x2e, 0xde, 0xd2, 0xdf, 0xcb, 0xc1, 0x94, 0xd0, 0xd1, 0xef, 0x10, 0xb6, 0xe3, 0x84,
-	0xd6, 0xde, 0xe7, 0xaf, 0x9f, 0xcf, 0x41, 0xb5, 0x6d, 0x51, 0x42, 0xcd, 0xc0, 0x27, 0x1e, 0x93,
-	0xea, 0xa9, 0x19, 0x15, 0xd1, 0xb7, 0x2e, 0xba, 0x64, 0x6e, 0xba, 0xef, 0xba, 0x98, 0x85, 0xfb,
-	0x42, 0xd1, 0xaa, 0xad, 0x05, 0xb5, 0xeb, 0x0b, 0x63, 0xec, 0xba, 0x82, 0x6d, 0xa3, 0x0f, 0xd0,
-	0x0f, 0x7d, 0xf9, 0x13, 0x43, 0xdf, 0xfd, 0xa1, 0x78, 0x96, 0xd2, 0x95, 0x0d, 0x04, 0xbb, 0x18,
-	0x4f, 0xc6, 0xf2, 0xe2, 0x19, 0xf0, 0x64, 0x04, 0x37, 0xe1, 0x02, 0x71, 0x03, 0xb3, 0xcb, 0xfd,
-	0x2d, 0xbf, 0x64, 0x60, 0x9b, 0x11, 0xdf, 0x4b, 0xeb, 0xff, 0x66, 0x88, 0x1b, 0xdc, 0xf3, 0x29,
-	0x5d, 0x4f, 0x90, 0xf4, 0x9f, 0xe4, 0xe1, 0x29, 0xa1, 0x2b, 0xeb, 0xd8, 0x73, 0x88, 0xd7, 0x49,
-	0xe1, 0xd3, 0xbe, 0x0d, 0xd5, 0x40, 0x2e, 0x36, 0xf9, 0x5e, 0x42, 0x63, 0xce, 0xdf, 0x7c, 0x76,
-	0x41, 0x6e, 0x7c, 0x14, 0x77, 0x73, 0x3f, 0xc0, 0x46, 0x45, 0x2d, 0xe0, 0x8d, 0x2f, 0x93, 0xef,
-	0x1a, 0x31, 0xd8, 0xfc, 0x24, 0x0c, 0x76, 0xc4, 0x25, 0x16, 0x26, 0xef, 0x12, 0x8b, 0x9f, 0x9d,
-	0x4b, 0x2c, 0x4d, 0xd0, 0x25, 0xea, 0xef, 0x40, 0x45, 0xa8, 0xe3, 0x8a, 0xef, 0x59, 0x0c, 0x8f,
-	0xaf, 0x84, 0x89, 0xbd, 0x67, 0x4f, 0xb2, 0x77, 0xdd, 0x54, 0x77, 0x14, 0x7e, 0x4d, 0x1f, 0x1f,
-	0xfc, 0x0a, 0x14, 0x36, 0x98, 0xc5, 0x22, 0xaa, 0x74, 0x7b, 0x26, 0xd6, 0x6d, 0xdf, 0xef, 0xca,
-	0x01, 0x43, 0x4d, 0xd0, 0xef, 0xc9, 0x12, 0x00, 0xbf, 0x1e, 0x9f, 0xa2, 0x04, 0x70, 0x09, 0x0a,
-	0x4a, 0xf4, 0x59, 0xe1, 0x18, 0x55, 0x4b, 0xff, 0x55, 0x06, 0xce, 0x0b, 0x7a, 0x0d, 0xbc, 0x67,
-	0x85, 0x0e, 0xdd, 0x5a, 0xe2, 0xe9, 0x74, 0xdb, 0xf7, 0x1c, 0x33, 0x14, 0x3d, 0x2a, 0x05, 0x3d,
-	0x7d, 0x3a, 0xcd, 0x31, 0x24, 0x28, 0xba, 0x05, 0x55, 0x7e, 0x4a, 0x85, 0xc8, 0xcf, 0xa8, 0xcd,
-	0x57, 0x6e, 0x9e, 0x1f, 0x38, 0x63, 0xd3, 0x8d, 0xe9, 0xad, 0xf0, 0x99, 0x8a, 0x18, 0xfd, 0xc3,
-	0x2c, 0x54, 0x07, 0xa9, 0xfb, 0x02, 0xd1, 0x86, 0xbe, 0x0b, 0x33, 0x52, 0xfd, 0x07, 0x96, 0xa7,
-	0xbd, 0x0c, 0x4e, 0x0b, 0xa4, 0xf5, 0x04, 0x1d, 0xbd, 0x0d, 0x35, 0xae, 0xc7, 0xe6, 0x76, 0xd4,
-	0x3f, 0x6c, 0x4a, 0xf7, 0x72, 0x9e, 0x03, 0xdd, 0x8e, 0xe2, 0x03, 0xeb, 0xdf, 0xcf, 0x28, 0x03,
-	0x30, 0x30, 0x47, 0xe7, 0xf7, 0x03, 0xdb, 0x77, 0xb0, 0xe0, 0xe5, 0x94, 0x21, 0xbe, 0xb9, 0xb6,
-	0xc8, 0xdb, 0xb8, 0xba, 0x35, 0xa8, 0x56, 0xdf, 0x06, 0xb4, 0x13, 0x63, 0xde, 0xf3, 0xa0, 0xc5,
-	0xf7, 0xd8, 0xca, 0xcd, 0x4a, 0x3c, 0x89, 0xe7, 0xb7, 0xaa, 0x40, 0xb0, 0x8d, 0xb1, 0xfe, 0x5e,
-	0x46, 0x59, 0x4a, 0xcb, 0xf7, 0x1c, 0x74, 0x27, 0xd1, 0xcf, 0x94, 0x32, 0x55, 0xcb, 0xd1, 0x35,
-	0x28, 0x0b, 0x0d, 0x19, 0x08, 0x14, 0xd3, 0x4a, 0x98, 0x7c, 0x23, 0x11, 0x1c, 0x4a, 0x6d, 0xf5,
-	0xc5, 0x0f, 0xc4, 0x5d, 0x8c, 0x77, 0xfc, 0x81, 0x58, 0x6f, 0xd5, 0xd3, 0x3f, 0xca, 0xa8, 0x7c,
-	0x87, 0x43, 0x6c, 0x2d, 0xdd, 0x78, 0xf9, 0x8b, 0x4d, 0x6f, 0xdf, 0x31, 0xe4, 0x3e, 0xcd, 0x31,
-	0xe8, 0xff, 0xc8, 0x40, 0xf1, 0x8e, 0x45, 0xd7, 0xa5, 0x17, 0xfa, 0x9c, 0x4a, 0x8a, 0x43, 0x55,
-	0x43, 0xed, 0xac, 0x55, 0xc3, 0xa1, 0xea, 0x9b, 0xa6, 0xaa, 0x6f, 0xfa, 0x2b, 0x50, 0x12, 0x22,
-	0xbc, 0x63, 0x51, 0x74, 0x15, 0xf2, 0xdc, 0x6a, 0x69, 0x3d, 0x33, 0x64, 0xed, 0x8a, 0x0f, 0xf1,
-	0x49, 0xc5, 0x14, 0xfd, 0x07, 0x99, 0xc4, 0x07, 0x89, 0xf2, 0x2e, 0x5a, 0x87, 0x0b, 0x9f, 0x50,
-	0xe9, 0x55, 0x3c, 0x7b, 0x46, 0x41, 0xa9, 0xc9, 0xcb, 0xfd, 0x09, 0x0a, 0x15, 0x85, 0x23, 0x23,
-	0xe3, 0x86, 0x96, 0x3b, 0x70, 0x49, 0x96, 0xbf, 0xec, 0x1d, 0xec, 0x44, 0x5d, 0xec, 0xbc, 0x19,
-	0xb1, 0xb6, 0xcf, 0x6d, 0xf8, 0x3a, 0x14, 0x64, 0x7d, 0x45, 0x51, 0x51, 0x53, 0x54, 0x6c, 0xf6,
-	0xde, 0x8c, 0xd8, 0x2a, 0xc3, 0x6e, 0x7c, 0x24, 0x51, 0x64, 0xd1, 0x97, 0x95, 0x36, 0x6f, 0x60,
-	0x3b, 0x0a, 0x79, 0x26, 0x56, 0x03, 0xcd, 0xa5, 0x1d, 0xa9, 0xca, 0x06, 0xff, 0x44, 0x73, 0x90,
-	0x3d, 0x81, 0x9e, 0x2c, 0xeb, 0xe9, 0x1e, 0x80, 0x04, 0xe9, 0x5a, 0x74, 0x67, 0xfc, 0x48, 0x77,
-	0x0b, 0xaa, 0x94, 0xaf, 0x30, 0x93, 0x70, 0x74, 0x82, 0xbf, 0x15, 0x33, 0x65, 0xba, 0xa1, 0xff,
-	0x21, 0x0b, 0x17, 0xfa, 0x1b, 0xf6, 0xb3, 0xc8, 0x77, 0x60, 0x86, 0x87, 0x7b, 0x53, 0x58, 0x51,
-	0x9c, 0x35, 0x65, 0x44, 0x76, 0xbf, 0xf4, 0xf8, 0xa0, 0x71, 0x7d, 0x0c, 0xfd, 0x69, 0xda, 0x76,
-	0x9c, 0x36, 0x4d, 0x73, 0x2c, 0x6e, 0x78, 0x23, 0x75, 0x8b, 0xec, 0xa7, 0xda, 0xc4, 0x5d, 0x28,
-	0x9e, 0x35, 0xc1, 0x8c, 0x01, 0xd0, 0x5d, 0x28, 0x75, 0x03, 0x75, 0x41, 0x4a, 0xe9, 0xf8, 0x8b,
-	0xdd, 0x40, 0x5c, 0x8d, 0xf4, 0x5f, 0xc4, 0x1e, 0xff, 0xb5, 0x30, 0xb4, 0x98, 0x35, 0xd1, 0xea,
-	0xd2, 0x2b, 0xb1, 0x25, 0x8d, 0xca, 0x71, 0xcd, 0x77, 0x5a, 0x35, 0x4e, 0xf4, 0xef, 0xff, 0xda,
-	0x28, 0xa9, 0x0e, 0x1a, 0x5b, 0xd5, 0x9f, 0x32, 0xca, 0x1c, 0x27, 0x5d, 0xee, 0x52, 0xb1, 0x27,
-	0x7b, 0x52, 0xec, 0x39, 0x5a, 0x31, 0xd4, 0xce, 0x5c, 0x31, 0xd4, 0xbf, 0x17, 0x47, 0x88, 0xc4,
-	0x26, 0xdf, 0x82, 0x92, 0x30, 0xea, 0xfe, 0xb9, 0x6e, 0x1d, 0x1e, 0x34, 0x0a, 0xab, 0xde, 0xe9,
-	0x4f, 0x56, 0xe0, 0xe6, 0xbf, 0xea, 0x8c, 0x61, 0x94, 0xbf, 0xcc, 0xa8, 0xcb, 0xd6, 0x26, 0xa5,
-	0x6f, 0xe0, 0xfd, 0x0e, 0xf6, 0x36, 0x22, 0xdb, 0xe6, 0x0a, 0xf5, 0x3a, 0x14, 0x83, 0xa8, 0x6d,
-	0x3e, 0xc2, 0xfb, 0x71, 0xc4, 0x7a, 0x7c, 0xd0, 0x78, 0x69, 0x2c, 0x1a, 0xd6, 0xa3, 0xf6, 0x1b,
-	0x78, 0xdf, 0x28, 0x04, 0xe2, 0x3f, 0xaa, 0x43, 0xd1, 0xc5, 0x6e, 0x1b, 0x87, 0x52, 0xe8, 0x65,
-	0x23, 0x6e, 0xf2, 0xb4, 0x41, 0xbd, 0x6d, 0xc8, 0xdb, 0xb7, 0x6a, 0xe9, 0xbf, 0x1d, 0xa1, 0xea,
-	0xb6, 0x45, 0xba, 0x51, 0x88, 0x51, 0x03, 0xc4, 0x8b, 0x80, 0xaa, 0xfd, 0x2b, 0x07, 0x04, 0xbc,
-	0x4b, 0x16, 0xfd, 0xd1, 0x57, 0x00, 0x08, 0xe5, 0x62, 0xb2, 0x2d, 0x2a, 0x6d, 0xb0, 0x64, 0x94,
-	0x09, 0x7d, 0x20, 0x3b, 0xf8, 0xfa, 0x76, 0xd7, 0x72, 0xb1, 0xc9, 0xe9, 0xe5, 0x82, 0xe4, 0xf4,
-	0x80, 0xe8, 0xba, 0xcf, 0x7b, 0x78, 0x2c, 0x08, 0xb9, 0x38, 0xa4, 0x11, 0x19, 0xb2, 0x31, 0x40,
-	0x68, 0x7e, 0x88, 0xd0, 0x9f, 0x66, 0xe0, 0xe2, 0x30, 0xa1, 0x6b, 0x98, 0x85, 0xc4, 0x9e, 0x20,
-	0xf7, 0xae, 0x01, 0x72, 0xb1, 0x43, 0x2c, 0xcf, 0x74, 0xa2, 0xd0, 0xe2, 0x37, 0x64, 0xd3, 0xa5,
-	0x2a, 0x29, 0xaf, 0xc9, 0x91, 0x15, 0x35, 0xb0, 0x26, 0x4c, 0x77, 0x90, 0x73, 0x94, 0x74, 0x62,
-	0x8a, 0x26, 0x69, 0x33, 0xa7, 0xa3, 0xe9, 0x37, 0x19, 0x98, 0xee, 0x3b, 0x62, 0x51, 0x5b, 0x41,
-	0x9b, 0x50, 0x15, 0x4e, 0xf8, 0xcc, 0xfe, 0xb7, 0xc2, 0x61, 0x62, 0xdf, 0xfb, 0x5c, 0x1c, 0x2b,
-	0x54, 0x4d, 0x47, 0x52, 0x24, 0xa3, 0x82, 0xaa, 0xe9, 0xf4, 0x33, 0x55, 0x6d, 0x30, 0x53, 0xd5,
-	0x77, 0xe0, 0xe9, 0xe4, 0x1a, 0xd6, 0xb2, 0xba, 0x96, 0x67, 0xe3, 0xe5, 0x1d, 0xcb, 0xeb, 0x60,
-	0x07, 0xbd, 0x0c, 0x22, 0x8f, 0x37, 0x6d, 0xd1, 0x56, 0x11, 0xeb, 0xa8, 0xe3, 0x92, 0x26, 0x05,
-	0x7c, 0xa2, 0x5c, 0x77, 0x5c, 0x4e, 0xac, 0xff, 0x2e, 0xab, 0xbc, 0xeb, 0xc6, 0x1e, 0x61, 0xf6,
-	0x0e, 0x5a, 0x07, 0x60, 0xfe, 0xd9, 0x19, 0x51, 0x66, 0x49, 0x9d, 0x61, 0x03, 0xaa, 0xdb, 0xa1,
-	0xef, 0x26, 0x98, 0xd9, 0x94, 0xc1, 0xa5, 0xc2, 0x51, 0x62, 0xd0, 0x17, 0x20, 0xd7, 0x8e, 0xc2,
-	0x38, 0x91, 0xfc, 0xa4, 0x17, 0x16, 0x31, 0xde, 0xd7, 0xb3, 0xdc, 0x99, 0xf5, 0x4c, 0xff, 0x77,
-	0x56, 0x5d, 0x36, 0x25, 0xab, 0xb6, 0x5e, 0xbd, 0xf5, 0x84, 0x5b, 0xc7, 0x5b, 0xe5, 0x32, 0xe4,
-	0x5c, 0x92, 0xbe, 0x7c, 0x2d, 0x16, 0xeb, 0x7f, 0xd4, 0xd4, 0x6b, 0xc2, 0x5a, 0xf3, 0xed, 0xe6,
-	0x7d, 0xcb, 0xc5, 0x5b, 0x4b, 0x4b, 0x4b, 0xfc, 0xce, 0x27, 0xde, 0x7e, 0xa4, 0xbf, 0x15, 0xdf,
-	0x68, 0x05, 0xf2, 0x82, 0x24, 0xc5, 0xb0, 0x85, 0xc7, 0x07, 0x8d, 0xab, 0x63, 0x91, 0xbc, 0xcc,
-	0x7b, 0x0d, 0xb9, 0x78, 0xa2, 0x39, 0xd0, 0x43, 0xa8, 0x85, 0xb8, 0x43, 0x28, 0x53, 0x3e, 0xe9,
-	0x0c, 0x6f, 0xa3, 0xd3, 0x83, 0x40, 0x32, 0xe5, 0x28, 0x89, 0xbb, 0x35, 0xbf, 0x70, 0xa4, 0x64,
-	0x70, 0x91, 0x03, 0xf0, 0xfb, 0xc6, 0x25, 0x28, 0xe0, 0x5e, 0x40, 0x42, 0x2c, 0xca, 0x6a, 0x9a,
-	0xa1, 0x5a, 0xe8, 0x0e, 0xe4, 0xfd, 0x3d, 0x0f, 0x87, 0xa2, 0x34, 0x96, 0x4a, 0xad, 0xe5, 0x7a,
-	0xfd, 0x9f, 0x71, 0xb9, 0x3d, 0x16, 0xe2, 0x13, 0x01, 0x7e, 0xa9, 0x04, 0x88, 0x9e, 0x87, 0x29,
-	0x2b, 0x7e, 0xdf, 0x15, 0xaf, 0x7e, 0x25, 0xb1, 0x4f, 0x35, 0xe9, 0x6c, 0x05, 0x14, 0xbd, 0x04,
-	0x33, 0x34, 0x6a, 0xf7, 0xe7, 0x09, 0x01, 0x97, 0x45, 0x46, 0x53, 0x1b, 0x1c, 0x10, 0x0a, 0xf0,
-	0x10, 0x86, 0xfa, 0xd4, 0x53, 0xa2, 0x96, 0x8a, 0xb5, 0x83, 0x40, 0xad, 0x80, 0xea, 0xb7, 0x92,
-	0xeb, 0x21, 0x5b, 0x23, 0x2e, 0x09, 0xf9, 0xf5, 0x30, 0xc9, 0x7c, 0x0c, 0xfe, 0xc9, 0xd3, 0xaa,
-	0x5d, 0xab, 0x1b, 0x61, 0x15, 0x0b, 0x65, 0x43, 0x7f, 0xa0, 0x7c, 0xcd, 0x06, 0x66, 0x3c, 0xfb,
-	0x3a, 0xd5, 0x62, 0x9e, 0x56, 0x0e, 0x29, 0x5e, 0xa2, 0x46, 0xfa, 0x9f, 0xb3, 0x2a, 0x09, 0x5a,
-	0x6e, 0x2e, 0x37, 0xdf, 0xe4, 0x11, 0x7a, 0x45, 0xfd, 0x5c, 0x65, 0xeb, 0x68, 0x61, 0x3f, 0x75,
-	0x00, 0x39, 0xb9, 0xb2, 0x9f, 0x9d, 0x40, 0x65, 0xff, 0x35, 0xc8, 0x9f, 0xe9, 0xb6, 0x21, 0x57,
-	0xa3, 0xd6, 0x70, 0x84, 0xb9, 0x9e, 0x26, 0x0e, 0xff, 0x3d, 0x07, 0x97, 0x87, 0x19, 0x1a, 0xbf,
-	0xe3, 0x6d, 0x2d, 0x2d, 0xbd, 0xfa, 0x99, 0x71, 0xf5, 0xe8, 0x13, 0x5d, 0x76, 0xf4, 0x89, 0xee,
-	0x28, 0xe3, 0xb5, 0x49, 0x32, 0x3e, 0x37, 0x19, 0xc6, 0xe7, 0x53, 0x33, 0x1e, 0x2d, 0xc0, 0x85,
-	0x01, 0x93, 0x95, 0xbc, 0x60, 0x54, 0x79, 0x9d, 0x99, 0xbe, 0x11, 0x0a, 0x8e, 0x30, 0xe1, 0x40,
-	0xfb, 0xf3, 0x15, 0x4b, 0x52, 0x3e, 0xf9, 0x4d, 0x27, 0x40, 0x8a, 0x2d, 0xef, 0xc0, 0xcc, 0x00,
-	0xf6, 0x19, 0x9f, 0x87, 0xfb, 0x64, 0xc6, 0x4f, 0xc4, 0xff, 0xd1, 0x54, 0xb5, 0x6a, 0x44, 0xc7,
-	0x9e, 0xe8, 0xd7, 0xff, 0x83, 0x7e, 0xe9, 0x3f, 0xd4, 0xa0, 0x2e, 0xaf, 0xae, 0xa1, 0xe5, 0xe0,
-	0xa6, 0x2d, 0xaa, 0xb0, 0xb1, 0xe3, 0x9e, 0x58, 0xf9, 0xfc, 0x14, 0xe5, 0xb9, 0x91, 0xa7, 0x55,
-	0x6d, 0x22, 0x4f, 0xab, 0x9f, 0xd1, 0xef, 0xa5, 0xee, 0x0e, 0xab, 0xc3, 0x99, 0xee, 0x5d, 0x3f,
-	0xd2, 0xe0, 0x99, 0x11, 0x51, 0x24, 0xe6, 0xf8, 0x44, 0x16, 0xff, 0x43, 0x59, 0x5c, 0xbd, 0x0e,
-	0x17, 0x3f, 0xe9, 0x07, 0x0b, 0xa8, 0x08, 0x9a, 0xe5, 0x38, 0xb5, 0x73, 0xa8, 0x0a, 0xa5, 0x3d,
-	0x25, 0x9a, 0x5a, 0xe6, 0x6a, 0x1b, 0x4a, 0xf1, 0x33, 0x10, 0x9a, 0x52, 0x4f, 0x45, 0x81, 0x45,
-	0xf8, 0xc4, 0x19, 0x98, 0x52, 0x6f, 0xa1, 0x2c, 0x0a, 0x3d, 0xec, 0xd4, 0x32, 0x68, 0x7a, 0xe8,
-	0x79, 0xb4, 0x96, 0x4d, 0x96, 0xd8, 0x3e, 0x65, 0x35, 0x0d, 0x5d, 0x84, 0xda, 0xc0, 0xb8, 0x04,
-	0xca, 0xb5, 0x56, 0xdf, 0x3f, 0x9c, 0xcd, 0x7c, 0x70, 0x38, 0x9b, 0xf9, 0xdb, 0xe1, 0x6c, 0xe6,
-	0xe7, 0x1f, 0xcf, 0x9e, 0xfb, 0xe0, 0xe3, 0xd9, 0x73, 0x1f, 0x7d, 0x3c, 0x7b, 0xee, 0xe1, 0xe2,
-	0xc9, 0xa7, 0x1b, 0xf9, 0x21, 0x7b, 0xbb, 0x20, 0x7e, 0xa7, 0xfe, 0xf5, 0xff, 0x06, 0x00, 0x00,
-	0xff, 0xff, 0xa7, 0x89, 0xd1, 0x91, 0xbb, 0x2f, 0x00, 0x00,
+func (m *EventTradeAccountTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *PoolMod) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventTradeAccountTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTradeAccountTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *PoolMod) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventTradeAccountTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTradeAccountTransfer.Merge(m, src)
+}
+func (m *EventTradeAccountTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTradeAccountTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTradeAccountTransfer.DiscardUnknown(m)
 }
 
-func (m *PoolMod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AssetAdd {
-		i--
-		if m.AssetAdd {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
+var xxx_messageInfo_EventTradeAccountTransfer proto.InternalMessageInfo
+
+func (m *EventTradeAccountTransfer) GetAsset() common.Asset {
+	if m != nil {
+		return m.Asset
 	}
-	{
-		size := m.AssetAmt.Size()
-		i -= size
-		if _, err := m.AssetAmt.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	return common.Asset{}
+}
+
+func (m *EventTradeAccountTransfer) GetFromAddress() gitlab_com_mayachain_mayanode_common.Address {
+	if m != nil {
+		return m.FromAddress
 	}
-	i--
-	dAtA[i] = 0x22
-	if m.CacaoAdd {
-		i--
-		if m.CacaoAdd {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+	return ""
+}
+
+func (m *EventTradeAccountTransfer) GetToAddress() gitlab_com_mayachain_mayanode_common.Address {
+	if m != nil {
+		return m.ToAddress
 	}
-	{
-		size := m.CacaoAmt.Size()
-		i -= size
-		if _, err := m.CacaoAmt.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *EventTradeAccountTransfer) GetTxID() gitlab_com_mayachain_mayanode_common.TxID {
+	if m != nil {
+		return m.TxID
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
+	return ""
+}
+
+type EventLimitOrderClose struct {
+	TxID         gitlab_com_mayachain_mayanode_common.TxID    `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3,casttype=gitlab.com/mayachain/mayanode/common.TxID" json:"tx_id,omitempty"`
+	FromAddress  gitlab_com_mayachain_mayanode_common.Address `protobuf:"bytes,2,opt,name=from_address,json=fromAddress,proto3,casttype=gitlab.com/mayachain/mayanode/common.Address" json:"from_address,omitempty"`
+	Source       common.Coin                                  `protobuf:"bytes,3,opt,name=source,proto3" json:"source"`
+	TargetAsset  common.Asset                                 `protobuf:"bytes,4,opt,name=target_asset,json=targetAsset,proto3" json:"target_asset"`
+	TradeTarget  github_com_cosmos_cosmos_sdk_types.Uint      `protobuf:"bytes,5,opt,name=trade_target,json=tradeTarget,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"trade_target"`
+	ExpiryHeight int64                                        `protobuf:"varint,6,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	Reason       string                                       `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	CancelTxID   gitlab_com_mayachain_mayanode_common.TxID    `protobuf:"bytes,8,opt,name=cancel_tx_id,json=cancelTxId,proto3,casttype=gitlab.com/mayachain/mayanode/common.TxID" json:"cancel_tx_id,omitempty"`
+}
+
+func (m *EventLimitOrderClose) Reset()         { *m = EventLimitOrderClose{} }
+func (m *EventLimitOrderClose) String() string { return proto.CompactTextString(m) }
+func (*EventLimitOrderClose) ProtoMessage()    {}
+func (*EventLimitOrderClose) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd2486ffd24912c2, []int{45}
+}
+func (m *EventLimitOrderClose) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventLimitOrderClose) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventLimitOrderClose.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *EventLimitOrderClose) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventLimitOrderClose.Merge(m, src)
+}
+func (m *EventLimitOrderClose) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventLimitOrderClose) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventLimitOrderClose.DiscardUnknown(m)
 }
 
-func (m *EventStreamingSwap) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventLimitOrderClose proto.InternalMessageInfo
+
+func (m *EventLimitOrderClose) GetTxID() gitlab_com_mayachain_mayanode_common.TxID {
+	if m != nil {
+		return m.TxID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventStreamingSwap) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventLimitOrderClose) GetFromAddress() gitlab_com_mayachain_mayanode_common.Address {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
 }
 
-func (m *EventStreamingSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FailedSwapReasons) > 0 {
-		for iNdEx := len(m.FailedSwapReasons) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.FailedSwapReasons[iNdEx])
-			copy(dAtA[i:], m.FailedSwapReasons[iNdEx])
-			i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.FailedSwapReasons[iNdEx])))
-			i--
-			dAtA[i] = 0x5a
-		}
+func (m *EventLimitOrderClose) GetSource() common.Coin {
+	if m != nil {
+		return m.Source
 	}
-	if len(m.FailedSwaps) > 0 {
-		dAtA3 := make([]byte, len(m.FailedSwaps)*10)
-		var j2 int
-		for _, num := range m.FailedSwaps {
-			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j2++
-			}
-			dAtA3[j2] = uint8(num)
-			j2++
-		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintTypeEvents(dAtA, i, uint64(j2))
-		i--
-		dAtA[i] = 0x52
+	return common.Coin{}
+}
+
+func (m *EventLimitOrderClose) GetTargetAsset() common.Asset {
+	if m != nil {
+		return m.TargetAsset
 	}
-	{
-		size, err := m.Out.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	return common.Asset{}
+}
+
+func (m *EventLimitOrderClose) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
 	}
-	i--
-	dAtA[i] = 0x4a
-	{
-		size, err := m.In.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *EventLimitOrderClose) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+	return ""
+}
+
+func (m *EventLimitOrderClose) GetCancelTxID() gitlab_com_mayachain_mayanode_common.TxID {
+	if m != nil {
+		return m.CancelTxID
+	}
+	return ""
+}
+
+type EventLimitOrderFill struct {
+	TxID      gitlab_com_mayachain_mayanode_common.TxID `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3,casttype=gitlab.com/mayachain/mayanode/common.TxID" json:"tx_id,omitempty"`
+	In        common.Coin                               `protobuf:"bytes,2,opt,name=in,proto3" json:"in"`
+	Out       common.Coin                               `protobuf:"bytes,3,opt,name=out,proto3" json:"out"`
+	FilledIn  github_com_cosmos_cosmos_sdk_types.Uint   `protobuf:"bytes,4,opt,name=filled_in,json=filledIn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"filled_in"`
+	FilledOut github_com_cosmos_cosmos_sdk_types.Uint   `protobuf:"bytes,5,opt,name=filled_out,json=filledOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"filled_out"`
+	Remaining github_com_cosmos_cosmos_sdk_types.Uint   `protobuf:"bytes,6,opt,name=remaining,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"remaining"`
+	Count     uint64                                    `protobuf:"varint,7,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *EventLimitOrderFill) Reset()         { *m = EventLimitOrderFill{} }
+func (m *EventLimitOrderFill) String() string { return proto.CompactTextString(m) }
+func (*EventLimitOrderFill) ProtoMessage()    {}
+func (*EventLimitOrderFill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd2486ffd24912c2, []int{46}
+}
+func (m *EventLimitOrderFill) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventLimitOrderFill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventLimitOrderFill.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.TradeTarget.Size()
-		i -= size
-		if _, err := m.TradeTarget.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+}
+func (m *EventLimitOrderFill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventLimitOrderFill.Merge(m, src)
+}
+func (m *EventLimitOrderFill) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventLimitOrderFill) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventLimitOrderFill.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventLimitOrderFill proto.InternalMessageInfo
+
+func (m *EventLimitOrderFill) GetTxID() gitlab_com_mayachain_mayanode_common.TxID {
+	if m != nil {
+		return m.TxID
 	}
-	i--
-	dAtA[i] = 0x32
-	if m.LastHeight != 0 {
-		i = encodeVarintTypeEvents(dAtA, i, uint64(m.LastHeight))
-		i--
-		dAtA[i] = 0x28
+	return ""
+}
+
+func (m *EventLimitOrderFill) GetIn() common.Coin {
+	if m != nil {
+		return m.In
 	}
-	if m.Count != 0 {
-		i = encodeVarintTypeEvents(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x20
+	return common.Coin{}
+}
+
+func (m *EventLimitOrderFill) GetOut() common.Coin {
+	if m != nil {
+		return m.Out
 	}
-	if m.Quantity != 0 {
-		i = encodeVarintTypeEvents(dAtA, i, uint64(m.Quantity))
-		i--
-		dAtA[i] = 0x18
+	return common.Coin{}
+}
+
+func (m *EventLimitOrderFill) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	if m.Interval != 0 {
-		i = encodeVarintTypeEvents(dAtA, i, uint64(m.Interval))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+type EventStreamingSwapCancel struct {
+	TxID        gitlab_com_mayachain_mayanode_common.TxID    `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3,casttype=gitlab.com/mayachain/mayanode/common.TxID" json:"tx_id,omitempty"`
+	CancelTxID  gitlab_com_mayachain_mayanode_common.TxID    `protobuf:"bytes,2,opt,name=cancel_tx_id,json=cancelTxId,proto3,casttype=gitlab.com/mayachain/mayanode/common.TxID" json:"cancel_tx_id,omitempty"`
+	FromAddress gitlab_com_mayachain_mayanode_common.Address `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3,casttype=gitlab.com/mayachain/mayanode/common.Address" json:"from_address,omitempty"`
+	Quantity    uint64                                       `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Count       uint64                                       `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	Deposit     common.Coin                                  `protobuf:"bytes,6,opt,name=deposit,proto3" json:"deposit"`
+	In          common.Coin                                  `protobuf:"bytes,7,opt,name=in,proto3" json:"in"`
+	Out         common.Coin                                  `protobuf:"bytes,8,opt,name=out,proto3" json:"out"`
+	Refund      common.Coin                                  `protobuf:"bytes,9,opt,name=refund,proto3" json:"refund"`
+}
+
+func (m *EventStreamingSwapCancel) Reset()         { *m = EventStreamingSwapCancel{} }
+func (m *EventStreamingSwapCancel) String() string { return proto.CompactTextString(m) }
+func (*EventStreamingSwapCancel) ProtoMessage()    {}
+func (*EventStreamingSwapCancel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd2486ffd24912c2, []int{47}
+}
+func (m *EventStreamingSwapCancel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventStreamingSwapCancel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventStreamingSwapCancel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.TxID) > 0 {
-		i -= len(m.TxID)
-		copy(dAtA[i:], m.TxID)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventStreamingSwapCancel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventStreamingSwapCancel.Merge(m, src)
+}
+func (m *EventStreamingSwapCancel) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventStreamingSwapCancel) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventStreamingSwapCancel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventStreamingSwapCancel proto.InternalMessageInfo
+
+func (m *EventStreamingSwapCancel) GetTxID() gitlab_com_mayachain_mayanode_common.TxID {
+	if m != nil {
+		return m.TxID
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventSwap) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventStreamingSwapCancel) GetCancelTxID() gitlab_com_mayachain_mayanode_common.TxID {
+	if m != nil {
+		return m.CancelTxID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventSwap) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventStreamingSwapCancel) GetFromAddress() gitlab_com_mayachain_mayanode_common.Address {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
 }
 
-func (m *EventSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StreamingSwapCount != 0 {
-		i = encodeVarintTypeEvents(dAtA, i, uint64(m.StreamingSwapCount))
-		i--
-		dAtA[i] = 0x58
+func (m *EventStreamingSwapCancel) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
 	}
-	if m.StreamingSwapQuantity != 0 {
-		i = encodeVarintTypeEvents(dAtA, i, uint64(m.StreamingSwapQuantity))
-		i--
-		dAtA[i] = 0x50
+	return 0
+}
+
+func (m *EventStreamingSwapCancel) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	{
-		size := m.SynthUnits.Size()
-		i -= size
-		if _, err := m.SynthUnits.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *EventStreamingSwapCancel) GetDeposit() common.Coin {
+	if m != nil {
+		return m.Deposit
 	}
-	i--
-	dAtA[i] = 0x4a
-	{
-		size, err := m.EmitAsset.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	return common.Coin{}
+}
+
+func (m *EventStreamingSwapCancel) GetIn() common.Coin {
+	if m != nil {
+		return m.In
 	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size, err := m.OutTxs.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	return common.Coin{}
+}
+
+func (m *EventStreamingSwapCancel) GetOut() common.Coin {
+	if m != nil {
+		return m.Out
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size, err := m.InTx.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	return common.Coin{}
+}
+
+func (m *EventStreamingSwapCancel) GetRefund() common.Coin {
+	if m != nil {
+		return m.Refund
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.LiquidityFeeInCacao.Size()
-		i -= size
-		if _, err := m.LiquidityFeeInCacao.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.LiquidityFee.Size()
-		i -= size
-		if _, err := m.LiquidityFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.SwapSlip.Size()
-		i -= size
-		if _, err := m.SwapSlip.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.SwapTarget.Size()
-		i -= size
-		if _, err := m.SwapTarget.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+	return common.Coin{}
+}
+
+type EventDCAOrder struct {
+	TxID        gitlab_com_mayachain_mayanode_common.TxID    `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3,casttype=gitlab.com/mayachain/mayanode/common.TxID" json:"tx_id,omitempty"`
+	FromAddress gitlab_com_mayachain_mayanode_common.Address `protobuf:"bytes,2,opt,name=from_address,json=fromAddress,proto3,casttype=gitlab.com/mayachain/mayanode/common.Address" json:"from_address,omitempty"`
+	Destination gitlab_com_mayachain_mayanode_common.Address `protobuf:"bytes,3,opt,name=destination,proto3,casttype=gitlab.com/mayachain/mayanode/common.Address" json:"destination,omitempty"`
+	Deposit     common.Coin                                  `protobuf:"bytes,4,opt,name=deposit,proto3" json:"deposit"`
+	TargetAsset common.Asset                                 `protobuf:"bytes,5,opt,name=target_asset,json=targetAsset,proto3" json:"target_asset"`
+	Interval    uint64                                       `protobuf:"varint,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	Quantity    uint64                                       `protobuf:"varint,7,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	SliceLimit  github_com_cosmos_cosmos_sdk_types.Uint      `protobuf:"bytes,8,opt,name=slice_limit,json=sliceLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"slice_limit"`
+}
+
+func (m *EventDCAOrder) Reset()         { *m = EventDCAOrder{} }
+func (m *EventDCAOrder) String() string { return proto.CompactTextString(m) }
+func (*EventDCAOrder) ProtoMessage()    {}
+func (*EventDCAOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd2486ffd24912c2, []int{48}
+}
+func (m *EventDCAOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDCAOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDCAOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *EventDCAOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDCAOrder.Merge(m, src)
+}
+func (m *EventDCAOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDCAOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDCAOrder.DiscardUnknown(m)
 }
 
-func (m *EventAffiliateFee) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventDCAOrder proto.InternalMessageInfo
+
+func (m *EventDCAOrder) GetTxID() gitlab_com_mayachain_mayanode_common.TxID {
+	if m != nil {
+		return m.TxID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventAffiliateFee) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventDCAOrder) GetFromAddress() gitlab_com_mayachain_mayanode_common.Address {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
 }
 
-func (m *EventAffiliateFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SubFeeBps != 0 {
-		i = encodeVarintTypeEvents(dAtA, i, uint64(m.SubFeeBps))
-		i--
-		dAtA[i] = 0x50
+func (m *EventDCAOrder) GetDestination() gitlab_com_mayachain_mayanode_common.Address {
+	if m != nil {
+		return m.Destination
 	}
-	if len(m.Parent) > 0 {
-		i -= len(m.Parent)
-		copy(dAtA[i:], m.Parent)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Parent)))
-		i--
-		dAtA[i] = 0x4a
+	return ""
+}
+
+func (m *EventDCAOrder) GetDeposit() common.Coin {
+	if m != nil {
+		return m.Deposit
 	}
-	{
-		size := m.FeeAmount.Size()
-		i -= size
-		if _, err := m.FeeAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	return common.Coin{}
+}
+
+func (m *EventDCAOrder) GetTargetAsset() common.Asset {
+	if m != nil {
+		return m.TargetAsset
 	}
-	i--
-	dAtA[i] = 0x42
-	if m.FeeBpsTick != 0 {
-		i = encodeVarintTypeEvents(dAtA, i, uint64(m.FeeBpsTick))
-		i--
-		dAtA[i] = 0x38
+	return common.Asset{}
+}
+
+func (m *EventDCAOrder) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
 	}
-	{
-		size := m.GrossAmount.Size()
-		i -= size
-		if _, err := m.GrossAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *EventDCAOrder) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
+	return 0
+}
+
+type EventDCASlice struct {
+	TxID       gitlab_com_mayachain_mayanode_common.TxID `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3,casttype=gitlab.com/mayachain/mayanode/common.TxID" json:"tx_id,omitempty"`
+	Quantity   uint64                                    `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Count      uint64                                    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	In         common.Coin                               `protobuf:"bytes,4,opt,name=in,proto3" json:"in"`
+	Out        common.Coin                               `protobuf:"bytes,5,opt,name=out,proto3" json:"out"`
+	SliceLimit github_com_cosmos_cosmos_sdk_types.Uint   `protobuf:"bytes,6,opt,name=slice_limit,json=sliceLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"slice_limit"`
+	Reason     string                                    `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventDCASlice) Reset()         { *m = EventDCASlice{} }
+func (m *EventDCASlice) String() string { return proto.CompactTextString(m) }
+func (*EventDCASlice) ProtoMessage()    {}
+func (*EventDCASlice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd2486ffd24912c2, []int{49}
+}
+func (m *EventDCASlice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDCASlice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDCASlice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.CacaoAddress) > 0 {
-		i -= len(m.CacaoAddress)
-		copy(dAtA[i:], m.CacaoAddress)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.CacaoAddress)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *EventDCASlice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDCASlice.Merge(m, src)
+}
+func (m *EventDCASlice) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDCASlice) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDCASlice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDCASlice proto.InternalMessageInfo
+
+func (m *EventDCASlice) GetTxID() gitlab_com_mayachain_mayanode_common.TxID {
+	if m != nil {
+		return m.TxID
 	}
-	if len(m.Mayaname) > 0 {
-		i -= len(m.Mayaname)
-		copy(dAtA[i:], m.Mayaname)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Mayaname)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *EventDCASlice) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
 	}
-	if len(m.Memo) > 0 {
-		i -= len(m.Memo)
-		copy(dAtA[i:], m.Memo)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Memo)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *EventDCASlice) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	if len(m.TxID) > 0 {
-		i -= len(m.TxID)
-		copy(dAtA[i:], m.TxID)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *EventDCASlice) GetIn() common.Coin {
+	if m != nil {
+		return m.In
 	}
-	return len(dAtA) - i, nil
+	return common.Coin{}
 }
 
-func (m *EventAddLiquidity) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventDCASlice) GetOut() common.Coin {
+	if m != nil {
+		return m.Out
 	}
-	return dAtA[:n], nil
+	return common.Coin{}
 }
 
-func (m *EventAddLiquidity) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventDCASlice) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *EventAddLiquidity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AssetAddress) > 0 {
-		i -= len(m.AssetAddress)
-		copy(dAtA[i:], m.AssetAddress)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.AssetAddress)))
-		i--
-		dAtA[i] = 0x42
+type EventLoanOpen struct {
+	Owner                  gitlab_com_mayachain_mayanode_common.Address `protobuf:"bytes,1,opt,name=owner,proto3,casttype=gitlab.com/mayachain/mayanode/common.Address" json:"owner,omitempty"`
+	CollateralAsset        common.Asset                                 `protobuf:"bytes,2,opt,name=collateral_asset,json=collateralAsset,proto3" json:"collateral_asset"`
+	CollateralDeposited    github_com_cosmos_cosmos_sdk_types.Uint      `protobuf:"bytes,3,opt,name=collateral_deposited,json=collateralDeposited,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"collateral_deposited"`
+	CollateralUnits        github_com_cosmos_cosmos_sdk_types.Uint      `protobuf:"bytes,4,opt,name=collateral_units,json=collateralUnits,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"collateral_units"`
+	DebtAsset              common.Asset                                 `protobuf:"bytes,5,opt,name=debt_asset,json=debtAsset,proto3" json:"debt_asset"`
+	DebtIssued             github_com_cosmos_cosmos_sdk_types.Uint      `protobuf:"bytes,6,opt,name=debt_issued,json=debtIssued,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"debt_issued"`
+	TargetAddress          gitlab_com_mayachain_mayanode_common.Address `protobuf:"bytes,7,opt,name=target_address,json=targetAddress,proto3,casttype=gitlab.com/mayachain/mayanode/common.Address" json:"target_address,omitempty"`
+	CollateralizationRatio int64                                        `protobuf:"varint,8,opt,name=collateralization_ratio,json=collateralizationRatio,proto3" json:"collateralization_ratio,omitempty"`
+	TxID                   gitlab_com_mayachain_mayanode_common.TxID    `protobuf:"bytes,9,opt,name=tx_id,json=txId,proto3,casttype=gitlab.com/mayachain/mayanode/common.TxID" json:"tx_id,omitempty"`
+}
+
+func (m *EventLoanOpen) Reset()         { *m = EventLoanOpen{} }
+func (m *EventLoanOpen) String() string { return proto.CompactTextString(m) }
+func (*EventLoanOpen) ProtoMessage()    {}
+func (*EventLoanOpen) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd2486ffd24912c2, []int{50}
+}
+func (m *EventLoanOpen) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventLoanOpen) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventLoanOpen.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.AssetTxID) > 0 {
-		i -= len(m.AssetTxID)
-		copy(dAtA[i:], m.AssetTxID)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.AssetTxID)))
-		i--
-		dAtA[i] = 0x3a
+}
+func (m *EventLoanOpen) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventLoanOpen.Merge(m, src)
+}
+func (m *EventLoanOpen) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventLoanOpen) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventLoanOpen.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventLoanOpen proto.InternalMessageInfo
+
+func (m *EventLoanOpen) GetOwner() gitlab_com_mayachain_mayanode_common.Address {
+	if m != nil {
+		return m.Owner
 	}
-	if len(m.RuneTxID) > 0 {
-		i -= len(m.RuneTxID)
-		copy(dAtA[i:], m.RuneTxID)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.RuneTxID)))
-		i--
-		dAtA[i] = 0x32
+	return ""
+}
+
+func (m *EventLoanOpen) GetCollateralAsset() common.Asset {
+	if m != nil {
+		return m.CollateralAsset
 	}
-	{
-		size := m.AssetAmount.Size()
-		i -= size
-		if _, err := m.AssetAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	return common.Asset{}
+}
+
+func (m *EventLoanOpen) GetDebtAsset() common.Asset {
+	if m != nil {
+		return m.DebtAsset
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.CacaoAmount.Size()
-		i -= size
-		if _, err := m.CacaoAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	return common.Asset{}
+}
+
+func (m *EventLoanOpen) GetTargetAddress() gitlab_com_mayachain_mayanode_common.Address {
+	if m != nil {
+		return m.TargetAddress
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.CacaoAddress) > 0 {
-		i -= len(m.CacaoAddress)
-		copy(dAtA[i:], m.CacaoAddress)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.CacaoAddress)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *EventLoanOpen) GetCollateralizationRatio() int64 {
+	if m != nil {
+		return m.CollateralizationRatio
 	}
-	{
-		size := m.ProviderUnits.Size()
-		i -= size
-		if _, err := m.ProviderUnits.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *EventLoanOpen) GetTxID() gitlab_com_mayachain_mayanode_common.TxID {
+	if m != nil {
+		return m.TxID
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+	return ""
+}
+
+type EventLoanRepayment struct {
+	Owner               gitlab_com_mayachain_mayanode_common.Address `protobuf:"bytes,1,opt,name=owner,proto3,casttype=gitlab.com/mayachain/mayanode/common.Address" json:"owner,omitempty"`
+	CollateralAsset     common.Asset                                 `protobuf:"bytes,2,opt,name=collateral_asset,json=collateralAsset,proto3" json:"collateral_asset"`
+	CollateralWithdrawn github_com_cosmos_cosmos_sdk_types.Uint      `protobuf:"bytes,3,opt,name=collateral_withdrawn,json=collateralWithdrawn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"collateral_withdrawn"`
+	DebtAsset           common.Asset                                 `protobuf:"bytes,4,opt,name=debt_asset,json=debtAsset,proto3" json:"debt_asset"`
+	DebtRepaid          github_com_cosmos_cosmos_sdk_types.Uint      `protobuf:"bytes,5,opt,name=debt_repaid,json=debtRepaid,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"debt_repaid"`
+	Coin                common.Coin                                  `protobuf:"bytes,6,opt,name=coin,proto3" json:"coin"`
+	TxID                gitlab_com_mayachain_mayanode_common.TxID    `protobuf:"bytes,7,opt,name=tx_id,json=txId,proto3,casttype=gitlab.com/mayachain/mayanode/common.TxID" json:"tx_id,omitempty"`
+}
+
+func (m *EventLoanRepayment) Reset()         { *m = EventLoanRepayment{} }
+func (m *EventLoanRepayment) String() string { return proto.CompactTextString(m) }
+func (*EventLoanRepayment) ProtoMessage()    {}
+func (*EventLoanRepayment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd2486ffd24912c2, []int{51}
+}
+func (m *EventLoanRepayment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventLoanRepayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventLoanRepayment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *EventLoanRepayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventLoanRepayment.Merge(m, src)
+}
+func (m *EventLoanRepayment) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventLoanRepayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventLoanRepayment.DiscardUnknown(m)
 }
 
-func (m *EventWithdraw) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventLoanRepayment proto.InternalMessageInfo
+
+func (m *EventLoanRepayment) GetOwner() gitlab_com_mayachain_mayanode_common.Address {
+	if m != nil {
+		return m.Owner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventWithdraw) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventLoanRepayment) GetCollateralAsset() common.Asset {
+	if m != nil {
+		return m.CollateralAsset
+	}
+	return common.Asset{}
 }
 
-func (m *EventWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ImpLossProtection.Size()
-		i -= size
-		if _, err := m.ImpLossProtection.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+func (m *EventLoanRepayment) GetDebtAsset() common.Asset {
+	if m != nil {
+		return m.DebtAsset
 	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.EmitCacao.Size()
-		i -= size
-		if _, err := m.EmitCacao.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	return common.Asset{}
+}
+
+func (m *EventLoanRepayment) GetCoin() common.Coin {
+	if m != nil {
+		return m.Coin
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.EmitAsset.Size()
-		i -= size
-		if _, err := m.EmitAsset.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	return common.Coin{}
+}
+
+func (m *EventLoanRepayment) GetTxID() gitlab_com_mayachain_mayanode_common.TxID {
+	if m != nil {
+		return m.TxID
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size, err := m.InTx.MarshalToSizedBuffer(dAtA[:i])
+	return ""
+}
+
+type EventLoanClose struct {
+	Owner               gitlab_com_mayachain_mayanode_common.Address `protobuf:"bytes,1,opt,name=owner,proto3,casttype=gitlab.com/mayachain/mayanode/common.Address" json:"owner,omitempty"`
+	CollateralAsset     common.Asset                                 `protobuf:"bytes,2,opt,name=collateral_asset,json=collateralAsset,proto3" json:"collateral_asset"`
+	CollateralWithdrawn github_com_cosmos_cosmos_sdk_types.Uint      `protobuf:"bytes,3,opt,name=collateral_withdrawn,json=collateralWithdrawn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"collateral_withdrawn"`
+	DebtAsset           common.Asset                                 `protobuf:"bytes,4,opt,name=debt_asset,json=debtAsset,proto3" json:"debt_asset"`
+	DebtRepaid          github_com_cosmos_cosmos_sdk_types.Uint      `protobuf:"bytes,5,opt,name=debt_repaid,json=debtRepaid,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"debt_repaid"`
+	TxID                gitlab_com_mayachain_mayanode_common.TxID    `protobuf:"bytes,6,opt,name=tx_id,json=txId,proto3,casttype=gitlab.com/mayachain/mayanode/common.TxID" json:"tx_id,omitempty"`
+}
+
+func (m *EventLoanClose) Reset()         { *m = EventLoanClose{} }
+func (m *EventLoanClose) String() string { return proto.CompactTextString(m) }
+func (*EventLoanClose) ProtoMessage()    {}
+func (*EventLoanClose) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd2486ffd24912c2, []int{52}
+}
+func (m *EventLoanClose) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventLoanClose) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventLoanClose.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.Asymmetry.Size()
-		i -= size
-		if _, err := m.Asymmetry.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			return nil, err
 		}
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	if m.BasisPoints != 0 {
-		i = encodeVarintTypeEvents(dAtA, i, uint64(m.BasisPoints))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *EventLoanClose) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventLoanClose.Merge(m, src)
+}
+func (m *EventLoanClose) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventLoanClose) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventLoanClose.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventLoanClose proto.InternalMessageInfo
+
+func (m *EventLoanClose) GetOwner() gitlab_com_mayachain_mayanode_common.Address {
+	if m != nil {
+		return m.Owner
 	}
-	{
-		size := m.ProviderUnits.Size()
-		i -= size
-		if _, err := m.ProviderUnits.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *EventLoanClose) GetCollateralAsset() common.Asset {
+	if m != nil {
+		return m.CollateralAsset
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	return common.Asset{}
+}
+
+func (m *EventLoanClose) GetDebtAsset() common.Asset {
+	if m != nil {
+		return m.DebtAsset
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return common.Asset{}
 }
 
-func (m *EventPendingLiquidity) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventLoanClose) GetTxID() gitlab_com_mayachain_mayanode_common.TxID {
+	if m != nil {
+		return m.TxID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventPendingLiquidity) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventLoanLiquidation struct {
+	Owner                  gitlab_com_mayachain_mayanode_common.Address `protobuf:"bytes,1,opt,name=owner,proto3,casttype=gitlab.com/mayachain/mayanode/common.Address" json:"owner,omitempty"`
+	CollateralAsset        common.Asset                                 `protobuf:"bytes,2,opt,name=collateral_asset,json=collateralAsset,proto3" json:"collateral_asset"`
+	CollateralLiquidated   github_com_cosmos_cosmos_sdk_types.Uint      `protobuf:"bytes,3,opt,name=collateral_liquidated,json=collateralLiquidated,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"collateral_liquidated"`
+	DebtAsset              common.Asset                                 `protobuf:"bytes,4,opt,name=debt_asset,json=debtAsset,proto3" json:"debt_asset"`
+	DebtRepaid             github_com_cosmos_cosmos_sdk_types.Uint      `protobuf:"bytes,5,opt,name=debt_repaid,json=debtRepaid,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"debt_repaid"`
+	CacaoRecovered         github_com_cosmos_cosmos_sdk_types.Uint      `protobuf:"bytes,6,opt,name=cacao_recovered,json=cacaoRecovered,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"cacao_recovered"`
+	CollateralizationRatio int64                                        `protobuf:"varint,7,opt,name=collateralization_ratio,json=collateralizationRatio,proto3" json:"collateralization_ratio,omitempty"`
 }
 
-func (m *EventPendingLiquidity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AssetTxID) > 0 {
-		i -= len(m.AssetTxID)
-		copy(dAtA[i:], m.AssetTxID)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.AssetTxID)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.RuneTxID) > 0 {
-		i -= len(m.RuneTxID)
-		copy(dAtA[i:], m.RuneTxID)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.RuneTxID)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	{
-		size := m.AssetAmount.Size()
-		i -= size
-		if _, err := m.AssetAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.AssetAddress) > 0 {
-		i -= len(m.AssetAddress)
-		copy(dAtA[i:], m.AssetAddress)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.AssetAddress)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	{
-		size := m.CacaoAmount.Size()
-		i -= size
-		if _, err := m.CacaoAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *EventLoanLiquidation) Reset()         { *m = EventLoanLiquidation{} }
+func (m *EventLoanLiquidation) String() string { return proto.CompactTextString(m) }
+func (*EventLoanLiquidation) ProtoMessage()    {}
+func (*EventLoanLiquidation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd2486ffd24912c2, []int{53}
+}
+func (m *EventLoanLiquidation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventLoanLiquidation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventLoanLiquidation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.CacaoAddress) > 0 {
-		i -= len(m.CacaoAddress)
-		copy(dAtA[i:], m.CacaoAddress)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.CacaoAddress)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *EventLoanLiquidation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventLoanLiquidation.Merge(m, src)
+}
+func (m *EventLoanLiquidation) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventLoanLiquidation) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventLoanLiquidation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventLoanLiquidation proto.InternalMessageInfo
+
+func (m *EventLoanLiquidation) GetOwner() gitlab_com_mayachain_mayanode_common.Address {
+	if m != nil {
+		return m.Owner
 	}
-	if m.PendingType != 0 {
-		i = encodeVarintTypeEvents(dAtA, i, uint64(m.PendingType))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *EventLoanLiquidation) GetCollateralAsset() common.Asset {
+	if m != nil {
+		return m.CollateralAsset
 	}
-	{
-		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	return common.Asset{}
+}
+
+func (m *EventLoanLiquidation) GetDebtAsset() common.Asset {
+	if m != nil {
+		return m.DebtAsset
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return common.Asset{}
 }
 
-func (m *EventDonate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventLoanLiquidation) GetCollateralizationRatio() int64 {
+	if m != nil {
+		return m.CollateralizationRatio
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventDonate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventMAYANameList struct {
+	Name              string                                       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Seller            gitlab_com_mayachain_mayanode_common.Address `protobuf:"bytes,2,opt,name=seller,proto3,casttype=gitlab.com/mayachain/mayanode/common.Address" json:"seller,omitempty"`
+	Price             github_com_cosmos_cosmos_sdk_types.Uint      `protobuf:"bytes,3,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"price"`
+	ExpireHeight      int64                                        `protobuf:"varint,4,opt,name=expire_height,json=expireHeight,proto3" json:"expire_height,omitempty"`
+	KeepAliases       bool                                         `protobuf:"varint,5,opt,name=keep_aliases,json=keepAliases,proto3" json:"keep_aliases,omitempty"`
+	KeepSubaffiliates bool                                         `protobuf:"varint,6,opt,name=keep_subaffiliates,json=keepSubaffiliates,proto3" json:"keep_subaffiliates,omitempty"`
+	TxID              gitlab_com_mayachain_mayanode_common.TxID    `protobuf:"bytes,7,opt,name=tx_id,json=txId,proto3,casttype=gitlab.com/mayachain/mayanode/common.TxID" json:"tx_id,omitempty"`
 }
 
-func (m *EventDonate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.InTx.MarshalToSizedBuffer(dAtA[:i])
+func (m *EventMAYANameList) Reset()         { *m = EventMAYANameList{} }
+func (m *EventMAYANameList) String() string { return proto.CompactTextString(m) }
+func (*EventMAYANameList) ProtoMessage()    {}
+func (*EventMAYANameList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd2486ffd24912c2, []int{54}
+}
+func (m *EventMAYANameList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMAYANameList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMAYANameList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+}
+func (m *EventMAYANameList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMAYANameList.Merge(m, src)
+}
+func (m *EventMAYANameList) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMAYANameList) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMAYANameList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMAYANameList proto.InternalMessageInfo
+
+func (m *EventMAYANameList) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventPool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMAYANameList) GetSeller() gitlab_com_mayachain_mayanode_common.Address {
+	if m != nil {
+		return m.Seller
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventPool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMAYANameList) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
 }
 
-func (m *EventPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		i = encodeVarintTypeEvents(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x10
+func (m *EventMAYANameList) GetKeepAliases() bool {
+	if m != nil {
+		return m.KeepAliases
 	}
-	{
-		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	return false
+}
+
+func (m *EventMAYANameList) GetKeepSubaffiliates() bool {
+	if m != nil {
+		return m.KeepSubaffiliates
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *PoolAmt) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMAYANameList) GetTxID() gitlab_com_mayachain_mayanode_common.TxID {
+	if m != nil {
+		return m.TxID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PoolAmt) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventMAYANameDelist struct {
+	Name   string                                       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Seller gitlab_com_mayachain_mayanode_common.Address `protobuf:"bytes,2,opt,name=seller,proto3,casttype=gitlab.com/mayachain/mayanode/common.Address" json:"seller,omitempty"`
+	TxID   gitlab_com_mayachain_mayanode_common.TxID    `protobuf:"bytes,3,opt,name=tx_id,json=txId,proto3,casttype=gitlab.com/mayachain/mayanode/common.TxID" json:"tx_id,omitempty"`
 }
 
-func (m *PoolAmt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintTypeEvents(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
+func (m *EventMAYANameDelist) Reset()         { *m = EventMAYANameDelist{} }
+func (m *EventMAYANameDelist) String() string { return proto.CompactTextString(m) }
+func (*EventMAYANameDelist) ProtoMessage()    {}
+func (*EventMAYANameDelist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd2486ffd24912c2, []int{55}
+}
+func (m *EventMAYANameDelist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMAYANameDelist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMAYANameDelist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *EventMAYANameDelist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMAYANameDelist.Merge(m, src)
+}
+func (m *EventMAYANameDelist) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMAYANameDelist) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMAYANameDelist.DiscardUnknown(m)
 }
 
-func (m *EventRewardsV1) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventMAYANameDelist proto.InternalMessageInfo
+
+func (m *EventMAYANameDelist) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventRewardsV1) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMAYANameDelist) GetSeller() gitlab_com_mayachain_mayanode_common.Address {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
 }
 
-func (m *EventRewardsV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PoolRewards) > 0 {
-		for iNdEx := len(m.PoolRewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PoolRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypeEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+func (m *EventMAYANameDelist) GetTxID() gitlab_com_mayachain_mayanode_common.TxID {
+	if m != nil {
+		return m.TxID
 	}
-	{
-		size := m.BondReward.Size()
-		i -= size
-		if _, err := m.BondReward.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventRewards) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type EventMAYANameSale struct {
+	Name   string                                       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Seller gitlab_com_mayachain_mayanode_common.Address `protobuf:"bytes,2,opt,name=seller,proto3,casttype=gitlab.com/mayachain/mayanode/common.Address" json:"seller,omitempty"`
+	Buyer  gitlab_com_mayachain_mayanode_common.Address `protobuf:"bytes,3,opt,name=buyer,proto3,casttype=gitlab.com/mayachain/mayanode/common.Address" json:"buyer,omitempty"`
+	Price  github_com_cosmos_cosmos_sdk_types.Uint      `protobuf:"bytes,4,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"price"`
+	TxID   gitlab_com_mayachain_mayanode_common.TxID    `protobuf:"bytes,5,opt,name=tx_id,json=txId,proto3,casttype=gitlab.com/mayachain/mayanode/common.TxID" json:"tx_id,omitempty"`
 }
 
-func (m *EventRewards) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMAYANameSale) Reset()         { *m = EventMAYANameSale{} }
+func (m *EventMAYANameSale) String() string { return proto.CompactTextString(m) }
+func (*EventMAYANameSale) ProtoMessage()    {}
+func (*EventMAYANameSale) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd2486ffd24912c2, []int{56}
 }
-
-func (m *EventRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MayaFundReward.Size()
-		i -= size
-		if _, err := m.MayaFundReward.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *EventMAYANameSale) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMAYANameSale) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMAYANameSale.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.CacaoPoolReward.Size()
-		i -= size
-		if _, err := m.CacaoPoolReward.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+}
+func (m *EventMAYANameSale) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMAYANameSale.Merge(m, src)
+}
+func (m *EventMAYANameSale) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMAYANameSale) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMAYANameSale.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMAYANameSale proto.InternalMessageInfo
+
+func (m *EventMAYANameSale) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.PoolRewards) > 0 {
-		for iNdEx := len(m.PoolRewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PoolRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypeEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	return ""
+}
+
+func (m *EventMAYANameSale) GetSeller() gitlab_com_mayachain_mayanode_common.Address {
+	if m != nil {
+		return m.Seller
 	}
-	{
-		size := m.BondReward.Size()
-		i -= size
-		if _, err := m.BondReward.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *EventMAYANameSale) GetBuyer() gitlab_com_mayachain_mayanode_common.Address {
+	if m != nil {
+		return m.Buyer
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventRefund) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMAYANameSale) GetTxID() gitlab_com_mayachain_mayanode_common.TxID {
+	if m != nil {
+		return m.TxID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventRefund) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventPOL struct {
+	Pool                   common.Asset                            `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool"`
+	Action                 string                                  `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	CacaoAmount            github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=cacao_amount,json=cacaoAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"cacao_amount"`
+	SynthUtilization       github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,4,opt,name=synth_utilization,json=synthUtilization,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"synth_utilization"`
+	TargetSynthUtilization github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,5,opt,name=target_synth_utilization,json=targetSynthUtilization,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"target_synth_utilization"`
+	Buffer                 github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,6,opt,name=buffer,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"buffer"`
+	Reason                 string                                  `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *EventRefund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.InTx.MarshalToSizedBuffer(dAtA[:i])
+func (m *EventPOL) Reset()         { *m = EventPOL{} }
+func (m *EventPOL) String() string { return proto.CompactTextString(m) }
+func (*EventPOL) ProtoMessage()    {}
+func (*EventPOL) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd2486ffd24912c2, []int{57}
+}
+func (m *EventPOL) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPOL) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPOL.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventPOL) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPOL.Merge(m, src)
+}
+func (m *EventPOL) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPOL) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPOL.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPOL proto.InternalMessageInfo
+
+func (m *EventPOL) GetPool() common.Asset {
+	if m != nil {
+		return m.Pool
 	}
-	if m.Code != 0 {
-		i = encodeVarintTypeEvents(dAtA, i, uint64(m.Code))
-		i--
-		dAtA[i] = 0x8
+	return common.Asset{}
+}
+
+func (m *EventPOL) GetAction() string {
+	if m != nil {
+		return m.Action
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventBond) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventPOL) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventBond) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventBondProvider struct {
+	NodeAddress     github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=node_address,json=nodeAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"node_address,omitempty"`
+	ProviderAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=provider_address,json=providerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"provider_address,omitempty"`
+	Action          string                                        `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	TxID            gitlab_com_mayachain_mayanode_common.TxID     `protobuf:"bytes,4,opt,name=tx_id,json=txId,proto3,casttype=gitlab.com/mayachain/mayanode/common.TxID" json:"tx_id,omitempty"`
 }
 
-func (m *EventBond) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.TxIn.MarshalToSizedBuffer(dAtA[:i])
+func (m *EventBondProvider) Reset()         { *m = EventBondProvider{} }
+func (m *EventBondProvider) String() string { return proto.CompactTextString(m) }
+func (*EventBondProvider) ProtoMessage()    {}
+func (*EventBondProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd2486ffd24912c2, []int{58}
+}
+func (m *EventBondProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBondProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBondProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.BondType != 0 {
-		i = encodeVarintTypeEvents(dAtA, i, uint64(m.BondType))
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+}
+func (m *EventBondProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBondProvider.Merge(m, src)
+}
+func (m *EventBondProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBondProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBondProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBondProvider proto.InternalMessageInfo
+
+func (m *EventBondProvider) GetNodeAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.NodeAddress
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *EventBondV105) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventBondProvider) GetProviderAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.ProviderAddress
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventBondV105) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventBondProvider) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
 }
 
-func (m *EventBondV105) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+func (m *EventBondProvider) GetTxID() gitlab_com_mayachain_mayanode_common.TxID {
+	if m != nil {
+		return m.TxID
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.TxIn.MarshalToSizedBuffer(dAtA[:i])
+	return ""
+}
+
+type EventNodeOperatorFee struct {
+	NodeAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=node_address,json=nodeAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"node_address,omitempty"`
+	OldFee      int64                                         `protobuf:"varint,2,opt,name=old_fee,json=oldFee,proto3" json:"old_fee,omitempty"`
+	NewFee      int64                                         `protobuf:"varint,3,opt,name=new_fee,json=newFee,proto3" json:"new_fee,omitempty"`
+	ApplyHeight int64                                         `protobuf:"varint,4,opt,name=apply_height,json=applyHeight,proto3" json:"apply_height,omitempty"`
+	TxID        gitlab_com_mayachain_mayanode_common.TxID     `protobuf:"bytes,5,opt,name=tx_id,json=txId,proto3,casttype=gitlab.com/mayachain/mayanode/common.TxID" json:"tx_id,omitempty"`
+}
+
+func (m *EventNodeOperatorFee) Reset()         { *m = EventNodeOperatorFee{} }
+func (m *EventNodeOperatorFee) String() string { return proto.CompactTextString(m) }
+func (*EventNodeOperatorFee) ProtoMessage()    {}
+func (*EventNodeOperatorFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd2486ffd24912c2, []int{59}
+}
+func (m *EventNodeOperatorFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNodeOperatorFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNodeOperatorFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.BondType != 0 {
-		i = encodeVarintTypeEvents(dAtA, i, uint64(m.BondType))
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			return nil, err
 		}
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *EventNodeOperatorFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNodeOperatorFee.Merge(m, src)
+}
+func (m *EventNodeOperatorFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNodeOperatorFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNodeOperatorFee.DiscardUnknown(m)
 }
 
-func (m *GasPool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventNodeOperatorFee proto.InternalMessageInfo
+
+func (m *EventNodeOperatorFee) GetNodeAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.NodeAddress
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GasPool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventNodeOperatorFee) GetOldFee() int64 {
+	if m != nil {
+		return m.OldFee
+	}
+	return 0
 }
 
-func (m *GasPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Count != 0 {
-		i = encodeVarintTypeEvents(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x20
-	}
-	{
-		size := m.AssetAmt.Size()
-		i -= size
-		if _, err := m.AssetAmt.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.CacaoAmt.Size()
-		i -= size
-		if _, err := m.CacaoAmt.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+func (m *EventNodeOperatorFee) GetNewFee() int64 {
+	if m != nil {
+		return m.NewFee
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *EventNodeOperatorFee) GetApplyHeight() int64 {
+	if m != nil {
+		return m.ApplyHeight
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventGas) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventNodeOperatorFee) GetTxID() gitlab_com_mayachain_mayanode_common.TxID {
+	if m != nil {
+		return m.TxID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventGas) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventBondProviderTransfer struct {
+	NodeAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=node_address,json=nodeAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"node_address,omitempty"`
+	FromAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=from_address,json=fromAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"from_address,omitempty"`
+	ToAddress   github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=to_address,json=toAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"to_address,omitempty"`
+	Asset       common.Asset                                  `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset"`
+	Units       github_com_cosmos_cosmos_sdk_types.Uint       `protobuf:"bytes,5,opt,name=units,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"units"`
+	TxID        gitlab_com_mayachain_mayanode_common.TxID     `protobuf:"bytes,6,opt,name=tx_id,json=txId,proto3,casttype=gitlab.com/mayachain/mayanode/common.TxID" json:"tx_id,omitempty"`
 }
 
-func (m *EventGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Pools) > 0 {
-		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypeEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *EventBondProviderTransfer) Reset()         { *m = EventBondProviderTransfer{} }
+func (m *EventBondProviderTransfer) String() string { return proto.CompactTextString(m) }
+func (*EventBondProviderTransfer) ProtoMessage()    {}
+func (*EventBondProviderTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd2486ffd24912c2, []int{60}
+}
+func (m *EventBondProviderTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBondProviderTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBondProviderTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventBondProviderTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBondProviderTransfer.Merge(m, src)
+}
+func (m *EventBondProviderTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBondProviderTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBondProviderTransfer.DiscardUnknown(m)
 }
 
-func (m *EventReserve) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventBondProviderTransfer proto.InternalMessageInfo
+
+func (m *EventBondProviderTransfer) GetNodeAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.NodeAddress
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventReserve) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventBondProviderTransfer) GetFromAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.FromAddress
+	}
+	return nil
 }
 
-func (m *EventReserve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.InTx.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+func (m *EventBondProviderTransfer) GetToAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.ToAddress
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.ReserveContributor.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *EventBondProviderTransfer) GetAsset() common.Asset {
+	if m != nil {
+		return m.Asset
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return common.Asset{}
 }
 
-func (m *EventScheduledOutbound) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventBondProviderTransfer) GetTxID() gitlab_com_mayachain_mayanode_common.TxID {
+	if m != nil {
+		return m.TxID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventScheduledOutbound) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventVaultMigrated struct {
+	PubKey         gitlab_com_mayachain_mayanode_common.PubKey `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3,casttype=gitlab.com/mayachain/mayanode/common.PubKey" json:"pub_key,omitempty"`
+	RetiringHeight int64                                       `protobuf:"varint,2,opt,name=retiring_height,json=retiringHeight,proto3" json:"retiring_height,omitempty"`
+	Migrated       gitlab_com_mayachain_mayanode_common.Coins  `protobuf:"bytes,3,rep,name=migrated,proto3,castrepeated=gitlab.com/mayachain/mayanode/common.Coins" json:"migrated"`
 }
 
-func (m *EventScheduledOutbound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.OutTx.MarshalToSizedBuffer(dAtA[:i])
+func (m *EventVaultMigrated) Reset()         { *m = EventVaultMigrated{} }
+func (m *EventVaultMigrated) String() string { return proto.CompactTextString(m) }
+func (*EventVaultMigrated) ProtoMessage()    {}
+func (*EventVaultMigrated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd2486ffd24912c2, []int{61}
+}
+func (m *EventVaultMigrated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventVaultMigrated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventVaultMigrated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *EventVaultMigrated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventVaultMigrated.Merge(m, src)
+}
+func (m *EventVaultMigrated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventVaultMigrated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventVaultMigrated.DiscardUnknown(m)
 }
 
-func (m *EventSecurity) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventVaultMigrated proto.InternalMessageInfo
+
+func (m *EventVaultMigrated) GetPubKey() gitlab_com_mayachain_mayanode_common.PubKey {
+	if m != nil {
+		return m.PubKey
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventSecurity) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventVaultMigrated) GetRetiringHeight() int64 {
+	if m != nil {
+		return m.RetiringHeight
+	}
+	return 0
 }
 
-func (m *EventSecurity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+func (m *EventVaultMigrated) GetMigrated() gitlab_com_mayachain_mayanode_common.Coins {
+	if m != nil {
+		return m.Migrated
+	}
+	return nil
+}
+
+type EventSolvencyWarning struct {
+	PubKey       gitlab_com_mayachain_mayanode_common.PubKey `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3,casttype=gitlab.com/mayachain/mayanode/common.PubKey" json:"pub_key,omitempty"`
+	Chain        gitlab_com_mayachain_mayanode_common.Chain  `protobuf:"bytes,2,opt,name=chain,proto3,casttype=gitlab.com/mayachain/mayanode/common.Chain" json:"chain,omitempty"`
+	Asset        common.Asset                                `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset"`
+	VaultAmount  github_com_cosmos_cosmos_sdk_types.Uint     `protobuf:"bytes,4,opt,name=vault_amount,json=vaultAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"vault_amount"`
+	WalletAmount github_com_cosmos_cosmos_sdk_types.Uint     `protobuf:"bytes,5,opt,name=wallet_amount,json=walletAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"wallet_amount"`
+	Gap          int64                                       `protobuf:"varint,6,opt,name=gap,proto3" json:"gap,omitempty"`
+	WarnGap      int64                                       `protobuf:"varint,7,opt,name=warn_gap,json=warnGap,proto3" json:"warn_gap,omitempty"`
+	HaltGap      int64                                       `protobuf:"varint,8,opt,name=halt_gap,json=haltGap,proto3" json:"halt_gap,omitempty"`
+}
+
+func (m *EventSolvencyWarning) Reset()         { *m = EventSolvencyWarning{} }
+func (m *EventSolvencyWarning) String() string { return proto.CompactTextString(m) }
+func (*EventSolvencyWarning) ProtoMessage()    {}
+func (*EventSolvencyWarning) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd2486ffd24912c2, []int{62}
+}
+func (m *EventSolvencyWarning) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSolvencyWarning) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSolvencyWarning.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventSolvencyWarning) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSolvencyWarning.Merge(m, src)
+}
+func (m *EventSolvencyWarning) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSolvencyWarning) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSolvencyWarning.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSolvencyWarning proto.InternalMessageInfo
+
+func (m *EventSolvencyWarning) GetPubKey() gitlab_com_mayachain_mayanode_common.PubKey {
+	if m != nil {
+		return m.PubKey
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventSlash) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventSolvencyWarning) GetChain() gitlab_com_mayachain_mayanode_common.Chain {
+	if m != nil {
+		return m.Chain
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventSlash) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventSolvencyWarning) GetAsset() common.Asset {
+	if m != nil {
+		return m.Asset
+	}
+	return common.Asset{}
 }
 
-func (m *EventSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SlashAmount) > 0 {
-		for iNdEx := len(m.SlashAmount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SlashAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypeEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+func (m *EventSolvencyWarning) GetGap() int64 {
+	if m != nil {
+		return m.Gap
 	}
-	{
-		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *EventSolvencyWarning) GetWarnGap() int64 {
+	if m != nil {
+		return m.WarnGap
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventSlashLiquidity) Marshal() (dAtA []byte, err error) {
+func (m *EventSolvencyWarning) GetHaltGap() int64 {
+	if m != nil {
+		return m.HaltGap
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("types.PendingLiquidityType", PendingLiquidityType_name, PendingLiquidityType_value)
+	proto.RegisterEnum("types.BondType", BondType_name, BondType_value)
+	proto.RegisterType((*PoolMod)(nil), "types.PoolMod")
+	proto.RegisterType((*EventStreamingSwap)(nil), "types.EventStreamingSwap")
+	proto.RegisterType((*EventSwap)(nil), "types.EventSwap")
+	proto.RegisterType((*EventAffiliateFee)(nil), "types.EventAffiliateFee")
+	proto.RegisterType((*EventAddLiquidity)(nil), "types.EventAddLiquidity")
+	proto.RegisterType((*EventWithdraw)(nil), "types.EventWithdraw")
+	proto.RegisterType((*EventPendingLiquidity)(nil), "types.EventPendingLiquidity")
+	proto.RegisterType((*EventDonate)(nil), "types.EventDonate")
+	proto.RegisterType((*EventPool)(nil), "types.EventPool")
+	proto.RegisterType((*PoolAmt)(nil), "types.PoolAmt")
+	proto.RegisterType((*EventRewardsV1)(nil), "types.EventRewardsV1")
+	proto.RegisterType((*EventRewards)(nil), "types.EventRewards")
+	proto.RegisterType((*EventRefund)(nil), "types.EventRefund")
+	proto.RegisterType((*EventBond)(nil), "types.EventBond")
+	proto.RegisterType((*EventBondV105)(nil), "types.EventBondV105")
+	proto.RegisterType((*GasPool)(nil), "types.GasPool")
+	proto.RegisterType((*EventGas)(nil), "types.EventGas")
+	proto.RegisterType((*EventReserve)(nil), "types.EventReserve")
+	proto.RegisterType((*EventScheduledOutbound)(nil), "types.EventScheduledOutbound")
+	proto.RegisterType((*EventSecurity)(nil), "types.EventSecurity")
+	proto.RegisterType((*EventSlash)(nil), "types.EventSlash")
+	proto.RegisterType((*EventSlashLiquidity)(nil), "types.EventSlashLiquidity")
+	proto.RegisterType((*EventErrata)(nil), "types.EventErrata")
+	proto.RegisterType((*EventFee)(nil), "types.EventFee")
+	proto.RegisterType((*EventOutbound)(nil), "types.EventOutbound")
+	proto.RegisterType((*EventTssKeygenSuccess)(nil), "types.EventTssKeygenSuccess")
+	proto.RegisterType((*EventTssKeygenFailure)(nil), "types.EventTssKeygenFailure")
+	proto.RegisterType((*EventTssKeygenMetric)(nil), "types.EventTssKeygenMetric")
+	proto.RegisterType((*EventTssKeysignMetric)(nil), "types.EventTssKeysignMetric")
+	proto.RegisterType((*EventSlashPoint)(nil), "types.EventSlashPoint")
+	proto.RegisterType((*EventPoolBalanceChanged)(nil), "types.EventPoolBalanceChanged")
+	proto.RegisterType((*EventSwitch)(nil), "types.EventSwitch")
+	proto.RegisterType((*EventSwitchV87)(nil), "types.EventSwitchV87")
+	proto.RegisterType((*EventMAYANameV111)(nil), "types.EventMAYANameV111")
+	proto.RegisterType((*EventMAYAName)(nil), "types.EventMAYAName")
+	proto.RegisterType((*EventSetMimir)(nil), "types.EventSetMimir")
+	proto.RegisterType((*EventSetNodeMimir)(nil), "types.EventSetNodeMimir")
+	proto.RegisterType((*EventCACAOPoolDeposit)(nil), "types.EventCACAOPoolDeposit")
+	proto.RegisterType((*EventCACAOPoolWithdrawV118)(nil), "types.EventCACAOPoolWithdrawV118")
+	proto.RegisterType((*EventCACAOPoolWithdraw)(nil), "types.EventCACAOPoolWithdraw")
+	proto.RegisterType((*EventCACAOPoolWithdrawQueued)(nil), "types.EventCACAOPoolWithdrawQueued")
+	proto.RegisterType((*EventCACAOPoolCompound)(nil), "types.EventCACAOPoolCompound")
+	proto.RegisterType((*EventTradeAccountDeposit)(nil), "types.EventTradeAccountDeposit")
+	proto.RegisterType((*EventTradeAccountWithdraw)(nil), "types.EventTradeAccountWithdraw")
+	proto.RegisterType((*EventTradeAccountTransfer)(nil), "types.EventTradeAccountTransfer")
+	proto.RegisterType((*EventLimitOrderClose)(nil), "types.EventLimitOrderClose")
+	proto.RegisterType((*EventLimitOrderFill)(nil), "types.EventLimitOrderFill")
+	proto.RegisterType((*EventStreamingSwapCancel)(nil), "types.EventStreamingSwapCancel")
+	proto.RegisterType((*EventDCAOrder)(nil), "types.EventDCAOrder")
+	proto.RegisterType((*EventDCASlice)(nil), "types.EventDCASlice")
+	proto.RegisterType((*EventLoanOpen)(nil), "types.EventLoanOpen")
+	proto.RegisterType((*EventLoanRepayment)(nil), "types.EventLoanRepayment")
+	proto.RegisterType((*EventLoanClose)(nil), "types.EventLoanClose")
+	proto.RegisterType((*EventLoanLiquidation)(nil), "types.EventLoanLiquidation")
+	proto.RegisterType((*EventMAYANameList)(nil), "types.EventMAYANameList")
+	proto.RegisterType((*EventMAYANameDelist)(nil), "types.EventMAYANameDelist")
+	proto.RegisterType((*EventMAYANameSale)(nil), "types.EventMAYANameSale")
+	proto.RegisterType((*EventPOL)(nil), "types.EventPOL")
+	proto.RegisterType((*EventBondProvider)(nil), "types.EventBondProvider")
+	proto.RegisterType((*EventNodeOperatorFee)(nil), "types.EventNodeOperatorFee")
+	proto.RegisterType((*EventBondProviderTransfer)(nil), "types.EventBondProviderTransfer")
+	proto.RegisterType((*EventVaultMigrated)(nil), "types.EventVaultMigrated")
+	proto.RegisterType((*EventSolvencyWarning)(nil), "types.EventSolvencyWarning")
+}
+
+func init() {
+	proto.RegisterFile("mayachain/v1/x/mayachain/types/type_events.proto", fileDescriptor_dd2486ffd24912c2)
+}
+
+var fileDescriptor_dd2486ffd24912c2 = []byte{
+	// 3953 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x4b, 0x8c, 0x1c, 0x49,
+	0x5a, 0x76, 0x55, 0xd6, 0xf3, 0xaf, 0x6a, 0x77, 0x75, 0xd8, 0x63, 0xf7, 0xcc, 0x82, 0xdb, 0xce,
+	0x81, 0x19, 0x8f, 0x77, 0xdc, 0x9e, 0x36, 0x9a, 0xf1, 0x02, 0x62, 0xa5, 0x7e, 0x8c, 0x3d, 0x3d,
+	0xdb, 0x76, 0xf7, 0x64, 0xb7, 0x3d, 0x8c, 0x99, 0x51, 0x2a, 0xaa, 0x32, 0xba, 0x3a, 0xe4, 0x7c,
+	0x6d, 0x46, 0x64, 0x3f, 0x38, 0x22, 0x10, 0x2f, 0x2d, 0x0f, 0x71, 0x44, 0x1c, 0xe0, 0x80, 0x58,
+	0x90, 0xb8, 0x72, 0xe0, 0x80, 0x78, 0x1c, 0x06, 0x09, 0x56, 0xbb, 0x27, 0x56, 0x1c, 0x1a, 0xf0,
+	0x08, 0x4e, 0x08, 0xed, 0x81, 0x93, 0x91, 0x10, 0x8a, 0x47, 0x66, 0x65, 0x55, 0xbb, 0xcb, 0xd5,
+	0x59, 0xd5, 0x63, 0x8f, 0xf0, 0xa5, 0x3b, 0xe3, 0xf5, 0xc7, 0xe3, 0xff, 0xfe, 0x47, 0xfc, 0x11,
+	0x51, 0xf0, 0x8e, 0x87, 0x0f, 0x70, 0x67, 0x07, 0x53, 0xff, 0xc6, 0xee, 0xc2, 0x8d, 0xfd, 0x1b,
+	0xbd, 0x24, 0x3f, 0x08, 0x09, 0x93, 0x7f, 0x6d, 0xb2, 0x4b, 0x7c, 0xce, 0xe6, 0xc3, 0x28, 0xe0,
+	0x01, 0x2a, 0xcb, 0x82, 0xd7, 0x2e, 0xf7, 0x35, 0xec, 0x04, 0x9e, 0x17, 0xf8, 0xfa, 0x9f, 0xaa,
+	0xf8, 0xda, 0xfc, 0x28, 0xa4, 0xc3, 0x20, 0x70, 0x75, 0xfd, 0x9f, 0x1b, 0xa5, 0x7e, 0x44, 0x18,
+	0x89, 0x76, 0x89, 0xdd, 0x09, 0x7c, 0x1e, 0xd1, 0x76, 0xcc, 0x83, 0x48, 0x37, 0x1f, 0x69, 0x26,
+	0x7c, 0xdf, 0x0e, 0x62, 0xae, 0x5b, 0x9c, 0xef, 0x06, 0xdd, 0x40, 0x7e, 0xde, 0x10, 0x5f, 0x2a,
+	0xd7, 0xfc, 0x8d, 0x22, 0x54, 0x37, 0x82, 0xc0, 0xbd, 0x1b, 0x38, 0xe8, 0x2d, 0x28, 0x63, 0xc6,
+	0x08, 0x9f, 0x2d, 0x5c, 0x2e, 0x5c, 0x6d, 0xdc, 0x9c, 0x9a, 0xd7, 0x13, 0x5c, 0x14, 0x99, 0x4b,
+	0xa5, 0xcf, 0x0f, 0xe7, 0xce, 0x58, 0xaa, 0x06, 0x5a, 0x83, 0x7a, 0x07, 0x77, 0x70, 0x60, 0x63,
+	0x8f, 0xcf, 0x16, 0x2f, 0x17, 0xae, 0xd6, 0x97, 0x6e, 0x88, 0xf2, 0x7f, 0x3e, 0x9c, 0x7b, 0xb3,
+	0x4b, 0xf9, 0x4e, 0xdc, 0x16, 0x8d, 0x6f, 0x74, 0x02, 0xe6, 0x05, 0x4c, 0xff, 0xbb, 0xce, 0x9c,
+	0x47, 0x6a, 0x74, 0xf3, 0xf7, 0xa9, 0xcf, 0xad, 0x9a, 0xa4, 0xb0, 0xe8, 0x71, 0xf4, 0xb5, 0x94,
+	0x9a, 0xe3, 0xcc, 0x1a, 0x97, 0x0b, 0x57, 0x6b, 0x49, 0xa1, 0xe3, 0x88, 0xae, 0x64, 0x9f, 0xb2,
+	0xab, 0x52, 0xce, 0xae, 0x24, 0x05, 0xdd, 0x95, 0xa6, 0xe6, 0x38, 0xb3, 0x65, 0xd5, 0x95, 0x2a,
+	0x74, 0x1c, 0xf3, 0xbf, 0x0c, 0x40, 0xef, 0x0b, 0xee, 0x6f, 0xf2, 0x88, 0x60, 0x8f, 0xfa, 0xdd,
+	0xcd, 0x3d, 0x1c, 0xa2, 0x0f, 0xa1, 0xcc, 0xf7, 0x6d, 0xea, 0xc8, 0x75, 0xa9, 0x2f, 0xbd, 0xfb,
+	0xf8, 0x70, 0xae, 0xb4, 0xb5, 0xbf, 0xba, 0xf2, 0xe4, 0x70, 0xee, 0xad, 0x2e, 0xe5, 0x2e, 0x56,
+	0x23, 0xe8, 0xb1, 0x40, 0x7c, 0xf9, 0x81, 0x43, 0x12, 0x84, 0x88, 0xca, 0x56, 0x89, 0xef, 0xaf,
+	0x3a, 0xe8, 0x35, 0xa8, 0x51, 0x9f, 0x93, 0x68, 0x17, 0xbb, 0x72, 0xdd, 0x4a, 0x56, 0x9a, 0x16,
+	0x65, 0xdf, 0x8e, 0xb1, 0xcf, 0x29, 0x3f, 0x90, 0xab, 0x50, 0xb2, 0xd2, 0x34, 0x3a, 0x0f, 0xe5,
+	0x4e, 0x10, 0xfb, 0x6a, 0x05, 0x4a, 0x96, 0x4a, 0xa0, 0x39, 0x68, 0xb8, 0x98, 0x71, 0x7b, 0x87,
+	0xd0, 0xee, 0x0e, 0x97, 0xf3, 0x31, 0x2c, 0x10, 0x59, 0x1f, 0xc8, 0x1c, 0x64, 0x41, 0x93, 0x47,
+	0xd8, 0x21, 0x36, 0xc7, 0x51, 0x97, 0xf0, 0xd9, 0x4a, 0xbe, 0xf5, 0x6b, 0x48, 0x22, 0x5b, 0x92,
+	0x06, 0x7a, 0x1b, 0xaa, 0x0e, 0x09, 0x03, 0x46, 0xf9, 0x6c, 0x55, 0x02, 0xa5, 0x99, 0x00, 0x65,
+	0x39, 0xa0, 0xbe, 0xc6, 0x49, 0x52, 0x05, 0x99, 0x50, 0xa4, 0xfe, 0x6c, 0xed, 0xd8, 0x8a, 0x45,
+	0xea, 0xa3, 0x9f, 0x00, 0x23, 0x88, 0xf9, 0x6c, 0xfd, 0xd8, 0x4a, 0xa2, 0x18, 0x5d, 0x81, 0xe6,
+	0x36, 0xa6, 0x2e, 0x71, 0x6c, 0xb6, 0x87, 0x43, 0x36, 0x0b, 0x97, 0x8d, 0xab, 0x25, 0xab, 0xa1,
+	0xf2, 0x04, 0xa3, 0x18, 0x9a, 0x87, 0x73, 0x99, 0x2a, 0x76, 0x44, 0x30, 0x0b, 0x7c, 0x36, 0xdb,
+	0xb8, 0x6c, 0x5c, 0xad, 0x5b, 0x33, 0xbd, 0x9a, 0x96, 0x2a, 0x30, 0x7f, 0x50, 0x86, 0xba, 0x62,
+	0xb8, 0xe0, 0xf3, 0x9b, 0x50, 0x12, 0x02, 0x3a, 0x0c, 0xfe, 0xb2, 0x02, 0xda, 0x80, 0x86, 0xa4,
+	0xaf, 0x17, 0x35, 0x27, 0xfe, 0x41, 0xd0, 0xd0, 0x6b, 0xba, 0x06, 0x75, 0x49, 0x91, 0xb9, 0x34,
+	0x94, 0xbc, 0xcf, 0x03, 0x72, 0x41, 0x61, 0xd3, 0xa5, 0x21, 0xda, 0x82, 0x29, 0x97, 0x7e, 0x3b,
+	0xa6, 0x0e, 0xe5, 0x07, 0xf6, 0x36, 0x21, 0x79, 0xc5, 0xa6, 0x99, 0x52, 0xb9, 0x4d, 0x08, 0x72,
+	0xe0, 0x42, 0x1f, 0x55, 0x9b, 0xfa, 0xb6, 0x94, 0x52, 0x89, 0xbb, 0x1c, 0xe4, 0xcf, 0x65, 0xc9,
+	0xaf, 0xfa, 0xcb, 0x82, 0x16, 0xfa, 0x49, 0x28, 0x53, 0xdf, 0xe6, 0xfb, 0x12, 0xaa, 0x8d, 0x9b,
+	0x30, 0x9f, 0xca, 0x50, 0xc2, 0x02, 0xea, 0x6f, 0xed, 0xa3, 0xb7, 0xa0, 0x1a, 0xc4, 0xdc, 0xe6,
+	0xfb, 0x4c, 0x83, 0xf0, 0x68, 0xc5, 0x4a, 0x10, 0xf3, 0xad, 0x7d, 0x86, 0x16, 0x00, 0x88, 0x47,
+	0xb9, 0xad, 0x74, 0xdb, 0xf1, 0x48, 0xac, 0x8b, 0x5a, 0x92, 0xd9, 0x92, 0xc1, 0x07, 0x3e, 0xdf,
+	0xb1, 0x63, 0x9f, 0x72, 0x26, 0x81, 0x99, 0x8b, 0xc1, 0x82, 0xc6, 0x7d, 0x41, 0x02, 0xbd, 0x07,
+	0x17, 0x59, 0xa2, 0x54, 0x14, 0x38, 0x53, 0x51, 0x07, 0x29, 0xd1, 0xaf, 0xb0, 0xac, 0xce, 0xf9,
+	0x28, 0x91, 0xfb, 0x77, 0xe0, 0xfc, 0x40, 0x3b, 0xa5, 0x06, 0x1a, 0xb2, 0x11, 0xea, 0x6b, 0xb4,
+	0x2c, 0x4a, 0xcc, 0xdf, 0x2e, 0xc1, 0x8c, 0xc4, 0xf4, 0xe2, 0xf6, 0x36, 0x75, 0x29, 0xe6, 0x44,
+	0x30, 0x6f, 0x92, 0x3a, 0x0c, 0x41, 0xc9, 0x23, 0x5e, 0xa0, 0x70, 0x6f, 0xc9, 0x6f, 0xa1, 0xbb,
+	0x64, 0x0b, 0xec, 0x11, 0x85, 0x5f, 0x2b, 0x4d, 0xa3, 0xfb, 0x30, 0x95, 0xaa, 0xf7, 0x88, 0x30,
+	0xa6, 0xe1, 0xf8, 0xce, 0x93, 0xc3, 0xb9, 0xb7, 0x47, 0xea, 0x7b, 0x51, 0xb5, 0xb3, 0x9a, 0x89,
+	0x51, 0x10, 0xa9, 0x9e, 0xb9, 0x2a, 0x3f, 0xd3, 0x5c, 0x59, 0xd0, 0xec, 0x46, 0x01, 0x63, 0x36,
+	0xf6, 0xe4, 0xea, 0xe5, 0x55, 0x83, 0x92, 0xc8, 0xa2, 0xa4, 0x81, 0x2e, 0x43, 0x53, 0x08, 0x41,
+	0x3b, 0x64, 0x36, 0xa7, 0x9d, 0x47, 0x12, 0x86, 0x25, 0x0b, 0xb6, 0x09, 0x59, 0x0a, 0xd9, 0x16,
+	0xed, 0x3c, 0x42, 0xf7, 0x40, 0xa4, 0x92, 0x3e, 0x6b, 0xf9, 0xfa, 0xac, 0x6f, 0x13, 0xa2, 0x7b,
+	0xbc, 0x00, 0x95, 0x10, 0x47, 0xc4, 0x57, 0x9a, 0xb2, 0x6e, 0xe9, 0x14, 0xba, 0x04, 0x0d, 0x16,
+	0xb7, 0x6d, 0x3d, 0x1a, 0x8d, 0xa7, 0x3a, 0x8b, 0xdb, 0xb7, 0xe5, 0x58, 0xcc, 0x3f, 0x2c, 0x27,
+	0x88, 0x70, 0x9c, 0xb5, 0x44, 0xe4, 0x46, 0xd7, 0x76, 0x0f, 0xe0, 0x6c, 0x18, 0x05, 0xbb, 0xd4,
+	0x21, 0x91, 0x96, 0x87, 0x9c, 0x0a, 0x6f, 0x2a, 0x21, 0xa3, 0x44, 0xe2, 0x08, 0x2c, 0x8c, 0x89,
+	0xc0, 0xc2, 0x82, 0x66, 0xe2, 0x9a, 0xa4, 0x06, 0x33, 0x0f, 0xaf, 0xb5, 0x77, 0x22, 0x57, 0xde,
+	0x82, 0x66, 0xe2, 0x83, 0x48, 0x9a, 0x39, 0x15, 0x5e, 0x43, 0xbb, 0x21, 0x92, 0xe6, 0x27, 0xa0,
+	0xba, 0xb0, 0x95, 0x5c, 0x2a, 0x48, 0xfe, 0xf4, 0xe3, 0xc3, 0xb9, 0x9a, 0x15, 0xfb, 0xe4, 0xe4,
+	0xb2, 0xa9, 0x5c, 0xa8, 0x2d, 0x21, 0xa0, 0x0f, 0x41, 0xf5, 0xa4, 0x49, 0x57, 0x25, 0xe9, 0x9f,
+	0x79, 0x7c, 0x38, 0x57, 0x97, 0xdc, 0xcd, 0x41, 0x1b, 0xeb, 0x76, 0x8e, 0xe0, 0x5a, 0xea, 0x40,
+	0x49, 0xae, 0xd5, 0xf2, 0x72, 0x2d, 0x71, 0xbb, 0x44, 0xca, 0xfc, 0x6e, 0x09, 0xa6, 0x24, 0x46,
+	0x3f, 0xa6, 0x7c, 0xc7, 0x89, 0xf0, 0xde, 0xf3, 0xc7, 0xe7, 0x15, 0x68, 0xb6, 0x31, 0xa3, 0xcc,
+	0x0e, 0x03, 0xea, 0x73, 0x05, 0x4f, 0xc3, 0x6a, 0xc8, 0xbc, 0x0d, 0x99, 0xa5, 0x7c, 0xd3, 0x03,
+	0xcf, 0x23, 0x3c, 0x3a, 0x90, 0x40, 0x6b, 0x2e, 0xcd, 0xeb, 0x5e, 0xdf, 0x18, 0xa1, 0xd7, 0x15,
+	0xd2, 0xb1, 0x7a, 0x04, 0x7a, 0xa6, 0xaf, 0x3c, 0xd4, 0xf4, 0xdd, 0xeb, 0xb3, 0x67, 0x39, 0x55,
+	0x59, 0xc6, 0xd8, 0x25, 0xf4, 0x94, 0x2d, 0xaf, 0x8e, 0x41, 0x4f, 0x59, 0x70, 0x1b, 0xce, 0x51,
+	0x2f, 0xb4, 0x5d, 0xa1, 0x6f, 0xc5, 0x26, 0x83, 0x74, 0x38, 0x0d, 0xfc, 0xbc, 0xfa, 0x6f, 0x86,
+	0x7a, 0xe1, 0x5a, 0xc0, 0xd8, 0x46, 0x4a, 0xc9, 0xfc, 0x4e, 0x19, 0x5e, 0x91, 0x58, 0xd9, 0x20,
+	0xbe, 0x43, 0xfd, 0x6e, 0x0e, 0x9d, 0xf6, 0x4d, 0x68, 0x86, 0xaa, 0xb1, 0x2d, 0xfa, 0x92, 0x88,
+	0x39, 0x7b, 0xf3, 0x6b, 0xf3, 0xaa, 0xe3, 0x41, 0xba, 0x5b, 0x07, 0x21, 0xb1, 0x1a, 0xba, 0x81,
+	0x48, 0x7c, 0x95, 0x74, 0xd7, 0x11, 0x81, 0x2d, 0x4f, 0x42, 0x60, 0x8f, 0xa8, 0xc4, 0xca, 0xe4,
+	0x55, 0x62, 0xf5, 0xf4, 0x54, 0x62, 0x6d, 0x82, 0x2a, 0xd1, 0xfc, 0x0c, 0x1a, 0x12, 0x8e, 0x2b,
+	0x81, 0x8f, 0x39, 0x19, 0x1d, 0x84, 0xa9, 0xbc, 0x17, 0x87, 0xc9, 0xbb, 0x69, 0xeb, 0x3d, 0x8a,
+	0xd8, 0xa6, 0x8f, 0x4e, 0xfc, 0x2d, 0xa8, 0x6c, 0x72, 0xcc, 0x63, 0xa6, 0xb1, 0x3d, 0x93, 0x60,
+	0x3b, 0x08, 0x5c, 0x55, 0x60, 0xe9, 0x0a, 0xe6, 0x9a, 0x0a, 0x01, 0x88, 0xed, 0xf1, 0x09, 0x42,
+	0x00, 0x17, 0xa0, 0xa2, 0x59, 0x5f, 0x94, 0x8a, 0x51, 0xa7, 0xcc, 0xdf, 0x2f, 0xc0, 0x59, 0x39,
+	0x5e, 0x8b, 0xec, 0xe1, 0xc8, 0x61, 0x0f, 0x16, 0x84, 0x3b, 0xdd, 0x0e, 0x7c, 0xc7, 0x8e, 0x64,
+	0x8e, 0x76, 0x41, 0x4f, 0xee, 0x4e, 0x0b, 0x1a, 0x8a, 0x28, 0xba, 0x05, 0x4d, 0x31, 0x4b, 0x4d,
+	0x51, 0xcc, 0xd1, 0xb8, 0xda, 0xb8, 0x79, 0x36, 0x33, 0xc7, 0x45, 0x2f, 0x19, 0x6f, 0x43, 0xd4,
+	0xd4, 0x83, 0x31, 0x7f, 0x50, 0x84, 0x66, 0x76, 0x74, 0x2f, 0xd0, 0xd8, 0xd0, 0x2f, 0xc0, 0x8c,
+	0x82, 0x7f, 0xa6, 0x79, 0xde, 0xcd, 0xe0, 0xb4, 0xa4, 0xb4, 0x91, 0x52, 0x47, 0x9f, 0x40, 0x4b,
+	0xe0, 0xd8, 0xde, 0x8e, 0x7b, 0x93, 0xcd, 0xa9, 0x5e, 0xce, 0x0a, 0x42, 0xb7, 0xe3, 0x64, 0xc2,
+	0xe6, 0xaf, 0x14, 0xb4, 0x00, 0x58, 0x44, 0x50, 0x17, 0xfb, 0x83, 0x4e, 0xe0, 0x10, 0xb9, 0x96,
+	0x53, 0x96, 0xfc, 0x16, 0x68, 0x51, 0xbb, 0x71, 0xbd, 0x6b, 0xd0, 0xa9, 0x9e, 0x0c, 0x18, 0x43,
+	0x6d, 0xde, 0xeb, 0x60, 0x24, 0xfb, 0xd8, 0xc6, 0xcd, 0x46, 0x52, 0x49, 0xf8, 0xb7, 0x3a, 0x40,
+	0xb0, 0x4d, 0x88, 0xf9, 0xdd, 0x82, 0x96, 0x94, 0xa5, 0xc0, 0x77, 0xd0, 0x9d, 0x14, 0x9f, 0x39,
+	0x79, 0xaa, 0x9b, 0xa3, 0xb7, 0xa1, 0x2e, 0x11, 0x92, 0x31, 0x14, 0xd3, 0x9a, 0x99, 0xa2, 0x23,
+	0x69, 0x1c, 0x6a, 0x6d, 0xfd, 0x25, 0x26, 0x24, 0x54, 0x8c, 0x7f, 0xfc, 0x84, 0xf8, 0xfe, 0xaa,
+	0x6f, 0xfe, 0xb0, 0xa0, 0xfd, 0x1d, 0x41, 0xe2, 0xc1, 0xc2, 0x3b, 0xef, 0xbe, 0xd8, 0xe3, 0xed,
+	0x29, 0x86, 0xd2, 0xb3, 0x14, 0x83, 0xf9, 0x1f, 0x05, 0xa8, 0xde, 0xc1, 0x6c, 0x43, 0x69, 0xa1,
+	0xe7, 0x14, 0x52, 0xec, 0x8b, 0x1a, 0x1a, 0xe3, 0x46, 0x0d, 0xfb, 0xa2, 0x6f, 0x86, 0x8e, 0xbe,
+	0x99, 0xef, 0x41, 0x4d, 0xb2, 0xf0, 0x0e, 0x66, 0xe8, 0x1a, 0x94, 0x85, 0xd4, 0xb2, 0xd9, 0x42,
+	0x9f, 0xb4, 0xeb, 0x75, 0x48, 0x66, 0x2a, 0xab, 0x98, 0xbf, 0x5a, 0x48, 0x75, 0x90, 0x0c, 0xef,
+	0xa2, 0x0d, 0x38, 0xf7, 0x94, 0x48, 0xaf, 0x5e, 0xb3, 0x57, 0x35, 0x29, 0x5d, 0x79, 0xb9, 0x57,
+	0x41, 0x53, 0x45, 0xd1, 0x91, 0x92, 0x51, 0x4d, 0xcb, 0x1d, 0xb8, 0xa0, 0xc2, 0x5f, 0x9d, 0x1d,
+	0xe2, 0xc4, 0x2e, 0x71, 0xd6, 0x63, 0xde, 0x0e, 0x84, 0x0c, 0x5f, 0x87, 0x8a, 0x8a, 0xaf, 0xe8,
+	0x51, 0xb4, 0xf4, 0x28, 0xb6, 0xf6, 0xd7, 0x63, 0xbe, 0xca, 0x89, 0x97, 0x4c, 0x49, 0x06, 0x59,
+	0xcc, 0x65, 0x8d, 0xe6, 0x4d, 0xd2, 0x89, 0x23, 0xe1, 0x89, 0xb5, 0xc0, 0xf0, 0x58, 0x57, 0x41,
+	0xd9, 0x12, 0x9f, 0xe8, 0x32, 0x14, 0x87, 0x8c, 0xa7, 0xc8, 0xf7, 0x4d, 0x1f, 0x40, 0x11, 0x71,
+	0x31, 0xdb, 0x19, 0xdd, 0xd2, 0xdd, 0x82, 0x26, 0x13, 0x2d, 0xec, 0xd4, 0x1c, 0x0d, 0xd1, 0xb7,
+	0xb2, 0xa6, 0x72, 0x37, 0xcc, 0xbf, 0x28, 0xc2, 0xb9, 0x5e, 0x87, 0x3d, 0x2f, 0xf2, 0x33, 0x98,
+	0x11, 0xe6, 0xde, 0x96, 0x52, 0x94, 0x78, 0x4d, 0x05, 0xe9, 0xdd, 0x2f, 0x3c, 0x39, 0x9c, 0xbb,
+	0x3e, 0x02, 0x7e, 0x16, 0x3b, 0x9d, 0xc4, 0x6d, 0x9a, 0x16, 0xb4, 0x84, 0xe0, 0x1d, 0x89, 0x5b,
+	0x14, 0x9f, 0x29, 0x13, 0x1f, 0x42, 0x75, 0x5c, 0x07, 0x33, 0x21, 0x80, 0x3e, 0x84, 0x9a, 0x1b,
+	0xea, 0x0d, 0x52, 0x4e, 0xc5, 0x5f, 0x75, 0x43, 0xb9, 0x35, 0x32, 0x7f, 0x37, 0xd1, 0xf8, 0xef,
+	0x47, 0x11, 0xe6, 0x78, 0xa2, 0xd1, 0xa5, 0xf7, 0x12, 0x49, 0x3a, 0xca, 0xc7, 0xbb, 0x81, 0xb3,
+	0xd4, 0x12, 0x83, 0xfe, 0xd3, 0x7f, 0x99, 0xab, 0xe9, 0x0c, 0x96, 0x48, 0xd5, 0x3f, 0x16, 0xb4,
+	0x38, 0x4e, 0x3a, 0xdc, 0xa5, 0x6d, 0x4f, 0x71, 0x98, 0xed, 0x19, 0x8c, 0x18, 0x1a, 0x63, 0x47,
+	0x0c, 0xcd, 0x5f, 0x4e, 0x2c, 0x44, 0x2a, 0x93, 0x1f, 0x41, 0x4d, 0x0a, 0x75, 0x6f, 0x5e, 0xb7,
+	0x1e, 0x1f, 0xce, 0x55, 0x56, 0xfd, 0x93, 0xcf, 0xac, 0x22, 0xc4, 0x7f, 0xd5, 0x19, 0x41, 0x28,
+	0x7f, 0xaf, 0xa0, 0x37, 0x5b, 0x5b, 0x8c, 0x7d, 0x8b, 0x1c, 0x74, 0x89, 0xbf, 0x19, 0x77, 0x3a,
+	0x02, 0x50, 0x1f, 0x40, 0x35, 0x8c, 0xdb, 0xf6, 0x23, 0x72, 0x90, 0x58, 0xac, 0x27, 0x87, 0x73,
+	0x5f, 0x1f, 0x69, 0x0c, 0x1b, 0x71, 0xfb, 0x5b, 0xe4, 0xc0, 0xaa, 0x84, 0xf2, 0x3f, 0x9a, 0x85,
+	0xaa, 0x47, 0xbc, 0x36, 0x89, 0x14, 0xd3, 0xeb, 0x56, 0x92, 0x14, 0x6e, 0x83, 0x3e, 0xdb, 0x50,
+	0xbb, 0x6f, 0x9d, 0x32, 0xff, 0xf8, 0xc8, 0xa8, 0x6e, 0x63, 0xea, 0xc6, 0x11, 0x41, 0x73, 0x20,
+	0x4f, 0x04, 0x74, 0xec, 0x5f, 0x2b, 0x20, 0x10, 0x59, 0x2a, 0xe8, 0x8f, 0x7e, 0x1c, 0x80, 0x32,
+	0xc1, 0xa6, 0x0e, 0x66, 0x4a, 0x06, 0x6b, 0x56, 0x9d, 0xb2, 0xfb, 0x2a, 0x43, 0xb4, 0x6f, 0xbb,
+	0xd8, 0x23, 0xb6, 0x18, 0xaf, 0x60, 0xa4, 0x18, 0x0f, 0xc8, 0xac, 0x7b, 0x22, 0x47, 0xd8, 0x82,
+	0x48, 0xb0, 0x43, 0x09, 0x91, 0xa5, 0x12, 0x99, 0x81, 0x96, 0xfb, 0x06, 0xfa, 0x5b, 0x05, 0x38,
+	0xdf, 0x3f, 0xd0, 0xbb, 0x84, 0x47, 0xb4, 0x33, 0xc1, 0xd5, 0x7b, 0x1b, 0x90, 0x47, 0x1c, 0x8a,
+	0x7d, 0xdb, 0x89, 0x23, 0x2c, 0x76, 0xc8, 0xb6, 0xc7, 0xb4, 0x53, 0xde, 0x52, 0x25, 0x2b, 0xba,
+	0xe0, 0xae, 0x14, 0xdd, 0xec, 0xca, 0x31, 0xda, 0x4d, 0x46, 0x34, 0x49, 0x99, 0x39, 0xd9, 0x98,
+	0xfe, 0xa8, 0x00, 0xd3, 0x3d, 0x45, 0x2c, 0x63, 0x2b, 0x68, 0x0b, 0x9a, 0x52, 0x09, 0x8f, 0xad,
+	0x7f, 0x1b, 0x82, 0x4c, 0xa2, 0x7b, 0xaf, 0x24, 0xb6, 0x42, 0xc7, 0x74, 0xd4, 0x88, 0x94, 0x55,
+	0xd0, 0x31, 0x9d, 0x9e, 0xa7, 0x6a, 0x64, 0x3d, 0x55, 0x73, 0x07, 0x2e, 0xa6, 0xdb, 0xb0, 0x25,
+	0xec, 0x62, 0xbf, 0x43, 0x96, 0x77, 0xb0, 0xdf, 0x25, 0x0e, 0x7a, 0x17, 0xa4, 0x1f, 0x6f, 0x77,
+	0x64, 0x5a, 0x5b, 0xac, 0x41, 0xc5, 0xa5, 0x44, 0x0a, 0x44, 0x45, 0xd5, 0xee, 0x38, 0x9f, 0xd8,
+	0xfc, 0x93, 0xa2, 0xd6, 0xae, 0x9b, 0x7b, 0x94, 0x77, 0x76, 0xd0, 0x06, 0x00, 0x0f, 0xc6, 0x5f,
+	0x88, 0x3a, 0x4f, 0xe3, 0x0c, 0x9b, 0xd0, 0xdc, 0x8e, 0x02, 0x2f, 0xa5, 0x59, 0xcc, 0x69, 0x5c,
+	0x1a, 0x82, 0x4a, 0x42, 0xf4, 0x0d, 0x28, 0xb5, 0xe3, 0x28, 0x71, 0x24, 0x9f, 0x76, 0xc2, 0x22,
+	0xcb, 0x7b, 0x38, 0x2b, 0x8d, 0x8d, 0x33, 0xf3, 0x47, 0x45, 0xbd, 0xd9, 0x54, 0x4b, 0xf5, 0xe0,
+	0x1b, 0xb7, 0x5e, 0xae, 0xd6, 0xf1, 0x52, 0xb9, 0x0c, 0x25, 0x8f, 0xe6, 0x0f, 0x5f, 0xcb, 0xc6,
+	0xe6, 0xdf, 0x18, 0xfa, 0x34, 0xe1, 0xee, 0xe2, 0x27, 0x8b, 0xf7, 0xb0, 0x47, 0x1e, 0x2c, 0x2c,
+	0x2c, 0x88, 0x3d, 0x9f, 0x3c, 0xfb, 0x51, 0xfa, 0x56, 0x7e, 0xa3, 0x15, 0x28, 0xcb, 0x21, 0xe9,
+	0x05, 0x9b, 0x7f, 0x72, 0x38, 0x77, 0x6d, 0xa4, 0x21, 0x2f, 0x8b, 0x5c, 0x4b, 0x35, 0x9e, 0xa8,
+	0x0f, 0xf4, 0x10, 0x5a, 0x11, 0xe9, 0x52, 0xc6, 0xb5, 0x4e, 0x1a, 0xe3, 0x6c, 0x74, 0x3a, 0x4b,
+	0x48, 0xb9, 0x1c, 0x35, 0xb9, 0xb7, 0x16, 0x1b, 0x8e, 0x9c, 0x0b, 0x5c, 0x15, 0x04, 0xc4, 0x7e,
+	0xe3, 0x02, 0x54, 0xc8, 0x7e, 0x48, 0x23, 0x22, 0xc3, 0x6a, 0x86, 0xa5, 0x53, 0xe8, 0x0e, 0x94,
+	0x83, 0x3d, 0x9f, 0x44, 0x32, 0x34, 0x96, 0x0b, 0xd6, 0xaa, 0xbd, 0xf9, 0x9f, 0x49, 0xb8, 0x3d,
+	0x61, 0xe2, 0x4b, 0x06, 0x7e, 0xa5, 0x18, 0x88, 0x5e, 0x87, 0x29, 0x9c, 0x9c, 0xef, 0xca, 0x53,
+	0xbf, 0x9a, 0xec, 0xa7, 0x99, 0x66, 0x2e, 0x85, 0x0c, 0x7d, 0x1d, 0x66, 0x58, 0xdc, 0xee, 0xd5,
+	0x93, 0x0c, 0xae, 0x4b, 0x8f, 0xa6, 0x95, 0x2d, 0x90, 0x00, 0x78, 0x08, 0x7d, 0x79, 0xfa, 0x28,
+	0xd1, 0xc8, 0xb5, 0xb4, 0x59, 0x42, 0x4b, 0x21, 0x33, 0x6f, 0xa5, 0xdb, 0x43, 0x7e, 0x97, 0x7a,
+	0x34, 0x12, 0xdb, 0xc3, 0xd4, 0xf3, 0xb1, 0xc4, 0xa7, 0x70, 0xab, 0x76, 0xb1, 0x1b, 0x13, 0x6d,
+	0x0b, 0x55, 0xc2, 0xbc, 0xaf, 0x75, 0xcd, 0x26, 0xe1, 0xc2, 0xfb, 0x3a, 0x51, 0x63, 0xe1, 0x56,
+	0xf6, 0x01, 0x2f, 0x85, 0x91, 0xf9, 0xbd, 0xa2, 0x76, 0x82, 0x96, 0x17, 0x97, 0x17, 0xd7, 0x85,
+	0x85, 0x5e, 0xd1, 0xd7, 0x55, 0x1e, 0x0c, 0x06, 0xf6, 0x73, 0x1b, 0x90, 0xe1, 0x91, 0xfd, 0xe2,
+	0x04, 0x22, 0xfb, 0xef, 0x43, 0x79, 0xac, 0xdd, 0x86, 0x6a, 0x8d, 0x96, 0xfa, 0x2d, 0xcc, 0xf5,
+	0x3c, 0x76, 0xf8, 0xdf, 0x4a, 0xf0, 0x5a, 0xff, 0x82, 0x26, 0xe7, 0x78, 0x0f, 0x16, 0x16, 0xbe,
+	0x71, 0x6a, 0xab, 0x3a, 0x78, 0x44, 0x57, 0x3c, 0x7a, 0x44, 0x37, 0xb8, 0xf0, 0xc6, 0x24, 0x17,
+	0xbe, 0x34, 0x99, 0x85, 0x2f, 0xe7, 0x5e, 0x78, 0x34, 0x0f, 0xe7, 0x32, 0x22, 0xab, 0xd6, 0x82,
+	0x33, 0xad, 0x75, 0x66, 0x7a, 0x42, 0x28, 0x57, 0x84, 0x4b, 0x05, 0xda, 0xab, 0xaf, 0x97, 0x24,
+	0xe7, 0x91, 0xdf, 0x74, 0x4a, 0x48, 0x2f, 0xcb, 0x67, 0x30, 0x93, 0xa1, 0x3d, 0xe6, 0xf1, 0x70,
+	0x6f, 0x98, 0xc9, 0x11, 0xf1, 0xff, 0x1a, 0x3a, 0x5a, 0x75, 0x04, 0x63, 0x2f, 0xf1, 0xf5, 0xff,
+	0x01, 0x5f, 0xe6, 0x2f, 0x15, 0xe1, 0xc7, 0x9e, 0x0e, 0x80, 0x8f, 0x62, 0x12, 0x13, 0xe7, 0x79,
+	0xc2, 0xe0, 0x75, 0x98, 0xf2, 0x30, 0x8f, 0x23, 0x62, 0xf7, 0xc5, 0x2b, 0x9a, 0x2a, 0x53, 0xdf,
+	0xc6, 0x9c, 0x84, 0xa6, 0xfd, 0xbb, 0xc2, 0xa0, 0x14, 0x2c, 0x07, 0x5e, 0x28, 0x63, 0x10, 0x5f,
+	0x21, 0xdb, 0x65, 0xfe, 0x9a, 0x01, 0xb3, 0x2a, 0x0c, 0x11, 0x61, 0x87, 0x2c, 0x76, 0x64, 0x44,
+	0x3d, 0x31, 0xc2, 0x13, 0x3b, 0x0a, 0x39, 0x41, 0xa8, 0xf5, 0xc8, 0x31, 0xb9, 0x31, 0x91, 0x63,
+	0xf2, 0x53, 0xba, 0xfb, 0xf6, 0x61, 0xbf, 0x68, 0x8f, 0xb5, 0x87, 0xfe, 0x75, 0x03, 0x5e, 0x3d,
+	0xc2, 0x8a, 0x54, 0xb5, 0xbe, 0xe4, 0xc5, 0x97, 0xc9, 0x8b, 0xcf, 0x9f, 0xc6, 0x8b, 0xad, 0x08,
+	0xfb, 0x6c, 0x9b, 0x44, 0xcf, 0x85, 0x17, 0x83, 0xc1, 0x0f, 0x63, 0x12, 0xc1, 0x8f, 0xf5, 0xbe,
+	0x18, 0x4d, 0x5e, 0x36, 0x64, 0x42, 0x34, 0xa9, 0xc5, 0x2c, 0x8f, 0x65, 0x31, 0x53, 0x56, 0x56,
+	0xc6, 0x67, 0xe5, 0x6f, 0x96, 0x74, 0xe4, 0x77, 0x8d, 0x7a, 0x94, 0xaf, 0x47, 0x0e, 0x89, 0x96,
+	0xdd, 0x80, 0x4d, 0xf6, 0x6c, 0xe2, 0x54, 0x42, 0x53, 0xd7, 0xa0, 0xc2, 0x82, 0x38, 0xea, 0x90,
+	0x21, 0xc1, 0x29, 0x5d, 0x03, 0xbd, 0x07, 0x4d, 0x75, 0x0b, 0xde, 0x7e, 0xe6, 0xf1, 0x70, 0x43,
+	0x55, 0x5c, 0x4c, 0x6e, 0xe4, 0xf6, 0x3d, 0x4c, 0x28, 0x4f, 0xe0, 0x61, 0xc2, 0xeb, 0x30, 0x25,
+	0xb7, 0xd9, 0x07, 0x89, 0x0d, 0x56, 0x5e, 0x4a, 0x53, 0x65, 0x6a, 0x1b, 0xdc, 0x0b, 0xba, 0x56,
+	0xfb, 0x2e, 0x22, 0x7c, 0x26, 0x8c, 0x9c, 0xdf, 0x21, 0x6e, 0xdf, 0x0d, 0xa1, 0x9f, 0x7d, 0x7c,
+	0x38, 0x07, 0xcb, 0x32, 0xff, 0xe4, 0x2c, 0x82, 0x4e, 0xd2, 0xd0, 0x31, 0xff, 0xdc, 0xd0, 0x67,
+	0x8d, 0x3d, 0x34, 0xdc, 0xa6, 0xae, 0x3b, 0x51, 0x30, 0xa8, 0xa7, 0x16, 0xc5, 0x51, 0x9e, 0x5a,
+	0x18, 0xc3, 0x9f, 0x5a, 0xac, 0x41, 0x7d, 0x9b, 0xba, 0x2e, 0x71, 0x6c, 0xea, 0xe7, 0x7e, 0x73,
+	0xa3, 0x28, 0xac, 0xfa, 0xf2, 0x1e, 0xb4, 0xa2, 0x26, 0xba, 0x2e, 0xe7, 0xbd, 0x07, 0x2d, 0x49,
+	0xac, 0xc7, 0x1c, 0xdd, 0x85, 0x7a, 0x44, 0x3c, 0x4c, 0x7d, 0xea, 0x77, 0x73, 0xdf, 0x7f, 0x4c,
+	0x29, 0xf4, 0x0e, 0xf7, 0xab, 0x99, 0xa7, 0x35, 0xe6, 0x8f, 0x12, 0x07, 0xa5, 0xef, 0x2d, 0x90,
+	0x82, 0xc2, 0x44, 0xb9, 0x36, 0x08, 0xbc, 0xe2, 0x44, 0x81, 0x77, 0x3a, 0xfa, 0x3b, 0xfb, 0x52,
+	0xa9, 0x74, 0xdc, 0x4b, 0xa5, 0x72, 0xf6, 0xa5, 0x52, 0xe6, 0xd1, 0x50, 0x65, 0xd4, 0x47, 0x43,
+	0xd5, 0x51, 0x90, 0x5c, 0x1b, 0x8e, 0xe4, 0x6b, 0x42, 0xdc, 0xb7, 0x63, 0xdf, 0x19, 0xf2, 0xba,
+	0x48, 0xd7, 0x30, 0xff, 0xdb, 0xd0, 0x61, 0xaa, 0x95, 0xe5, 0x45, 0x29, 0xa1, 0x2f, 0xbe, 0xaa,
+	0xb6, 0xa0, 0xe1, 0x10, 0xc6, 0xa9, 0x2f, 0xa3, 0x98, 0xf9, 0x99, 0x9b, 0x21, 0x92, 0x65, 0x55,
+	0xe9, 0xd9, 0xac, 0x1a, 0x34, 0x00, 0xe5, 0x11, 0x0d, 0x40, 0xf6, 0x21, 0x5c, 0x65, 0xc8, 0x43,
+	0xb8, 0xea, 0x00, 0xbc, 0x36, 0xa0, 0xc1, 0x5c, 0xda, 0x21, 0xb6, 0x2b, 0x14, 0x69, 0xde, 0x5b,
+	0xc5, 0x20, 0x69, 0x48, 0x5d, 0x6c, 0xfe, 0x75, 0xb1, 0xc7, 0xf6, 0x4d, 0x91, 0x3d, 0xe9, 0x07,
+	0x7f, 0xe9, 0x5c, 0x8a, 0xc7, 0x89, 0x8a, 0x91, 0x15, 0x15, 0x05, 0xfe, 0xd2, 0x28, 0xe0, 0x2f,
+	0x0f, 0x07, 0xff, 0xc0, 0x5a, 0x55, 0xc6, 0x5e, 0xab, 0xe3, 0xac, 0xa7, 0xf9, 0x97, 0x65, 0xbd,
+	0x86, 0x6b, 0x01, 0xf6, 0xd7, 0x43, 0xe2, 0xa3, 0xdb, 0x49, 0xa4, 0xbb, 0x90, 0x13, 0x93, 0x3a,
+	0xd0, 0xfd, 0x4d, 0x68, 0x75, 0x02, 0xd7, 0xc5, 0x9c, 0x44, 0xd8, 0xb5, 0x9f, 0xe9, 0xb5, 0x4e,
+	0xf7, 0x2a, 0x2b, 0x9c, 0xb5, 0xe1, 0x7c, 0xa6, 0xbd, 0x46, 0x2d, 0xc9, 0x7d, 0xaf, 0xf2, 0x5c,
+	0x8f, 0xd8, 0x4a, 0x42, 0x0b, 0x3d, 0xec, 0x1b, 0xe3, 0x58, 0xa1, 0x9b, 0xcc, 0xf8, 0xd5, 0x2b,
+	0x84, 0x9b, 0x00, 0x0e, 0x69, 0x8f, 0x20, 0x5d, 0x75, 0x51, 0x2d, 0x7d, 0xbe, 0x26, 0xdb, 0x50,
+	0xc6, 0x62, 0xe2, 0xe4, 0xe6, 0xbb, 0xa0, 0xb1, 0x2a, 0x49, 0xa0, 0x8f, 0xe1, 0x6c, 0x22, 0xe5,
+	0x5a, 0x7d, 0x55, 0x73, 0xb2, 0x75, 0x4a, 0x2b, 0x01, 0xad, 0xc0, 0x6e, 0xc1, 0xc5, 0xde, 0x8c,
+	0xe9, 0x2f, 0xaa, 0x53, 0x1d, 0x79, 0x26, 0xa3, 0x4f, 0x34, 0x2e, 0x1c, 0x29, 0xb6, 0xc4, 0xdf,
+	0x9e, 0x8c, 0xd6, 0xc7, 0x77, 0xd5, 0x9f, 0x24, 0xef, 0x7e, 0x05, 0x7a, 0x2d, 0x12, 0xe2, 0x03,
+	0x8f, 0xf8, 0xfc, 0x05, 0x85, 0xf0, 0x9e, 0xde, 0x99, 0xfb, 0x13, 0x80, 0x70, 0xb2, 0xcb, 0xf7,
+	0x07, 0x60, 0x56, 0x3a, 0x11, 0xcc, 0x22, 0x12, 0xe2, 0x74, 0xfb, 0x9b, 0x0f, 0x66, 0x96, 0x24,
+	0x81, 0xde, 0x80, 0x52, 0x27, 0xa0, 0xfe, 0x10, 0x17, 0x41, 0x96, 0xf7, 0x98, 0x5f, 0x1d, 0x9f,
+	0xf9, 0xdf, 0x33, 0xf4, 0x15, 0x02, 0xc1, 0x7c, 0xb5, 0x43, 0x7b, 0xc9, 0xf8, 0x2f, 0x9b, 0xf1,
+	0x93, 0xdc, 0x78, 0xff, 0x41, 0xba, 0xf1, 0x0e, 0xb0, 0xaf, 0x6e, 0x75, 0x2a, 0xc7, 0xe6, 0x45,
+	0x61, 0xab, 0x03, 0xaf, 0x64, 0xda, 0xbb, 0x7a, 0x84, 0xf9, 0x6d, 0x52, 0x06, 0x24, 0x6b, 0x29,
+	0xb1, 0x17, 0x84, 0xb1, 0x3f, 0x0f, 0xea, 0x25, 0x82, 0x1d, 0x91, 0x4e, 0xb0, 0x4b, 0xa2, 0xfc,
+	0xe6, 0xe8, 0xac, 0xa4, 0x63, 0x25, 0x64, 0x86, 0x59, 0x8e, 0xea, 0x30, 0xcb, 0x61, 0xfe, 0x4f,
+	0x71, 0xe0, 0x02, 0xcb, 0x1a, 0x65, 0xfc, 0xa9, 0xf7, 0x1f, 0x3e, 0x80, 0x0a, 0x23, 0xae, 0x4b,
+	0xa2, 0xdc, 0xce, 0xba, 0x6e, 0x8f, 0xde, 0x87, 0x72, 0x18, 0x51, 0x1d, 0x51, 0xc9, 0x13, 0x9f,
+	0x92, 0xad, 0xd3, 0x08, 0x47, 0x7a, 0xca, 0x50, 0xca, 0x44, 0x38, 0x92, 0x53, 0x86, 0x2b, 0xd0,
+	0x7c, 0x44, 0x48, 0x68, 0x63, 0x97, 0x62, 0x46, 0x98, 0xfe, 0x95, 0x8b, 0x86, 0xc8, 0x5b, 0x54,
+	0x59, 0xe8, 0x3a, 0x20, 0x59, 0x25, 0x7b, 0x4e, 0xaf, 0x0e, 0x75, 0x6a, 0xd6, 0x8c, 0x28, 0xd9,
+	0xcc, 0x16, 0x4c, 0x54, 0xdd, 0xfe, 0x55, 0x41, 0x07, 0x42, 0x92, 0xd5, 0x5f, 0x21, 0xee, 0xe9,
+	0xaf, 0x7f, 0x3a, 0x03, 0x63, 0xfc, 0x19, 0xfc, 0xc3, 0x20, 0x7e, 0x36, 0xb1, 0x4b, 0x4e, 0x79,
+	0xfc, 0xb7, 0xa1, 0xdc, 0x8e, 0x0f, 0x48, 0x94, 0x7b, 0x87, 0xa7, 0x9a, 0xf7, 0x70, 0x58, 0x1a,
+	0x0b, 0x87, 0x93, 0x0c, 0x79, 0xff, 0xbd, 0xa1, 0xef, 0x6d, 0x6f, 0xac, 0xaf, 0x8d, 0x7e, 0xe9,
+	0xff, 0x02, 0x54, 0xb0, 0x7a, 0x57, 0xaa, 0xef, 0x4e, 0xaa, 0xd4, 0xa9, 0x1c, 0xc7, 0x7e, 0x0a,
+	0x33, 0xfa, 0x6e, 0x37, 0xa7, 0x89, 0x2a, 0xc9, 0xbb, 0x80, 0x2d, 0x75, 0xc3, 0xbb, 0x47, 0x08,
+	0x51, 0x98, 0xd5, 0xae, 0xf5, 0xd1, 0x4e, 0x72, 0x2a, 0xe0, 0x0b, 0x8a, 0xe0, 0xe6, 0x60, 0x57,
+	0x77, 0xa0, 0xd2, 0x8e, 0xb7, 0xb7, 0x49, 0x94, 0x57, 0x07, 0xeb, 0xe6, 0xc7, 0x6e, 0x03, 0xff,
+	0x2c, 0x11, 0x8d, 0xa5, 0xc0, 0x77, 0x36, 0xf4, 0x6b, 0xea, 0x53, 0xba, 0xca, 0xfb, 0x29, 0xb4,
+	0xd2, 0x67, 0xdf, 0xd9, 0x98, 0x4a, 0xbe, 0x47, 0x1a, 0x09, 0xa9, 0x84, 0x7a, 0x0f, 0x5f, 0x46,
+	0x1f, 0xbe, 0x26, 0x79, 0x79, 0xf5, 0x3b, 0x45, 0xed, 0xa8, 0xdc, 0x0b, 0x1c, 0xb2, 0x1e, 0x92,
+	0x08, 0xf3, 0x20, 0xba, 0x4d, 0xc8, 0x29, 0x2d, 0xd8, 0x45, 0xa8, 0x06, 0xae, 0x63, 0x27, 0x6f,
+	0x19, 0x0c, 0xab, 0x12, 0xb8, 0x8e, 0xe8, 0xee, 0x22, 0x54, 0x7d, 0xb2, 0x27, 0x0b, 0xf4, 0x2d,
+	0x7b, 0x9f, 0xec, 0x89, 0x82, 0x2b, 0xd0, 0xc4, 0x61, 0xe8, 0x1e, 0xf4, 0x5b, 0x9b, 0x86, 0xcc,
+	0xd3, 0xc6, 0x66, 0x92, 0x9a, 0xe0, 0x9f, 0x92, 0xc3, 0xaf, 0x2c, 0x7a, 0xd2, 0xc3, 0xaf, 0xd3,
+	0x59, 0x94, 0xad, 0xa7, 0x44, 0xe5, 0xf2, 0x51, 0xcd, 0x86, 0xe5, 0xfa, 0xef, 0x20, 0x1b, 0x13,
+	0xb8, 0x83, 0x3c, 0xfa, 0xfb, 0xbb, 0x17, 0xf1, 0x2c, 0xec, 0xdf, 0x0b, 0x7a, 0x83, 0xfd, 0x00,
+	0xc7, 0x2e, 0xbf, 0x4b, 0xbb, 0x91, 0x74, 0x51, 0x27, 0xf7, 0x06, 0xe2, 0x4d, 0x98, 0x8e, 0x08,
+	0xa7, 0x11, 0xf5, 0xbb, 0x09, 0x58, 0x15, 0xc6, 0xcf, 0x26, 0xd9, 0x1a, 0xaf, 0x9f, 0x42, 0xcd,
+	0xd3, 0xdd, 0xcb, 0xb7, 0x1d, 0x83, 0xbb, 0xcc, 0x9b, 0xfa, 0x79, 0xd1, 0x88, 0xf7, 0x5c, 0x03,
+	0xea, 0x33, 0x2b, 0xa5, 0x68, 0xfe, 0xad, 0xa1, 0x25, 0x7a, 0x33, 0x70, 0x77, 0x89, 0xdf, 0x39,
+	0xf8, 0x18, 0x47, 0xf2, 0x8c, 0x61, 0x72, 0x33, 0x9d, 0xcc, 0x9d, 0xdc, 0x14, 0x4e, 0xc6, 0x28,
+	0xbf, 0x9d, 0xb3, 0x2b, 0xb8, 0x36, 0xee, 0x6f, 0x12, 0x48, 0x22, 0xda, 0xa2, 0x6e, 0xc1, 0xd4,
+	0x1e, 0x76, 0xdd, 0xb1, 0x7f, 0x50, 0xa5, 0xa9, 0xa8, 0x68, 0xaa, 0x2d, 0x30, 0xba, 0x38, 0xd4,
+	0xa7, 0x7e, 0xe2, 0x13, 0xbd, 0x0a, 0xb5, 0x3d, 0x1c, 0xf9, 0xb6, 0xc8, 0x56, 0x9b, 0x82, 0xaa,
+	0x48, 0xdf, 0x51, 0x45, 0x3b, 0xd8, 0xe5, 0xb2, 0x48, 0x45, 0x9a, 0xaa, 0x22, 0x7d, 0x07, 0x87,
+	0xd7, 0xae, 0xc3, 0xf9, 0xa7, 0xfd, 0x02, 0x04, 0xaa, 0x82, 0x81, 0x1d, 0xa7, 0x75, 0x06, 0x35,
+	0xa1, 0x96, 0x6c, 0xc6, 0x5b, 0x85, 0x6b, 0x6d, 0xa8, 0x25, 0xef, 0x6a, 0xd1, 0x94, 0x7e, 0x7b,
+	0x2b, 0xf6, 0x3e, 0xad, 0x33, 0x68, 0x06, 0xa6, 0xf4, 0xe3, 0x72, 0x1e, 0x47, 0x3e, 0x71, 0x5a,
+	0x05, 0x34, 0xdd, 0xf7, 0xde, 0xbc, 0x55, 0x4c, 0x9b, 0x74, 0x02, 0xc6, 0x5b, 0x06, 0x3a, 0x0f,
+	0xad, 0x4c, 0xb9, 0x22, 0x54, 0x5a, 0x5a, 0xfd, 0xfc, 0xf1, 0xa5, 0xc2, 0xf7, 0x1f, 0x5f, 0x2a,
+	0xfc, 0xeb, 0xe3, 0x4b, 0x85, 0xdf, 0xf9, 0xe2, 0xd2, 0x99, 0xef, 0x7f, 0x71, 0xe9, 0xcc, 0x0f,
+	0xbf, 0xb8, 0x74, 0xe6, 0xe1, 0x8d, 0xe1, 0xcc, 0x3f, 0xf2, 0xcb, 0x80, 0xed, 0x8a, 0xfc, 0xe1,
+	0xbf, 0x9f, 0xfa, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa6, 0x32, 0xbf, 0x7b, 0x0c, 0x51, 0x00,
+	0x00,
+}
+
+func (m *PoolMod) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4320,33 +4665,56 @@ func (m *EventSlashLiquidity) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventSlashLiquidity) MarshalTo(dAtA []byte) (int, error) {
+func (m *PoolMod) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSlashLiquidity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PoolMod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.AssetAdd {
+		i--
+		if m.AssetAdd {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	{
-		size := m.LpUnits.Size()
+		size := m.AssetAmt.Size()
 		i -= size
-		if _, err := m.LpUnits.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.AssetAmt.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x22
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Address)))
+	if m.CacaoAdd {
 		i--
-		dAtA[i] = 0x1a
+		if m.CacaoAdd {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.CacaoAmt.Size()
+		i -= size
+		if _, err := m.CacaoAmt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -4356,18 +4724,11 @@ func (m *EventSlashLiquidity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.NodeBondAddress) > 0 {
-		i -= len(m.NodeBondAddress)
-		copy(dAtA[i:], m.NodeBondAddress)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.NodeBondAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EventErrata) Marshal() (dAtA []byte, err error) {
+func (m *EventStreamingSwap) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4377,60 +4738,143 @@ func (m *EventErrata) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventErrata) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventStreamingSwap) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventErrata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventStreamingSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Pools) > 0 {
-		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypeEvents(dAtA, i, uint64(size))
-			}
+	if len(m.FailedSwapReasons) > 0 {
+		for iNdEx := len(m.FailedSwapReasons) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FailedSwapReasons[iNdEx])
+			copy(dAtA[i:], m.FailedSwapReasons[iNdEx])
+			i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.FailedSwapReasons[iNdEx])))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x5a
 		}
 	}
-	if len(m.TxID) > 0 {
-		i -= len(m.TxID)
-		copy(dAtA[i:], m.TxID)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
+	if len(m.FailedSwaps) > 0 {
+		dAtA3 := make([]byte, len(m.FailedSwaps)*10)
+		var j2 int
+		for _, num := range m.FailedSwaps {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintTypeEvents(dAtA, i, uint64(j2))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x52
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *EventFee) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	{
+		size, err := m.Out.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.In.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.TradeTarget.Size()
+		i -= size
+		if _, err := m.TradeTarget.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.LastHeight != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.LastHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Count != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *EventFee) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSwap) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.StreamingSwapCount != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.StreamingSwapCount))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.StreamingSwapQuantity != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.StreamingSwapQuantity))
+		i--
+		dAtA[i] = 0x50
+	}
 	{
 		size := m.SynthUnits.Size()
 		i -= size
@@ -4440,9 +4884,29 @@ func (m *EventFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x4a
 	{
-		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.EmitAsset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.OutTxs.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.InTx.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4450,18 +4914,61 @@ func (m *EventFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
 	i--
+	dAtA[i] = 0x32
+	{
+		size := m.LiquidityFeeInCacao.Size()
+		i -= size
+		if _, err := m.LiquidityFeeInCacao.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.LiquidityFee.Size()
+		i -= size
+		if _, err := m.LiquidityFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.SwapSlip.Size()
+		i -= size
+		if _, err := m.SwapSlip.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SwapTarget.Size()
+		i -= size
+		if _, err := m.SwapTarget.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
 	dAtA[i] = 0x12
-	if len(m.TxID) > 0 {
-		i -= len(m.TxID)
-		copy(dAtA[i:], m.TxID)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EventOutbound) Marshal() (dAtA []byte, err error) {
+func (m *EventAffiliateFee) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4471,18 +4978,55 @@ func (m *EventOutbound) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventOutbound) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventAffiliateFee) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventOutbound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventAffiliateFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.SubFeeBps != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.SubFeeBps))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Parent) > 0 {
+		i -= len(m.Parent)
+		copy(dAtA[i:], m.Parent)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Parent)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	{
-		size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+		size := m.FeeAmount.Size()
+		i -= size
+		if _, err := m.FeeAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.FeeBpsTick != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.FeeBpsTick))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.GrossAmount.Size()
+		i -= size
+		if _, err := m.GrossAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4490,18 +5034,39 @@ func (m *EventOutbound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.InTxID) > 0 {
-		i -= len(m.InTxID)
-		copy(dAtA[i:], m.InTxID)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.InTxID)))
+	dAtA[i] = 0x2a
+	if len(m.CacaoAddress) > 0 {
+		i -= len(m.CacaoAddress)
+		copy(dAtA[i:], m.CacaoAddress)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.CacaoAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Mayaname) > 0 {
+		i -= len(m.Mayaname)
+		copy(dAtA[i:], m.Mayaname)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Mayaname)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventTssKeygenSuccess) Marshal() (dAtA []byte, err error) {
+func (m *EventAddLiquidity) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4511,41 +5076,88 @@ func (m *EventTssKeygenSuccess) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventTssKeygenSuccess) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventAddLiquidity) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventTssKeygenSuccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventAddLiquidity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		i = encodeVarintTypeEvents(dAtA, i, uint64(m.Height))
+	if len(m.AssetAddress) > 0 {
+		i -= len(m.AssetAddress)
+		copy(dAtA[i:], m.AssetAddress)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.AssetAddress)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x42
 	}
-	if len(m.Members) > 0 {
-		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Members[iNdEx])
-			copy(dAtA[i:], m.Members[iNdEx])
-			i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Members[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+	if len(m.AssetTxID) > 0 {
+		i -= len(m.AssetTxID)
+		copy(dAtA[i:], m.AssetTxID)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.AssetTxID)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.RuneTxID) > 0 {
+		i -= len(m.RuneTxID)
+		copy(dAtA[i:], m.RuneTxID)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.RuneTxID)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.AssetAmount.Size()
+		i -= size
+		if _, err := m.AssetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
-	if len(m.PubKey) > 0 {
-		i -= len(m.PubKey)
-		copy(dAtA[i:], m.PubKey)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.PubKey)))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.CacaoAmount.Size()
+		i -= size
+		if _, err := m.CacaoAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.CacaoAddress) > 0 {
+		i -= len(m.CacaoAddress)
+		copy(dAtA[i:], m.CacaoAddress)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.CacaoAddress)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.ProviderUnits.Size()
+		i -= size
+		if _, err := m.ProviderUnits.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EventTssKeygenFailure) Marshal() (dAtA []byte, err error) {
+func (m *EventWithdraw) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4555,58 +5167,95 @@ func (m *EventTssKeygenFailure) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventTssKeygenFailure) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventWithdraw) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventTssKeygenFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		i = encodeVarintTypeEvents(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x28
+	{
+		size := m.ImpLossProtection.Size()
+		i -= size
+		if _, err := m.ImpLossProtection.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
-	if len(m.Round) > 0 {
-		i -= len(m.Round)
-		copy(dAtA[i:], m.Round)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Round)))
-		i--
-		dAtA[i] = 0x22
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.EmitCacao.Size()
+		i -= size
+		if _, err := m.EmitCacao.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
-	if len(m.BlameNodes) > 0 {
-		for iNdEx := len(m.BlameNodes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.BlameNodes[iNdEx])
-			copy(dAtA[i:], m.BlameNodes[iNdEx])
-			i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.BlameNodes[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.EmitAsset.Size()
+		i -= size
+		if _, err := m.EmitAsset.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
-	if m.IsUnicast {
-		i--
-		if m.IsUnicast {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.InTx.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x10
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
-	if len(m.FailReason) > 0 {
-		i -= len(m.FailReason)
-		copy(dAtA[i:], m.FailReason)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.FailReason)))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Asymmetry.Size()
+		i -= size
+		if _, err := m.Asymmetry.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.BasisPoints != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.BasisPoints))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.ProviderUnits.Size()
+		i -= size
+		if _, err := m.ProviderUnits.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EventTssKeygenMetric) Marshal() (dAtA []byte, err error) {
+func (m *EventPendingLiquidity) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4616,32 +5265,83 @@ func (m *EventTssKeygenMetric) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventTssKeygenMetric) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventPendingLiquidity) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventTssKeygenMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventPendingLiquidity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MedianDurationMs != 0 {
-		i = encodeVarintTypeEvents(dAtA, i, uint64(m.MedianDurationMs))
+	if len(m.AssetTxID) > 0 {
+		i -= len(m.AssetTxID)
+		copy(dAtA[i:], m.AssetTxID)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.AssetTxID)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x42
 	}
-	if len(m.PubKey) > 0 {
-		i -= len(m.PubKey)
-		copy(dAtA[i:], m.PubKey)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.PubKey)))
+	if len(m.RuneTxID) > 0 {
+		i -= len(m.RuneTxID)
+		copy(dAtA[i:], m.RuneTxID)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.RuneTxID)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.AssetAmount.Size()
+		i -= size
+		if _, err := m.AssetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.AssetAddress) > 0 {
+		i -= len(m.AssetAddress)
+		copy(dAtA[i:], m.AssetAddress)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.AssetAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.CacaoAmount.Size()
+		i -= size
+		if _, err := m.CacaoAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.CacaoAddress) > 0 {
+		i -= len(m.CacaoAddress)
+		copy(dAtA[i:], m.CacaoAddress)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.CacaoAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PendingType != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.PendingType))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EventTssKeysignMetric) Marshal() (dAtA []byte, err error) {
+func (m *EventDonate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4651,32 +5351,40 @@ func (m *EventTssKeysignMetric) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventTssKeysignMetric) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventDonate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventTssKeysignMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventDonate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MedianDurationMs != 0 {
-		i = encodeVarintTypeEvents(dAtA, i, uint64(m.MedianDurationMs))
-		i--
-		dAtA[i] = 0x10
+	{
+		size, err := m.InTx.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
-	if len(m.TxID) > 0 {
-		i -= len(m.TxID)
-		copy(dAtA[i:], m.TxID)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
-		i--
-		dAtA[i] = 0xa
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EventSlashPoint) Marshal() (dAtA []byte, err error) {
+func (m *EventPool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4686,39 +5394,35 @@ func (m *EventSlashPoint) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventSlashPoint) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventPool) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSlashPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.SlashPoints != 0 {
-		i = encodeVarintTypeEvents(dAtA, i, uint64(m.SlashPoints))
+	if m.Status != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.Status))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.NodeAddress) > 0 {
-		i -= len(m.NodeAddress)
-		copy(dAtA[i:], m.NodeAddress)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.NodeAddress)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EventPoolBalanceChanged) Marshal() (dAtA []byte, err error) {
+func (m *PoolAmt) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4728,25 +5432,23 @@ func (m *EventPoolBalanceChanged) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventPoolBalanceChanged) MarshalTo(dAtA []byte) (int, error) {
+func (m *PoolAmt) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventPoolBalanceChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PoolAmt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Reason)))
+	if m.Amount != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.Amount))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
 	{
-		size, err := m.PoolChange.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4758,7 +5460,7 @@ func (m *EventPoolBalanceChanged) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *EventSwitch) Marshal() (dAtA []byte, err error) {
+func (m *EventRewardsV1) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4768,51 +5470,44 @@ func (m *EventSwitch) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventSwitch) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventRewardsV1) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSwitch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventRewardsV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TxID) > 0 {
-		i -= len(m.TxID)
-		copy(dAtA[i:], m.TxID)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
-		i--
-		dAtA[i] = 0x22
+	if len(m.PoolRewards) > 0 {
+		for iNdEx := len(m.PoolRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
 	{
-		size, err := m.Burn.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.BondReward.Size()
+		i -= size
+		if _, err := m.BondReward.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ToAddress) > 0 {
-		i -= len(m.ToAddress)
-		copy(dAtA[i:], m.ToAddress)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.ToAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EventSwitchV87) Marshal() (dAtA []byte, err error) {
+func (m *EventRewards) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4822,61 +5517,64 @@ func (m *EventSwitchV87) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventSwitchV87) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventRewards) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSwitchV87) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.Mint.Size()
+		size := m.MayaFundReward.Size()
 		i -= size
-		if _, err := m.Mint.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MayaFundReward.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
-	if len(m.TxID) > 0 {
-		i -= len(m.TxID)
-		copy(dAtA[i:], m.TxID)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
-		i--
-		dAtA[i] = 0x22
-	}
+	dAtA[i] = 0x22
 	{
-		size, err := m.Burn.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.CacaoPoolReward.Size()
+		i -= size
+		if _, err := m.CacaoPoolReward.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x1a
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.PoolRewards) > 0 {
+		for iNdEx := len(m.PoolRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if len(m.ToAddress) > 0 {
-		i -= len(m.ToAddress)
-		copy(dAtA[i:], m.ToAddress)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.ToAddress)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size := m.BondReward.Size()
+		i -= size
+		if _, err := m.BondReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMAYANameV111) Marshal() (dAtA []byte, err error) {
+func (m *EventRefund) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4886,73 +5584,52 @@ func (m *EventMAYANameV111) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMAYANameV111) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventRefund) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMAYANameV111) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventRefund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.Expire != 0 {
-		i = encodeVarintTypeEvents(dAtA, i, uint64(m.Expire))
-		i--
-		dAtA[i] = 0x30
-	}
 	{
-		size := m.FundAmt.Size()
-		i -= size
-		if _, err := m.FundAmt.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
+	dAtA[i] = 0x22
 	{
-		size := m.RegistrationFee.Size()
-		i -= size
-		if _, err := m.RegistrationFee.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.InTx.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Chain) > 0 {
-		i -= len(m.Chain)
-		copy(dAtA[i:], m.Chain)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Chain)))
+	dAtA[i] = 0x1a
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Reason)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Name)))
+	if m.Code != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.Code))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMAYAName) Marshal() (dAtA []byte, err error) {
+func (m *EventBond) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4962,101 +5639,103 @@ func (m *EventMAYAName) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMAYAName) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventBond) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMAYAName) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventBond) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SubaffiliateBps) > 0 {
-		for iNdEx := len(m.SubaffiliateBps) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.SubaffiliateBps[iNdEx].Size()
-				i -= size
-				if _, err := m.SubaffiliateBps[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTypeEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
-		}
-	}
-	if len(m.SubaffiliateName) > 0 {
-		for iNdEx := len(m.SubaffiliateName) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.SubaffiliateName[iNdEx])
-			copy(dAtA[i:], m.SubaffiliateName[iNdEx])
-			i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.SubaffiliateName[iNdEx])))
-			i--
-			dAtA[i] = 0x4a
+	{
+		size, err := m.TxIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
-	if m.AffiliateBps != 0 {
-		i = encodeVarintTypeEvents(dAtA, i, uint64(m.AffiliateBps))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.Expire != 0 {
-		i = encodeVarintTypeEvents(dAtA, i, uint64(m.Expire))
+	i--
+	dAtA[i] = 0x1a
+	if m.BondType != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.BondType))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x10
 	}
 	{
-		size := m.FundAmt.Size()
+		size := m.Amount.Size()
 		i -= size
-		if _, err := m.FundAmt.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBondV105) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBondV105) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBondV105) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	{
-		size := m.RegistrationFee.Size()
-		i -= size
-		if _, err := m.RegistrationFee.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x22
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x1a
+	{
+		size, err := m.TxIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
-	if len(m.Chain) > 0 {
-		i -= len(m.Chain)
-		copy(dAtA[i:], m.Chain)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Chain)))
+	i--
+	dAtA[i] = 0x1a
+	if m.BondType != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.BondType))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EventSetMimir) Marshal() (dAtA []byte, err error) {
+func (m *GasPool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5066,34 +5745,55 @@ func (m *EventSetMimir) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventSetMimir) MarshalTo(dAtA []byte) (int, error) {
+func (m *GasPool) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSetMimir) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GasPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Value)))
+	if m.Count != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.Count))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x20
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size := m.AssetAmt.Size()
+		i -= size
+		if _, err := m.AssetAmt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CacaoAmt.Size()
+		i -= size
+		if _, err := m.CacaoAmt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EventSetNodeMimir) Marshal() (dAtA []byte, err error) {
+func (m *EventGas) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5103,41 +5803,34 @@ func (m *EventSetNodeMimir) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventSetNodeMimir) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventGas) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSetNodeMimir) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventCACAOPoolDeposit) Marshal() (dAtA []byte, err error) {
+func (m *EventReserve) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5147,54 +5840,40 @@ func (m *EventCACAOPoolDeposit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventCACAOPoolDeposit) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventReserve) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventCACAOPoolDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventReserve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TxId) > 0 {
-		i -= len(m.TxId)
-		copy(dAtA[i:], m.TxId)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxId)))
-		i--
-		dAtA[i] = 0x22
-	}
 	{
-		size := m.Units.Size()
-		i -= size
-		if _, err := m.Units.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.InTx.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x12
 	{
-		size := m.CacaoAmount.Size()
-		i -= size
-		if _, err := m.CacaoAmount.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.ReserveContributor.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.CacaoAddress) > 0 {
-		i -= len(m.CacaoAddress)
-		copy(dAtA[i:], m.CacaoAddress)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.CacaoAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EventCACAOPoolWithdrawV118) Marshal() (dAtA []byte, err error) {
+func (m *EventScheduledOutbound) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5204,81 +5883,70 @@ func (m *EventCACAOPoolWithdrawV118) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventCACAOPoolWithdrawV118) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventScheduledOutbound) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventCACAOPoolWithdrawV118) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventScheduledOutbound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.AffiliateAddress) > 0 {
-		i -= len(m.AffiliateAddress)
-		copy(dAtA[i:], m.AffiliateAddress)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.AffiliateAddress)))
-		i--
-		dAtA[i] = 0x42
-	}
 	{
-		size := m.AffiliateAmount.Size()
-		i -= size
-		if _, err := m.AffiliateAmount.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.OutTx.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x3a
-	if m.AffiliateBasisPts != 0 {
-		i = encodeVarintTypeEvents(dAtA, i, uint64(m.AffiliateBasisPts))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.TxId) > 0 {
-		i -= len(m.TxId)
-		copy(dAtA[i:], m.TxId)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxId)))
-		i--
-		dAtA[i] = 0x2a
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSecurity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSecurity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSecurity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	{
-		size := m.Units.Size()
-		i -= size
-		if _, err := m.Units.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.CacaoAmount.Size()
 		i -= size
-		if _, err := m.CacaoAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
 		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if m.BasisPoints != 0 {
-		i = encodeVarintTypeEvents(dAtA, i, uint64(m.BasisPoints))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.CacaoAddress) > 0 {
-		i -= len(m.CacaoAddress)
-		copy(dAtA[i:], m.CacaoAddress)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.CacaoAddress)))
+	dAtA[i] = 0x12
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Msg)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventCACAOPoolWithdraw) Marshal() (dAtA []byte, err error) {
+func (m *EventSlash) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5288,74 +5956,44 @@ func (m *EventCACAOPoolWithdraw) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventCACAOPoolWithdraw) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSlash) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventCACAOPoolWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.AffiliateAmount.Size()
-		i -= size
-		if _, err := m.AffiliateAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.SlashAmount) > 0 {
+		for iNdEx := len(m.SlashAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	if m.AffiliateBasisPts != 0 {
-		i = encodeVarintTypeEvents(dAtA, i, uint64(m.AffiliateBasisPts))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.TxId) > 0 {
-		i -= len(m.TxId)
-		copy(dAtA[i:], m.TxId)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxId)))
-		i--
-		dAtA[i] = 0x2a
 	}
 	{
-		size := m.Units.Size()
-		i -= size
-		if _, err := m.Units.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.CacaoAmount.Size()
 		i -= size
-		if _, err := m.CacaoAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
 		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if m.BasisPoints != 0 {
-		i = encodeVarintTypeEvents(dAtA, i, uint64(m.BasisPoints))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.CacaoAddress) > 0 {
-		i -= len(m.CacaoAddress)
-		copy(dAtA[i:], m.CacaoAddress)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.CacaoAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EventTradeAccountDeposit) Marshal() (dAtA []byte, err error) {
+func (m *EventSlashLiquidity) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5365,34 +6003,30 @@ func (m *EventTradeAccountDeposit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventTradeAccountDeposit) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSlashLiquidity) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventTradeAccountDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSlashLiquidity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TxID) > 0 {
-		i -= len(m.TxID)
-		copy(dAtA[i:], m.TxID)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.CacaoAddress) > 0 {
-		i -= len(m.CacaoAddress)
-		copy(dAtA[i:], m.CacaoAddress)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.CacaoAddress)))
-		i--
-		dAtA[i] = 0x22
+	{
+		size := m.LpUnits.Size()
+		i -= size
+		if _, err := m.LpUnits.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
-	if len(m.AssetAddress) > 0 {
-		i -= len(m.AssetAddress)
-		copy(dAtA[i:], m.AssetAddress)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.AssetAddress)))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -5406,20 +6040,17 @@ func (m *EventTradeAccountDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error
 	}
 	i--
 	dAtA[i] = 0x12
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	if len(m.NodeBondAddress) > 0 {
+		i -= len(m.NodeBondAddress)
+		copy(dAtA[i:], m.NodeBondAddress)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.NodeBondAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EventTradeAccountWithdraw) Marshal() (dAtA []byte, err error) {
+func (m *EventErrata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5429,1022 +6060,8959 @@ func (m *EventTradeAccountWithdraw) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventTradeAccountWithdraw) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventErrata) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventTradeAccountWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventErrata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.TxID) > 0 {
 		i -= len(m.TxID)
 		copy(dAtA[i:], m.TxID)
 		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
 		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.CacaoAddress) > 0 {
-		i -= len(m.CacaoAddress)
-		copy(dAtA[i:], m.CacaoAddress)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.CacaoAddress)))
-		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0xa
 	}
-	if len(m.AssetAddress) > 0 {
-		i -= len(m.AssetAddress)
-		copy(dAtA[i:], m.AssetAddress)
-		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.AssetAddress)))
-		i--
-		dAtA[i] = 0x1a
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	{
-		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.SynthUnits.Size()
+		i -= size
+		if _, err := m.SynthUnits.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x1a
 	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x12
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypeEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypeEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventOutbound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *PoolMod) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventOutbound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOutbound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Asset.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = m.CacaoAmt.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	if m.CacaoAdd {
-		n += 2
+	{
+		size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
-	l = m.AssetAmt.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	if m.AssetAdd {
-		n += 2
+	i--
+	dAtA[i] = 0x12
+	if len(m.InTxID) > 0 {
+		i -= len(m.InTxID)
+		copy(dAtA[i:], m.InTxID)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.InTxID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventStreamingSwap) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventTssKeygenSuccess) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTssKeygenSuccess) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTssKeygenSuccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TxID)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
-	}
-	if m.Interval != 0 {
-		n += 1 + sovTypeEvents(uint64(m.Interval))
-	}
-	if m.Quantity != 0 {
-		n += 1 + sovTypeEvents(uint64(m.Quantity))
-	}
-	if m.Count != 0 {
-		n += 1 + sovTypeEvents(uint64(m.Count))
-	}
-	if m.LastHeight != 0 {
-		n += 1 + sovTypeEvents(uint64(m.LastHeight))
+	if m.Height != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = m.TradeTarget.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = m.Deposit.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = m.In.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = m.Out.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	if len(m.FailedSwaps) > 0 {
-		l = 0
-		for _, e := range m.FailedSwaps {
-			l += sovTypeEvents(uint64(e))
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
-		n += 1 + sovTypeEvents(uint64(l)) + l
 	}
-	if len(m.FailedSwapReasons) > 0 {
-		for _, s := range m.FailedSwapReasons {
-			l = len(s)
-			n += 1 + l + sovTypeEvents(uint64(l))
-		}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventSwap) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Pool.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = m.SwapTarget.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = m.SwapSlip.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = m.LiquidityFee.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = m.LiquidityFeeInCacao.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = m.InTx.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = m.OutTxs.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = m.EmitAsset.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = m.SynthUnits.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	if m.StreamingSwapQuantity != 0 {
-		n += 1 + sovTypeEvents(uint64(m.StreamingSwapQuantity))
-	}
-	if m.StreamingSwapCount != 0 {
-		n += 1 + sovTypeEvents(uint64(m.StreamingSwapCount))
+func (m *EventTssKeygenFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventAffiliateFee) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventTssKeygenFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTssKeygenFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TxID)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
-	}
-	l = len(m.Memo)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
-	}
-	l = len(m.Mayaname)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.CacaoAddress)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	if len(m.Round) > 0 {
+		i -= len(m.Round)
+		copy(dAtA[i:], m.Round)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Round)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = m.Asset.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = m.GrossAmount.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	if m.FeeBpsTick != 0 {
-		n += 1 + sovTypeEvents(uint64(m.FeeBpsTick))
+	if len(m.BlameNodes) > 0 {
+		for iNdEx := len(m.BlameNodes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlameNodes[iNdEx])
+			copy(dAtA[i:], m.BlameNodes[iNdEx])
+			i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.BlameNodes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = m.FeeAmount.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = len(m.Parent)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	if m.IsUnicast {
+		i--
+		if m.IsUnicast {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.SubFeeBps != 0 {
-		n += 1 + sovTypeEvents(uint64(m.SubFeeBps))
+	if len(m.FailReason) > 0 {
+		i -= len(m.FailReason)
+		copy(dAtA[i:], m.FailReason)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.FailReason)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventAddLiquidity) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventTssKeygenMetric) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTssKeygenMetric) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTssKeygenMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Pool.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = m.ProviderUnits.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = len(m.CacaoAddress)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
-	}
-	l = m.CacaoAmount.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = m.AssetAmount.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = len(m.RuneTxID)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
-	}
-	l = len(m.AssetTxID)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	if m.MedianDurationMs != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.MedianDurationMs))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.AssetAddress)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventWithdraw) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Pool.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = m.ProviderUnits.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	if m.BasisPoints != 0 {
-		n += 1 + sovTypeEvents(uint64(m.BasisPoints))
+func (m *EventTssKeysignMetric) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Asymmetry.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = m.InTx.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = m.EmitAsset.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = m.EmitCacao.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = m.ImpLossProtection.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventPendingLiquidity) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventTssKeysignMetric) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTssKeysignMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Pool.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	if m.PendingType != 0 {
-		n += 1 + sovTypeEvents(uint64(m.PendingType))
-	}
-	l = len(m.CacaoAddress)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
-	}
-	l = m.CacaoAmount.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = len(m.AssetAddress)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
-	}
-	l = m.AssetAmount.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = len(m.RuneTxID)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	if m.MedianDurationMs != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.MedianDurationMs))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.AssetTxID)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventDonate) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSlashPoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Pool.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = m.InTx.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Pool.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	if m.Status != 0 {
-		n += 1 + sovTypeEvents(uint64(m.Status))
-	}
-	return n
+func (m *EventSlashPoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PoolAmt) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventSlashPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Asset.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	if m.Amount != 0 {
-		n += 1 + sovTypeEvents(uint64(m.Amount))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func (m *EventRewardsV1) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.SlashPoints != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.SlashPoints))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
-	l = m.BondReward.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	if len(m.PoolRewards) > 0 {
-		for _, e := range m.PoolRewards {
-			l = e.Size()
-			n += 1 + l + sovTypeEvents(uint64(l))
-		}
+	if len(m.NodeAddress) > 0 {
+		i -= len(m.NodeAddress)
+		copy(dAtA[i:], m.NodeAddress)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.NodeAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventRewards) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventPoolBalanceChanged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPoolBalanceChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPoolBalanceChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.BondReward.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	if len(m.PoolRewards) > 0 {
-		for _, e := range m.PoolRewards {
-			l = e.Size()
-			n += 1 + l + sovTypeEvents(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.PoolChange.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
-	l = m.CacaoPoolReward.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = m.MayaFundReward.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *EventRefund) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSwitch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSwitch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSwitch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Code != 0 {
-		n += 1 + sovTypeEvents(uint64(m.Code))
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	{
+		size, err := m.Burn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
-	l = m.InTx.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = m.Fee.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	return n
-}
-
-func (m *EventBond) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x1a
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	if m.BondType != 0 {
-		n += 1 + sovTypeEvents(uint64(m.BondType))
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.TxIn.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventBondV105) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	if m.BondType != 0 {
-		n += 1 + sovTypeEvents(uint64(m.BondType))
+func (m *EventSwitchV87) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.TxIn.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = m.Asset.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GasPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Asset.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = m.CacaoAmt.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = m.AssetAmt.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	if m.Count != 0 {
-		n += 1 + sovTypeEvents(uint64(m.Count))
-	}
-	return n
+func (m *EventSwitchV87) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventGas) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventSwitchV87) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovTypeEvents(uint64(l))
+	{
+		size := m.Mint.Size()
+		i -= size
+		if _, err := m.Mint.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *EventReserve) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x2a
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	l = m.ReserveContributor.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = m.InTx.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	return n
-}
-
-func (m *EventScheduledOutbound) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size, err := m.Burn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = m.OutTx.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	return n
-}
-
-func (m *EventSecurity) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x1a
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Tx.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventSlash) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Pool.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	if len(m.SlashAmount) > 0 {
-		for _, e := range m.SlashAmount {
-			l = e.Size()
-			n += 1 + l + sovTypeEvents(uint64(l))
-		}
+func (m *EventMAYANameV111) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventSlashLiquidity) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.NodeBondAddress)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
-	}
-	l = m.Asset.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
-	}
-	l = m.LpUnits.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	return n
+func (m *EventMAYANameV111) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventErrata) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventMAYANameV111) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TxID)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovTypeEvents(uint64(l))
-		}
+	if m.Expire != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.Expire))
+		i--
+		dAtA[i] = 0x30
 	}
-	return n
-}
-
-func (m *EventFee) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size := m.FundAmt.Size()
+		i -= size
+		if _, err := m.FundAmt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.TxID)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.RegistrationFee.Size()
+		i -= size
+		if _, err := m.RegistrationFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
-	l = m.Fee.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = m.SynthUnits.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	return n
-}
-
-func (m *EventOutbound) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x22
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	l = len(m.InTxID)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.Tx.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventTssKeygenSuccess) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMAYAName) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMAYAName) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMAYAName) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PubKey)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	if len(m.SubaffiliateBps) > 0 {
+		for iNdEx := len(m.SubaffiliateBps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.SubaffiliateBps[iNdEx].Size()
+				i -= size
+				if _, err := m.SubaffiliateBps[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	if len(m.Members) > 0 {
-		for _, s := range m.Members {
-			l = len(s)
-			n += 1 + l + sovTypeEvents(uint64(l))
+	if len(m.SubaffiliateName) > 0 {
+		for iNdEx := len(m.SubaffiliateName) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SubaffiliateName[iNdEx])
+			copy(dAtA[i:], m.SubaffiliateName[iNdEx])
+			i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.SubaffiliateName[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
 		}
 	}
-	if m.Height != 0 {
-		n += 1 + sovTypeEvents(uint64(m.Height))
+	if m.AffiliateBps != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.AffiliateBps))
+		i--
+		dAtA[i] = 0x40
 	}
-	return n
-}
-
-func (m *EventTssKeygenFailure) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	var l int
-	_ = l
-	l = len(m.FailReason)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	if m.Expire != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.Expire))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.IsUnicast {
-		n += 2
+	{
+		size := m.FundAmt.Size()
+		i -= size
+		if _, err := m.FundAmt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
-	if len(m.BlameNodes) > 0 {
-		for _, s := range m.BlameNodes {
-			l = len(s)
-			n += 1 + l + sovTypeEvents(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.RegistrationFee.Size()
+		i -= size
+		if _, err := m.RegistrationFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
-	l = len(m.Round)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Height != 0 {
-		n += 1 + sovTypeEvents(uint64(m.Height))
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventTssKeygenMetric) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PubKey)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
-	}
-	if m.MedianDurationMs != 0 {
-		n += 1 + sovTypeEvents(uint64(m.MedianDurationMs))
+func (m *EventSetMimir) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventTssKeysignMetric) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventSetMimir) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetMimir) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TxID)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.MedianDurationMs != 0 {
-		n += 1 + sovTypeEvents(uint64(m.MedianDurationMs))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventSlashPoint) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSetNodeMimir) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetNodeMimir) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetNodeMimir) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NodeAddress)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.SlashPoints != 0 {
-		n += 1 + sovTypeEvents(uint64(m.SlashPoints))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventPoolBalanceChanged) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.PoolChange.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+func (m *EventCACAOPoolDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventSwitch) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventCACAOPoolDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCACAOPoolDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	{
+		size := m.Units.Size()
+		i -= size
+		if _, err := m.Units.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
-	l = m.Burn.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = len(m.TxID)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CacaoAmount.Size()
+		i -= size
+		if _, err := m.CacaoAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.CacaoAddress) > 0 {
+		i -= len(m.CacaoAddress)
+		copy(dAtA[i:], m.CacaoAddress)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.CacaoAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventSwitchV87) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventCACAOPoolWithdrawV118) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCACAOPoolWithdrawV118) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCACAOPoolWithdrawV118) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
-	}
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	if len(m.AffiliateAddress) > 0 {
+		i -= len(m.AffiliateAddress)
+		copy(dAtA[i:], m.AffiliateAddress)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.AffiliateAddress)))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = m.Burn.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = len(m.TxID)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	{
+		size := m.AffiliateAmount.Size()
+		i -= size
+		if _, err := m.AffiliateAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
-	l = m.Mint.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	return n
-}
-
-func (m *EventMAYANameV111) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x3a
+	if m.AffiliateBasisPts != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.AffiliateBasisPts))
+		i--
+		dAtA[i] = 0x30
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Chain)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	{
+		size := m.Units.Size()
+		i -= size
+		if _, err := m.Units.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CacaoAmount.Size()
+		i -= size
+		if _, err := m.CacaoAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
-	l = m.RegistrationFee.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = m.FundAmt.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	if m.Expire != 0 {
-		n += 1 + sovTypeEvents(uint64(m.Expire))
+	i--
+	dAtA[i] = 0x1a
+	if m.BasisPoints != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.BasisPoints))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	if len(m.CacaoAddress) > 0 {
+		i -= len(m.CacaoAddress)
+		copy(dAtA[i:], m.CacaoAddress)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.CacaoAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventMAYAName) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventCACAOPoolWithdraw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCACAOPoolWithdraw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCACAOPoolWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
-	}
-	l = len(m.Chain)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	{
+		size := m.AffiliateAmount.Size()
+		i -= size
+		if _, err := m.AffiliateAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	i--
+	dAtA[i] = 0x3a
+	if m.AffiliateBasisPts != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.AffiliateBasisPts))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = m.RegistrationFee.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = m.FundAmt.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	if m.Expire != 0 {
-		n += 1 + sovTypeEvents(uint64(m.Expire))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
-	}
-	if m.AffiliateBps != 0 {
-		n += 1 + sovTypeEvents(uint64(m.AffiliateBps))
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if len(m.SubaffiliateName) > 0 {
-		for _, s := range m.SubaffiliateName {
-			l = len(s)
-			n += 1 + l + sovTypeEvents(uint64(l))
+	{
+		size := m.Units.Size()
+		i -= size
+		if _, err := m.Units.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
-	if len(m.SubaffiliateBps) > 0 {
-		for _, e := range m.SubaffiliateBps {
-			l = e.Size()
-			n += 1 + l + sovTypeEvents(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CacaoAmount.Size()
+		i -= size
+		if _, err := m.CacaoAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *EventSetMimir) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if m.BasisPoints != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.BasisPoints))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	if len(m.CacaoAddress) > 0 {
+		i -= len(m.CacaoAddress)
+		copy(dAtA[i:], m.CacaoAddress)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.CacaoAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventSetNodeMimir) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventCACAOPoolWithdrawQueued) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCACAOPoolWithdrawQueued) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCACAOPoolWithdrawQueued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	if m.MatureHeight != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.MatureHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	if m.BasisPoints != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.BasisPoints))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.CacaoAddress) > 0 {
+		i -= len(m.CacaoAddress)
+		copy(dAtA[i:], m.CacaoAddress)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.CacaoAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventCACAOPoolDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventCACAOPoolCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCACAOPoolCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCACAOPoolCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CacaoAddress)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	{
+		size := m.CacaoAmount.Size()
+		i -= size
+		if _, err := m.CacaoAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
-	l = m.CacaoAmount.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = m.Units.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = len(m.TxId)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.CacaoAddress) > 0 {
+		i -= len(m.CacaoAddress)
+		copy(dAtA[i:], m.CacaoAddress)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.CacaoAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventCACAOPoolWithdrawV118) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventTradeAccountDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTradeAccountDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTradeAccountDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CacaoAddress)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.BasisPoints != 0 {
-		n += 1 + sovTypeEvents(uint64(m.BasisPoints))
+	if len(m.CacaoAddress) > 0 {
+		i -= len(m.CacaoAddress)
+		copy(dAtA[i:], m.CacaoAddress)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.CacaoAddress)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = m.CacaoAmount.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = m.Units.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = len(m.TxId)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	if len(m.AssetAddress) > 0 {
+		i -= len(m.AssetAddress)
+		copy(dAtA[i:], m.AssetAddress)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.AssetAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.AffiliateBasisPts != 0 {
-		n += 1 + sovTypeEvents(uint64(m.AffiliateBasisPts))
+	{
+		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
-	l = m.AffiliateAmount.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = len(m.AffiliateAddress)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *EventCACAOPoolWithdraw) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventTradeAccountWithdraw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTradeAccountWithdraw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTradeAccountWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CacaoAddress)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.BasisPoints != 0 {
-		n += 1 + sovTypeEvents(uint64(m.BasisPoints))
+	if len(m.CacaoAddress) > 0 {
+		i -= len(m.CacaoAddress)
+		copy(dAtA[i:], m.CacaoAddress)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.CacaoAddress)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = m.CacaoAmount.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = m.Units.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = len(m.TxId)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	if len(m.AssetAddress) > 0 {
+		i -= len(m.AssetAddress)
+		copy(dAtA[i:], m.AssetAddress)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.AssetAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.AffiliateBasisPts != 0 {
-		n += 1 + sovTypeEvents(uint64(m.AffiliateBasisPts))
+	{
+		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
-	l = m.AffiliateAmount.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *EventTradeAccountDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventTradeAccountTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTradeAccountTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTradeAccountTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = m.Asset.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = len(m.AssetAddress)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.CacaoAddress)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	{
+		size := m.Units.Size()
+		i -= size
+		if _, err := m.Units.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
 	}
-	l = len(m.TxID)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventLimitOrderClose) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventLimitOrderClose) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventLimitOrderClose) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CancelTxID) > 0 {
+		i -= len(m.CancelTxID)
+		copy(dAtA[i:], m.CancelTxID)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.CancelTxID)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.TradeTarget.Size()
+		i -= size
+		if _, err := m.TradeTarget.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.TargetAsset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventLimitOrderFill) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventLimitOrderFill) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventLimitOrderFill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.Remaining.Size()
+		i -= size
+		if _, err := m.Remaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.FilledOut.Size()
+		i -= size
+		if _, err := m.FilledOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.FilledIn.Size()
+		i -= size
+		if _, err := m.FilledIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Out.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.In.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventStreamingSwapCancel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventStreamingSwapCancel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventStreamingSwapCancel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Refund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.Out.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.In.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Count != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CancelTxID) > 0 {
+		i -= len(m.CancelTxID)
+		copy(dAtA[i:], m.CancelTxID)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.CancelTxID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDCAOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDCAOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDCAOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SliceLimit.Size()
+		i -= size
+		if _, err := m.SliceLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.Quantity != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.TargetAsset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDCASlice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDCASlice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDCASlice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.SliceLimit.Size()
+		i -= size
+		if _, err := m.SliceLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Out.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.In.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Count != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventLoanOpen) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventLoanOpen) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventLoanOpen) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.CollateralizationRatio != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.CollateralizationRatio))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.TargetAddress) > 0 {
+		i -= len(m.TargetAddress)
+		copy(dAtA[i:], m.TargetAddress)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TargetAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.DebtIssued.Size()
+		i -= size
+		if _, err := m.DebtIssued.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.DebtAsset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.CollateralUnits.Size()
+		i -= size
+		if _, err := m.CollateralUnits.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CollateralDeposited.Size()
+		i -= size
+		if _, err := m.CollateralDeposited.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.CollateralAsset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventLoanRepayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventLoanRepayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventLoanRepayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.DebtRepaid.Size()
+		i -= size
+		if _, err := m.DebtRepaid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.DebtAsset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CollateralWithdrawn.Size()
+		i -= size
+		if _, err := m.CollateralWithdrawn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.CollateralAsset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventLoanClose) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventLoanClose) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventLoanClose) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.DebtRepaid.Size()
+		i -= size
+		if _, err := m.DebtRepaid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.DebtAsset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CollateralWithdrawn.Size()
+		i -= size
+		if _, err := m.CollateralWithdrawn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.CollateralAsset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventLoanLiquidation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventLoanLiquidation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventLoanLiquidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CollateralizationRatio != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.CollateralizationRatio))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.CacaoRecovered.Size()
+		i -= size
+		if _, err := m.CacaoRecovered.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.DebtRepaid.Size()
+		i -= size
+		if _, err := m.DebtRepaid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.DebtAsset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CollateralLiquidated.Size()
+		i -= size
+		if _, err := m.CollateralLiquidated.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.CollateralAsset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMAYANameList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMAYANameList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMAYANameList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.KeepSubaffiliates {
+		i--
+		if m.KeepSubaffiliates {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.KeepAliases {
+		i--
+		if m.KeepAliases {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExpireHeight != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.ExpireHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMAYANameDelist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMAYANameDelist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMAYANameDelist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMAYANameSale) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMAYANameSale) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMAYANameSale) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPOL) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPOL) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPOL) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.Buffer.Size()
+		i -= size
+		if _, err := m.Buffer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TargetSynthUtilization.Size()
+		i -= size
+		if _, err := m.TargetSynthUtilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SynthUtilization.Size()
+		i -= size
+		if _, err := m.SynthUtilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CacaoAmount.Size()
+		i -= size
+		if _, err := m.CacaoAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBondProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBondProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBondProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NodeAddress) > 0 {
+		i -= len(m.NodeAddress)
+		copy(dAtA[i:], m.NodeAddress)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.NodeAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNodeOperatorFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNodeOperatorFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNodeOperatorFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ApplyHeight != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.ApplyHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NewFee != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.NewFee))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OldFee != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.OldFee))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.NodeAddress) > 0 {
+		i -= len(m.NodeAddress)
+		copy(dAtA[i:], m.NodeAddress)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.NodeAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBondProviderTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBondProviderTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBondProviderTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.Units.Size()
+		i -= size
+		if _, err := m.Units.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NodeAddress) > 0 {
+		i -= len(m.NodeAddress)
+		copy(dAtA[i:], m.NodeAddress)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.NodeAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventVaultMigrated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventVaultMigrated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventVaultMigrated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Migrated) > 0 {
+		for iNdEx := len(m.Migrated) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Migrated[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.RetiringHeight != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.RetiringHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSolvencyWarning) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSolvencyWarning) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSolvencyWarning) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HaltGap != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.HaltGap))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.WarnGap != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.WarnGap))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Gap != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.Gap))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.WalletAmount.Size()
+		i -= size
+		if _, err := m.WalletAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.VaultAmount.Size()
+		i -= size
+		if _, err := m.VaultAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypeEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypeEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PoolMod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Asset.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.CacaoAmt.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	if m.CacaoAdd {
+		n += 2
+	}
+	l = m.AssetAmt.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	if m.AssetAdd {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventStreamingSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTypeEvents(uint64(m.Interval))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovTypeEvents(uint64(m.Quantity))
+	}
+	if m.Count != 0 {
+		n += 1 + sovTypeEvents(uint64(m.Count))
+	}
+	if m.LastHeight != 0 {
+		n += 1 + sovTypeEvents(uint64(m.LastHeight))
+	}
+	l = m.TradeTarget.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.Deposit.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.In.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.Out.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	if len(m.FailedSwaps) > 0 {
+		l = 0
+		for _, e := range m.FailedSwaps {
+			l += sovTypeEvents(uint64(e))
+		}
+		n += 1 + sovTypeEvents(uint64(l)) + l
+	}
+	if len(m.FailedSwapReasons) > 0 {
+		for _, s := range m.FailedSwapReasons {
+			l = len(s)
+			n += 1 + l + sovTypeEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pool.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.SwapTarget.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.SwapSlip.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.LiquidityFee.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.LiquidityFeeInCacao.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.InTx.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.OutTxs.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.EmitAsset.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.SynthUnits.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	if m.StreamingSwapQuantity != 0 {
+		n += 1 + sovTypeEvents(uint64(m.StreamingSwapQuantity))
+	}
+	if m.StreamingSwapCount != 0 {
+		n += 1 + sovTypeEvents(uint64(m.StreamingSwapCount))
+	}
+	return n
+}
+
+func (m *EventAffiliateFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.Mayaname)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.CacaoAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = m.Asset.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.GrossAmount.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	if m.FeeBpsTick != 0 {
+		n += 1 + sovTypeEvents(uint64(m.FeeBpsTick))
+	}
+	l = m.FeeAmount.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = len(m.Parent)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	if m.SubFeeBps != 0 {
+		n += 1 + sovTypeEvents(uint64(m.SubFeeBps))
+	}
+	return n
+}
+
+func (m *EventAddLiquidity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pool.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.ProviderUnits.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = len(m.CacaoAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = m.CacaoAmount.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.AssetAmount.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = len(m.RuneTxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.AssetTxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.AssetAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pool.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.ProviderUnits.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	if m.BasisPoints != 0 {
+		n += 1 + sovTypeEvents(uint64(m.BasisPoints))
+	}
+	l = m.Asymmetry.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.InTx.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.EmitAsset.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.EmitCacao.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.ImpLossProtection.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	return n
+}
+
+func (m *EventPendingLiquidity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pool.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	if m.PendingType != 0 {
+		n += 1 + sovTypeEvents(uint64(m.PendingType))
+	}
+	l = len(m.CacaoAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = m.CacaoAmount.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = len(m.AssetAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = m.AssetAmount.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = len(m.RuneTxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.AssetTxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDonate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pool.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.InTx.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	return n
+}
+
+func (m *EventPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pool.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovTypeEvents(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *PoolAmt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Asset.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	if m.Amount != 0 {
+		n += 1 + sovTypeEvents(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *EventRewardsV1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BondReward.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	if len(m.PoolRewards) > 0 {
+		for _, e := range m.PoolRewards {
+			l = e.Size()
+			n += 1 + l + sovTypeEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BondReward.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	if len(m.PoolRewards) > 0 {
+		for _, e := range m.PoolRewards {
+			l = e.Size()
+			n += 1 + l + sovTypeEvents(uint64(l))
+		}
+	}
+	l = m.CacaoPoolReward.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.MayaFundReward.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	return n
+}
+
+func (m *EventRefund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovTypeEvents(uint64(m.Code))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = m.InTx.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	return n
+}
+
+func (m *EventBond) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	if m.BondType != 0 {
+		n += 1 + sovTypeEvents(uint64(m.BondType))
+	}
+	l = m.TxIn.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	return n
+}
+
+func (m *EventBondV105) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	if m.BondType != 0 {
+		n += 1 + sovTypeEvents(uint64(m.BondType))
+	}
+	l = m.TxIn.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.Asset.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	return n
+}
+
+func (m *GasPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Asset.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.CacaoAmt.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.AssetAmt.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	if m.Count != 0 {
+		n += 1 + sovTypeEvents(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *EventGas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovTypeEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventReserve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ReserveContributor.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.InTx.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	return n
+}
+
+func (m *EventScheduledOutbound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OutTx.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	return n
+}
+
+func (m *EventSecurity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = m.Tx.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	return n
+}
+
+func (m *EventSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pool.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	if len(m.SlashAmount) > 0 {
+		for _, e := range m.SlashAmount {
+			l = e.Size()
+			n += 1 + l + sovTypeEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventSlashLiquidity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeBondAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = m.Asset.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = m.LpUnits.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	return n
+}
+
+func (m *EventErrata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovTypeEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.SynthUnits.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	return n
+}
+
+func (m *EventOutbound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InTxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = m.Tx.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	return n
+}
+
+func (m *EventTssKeygenSuccess) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovTypeEvents(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypeEvents(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *EventTssKeygenFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FailReason)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	if m.IsUnicast {
+		n += 2
+	}
+	if len(m.BlameNodes) > 0 {
+		for _, s := range m.BlameNodes {
+			l = len(s)
+			n += 1 + l + sovTypeEvents(uint64(l))
+		}
+	}
+	l = len(m.Round)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypeEvents(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *EventTssKeygenMetric) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	if m.MedianDurationMs != 0 {
+		n += 1 + sovTypeEvents(uint64(m.MedianDurationMs))
+	}
+	return n
+}
+
+func (m *EventTssKeysignMetric) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	if m.MedianDurationMs != 0 {
+		n += 1 + sovTypeEvents(uint64(m.MedianDurationMs))
+	}
+	return n
+}
+
+func (m *EventSlashPoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	if m.SlashPoints != 0 {
+		n += 1 + sovTypeEvents(uint64(m.SlashPoints))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPoolBalanceChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PoolChange.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSwitch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = m.Burn.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSwitchV87) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = m.Burn.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = m.Mint.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	return n
+}
+
+func (m *EventMAYANameV111) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = m.RegistrationFee.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.FundAmt.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	if m.Expire != 0 {
+		n += 1 + sovTypeEvents(uint64(m.Expire))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMAYAName) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = m.RegistrationFee.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.FundAmt.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	if m.Expire != 0 {
+		n += 1 + sovTypeEvents(uint64(m.Expire))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	if m.AffiliateBps != 0 {
+		n += 1 + sovTypeEvents(uint64(m.AffiliateBps))
+	}
+	if len(m.SubaffiliateName) > 0 {
+		for _, s := range m.SubaffiliateName {
+			l = len(s)
+			n += 1 + l + sovTypeEvents(uint64(l))
+		}
+	}
+	if len(m.SubaffiliateBps) > 0 {
+		for _, e := range m.SubaffiliateBps {
+			l = e.Size()
+			n += 1 + l + sovTypeEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventSetMimir) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSetNodeMimir) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCACAOPoolDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CacaoAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = m.CacaoAmount.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.Units.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCACAOPoolWithdrawV118) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CacaoAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	if m.BasisPoints != 0 {
+		n += 1 + sovTypeEvents(uint64(m.BasisPoints))
+	}
+	l = m.CacaoAmount.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.Units.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	if m.AffiliateBasisPts != 0 {
+		n += 1 + sovTypeEvents(uint64(m.AffiliateBasisPts))
+	}
+	l = m.AffiliateAmount.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = len(m.AffiliateAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCACAOPoolWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CacaoAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	if m.BasisPoints != 0 {
+		n += 1 + sovTypeEvents(uint64(m.BasisPoints))
+	}
+	l = m.CacaoAmount.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.Units.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	if m.AffiliateBasisPts != 0 {
+		n += 1 + sovTypeEvents(uint64(m.AffiliateBasisPts))
+	}
+	l = m.AffiliateAmount.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	return n
+}
+
+func (m *EventCACAOPoolWithdrawQueued) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CacaoAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	if m.BasisPoints != 0 {
+		n += 1 + sovTypeEvents(uint64(m.BasisPoints))
+	}
+	if m.MatureHeight != 0 {
+		n += 1 + sovTypeEvents(uint64(m.MatureHeight))
+	}
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCACAOPoolCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CacaoAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = m.CacaoAmount.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	return n
+}
+
+func (m *EventTradeAccountDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.Asset.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = len(m.AssetAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.CacaoAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventTradeAccountWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.Asset.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = len(m.AssetAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.CacaoAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventTradeAccountTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.Asset.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = m.Units.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventLimitOrderClose) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = m.Source.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.TargetAsset.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.TradeTarget.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTypeEvents(uint64(m.ExpiryHeight))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.CancelTxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventLimitOrderFill) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = m.In.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.Out.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.FilledIn.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.FilledOut.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.Remaining.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	if m.Count != 0 {
+		n += 1 + sovTypeEvents(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *EventStreamingSwapCancel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.CancelTxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovTypeEvents(uint64(m.Quantity))
+	}
+	if m.Count != 0 {
+		n += 1 + sovTypeEvents(uint64(m.Count))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.In.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.Out.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.Refund.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	return n
+}
+
+func (m *EventDCAOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.TargetAsset.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	if m.Interval != 0 {
+		n += 1 + sovTypeEvents(uint64(m.Interval))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovTypeEvents(uint64(m.Quantity))
+	}
+	l = m.SliceLimit.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	return n
+}
+
+func (m *EventDCASlice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovTypeEvents(uint64(m.Quantity))
+	}
+	if m.Count != 0 {
+		n += 1 + sovTypeEvents(uint64(m.Count))
+	}
+	l = m.In.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.Out.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.SliceLimit.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventLoanOpen) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = m.CollateralAsset.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.CollateralDeposited.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.CollateralUnits.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.DebtAsset.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.DebtIssued.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = len(m.TargetAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	if m.CollateralizationRatio != 0 {
+		n += 1 + sovTypeEvents(uint64(m.CollateralizationRatio))
+	}
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventLoanRepayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = m.CollateralAsset.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.CollateralWithdrawn.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.DebtAsset.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.DebtRepaid.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.Coin.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventLoanClose) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = m.CollateralAsset.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.CollateralWithdrawn.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.DebtAsset.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.DebtRepaid.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventLoanLiquidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = m.CollateralAsset.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.CollateralLiquidated.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.DebtAsset.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.DebtRepaid.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.CacaoRecovered.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	if m.CollateralizationRatio != 0 {
+		n += 1 + sovTypeEvents(uint64(m.CollateralizationRatio))
+	}
+	return n
+}
+
+func (m *EventMAYANameList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	if m.ExpireHeight != 0 {
+		n += 1 + sovTypeEvents(uint64(m.ExpireHeight))
+	}
+	if m.KeepAliases {
+		n += 2
+	}
+	if m.KeepSubaffiliates {
+		n += 2
+	}
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMAYANameDelist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMAYANameSale) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPOL) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pool.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = m.CacaoAmount.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.SynthUtilization.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.TargetSynthUtilization.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.Buffer.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventBondProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNodeOperatorFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	if m.OldFee != 0 {
+		n += 1 + sovTypeEvents(uint64(m.OldFee))
+	}
+	if m.NewFee != 0 {
+		n += 1 + sovTypeEvents(uint64(m.NewFee))
+	}
+	if m.ApplyHeight != 0 {
+		n += 1 + sovTypeEvents(uint64(m.ApplyHeight))
+	}
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventBondProviderTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = m.Asset.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.Units.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventVaultMigrated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	if m.RetiringHeight != 0 {
+		n += 1 + sovTypeEvents(uint64(m.RetiringHeight))
+	}
+	if len(m.Migrated) > 0 {
+		for _, e := range m.Migrated {
+			l = e.Size()
+			n += 1 + l + sovTypeEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventSolvencyWarning) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = m.Asset.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.VaultAmount.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.WalletAmount.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	if m.Gap != 0 {
+		n += 1 + sovTypeEvents(uint64(m.Gap))
+	}
+	if m.WarnGap != 0 {
+		n += 1 + sovTypeEvents(uint64(m.WarnGap))
+	}
+	if m.HaltGap != 0 {
+		n += 1 + sovTypeEvents(uint64(m.HaltGap))
+	}
+	return n
+}
+
+func sovTypeEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypeEvents(x uint64) (n int) {
+	return sovTypeEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PoolMod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolMod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolMod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAmt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CacaoAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAdd", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CacaoAdd = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetAmt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AssetAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetAdd", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AssetAdd = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventStreamingSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventStreamingSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventStreamingSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			}
+			m.LastHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeTarget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TradeTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field In", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.In.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Out", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Out.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypeEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.FailedSwaps = append(m.FailedSwaps, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypeEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypeEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypeEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.FailedSwaps) == 0 {
+					m.FailedSwaps = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypeEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.FailedSwaps = append(m.FailedSwaps, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedSwaps", wireType)
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedSwapReasons", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedSwapReasons = append(m.FailedSwapReasons, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapTarget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapSlip", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapSlip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityFeeInCacao", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityFeeInCacao.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutTxs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmitAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EmitAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SynthUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SynthUnits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamingSwapQuantity", wireType)
+			}
+			m.StreamingSwapQuantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamingSwapQuantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamingSwapCount", wireType)
+			}
+			m.StreamingSwapCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamingSwapCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAffiliateFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAffiliateFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAffiliateFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mayaname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mayaname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacaoAddress = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrossAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GrossAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBpsTick", wireType)
+			}
+			m.FeeBpsTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeBpsTick |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubFeeBps", wireType)
+			}
+			m.SubFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubFeeBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAddLiquidity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAddLiquidity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAddLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProviderUnits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacaoAddress = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CacaoAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuneTxID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuneTxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetTxID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetTxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetAddress = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventWithdraw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProviderUnits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasisPoints", wireType)
+			}
+			m.BasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BasisPoints |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asymmetry", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asymmetry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmitAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EmitAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmitCacao", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EmitCacao.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImpLossProtection", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ImpLossProtection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPendingLiquidity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPendingLiquidity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPendingLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingType", wireType)
+			}
+			m.PendingType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingType |= PendingLiquidityType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacaoAddress = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CacaoAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetAddress = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuneTxID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuneTxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetTxID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetTxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDonate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDonate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDonate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PoolStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolAmt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolAmt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolAmt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRewardsV1) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRewardsV1: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRewardsV1: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolRewards = append(m.PoolRewards, PoolAmt{})
+			if err := m.PoolRewards[len(m.PoolRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolRewards = append(m.PoolRewards, PoolAmt{})
+			if err := m.PoolRewards[len(m.PoolRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacaoPoolReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CacaoPoolReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MayaFundReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MayaFundReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRefund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRefund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRefund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBond) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBond: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBond: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondType", wireType)
+			}
+			m.BondType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BondType |= BondType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBondV105) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBondV105: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBondV105: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondType", wireType)
+			}
+			m.BondType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BondType |= BondType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAmt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CacaoAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetAmt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AssetAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventGas) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventGas: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventGas: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, GasPool{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventReserve) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventReserve: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventReserve: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveContributor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReserveContributor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScheduledOutbound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScheduledOutbound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScheduledOutbound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSecurity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSecurity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSecurity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSlash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSlash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSlash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashAmount = append(m.SlashAmount, PoolAmt{})
+			if err := m.SlashAmount[len(m.SlashAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSlashLiquidity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSlashLiquidity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSlashLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeBondAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeBondAddress = append(m.NodeBondAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.NodeBondAddress == nil {
+				m.NodeBondAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LpUnits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventErrata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventErrata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventErrata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, PoolMod{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SynthUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SynthUnits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOutbound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOutbound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOutbound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InTxID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InTxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTssKeygenSuccess) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTssKeygenSuccess: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTssKeygenSuccess: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = gitlab_com_mayachain_mayanode_common.PubKey(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *EventTradeAccountWithdraw) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = m.Asset.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
-	l = len(m.AssetAddress)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
-	}
-	l = len(m.CacaoAddress)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
-	}
-	l = len(m.TxID)
-	if l > 0 {
-		n += 1 + l + sovTypeEvents(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTypeEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypeEvents(x uint64) (n int) {
-	return sovTypeEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *PoolMod) Unmarshal(dAtA []byte) error {
+func (m *EventTssKeygenFailure) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6467,17 +15035,17 @@ func (m *PoolMod) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolMod: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventTssKeygenFailure: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolMod: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventTssKeygenFailure: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailReason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -6487,28 +15055,47 @@ func (m *PoolMod) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FailReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsUnicast", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsUnicast = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAmt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlameNodes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6536,15 +15123,45 @@ func (m *PoolMod) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CacaoAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.BlameNodes = append(m.BlameNodes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Round = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAdd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var v int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -6554,15 +15171,67 @@ func (m *PoolMod) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CacaoAdd = bool(v != 0)
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTssKeygenMetric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTssKeygenMetric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTssKeygenMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetAmt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6590,15 +15259,13 @@ func (m *PoolMod) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AssetAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PubKey = gitlab_com_mayachain_mayanode_common.PubKey(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetAdd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MedianDurationMs", wireType)
 			}
-			var v int
+			m.MedianDurationMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -6608,12 +15275,11 @@ func (m *PoolMod) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MedianDurationMs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AssetAdd = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypeEvents(dAtA[iNdEx:])
@@ -6638,7 +15304,7 @@ func (m *PoolMod) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventStreamingSwap) Unmarshal(dAtA []byte) error {
+func (m *EventTssKeysignMetric) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6661,10 +15327,10 @@ func (m *EventStreamingSwap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventStreamingSwap: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventTssKeysignMetric: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventStreamingSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventTssKeysignMetric: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6701,9 +15367,9 @@ func (m *EventStreamingSwap) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MedianDurationMs", wireType)
 			}
-			m.Interval = 0
+			m.MedianDurationMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -6713,16 +15379,69 @@ func (m *EventStreamingSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Interval |= uint64(b&0x7F) << shift
+				m.MedianDurationMs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSlashPoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSlashPoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSlashPoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeAddress", wireType)
 			}
-			m.Quantity = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -6732,35 +15451,31 @@ func (m *EventStreamingSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Quantity |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthTypeEvents
 			}
-			m.Count = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypeEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Count |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
 			}
-		case 5:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeAddress = append(m.NodeAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.NodeAddress == nil {
+				m.NodeAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashPoints", wireType)
 			}
-			m.LastHeight = 0
+			m.SlashPoints = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -6770,14 +15485,14 @@ func (m *EventStreamingSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastHeight |= int64(b&0x7F) << shift
+				m.SlashPoints |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TradeTarget", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6805,13 +15520,64 @@ func (m *EventStreamingSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TradeTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 7:
+			if skippy < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPoolBalanceChanged) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPoolBalanceChanged: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPoolBalanceChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolChange", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6838,15 +15604,15 @@ func (m *EventStreamingSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PoolChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field In", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -6856,30 +15622,82 @@ func (m *EventStreamingSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.In.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 9:
+			if skippy < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSwitch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSwitch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSwitch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Out", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -6889,104 +15707,94 @@ func (m *EventStreamingSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Out.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ToAddress = append(m.ToAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ToAddress == nil {
+				m.ToAddress = []byte{}
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTypeEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.FailedSwaps = append(m.FailedSwaps, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTypeEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTypeEvents
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTypeEvents
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.FailedSwaps) == 0 {
-					m.FailedSwaps = make([]uint64, 0, elementCount)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTypeEvents
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.FailedSwaps = append(m.FailedSwaps, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedSwaps", wireType)
 			}
-		case 11:
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedSwapReasons", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7014,7 +15822,7 @@ func (m *EventStreamingSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FailedSwapReasons = append(m.FailedSwapReasons, string(dAtA[iNdEx:postIndex]))
+			m.TxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7040,7 +15848,7 @@ func (m *EventStreamingSwap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSwap) Unmarshal(dAtA []byte) error {
+func (m *EventSwitchV87) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7063,17 +15871,17 @@ func (m *EventSwap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSwap: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSwitchV87: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSwitchV87: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -7083,28 +15891,29 @@ func (m *EventSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ToAddress = append(m.ToAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ToAddress == nil {
+				m.ToAddress = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapTarget", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7132,13 +15941,44 @@ func (m *EventSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SwapTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FromAddress = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapSlip", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7166,13 +16006,11 @@ func (m *EventSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SwapSlip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7200,13 +16038,66 @@ func (m *EventSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidityFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Mint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMAYANameV111) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMAYANameV111: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMAYANameV111: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityFeeInCacao", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7234,15 +16125,13 @@ func (m *EventSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidityFeeInCacao.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -7252,30 +16141,29 @@ func (m *EventSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Chain = gitlab_com_mayachain_mayanode_common.Chain(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutTxs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -7285,30 +16173,29 @@ func (m *EventSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OutTxs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmitAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -7318,28 +16205,29 @@ func (m *EventSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EmitAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RegistrationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SynthUnits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FundAmt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7367,15 +16255,15 @@ func (m *EventSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SynthUnits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FundAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StreamingSwapQuantity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expire", wireType)
 			}
-			m.StreamingSwapQuantity = 0
+			m.Expire = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -7385,16 +16273,16 @@ func (m *EventSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StreamingSwapQuantity |= uint64(b&0x7F) << shift
+				m.Expire |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StreamingSwapCount", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			m.StreamingSwapCount = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -7404,11 +16292,26 @@ func (m *EventSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StreamingSwapCount |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypeEvents(dAtA[iNdEx:])
@@ -7433,7 +16336,7 @@ func (m *EventSwap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAffiliateFee) Unmarshal(dAtA []byte) error {
+func (m *EventMAYAName) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7456,15 +16359,15 @@ func (m *EventAffiliateFee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAffiliateFee: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMAYAName: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAffiliateFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMAYAName: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7492,11 +16395,11 @@ func (m *EventAffiliateFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7524,11 +16427,11 @@ func (m *EventAffiliateFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Memo = string(dAtA[iNdEx:postIndex])
+			m.Chain = gitlab_com_mayachain_mayanode_common.Chain(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mayaname", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7556,11 +16459,11 @@ func (m *EventAffiliateFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mayaname = string(dAtA[iNdEx:postIndex])
+			m.Address = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7588,13 +16491,15 @@ func (m *EventAffiliateFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CacaoAddress = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
+			if err := m.RegistrationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FundAmt", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -7604,30 +16509,50 @@ func (m *EventAffiliateFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FundAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expire", wireType)
+			}
+			m.Expire = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expire |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GrossAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -7637,31 +16562,31 @@ func (m *EventAffiliateFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GrossAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
 			}
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeBpsTick", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AffiliateBps", wireType)
 			}
-			m.FeeBpsTick = 0
+			m.AffiliateBps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -7671,14 +16596,14 @@ func (m *EventAffiliateFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FeeBpsTick |= uint64(b&0x7F) << shift
+				m.AffiliateBps |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaffiliateName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7706,13 +16631,11 @@ func (m *EventAffiliateFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FeeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SubaffiliateName = append(m.SubaffiliateName, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 9:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaffiliateBps", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7740,27 +16663,12 @@ func (m *EventAffiliateFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parent = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubFeeBps", wireType)
-			}
-			m.SubFeeBps = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypeEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SubFeeBps |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v github_com_cosmos_cosmos_sdk_types.Uint
+			m.SubaffiliateBps = append(m.SubaffiliateBps, v)
+			if err := m.SubaffiliateBps[len(m.SubaffiliateBps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypeEvents(dAtA[iNdEx:])
@@ -7785,7 +16693,7 @@ func (m *EventAffiliateFee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAddLiquidity) Unmarshal(dAtA []byte) error {
+func (m *EventSetMimir) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7808,17 +16716,17 @@ func (m *EventAddLiquidity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAddLiquidity: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSetMimir: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAddLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSetMimir: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -7828,28 +16736,112 @@ func (m *EventAddLiquidity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderUnits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSetNodeMimir) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSetNodeMimir: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSetNodeMimir: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7877,13 +16869,11 @@ func (m *EventAddLiquidity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProviderUnits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7911,11 +16901,11 @@ func (m *EventAddLiquidity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CacaoAddress = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7943,15 +16933,66 @@ func (m *EventAddLiquidity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CacaoAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
+			if skippy < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCACAOPoolDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCACAOPoolDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCACAOPoolDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAddress", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -7961,29 +17002,29 @@ func (m *EventAddLiquidity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CacaoAddress = append(m.CacaoAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.CacaoAddress == nil {
+				m.CacaoAddress = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RuneTxID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8011,11 +17052,13 @@ func (m *EventAddLiquidity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RuneTxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
+			if err := m.CacaoAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetTxID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8043,11 +17086,13 @@ func (m *EventAddLiquidity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetTxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
+			if err := m.Units.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8075,7 +17120,7 @@ func (m *EventAddLiquidity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetAddress = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
+			m.TxId = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8101,7 +17146,7 @@ func (m *EventAddLiquidity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
+func (m *EventCACAOPoolWithdrawV118) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8124,17 +17169,17 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCACAOPoolWithdrawV118: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCACAOPoolWithdrawV118: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -8144,60 +17189,27 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CacaoAddress = append(m.CacaoAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.CacaoAddress == nil {
+				m.CacaoAddress = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderUnits", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypeEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ProviderUnits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BasisPoints", wireType)
 			}
@@ -8216,11 +17228,11 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asymmetry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAmount", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -8230,30 +17242,31 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Asymmetry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CacaoAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -8263,28 +17276,29 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Units.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmitAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8312,13 +17326,30 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EmitAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TxId = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AffiliateBasisPts", wireType)
+			}
+			m.AffiliateBasisPts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AffiliateBasisPts |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmitCacao", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AffiliateAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8346,13 +17377,13 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EmitCacao.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AffiliateAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImpLossProtection", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AffiliateAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8380,9 +17411,7 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ImpLossProtection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AffiliateAddress = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8408,7 +17437,7 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventPendingLiquidity) Unmarshal(dAtA []byte) error {
+func (m *EventCACAOPoolWithdraw) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8431,17 +17460,17 @@ func (m *EventPendingLiquidity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventPendingLiquidity: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCACAOPoolWithdraw: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPendingLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCACAOPoolWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -8451,30 +17480,31 @@ func (m *EventPendingLiquidity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CacaoAddress = append(m.CacaoAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.CacaoAddress == nil {
+				m.CacaoAddress = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BasisPoints", wireType)
 			}
-			m.PendingType = 0
+			m.BasisPoints = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -8484,14 +17514,14 @@ func (m *EventPendingLiquidity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PendingType |= PendingLiquidityType(b&0x7F) << shift
+				m.BasisPoints |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8519,11 +17549,13 @@ func (m *EventPendingLiquidity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CacaoAddress = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
+			if err := m.CacaoAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8551,13 +17583,13 @@ func (m *EventPendingLiquidity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CacaoAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Units.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8585,13 +17617,13 @@ func (m *EventPendingLiquidity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetAddress = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
+			m.TxId = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetAmount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AffiliateBasisPts", wireType)
 			}
-			var stringLen uint64
+			m.AffiliateBasisPts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -8601,29 +17633,14 @@ func (m *EventPendingLiquidity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AffiliateBasisPts |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.AssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RuneTxID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AffiliateAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8651,39 +17668,9 @@ func (m *EventPendingLiquidity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RuneTxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetTxID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypeEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.AffiliateAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.AssetTxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8709,7 +17696,7 @@ func (m *EventPendingLiquidity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDonate) Unmarshal(dAtA []byte) error {
+func (m *EventCACAOPoolWithdrawQueued) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8732,17 +17719,17 @@ func (m *EventDonate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDonate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCACAOPoolWithdrawQueued: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDonate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCACAOPoolWithdrawQueued: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -8752,30 +17739,31 @@ func (m *EventDonate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CacaoAddress = append(m.CacaoAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.CacaoAddress == nil {
+				m.CacaoAddress = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InTx", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasisPoints", wireType)
 			}
-			var msglen int
+			m.BasisPoints = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -8785,83 +17773,16 @@ func (m *EventDonate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BasisPoints |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.InTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypeEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventPool) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypeEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventPool: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPool: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatureHeight", wireType)
 			}
-			var msglen int
+			m.MatureHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -8871,30 +17792,16 @@ func (m *EventPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MatureHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -8904,11 +17811,24 @@ func (m *EventPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= PoolStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypeEvents(dAtA[iNdEx:])
@@ -8933,7 +17853,7 @@ func (m *EventPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolAmt) Unmarshal(dAtA []byte) error {
+func (m *EventCACAOPoolCompound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8956,17 +17876,17 @@ func (m *PoolAmt) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolAmt: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCACAOPoolCompound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolAmt: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCACAOPoolCompound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -8976,30 +17896,31 @@ func (m *PoolAmt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CacaoAddress = append(m.CacaoAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.CacaoAddress == nil {
+				m.CacaoAddress = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAmount", wireType)
 			}
-			m.Amount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -9009,11 +17930,26 @@ func (m *PoolAmt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CacaoAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypeEvents(dAtA[iNdEx:])
@@ -9038,7 +17974,7 @@ func (m *PoolAmt) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRewardsV1) Unmarshal(dAtA []byte) error {
+func (m *EventTradeAccountDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9061,15 +17997,15 @@ func (m *EventRewardsV1) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRewardsV1: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventTradeAccountDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRewardsV1: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventTradeAccountDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9097,13 +18033,13 @@ func (m *EventRewardsV1) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BondReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9130,11 +18066,106 @@ func (m *EventRewardsV1) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolRewards = append(m.PoolRewards, PoolAmt{})
-			if err := m.PoolRewards[len(m.PoolRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetAddress = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacaoAddress = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypeEvents(dAtA[iNdEx:])
@@ -9159,7 +18190,7 @@ func (m *EventRewardsV1) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRewards) Unmarshal(dAtA []byte) error {
+func (m *EventTradeAccountWithdraw) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9182,15 +18213,15 @@ func (m *EventRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventTradeAccountWithdraw: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventTradeAccountWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9218,13 +18249,13 @@ func (m *EventRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BondReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9251,14 +18282,13 @@ func (m *EventRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolRewards = append(m.PoolRewards, PoolAmt{})
-			if err := m.PoolRewards[len(m.PoolRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacaoPoolReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9286,13 +18316,11 @@ func (m *EventRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CacaoPoolReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AssetAddress = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MayaFundReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9320,9 +18348,39 @@ func (m *EventRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MayaFundReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CacaoAddress = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9348,7 +18406,7 @@ func (m *EventRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRefund) Unmarshal(dAtA []byte) error {
+func (m *EventTradeAccountTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9371,17 +18429,17 @@ func (m *EventRefund) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRefund: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventTradeAccountTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRefund: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventTradeAccountTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Code = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -9391,14 +18449,94 @@ func (m *EventRefund) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Code |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9426,13 +18564,13 @@ func (m *EventRefund) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.ToAddress = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -9442,30 +18580,31 @@ func (m *EventRefund) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Units.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -9475,24 +18614,23 @@ func (m *EventRefund) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9518,7 +18656,7 @@ func (m *EventRefund) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBond) Unmarshal(dAtA []byte) error {
+func (m *EventLimitOrderClose) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9541,15 +18679,15 @@ func (m *EventBond) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBond: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventLimitOrderClose: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBond: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventLimitOrderClose: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9577,15 +18715,13 @@ func (m *EventBond) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
-			m.BondType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -9595,14 +18731,27 @@ func (m *EventBond) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BondType |= BondType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9629,66 +18778,46 @@ func (m *EventBond) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TxIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypeEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAsset", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthTypeEvents
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventBondV105) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypeEvents
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.TargetAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventBondV105: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBondV105: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeTarget", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9716,15 +18845,15 @@ func (m *EventBondV105) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TradeTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
 			}
-			m.BondType = 0
+			m.ExpiryHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -9734,16 +18863,16 @@ func (m *EventBondV105) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BondType |= BondType(b&0x7F) << shift
+				m.ExpiryHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -9753,30 +18882,29 @@ func (m *EventBondV105) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TxIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelTxID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -9786,24 +18914,23 @@ func (m *EventBondV105) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CancelTxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9829,7 +18956,7 @@ func (m *EventBondV105) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GasPool) Unmarshal(dAtA []byte) error {
+func (m *EventLimitOrderFill) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9852,15 +18979,80 @@ func (m *GasPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GasPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventLimitOrderFill: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GasPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventLimitOrderFill: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field In", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.In.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Out", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9887,13 +19079,13 @@ func (m *GasPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Out.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAmt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FilledIn", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9921,13 +19113,13 @@ func (m *GasPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CacaoAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FilledIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetAmt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FilledOut", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9955,15 +19147,15 @@ func (m *GasPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AssetAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FilledOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
 			}
-			m.Count = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -9973,69 +19165,31 @@ func (m *GasPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypeEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventGas) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypeEvents
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Remaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventGas: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventGas: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var msglen int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -10045,26 +19199,11 @@ func (m *EventGas) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Count |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Pools = append(m.Pools, GasPool{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypeEvents(dAtA[iNdEx:])
@@ -10089,7 +19228,7 @@ func (m *EventGas) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventReserve) Unmarshal(dAtA []byte) error {
+func (m *EventStreamingSwapCancel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10112,17 +19251,17 @@ func (m *EventReserve) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventReserve: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventStreamingSwapCancel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventReserve: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventStreamingSwapCancel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReserveContributor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -10132,30 +19271,29 @@ func (m *EventReserve) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReserveContributor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelTxID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -10165,83 +19303,29 @@ func (m *EventReserve) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CancelTxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypeEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventScheduledOutbound) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypeEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventScheduledOutbound: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventScheduledOutbound: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -10251,83 +19335,67 @@ func (m *EventScheduledOutbound) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OutTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FromAddress = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypeEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventSecurity) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypeEvents
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventSecurity: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSecurity: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -10337,27 +19405,28 @@ func (m *EventSecurity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field In", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10384,66 +19453,13 @@ func (m *EventSecurity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.In.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypeEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventSlash) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypeEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventSlash: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSlash: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Out", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10470,13 +19486,13 @@ func (m *EventSlash) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Out.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10503,8 +19519,7 @@ func (m *EventSlash) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SlashAmount = append(m.SlashAmount, PoolAmt{})
-			if err := m.SlashAmount[len(m.SlashAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Refund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10532,7 +19547,7 @@ func (m *EventSlash) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSlashLiquidity) Unmarshal(dAtA []byte) error {
+func (m *EventDCAOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10555,17 +19570,17 @@ func (m *EventSlashLiquidity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSlashLiquidity: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDCAOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSlashLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDCAOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeBondAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -10575,31 +19590,29 @@ func (m *EventSlashLiquidity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeBondAddress = append(m.NodeBondAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.NodeBondAddress == nil {
-				m.NodeBondAddress = []byte{}
-			}
+			m.TxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -10609,28 +19622,27 @@ func (m *EventSlashLiquidity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FromAddress = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10658,13 +19670,13 @@ func (m *EventSlashLiquidity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
+			m.Destination = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LpUnits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -10674,84 +19686,82 @@ func (m *EventSlashLiquidity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LpUnits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypeEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAsset", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventErrata) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypeEvents
+			if err := m.TargetAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventErrata: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventErrata: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
 			}
-			var stringLen uint64
+			m.Quantity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -10761,29 +19771,16 @@ func (m *EventErrata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Quantity |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SliceLimit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -10793,23 +19790,23 @@ func (m *EventErrata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, PoolMod{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SliceLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10837,7 +19834,7 @@ func (m *EventErrata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventFee) Unmarshal(dAtA []byte) error {
+func (m *EventDCASlice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10860,10 +19857,10 @@ func (m *EventFee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventFee: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDCASlice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDCASlice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10899,8 +19896,46 @@ func (m *EventFee) Unmarshal(dAtA []byte) error {
 			m.TxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field In", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10927,15 +19962,15 @@ func (m *EventFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.In.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SynthUnits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Out", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -10945,82 +19980,28 @@ func (m *EventFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SynthUnits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Out.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypeEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventOutbound) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypeEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventOutbound: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOutbound: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InTxID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SliceLimit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11048,13 +20029,15 @@ func (m *EventOutbound) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InTxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
+			if err := m.SliceLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -11064,24 +20047,23 @@ func (m *EventOutbound) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11107,7 +20089,7 @@ func (m *EventOutbound) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventTssKeygenSuccess) Unmarshal(dAtA []byte) error {
+func (m *EventLoanOpen) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11130,15 +20112,15 @@ func (m *EventTssKeygenSuccess) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventTssKeygenSuccess: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventLoanOpen: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventTssKeygenSuccess: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventLoanOpen: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11166,13 +20148,13 @@ func (m *EventTssKeygenSuccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKey = gitlab_com_mayachain_mayanode_common.PubKey(dAtA[iNdEx:postIndex])
+			m.Owner = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralAsset", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -11182,29 +20164,30 @@ func (m *EventTssKeygenSuccess) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			if err := m.CollateralAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDeposited", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -11214,67 +20197,29 @@ func (m *EventTssKeygenSuccess) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypeEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventTssKeygenFailure) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypeEvents
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.CollateralDeposited.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventTssKeygenFailure: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventTssKeygenFailure: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailReason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralUnits", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11302,13 +20247,15 @@ func (m *EventTssKeygenFailure) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FailReason = string(dAtA[iNdEx:postIndex])
+			if err := m.CollateralUnits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsUnicast", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtAsset", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -11318,15 +20265,28 @@ func (m *EventTssKeygenFailure) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsUnicast = bool(v != 0)
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DebtAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlameNodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtIssued", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11354,11 +20314,13 @@ func (m *EventTssKeygenFailure) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlameNodes = append(m.BlameNodes, string(dAtA[iNdEx:postIndex]))
+			if err := m.DebtIssued.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11386,13 +20348,13 @@ func (m *EventTssKeygenFailure) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Round = string(dAtA[iNdEx:postIndex])
+			m.TargetAddress = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralizationRatio", wireType)
 			}
-			m.Height = 0
+			m.CollateralizationRatio = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -11402,67 +20364,14 @@ func (m *EventTssKeygenFailure) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				m.CollateralizationRatio |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypeEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventTssKeygenMetric) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypeEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventTssKeygenMetric: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventTssKeygenMetric: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11490,27 +20399,8 @@ func (m *EventTssKeygenMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKey = gitlab_com_mayachain_mayanode_common.PubKey(dAtA[iNdEx:postIndex])
+			m.TxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MedianDurationMs", wireType)
-			}
-			m.MedianDurationMs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypeEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MedianDurationMs |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypeEvents(dAtA[iNdEx:])
@@ -11535,7 +20425,7 @@ func (m *EventTssKeygenMetric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventTssKeysignMetric) Unmarshal(dAtA []byte) error {
+func (m *EventLoanRepayment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11558,15 +20448,15 @@ func (m *EventTssKeysignMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventTssKeysignMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventLoanRepayment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventTssKeysignMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventLoanRepayment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11594,13 +20484,13 @@ func (m *EventTssKeysignMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
+			m.Owner = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MedianDurationMs", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralAsset", wireType)
 			}
-			m.MedianDurationMs = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -11610,69 +20500,30 @@ func (m *EventTssKeysignMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MedianDurationMs |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypeEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventSlashPoint) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypeEvents
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.CollateralAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventSlashPoint: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSlashPoint: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralWithdrawn", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -11682,31 +20533,31 @@ func (m *EventSlashPoint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeAddress = append(m.NodeAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.NodeAddress == nil {
-				m.NodeAddress = []byte{}
+			if err := m.CollateralWithdrawn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashPoints", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtAsset", wireType)
 			}
-			m.SlashPoints = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -11716,14 +20567,28 @@ func (m *EventSlashPoint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlashPoints |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DebtAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtRepaid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11748,67 +20613,16 @@ func (m *EventSlashPoint) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypeEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventPoolBalanceChanged) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypeEvents
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.DebtRepaid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventPoolBalanceChanged: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPoolBalanceChanged: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolChange", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11835,13 +20649,13 @@ func (m *EventPoolBalanceChanged) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PoolChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11869,7 +20683,7 @@ func (m *EventPoolBalanceChanged) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.TxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11895,7 +20709,7 @@ func (m *EventPoolBalanceChanged) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSwitch) Unmarshal(dAtA []byte) error {
+func (m *EventLoanClose) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11918,17 +20732,17 @@ func (m *EventSwitch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSwitch: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventLoanClose: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSwitch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventLoanClose: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -11938,29 +20752,60 @@ func (m *EventSwitch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = append(m.ToAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ToAddress == nil {
-				m.ToAddress = []byte{}
-			}
+			m.Owner = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollateralAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralWithdrawn", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11988,11 +20833,13 @@ func (m *EventSwitch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
+			if err := m.CollateralWithdrawn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtAsset", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12019,11 +20866,45 @@ func (m *EventSwitch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Burn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DebtAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtRepaid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DebtRepaid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
 			}
@@ -12079,7 +20960,7 @@ func (m *EventSwitch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSwitchV87) Unmarshal(dAtA []byte) error {
+func (m *EventLoanLiquidation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12102,17 +20983,17 @@ func (m *EventSwitchV87) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSwitchV87: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventLoanLiquidation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSwitchV87: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventLoanLiquidation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -12122,29 +21003,60 @@ func (m *EventSwitchV87) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = append(m.ToAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ToAddress == nil {
-				m.ToAddress = []byte{}
-			}
+			m.Owner = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollateralAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralLiquidated", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12172,11 +21084,13 @@ func (m *EventSwitchV87) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
+			if err := m.CollateralLiquidated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtAsset", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12203,13 +21117,13 @@ func (m *EventSwitchV87) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Burn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DebtAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtRepaid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12237,11 +21151,13 @@ func (m *EventSwitchV87) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
+			if err := m.DebtRepaid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CacaoRecovered", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12269,10 +21185,29 @@ func (m *EventSwitchV87) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Mint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CacaoRecovered.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralizationRatio", wireType)
+			}
+			m.CollateralizationRatio = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CollateralizationRatio |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypeEvents(dAtA[iNdEx:])
@@ -12297,7 +21232,7 @@ func (m *EventSwitchV87) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMAYANameV111) Unmarshal(dAtA []byte) error {
+func (m *EventMAYANameList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12320,10 +21255,10 @@ func (m *EventMAYANameV111) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMAYANameV111: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMAYANameList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMAYANameV111: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMAYANameList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12360,7 +21295,7 @@ func (m *EventMAYANameV111) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12388,11 +21323,11 @@ func (m *EventMAYANameV111) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chain = gitlab_com_mayachain_mayanode_common.Chain(dAtA[iNdEx:postIndex])
+			m.Seller = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12420,13 +21355,15 @@ func (m *EventMAYANameV111) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireHeight", wireType)
 			}
-			var stringLen uint64
+			m.ExpireHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -12436,31 +21373,16 @@ func (m *EventMAYANameV111) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExpireHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.RegistrationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundAmt", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepAliases", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -12469,32 +21391,18 @@ func (m *EventMAYANameV111) Unmarshal(dAtA []byte) error {
 					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.FundAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.KeepAliases = bool(v != 0)
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expire", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepSubaffiliates", wireType)
 			}
-			m.Expire = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -12504,16 +21412,17 @@ func (m *EventMAYANameV111) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Expire |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.KeepSubaffiliates = bool(v != 0)
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -12523,25 +21432,23 @@ func (m *EventMAYANameV111) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
-			if m.Owner == nil {
-				m.Owner = []byte{}
-			}
+			m.TxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12567,7 +21474,7 @@ func (m *EventMAYANameV111) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMAYAName) Unmarshal(dAtA []byte) error {
+func (m *EventMAYANameDelist) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12590,10 +21497,10 @@ func (m *EventMAYAName) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMAYAName: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMAYANameDelist: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMAYAName: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMAYANameDelist: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12630,7 +21537,7 @@ func (m *EventMAYAName) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12658,11 +21565,11 @@ func (m *EventMAYAName) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chain = gitlab_com_mayachain_mayanode_common.Chain(dAtA[iNdEx:postIndex])
+			m.Seller = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12690,11 +21597,64 @@ func (m *EventMAYAName) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
+			m.TxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMAYANameSale) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMAYANameSale: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMAYANameSale: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12722,13 +21682,11 @@ func (m *EventMAYAName) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RegistrationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundAmt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12756,34 +21714,13 @@ func (m *EventMAYAName) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FundAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Seller = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expire", wireType)
-			}
-			m.Expire = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypeEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Expire |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -12793,48 +21730,27 @@ func (m *EventMAYAName) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
-			if m.Owner == nil {
-				m.Owner = []byte{}
-			}
+			m.Buyer = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AffiliateBps", wireType)
-			}
-			m.AffiliateBps = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypeEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AffiliateBps |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubaffiliateName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12862,11 +21778,13 @@ func (m *EventMAYAName) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubaffiliateName = append(m.SubaffiliateName, string(dAtA[iNdEx:postIndex]))
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubaffiliateBps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12894,11 +21812,7 @@ func (m *EventMAYAName) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Uint
-			m.SubaffiliateBps = append(m.SubaffiliateBps, v)
-			if err := m.SubaffiliateBps[len(m.SubaffiliateBps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12924,7 +21838,7 @@ func (m *EventMAYAName) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSetMimir) Unmarshal(dAtA []byte) error {
+func (m *EventPOL) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12947,17 +21861,17 @@ func (m *EventSetMimir) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSetMimir: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPOL: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSetMimir: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPOL: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -12967,27 +21881,28 @@ func (m *EventSetMimir) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13015,64 +21930,79 @@ func (m *EventSetMimir) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Action = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypeEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAmount", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventSetNodeMimir) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypeEvents
+			if err := m.CacaoAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SynthUtilization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.SynthUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventSetNodeMimir: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSetNodeMimir: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetSynthUtilization", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13100,11 +22030,13 @@ func (m *EventSetNodeMimir) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if err := m.TargetSynthUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buffer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13132,11 +22064,13 @@ func (m *EventSetNodeMimir) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			if err := m.Buffer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13164,7 +22098,7 @@ func (m *EventSetNodeMimir) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13190,7 +22124,7 @@ func (m *EventSetNodeMimir) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCACAOPoolDeposit) Unmarshal(dAtA []byte) error {
+func (m *EventBondProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13213,15 +22147,15 @@ func (m *EventCACAOPoolDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCACAOPoolDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventBondProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCACAOPoolDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventBondProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeAddress", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -13248,16 +22182,16 @@ func (m *EventCACAOPoolDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CacaoAddress = append(m.CacaoAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.CacaoAddress == nil {
-				m.CacaoAddress = []byte{}
+			m.NodeAddress = append(m.NodeAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.NodeAddress == nil {
+				m.NodeAddress = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -13267,29 +22201,29 @@ func (m *EventCACAOPoolDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CacaoAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ProviderAddress = append(m.ProviderAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProviderAddress == nil {
+				m.ProviderAddress = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13317,13 +22251,11 @@ func (m *EventCACAOPoolDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Units.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Action = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13351,7 +22283,7 @@ func (m *EventCACAOPoolDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxId = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
+			m.TxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13377,7 +22309,7 @@ func (m *EventCACAOPoolDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCACAOPoolWithdrawV118) Unmarshal(dAtA []byte) error {
+func (m *EventNodeOperatorFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13400,15 +22332,15 @@ func (m *EventCACAOPoolWithdrawV118) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCACAOPoolWithdrawV118: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNodeOperatorFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCACAOPoolWithdrawV118: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNodeOperatorFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeAddress", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -13435,16 +22367,16 @@ func (m *EventCACAOPoolWithdrawV118) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CacaoAddress = append(m.CacaoAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.CacaoAddress == nil {
-				m.CacaoAddress = []byte{}
+			m.NodeAddress = append(m.NodeAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.NodeAddress == nil {
+				m.NodeAddress = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasisPoints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldFee", wireType)
 			}
-			m.BasisPoints = 0
+			m.OldFee = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -13454,16 +22386,16 @@ func (m *EventCACAOPoolWithdrawV118) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BasisPoints |= int64(b&0x7F) << shift
+				m.OldFee |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAmount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFee", wireType)
 			}
-			var stringLen uint64
+			m.NewFee = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -13473,116 +22405,16 @@ func (m *EventCACAOPoolWithdrawV118) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NewFee |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CacaoAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypeEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Units.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypeEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TxId = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AffiliateBasisPts", wireType)
-			}
-			m.AffiliateBasisPts = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypeEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AffiliateBasisPts |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AffiliateAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplyHeight", wireType)
 			}
-			var stringLen uint64
+			m.ApplyHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -13592,29 +22424,14 @@ func (m *EventCACAOPoolWithdrawV118) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ApplyHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.AffiliateAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AffiliateAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13642,7 +22459,7 @@ func (m *EventCACAOPoolWithdrawV118) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AffiliateAddress = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
+			m.TxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13668,7 +22485,7 @@ func (m *EventCACAOPoolWithdrawV118) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCACAOPoolWithdraw) Unmarshal(dAtA []byte) error {
+func (m *EventBondProviderTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13691,15 +22508,15 @@ func (m *EventCACAOPoolWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCACAOPoolWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventBondProviderTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCACAOPoolWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventBondProviderTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeAddress", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -13726,16 +22543,16 @@ func (m *EventCACAOPoolWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CacaoAddress = append(m.CacaoAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.CacaoAddress == nil {
-				m.CacaoAddress = []byte{}
+			m.NodeAddress = append(m.NodeAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.NodeAddress == nil {
+				m.NodeAddress = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasisPoints", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
-			m.BasisPoints = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -13745,16 +22562,31 @@ func (m *EventCACAOPoolWithdraw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BasisPoints |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = append(m.FromAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.FromAddress == nil {
+				m.FromAddress = []byte{}
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -13764,31 +22596,31 @@ func (m *EventCACAOPoolWithdraw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CacaoAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ToAddress = append(m.ToAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ToAddress == nil {
+				m.ToAddress = []byte{}
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -13798,29 +22630,28 @@ func (m *EventCACAOPoolWithdraw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Units.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13848,30 +22679,13 @@ func (m *EventCACAOPoolWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxId = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
+			if err := m.Units.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AffiliateBasisPts", wireType)
-			}
-			m.AffiliateBasisPts = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypeEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AffiliateBasisPts |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AffiliateAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13899,9 +22713,7 @@ func (m *EventCACAOPoolWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AffiliateAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13927,7 +22739,7 @@ func (m *EventCACAOPoolWithdraw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventTradeAccountDeposit) Unmarshal(dAtA []byte) error {
+func (m *EventVaultMigrated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13950,15 +22762,15 @@ func (m *EventTradeAccountDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventTradeAccountDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventVaultMigrated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventTradeAccountDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventVaultMigrated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13986,15 +22798,13 @@ func (m *EventTradeAccountDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PubKey = gitlab_com_mayachain_mayanode_common.PubKey(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetiringHeight", wireType)
 			}
-			var msglen int
+			m.RetiringHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -14004,62 +22814,16 @@ func (m *EventTradeAccountDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RetiringHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypeEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AssetAddress = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Migrated", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -14069,55 +22833,25 @@ func (m *EventTradeAccountDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CacaoAddress = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypeEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypeEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Migrated = append(m.Migrated, common.Coin{})
+			if err := m.Migrated[len(m.Migrated)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.TxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14143,7 +22877,7 @@ func (m *EventTradeAccountDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventTradeAccountWithdraw) Unmarshal(dAtA []byte) error {
+func (m *EventSolvencyWarning) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14166,15 +22900,15 @@ func (m *EventTradeAccountWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventTradeAccountWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSolvencyWarning: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventTradeAccountWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSolvencyWarning: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14202,15 +22936,13 @@ func (m *EventTradeAccountWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PubKey = gitlab_com_mayachain_mayanode_common.PubKey(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -14220,30 +22952,29 @@ func (m *EventTradeAccountWithdraw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Chain = gitlab_com_mayachain_mayanode_common.Chain(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -14253,27 +22984,28 @@ func (m *EventTradeAccountWithdraw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypeEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetAddress = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacaoAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14301,11 +23033,13 @@ func (m *EventTradeAccountWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CacaoAddress = gitlab_com_mayachain_mayanode_common.Address(dAtA[iNdEx:postIndex])
+			if err := m.VaultAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14333,8 +23067,67 @@ func (m *EventTradeAccountWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
+			if err := m.WalletAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gap", wireType)
+			}
+			m.Gap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gap |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WarnGap", wireType)
+			}
+			m.WarnGap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WarnGap |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltGap", wireType)
+			}
+			m.HaltGap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HaltGap |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypeEvents(dAtA[iNdEx:])
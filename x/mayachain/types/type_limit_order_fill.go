@@ -0,0 +1,25 @@
+package types
+
+import (
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+)
+
+// NewLimitOrderFill create a new instance of LimitOrderFill
+func NewLimitOrderFill(hash common.TxID) LimitOrderFill {
+	return LimitOrderFill{
+		TxID: hash,
+		In:   cosmos.ZeroUint(),
+		Out:  cosmos.ZeroUint(),
+	}
+}
+
+// Remaining returns the part of the given deposit that hasn't been filled yet
+func (m *LimitOrderFill) Remaining(deposit cosmos.Uint) cosmos.Uint {
+	return common.SafeSub(deposit, m.In)
+}
+
+// IsDone returns true once the whole deposit has been filled
+func (m *LimitOrderFill) IsDone(deposit cosmos.Uint) bool {
+	return m.In.GTE(deposit)
+}
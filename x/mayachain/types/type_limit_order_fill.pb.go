@@ -0,0 +1,496 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: mayachain/v1/x/mayachain/types/type_limit_order_fill.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	gitlab_com_mayachain_mayanode_common "gitlab.com/mayachain/mayanode/common"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type LimitOrderFill struct {
+	TxID       gitlab_com_mayachain_mayanode_common.TxID `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3,casttype=gitlab.com/mayachain/mayanode/common.TxID" json:"tx_id,omitempty"`
+	In         github_com_cosmos_cosmos_sdk_types.Uint   `protobuf:"bytes,2,opt,name=in,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"in"`
+	Out        github_com_cosmos_cosmos_sdk_types.Uint   `protobuf:"bytes,3,opt,name=out,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"out"`
+	Count      uint64                                    `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	LastHeight int64                                     `protobuf:"varint,5,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty"`
+}
+
+func (m *LimitOrderFill) Reset()         { *m = LimitOrderFill{} }
+func (m *LimitOrderFill) String() string { return proto.CompactTextString(m) }
+func (*LimitOrderFill) ProtoMessage()    {}
+func (*LimitOrderFill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b62d60cabf5ba8, []int{0}
+}
+func (m *LimitOrderFill) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrderFill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrderFill.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitOrderFill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrderFill.Merge(m, src)
+}
+func (m *LimitOrderFill) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrderFill) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrderFill.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitOrderFill proto.InternalMessageInfo
+
+func (m *LimitOrderFill) GetTxID() gitlab_com_mayachain_mayanode_common.TxID {
+	if m != nil {
+		return m.TxID
+	}
+	return ""
+}
+
+func (m *LimitOrderFill) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *LimitOrderFill) GetLastHeight() int64 {
+	if m != nil {
+		return m.LastHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*LimitOrderFill)(nil), "types.LimitOrderFill")
+}
+
+func init() {
+	proto.RegisterFile("mayachain/v1/x/mayachain/types/type_limit_order_fill.proto", fileDescriptor_68b62d60cabf5ba8)
+}
+
+var fileDescriptor_68b62d60cabf5ba8 = []byte{
+	// 316 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x51, 0x31, 0x4b, 0xc3, 0x40,
+	0x18, 0xcd, 0xa5, 0x89, 0xe0, 0x09, 0x0e, 0xa1, 0x43, 0x70, 0x48, 0x8a, 0x8b, 0x75, 0x30, 0x87,
+	0x88, 0x8b, 0x8b, 0x58, 0x44, 0xac, 0x08, 0x42, 0xd0, 0xc5, 0x25, 0xa4, 0x49, 0x4c, 0x0e, 0x2f,
+	0xf7, 0x95, 0xe6, 0xab, 0xa4, 0xff, 0xc2, 0xcd, 0xbf, 0xd4, 0xb1, 0xa3, 0x38, 0x04, 0x49, 0xff,
+	0x85, 0x93, 0xdc, 0x45, 0x50, 0x10, 0x1c, 0x5c, 0xee, 0xbe, 0xf7, 0xee, 0xbd, 0xc7, 0xdd, 0x3b,
+	0x7a, 0x52, 0xc6, 0x8b, 0x38, 0x29, 0x62, 0x2e, 0xd9, 0xd3, 0x21, 0xab, 0xd9, 0x37, 0xc4, 0xc5,
+	0x34, 0xab, 0xf4, 0x1a, 0x09, 0x5e, 0x72, 0x8c, 0x60, 0x96, 0x66, 0xb3, 0xe8, 0x81, 0x0b, 0x11,
+	0x4c, 0x67, 0x80, 0xe0, 0xd8, 0x5a, 0xb2, 0xd3, 0xcf, 0x21, 0x07, 0xcd, 0x30, 0x35, 0x75, 0x87,
+	0xbb, 0x2f, 0x26, 0xdd, 0xbe, 0x56, 0xbe, 0x1b, 0x65, 0xbb, 0xe0, 0x42, 0x38, 0x57, 0xd4, 0xc6,
+	0x3a, 0xe2, 0xa9, 0x4b, 0x06, 0x64, 0xb8, 0x39, 0x3a, 0x6e, 0x1b, 0xdf, 0xba, 0xad, 0xc7, 0xe7,
+	0x1f, 0x8d, 0xbf, 0x9f, 0x73, 0x14, 0xf1, 0x24, 0x48, 0xa0, 0xfc, 0x71, 0x05, 0x35, 0x49, 0x48,
+	0x33, 0x96, 0x40, 0x59, 0x82, 0x0c, 0x94, 0x38, 0xb4, 0xb0, 0x1e, 0xa7, 0xce, 0x29, 0x35, 0xb9,
+	0x74, 0x4d, 0x1d, 0xc4, 0x96, 0x8d, 0x6f, 0xbc, 0x35, 0xfe, 0x5e, 0xce, 0xb1, 0x98, 0x77, 0x21,
+	0x09, 0x54, 0x25, 0x54, 0x5f, 0xdb, 0x41, 0x95, 0x3e, 0x76, 0xef, 0x09, 0xee, 0xb8, 0xc4, 0xd0,
+	0xe4, 0xd2, 0x39, 0xa3, 0x3d, 0x98, 0xa3, 0xdb, 0xfb, 0x5f, 0x82, 0xf2, 0x3a, 0x7d, 0x6a, 0x27,
+	0x30, 0x97, 0xe8, 0x5a, 0x03, 0x32, 0xb4, 0xc2, 0x0e, 0x38, 0x3e, 0xdd, 0x12, 0x71, 0x85, 0x51,
+	0x91, 0xf1, 0xbc, 0x40, 0xd7, 0x1e, 0x90, 0x61, 0x2f, 0xa4, 0x8a, 0xba, 0xd4, 0xcc, 0x68, 0xbc,
+	0x6c, 0x3d, 0xb2, 0x6a, 0x3d, 0xf2, 0xde, 0x7a, 0xe4, 0x79, 0xed, 0x19, 0xab, 0xb5, 0x67, 0xbc,
+	0xae, 0x3d, 0xe3, 0x9e, 0xfd, 0xdd, 0xc2, 0xaf, 0xdf, 0x99, 0x6c, 0xe8, 0xae, 0x8f, 0x3e, 0x03,
+	0x00, 0x00, 0xff, 0xff, 0xc7, 0x05, 0x01, 0xf0, 0xc6, 0x01, 0x00, 0x00,
+}
+
+func (m *LimitOrderFill) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrderFill) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrderFill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastHeight != 0 {
+		i = encodeVarintTypeLimitOrderFill(dAtA, i, uint64(m.LastHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Count != 0 {
+		i = encodeVarintTypeLimitOrderFill(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Out.Size()
+		i -= size
+		if _, err := m.Out.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeLimitOrderFill(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.In.Size()
+		i -= size
+		if _, err := m.In.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeLimitOrderFill(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintTypeLimitOrderFill(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypeLimitOrderFill(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypeLimitOrderFill(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LimitOrderFill) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTypeLimitOrderFill(uint64(l))
+	}
+	l = m.In.Size()
+	n += 1 + l + sovTypeLimitOrderFill(uint64(l))
+	l = m.Out.Size()
+	n += 1 + l + sovTypeLimitOrderFill(uint64(l))
+	if m.Count != 0 {
+		n += 1 + sovTypeLimitOrderFill(uint64(m.Count))
+	}
+	if m.LastHeight != 0 {
+		n += 1 + sovTypeLimitOrderFill(uint64(m.LastHeight))
+	}
+	return n
+}
+
+func sovTypeLimitOrderFill(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypeLimitOrderFill(x uint64) (n int) {
+	return sovTypeLimitOrderFill(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LimitOrderFill) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeLimitOrderFill
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitOrderFill: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitOrderFill: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeLimitOrderFill
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeLimitOrderFill
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeLimitOrderFill
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxID = gitlab_com_mayachain_mayanode_common.TxID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field In", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeLimitOrderFill
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeLimitOrderFill
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeLimitOrderFill
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.In.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Out", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeLimitOrderFill
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeLimitOrderFill
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeLimitOrderFill
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Out.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeLimitOrderFill
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			}
+			m.LastHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeLimitOrderFill
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeLimitOrderFill(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeLimitOrderFill
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeLimitOrderFill
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTypeLimitOrderFill(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTypeLimitOrderFill
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypeLimitOrderFill
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypeLimitOrderFill
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTypeLimitOrderFill
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTypeLimitOrderFill
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTypeLimitOrderFill
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTypeLimitOrderFill        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTypeLimitOrderFill          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTypeLimitOrderFill = fmt.Errorf("proto: unexpected end of group")
+)
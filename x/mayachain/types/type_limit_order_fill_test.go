@@ -0,0 +1,27 @@
+package types
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+)
+
+type LimitOrderFillSuite struct{}
+
+var _ = Suite(&LimitOrderFillSuite{})
+
+func (s *LimitOrderFillSuite) TestRemaining(c *C) {
+	fill := NewLimitOrderFill(common.BlankTxID)
+	deposit := cosmos.NewUint(100)
+	c.Check(fill.Remaining(deposit).String(), Equals, "100")
+	c.Check(fill.IsDone(deposit), Equals, false)
+
+	fill.In = cosmos.NewUint(40)
+	c.Check(fill.Remaining(deposit).String(), Equals, "60")
+	c.Check(fill.IsDone(deposit), Equals, false)
+
+	fill.In = cosmos.NewUint(100)
+	c.Check(fill.Remaining(deposit).String(), Equals, "0")
+	c.Check(fill.IsDone(deposit), Equals, true)
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+)
+
+// NewLoan create a new instance of Loan, backed by the trade asset collateral
+// of the owner
+func NewLoan(owner cosmos.AccAddress, collateralAsset, debtAsset common.Asset) Loan {
+	return Loan{
+		Owner:               owner,
+		CollateralAsset:     collateralAsset,
+		CollateralUnits:     cosmos.ZeroUint(),
+		CollateralDeposited: cosmos.ZeroUint(),
+		CollateralWithdrawn: cosmos.ZeroUint(),
+		DebtAsset:           debtAsset,
+		DebtIssued:          cosmos.ZeroUint(),
+		DebtRepaid:          cosmos.ZeroUint(),
+	}
+}
+
+// Key return the key of the loan in the kv store, an owner has at most one loan
+// per collateral asset
+func (l Loan) Key() string {
+	return fmt.Sprintf("%s/%s", l.Owner.String(), l.CollateralAsset.String())
+}
+
+// Valid check whether the loan has all the necessary values
+func (l Loan) Valid() error {
+	if l.Owner.Empty() {
+		return fmt.Errorf("owner cannot be empty")
+	}
+	if !l.CollateralAsset.IsTradeAsset() {
+		return fmt.Errorf("collateral asset must be a trade asset")
+	}
+	if l.DebtAsset.IsEmpty() {
+		return fmt.Errorf("debt asset cannot be empty")
+	}
+	return nil
+}
+
+// IsOpen returns true while the loan has outstanding debt or locked collateral
+func (l Loan) IsOpen() bool {
+	return !l.DebtOutstanding().IsZero() || !l.CollateralUnits.IsZero()
+}
+
+// DebtOutstanding returns the debt which has not been repaid yet
+func (l Loan) DebtOutstanding() cosmos.Uint {
+	return common.SafeSub(l.DebtIssued, l.DebtRepaid)
+}
@@ -0,0 +1,740 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: mayachain/v1/x/mayachain/types/type_loan.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "gitlab.com/mayachain/mayanode/common"
+	gitlab_com_mayachain_mayanode_common "gitlab.com/mayachain/mayanode/common"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type Loan struct {
+	Owner               github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	CollateralAsset     gitlab_com_mayachain_mayanode_common.Asset    `protobuf:"bytes,2,opt,name=collateral_asset,json=collateralAsset,proto3,customtype=gitlab.com/mayachain/mayanode/common.Asset" json:"collateral_asset"`
+	CollateralUnits     github_com_cosmos_cosmos_sdk_types.Uint       `protobuf:"bytes,3,opt,name=collateral_units,json=collateralUnits,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"collateral_units"`
+	CollateralDeposited github_com_cosmos_cosmos_sdk_types.Uint       `protobuf:"bytes,4,opt,name=collateral_deposited,json=collateralDeposited,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"collateral_deposited"`
+	CollateralWithdrawn github_com_cosmos_cosmos_sdk_types.Uint       `protobuf:"bytes,5,opt,name=collateral_withdrawn,json=collateralWithdrawn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"collateral_withdrawn"`
+	DebtAsset           gitlab_com_mayachain_mayanode_common.Asset    `protobuf:"bytes,6,opt,name=debt_asset,json=debtAsset,proto3,customtype=gitlab.com/mayachain/mayanode/common.Asset" json:"debt_asset"`
+	DebtIssued          github_com_cosmos_cosmos_sdk_types.Uint       `protobuf:"bytes,7,opt,name=debt_issued,json=debtIssued,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"debt_issued"`
+	DebtRepaid          github_com_cosmos_cosmos_sdk_types.Uint       `protobuf:"bytes,8,opt,name=debt_repaid,json=debtRepaid,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"debt_repaid"`
+	LastOpenHeight      int64                                         `protobuf:"varint,9,opt,name=last_open_height,json=lastOpenHeight,proto3" json:"last_open_height,omitempty"`
+	LastRepayHeight     int64                                         `protobuf:"varint,10,opt,name=last_repay_height,json=lastRepayHeight,proto3" json:"last_repay_height,omitempty"`
+}
+
+func (m *Loan) Reset()         { *m = Loan{} }
+func (m *Loan) String() string { return proto.CompactTextString(m) }
+func (*Loan) ProtoMessage()    {}
+func (*Loan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f160e21f3b4e5bb, []int{0}
+}
+func (m *Loan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Loan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Loan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Loan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Loan.Merge(m, src)
+}
+func (m *Loan) XXX_Size() int {
+	return m.Size()
+}
+func (m *Loan) XXX_DiscardUnknown() {
+	xxx_messageInfo_Loan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Loan proto.InternalMessageInfo
+
+func (m *Loan) GetOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *Loan) GetLastOpenHeight() int64 {
+	if m != nil {
+		return m.LastOpenHeight
+	}
+	return 0
+}
+
+func (m *Loan) GetLastRepayHeight() int64 {
+	if m != nil {
+		return m.LastRepayHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Loan)(nil), "types.Loan")
+}
+
+func init() {
+	proto.RegisterFile("mayachain/v1/x/mayachain/types/type_loan.proto", fileDescriptor_5f160e21f3b4e5bb)
+}
+
+var fileDescriptor_5f160e21f3b4e5bb = []byte{
+	// 446 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x6b, 0xb6, 0x16, 0xea, 0x01, 0x1b, 0x66, 0x07, 0x6b, 0x87, 0x34, 0xe2, 0x42, 0x34,
+	0x69, 0xb1, 0x36, 0x9e, 0xa0, 0x15, 0x12, 0x4c, 0x42, 0x02, 0x55, 0x9a, 0x90, 0x76, 0x29, 0x6e,
+	0x6c, 0x25, 0x16, 0x89, 0xbf, 0x28, 0x76, 0x29, 0x7d, 0x0b, 0x5e, 0x88, 0xfb, 0x8e, 0x3b, 0x22,
+	0x0e, 0x15, 0x6a, 0xdf, 0x82, 0x13, 0xb2, 0x93, 0xd2, 0x15, 0x24, 0x84, 0xd6, 0x5d, 0x62, 0xe7,
+	0x9f, 0xff, 0xf7, 0xfb, 0x7f, 0xce, 0x27, 0xe3, 0xb8, 0xe0, 0x33, 0x9e, 0x64, 0x5c, 0x69, 0xf6,
+	0xe9, 0x94, 0x7d, 0x66, 0xeb, 0x57, 0x3b, 0x2b, 0xa5, 0xf1, 0xcf, 0x51, 0x0e, 0x5c, 0xc7, 0x65,
+	0x05, 0x16, 0x48, 0xdb, 0xcb, 0x47, 0xe1, 0x46, 0x59, 0x02, 0x45, 0x01, 0xba, 0x59, 0x6a, 0xe3,
+	0xd1, 0x61, 0x0a, 0x29, 0xf8, 0x2d, 0x73, 0xbb, 0x5a, 0x7d, 0xf6, 0xb5, 0x83, 0x77, 0xdf, 0x00,
+	0xd7, 0xe4, 0x15, 0x6e, 0xc3, 0x54, 0xcb, 0x8a, 0xa2, 0x10, 0x45, 0x0f, 0x07, 0xa7, 0x3f, 0xe7,
+	0xbd, 0x93, 0x54, 0xd9, 0x6c, 0x32, 0x8e, 0x13, 0x28, 0x58, 0x02, 0xa6, 0x00, 0xd3, 0x2c, 0x27,
+	0x46, 0x7c, 0xac, 0xdb, 0x89, 0xfb, 0x49, 0xd2, 0x17, 0xa2, 0x92, 0xc6, 0x0c, 0xeb, 0x7a, 0x92,
+	0xe3, 0x83, 0x04, 0xf2, 0x9c, 0x5b, 0x59, 0xf1, 0x7c, 0xc4, 0x8d, 0x91, 0x96, 0xde, 0x0b, 0x51,
+	0xb4, 0x77, 0xf6, 0x28, 0x6e, 0x1a, 0xea, 0x3b, 0x71, 0x70, 0x76, 0x35, 0xef, 0xb5, 0xbe, 0xcf,
+	0x7b, 0xc7, 0xa9, 0xb2, 0x39, 0xaf, 0x63, 0xd6, 0xa7, 0x70, 0x3b, 0x0d, 0x42, 0xb2, 0x9b, 0x35,
+	0xc3, 0xfd, 0x35, 0xda, 0x0b, 0xe4, 0x72, 0x23, 0x6d, 0xa2, 0x95, 0x35, 0x74, 0x27, 0x44, 0x51,
+	0x77, 0xc0, 0x1a, 0xfc, 0xf3, 0xff, 0x38, 0xc5, 0x85, 0xd2, 0x1b, 0xec, 0x0b, 0xc7, 0x21, 0x63,
+	0x7c, 0x78, 0x83, 0x2d, 0x64, 0x09, 0x46, 0x59, 0x29, 0xe8, 0xee, 0xed, 0xf8, 0x4f, 0xd7, 0xb0,
+	0x97, 0x2b, 0xd6, 0x1f, 0x19, 0x53, 0x65, 0x33, 0x51, 0xf1, 0xa9, 0xa6, 0xed, 0xad, 0x33, 0xde,
+	0xaf, 0x58, 0xe4, 0x03, 0xc6, 0x42, 0x8e, 0x6d, 0x33, 0x8b, 0xce, 0x5d, 0xcd, 0xa2, 0xeb, 0xa0,
+	0xf5, 0x14, 0xde, 0xe1, 0x3d, 0x9f, 0xa0, 0x8c, 0x99, 0x48, 0x41, 0xef, 0xdf, 0xae, 0x79, 0xdf,
+	0xe5, 0xb9, 0x47, 0xfc, 0x26, 0x56, 0xb2, 0xe4, 0x4a, 0xd0, 0x07, 0x5b, 0x10, 0x87, 0x1e, 0x41,
+	0x22, 0x7c, 0x90, 0x73, 0x63, 0x47, 0x50, 0x4a, 0x3d, 0xca, 0xa4, 0x4a, 0x33, 0x4b, 0xbb, 0x21,
+	0x8a, 0x76, 0x86, 0x8f, 0x9d, 0xfe, 0xb6, 0x94, 0xfa, 0xb5, 0x57, 0xc9, 0x31, 0x7e, 0xe2, 0x9d,
+	0x2e, 0x7b, 0xb6, 0xb2, 0x62, 0x6f, 0xdd, 0x77, 0x1f, 0x1c, 0x70, 0x56, 0x7b, 0x07, 0xe7, 0x57,
+	0x8b, 0x00, 0x5d, 0x2f, 0x02, 0xf4, 0x63, 0x11, 0xa0, 0x2f, 0xcb, 0xa0, 0x75, 0xbd, 0x0c, 0x5a,
+	0xdf, 0x96, 0x41, 0xeb, 0x92, 0xfd, 0xfb, 0x57, 0xfe, 0x75, 0xb3, 0xc7, 0x1d, 0x7f, 0x23, 0x5f,
+	0xfc, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xe6, 0xa4, 0x2b, 0x0f, 0x02, 0x04, 0x00, 0x00,
+}
+
+func (m *Loan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Loan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Loan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastRepayHeight != 0 {
+		i = encodeVarintTypeLoan(dAtA, i, uint64(m.LastRepayHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.LastOpenHeight != 0 {
+		i = encodeVarintTypeLoan(dAtA, i, uint64(m.LastOpenHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.DebtRepaid.Size()
+		i -= size
+		if _, err := m.DebtRepaid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeLoan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.DebtIssued.Size()
+		i -= size
+		if _, err := m.DebtIssued.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeLoan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.DebtAsset.Size()
+		i -= size
+		if _, err := m.DebtAsset.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeLoan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.CollateralWithdrawn.Size()
+		i -= size
+		if _, err := m.CollateralWithdrawn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeLoan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.CollateralDeposited.Size()
+		i -= size
+		if _, err := m.CollateralDeposited.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeLoan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CollateralUnits.Size()
+		i -= size
+		if _, err := m.CollateralUnits.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeLoan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CollateralAsset.Size()
+		i -= size
+		if _, err := m.CollateralAsset.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeLoan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypeLoan(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypeLoan(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypeLoan(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Loan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypeLoan(uint64(l))
+	}
+	l = m.CollateralAsset.Size()
+	n += 1 + l + sovTypeLoan(uint64(l))
+	l = m.CollateralUnits.Size()
+	n += 1 + l + sovTypeLoan(uint64(l))
+	l = m.CollateralDeposited.Size()
+	n += 1 + l + sovTypeLoan(uint64(l))
+	l = m.CollateralWithdrawn.Size()
+	n += 1 + l + sovTypeLoan(uint64(l))
+	l = m.DebtAsset.Size()
+	n += 1 + l + sovTypeLoan(uint64(l))
+	l = m.DebtIssued.Size()
+	n += 1 + l + sovTypeLoan(uint64(l))
+	l = m.DebtRepaid.Size()
+	n += 1 + l + sovTypeLoan(uint64(l))
+	if m.LastOpenHeight != 0 {
+		n += 1 + sovTypeLoan(uint64(m.LastOpenHeight))
+	}
+	if m.LastRepayHeight != 0 {
+		n += 1 + sovTypeLoan(uint64(m.LastRepayHeight))
+	}
+	return n
+}
+
+func sovTypeLoan(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypeLoan(x uint64) (n int) {
+	return sovTypeLoan(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Loan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeLoan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Loan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Loan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypeLoan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeLoan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollateralAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeLoan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollateralUnits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDeposited", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeLoan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollateralDeposited.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralWithdrawn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeLoan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollateralWithdrawn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeLoan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DebtAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtIssued", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeLoan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DebtIssued.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtRepaid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeLoan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DebtRepaid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOpenHeight", wireType)
+			}
+			m.LastOpenHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastOpenHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRepayHeight", wireType)
+			}
+			m.LastRepayHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRepayHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeLoan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeLoan
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeLoan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTypeLoan(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTypeLoan
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypeLoan
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypeLoan
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTypeLoan
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTypeLoan
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTypeLoan
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTypeLoan        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTypeLoan          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTypeLoan = fmt.Errorf("proto: unexpected end of group")
+)
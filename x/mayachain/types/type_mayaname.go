@@ -154,3 +154,27 @@ func (m *MAYAName) CanReceiveAffiliateFee() bool {
 	}
 	return true
 }
+
+// NewMAYANameListing create a new instance of MAYANameListing
+func NewMAYANameListing(name string, seller ctypes.AccAddress, price cosmos.Uint, expireHeight int64, keepAliases, keepSubaffiliates bool, height int64) MAYANameListing {
+	return MAYANameListing{
+		Name:              name,
+		Seller:            seller,
+		Price:             price,
+		ExpireHeight:      expireHeight,
+		KeepAliases:       keepAliases,
+		KeepSubaffiliates: keepSubaffiliates,
+		Height:            height,
+	}
+}
+
+// Key return the key of the listing in the kv store, a MAYAName has at most
+// one open listing
+func (m *MAYANameListing) Key() string {
+	return b64.StdEncoding.EncodeToString([]byte(strings.ToLower(m.Name)))
+}
+
+// IsExpired returns true when the listing can no longer be bought at the given height
+func (m *MAYANameListing) IsExpired(height int64) bool {
+	return height > m.ExpireHeight
+}
@@ -208,10 +208,96 @@ func (m *MAYAName) GetSubaffiliates() []MAYANameSubaffiliate {
 	return nil
 }
 
+type MAYANameListing struct {
+	Name              string                                        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Seller            github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=seller,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"seller,omitempty"`
+	Price             github_com_cosmos_cosmos_sdk_types.Uint       `protobuf:"bytes,3,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"price"`
+	ExpireHeight      int64                                         `protobuf:"varint,4,opt,name=expire_height,json=expireHeight,proto3" json:"expire_height,omitempty"`
+	KeepAliases       bool                                          `protobuf:"varint,5,opt,name=keep_aliases,json=keepAliases,proto3" json:"keep_aliases,omitempty"`
+	KeepSubaffiliates bool                                          `protobuf:"varint,6,opt,name=keep_subaffiliates,json=keepSubaffiliates,proto3" json:"keep_subaffiliates,omitempty"`
+	Height            int64                                         `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *MAYANameListing) Reset()         { *m = MAYANameListing{} }
+func (m *MAYANameListing) String() string { return proto.CompactTextString(m) }
+func (*MAYANameListing) ProtoMessage()    {}
+func (*MAYANameListing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bf0ab87482b98a0, []int{3}
+}
+func (m *MAYANameListing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MAYANameListing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MAYANameListing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MAYANameListing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MAYANameListing.Merge(m, src)
+}
+func (m *MAYANameListing) XXX_Size() int {
+	return m.Size()
+}
+func (m *MAYANameListing) XXX_DiscardUnknown() {
+	xxx_messageInfo_MAYANameListing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MAYANameListing proto.InternalMessageInfo
+
+func (m *MAYANameListing) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MAYANameListing) GetSeller() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Seller
+	}
+	return nil
+}
+
+func (m *MAYANameListing) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *MAYANameListing) GetKeepAliases() bool {
+	if m != nil {
+		return m.KeepAliases
+	}
+	return false
+}
+
+func (m *MAYANameListing) GetKeepSubaffiliates() bool {
+	if m != nil {
+		return m.KeepSubaffiliates
+	}
+	return false
+}
+
+func (m *MAYANameListing) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MAYANameAlias)(nil), "types.MAYANameAlias")
 	proto.RegisterType((*MAYANameSubaffiliate)(nil), "types.MAYANameSubaffiliate")
 	proto.RegisterType((*MAYAName)(nil), "types.MAYAName")
+	proto.RegisterType((*MAYANameListing)(nil), "types.MAYANameListing")
 }
 
 func init() {
@@ -219,37 +305,43 @@ func init() {
 }
 
 var fileDescriptor_1bf0ab87482b98a0 = []byte{
-	// 477 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xc1, 0x8e, 0xd3, 0x30,
-	0x10, 0xad, 0x49, 0xbb, 0x05, 0xef, 0x16, 0x84, 0xe9, 0x21, 0x5a, 0xa4, 0x24, 0xea, 0x85, 0x0a,
-	0xb1, 0x09, 0x5b, 0x38, 0x72, 0x49, 0x40, 0x5a, 0x40, 0x82, 0x43, 0x80, 0x03, 0x5c, 0x22, 0x27,
-	0x71, 0x53, 0x6b, 0x9b, 0x38, 0x8a, 0xb3, 0xb0, 0xfb, 0x17, 0xfc, 0x02, 0x57, 0xbe, 0x64, 0x8f,
-	0x7b, 0x44, 0x1c, 0x22, 0xd4, 0xfe, 0x45, 0x4f, 0xc8, 0x76, 0x1d, 0x5a, 0x40, 0xa8, 0x5c, 0xe2,
-	0xc9, 0xcc, 0xbc, 0x79, 0x2f, 0x6f, 0x62, 0x38, 0xc9, 0xf1, 0x05, 0x4e, 0x66, 0x98, 0x16, 0xde,
-	0xc7, 0x63, 0xef, 0xdc, 0xfb, 0xf5, 0x5a, 0x5f, 0x94, 0x84, 0xcb, 0x67, 0x24, 0x92, 0x05, 0xce,
-	0x89, 0x5b, 0x56, 0xac, 0x66, 0xa8, 0x27, 0x4b, 0x87, 0xce, 0x16, 0x34, 0x61, 0x79, 0xce, 0x8a,
-	0xf5, 0xa1, 0x1a, 0x0f, 0x87, 0x19, 0xcb, 0x98, 0x0c, 0x3d, 0x11, 0xa9, 0xec, 0xe8, 0x0b, 0x80,
-	0x83, 0x57, 0xfe, 0x7b, 0xff, 0x35, 0xce, 0x89, 0x3f, 0xa7, 0x98, 0xa3, 0x67, 0xb0, 0x27, 0xe7,
-	0x98, 0xc0, 0x01, 0xe3, 0x1b, 0x81, 0xbb, 0x6a, 0xec, 0xfb, 0x19, 0xad, 0xe7, 0x38, 0x76, 0x13,
-	0x96, 0x6f, 0x68, 0x92, 0x42, 0x58, 0x4a, 0x34, 0xcd, 0x53, 0x91, 0x0d, 0x15, 0x18, 0xbd, 0x84,
-	0x7d, 0x9c, 0xa6, 0x15, 0xe1, 0xdc, 0xbc, 0x26, 0xe7, 0x3c, 0x5c, 0x35, 0xf6, 0x83, 0x9d, 0xe6,
-	0xf8, 0x0a, 0x17, 0xea, 0x01, 0xa3, 0x1c, 0x0e, 0xb5, 0xc4, 0x37, 0x67, 0x31, 0x9e, 0x4e, 0xe9,
-	0x9c, 0xe2, 0x9a, 0x20, 0x04, 0xbb, 0xc2, 0x08, 0x25, 0x34, 0x94, 0x31, 0xf2, 0xa1, 0x11, 0x97,
-	0x9a, 0xd3, 0xbb, 0x6c, 0xec, 0xce, 0xf7, 0xc6, 0xbe, 0x97, 0xd1, 0x7a, 0x76, 0xa6, 0x78, 0x13,
-	0xc6, 0x73, 0xc6, 0xd7, 0xc7, 0x11, 0x4f, 0x4f, 0x95, 0xb7, 0xee, 0x3b, 0x5a, 0xd4, 0xa1, 0xc0,
-	0x8e, 0xbe, 0x1a, 0xf0, 0xba, 0xe6, 0xfb, 0x2b, 0x87, 0x0b, 0xef, 0x90, 0xf3, 0x92, 0x56, 0x24,
-	0x8a, 0xe7, 0x2c, 0x39, 0x8d, 0x66, 0x84, 0x66, 0xb3, 0x5a, 0x72, 0x1a, 0xe1, 0x6d, 0x55, 0x0a,
-	0x44, 0xe5, 0xb9, 0x2c, 0xa0, 0x13, 0xd8, 0x63, 0x9f, 0x0a, 0x52, 0x99, 0x86, 0x03, 0xc6, 0x07,
-	0xc1, 0xf1, 0xaa, 0xb1, 0x8f, 0x76, 0x50, 0xe4, 0x27, 0x89, 0xb6, 0x42, 0xe1, 0xd1, 0x13, 0x78,
-	0xab, 0xac, 0xc8, 0x94, 0x54, 0x15, 0x49, 0x23, 0xcc, 0x39, 0xa9, 0xcd, 0xae, 0x03, 0xc6, 0xfb,
-	0x93, 0x81, 0xab, 0xbd, 0x13, 0xc9, 0xa0, 0x2b, 0xbe, 0x3b, 0xbc, 0xd9, 0xf6, 0xca, 0x2c, 0x7a,
-	0x0c, 0xfb, 0x58, 0x6c, 0x98, 0x70, 0xb3, 0xe7, 0x18, 0xe3, 0xfd, 0xc9, 0xd0, 0x55, 0x44, 0x5b,
-	0xfb, 0x5f, 0x83, 0x75, 0x2b, 0x7a, 0x0b, 0x07, 0xad, 0xe3, 0x91, 0xb0, 0x76, 0xaf, 0xb5, 0x16,
-	0xfc, 0x8f, 0xb5, 0x07, 0xed, 0x94, 0xa0, 0xe4, 0xe8, 0x04, 0x0e, 0xf8, 0xc6, 0x2a, 0xb9, 0xd9,
-	0x97, 0x8a, 0xee, 0xfe, 0xa6, 0x68, 0x73, 0xdd, 0x6b, 0x61, 0xdb, 0xb8, 0xe0, 0xc5, 0xe5, 0xc2,
-	0x02, 0x57, 0x0b, 0x0b, 0xfc, 0x58, 0x58, 0xe0, 0xf3, 0xd2, 0xea, 0x5c, 0x2d, 0xad, 0xce, 0xb7,
-	0xa5, 0xd5, 0xf9, 0xe0, 0xfd, 0xfb, 0x67, 0xfb, 0xe3, 0x76, 0xc5, 0x7b, 0xf2, 0x46, 0x3c, 0xfa,
-	0x19, 0x00, 0x00, 0xff, 0xff, 0xe7, 0x2c, 0x8a, 0x1e, 0x86, 0x03, 0x00, 0x00,
+	// 576 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x8d, 0x9b, 0x57, 0x99, 0x24, 0x54, 0x1d, 0x22, 0x64, 0x15, 0xc9, 0x09, 0x61, 0x41, 0x84,
+	0x88, 0x4d, 0x03, 0x4b, 0x36, 0x36, 0xa0, 0x52, 0x04, 0x2c, 0x0c, 0x2c, 0x60, 0x63, 0x4d, 0x9c,
+	0x89, 0x33, 0x8a, 0xed, 0xb1, 0x3c, 0x2e, 0xb4, 0x7f, 0xc1, 0x2f, 0xb0, 0xe5, 0x43, 0x50, 0x97,
+	0x5d, 0x22, 0x16, 0x16, 0x4a, 0xfe, 0x22, 0x2b, 0x34, 0x0f, 0xa7, 0x09, 0x54, 0xa8, 0xed, 0x26,
+	0x1e, 0xdf, 0xd7, 0x39, 0x39, 0xe7, 0x7a, 0xc0, 0x30, 0x42, 0x27, 0xc8, 0x9f, 0x22, 0x12, 0x5b,
+	0x9f, 0xf7, 0xad, 0x63, 0xeb, 0xfc, 0x35, 0x3b, 0x49, 0x30, 0x13, 0xbf, 0x1e, 0x0f, 0xc6, 0x28,
+	0xc2, 0x66, 0x92, 0xd2, 0x8c, 0xc2, 0xaa, 0x48, 0xed, 0x75, 0x37, 0x5a, 0x7d, 0x1a, 0x45, 0x34,
+	0x56, 0x0f, 0x59, 0xb8, 0xd7, 0x0e, 0x68, 0x40, 0xc5, 0xd1, 0xe2, 0x27, 0x19, 0xed, 0x7d, 0xd3,
+	0x40, 0xeb, 0x8d, 0xfd, 0xd1, 0x7e, 0x8b, 0x22, 0x6c, 0x87, 0x04, 0x31, 0xf8, 0x1c, 0x54, 0xc5,
+	0x1c, 0x5d, 0xeb, 0x6a, 0xfd, 0x1b, 0x8e, 0xb9, 0xcc, 0x3b, 0x0f, 0x02, 0x92, 0x85, 0x68, 0x64,
+	0xfa, 0x34, 0x5a, 0xe3, 0x24, 0x88, 0xd0, 0x31, 0x2e, 0x60, 0x9e, 0xf1, 0xa8, 0x2b, 0x9b, 0xe1,
+	0x2b, 0x50, 0x47, 0xe3, 0x71, 0x8a, 0x19, 0xd3, 0xb7, 0xc4, 0x9c, 0x47, 0xcb, 0xbc, 0xf3, 0xf0,
+	0x52, 0x73, 0x6c, 0xd9, 0xe7, 0x16, 0x03, 0x7a, 0x11, 0x68, 0x17, 0x14, 0xdf, 0x1d, 0x8d, 0xd0,
+	0x64, 0x42, 0x42, 0x82, 0x32, 0x0c, 0x21, 0xa8, 0x70, 0x21, 0x24, 0x51, 0x57, 0x9c, 0xa1, 0x0d,
+	0xca, 0xa3, 0xa4, 0xc0, 0xb4, 0x4e, 0xf3, 0x4e, 0xe9, 0x57, 0xde, 0xb9, 0x1f, 0x90, 0x6c, 0x7a,
+	0x24, 0x71, 0x7d, 0xca, 0x22, 0xca, 0xd4, 0x63, 0xc0, 0xc6, 0x33, 0xa9, 0xad, 0xf9, 0x81, 0xc4,
+	0x99, 0xcb, 0x7b, 0x7b, 0xdf, 0xcb, 0x60, 0xbb, 0xc0, 0xbb, 0x10, 0xc3, 0x04, 0xb7, 0xf0, 0x71,
+	0x42, 0x52, 0xec, 0x8d, 0x42, 0xea, 0xcf, 0xbc, 0x29, 0x26, 0xc1, 0x34, 0x13, 0x98, 0x65, 0x77,
+	0x57, 0xa6, 0x1c, 0x9e, 0x79, 0x29, 0x12, 0xf0, 0x00, 0x54, 0xe9, 0x97, 0x18, 0xa7, 0x7a, 0xb9,
+	0xab, 0xf5, 0x9b, 0xce, 0xfe, 0x32, 0xef, 0x0c, 0x2e, 0xc1, 0xc8, 0xf6, 0xfd, 0x42, 0x0a, 0xd9,
+	0x0f, 0x9f, 0x82, 0x9d, 0x24, 0xc5, 0x13, 0x9c, 0xa6, 0x78, 0xec, 0x21, 0xc6, 0x70, 0xa6, 0x57,
+	0xba, 0x5a, 0xbf, 0x31, 0x6c, 0x99, 0x85, 0x76, 0x3c, 0xe8, 0x54, 0xf8, 0xff, 0x76, 0x6f, 0xae,
+	0x6a, 0x45, 0x14, 0x3e, 0x01, 0x75, 0xc4, 0x1d, 0xc6, 0x4c, 0xaf, 0x76, 0xcb, 0xfd, 0xc6, 0xb0,
+	0x6d, 0x4a, 0xa0, 0x0d, 0xff, 0x55, 0x73, 0x51, 0x0a, 0xdf, 0x83, 0xd6, 0x4a, 0x71, 0x8f, 0x4b,
+	0x5b, 0x5b, 0x49, 0xab, 0x5d, 0x45, 0xda, 0xe6, 0x6a, 0x8a, 0x93, 0x30, 0x78, 0x00, 0x5a, 0x6c,
+	0xcd, 0x4a, 0xa6, 0xd7, 0x05, 0xa3, 0x3b, 0x7f, 0x31, 0x5a, 0xb7, 0x5b, 0x11, 0xdb, 0xec, 0xeb,
+	0xfd, 0xd8, 0x02, 0x3b, 0x45, 0xf5, 0x6b, 0xc2, 0x32, 0x12, 0x07, 0x17, 0x7a, 0x76, 0x08, 0x6a,
+	0x0c, 0x87, 0x21, 0x4e, 0x85, 0x4d, 0xd7, 0x32, 0x41, 0x0d, 0x80, 0x2f, 0x40, 0x35, 0x49, 0x89,
+	0x8f, 0x85, 0x9d, 0xd7, 0x58, 0x32, 0xd9, 0x0d, 0xef, 0x81, 0x96, 0xda, 0x22, 0xb5, 0x3f, 0x15,
+	0xb1, 0x3f, 0x4d, 0x19, 0x54, 0xab, 0x73, 0x17, 0x34, 0x67, 0x18, 0x27, 0xde, 0xb9, 0x71, 0x5a,
+	0x7f, 0xdb, 0x6d, 0xf0, 0x98, 0xad, 0x0c, 0x1a, 0x00, 0x28, 0x4a, 0x36, 0xf5, 0xac, 0x89, 0xc2,
+	0x5d, 0x9e, 0x59, 0x17, 0x91, 0xc1, 0xdb, 0xa0, 0xa6, 0xf0, 0xea, 0x02, 0x4f, 0xbd, 0x39, 0x87,
+	0xa7, 0x73, 0x43, 0x3b, 0x9b, 0x1b, 0xda, 0xef, 0xb9, 0xa1, 0x7d, 0x5d, 0x18, 0xa5, 0xb3, 0x85,
+	0x51, 0xfa, 0xb9, 0x30, 0x4a, 0x9f, 0xac, 0xff, 0x7f, 0xb5, 0xff, 0x5c, 0x53, 0xa3, 0x9a, 0xb8,
+	0x5a, 0x1e, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0xab, 0x44, 0xc1, 0x2d, 0xcf, 0x04, 0x00, 0x00,
 }
 
 func (m *MAYANameAlias) Marshal() (dAtA []byte, err error) {
@@ -421,6 +513,83 @@ func (m *MAYAName) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MAYANameListing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MAYANameListing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MAYANameListing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTypeMayaname(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.KeepSubaffiliates {
+		i--
+		if m.KeepSubaffiliates {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.KeepAliases {
+		i--
+		if m.KeepAliases {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExpireHeight != 0 {
+		i = encodeVarintTypeMayaname(dAtA, i, uint64(m.ExpireHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeMayaname(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintTypeMayaname(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypeMayaname(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypeMayaname(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypeMayaname(v)
 	base := offset
@@ -502,6 +671,37 @@ func (m *MAYAName) Size() (n int) {
 	return n
 }
 
+func (m *MAYANameListing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypeMayaname(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovTypeMayaname(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTypeMayaname(uint64(l))
+	if m.ExpireHeight != 0 {
+		n += 1 + sovTypeMayaname(uint64(m.ExpireHeight))
+	}
+	if m.KeepAliases {
+		n += 2
+	}
+	if m.KeepSubaffiliates {
+		n += 2
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypeMayaname(uint64(m.Height))
+	}
+	return n
+}
+
 func sovTypeMayaname(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1019,6 +1219,237 @@ func (m *MAYAName) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MAYANameListing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeMayaname
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MAYANameListing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MAYANameListing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeMayaname
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeMayaname
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeMayaname
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeMayaname
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypeMayaname
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeMayaname
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = append(m.Seller[:0], dAtA[iNdEx:postIndex]...)
+			if m.Seller == nil {
+				m.Seller = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeMayaname
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeMayaname
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeMayaname
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireHeight", wireType)
+			}
+			m.ExpireHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeMayaname
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepAliases", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeMayaname
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.KeepAliases = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepSubaffiliates", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeMayaname
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.KeepSubaffiliates = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeMayaname
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeMayaname(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeMayaname
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeMayaname
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypeMayaname(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
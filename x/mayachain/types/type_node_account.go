@@ -340,6 +340,24 @@ func (bp *BondProviders) Remove(acc cosmos.AccAddress) bool {
 	return false
 }
 
+// HasPendingOperatorFee returns true when an operator fee change is scheduled
+func (bp *BondProviders) HasPendingOperatorFee() bool {
+	return bp.PendingNodeOperatorFeeHeight > 0
+}
+
+// ApplyPendingOperatorFee sets the scheduled operator fee once the given
+// height has reached the height it was scheduled for, returns true if the fee
+// has been changed
+func (bp *BondProviders) ApplyPendingOperatorFee(height int64) bool {
+	if !bp.HasPendingOperatorFee() || height < bp.PendingNodeOperatorFeeHeight {
+		return false
+	}
+	bp.NodeOperatorFee = cosmos.NewUint(uint64(bp.PendingNodeOperatorFee))
+	bp.PendingNodeOperatorFee = 0
+	bp.PendingNodeOperatorFeeHeight = 0
+	return true
+}
+
 // HasProviderBonded: Checks if a bond provider (not the operator) has bonded to the node
 func (bp *BondProviders) HasProviderBonded(opBondAddress cosmos.AccAddress) bool {
 	for i := range bp.Providers {
@@ -350,3 +368,13 @@ func (bp *BondProviders) HasProviderBonded(opBondAddress cosmos.AccAddress) bool
 
 	return false
 }
+
+// NewNodeSlashEntry create a new instance of NodeSlashEntry, the net slash
+// points a node received at the given height for the given reason
+func NewNodeSlashEntry(addr cosmos.AccAddress, height int64, reason string) NodeSlashEntry {
+	return NodeSlashEntry{
+		NodeAddress: addr,
+		Height:      height,
+		Reason:      reason,
+	}
+}
@@ -184,9 +184,11 @@ func (m *BondProvider) XXX_DiscardUnknown() {
 var xxx_messageInfo_BondProvider proto.InternalMessageInfo
 
 type BondProviders struct {
-	NodeAddress     github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=node_address,json=nodeAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"node_address,omitempty"`
-	NodeOperatorFee github_com_cosmos_cosmos_sdk_types.Uint       `protobuf:"bytes,2,opt,name=node_operator_fee,json=nodeOperatorFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"node_operator_fee"`
-	Providers       []BondProvider                                `protobuf:"bytes,3,rep,name=providers,proto3" json:"providers"`
+	NodeAddress                  github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=node_address,json=nodeAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"node_address,omitempty"`
+	NodeOperatorFee              github_com_cosmos_cosmos_sdk_types.Uint       `protobuf:"bytes,2,opt,name=node_operator_fee,json=nodeOperatorFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"node_operator_fee"`
+	Providers                    []BondProvider                                `protobuf:"bytes,3,rep,name=providers,proto3" json:"providers"`
+	PendingNodeOperatorFee       int64                                         `protobuf:"varint,4,opt,name=pending_node_operator_fee,json=pendingNodeOperatorFee,proto3" json:"pending_node_operator_fee,omitempty"`
+	PendingNodeOperatorFeeHeight int64                                         `protobuf:"varint,5,opt,name=pending_node_operator_fee_height,json=pendingNodeOperatorFeeHeight,proto3" json:"pending_node_operator_fee_height,omitempty"`
 }
 
 func (m *BondProviders) Reset()      { *m = BondProviders{} }
@@ -221,12 +223,52 @@ func (m *BondProviders) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BondProviders proto.InternalMessageInfo
 
+type NodeSlashEntry struct {
+	NodeAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=node_address,json=nodeAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"node_address,omitempty"`
+	Height      int64                                         `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Reason      string                                        `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Points      int64                                         `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
+}
+
+func (m *NodeSlashEntry) Reset()      { *m = NodeSlashEntry{} }
+func (*NodeSlashEntry) ProtoMessage() {}
+func (*NodeSlashEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14be3c2d10886738, []int{3}
+}
+func (m *NodeSlashEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeSlashEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeSlashEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NodeSlashEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeSlashEntry.Merge(m, src)
+}
+func (m *NodeSlashEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeSlashEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeSlashEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeSlashEntry proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("types.NodeStatus", NodeStatus_name, NodeStatus_value)
 	proto.RegisterEnum("types.NodeType", NodeType_name, NodeType_value)
 	proto.RegisterType((*NodeAccount)(nil), "types.NodeAccount")
 	proto.RegisterType((*BondProvider)(nil), "types.BondProvider")
 	proto.RegisterType((*BondProviders)(nil), "types.BondProviders")
+	proto.RegisterType((*NodeSlashEntry)(nil), "types.NodeSlashEntry")
 }
 
 func init() {
@@ -234,62 +276,67 @@ func init() {
 }
 
 var fileDescriptor_14be3c2d10886738 = []byte{
-	// 865 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0xf7, 0x24, 0x69, 0x1a, 0x3f, 0x27, 0x1b, 0x67, 0x8a, 0x90, 0xd5, 0x83, 0x63, 0x8a, 0x04,
-	0x66, 0xe9, 0x26, 0xb4, 0x2b, 0xb1, 0x12, 0xb7, 0xa6, 0x08, 0x58, 0xf1, 0xaf, 0x72, 0x5a, 0x90,
-	0xe0, 0x60, 0xf9, 0xcf, 0x6c, 0x62, 0x35, 0xf1, 0x18, 0xcf, 0x24, 0x4b, 0x38, 0x2d, 0xdf, 0x80,
-	0xcf, 0x80, 0x38, 0xf0, 0x51, 0x7a, 0x63, 0x8f, 0x2b, 0x84, 0x22, 0x36, 0xfd, 0x16, 0x7b, 0x42,
-	0x33, 0x63, 0xbb, 0x59, 0x21, 0x21, 0xb6, 0xd2, 0x5e, 0x3c, 0x33, 0xef, 0xf7, 0xe6, 0xcd, 0xef,
-	0xfd, 0x35, 0x7c, 0x38, 0x0f, 0x56, 0x41, 0x34, 0x0d, 0x92, 0x74, 0xb8, 0x3c, 0x1a, 0xfe, 0x38,
-	0xbc, 0x39, 0xf2, 0x55, 0x46, 0x98, 0xfc, 0xfa, 0x29, 0x8d, 0x89, 0x1f, 0x44, 0x11, 0x5d, 0xa4,
-	0x7c, 0x90, 0xe5, 0x94, 0x53, 0xbc, 0x23, 0xe1, 0x7d, 0xe7, 0xa5, 0xeb, 0x11, 0x9d, 0xcf, 0x69,
-	0x5a, 0x2c, 0x4a, 0x71, 0xff, 0x8d, 0x09, 0x9d, 0x50, 0xb9, 0x1d, 0x8a, 0x9d, 0x92, 0x1e, 0xfc,
-	0xba, 0x0b, 0xc6, 0x57, 0x34, 0x26, 0x27, 0xca, 0x28, 0x3e, 0x87, 0xb6, 0x7a, 0x24, 0x8e, 0x73,
-	0xc2, 0x98, 0x85, 0x1c, 0xe4, 0xb6, 0x47, 0x47, 0x2f, 0xd6, 0xfd, 0x7b, 0x93, 0x84, 0x4f, 0x17,
-	0xe1, 0x20, 0xa2, 0xf3, 0x61, 0x44, 0xd9, 0x9c, 0xb2, 0x62, 0xb9, 0xc7, 0xe2, 0x4b, 0x45, 0x72,
-	0x70, 0x12, 0x45, 0x27, 0xea, 0xa2, 0x67, 0x08, 0x33, 0xc5, 0x01, 0xbf, 0x07, 0x4d, 0xc6, 0x03,
-	0xbe, 0x60, 0x56, 0xcd, 0x41, 0xee, 0x9d, 0xe3, 0xde, 0x40, 0xe9, 0x8b, 0x97, 0xc7, 0x12, 0xf0,
-	0x0a, 0x05, 0xfc, 0x00, 0x8c, 0x6c, 0x11, 0xfa, 0x97, 0x64, 0xe5, 0x33, 0xc2, 0xad, 0xba, 0x83,
-	0x5c, 0xe3, 0xb8, 0x37, 0x28, 0x5c, 0x39, 0x5b, 0x84, 0x9f, 0x93, 0xd5, 0x98, 0xf0, 0x51, 0xe3,
-	0x6a, 0xdd, 0xd7, 0x3c, 0x3d, 0x2b, 0x05, 0xf8, 0x02, 0x3a, 0xc1, 0x4f, 0x9c, 0x44, 0x15, 0xf5,
-	0x9e, 0x83, 0x5c, 0x7d, 0xf4, 0xc1, 0x8b, 0x75, 0xff, 0x70, 0x92, 0xf0, 0x59, 0xa0, 0xa8, 0xdf,
-	0xc4, 0x49, 0xec, 0x04, 0xc9, 0x32, 0x4c, 0x25, 0xf3, 0xb6, 0x34, 0x53, 0x52, 0xbf, 0x0f, 0x6f,
-	0x2e, 0x83, 0x59, 0x12, 0x07, 0x9c, 0xe6, 0x7e, 0x44, 0x53, 0xe6, 0x17, 0xf4, 0xac, 0x86, 0xb0,
-	0xef, 0xed, 0x55, 0xe8, 0x29, 0x4d, 0x99, 0xe2, 0x87, 0x4f, 0xa1, 0x11, 0xd2, 0x34, 0xb6, 0x76,
-	0x24, 0x85, 0xa1, 0xa0, 0xfa, 0xe7, 0xba, 0xff, 0xee, 0xff, 0x88, 0xe0, 0x45, 0x92, 0x72, 0x4f,
-	0x5e, 0xc6, 0x03, 0xd8, 0x0b, 0x22, 0x9e, 0x2c, 0x89, 0x1f, 0xce, 0x68, 0x74, 0xe9, 0x4f, 0x49,
-	0x32, 0x99, 0x72, 0xab, 0xe9, 0x20, 0xb7, 0xee, 0xf5, 0x14, 0x34, 0x12, 0xc8, 0x67, 0x12, 0xc0,
-	0x63, 0x68, 0x8b, 0x7b, 0x95, 0xff, 0xbb, 0xb7, 0xf4, 0xdf, 0x10, 0x56, 0x4a, 0xf7, 0xdf, 0x82,
-	0xb6, 0x4a, 0x8c, 0xcf, 0x92, 0x34, 0x22, 0x56, 0x4b, 0xbe, 0x6e, 0x28, 0xd9, 0x58, 0x88, 0xf0,
-	0xfb, 0xd0, 0x63, 0xc9, 0x24, 0x25, 0xb9, 0x3f, 0x27, 0xf3, 0x90, 0xe4, 0x6c, 0x9a, 0x64, 0x96,
-	0xee, 0xd4, 0x5d, 0xdd, 0x33, 0x15, 0xf0, 0x65, 0x25, 0xc7, 0x87, 0x80, 0x73, 0xf2, 0xc3, 0x82,
-	0x30, 0x4e, 0x62, 0x9f, 0x53, 0x7f, 0x46, 0x82, 0x25, 0xb1, 0xc0, 0x41, 0x6e, 0xcb, 0x33, 0x2b,
-	0xe4, 0x9c, 0x7e, 0x21, 0xe4, 0xf8, 0x1d, 0xe8, 0x3e, 0xa2, 0x79, 0xb4, 0xad, 0x6a, 0x48, 0xd5,
-	0x8e, 0x12, 0x97, 0x7a, 0x7d, 0x30, 0x24, 0xea, 0xb3, 0x88, 0xe6, 0xc4, 0x6a, 0x3b, 0xc8, 0x6d,
-	0x78, 0x20, 0x45, 0x63, 0x21, 0xc1, 0x87, 0x00, 0x49, 0x56, 0x45, 0xa6, 0x23, 0x23, 0xd3, 0xd9,
-	0xac, 0xfb, 0xfa, 0xc3, 0xb3, 0xd2, 0x6d, 0x3d, 0xc9, 0x4a, 0xa7, 0x2d, 0xd8, 0x5d, 0x92, 0x9c,
-	0x25, 0x34, 0xb5, 0xee, 0xc8, 0x24, 0x97, 0x47, 0xfc, 0x36, 0x34, 0x44, 0x9e, 0xac, 0xae, 0x2c,
-	0xe3, 0xee, 0x56, 0x19, 0x9f, 0xaf, 0x32, 0xe2, 0x49, 0x10, 0x3f, 0x84, 0x66, 0x4e, 0x1e, 0x07,
-	0x79, 0x6c, 0x99, 0xf2, 0xa1, 0xa3, 0x57, 0xcc, 0xbf, 0x85, 0xbc, 0xc2, 0xc0, 0x47, 0x8d, 0x27,
-	0x7f, 0x39, 0xda, 0xc1, 0x1f, 0x08, 0xda, 0x23, 0x9a, 0xc6, 0x67, 0x39, 0x5d, 0x26, 0x31, 0xc9,
-	0x45, 0x97, 0xbe, 0x94, 0xea, 0xdb, 0x77, 0xe9, 0x76, 0xae, 0xf7, 0xa1, 0x29, 0x8e, 0x24, 0x96,
-	0x5d, 0xd7, 0x1a, 0xd5, 0x04, 0x11, 0x25, 0xc1, 0x9f, 0x56, 0x3e, 0x35, 0xaa, 0x9a, 0x46, 0xaf,
-	0x52, 0xd3, 0xdb, 0x1e, 0xa1, 0x83, 0x9f, 0x6b, 0xd0, 0xd9, 0xf6, 0x88, 0xbd, 0xa6, 0xc1, 0xf3,
-	0x3d, 0xf4, 0xa4, 0x55, 0x9a, 0x91, 0x5c, 0x76, 0xf0, 0x23, 0x42, 0xe4, 0x0c, 0xba, 0x45, 0x57,
-	0x76, 0x85, 0xa5, 0xaf, 0x0b, 0x43, 0x9f, 0x10, 0x82, 0x1f, 0x80, 0x9e, 0x95, 0xfc, 0xad, 0xba,
-	0x53, 0x77, 0x8d, 0xe3, 0xbd, 0xa2, 0x22, 0xb6, 0x7d, 0xab, 0x46, 0x55, 0xa9, 0xab, 0x62, 0x70,
-	0x37, 0x04, 0xb8, 0x99, 0x7f, 0xd8, 0x80, 0xdd, 0x8b, 0xf4, 0x32, 0xa5, 0x8f, 0x53, 0x53, 0xc3,
-	0x5d, 0x30, 0xbe, 0x9d, 0x26, 0x9c, 0xcc, 0x12, 0xd1, 0x0d, 0x26, 0x12, 0xe8, 0x98, 0x07, 0x69,
-	0x1c, 0xae, 0xcc, 0x1a, 0xd6, 0x61, 0xc7, 0x23, 0x41, 0xbc, 0x32, 0xeb, 0x18, 0xa0, 0x79, 0x22,
-	0x07, 0x81, 0xd9, 0xc0, 0x6d, 0x68, 0x7d, 0x9c, 0xb0, 0x20, 0x9c, 0x91, 0xd8, 0xdc, 0xd9, 0x6f,
-	0xfc, 0xfe, 0x9b, 0x8d, 0xee, 0x9e, 0x42, 0xab, 0x2c, 0x4e, 0xdc, 0x83, 0x8e, 0x58, 0xbf, 0x29,
-	0xe7, 0x95, 0xa9, 0xe1, 0x0e, 0xe8, 0x4a, 0xb4, 0x98, 0x71, 0x13, 0x89, 0x67, 0xc5, 0xb1, 0xe4,
-	0x51, 0x53, 0x46, 0x46, 0x17, 0x57, 0xcf, 0x6d, 0xed, 0xd9, 0x73, 0x5b, 0x7b, 0xb2, 0xb1, 0xb5,
-	0xab, 0x8d, 0x8d, 0x9e, 0x6e, 0x6c, 0xf4, 0xf7, 0xc6, 0x46, 0xbf, 0x5c, 0xdb, 0xda, 0xd3, 0x6b,
-	0x5b, 0x7b, 0x76, 0x6d, 0x6b, 0xdf, 0x0d, 0xff, 0x7b, 0xc8, 0xfc, 0xeb, 0x8f, 0x16, 0x36, 0xe5,
-	0x1f, 0xe8, 0xfe, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x56, 0xcf, 0x57, 0x59, 0xfa, 0x06, 0x00,
-	0x00,
+	// 955 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0xb6, 0x1b, 0x27, 0x8d, 0x8f, 0x93, 0xc6, 0x99, 0xa2, 0xca, 0x54, 0xc8, 0x31, 0x45, 0x82,
+	0xb0, 0x74, 0x13, 0xda, 0x95, 0x58, 0xc1, 0x5d, 0x53, 0x58, 0x58, 0x01, 0x4b, 0xe5, 0xb4, 0x20,
+	0xc1, 0x85, 0xe5, 0x9f, 0xd9, 0xc4, 0x6a, 0x32, 0x63, 0x3c, 0x93, 0x2c, 0xe1, 0x6a, 0x2f, 0x78,
+	0x00, 0x9e, 0x01, 0x71, 0xc1, 0x03, 0xf0, 0x10, 0xbd, 0x63, 0x2f, 0x57, 0x08, 0x45, 0x6c, 0xfa,
+	0x16, 0x7b, 0x85, 0x66, 0xfc, 0xd3, 0x2c, 0x0b, 0x88, 0xad, 0xb4, 0x37, 0xb1, 0xcf, 0x77, 0xce,
+	0x7c, 0xf3, 0x9d, 0x39, 0x73, 0x8e, 0x03, 0xef, 0x4d, 0xfd, 0x85, 0x1f, 0x8e, 0xfd, 0x98, 0xf4,
+	0xe7, 0x07, 0xfd, 0xef, 0xfa, 0x57, 0x26, 0x5f, 0x24, 0x98, 0xc9, 0x5f, 0x8f, 0xd0, 0x08, 0x7b,
+	0x7e, 0x18, 0xd2, 0x19, 0xe1, 0xbd, 0x24, 0xa5, 0x9c, 0xa2, 0xaa, 0x74, 0xef, 0x3a, 0xcf, 0x2c,
+	0x0f, 0xe9, 0x74, 0x4a, 0x49, 0xfe, 0xc8, 0x02, 0x77, 0x5f, 0x19, 0xd1, 0x11, 0x95, 0xaf, 0x7d,
+	0xf1, 0x96, 0xa1, 0x7b, 0x3f, 0x6d, 0x82, 0x71, 0x8f, 0x46, 0xf8, 0x28, 0x23, 0x45, 0xa7, 0xd0,
+	0xc8, 0x36, 0x89, 0xa2, 0x14, 0x33, 0x66, 0xa9, 0x8e, 0xda, 0x6d, 0x0c, 0x0e, 0x9e, 0x2e, 0x3b,
+	0x37, 0x47, 0x31, 0x1f, 0xcf, 0x82, 0x5e, 0x48, 0xa7, 0xfd, 0x90, 0xb2, 0x29, 0x65, 0xf9, 0xe3,
+	0x26, 0x8b, 0xce, 0x33, 0x91, 0xbd, 0xa3, 0x30, 0x3c, 0xca, 0x16, 0xba, 0x86, 0xa0, 0xc9, 0x0d,
+	0xf4, 0x36, 0xd4, 0x18, 0xf7, 0xf9, 0x8c, 0x59, 0x1b, 0x8e, 0xda, 0xdd, 0x3a, 0x6c, 0xf7, 0xb2,
+	0x78, 0xb1, 0xf3, 0x50, 0x3a, 0xdc, 0x3c, 0x00, 0xdd, 0x06, 0x23, 0x99, 0x05, 0xde, 0x39, 0x5e,
+	0x78, 0x0c, 0x73, 0xab, 0xe2, 0xa8, 0x5d, 0xe3, 0xb0, 0xdd, 0xcb, 0x53, 0x39, 0x99, 0x05, 0x9f,
+	0xe2, 0xc5, 0x10, 0xf3, 0x81, 0x76, 0xb1, 0xec, 0x28, 0xae, 0x9e, 0x14, 0x00, 0x3a, 0x83, 0xa6,
+	0xff, 0x3d, 0xc7, 0x61, 0x29, 0xbd, 0xed, 0xa8, 0x5d, 0x7d, 0xf0, 0xee, 0xd3, 0x65, 0x67, 0x7f,
+	0x14, 0xf3, 0x89, 0x9f, 0x49, 0xbf, 0x3a, 0x27, 0xf1, 0x26, 0x44, 0x16, 0xc7, 0x54, 0x28, 0x6f,
+	0x48, 0x9a, 0x42, 0xfa, 0x2d, 0xd8, 0x99, 0xfb, 0x93, 0x38, 0xf2, 0x39, 0x4d, 0xbd, 0x90, 0x12,
+	0xe6, 0xe5, 0xf2, 0x2c, 0x4d, 0xf0, 0xbb, 0xdb, 0xa5, 0xf7, 0x98, 0x12, 0x96, 0xe9, 0x43, 0xc7,
+	0xa0, 0x05, 0x94, 0x44, 0x56, 0x55, 0x4a, 0xe8, 0x0b, 0xa9, 0xbf, 0x2f, 0x3b, 0x6f, 0xfd, 0x8f,
+	0x13, 0x3c, 0x8b, 0x09, 0x77, 0xe5, 0x62, 0xd4, 0x83, 0x6d, 0x3f, 0xe4, 0xf1, 0x1c, 0x7b, 0xc1,
+	0x84, 0x86, 0xe7, 0xde, 0x18, 0xc7, 0xa3, 0x31, 0xb7, 0x6a, 0x8e, 0xda, 0xad, 0xb8, 0xed, 0xcc,
+	0x35, 0x10, 0x9e, 0x4f, 0xa4, 0x03, 0x0d, 0xa1, 0x21, 0xd6, 0x95, 0xf9, 0x6f, 0x5e, 0x33, 0x7f,
+	0x43, 0xb0, 0x14, 0xe9, 0xbf, 0x0e, 0x8d, 0xac, 0x30, 0x1e, 0x8b, 0x49, 0x88, 0xad, 0xba, 0xdc,
+	0xdd, 0xc8, 0xb0, 0xa1, 0x80, 0xd0, 0x3b, 0xd0, 0x66, 0xf1, 0x88, 0xe0, 0xd4, 0x9b, 0xe2, 0x69,
+	0x80, 0x53, 0x36, 0x8e, 0x13, 0x4b, 0x77, 0x2a, 0x5d, 0xdd, 0x35, 0x33, 0xc7, 0xe7, 0x25, 0x8e,
+	0xf6, 0x01, 0xa5, 0xf8, 0xdb, 0x19, 0x66, 0x1c, 0x47, 0x1e, 0xa7, 0xde, 0x04, 0xfb, 0x73, 0x6c,
+	0x81, 0xa3, 0x76, 0xeb, 0xae, 0x59, 0x7a, 0x4e, 0xe9, 0x67, 0x02, 0x47, 0x6f, 0x42, 0xeb, 0x3e,
+	0x4d, 0xc3, 0xf5, 0x50, 0x43, 0x86, 0x36, 0x33, 0xb8, 0x88, 0xeb, 0x80, 0x21, 0xbd, 0x1e, 0x0b,
+	0x69, 0x8a, 0xad, 0x86, 0xa3, 0x76, 0x35, 0x17, 0x24, 0x34, 0x14, 0x08, 0xda, 0x07, 0x88, 0x93,
+	0xf2, 0x64, 0x9a, 0xf2, 0x64, 0x9a, 0xab, 0x65, 0x47, 0xbf, 0x7b, 0x52, 0xa4, 0xad, 0xc7, 0x49,
+	0x91, 0xb4, 0x05, 0x9b, 0x73, 0x9c, 0xb2, 0x98, 0x12, 0x6b, 0x4b, 0x16, 0xb9, 0x30, 0xd1, 0x1b,
+	0xa0, 0x89, 0x3a, 0x59, 0x2d, 0x79, 0x8d, 0x5b, 0x6b, 0xd7, 0xf8, 0x74, 0x91, 0x60, 0x57, 0x3a,
+	0xd1, 0x5d, 0xa8, 0xa5, 0xf8, 0x81, 0x9f, 0x46, 0x96, 0x29, 0x37, 0x3a, 0x78, 0xc1, 0xfa, 0x5b,
+	0xaa, 0x9b, 0x13, 0x7c, 0xa0, 0x3d, 0xfc, 0xc3, 0x51, 0xf6, 0x7e, 0x53, 0xa1, 0x31, 0xa0, 0x24,
+	0x3a, 0x49, 0xe9, 0x3c, 0x8e, 0x70, 0x2a, 0xba, 0xf4, 0x99, 0x52, 0x5f, 0xbf, 0x4b, 0xd7, 0x6b,
+	0xbd, 0x0b, 0x35, 0x61, 0xe2, 0x48, 0x76, 0x5d, 0x7d, 0xb0, 0x21, 0x84, 0x64, 0x08, 0xfa, 0xb8,
+	0xcc, 0x49, 0x2b, 0xef, 0xb4, 0xfa, 0x22, 0x77, 0x7a, 0x3d, 0x23, 0x75, 0xef, 0x87, 0x0a, 0x34,
+	0xd7, 0x33, 0x62, 0x2f, 0x69, 0xf0, 0x7c, 0x03, 0x6d, 0xc9, 0x4a, 0x13, 0x9c, 0xca, 0x0e, 0xbe,
+	0x8f, 0xb1, 0x9c, 0x41, 0xd7, 0xe8, 0xca, 0x96, 0x60, 0xfa, 0x22, 0x27, 0xba, 0x83, 0x31, 0xba,
+	0x0d, 0x7a, 0x52, 0xe8, 0xb7, 0x2a, 0x4e, 0xa5, 0x6b, 0x1c, 0x6e, 0xe7, 0x37, 0x62, 0x3d, 0xb7,
+	0x72, 0x54, 0x95, 0xb9, 0xbe, 0x0f, 0xaf, 0x26, 0x98, 0x44, 0x31, 0x19, 0x79, 0xcf, 0xab, 0xd3,
+	0x64, 0x87, 0xed, 0xe4, 0x01, 0xf7, 0xfe, 0xb6, 0xe7, 0x1d, 0x70, 0xfe, 0x75, 0x69, 0x31, 0x21,
+	0xaa, 0x92, 0xe1, 0xb5, 0x7f, 0x66, 0xc8, 0x86, 0x45, 0x5e, 0x86, 0x5f, 0x55, 0xd8, 0x92, 0x33,
+	0x78, 0xe2, 0xb3, 0xf1, 0x47, 0x84, 0xa7, 0x8b, 0x97, 0x54, 0x87, 0x1d, 0xa8, 0xe5, 0xe2, 0x36,
+	0xa4, 0xb8, 0xdc, 0x12, 0x78, 0x8a, 0x7d, 0x46, 0x89, 0xbc, 0x72, 0xba, 0x9b, 0x5b, 0x02, 0x4f,
+	0x68, 0x4c, 0x38, 0xcb, 0x8f, 0x23, 0xb7, 0x32, 0xd9, 0x37, 0x02, 0x80, 0xab, 0x2f, 0x07, 0x32,
+	0x60, 0xf3, 0x8c, 0x9c, 0x13, 0xfa, 0x80, 0x98, 0x0a, 0x6a, 0x81, 0xf1, 0xd5, 0x38, 0xe6, 0x78,
+	0x12, 0x8b, 0x39, 0x62, 0xaa, 0xc2, 0x3b, 0xe4, 0x3e, 0x89, 0x82, 0x85, 0xb9, 0x81, 0x74, 0xa8,
+	0xba, 0xd8, 0x8f, 0x16, 0x66, 0x05, 0x01, 0xd4, 0x8e, 0xe4, 0x08, 0x35, 0x35, 0xd4, 0x80, 0xfa,
+	0x87, 0x31, 0xf3, 0x83, 0x09, 0x8e, 0xcc, 0xea, 0xae, 0xf6, 0xcb, 0xcf, 0xb6, 0x7a, 0xe3, 0x18,
+	0xea, 0x45, 0x5b, 0xa3, 0x36, 0x34, 0xc5, 0xf3, 0xcb, 0x62, 0xd2, 0x9b, 0x0a, 0x6a, 0x82, 0x9e,
+	0x41, 0xb3, 0x09, 0x37, 0x55, 0xb1, 0xad, 0x30, 0x0b, 0x1d, 0x1b, 0x19, 0xc9, 0xe0, 0xec, 0xe2,
+	0x89, 0xad, 0x3c, 0x7e, 0x62, 0x2b, 0x0f, 0x57, 0xb6, 0x72, 0xb1, 0xb2, 0xd5, 0x47, 0x2b, 0x5b,
+	0xfd, 0x73, 0x65, 0xab, 0x3f, 0x5e, 0xda, 0xca, 0xa3, 0x4b, 0x5b, 0x79, 0x7c, 0x69, 0x2b, 0x5f,
+	0xf7, 0xff, 0x7b, 0x3c, 0x3f, 0xf7, 0x5f, 0x20, 0xa8, 0xc9, 0x6f, 0xf7, 0xad, 0xbf, 0x02, 0x00,
+	0x00, 0xff, 0xff, 0xb2, 0xf7, 0x73, 0x62, 0x34, 0x08, 0x00, 0x00,
 }
 
 func (m *NodeAccount) Marshal() (dAtA []byte, err error) {
@@ -517,6 +564,16 @@ func (m *BondProviders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PendingNodeOperatorFeeHeight != 0 {
+		i = encodeVarintTypeNodeAccount(dAtA, i, uint64(m.PendingNodeOperatorFeeHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PendingNodeOperatorFee != 0 {
+		i = encodeVarintTypeNodeAccount(dAtA, i, uint64(m.PendingNodeOperatorFee))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Providers) > 0 {
 		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -551,6 +608,53 @@ func (m *BondProviders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NodeSlashEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeSlashEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeSlashEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Points != 0 {
+		i = encodeVarintTypeNodeAccount(dAtA, i, uint64(m.Points))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTypeNodeAccount(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypeNodeAccount(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.NodeAddress) > 0 {
+		i -= len(m.NodeAddress)
+		copy(dAtA[i:], m.NodeAddress)
+		i = encodeVarintTypeNodeAccount(dAtA, i, uint64(len(m.NodeAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypeNodeAccount(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypeNodeAccount(v)
 	base := offset
@@ -666,6 +770,35 @@ func (m *BondProviders) Size() (n int) {
 			n += 1 + l + sovTypeNodeAccount(uint64(l))
 		}
 	}
+	if m.PendingNodeOperatorFee != 0 {
+		n += 1 + sovTypeNodeAccount(uint64(m.PendingNodeOperatorFee))
+	}
+	if m.PendingNodeOperatorFeeHeight != 0 {
+		n += 1 + sovTypeNodeAccount(uint64(m.PendingNodeOperatorFeeHeight))
+	}
+	return n
+}
+
+func (m *NodeSlashEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeNodeAccount(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypeNodeAccount(uint64(m.Height))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTypeNodeAccount(uint64(l))
+	}
+	if m.Points != 0 {
+		n += 1 + sovTypeNodeAccount(uint64(m.Points))
+	}
 	return n
 }
 
@@ -700,6 +833,21 @@ func (this *BondProviders) String() string {
 		`NodeAddress:` + fmt.Sprintf("%v", this.NodeAddress) + `,`,
 		`NodeOperatorFee:` + fmt.Sprintf("%v", this.NodeOperatorFee) + `,`,
 		`Providers:` + repeatedStringForProviders + `,`,
+		`PendingNodeOperatorFee:` + fmt.Sprintf("%v", this.PendingNodeOperatorFee) + `,`,
+		`PendingNodeOperatorFeeHeight:` + fmt.Sprintf("%v", this.PendingNodeOperatorFeeHeight) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NodeSlashEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NodeSlashEntry{`,
+		`NodeAddress:` + fmt.Sprintf("%v", this.NodeAddress) + `,`,
+		`Height:` + fmt.Sprintf("%v", this.Height) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Points:` + fmt.Sprintf("%v", this.Points) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1501,6 +1649,201 @@ func (m *BondProviders) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingNodeOperatorFee", wireType)
+			}
+			m.PendingNodeOperatorFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeNodeAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingNodeOperatorFee |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingNodeOperatorFeeHeight", wireType)
+			}
+			m.PendingNodeOperatorFeeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeNodeAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingNodeOperatorFeeHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeNodeAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeNodeAccount
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeNodeAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeSlashEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeNodeAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeSlashEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeSlashEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeNodeAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypeNodeAccount
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeNodeAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeAddress = append(m.NodeAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.NodeAddress == nil {
+				m.NodeAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeNodeAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeNodeAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeNodeAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeNodeAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			m.Points = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeNodeAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Points |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypeNodeAccount(dAtA[iNdEx:])
@@ -169,3 +169,28 @@ func (s *NodeAccountSuite) TestBondProviders(c *C) {
 	bp.Remove(acc3) // unsuccessful remove, due to bond still being there
 	c.Assert(bp.Has(acc3), Equals, false)
 }
+
+func (s *NodeAccountSuite) TestBondProvidersPendingOperatorFee(c *C) {
+	bp := NewBondProviders(GetRandomBech32Addr())
+	bp.NodeOperatorFee = cosmos.NewUint(2000)
+	c.Assert(bp.HasPendingOperatorFee(), Equals, false)
+	c.Assert(bp.ApplyPendingOperatorFee(100), Equals, false)
+
+	bp.PendingNodeOperatorFee = 3000
+	bp.PendingNodeOperatorFeeHeight = 200
+	c.Assert(bp.HasPendingOperatorFee(), Equals, true)
+
+	// too early, nothing changes
+	c.Assert(bp.ApplyPendingOperatorFee(199), Equals, false)
+	c.Assert(bp.NodeOperatorFee.Uint64(), Equals, uint64(2000))
+
+	c.Assert(bp.ApplyPendingOperatorFee(200), Equals, true)
+	c.Assert(bp.NodeOperatorFee.Uint64(), Equals, uint64(3000))
+	c.Assert(bp.HasPendingOperatorFee(), Equals, false)
+
+	// a pending fee of zero is still applied
+	bp.PendingNodeOperatorFee = 0
+	bp.PendingNodeOperatorFeeHeight = 300
+	c.Assert(bp.ApplyPendingOperatorFee(301), Equals, true)
+	c.Assert(bp.NodeOperatorFee.IsZero(), Equals, true)
+}
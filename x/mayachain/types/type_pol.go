@@ -1,6 +1,7 @@
 package types
 
 import (
+	"gitlab.com/mayachain/mayanode/common"
 	cosmos "gitlab.com/mayachain/mayanode/common/cosmos"
 )
 
@@ -25,3 +26,56 @@ func (pol ProtocolOwnedLiquidity) PnL(value cosmos.Uint) cosmos.Int {
 	v := cosmos.NewIntFromBigInt(value.BigInt())
 	return withdrawn.Sub(deposited).Add(v)
 }
+
+// NewPOLPool create a new instance of POLPool, the ledger of the POL liquidity
+// in the given pool
+func NewPOLPool(asset common.Asset) POLPool {
+	return POLPool{
+		Asset:               asset,
+		Units:               cosmos.ZeroUint(),
+		CacaoDeposited:      cosmos.ZeroUint(),
+		CacaoWithdrawn:      cosmos.ZeroUint(),
+		EntryValue:          cosmos.ZeroUint(),
+		WithdrawnEntryValue: cosmos.ZeroUint(),
+	}
+}
+
+// Key return a string which can be used to identify the POL pool ledger
+func (p POLPool) Key() string {
+	return p.Asset.String()
+}
+
+// Deposit records the given amount of cacao added to the pool, the value at
+// entry of the POL position increases by the cacao deposited
+func (p *POLPool) Deposit(cacaoAmt, units cosmos.Uint, height int64) {
+	p.CacaoDeposited = p.CacaoDeposited.Add(cacaoAmt)
+	p.EntryValue = p.EntryValue.Add(cacaoAmt)
+	p.Units = p.Units.Add(units)
+	p.LastMovementHeight = height
+}
+
+// Withdraw records the given amount of cacao withdrawn from the pool, the
+// value at entry of the withdrawn units is realized pro rata
+func (p *POLPool) Withdraw(cacaoAmt, units cosmos.Uint, height int64) {
+	entryValue := common.GetSafeShare(units, p.Units, p.EntryValue)
+	p.CacaoWithdrawn = p.CacaoWithdrawn.Add(cacaoAmt)
+	p.EntryValue = common.SafeSub(p.EntryValue, entryValue)
+	p.WithdrawnEntryValue = p.WithdrawnEntryValue.Add(entryValue)
+	p.Units = common.SafeSub(p.Units, units)
+	p.LastMovementHeight = height
+}
+
+// RealizedPnL - Profit and Loss of the POL liquidity already withdrawn
+func (p POLPool) RealizedPnL() cosmos.Int {
+	withdrawn := cosmos.NewIntFromBigInt(p.CacaoWithdrawn.BigInt())
+	entry := cosmos.NewIntFromBigInt(p.WithdrawnEntryValue.BigInt())
+	return withdrawn.Sub(entry)
+}
+
+// UnrealizedPnL - Profit and Loss of the POL liquidity still in the pool, given
+// its current value
+func (p POLPool) UnrealizedPnL(value cosmos.Uint) cosmos.Int {
+	v := cosmos.NewIntFromBigInt(value.BigInt())
+	entry := cosmos.NewIntFromBigInt(p.EntryValue.BigInt())
+	return v.Sub(entry)
+}
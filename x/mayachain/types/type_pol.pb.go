@@ -8,6 +8,8 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "gitlab.com/mayachain/mayanode/common"
+	gitlab_com_mayachain_mayanode_common "gitlab.com/mayachain/mayanode/common"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -62,8 +64,113 @@ func (m *ProtocolOwnedLiquidity) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ProtocolOwnedLiquidity proto.InternalMessageInfo
 
+type POLPool struct {
+	Asset               gitlab_com_mayachain_mayanode_common.Asset `protobuf:"bytes,1,opt,name=asset,proto3,customtype=gitlab.com/mayachain/mayanode/common.Asset" json:"asset"`
+	Units               github_com_cosmos_cosmos_sdk_types.Uint    `protobuf:"bytes,2,opt,name=units,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"units"`
+	CacaoDeposited      github_com_cosmos_cosmos_sdk_types.Uint    `protobuf:"bytes,3,opt,name=cacao_deposited,json=cacaoDeposited,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"cacao_deposited"`
+	CacaoWithdrawn      github_com_cosmos_cosmos_sdk_types.Uint    `protobuf:"bytes,4,opt,name=cacao_withdrawn,json=cacaoWithdrawn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"cacao_withdrawn"`
+	EntryValue          github_com_cosmos_cosmos_sdk_types.Uint    `protobuf:"bytes,5,opt,name=entry_value,json=entryValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"entry_value"`
+	WithdrawnEntryValue github_com_cosmos_cosmos_sdk_types.Uint    `protobuf:"bytes,6,opt,name=withdrawn_entry_value,json=withdrawnEntryValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"withdrawn_entry_value"`
+	LastMovementHeight  int64                                      `protobuf:"varint,7,opt,name=last_movement_height,json=lastMovementHeight,proto3" json:"last_movement_height,omitempty"`
+}
+
+func (m *POLPool) Reset()         { *m = POLPool{} }
+func (m *POLPool) String() string { return proto.CompactTextString(m) }
+func (*POLPool) ProtoMessage()    {}
+func (*POLPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56049012f7da4012, []int{1}
+}
+func (m *POLPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *POLPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_POLPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *POLPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_POLPool.Merge(m, src)
+}
+func (m *POLPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *POLPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_POLPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_POLPool proto.InternalMessageInfo
+
+func (m *POLPool) GetLastMovementHeight() int64 {
+	if m != nil {
+		return m.LastMovementHeight
+	}
+	return 0
+}
+
+type POLPoolSnapshot struct {
+	Pool   POLPool                                 `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool"`
+	Height int64                                   `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Value  github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=value,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"value"`
+}
+
+func (m *POLPoolSnapshot) Reset()         { *m = POLPoolSnapshot{} }
+func (m *POLPoolSnapshot) String() string { return proto.CompactTextString(m) }
+func (*POLPoolSnapshot) ProtoMessage()    {}
+func (*POLPoolSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56049012f7da4012, []int{2}
+}
+func (m *POLPoolSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *POLPoolSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_POLPoolSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *POLPoolSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_POLPoolSnapshot.Merge(m, src)
+}
+func (m *POLPoolSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *POLPoolSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_POLPoolSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_POLPoolSnapshot proto.InternalMessageInfo
+
+func (m *POLPoolSnapshot) GetPool() POLPool {
+	if m != nil {
+		return m.Pool
+	}
+	return POLPool{}
+}
+
+func (m *POLPoolSnapshot) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ProtocolOwnedLiquidity)(nil), "types.ProtocolOwnedLiquidity")
+	proto.RegisterType((*POLPool)(nil), "types.POLPool")
+	proto.RegisterType((*POLPoolSnapshot)(nil), "types.POLPoolSnapshot")
 }
 
 func init() {
@@ -71,23 +178,36 @@ func init() {
 }
 
 var fileDescriptor_56049012f7da4012 = []byte{
-	// 245 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xcd, 0x4d, 0xac, 0x4c,
-	0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x2f, 0x33, 0xd4, 0xaf, 0xd0, 0x47, 0x70, 0x4b, 0x2a, 0x0b,
-	0x52, 0x8b, 0xc1, 0x64, 0x7c, 0x41, 0x7e, 0x8e, 0x5e, 0x41, 0x51, 0x7e, 0x49, 0xbe, 0x10, 0x2b,
-	0x58, 0x54, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0x2c, 0xa2, 0x0f, 0x62, 0x41, 0x24, 0x95, 0xce,
-	0x30, 0x72, 0x89, 0x05, 0x80, 0x58, 0xc9, 0xf9, 0x39, 0xfe, 0xe5, 0x79, 0xa9, 0x29, 0x3e, 0x99,
-	0x85, 0xa5, 0x99, 0x29, 0x99, 0x25, 0x95, 0x42, 0x11, 0x5c, 0xfc, 0xc9, 0x89, 0xc9, 0x89, 0xf9,
-	0xf1, 0x29, 0xa9, 0x05, 0xf9, 0xc5, 0x99, 0x25, 0xa9, 0x29, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c,
-	0x4e, 0xfa, 0x27, 0xee, 0xc9, 0x33, 0xdc, 0xba, 0x27, 0xaf, 0x9e, 0x9e, 0x59, 0x92, 0x51, 0x9a,
-	0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x9f, 0x9c, 0x5f, 0x9c, 0x9b, 0x5f, 0x0c, 0xa5, 0x74, 0x8b, 0x53,
-	0xb2, 0x21, 0x6e, 0xd1, 0x0b, 0xcd, 0xcc, 0x2b, 0x09, 0xe2, 0x03, 0x9b, 0xe3, 0x02, 0x33, 0x06,
-	0x61, 0x72, 0x79, 0x66, 0x49, 0x46, 0x4a, 0x51, 0x62, 0x79, 0x9e, 0x04, 0x13, 0x25, 0x26, 0x87,
-	0xc3, 0x8c, 0x71, 0xf2, 0x3c, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4,
-	0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xfd,
-	0xf4, 0xcc, 0x92, 0x9c, 0x44, 0x88, 0x91, 0x88, 0x00, 0x03, 0xb1, 0xf2, 0xf2, 0x53, 0x52, 0x31,
-	0x43, 0x31, 0x89, 0x0d, 0x1c, 0x40, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x95, 0x43, 0xcd,
-	0xd7, 0x6e, 0x01, 0x00, 0x00,
+	// 461 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcd, 0x6e, 0x13, 0x31,
+	0x10, 0xc7, 0xe3, 0x36, 0x49, 0x85, 0x2b, 0x5a, 0xc9, 0x94, 0x2a, 0xea, 0x61, 0x1b, 0xe5, 0x42,
+	0x84, 0xd4, 0x35, 0x94, 0x27, 0x20, 0xa2, 0x12, 0x48, 0x45, 0x8d, 0x82, 0xa0, 0x88, 0xcb, 0xca,
+	0xf5, 0x5a, 0xbb, 0x16, 0xbb, 0x9e, 0x25, 0x76, 0x12, 0xf2, 0x16, 0x9c, 0x79, 0x20, 0xd4, 0x03,
+	0x87, 0x1e, 0x11, 0x87, 0x0a, 0x25, 0x2f, 0x82, 0xfc, 0xd1, 0xa6, 0x08, 0xc4, 0x61, 0xa1, 0x97,
+	0x78, 0x3c, 0x1f, 0xbf, 0xff, 0x64, 0x76, 0x64, 0x7c, 0x50, 0xb2, 0x39, 0xe3, 0x39, 0x93, 0x8a,
+	0x4e, 0x1f, 0xd3, 0x8f, 0x74, 0x75, 0x35, 0xf3, 0x4a, 0x68, 0xf7, 0x9b, 0x54, 0x50, 0xc4, 0xd5,
+	0x18, 0x0c, 0x90, 0x96, 0xf3, 0xee, 0x75, 0x7f, 0xa9, 0xe2, 0x50, 0x96, 0xa0, 0xc2, 0xe1, 0x13,
+	0xf7, 0x76, 0x32, 0xc8, 0xc0, 0x99, 0xd4, 0x5a, 0xde, 0xdb, 0xfb, 0x8a, 0xf0, 0xee, 0xd0, 0x5a,
+	0x1c, 0x8a, 0x93, 0x99, 0x12, 0xe9, 0xb1, 0xfc, 0x30, 0x91, 0xa9, 0x34, 0x73, 0xf2, 0x16, 0x6f,
+	0x73, 0xc6, 0x19, 0x24, 0xa9, 0xa8, 0x40, 0x4b, 0x23, 0xd2, 0x0e, 0xea, 0xa2, 0xfe, 0x9d, 0x01,
+	0x3d, 0xbf, 0xdc, 0x6f, 0x7c, 0xbf, 0xdc, 0x7f, 0x90, 0x49, 0x93, 0x4f, 0xce, 0x62, 0x0e, 0x25,
+	0xe5, 0xa0, 0x4b, 0xd0, 0xe1, 0x38, 0xd0, 0xe9, 0x7b, 0xdf, 0x6d, 0xfc, 0x5a, 0x2a, 0x33, 0xda,
+	0x72, 0x9c, 0x67, 0x57, 0x98, 0x15, 0x79, 0x26, 0x4d, 0x9e, 0x8e, 0xd9, 0x4c, 0x75, 0xd6, 0xfe,
+	0x85, 0x7c, 0x7a, 0x85, 0xe9, 0x7d, 0x69, 0xe2, 0x8d, 0xe1, 0xc9, 0xf1, 0x10, 0xa0, 0x20, 0xa7,
+	0xb8, 0xc5, 0xb4, 0x16, 0xc6, 0x75, 0xbd, 0x79, 0x78, 0x37, 0x0e, 0xe3, 0x78, 0x6a, 0x9d, 0x83,
+	0xc3, 0x20, 0xf5, 0x30, 0x93, 0xa6, 0x60, 0x5e, 0x6a, 0x35, 0x43, 0x6b, 0x29, 0x48, 0x05, 0xbd,
+	0x59, 0x33, 0xf2, 0x3c, 0x72, 0x84, 0x5b, 0x13, 0x25, 0x8d, 0xae, 0xdb, 0xb4, 0xaf, 0xfe, 0xd3,
+	0x7c, 0xd7, 0x6f, 0x6d, 0xbe, 0xcd, 0xff, 0x32, 0x5f, 0x32, 0xc4, 0x9b, 0x42, 0x99, 0xf1, 0x3c,
+	0x99, 0xb2, 0x62, 0x22, 0x3a, 0xad, 0x7a, 0x54, 0xec, 0x18, 0x6f, 0x2c, 0x82, 0x70, 0x7c, 0xff,
+	0xba, 0xcb, 0xe4, 0x26, 0xbb, 0x5d, 0x8f, 0x7d, 0xef, 0x9a, 0x76, 0xb4, 0x12, 0x79, 0x84, 0x77,
+	0x0a, 0xa6, 0x4d, 0x52, 0xc2, 0x54, 0x94, 0x42, 0x99, 0x24, 0x17, 0x32, 0xcb, 0x4d, 0x67, 0xa3,
+	0x8b, 0xfa, 0xeb, 0x23, 0x62, 0x63, 0x2f, 0x43, 0xe8, 0xb9, 0x8b, 0xf4, 0x3e, 0x23, 0xbc, 0x1d,
+	0x16, 0xe9, 0x95, 0x62, 0x95, 0xce, 0xc1, 0x90, 0x3e, 0x6e, 0x56, 0x00, 0x45, 0xd8, 0xa7, 0xad,
+	0xd8, 0xab, 0x86, 0xac, 0x41, 0xd3, 0x76, 0x3a, 0x72, 0x19, 0x64, 0x17, 0xb7, 0x83, 0xc2, 0x9a,
+	0x53, 0x08, 0x37, 0xbb, 0x39, 0xfe, 0xcf, 0xd5, 0xfc, 0xd0, 0xbe, 0x7a, 0xf0, 0xe2, 0x7c, 0x11,
+	0xa1, 0x8b, 0x45, 0x84, 0x7e, 0x2c, 0x22, 0xf4, 0x69, 0x19, 0x35, 0x2e, 0x96, 0x51, 0xe3, 0xdb,
+	0x32, 0x6a, 0xbc, 0xa3, 0x7f, 0xdf, 0xe6, 0xdf, 0x5e, 0x93, 0xb3, 0xb6, 0x7b, 0x06, 0x9e, 0xfc,
+	0x0c, 0x00, 0x00, 0xff, 0xff, 0xa3, 0xf3, 0x3e, 0xa7, 0x76, 0x04, 0x00, 0x00,
 }
 
 func (m *ProtocolOwnedLiquidity) Marshal() (dAtA []byte, err error) {
@@ -133,6 +253,142 @@ func (m *ProtocolOwnedLiquidity) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *POLPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *POLPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *POLPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastMovementHeight != 0 {
+		i = encodeVarintTypePol(dAtA, i, uint64(m.LastMovementHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.WithdrawnEntryValue.Size()
+		i -= size
+		if _, err := m.WithdrawnEntryValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypePol(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.EntryValue.Size()
+		i -= size
+		if _, err := m.EntryValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypePol(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.CacaoWithdrawn.Size()
+		i -= size
+		if _, err := m.CacaoWithdrawn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypePol(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CacaoDeposited.Size()
+		i -= size
+		if _, err := m.CacaoDeposited.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypePol(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Units.Size()
+		i -= size
+		if _, err := m.Units.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypePol(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Asset.Size()
+		i -= size
+		if _, err := m.Asset.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypePol(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *POLPoolSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *POLPoolSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *POLPoolSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypePol(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintTypePol(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypePol(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypePol(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypePol(v)
 	base := offset
@@ -157,6 +413,46 @@ func (m *ProtocolOwnedLiquidity) Size() (n int) {
 	return n
 }
 
+func (m *POLPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Asset.Size()
+	n += 1 + l + sovTypePol(uint64(l))
+	l = m.Units.Size()
+	n += 1 + l + sovTypePol(uint64(l))
+	l = m.CacaoDeposited.Size()
+	n += 1 + l + sovTypePol(uint64(l))
+	l = m.CacaoWithdrawn.Size()
+	n += 1 + l + sovTypePol(uint64(l))
+	l = m.EntryValue.Size()
+	n += 1 + l + sovTypePol(uint64(l))
+	l = m.WithdrawnEntryValue.Size()
+	n += 1 + l + sovTypePol(uint64(l))
+	if m.LastMovementHeight != 0 {
+		n += 1 + sovTypePol(uint64(m.LastMovementHeight))
+	}
+	return n
+}
+
+func (m *POLPoolSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pool.Size()
+	n += 1 + l + sovTypePol(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovTypePol(uint64(m.Height))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovTypePol(uint64(l))
+	return n
+}
+
 func sovTypePol(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -284,6 +580,420 @@ func (m *ProtocolOwnedLiquidity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *POLPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypePol
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: POLPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: POLPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypePol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypePol
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypePol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypePol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypePol
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypePol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Units.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacaoDeposited", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypePol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypePol
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypePol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CacaoDeposited.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacaoWithdrawn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypePol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypePol
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypePol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CacaoWithdrawn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypePol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypePol
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypePol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EntryValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnEntryValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypePol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypePol
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypePol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WithdrawnEntryValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastMovementHeight", wireType)
+			}
+			m.LastMovementHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypePol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastMovementHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypePol(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypePol
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypePol
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *POLPoolSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypePol
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: POLPoolSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: POLPoolSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypePol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypePol
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypePol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypePol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypePol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypePol
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypePol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypePol(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypePol
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypePol
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypePol(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
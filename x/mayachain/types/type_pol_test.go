@@ -3,6 +3,7 @@ package types
 import (
 	. "gopkg.in/check.v1"
 
+	"gitlab.com/mayachain/mayanode/common"
 	cosmos "gitlab.com/mayachain/mayanode/common/cosmos"
 )
 
@@ -39,3 +40,27 @@ func (s *ProtocolOwnedLiquiditySuite) PnL(c *C) {
 	pol.CacaoWithdrawn = cosmos.NewUint(10)
 	c.Check(pol.PnL(cosmos.NewUint(30)).Int64(), Equals, int64(15))
 }
+
+func (s *ProtocolOwnedLiquiditySuite) TestPOLPool(c *C) {
+	p := NewPOLPool(common.BTCAsset)
+	c.Check(p.Key(), Equals, "BTC.BTC")
+	c.Check(p.Units.IsZero(), Equals, true)
+
+	p.Deposit(cosmos.NewUint(100), cosmos.NewUint(50), 10)
+	p.Deposit(cosmos.NewUint(300), cosmos.NewUint(150), 20)
+	c.Check(p.CacaoDeposited.Uint64(), Equals, uint64(400))
+	c.Check(p.EntryValue.Uint64(), Equals, uint64(400))
+	c.Check(p.Units.Uint64(), Equals, uint64(200))
+	c.Check(p.LastMovementHeight, Equals, int64(20))
+	c.Check(p.UnrealizedPnL(cosmos.NewUint(440)).Int64(), Equals, int64(40))
+	c.Check(p.RealizedPnL().Int64(), Equals, int64(0))
+
+	// withdraw a quarter of the units for more than their value at entry
+	p.Withdraw(cosmos.NewUint(110), cosmos.NewUint(50), 30)
+	c.Check(p.CacaoWithdrawn.Uint64(), Equals, uint64(110))
+	c.Check(p.EntryValue.Uint64(), Equals, uint64(300))
+	c.Check(p.WithdrawnEntryValue.Uint64(), Equals, uint64(100))
+	c.Check(p.Units.Uint64(), Equals, uint64(150))
+	c.Check(p.RealizedPnL().Int64(), Equals, int64(10))
+	c.Check(p.UnrealizedPnL(cosmos.NewUint(270)).Int64(), Equals, int64(-30))
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"math"
+
 	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
 )
@@ -70,3 +72,46 @@ func (m *SolvencyVoter) Empty() bool {
 func (m *SolvencyVoter) String() string {
 	return m.Id.String()
 }
+
+// NewSolvencyDelta create a new instance of SolvencyDelta, the amount of an
+// asset a vault holds according to the network and to the chain
+func NewSolvencyDelta(asset common.Asset, vaultAmount, walletAmount cosmos.Uint) SolvencyDelta {
+	return SolvencyDelta{
+		Asset:        asset,
+		VaultAmount:  vaultAmount,
+		WalletAmount: walletAmount,
+	}
+}
+
+// Delta returns the wallet amount less the vault amount, negative when the
+// wallet holds less than the vault
+func (m SolvencyDelta) Delta() cosmos.Int {
+	return cosmos.NewIntFromBigInt(m.WalletAmount.BigInt()).Sub(cosmos.NewIntFromBigInt(m.VaultAmount.BigInt()))
+}
+
+// Gap returns how much the wallet is short of the vault in basis points of the
+// wallet amount, the measure PermittedSolvencyGap is expressed in
+func (m SolvencyDelta) Gap() int64 {
+	if m.WalletAmount.GTE(m.VaultAmount) {
+		return 0
+	}
+	if m.WalletAmount.IsZero() {
+		return math.MaxInt64
+	}
+	gap := m.VaultAmount.Sub(m.WalletAmount).MulUint64(10000).Quo(m.WalletAmount)
+	if !gap.BigInt().IsInt64() {
+		return math.MaxInt64
+	}
+	return gap.BigInt().Int64()
+}
+
+// NewSolvencyRecord create a new instance of SolvencyRecord, the balances of a
+// vault on a chain reported by a MsgSolvency that reached consensus
+func NewSolvencyRecord(pk common.PubKey, chain common.Chain, height int64) SolvencyRecord {
+	return SolvencyRecord{
+		PubKey: pk,
+		Chain:  chain,
+		Height: height,
+		Deltas: make([]SolvencyDelta, 0),
+	}
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	common "gitlab.com/mayachain/mayanode/common"
@@ -12,6 +13,8 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -67,8 +70,87 @@ func (m *SolvencyVoter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SolvencyVoter proto.InternalMessageInfo
 
+type SolvencyDelta struct {
+	Asset        common.Asset                            `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset"`
+	VaultAmount  github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=vault_amount,json=vaultAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"vault_amount"`
+	WalletAmount github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=wallet_amount,json=walletAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"wallet_amount"`
+}
+
+func (m *SolvencyDelta) Reset()      { *m = SolvencyDelta{} }
+func (*SolvencyDelta) ProtoMessage() {}
+func (*SolvencyDelta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_419ca5f2fbdd59a9, []int{1}
+}
+func (m *SolvencyDelta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SolvencyDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SolvencyDelta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SolvencyDelta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SolvencyDelta.Merge(m, src)
+}
+func (m *SolvencyDelta) XXX_Size() int {
+	return m.Size()
+}
+func (m *SolvencyDelta) XXX_DiscardUnknown() {
+	xxx_messageInfo_SolvencyDelta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SolvencyDelta proto.InternalMessageInfo
+
+type SolvencyRecord struct {
+	PubKey gitlab_com_mayachain_mayanode_common.PubKey `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3,casttype=gitlab.com/mayachain/mayanode/common.PubKey" json:"pub_key,omitempty"`
+	Chain  gitlab_com_mayachain_mayanode_common.Chain  `protobuf:"bytes,2,opt,name=chain,proto3,casttype=gitlab.com/mayachain/mayanode/common.Chain" json:"chain,omitempty"`
+	Height int64                                       `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Deltas []SolvencyDelta                             `protobuf:"bytes,4,rep,name=deltas,proto3" json:"deltas"`
+}
+
+func (m *SolvencyRecord) Reset()      { *m = SolvencyRecord{} }
+func (*SolvencyRecord) ProtoMessage() {}
+func (*SolvencyRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_419ca5f2fbdd59a9, []int{2}
+}
+func (m *SolvencyRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SolvencyRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SolvencyRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SolvencyRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SolvencyRecord.Merge(m, src)
+}
+func (m *SolvencyRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SolvencyRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SolvencyRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SolvencyRecord proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*SolvencyVoter)(nil), "types.SolvencyVoter")
+	proto.RegisterType((*SolvencyDelta)(nil), "types.SolvencyDelta")
+	proto.RegisterType((*SolvencyRecord)(nil), "types.SolvencyRecord")
 }
 
 func init() {
@@ -76,31 +158,41 @@ func init() {
 }
 
 var fileDescriptor_419ca5f2fbdd59a9 = []byte{
-	// 379 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xb2, 0xc8, 0x4d, 0xac, 0x4c,
-	0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x2f, 0x33, 0xd4, 0xaf, 0xd0, 0x47, 0x70, 0x4b, 0x2a, 0x0b,
-	0x52, 0x8b, 0xc1, 0x64, 0x7c, 0x71, 0x7e, 0x4e, 0x59, 0x6a, 0x5e, 0x72, 0x65, 0x7c, 0x59, 0x7e,
-	0x49, 0x6a, 0x91, 0x5e, 0x41, 0x51, 0x7e, 0x49, 0xbe, 0x10, 0x2b, 0x58, 0x81, 0x94, 0x48, 0x7a,
-	0x7e, 0x7a, 0x3e, 0x58, 0x44, 0x1f, 0xc4, 0x82, 0x48, 0x4a, 0x29, 0xa0, 0x18, 0x9b, 0x9c, 0x9f,
-	0x9b, 0x9b, 0x9f, 0x07, 0xa5, 0x20, 0x2a, 0x94, 0xe6, 0x31, 0x73, 0xf1, 0x06, 0x43, 0xcd, 0x0d,
-	0x03, 0x19, 0x2b, 0x64, 0xcb, 0xc5, 0x94, 0x99, 0x22, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0xa4,
-	0xfb, 0xeb, 0x9e, 0xbc, 0x66, 0x7a, 0x66, 0x49, 0x4e, 0x62, 0x92, 0x5e, 0x72, 0x7e, 0x2e, 0x92,
-	0xb3, 0x40, 0xac, 0xbc, 0xfc, 0x94, 0x54, 0x98, 0x69, 0x21, 0x15, 0x9e, 0x2e, 0x41, 0x4c, 0x99,
-	0x29, 0x42, 0x2e, 0x5c, 0xac, 0x60, 0x15, 0x12, 0x4c, 0x60, 0x13, 0xf4, 0x7e, 0xdd, 0x93, 0xd7,
-	0x22, 0xca, 0x04, 0x67, 0x90, 0x68, 0x10, 0x44, 0xb3, 0x90, 0x07, 0x17, 0x7b, 0x41, 0x69, 0x52,
-	0x7c, 0x76, 0x6a, 0xa5, 0x04, 0x33, 0xd8, 0x1c, 0xfd, 0x5f, 0xf7, 0xe4, 0xb5, 0x89, 0x32, 0x27,
-	0xa0, 0x34, 0xc9, 0x3b, 0xb5, 0x32, 0x88, 0xad, 0x00, 0x4c, 0x0b, 0x85, 0x71, 0xb1, 0x26, 0xe7,
-	0x67, 0xe6, 0x15, 0x4b, 0xb0, 0x28, 0x30, 0x6b, 0x70, 0x1b, 0xf1, 0xe8, 0xc1, 0xac, 0xcb, 0xcf,
-	0xcc, 0x73, 0x32, 0x3a, 0x71, 0x4f, 0x9e, 0x61, 0xd5, 0x7d, 0x62, 0x5d, 0x08, 0x32, 0x27, 0x08,
-	0x62, 0x9c, 0x90, 0x18, 0x17, 0x5b, 0x46, 0x6a, 0x66, 0x7a, 0x46, 0x89, 0x04, 0xab, 0x02, 0xa3,
-	0x06, 0x73, 0x10, 0x94, 0x27, 0x64, 0xc2, 0x25, 0x96, 0x9c, 0x9f, 0x57, 0x9c, 0x9a, 0x57, 0x5c,
-	0x5a, 0x1c, 0x9f, 0x94, 0x93, 0x9f, 0x9c, 0x1d, 0x0f, 0x55, 0xc7, 0x06, 0x56, 0x27, 0x02, 0x97,
-	0x75, 0x02, 0x49, 0x7a, 0x40, 0x74, 0x49, 0x70, 0xb1, 0x17, 0x67, 0xa6, 0xe7, 0xa5, 0x16, 0x15,
-	0x4b, 0xb0, 0x2b, 0x30, 0x6b, 0x70, 0x06, 0xc1, 0xb8, 0x4e, 0xa1, 0x27, 0x1e, 0xca, 0x31, 0xdc,
-	0x78, 0x28, 0xc7, 0xd0, 0xf0, 0x48, 0x8e, 0xe1, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18,
-	0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5,
-	0x18, 0xa2, 0xf4, 0xf1, 0x7b, 0x00, 0x23, 0x41, 0x25, 0xb1, 0x81, 0xa3, 0xdf, 0x18, 0x10, 0x00,
-	0x00, 0xff, 0xff, 0x5a, 0x81, 0x9f, 0x3d, 0x79, 0x02, 0x00, 0x00,
+	// 535 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x3d, 0x6f, 0xd3, 0x40,
+	0x1c, 0xc6, 0xed, 0x3a, 0x2f, 0xea, 0x25, 0x61, 0xb0, 0xa2, 0xca, 0xea, 0xe0, 0x58, 0x59, 0x48,
+	0x41, 0xb5, 0x45, 0x60, 0x40, 0x48, 0x0c, 0x0d, 0x19, 0x8a, 0x58, 0xd0, 0xd1, 0x76, 0x60, 0xb1,
+	0x1c, 0xfb, 0xe4, 0x9c, 0x62, 0xdf, 0x45, 0xb9, 0x73, 0x68, 0xb6, 0x7e, 0x04, 0x3e, 0x01, 0x1f,
+	0x80, 0x4f, 0x92, 0xb1, 0x63, 0x85, 0x50, 0xa0, 0xc9, 0xc6, 0xc8, 0x98, 0x09, 0xdd, 0x9d, 0xdd,
+	0x34, 0x42, 0x42, 0x11, 0xb0, 0xf8, 0xde, 0x9e, 0xfb, 0xf9, 0xff, 0xe8, 0xf9, 0xdb, 0xe0, 0x79,
+	0x1a, 0xcc, 0x82, 0x70, 0x18, 0x60, 0xe2, 0x4d, 0x9f, 0x78, 0x97, 0xde, 0x66, 0xc9, 0x67, 0x63,
+	0xc4, 0xe4, 0xd3, 0x67, 0x34, 0x99, 0x22, 0x12, 0xce, 0xfc, 0x29, 0xe5, 0x68, 0xe2, 0x8e, 0x27,
+	0x94, 0x53, 0xb3, 0x2c, 0x05, 0x87, 0xcd, 0x98, 0xc6, 0x54, 0xee, 0x78, 0x62, 0xa6, 0x0e, 0x0f,
+	0x9d, 0x2d, 0x6c, 0x48, 0xd3, 0x94, 0x92, 0x7c, 0x50, 0x8a, 0xf6, 0x27, 0x03, 0x34, 0xde, 0xe5,
+	0xdc, 0x0b, 0x81, 0x35, 0x5f, 0x82, 0x3d, 0x1c, 0x59, 0xba, 0xa3, 0x77, 0xf6, 0x7b, 0xc7, 0xeb,
+	0x45, 0xeb, 0x28, 0xc6, 0x3c, 0x09, 0x06, 0x6e, 0x48, 0xd3, 0x7b, 0x65, 0x89, 0x19, 0xa1, 0x11,
+	0x2a, 0x68, 0x67, 0x97, 0xaf, 0xfb, 0x70, 0x0f, 0x47, 0x66, 0x1f, 0x94, 0xa5, 0xc2, 0xda, 0x93,
+	0x04, 0x77, 0xbd, 0x68, 0x3d, 0xda, 0x89, 0xf0, 0x4a, 0xec, 0x42, 0x75, 0xd9, 0x3c, 0x05, 0xd5,
+	0x71, 0x36, 0xf0, 0x47, 0x68, 0x66, 0x19, 0x92, 0xe3, 0xad, 0x17, 0xad, 0xc7, 0x3b, 0x71, 0xde,
+	0x66, 0x83, 0x37, 0x68, 0x06, 0x2b, 0x63, 0x39, 0x9a, 0x17, 0xa0, 0x1c, 0x52, 0x4c, 0x98, 0x55,
+	0x72, 0x8c, 0x4e, 0xad, 0x5b, 0x77, 0x8b, 0xd7, 0x51, 0x4c, 0x7a, 0xdd, 0xf9, 0xa2, 0xa5, 0x7d,
+	0xfe, 0xb6, 0x6b, 0x85, 0x82, 0x03, 0x15, 0xce, 0x3c, 0x00, 0x95, 0x21, 0xc2, 0xf1, 0x90, 0x5b,
+	0x65, 0x47, 0xef, 0x18, 0x30, 0x5f, 0x99, 0xcf, 0xc0, 0x41, 0x48, 0x09, 0x43, 0x84, 0x65, 0xcc,
+	0x1f, 0x24, 0x34, 0x1c, 0xf9, 0xb9, 0xae, 0x22, 0x75, 0xcd, 0xbb, 0xd3, 0x9e, 0x38, 0x3c, 0x55,
+	0xb7, 0x2c, 0x50, 0x65, 0x38, 0x26, 0x68, 0xc2, 0xac, 0xaa, 0x63, 0x74, 0xf6, 0x61, 0xb1, 0x6c,
+	0xff, 0xd0, 0x37, 0x01, 0xf5, 0x51, 0xc2, 0x03, 0xf3, 0x08, 0x94, 0x03, 0xc6, 0x10, 0x97, 0x19,
+	0xd5, 0xba, 0x8d, 0xc2, 0xd1, 0x89, 0xd8, 0xec, 0x95, 0x84, 0x25, 0xa8, 0x14, 0x26, 0x04, 0xf5,
+	0x69, 0x90, 0x25, 0xdc, 0x0f, 0x52, 0x9a, 0x11, 0x9e, 0x67, 0xe2, 0x09, 0xc9, 0x97, 0x45, 0xeb,
+	0x61, 0x8c, 0xf9, 0x30, 0x53, 0xae, 0x43, 0xca, 0x52, 0xca, 0xf2, 0xe1, 0x98, 0x45, 0x23, 0xd5,
+	0x78, 0xee, 0x39, 0x26, 0x1c, 0xd6, 0x24, 0xe4, 0x44, 0x32, 0xcc, 0x33, 0xd0, 0xf8, 0x10, 0x24,
+	0x09, 0xba, 0x83, 0x1a, 0x7f, 0x07, 0xad, 0x2b, 0x8a, 0xa2, 0xbe, 0x28, 0x5d, 0x7d, 0x75, 0xf4,
+	0xf6, 0x4f, 0x1d, 0x3c, 0x28, 0xcc, 0x42, 0x14, 0xd2, 0x49, 0x74, 0xbf, 0x13, 0xf4, 0x7f, 0xeb,
+	0x84, 0xff, 0xd3, 0x99, 0x9b, 0xdc, 0x8d, 0xad, 0xdc, 0xbb, 0xa0, 0x12, 0x89, 0x78, 0x8a, 0x46,
+	0x6b, 0xba, 0xca, 0xeb, 0x56, 0x76, 0x79, 0x3a, 0xb9, 0x52, 0x99, 0xee, 0x9d, 0xcf, 0x6f, 0x6d,
+	0xed, 0xe6, 0xd6, 0xd6, 0xae, 0x96, 0xb6, 0x36, 0x5f, 0xda, 0xfa, 0xf5, 0xd2, 0xd6, 0xbf, 0x2f,
+	0x6d, 0xfd, 0xe3, 0xca, 0xd6, 0xae, 0x57, 0xb6, 0x76, 0xb3, 0xb2, 0xb5, 0xf7, 0xde, 0x9f, 0x4b,
+	0xfd, 0xed, 0x97, 0x31, 0xa8, 0xc8, 0x0f, 0xfc, 0xe9, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x39,
+	0x82, 0x14, 0xb2, 0x5b, 0x04, 0x00, 0x00,
 }
 
 func (m *SolvencyVoter) Marshal() (dAtA []byte, err error) {
@@ -180,6 +272,115 @@ func (m *SolvencyVoter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SolvencyDelta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SolvencyDelta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SolvencyDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.WalletAmount.Size()
+		i -= size
+		if _, err := m.WalletAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeSolvencyVoter(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.VaultAmount.Size()
+		i -= size
+		if _, err := m.VaultAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeSolvencyVoter(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeSolvencyVoter(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SolvencyRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SolvencyRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SolvencyRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deltas) > 0 {
+		for iNdEx := len(m.Deltas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deltas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypeSolvencyVoter(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypeSolvencyVoter(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTypeSolvencyVoter(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTypeSolvencyVoter(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypeSolvencyVoter(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypeSolvencyVoter(v)
 	base := offset
@@ -230,12 +431,91 @@ func (m *SolvencyVoter) Size() (n int) {
 	return n
 }
 
+func (m *SolvencyDelta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Asset.Size()
+	n += 1 + l + sovTypeSolvencyVoter(uint64(l))
+	l = m.VaultAmount.Size()
+	n += 1 + l + sovTypeSolvencyVoter(uint64(l))
+	l = m.WalletAmount.Size()
+	n += 1 + l + sovTypeSolvencyVoter(uint64(l))
+	return n
+}
+
+func (m *SolvencyRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTypeSolvencyVoter(uint64(l))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTypeSolvencyVoter(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypeSolvencyVoter(uint64(m.Height))
+	}
+	if len(m.Deltas) > 0 {
+		for _, e := range m.Deltas {
+			l = e.Size()
+			n += 1 + l + sovTypeSolvencyVoter(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTypeSolvencyVoter(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozTypeSolvencyVoter(x uint64) (n int) {
 	return sovTypeSolvencyVoter(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (this *SolvencyDelta) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SolvencyDelta{`,
+		`Asset:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Asset), "Asset", "common.Asset", 1), `&`, ``, 1) + `,`,
+		`VaultAmount:` + fmt.Sprintf("%v", this.VaultAmount) + `,`,
+		`WalletAmount:` + fmt.Sprintf("%v", this.WalletAmount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SolvencyRecord) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForDeltas := "[]SolvencyDelta{"
+	for _, f := range this.Deltas {
+		repeatedStringForDeltas += strings.Replace(strings.Replace(f.String(), "SolvencyDelta", "SolvencyDelta", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDeltas += "}"
+	s := strings.Join([]string{`&SolvencyRecord{`,
+		`PubKey:` + fmt.Sprintf("%v", this.PubKey) + `,`,
+		`Chain:` + fmt.Sprintf("%v", this.Chain) + `,`,
+		`Height:` + fmt.Sprintf("%v", this.Height) + `,`,
+		`Deltas:` + repeatedStringForDeltas + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringTypeSolvencyVoter(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
 func (m *SolvencyVoter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -489,6 +769,330 @@ func (m *SolvencyVoter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SolvencyDelta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeSolvencyVoter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SolvencyDelta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SolvencyDelta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeSolvencyVoter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeSolvencyVoter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeSolvencyVoter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeSolvencyVoter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeSolvencyVoter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeSolvencyVoter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VaultAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeSolvencyVoter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeSolvencyVoter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeSolvencyVoter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WalletAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeSolvencyVoter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeSolvencyVoter
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeSolvencyVoter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SolvencyRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeSolvencyVoter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SolvencyRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SolvencyRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeSolvencyVoter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeSolvencyVoter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeSolvencyVoter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = gitlab_com_mayachain_mayanode_common.PubKey(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeSolvencyVoter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeSolvencyVoter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeSolvencyVoter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = gitlab_com_mayachain_mayanode_common.Chain(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeSolvencyVoter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deltas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeSolvencyVoter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeSolvencyVoter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeSolvencyVoter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deltas = append(m.Deltas, SolvencyDelta{})
+			if err := m.Deltas[len(m.Deltas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeSolvencyVoter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeSolvencyVoter
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeSolvencyVoter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypeSolvencyVoter(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
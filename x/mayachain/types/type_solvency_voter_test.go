@@ -1,6 +1,8 @@
 package types
 
 import (
+	"math"
+
 	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
 	. "gopkg.in/check.v1"
@@ -34,3 +36,17 @@ func (s *TypeSolvencyVoterTestSuite) TestSolvencyVoter(c *C) {
 	}
 	c.Assert(voter.HasConsensus(nas), Equals, true)
 }
+
+func (s *TypeSolvencyVoterTestSuite) TestSolvencyDelta(c *C) {
+	delta := NewSolvencyDelta(common.ETHAsset, cosmos.NewUint(1000), cosmos.NewUint(1010))
+	c.Check(delta.Delta().String(), Equals, "10")
+	c.Check(delta.Gap(), Equals, int64(0))
+
+	delta = NewSolvencyDelta(common.ETHAsset, cosmos.NewUint(1000), cosmos.NewUint(990))
+	c.Check(delta.Delta().String(), Equals, "-10")
+	c.Check(delta.Gap(), Equals, int64(101))
+
+	delta = NewSolvencyDelta(common.ETHAsset, cosmos.NewUint(1000), cosmos.ZeroUint())
+	c.Check(delta.Delta().String(), Equals, "-1000")
+	c.Check(delta.Gap(), Equals, int64(math.MaxInt64))
+}
@@ -44,6 +44,8 @@ type TxOutItem struct {
 	AggregatorTargetLimit *github_com_cosmos_cosmos_sdk_types.Uint     `protobuf:"bytes,13,opt,name=aggregator_target_limit,json=aggregatorTargetLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"aggregator_target_limit,omitempty"`
 	// Skipping if we later need to add clout_spent as field number 14
 	VaultPubKeyEddsa gitlab_com_mayachain_mayanode_common.PubKey `protobuf:"bytes,15,opt,name=vault_pub_key_eddsa,json=vaultPubKeyEddsa,proto3,casttype=gitlab.com/mayachain/mayanode/common.PubKey" json:"vault_pub_key_eddsa,omitempty"`
+	// outbound lane the item is scheduled in, set when it's added to the block out
+	Lane string `protobuf:"bytes,16,opt,name=lane,proto3" json:"lane,omitempty"`
 }
 
 func (m *TxOutItem) Reset()      { *m = TxOutItem{} }
@@ -125,46 +127,47 @@ func init() {
 }
 
 var fileDescriptor_3731271d51a4f38c = []byte{
-	// 623 bytes of a gzipped FileDescriptorProto
+	// 633 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4d, 0x6f, 0xd3, 0x4c,
-	0x10, 0xc7, 0xed, 0xe6, 0x7d, 0xd3, 0xea, 0xa9, 0xf6, 0xe1, 0x65, 0xa9, 0x84, 0x1d, 0xf5, 0x00,
-	0xe1, 0x25, 0x49, 0x4b, 0x25, 0x0e, 0xdc, 0x5a, 0x0a, 0x6d, 0x05, 0x52, 0x91, 0x69, 0x05, 0xe2,
-	0x62, 0x4d, 0xe2, 0xd5, 0xda, 0x6a, 0xd6, 0x5b, 0x79, 0xd7, 0x95, 0x73, 0xeb, 0x47, 0xe0, 0x73,
-	0xf0, 0x49, 0x72, 0xec, 0xb1, 0x42, 0xc8, 0xd0, 0xf4, 0xc6, 0x47, 0x88, 0x84, 0x84, 0xbc, 0x4e,
-	0x69, 0xab, 0x20, 0x14, 0xb8, 0x78, 0x67, 0x76, 0x76, 0x7e, 0x33, 0x6b, 0xfd, 0x67, 0xd1, 0x0a,
-	0x87, 0x01, 0xf4, 0x7c, 0x08, 0xc2, 0xce, 0xd1, 0x6a, 0x27, 0xe9, 0x5c, 0xba, 0x6a, 0x70, 0x48,
-	0xa5, 0xfe, 0xba, 0x2a, 0x71, 0x45, 0xac, 0xda, 0x87, 0x91, 0x50, 0x02, 0x97, 0x74, 0x60, 0xa9,
-	0x71, 0x2d, 0xb1, 0x27, 0x38, 0x17, 0xe1, 0x64, 0xc9, 0x0f, 0x2e, 0xdd, 0x60, 0x82, 0x09, 0x6d,
-	0x76, 0x32, 0x2b, 0xdf, 0x5d, 0xfe, 0x51, 0x46, 0xb5, 0xbd, 0x64, 0x37, 0x56, 0x3b, 0x8a, 0x72,
-	0xbc, 0x89, 0x4a, 0x9a, 0x41, 0xcc, 0x86, 0xd9, 0xac, 0x6d, 0xb4, 0xc7, 0xa9, 0xfd, 0x90, 0x05,
-	0xaa, 0x0f, 0xdd, 0x76, 0x4f, 0xf0, 0x2b, 0xdd, 0x64, 0x56, 0x28, 0x3c, 0x7a, 0x51, 0xe2, 0x79,
-	0xb6, 0xeb, 0xe4, 0xc9, 0x78, 0x17, 0x21, 0x25, 0x5c, 0xf0, 0xbc, 0x88, 0x4a, 0x49, 0xe6, 0x34,
-	0x6a, 0x65, 0x9c, 0xda, 0x8f, 0x67, 0x42, 0xad, 0xe7, 0x79, 0x4e, 0x4d, 0x89, 0x89, 0x89, 0xdf,
-	0xa2, 0x85, 0x23, 0x88, 0xfb, 0xca, 0x3d, 0x8c, 0xbb, 0xee, 0x01, 0x1d, 0x90, 0x82, 0x66, 0x76,
-	0xc6, 0xa9, 0xfd, 0x68, 0x26, 0xe6, 0x9b, 0xb8, 0xfb, 0x8a, 0x0e, 0x9c, 0xba, 0xa6, 0xe4, 0x0e,
-	0xbe, 0x87, 0x8a, 0x3d, 0x11, 0x84, 0xa4, 0xd8, 0x30, 0x9b, 0xf5, 0x27, 0xf3, 0xed, 0x8b, 0x9b,
-	0x88, 0x20, 0xdc, 0x28, 0x0e, 0x53, 0xdb, 0x70, 0x74, 0x1c, 0x63, 0x54, 0xe4, 0x94, 0x0b, 0x52,
-	0xca, 0x6a, 0x3a, 0xda, 0xc6, 0xef, 0x50, 0x85, 0x43, 0xe2, 0x32, 0x90, 0xa4, 0xdc, 0x28, 0x4c,
-	0xa5, 0xaf, 0x64, 0xe9, 0x9f, 0xbe, 0xda, 0xcd, 0x99, 0x9a, 0xdb, 0x02, 0xe9, 0x94, 0x39, 0x24,
-	0x5b, 0x20, 0xf1, 0x1d, 0x54, 0x65, 0x20, 0xdd, 0x08, 0x14, 0x25, 0x95, 0x86, 0xd9, 0x2c, 0x38,
-	0x15, 0x06, 0xd2, 0x01, 0x45, 0xf1, 0x4b, 0x54, 0x09, 0x42, 0xd7, 0x07, 0xe9, 0x93, 0xaa, 0xbe,
-	0x7e, 0x6b, 0x9c, 0xda, 0x0f, 0x66, 0xaa, 0xb0, 0x97, 0xec, 0x6c, 0x3a, 0xe5, 0x20, 0xdc, 0x06,
-	0xe9, 0xe3, 0x6d, 0x54, 0x15, 0xb1, 0xca, 0x41, 0xb5, 0x7f, 0x01, 0x55, 0x44, 0xac, 0x34, 0xe9,
-	0x2e, 0xaa, 0x73, 0xe1, 0xc5, 0x7d, 0xea, 0x86, 0xc0, 0x29, 0x41, 0x1a, 0x56, 0xfa, 0x9e, 0xda,
-	0x66, 0xcb, 0x31, 0x5b, 0xd8, 0x42, 0x08, 0x18, 0x8b, 0x28, 0x03, 0x25, 0x22, 0x52, 0xd7, 0xbf,
-	0xef, 0xca, 0x0e, 0x7e, 0x8a, 0x6e, 0x5f, 0x7a, 0xae, 0x82, 0x88, 0x51, 0xe5, 0x82, 0x94, 0x54,
-	0x91, 0x79, 0x7d, 0xf8, 0xe6, 0x65, 0x78, 0x4f, 0x47, 0xd7, 0xb3, 0x20, 0x66, 0xbf, 0xcb, 0xeb,
-	0x07, 0x3c, 0x50, 0x64, 0x21, 0xd7, 0xc5, 0x30, 0xb5, 0xcd, 0xcf, 0xa9, 0x7d, 0x9f, 0x05, 0xca,
-	0x8f, 0xf3, 0x3b, 0xf5, 0x84, 0xe4, 0x42, 0x4e, 0x96, 0x96, 0xf4, 0x0e, 0xf2, 0x81, 0x6a, 0xef,
-	0x07, 0xa1, 0x9a, 0x2e, 0xf4, 0x3a, 0xa3, 0xe1, 0x2e, 0xfa, 0xff, 0x9a, 0xec, 0x5c, 0xea, 0x79,
-	0x12, 0xc8, 0x7f, 0xba, 0xc8, 0x5a, 0x56, 0xe4, 0x6f, 0x05, 0xb8, 0x78, 0x45, 0x80, 0x2f, 0x32,
-	0xd8, 0xf2, 0x7b, 0x54, 0xd2, 0xe3, 0x87, 0x6f, 0xa1, 0xb2, 0x4f, 0x03, 0xe6, 0x2b, 0x3d, 0x7b,
-	0x05, 0x67, 0xe2, 0xe1, 0x55, 0x54, 0x55, 0x89, 0x0b, 0x51, 0x04, 0x03, 0x32, 0xa7, 0xb5, 0xb6,
-	0xd8, 0xce, 0x5b, 0xff, 0x35, 0xb6, 0x13, 0xb9, 0x56, 0x54, 0xb2, 0x9e, 0x1d, 0x7b, 0x56, 0x3c,
-	0xfe, 0xd2, 0x30, 0x37, 0xf6, 0x87, 0x67, 0x96, 0x71, 0x7a, 0x66, 0x19, 0xc7, 0x23, 0xcb, 0x18,
-	0x8e, 0x2c, 0xf3, 0x64, 0x64, 0x99, 0xdf, 0x46, 0x96, 0xf9, 0xf1, 0xdc, 0x32, 0x4e, 0xce, 0x2d,
-	0xe3, 0xf4, 0xdc, 0x32, 0x3e, 0x74, 0xfe, 0xdc, 0xfe, 0xd4, 0x0b, 0xd4, 0x2d, 0xeb, 0x77, 0x63,
-	0xed, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x68, 0x99, 0x15, 0xdd, 0xaa, 0x04, 0x00, 0x00,
+	0x10, 0xc7, 0xed, 0xe6, 0xc5, 0xc9, 0xa6, 0xd5, 0x53, 0xed, 0xf3, 0xb6, 0x54, 0xc2, 0x8e, 0x7a,
+	0x80, 0xf0, 0x92, 0xa4, 0xa5, 0x12, 0x07, 0x6e, 0x2d, 0x85, 0xb6, 0x02, 0xa9, 0xc8, 0xb4, 0x02,
+	0x71, 0xb1, 0x26, 0xf1, 0x6a, 0x6d, 0x35, 0xeb, 0xad, 0xbc, 0xeb, 0xca, 0xb9, 0xf5, 0x23, 0xc0,
+	0xd7, 0xe0, 0x93, 0xe4, 0xd8, 0x63, 0x85, 0x90, 0xa1, 0xe9, 0x8d, 0x8f, 0x90, 0x13, 0xf2, 0x3a,
+	0xa5, 0xad, 0x8a, 0x50, 0xe0, 0xe2, 0x9d, 0xd9, 0xd9, 0xf9, 0xcd, 0x8e, 0xf5, 0x9f, 0x45, 0x2b,
+	0x1c, 0x86, 0xd0, 0x0f, 0x20, 0x8c, 0xba, 0x47, 0xab, 0xdd, 0xb4, 0x7b, 0xe9, 0xaa, 0xe1, 0x21,
+	0x95, 0xfa, 0xeb, 0xa9, 0xd4, 0x13, 0x89, 0xea, 0x1c, 0xc6, 0x42, 0x09, 0x5c, 0xd1, 0x81, 0xa5,
+	0xe6, 0xb5, 0xc4, 0xbe, 0xe0, 0x5c, 0x44, 0xd3, 0xa5, 0x38, 0xb8, 0xf4, 0x0f, 0x13, 0x4c, 0x68,
+	0xb3, 0x9b, 0x5b, 0xc5, 0xee, 0xf2, 0x07, 0x0b, 0xd5, 0xf7, 0xd2, 0xdd, 0x44, 0xed, 0x28, 0xca,
+	0xf1, 0x26, 0xaa, 0x68, 0x06, 0x31, 0x9b, 0x66, 0xab, 0xbe, 0xd1, 0x99, 0x64, 0xce, 0x7d, 0x16,
+	0xaa, 0x01, 0xf4, 0x3a, 0x7d, 0xc1, 0xaf, 0xdc, 0x26, 0xb7, 0x22, 0xe1, 0xd3, 0x8b, 0x12, 0x4f,
+	0xf3, 0x5d, 0xb7, 0x48, 0xc6, 0xbb, 0x08, 0x29, 0xe1, 0x81, 0xef, 0xc7, 0x54, 0x4a, 0x32, 0xa7,
+	0x51, 0x2b, 0x93, 0xcc, 0x79, 0x38, 0x13, 0x6a, 0xbd, 0xc8, 0x73, 0xeb, 0x4a, 0x4c, 0x4d, 0xfc,
+	0x1a, 0x2d, 0x1c, 0x41, 0x32, 0x50, 0xde, 0x61, 0xd2, 0xf3, 0x0e, 0xe8, 0x90, 0x94, 0x34, 0xb3,
+	0x3b, 0xc9, 0x9c, 0x07, 0x33, 0x31, 0x5f, 0x25, 0xbd, 0x17, 0x74, 0xe8, 0x36, 0x34, 0xa5, 0x70,
+	0xf0, 0x1d, 0x54, 0xee, 0x8b, 0x30, 0x22, 0xe5, 0xa6, 0xd9, 0x6a, 0x3c, 0x9a, 0xef, 0x5c, 0x74,
+	0x22, 0xc2, 0x68, 0xa3, 0x3c, 0xca, 0x1c, 0xc3, 0xd5, 0x71, 0x8c, 0x51, 0x99, 0x53, 0x2e, 0x48,
+	0x25, 0xaf, 0xe9, 0x6a, 0x1b, 0xbf, 0x41, 0x16, 0x87, 0xd4, 0x63, 0x20, 0x49, 0xb5, 0x59, 0xba,
+	0x91, 0xbe, 0x92, 0xa7, 0x7f, 0xfc, 0xe2, 0xb4, 0x66, 0xba, 0xdc, 0x16, 0x48, 0xb7, 0xca, 0x21,
+	0xdd, 0x02, 0x89, 0x6f, 0xa1, 0x1a, 0x03, 0xe9, 0xc5, 0xa0, 0x28, 0xb1, 0x9a, 0x66, 0xab, 0xe4,
+	0x5a, 0x0c, 0xa4, 0x0b, 0x8a, 0xe2, 0xe7, 0xc8, 0x0a, 0x23, 0x2f, 0x00, 0x19, 0x90, 0x9a, 0x6e,
+	0xbf, 0x3d, 0xc9, 0x9c, 0x7b, 0x33, 0x55, 0xd8, 0x4b, 0x77, 0x36, 0xdd, 0x6a, 0x18, 0x6d, 0x83,
+	0x0c, 0xf0, 0x36, 0xaa, 0x89, 0x44, 0x15, 0xa0, 0xfa, 0x9f, 0x80, 0x2c, 0x91, 0x28, 0x4d, 0xba,
+	0x8d, 0x1a, 0x5c, 0xf8, 0xc9, 0x80, 0x7a, 0x11, 0x70, 0x4a, 0x90, 0x86, 0x55, 0xbe, 0x65, 0x8e,
+	0xd9, 0x76, 0xcd, 0x36, 0xb6, 0x11, 0x02, 0xc6, 0x62, 0xca, 0x40, 0x89, 0x98, 0x34, 0xf4, 0xef,
+	0xbb, 0xb2, 0x83, 0x1f, 0xa3, 0xff, 0x2f, 0x3d, 0x4f, 0x41, 0xcc, 0xa8, 0xf2, 0x40, 0x4a, 0xaa,
+	0xc8, 0xbc, 0x3e, 0xfc, 0xef, 0x65, 0x78, 0x4f, 0x47, 0xd7, 0xf3, 0x20, 0x66, 0x3f, 0xcb, 0x1b,
+	0x84, 0x3c, 0x54, 0x64, 0xa1, 0xd0, 0xc5, 0x28, 0x73, 0xcc, 0x4f, 0x99, 0x73, 0x97, 0x85, 0x2a,
+	0x48, 0x8a, 0x9e, 0xfa, 0x42, 0x72, 0x21, 0xa7, 0x4b, 0x5b, 0xfa, 0x07, 0xc5, 0x40, 0x75, 0xf6,
+	0xc3, 0x48, 0xdd, 0x2c, 0xf4, 0x32, 0xa7, 0xe1, 0x1e, 0xfa, 0xfb, 0x9a, 0xec, 0x3c, 0xea, 0xfb,
+	0x12, 0xc8, 0x5f, 0xba, 0xc8, 0x5a, 0x5e, 0xe4, 0x77, 0x05, 0xb8, 0x78, 0x45, 0x80, 0xcf, 0x72,
+	0x58, 0xae, 0xae, 0x01, 0x44, 0x94, 0x2c, 0x16, 0xea, 0xca, 0xed, 0xe5, 0xb7, 0xa8, 0xa2, 0x47,
+	0x12, 0xff, 0x87, 0xaa, 0x01, 0x0d, 0x59, 0xa0, 0xf4, 0x3c, 0x96, 0xdc, 0xa9, 0x87, 0x57, 0x51,
+	0x4d, 0xa5, 0x1e, 0xc4, 0x31, 0x0c, 0xc9, 0x9c, 0xd6, 0xdf, 0x62, 0xa7, 0x68, 0xe7, 0xc7, 0x28,
+	0x4f, 0x25, 0x6c, 0xa9, 0x74, 0x3d, 0x3f, 0xf6, 0xa4, 0x7c, 0xfc, 0xb9, 0x69, 0x6e, 0xec, 0x8f,
+	0xce, 0x6c, 0xe3, 0xf4, 0xcc, 0x36, 0x8e, 0xc7, 0xb6, 0x31, 0x1a, 0xdb, 0xe6, 0xc9, 0xd8, 0x36,
+	0xbf, 0x8e, 0x6d, 0xf3, 0xfd, 0xb9, 0x6d, 0x9c, 0x9c, 0xdb, 0xc6, 0xe9, 0xb9, 0x6d, 0xbc, 0xeb,
+	0xfe, 0xba, 0xa5, 0x1b, 0xaf, 0x52, 0xaf, 0xaa, 0xdf, 0x92, 0xb5, 0xef, 0x01, 0x00, 0x00, 0xff,
+	0xff, 0xea, 0x1c, 0x79, 0xd8, 0xbe, 0x04, 0x00, 0x00,
 }
 
 func (m *TxOutItem) Marshal() (dAtA []byte, err error) {
@@ -187,6 +190,15 @@ func (m *TxOutItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Lane) > 0 {
+		i -= len(m.Lane)
+		copy(dAtA[i:], m.Lane)
+		i = encodeVarintTypeTxOut(dAtA, i, uint64(len(m.Lane)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
 	if len(m.VaultPubKeyEddsa) > 0 {
 		i -= len(m.VaultPubKeyEddsa)
 		copy(dAtA[i:], m.VaultPubKeyEddsa)
@@ -415,6 +427,10 @@ func (m *TxOutItem) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypeTxOut(uint64(l))
 	}
+	l = len(m.Lane)
+	if l > 0 {
+		n += 2 + l + sovTypeTxOut(uint64(l))
+	}
 	return n
 }
 
@@ -937,6 +953,38 @@ func (m *TxOutItem) Unmarshal(dAtA []byte) error {
 			}
 			m.VaultPubKeyEddsa = gitlab_com_mayachain_mayanode_common.PubKey(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lane", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeTxOut
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeTxOut
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeTxOut
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lane = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypeTxOut(dAtA[iNdEx:])
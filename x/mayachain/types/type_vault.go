@@ -327,6 +327,25 @@ func (m *Vault) GetAddress(chain common.Chain) (common.Address, error) {
 	return m.PubKeyEddsa.GetAddress(chain)
 }
 
+// NewVaultMigration create a new instance of VaultMigration, the record of the
+// funds migrated out of the given retiring vault
+func NewVaultMigration(pk common.PubKey) VaultMigration {
+	return VaultMigration{
+		PubKey:   pk,
+		Migrated: common.Coins{},
+	}
+}
+
+// AddMigrated records the given coins as migrated out of the vault
+func (m *VaultMigration) AddMigrated(coins common.Coins) {
+	for _, coin := range coins {
+		if coin.IsEmpty() {
+			continue
+		}
+		m.Migrated = m.Migrated.Add(coin)
+	}
+}
+
 // SortBy order coins by the given asset
 func (vs Vaults) SortBy(sortBy common.Asset) Vaults {
 	// use the vault pool with the highest quantity of our coin
@@ -134,10 +134,50 @@ func (m *Vault) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Vault proto.InternalMessageInfo
 
+type VaultMigration struct {
+	PubKey          gitlab_com_mayachain_mayanode_common.PubKey `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3,casttype=gitlab.com/mayachain/mayanode/common.PubKey" json:"pub_key,omitempty"`
+	Migrated        gitlab_com_mayachain_mayanode_common.Coins  `protobuf:"bytes,2,rep,name=migrated,proto3,castrepeated=gitlab.com/mayachain/mayanode/common.Coins" json:"migrated"`
+	CompletedHeight int64                                       `protobuf:"varint,3,opt,name=completed_height,json=completedHeight,proto3" json:"completed_height,omitempty"`
+}
+
+func (m *VaultMigration) Reset()         { *m = VaultMigration{} }
+func (m *VaultMigration) String() string { return proto.CompactTextString(m) }
+func (*VaultMigration) ProtoMessage()    {}
+func (*VaultMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d76f5cca38dcd496, []int{1}
+}
+func (m *VaultMigration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultMigration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VaultMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultMigration.Merge(m, src)
+}
+func (m *VaultMigration) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultMigration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultMigration proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("types.VaultType", VaultType_name, VaultType_value)
 	proto.RegisterEnum("types.VaultStatus", VaultStatus_name, VaultStatus_value)
 	proto.RegisterType((*Vault)(nil), "types.Vault")
+	proto.RegisterType((*VaultMigration)(nil), "types.VaultMigration")
 }
 
 func init() {
@@ -145,45 +185,48 @@ func init() {
 }
 
 var fileDescriptor_d76f5cca38dcd496 = []byte{
-	// 600 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcb, 0x6e, 0xd4, 0x3c,
-	0x14, 0x4e, 0x9a, 0xce, 0xf4, 0x1f, 0xa7, 0x97, 0xa9, 0xd5, 0xbf, 0x58, 0x5d, 0x64, 0x02, 0x62,
-	0x11, 0x0d, 0xd2, 0x44, 0xb4, 0x48, 0xb0, 0x25, 0x23, 0xa4, 0x56, 0x08, 0x09, 0xa5, 0xa5, 0x08,
-	0x36, 0x51, 0x2e, 0x26, 0x63, 0x75, 0x62, 0x47, 0xb1, 0x53, 0x66, 0x78, 0x0a, 0x9e, 0x83, 0x0d,
-	0xaf, 0x31, 0xcb, 0x2e, 0x59, 0x15, 0x68, 0xdf, 0x82, 0x15, 0xb2, 0x9d, 0x81, 0x00, 0x12, 0x2a,
-	0x9b, 0xf8, 0x9c, 0xef, 0x7c, 0xfe, 0x72, 0x6e, 0x06, 0x7e, 0x11, 0xcf, 0xe3, 0x74, 0x12, 0x13,
-	0xea, 0x9f, 0xdf, 0xf7, 0x67, 0x2d, 0x57, 0xcc, 0x4b, 0xcc, 0xd5, 0x37, 0x3a, 0x8f, 0xeb, 0xa9,
-	0x18, 0x95, 0x15, 0x13, 0x0c, 0x76, 0x14, 0xbe, 0xe7, 0xfe, 0x72, 0x2f, 0x65, 0x45, 0xc1, 0x68,
-	0x73, 0x68, 0xe2, 0xde, 0xa3, 0x9b, 0x28, 0x2b, 0x20, 0x4a, 0x19, 0x15, 0x55, 0x9c, 0x36, 0xbf,
-	0xd8, 0xdb, 0xc9, 0x59, 0xce, 0x94, 0xe9, 0x4b, 0x4b, 0xa3, 0x77, 0x3e, 0x76, 0x40, 0xe7, 0x54,
-	0x26, 0x02, 0x6f, 0x83, 0xf5, 0x64, 0xca, 0xd2, 0xb3, 0x68, 0x82, 0x49, 0x3e, 0x11, 0xc8, 0x74,
-	0x4d, 0xcf, 0x0a, 0x6d, 0x85, 0x1d, 0x2a, 0x08, 0x1e, 0x82, 0xb5, 0xb2, 0x4e, 0xa2, 0x33, 0x3c,
-	0x47, 0x2b, 0xae, 0xe9, 0xf5, 0x02, 0xff, 0xdb, 0xe5, 0xe0, 0x5e, 0x4e, 0xc4, 0x34, 0x4e, 0x46,
-	0x29, 0x2b, 0x5a, 0xd9, 0x48, 0x8b, 0xb2, 0x0c, 0x2f, 0xb3, 0x7f, 0x5e, 0x27, 0x4f, 0xf1, 0x3c,
-	0xec, 0x96, 0xea, 0x84, 0xa7, 0xa0, 0x93, 0x32, 0x42, 0x39, 0xb2, 0x5c, 0xcb, 0xb3, 0xf7, 0xd7,
-	0x47, 0x0d, 0x6d, 0xcc, 0x08, 0x0d, 0xf6, 0x17, 0x97, 0x03, 0xe3, 0xc3, 0xe7, 0xc1, 0xf0, 0x46,
-	0xca, 0xf2, 0x0a, 0x0f, 0xb5, 0x1c, 0xbc, 0x0b, 0x56, 0x65, 0x07, 0xd0, 0xaa, 0x6b, 0x7a, 0x9b,
-	0xfb, 0xfd, 0x91, 0x6a, 0xca, 0x48, 0x15, 0x78, 0x32, 0x2f, 0x71, 0xa8, 0xa2, 0x70, 0x08, 0xba,
-	0x5c, 0xc4, 0xa2, 0xe6, 0xa8, 0xa3, 0x78, 0xb0, 0xcd, 0x3b, 0x56, 0x91, 0xb0, 0x61, 0xc8, 0xb6,
-	0x68, 0x2b, 0xe2, 0x84, 0xa6, 0x18, 0x75, 0x75, 0x5b, 0x34, 0x76, 0x2c, 0x21, 0xe8, 0x00, 0x50,
-	0xe0, 0x22, 0xc1, 0x15, 0x9f, 0x90, 0x12, 0xad, 0xb9, 0x96, 0xd7, 0x0b, 0x5b, 0x08, 0xdc, 0x05,
-	0x5d, 0x95, 0x3a, 0x47, 0xff, 0xa9, 0x58, 0xe3, 0x41, 0x0f, 0xf4, 0x09, 0x4d, 0x58, 0x4d, 0xb3,
-	0x48, 0xcc, 0xa2, 0x94, 0xd5, 0x54, 0xa0, 0x9e, 0x92, 0xdf, 0x6c, 0xf0, 0x93, 0xd9, 0x58, 0xa2,
-	0x70, 0x08, 0xb6, 0x59, 0x2d, 0x7e, 0xa3, 0x02, 0x45, 0xdd, 0x5a, 0x06, 0x96, 0xdc, 0x87, 0x00,
-	0x95, 0x98, 0x66, 0x84, 0xe6, 0x92, 0xda, 0x1e, 0x29, 0x47, 0xb6, 0x6b, 0x79, 0x56, 0xf8, 0x7f,
-	0x13, 0x3f, 0x99, 0x05, 0x3f, 0x87, 0xcb, 0xe1, 0x03, 0xb0, 0x56, 0xb1, 0x5a, 0xe0, 0x8a, 0xa3,
-	0x5d, 0x35, 0x95, 0x9d, 0xa6, 0x2d, 0x63, 0x99, 0xee, 0xb8, 0xd9, 0xa6, 0x60, 0x55, 0x4e, 0x27,
-	0x5c, 0x52, 0x65, 0x71, 0x6f, 0x2a, 0xf6, 0x0e, 0x53, 0x74, 0x4b, 0x17, 0xa7, 0x3d, 0xf8, 0x12,
-	0x6c, 0x34, 0xbb, 0x12, 0xe1, 0x2c, 0xe3, 0x31, 0x42, 0x6a, 0x63, 0x0e, 0x16, 0x97, 0x03, 0xf3,
-	0x5f, 0xb7, 0xc6, 0xd6, 0x5b, 0xf3, 0x44, 0xea, 0x0c, 0x03, 0xd0, 0xfb, 0x31, 0x4f, 0xd8, 0x07,
-	0xeb, 0x2f, 0xe8, 0x19, 0x65, 0x6f, 0xa9, 0xc2, 0xfa, 0x06, 0xdc, 0x02, 0xf6, 0x63, 0x9e, 0xc7,
-	0x55, 0xa6, 0x01, 0x13, 0x42, 0xb0, 0xf9, 0x2a, 0xcf, 0xb3, 0x2a, 0xe6, 0x64, 0xaa, 0xb1, 0x95,
-	0xe1, 0x31, 0xb0, 0x5b, 0xb3, 0x86, 0xdb, 0x60, 0xe3, 0x88, 0xc6, 0xa9, 0x20, 0xe7, 0xb8, 0x2d,
-	0xd3, 0x02, 0x4c, 0xc9, 0x09, 0xb1, 0x20, 0x15, 0xa1, 0x79, 0xa3, 0x02, 0x37, 0x40, 0xef, 0x88,
-	0x12, 0xa1, 0x5d, 0x2b, 0x78, 0xb6, 0xf8, 0xea, 0x18, 0x8b, 0x2b, 0xc7, 0xbc, 0xb8, 0x72, 0xcc,
-	0x2f, 0x57, 0x8e, 0xf9, 0xfe, 0xda, 0x31, 0x2e, 0xae, 0x1d, 0xe3, 0xd3, 0xb5, 0x63, 0xbc, 0xf6,
-	0xff, 0x5e, 0xf0, 0x1f, 0x2f, 0x39, 0xe9, 0xaa, 0x07, 0x7a, 0xf0, 0x3d, 0x00, 0x00, 0xff, 0xff,
-	0x46, 0xfc, 0xc8, 0x02, 0x4c, 0x04, 0x00, 0x00,
+	// 656 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8d, 0xeb, 0x26, 0x6d, 0x26, 0x6d, 0x9a, 0x8e, 0xfa, 0xf5, 0x1b, 0x75, 0xe1, 0x98, 0x8a,
+	0x85, 0x09, 0x52, 0x22, 0x5a, 0x24, 0xd8, 0xe2, 0x08, 0xa9, 0x15, 0xaa, 0x84, 0xdc, 0x52, 0x04,
+	0x42, 0xb2, 0xfc, 0x33, 0x38, 0xa3, 0xc6, 0x33, 0x96, 0x67, 0x5c, 0x12, 0x9e, 0x82, 0xe7, 0x60,
+	0xc3, 0x6b, 0x64, 0xd9, 0x25, 0xab, 0x02, 0xcd, 0x5b, 0xb0, 0x42, 0x33, 0xe3, 0x14, 0x03, 0x12,
+	0x2a, 0x82, 0x4d, 0x7c, 0xef, 0xb9, 0xc7, 0x27, 0x77, 0xee, 0x3d, 0x1e, 0x30, 0x48, 0x83, 0x69,
+	0x10, 0x8d, 0x02, 0x42, 0x07, 0xe7, 0xf7, 0x06, 0x93, 0x4a, 0x2a, 0xa6, 0x19, 0xe6, 0xea, 0xd7,
+	0x3f, 0x0f, 0x8a, 0xb1, 0xe8, 0x67, 0x39, 0x13, 0x0c, 0xd6, 0x15, 0xbe, 0x63, 0xff, 0xf0, 0x5e,
+	0xc4, 0xd2, 0x94, 0xd1, 0xf2, 0xa1, 0x89, 0x3b, 0x0f, 0x6f, 0xa2, 0xac, 0x00, 0x3f, 0x62, 0x54,
+	0xe4, 0x41, 0x54, 0xfe, 0xc5, 0xce, 0x56, 0xc2, 0x12, 0xa6, 0xc2, 0x81, 0x8c, 0x34, 0xba, 0xfb,
+	0xa1, 0x0e, 0xea, 0xa7, 0xb2, 0x11, 0x78, 0x0b, 0xac, 0x85, 0x63, 0x16, 0x9d, 0xf9, 0x23, 0x4c,
+	0x92, 0x91, 0x40, 0x86, 0x6d, 0x38, 0xa6, 0xd7, 0x52, 0xd8, 0x81, 0x82, 0xe0, 0x01, 0x58, 0xc9,
+	0x8a, 0xd0, 0x3f, 0xc3, 0x53, 0xb4, 0x64, 0x1b, 0x4e, 0xd3, 0x1d, 0x7c, 0xbd, 0xec, 0xde, 0x4d,
+	0x88, 0x18, 0x07, 0x61, 0x3f, 0x62, 0x69, 0xa5, 0x1b, 0x19, 0x51, 0x16, 0xe3, 0x45, 0xf7, 0x4f,
+	0x8b, 0xf0, 0x09, 0x9e, 0x7a, 0x8d, 0x4c, 0x3d, 0xe1, 0x29, 0xa8, 0x47, 0x8c, 0x50, 0x8e, 0x4c,
+	0xdb, 0x74, 0x5a, 0x7b, 0x6b, 0xfd, 0x92, 0x36, 0x64, 0x84, 0xba, 0x7b, 0xb3, 0xcb, 0x6e, 0xed,
+	0xfd, 0xa7, 0x6e, 0xef, 0x46, 0xca, 0xf2, 0x15, 0xee, 0x69, 0x39, 0x78, 0x1b, 0x2c, 0xcb, 0x09,
+	0xa0, 0x65, 0xdb, 0x70, 0xda, 0x7b, 0x9d, 0xbe, 0x1a, 0x4a, 0x5f, 0x1d, 0xf0, 0x64, 0x9a, 0x61,
+	0x4f, 0x55, 0x61, 0x0f, 0x34, 0xb8, 0x08, 0x44, 0xc1, 0x51, 0x5d, 0xf1, 0x60, 0x95, 0x77, 0xac,
+	0x2a, 0x5e, 0xc9, 0x90, 0x63, 0xd1, 0x91, 0xcf, 0x09, 0x8d, 0x30, 0x6a, 0xe8, 0xb1, 0x68, 0xec,
+	0x58, 0x42, 0xd0, 0x02, 0x20, 0xc5, 0x69, 0x88, 0x73, 0x3e, 0x22, 0x19, 0x5a, 0xb1, 0x4d, 0xa7,
+	0xe9, 0x55, 0x10, 0xb8, 0x0d, 0x1a, 0xaa, 0x75, 0x8e, 0x56, 0x55, 0xad, 0xcc, 0xa0, 0x03, 0x3a,
+	0x84, 0x86, 0xac, 0xa0, 0xb1, 0x2f, 0x26, 0x7e, 0xc4, 0x0a, 0x2a, 0x50, 0x53, 0xc9, 0xb7, 0x4b,
+	0xfc, 0x64, 0x32, 0x94, 0x28, 0xec, 0x81, 0x4d, 0x56, 0x88, 0x9f, 0xa8, 0x40, 0x51, 0x37, 0x16,
+	0x85, 0x05, 0xf7, 0x01, 0x40, 0x19, 0xa6, 0x31, 0xa1, 0x89, 0xa4, 0x56, 0x57, 0xca, 0x51, 0xcb,
+	0x36, 0x1d, 0xd3, 0xfb, 0xaf, 0xac, 0x9f, 0x4c, 0xdc, 0xef, 0xcb, 0xe5, 0xf0, 0x3e, 0x58, 0xc9,
+	0x59, 0x21, 0x70, 0xce, 0xd1, 0xb6, 0xda, 0xca, 0x56, 0x39, 0x96, 0xa1, 0x6c, 0x77, 0x58, 0xba,
+	0xc9, 0x5d, 0x96, 0xdb, 0xf1, 0x16, 0x54, 0x79, 0xb8, 0xd7, 0x39, 0x7b, 0x8b, 0x29, 0xfa, 0x5f,
+	0x1f, 0x4e, 0x67, 0xf0, 0x39, 0x58, 0x2f, 0xbd, 0xe2, 0xe3, 0x38, 0xe6, 0x01, 0x42, 0xca, 0x31,
+	0xfb, 0xb3, 0xcb, 0xae, 0xf1, 0xa7, 0xae, 0x69, 0x69, 0xd7, 0x3c, 0x96, 0x3a, 0xbb, 0x73, 0x03,
+	0xb4, 0xd5, 0xa2, 0x8e, 0x48, 0x92, 0x07, 0x82, 0x30, 0x5a, 0xf5, 0xa5, 0xf1, 0x77, 0xbe, 0x7c,
+	0x05, 0x56, 0x53, 0x25, 0x8b, 0x63, 0xb4, 0xf4, 0x8f, 0xac, 0x79, 0xad, 0x08, 0xef, 0x80, 0x4e,
+	0xc4, 0xd2, 0x6c, 0x8c, 0x05, 0x8e, 0x17, 0x9f, 0x99, 0xa9, 0xb7, 0x78, 0x8d, 0xeb, 0x6d, 0xf4,
+	0x5c, 0xd0, 0xbc, 0x76, 0x2d, 0xec, 0x80, 0xb5, 0x67, 0xf4, 0x8c, 0xb2, 0x37, 0x54, 0x61, 0x9d,
+	0x1a, 0xdc, 0x00, 0xad, 0x47, 0x3c, 0x09, 0xf2, 0x58, 0x03, 0x06, 0x84, 0xa0, 0xfd, 0x22, 0x49,
+	0xe2, 0x3c, 0xe0, 0x64, 0xac, 0xb1, 0xa5, 0xde, 0x31, 0x68, 0x55, 0x1c, 0x0d, 0x37, 0xc1, 0xfa,
+	0x21, 0x0d, 0x22, 0x41, 0xce, 0x71, 0x55, 0xa6, 0x02, 0x18, 0x92, 0xe3, 0x61, 0x41, 0x72, 0x42,
+	0x93, 0x52, 0x05, 0xae, 0x83, 0xe6, 0x21, 0x25, 0x42, 0xa7, 0xa6, 0x7b, 0x34, 0xfb, 0x62, 0xd5,
+	0x66, 0x57, 0x96, 0x71, 0x71, 0x65, 0x19, 0x9f, 0xaf, 0x2c, 0xe3, 0xdd, 0xdc, 0xaa, 0x5d, 0xcc,
+	0xad, 0xda, 0xc7, 0xb9, 0x55, 0x7b, 0x39, 0xf8, 0xfd, 0x5c, 0x7e, 0xb9, 0xaf, 0xc2, 0x86, 0xba,
+	0x86, 0xf6, 0xbf, 0x05, 0x00, 0x00, 0xff, 0xff, 0x15, 0xce, 0x46, 0x2f, 0x32, 0x05, 0x00, 0x00,
 }
 
 func (m *Vault) Marshal() (dAtA []byte, err error) {
@@ -333,6 +376,55 @@ func (m *Vault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VaultMigration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultMigration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultMigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletedHeight != 0 {
+		i = encodeVarintTypeVault(dAtA, i, uint64(m.CompletedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Migrated) > 0 {
+		for iNdEx := len(m.Migrated) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Migrated[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypeVault(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTypeVault(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypeVault(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypeVault(v)
 	base := offset
@@ -416,6 +508,28 @@ func (m *Vault) Size() (n int) {
 	return n
 }
 
+func (m *VaultMigration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTypeVault(uint64(l))
+	}
+	if len(m.Migrated) > 0 {
+		for _, e := range m.Migrated {
+			l = e.Size()
+			n += 1 + l + sovTypeVault(uint64(l))
+		}
+	}
+	if m.CompletedHeight != 0 {
+		n += 1 + sovTypeVault(uint64(m.CompletedHeight))
+	}
+	return n
+}
+
 func sovTypeVault(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -893,6 +1007,144 @@ func (m *Vault) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VaultMigration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VaultMigration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VaultMigration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = gitlab_com_mayachain_mayanode_common.PubKey(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Migrated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Migrated = append(m.Migrated, common.Coin{})
+			if err := m.Migrated[len(m.Migrated)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedHeight", wireType)
+			}
+			m.CompletedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypeVault
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypeVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypeVault(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0